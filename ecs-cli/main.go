@@ -20,15 +20,24 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory"
+	addonsCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/addons"
+	applyCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/apply"
 	attributecheckercommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/attributechecker"
+	attributesCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/attributes"
 	clusterCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/cluster"
 	composeCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/compose"
 	configureCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/configure"
+	eventsCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/events"
 	imageCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/image"
 	licenseCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/license"
 	localCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/local"
 	logsCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/log"
 	regcredsCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/regcreds"
+	regionsCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/regions"
+	schemaCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/schema"
+	secretsCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/secrets"
+	statsCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/stats"
+	taskdefCommand "github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/taskdef"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/logger"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/version"
 	"github.com/sirupsen/logrus"
@@ -59,6 +68,15 @@ func main() {
 	// Setup logrus for amazon-ecr-credential-helper
 	logger.SetupLogger()
 
+	err := newApp().Run(cliArgsWithoutTestFlags())
+	if err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+// newApp returns the ECS CLI application. The apply command runs the commands of its plan with a
+// new application each, since the values of the flags of an application are kept between runs.
+func newApp() *cli.App {
 	app := cli.NewApp()
 	app.Name = version.AppName
 	app.Usage = "Command line interface for Amazon ECS"
@@ -69,19 +87,46 @@ func main() {
 
 	app.Commands = []cli.Command{
 		configureCommand.ConfigureCommand(),
+		configureCommand.ContextCommand(),
 		clusterCommand.UpCommand(),
 		clusterCommand.DownCommand(),
 		clusterCommand.ScaleCommand(),
+		clusterCommand.StopCommand(),
+		clusterCommand.StartCommand(),
 		clusterCommand.PsCommand(),
+		clusterCommand.SSHCommand(),
+		clusterCommand.StacksCommand(),
+		clusterCommand.InventoryCommand(),
+		clusterCommand.InterruptionsCommand(),
+		clusterCommand.AgentsCommand(),
+		clusterCommand.ReplaceInstanceCommand(),
+		clusterCommand.ResizeInstanceTypeCommand(),
+		clusterCommand.QuotasCommand(),
+		clusterCommand.CostsCommand(),
+		clusterCommand.HealthCommand(),
+		clusterCommand.EnvCommand(),
+		clusterCommand.CloneCommand(),
 		imageCommand.PushCommand(),
 		imageCommand.PullCommand(),
 		imageCommand.ImagesCommand(),
 		licenseCommand.LicenseCommand(),
+		licenseCommand.VersionCommand(),
 		composeCommand.ComposeCommand(composeFactory),
 		attributecheckercommand.AttributecheckerCommand(),
+		attributesCommand.AttributesCommand(),
+		taskdefCommand.TaskDefCommand(),
+		secretsCommand.SecretsCommand(),
 		logsCommand.LogCommand(),
+		statsCommand.StatsCommand(),
 		regcredsCommand.RegistryCredsCommand(),
+		regionsCommand.RegionsCommand(),
+		schemaCommand.SchemaCommand(),
+		addonsCommand.AddonsCommand(),
+		eventsCommand.EventsCommand(),
 		localCommand.LocalCommand(),
+		applyCommand.ApplyCommand(newApp),
+		applyCommand.ReconcileCommand(newApp),
+		applyCommand.DestroyCommand(newApp),
 	}
 
 	app.Flags = []cli.Flag{
@@ -89,10 +134,11 @@ func main() {
 			Name:  flags.EndpointFlag,
 			Usage: "Use a custom endpoint with the ECS CLI",
 		},
+		cli.BoolFlag{
+			Name:   flags.ReadOnlyFlag,
+			EnvVar: flags.ReadOnlyEnvVar,
+			Usage:  "Refuses to run the commands that create, update or delete AWS resources, for use with read-only credentials",
+		},
 	}
-
-	err := app.Run(cliArgsWithoutTestFlags())
-	if err != nil {
-		logrus.Fatal(err)
-	}
+	return app
 }
@@ -16,6 +16,7 @@ package app
 import (
 	log "github.com/sirupsen/logrus"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
 	"github.com/urfave/cli"
 )
 
@@ -24,5 +25,5 @@ func BeforeApp(c *cli.Context) error {
 	if c.GlobalBool(flags.VerboseFlag) || c.Bool(flags.VerboseFlag) {
 		log.SetLevel(log.DebugLevel)
 	}
-	return nil
+	return progress.SetFormat(c.String(flags.ProgressFlag))
 }
@@ -0,0 +1,172 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package addons
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// Names of the addons
+const (
+	Datadog   = "datadog"
+	FluentBit = "fluent-bit"
+	NewRelic  = "newrelic"
+)
+
+// fluentBitForwardPort is the port of the Fluent Bit forward input, the default one of the fluentd log driver
+const fluentBitForwardPort = 24224
+
+// installParams are the values of the cluster the task definition of an addon depends on
+type installParams struct {
+	cluster string
+	region  string
+}
+
+// addon describes a cluster-wide agent, run on every container instance by a daemon service
+type addon struct {
+	// apiKeyEnvVar is the environment variable the agent reads its API or license key from, injected
+	// from a Secrets Manager secret, or empty if the agent does not need one
+	apiKeyEnvVar string
+	// taskRolePolicies are the names of the AWS managed policies the agent needs to send its data
+	taskRolePolicies []string
+	// taskDefinition returns the task definition recommended by the vendor, whose first container is the agent
+	taskDefinition func(params installParams) *ecs.RegisterTaskDefinitionInput
+}
+
+var addons = map[string]addon{
+	Datadog: {
+		apiKeyEnvVar:   "DD_API_KEY",
+		taskDefinition: datadogTaskDefinition,
+	},
+	FluentBit: {
+		taskRolePolicies: []string{"CloudWatchAgentServerPolicy"},
+		taskDefinition:   fluentBitTaskDefinition,
+	},
+	NewRelic: {
+		apiKeyEnvVar:   "NRIA_LICENSE_KEY",
+		taskDefinition: newRelicTaskDefinition,
+	},
+}
+
+// Names returns the names of the addons, sorted
+func Names() []string {
+	var names []string
+	for name := range addons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// datadogTaskDefinition returns the task definition of the Datadog agent, which reads the metrics of
+// the containers from the Docker socket and the cgroups of the host
+func datadogTaskDefinition(params installParams) *ecs.RegisterTaskDefinitionInput {
+	return &ecs.RegisterTaskDefinitionInput{
+		ContainerDefinitions: []*ecs.ContainerDefinition{{
+			Name:              aws.String("datadog-agent"),
+			Image:             aws.String("public.ecr.aws/datadog/agent:latest"),
+			Essential:         aws.Bool(true),
+			Cpu:               aws.Int64(100),
+			MemoryReservation: aws.Int64(512),
+			Environment: []*ecs.KeyValuePair{
+				{Name: aws.String("DD_ECS_COLLECT_RESOURCE_TAGS_EC2"), Value: aws.String("true")},
+				{Name: aws.String("DD_TAGS"), Value: aws.String("ecs_cluster:" + params.cluster)},
+			},
+			MountPoints: []*ecs.MountPoint{
+				readOnlyMount("docker_sock", "/var/run/docker.sock"),
+				readOnlyMount("proc", "/host/proc"),
+				readOnlyMount("cgroup", "/host/sys/fs/cgroup"),
+			},
+		}},
+		Volumes: []*ecs.Volume{
+			hostVolume("docker_sock", "/var/run/docker.sock"),
+			hostVolume("proc", "/proc/"),
+			hostVolume("cgroup", "/sys/fs/cgroup/"),
+		},
+	}
+}
+
+// fluentBitTaskDefinition returns the task definition of Fluent Bit, which receives the logs sent by
+// the fluentd log driver of the containers on the instance and writes them to CloudWatch Logs
+func fluentBitTaskDefinition(params installParams) *ecs.RegisterTaskDefinitionInput {
+	return &ecs.RegisterTaskDefinitionInput{
+		NetworkMode: aws.String(ecs.NetworkModeHost),
+		ContainerDefinitions: []*ecs.ContainerDefinition{{
+			Name:              aws.String("fluent-bit"),
+			Image:             aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:stable"),
+			Essential:         aws.Bool(true),
+			Cpu:               aws.Int64(64),
+			MemoryReservation: aws.Int64(128),
+			Command: aws.StringSlice([]string{
+				"/fluent-bit/bin/fluent-bit",
+				"-i", "forward", "-p", "listen=0.0.0.0", "-p", fmt.Sprintf("port=%d", fluentBitForwardPort),
+				"-o", "cloudwatch_logs", "-p", "match=*", "-p", "region=" + params.region,
+				"-p", "log_group_name=/ecs/" + params.cluster + "/fluent-bit",
+				"-p", "log_stream_prefix=fluent-bit-", "-p", "auto_create_group=true",
+			}),
+			PortMappings: []*ecs.PortMapping{{
+				ContainerPort: aws.Int64(fluentBitForwardPort),
+				HostPort:      aws.Int64(fluentBitForwardPort),
+				Protocol:      aws.String(ecs.TransportProtocolTcp),
+			}},
+		}},
+	}
+}
+
+// newRelicTaskDefinition returns the task definition of the New Relic infrastructure agent, which
+// monitors the host and its containers from the host network and process namespaces
+func newRelicTaskDefinition(params installParams) *ecs.RegisterTaskDefinitionInput {
+	return &ecs.RegisterTaskDefinitionInput{
+		NetworkMode: aws.String(ecs.NetworkModeHost),
+		PidMode:     aws.String(ecs.PidModeHost),
+		ContainerDefinitions: []*ecs.ContainerDefinition{{
+			Name:              aws.String("newrelic-infra"),
+			Image:             aws.String("newrelic/infrastructure-bundle:latest"),
+			Essential:         aws.Bool(true),
+			Privileged:        aws.Bool(true),
+			Cpu:               aws.Int64(100),
+			MemoryReservation: aws.Int64(512),
+			Environment: []*ecs.KeyValuePair{
+				{Name: aws.String("NRIA_CUSTOM_ATTRIBUTES"), Value: aws.String(fmt.Sprintf(`{"ecsClusterName":"%s"}`, params.cluster))},
+			},
+			MountPoints: []*ecs.MountPoint{
+				readOnlyMount("host_root", "/host"),
+				readOnlyMount("docker_sock", "/var/run/docker.sock"),
+			},
+		}},
+		Volumes: []*ecs.Volume{
+			hostVolume("host_root", "/"),
+			hostVolume("docker_sock", "/var/run/docker.sock"),
+		},
+	}
+}
+
+func hostVolume(name, sourcePath string) *ecs.Volume {
+	return &ecs.Volume{
+		Name: aws.String(name),
+		Host: &ecs.HostVolumeProperties{SourcePath: aws.String(sourcePath)},
+	}
+}
+
+func readOnlyMount(volume, containerPath string) *ecs.MountPoint {
+	return &ecs.MountPoint{
+		SourceVolume:  aws.String(volume),
+		ContainerPath: aws.String(containerPath),
+		ReadOnly:      aws.Bool(true),
+	}
+}
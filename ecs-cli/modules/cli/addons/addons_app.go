@@ -0,0 +1,202 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package addons installs cluster-wide agents, such as monitoring and log routing agents, as daemon
+// services of a cluster.
+package addons
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	iamclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/cache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+const (
+	// resourcePrefix prefixes the names of the task definition family, the service and the roles of an addon
+	resourcePrefix = "ecs-cli-addon-"
+
+	assumeRolePolicyDoc   = `{"Version":"2008-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"ecs-tasks.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+	executionRolePolicy   = "service-role/AmazonECSTaskExecutionRolePolicy"
+	secretPolicyName      = "ecs-cli-addon-secret"
+	serviceStatusActive   = "ACTIVE"
+	roleDescriptionFormat = "Role generated by the ecs-cli for the %s addon"
+)
+
+// Install registers the task definition of the addon named by the argument and runs it on every
+// container instance of the cluster with a daemon service, which is updated if it already exists
+func Install(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'addons install': ", err)
+	}
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'addons install': ", err)
+	}
+	ecsClient := ecsclient.NewECSClient(commandConfig)
+	iamClient := iamclient.NewIAMClient(commandConfig)
+	if err := install(c, commandConfig, ecsClient, iamClient, entity.SetupTaskDefinitionCache()); err != nil {
+		logrus.Fatal("Error executing 'addons install': ", err)
+	}
+}
+
+func install(c *cli.Context, commandConfig *config.CommandConfig, ecsClient ecsclient.ECSClient, iamClient iamclient.Client, tdCache cache.Cache) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("Specify the addon to install: %s", strings.Join(Names(), ", "))
+	}
+	name := c.Args().First()
+	addon, ok := addons[name]
+	if !ok {
+		return fmt.Errorf("Unknown addon '%s'. Valid addons are: %s", name, strings.Join(Names(), ", "))
+	}
+	secretArn := c.String(flags.SecretArnFlag)
+	if addon.apiKeyEnvVar == "" && secretArn != "" {
+		return fmt.Errorf("The %s addon does not read an API key, --%s cannot be used with it", name, flags.SecretArnFlag)
+	}
+	if addon.apiKeyEnvVar != "" && secretArn == "" {
+		return fmt.Errorf("The %s addon reads its API key from a Secrets Manager secret, which must be specified with the --%s flag", name, flags.SecretArnFlag)
+	}
+	if secretArn != "" && !arn.IsARN(secretArn) {
+		return fmt.Errorf("--%s must be the full ARN of the secret, got '%s'", flags.SecretArnFlag, secretArn)
+	}
+
+	region := commandConfig.Region()
+	partition := utils.GetPartition(region)
+	resourceName := resourcePrefix + name
+	request := addon.taskDefinition(installParams{cluster: commandConfig.Cluster, region: region})
+	request.Family = aws.String(resourceName)
+
+	if secretArn != "" {
+		roleName := resourceName + "-execution-role"
+		roleArn, err := ensureRole(iamClient, roleName, name, partition, []string{executionRolePolicy})
+		if err != nil {
+			return err
+		}
+		if err := allowSecret(iamClient, roleName, secretArn); err != nil {
+			return err
+		}
+		request.ExecutionRoleArn = aws.String(roleArn)
+		agent := request.ContainerDefinitions[0]
+		agent.Secrets = append(agent.Secrets, &ecs.Secret{
+			Name:      aws.String(addon.apiKeyEnvVar),
+			ValueFrom: aws.String(secretArn),
+		})
+	}
+	if len(addon.taskRolePolicies) > 0 {
+		roleArn, err := ensureRole(iamClient, resourceName+"-task-role", name, partition, addon.taskRolePolicies)
+		if err != nil {
+			return err
+		}
+		request.TaskRoleArn = aws.String(roleArn)
+	}
+
+	taskDef, err := ecsClient.RegisterTaskDefinitionIfNeeded(request, tdCache)
+	if err != nil {
+		return errors.Wrapf(err, "Error registering the task definition of the %s addon", name)
+	}
+	return deployService(ecsClient, commandConfig.Cluster, resourceName, aws.StringValue(taskDef.TaskDefinitionArn))
+}
+
+// ensureRole creates the role assumed by the tasks of the addon, unless it already exists, attaches
+// the AWS managed policies to it and returns its ARN
+func ensureRole(client iamclient.Client, roleName, addonName, partition string, policies []string) (string, error) {
+	if _, err := client.CreateOrFindRole(roleName, fmt.Sprintf(roleDescriptionFormat, addonName), assumeRolePolicyDoc, nil); err != nil {
+		return "", errors.Wrapf(err, "Error creating the role %s", roleName)
+	}
+	for _, policy := range policies {
+		policyArn := fmt.Sprintf("arn:%s:iam::aws:policy/%s", partition, policy)
+		// attaching a policy which is already attached to the role is a no-op
+		if _, err := client.AttachRolePolicy(policyArn, roleName); err != nil {
+			return "", errors.Wrapf(err, "Error attaching the %s policy to the role %s", policy, roleName)
+		}
+	}
+	output, err := client.GetRole(roleName)
+	if err != nil {
+		return "", errors.Wrapf(err, "Error describing the role %s", roleName)
+	}
+	logrus.WithFields(logrus.Fields{"role": roleName}).Info("Using role")
+	return aws.StringValue(output.Role.Arn), nil
+}
+
+// allowSecret grants the execution role the permission to read the secret holding the API key,
+// replacing the secret of a previous installation
+func allowSecret(client iamclient.Client, roleName, secretArn string) error {
+	document, err := json.Marshal(map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []map[string]interface{}{{
+			"Effect":   "Allow",
+			"Action":   []string{"secretsmanager:GetSecretValue"},
+			"Resource": []string{secretArn},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := client.PutRolePolicy(roleName, secretPolicyName, string(document)); err != nil {
+		return errors.Wrapf(err, "Error allowing the role %s to read the secret", roleName)
+	}
+	return nil
+}
+
+// deployService updates the daemon service of the addon with the task definition, or creates the
+// service if it does not exist or was deleted
+func deployService(client ecsclient.ECSClient, cluster, serviceName, taskDefArn string) error {
+	output, err := client.DescribeService(serviceName)
+	if err != nil {
+		return err
+	}
+	fields := logrus.Fields{
+		"service":        serviceName,
+		"taskDefinition": taskDefArn,
+	}
+	for _, service := range output.Services {
+		if aws.StringValue(service.Status) != serviceStatusActive {
+			continue
+		}
+		if err := client.UpdateService(&ecs.UpdateServiceInput{
+			Cluster:        aws.String(cluster),
+			Service:        aws.String(serviceName),
+			TaskDefinition: aws.String(taskDefArn),
+		}); err != nil {
+			return err
+		}
+		logrus.WithFields(fields).Info("Updated the addon service")
+		return nil
+	}
+
+	if err := client.CreateService(&ecs.CreateServiceInput{
+		Cluster:            aws.String(cluster),
+		ServiceName:        aws.String(serviceName),
+		TaskDefinition:     aws.String(taskDefArn),
+		SchedulingStrategy: aws.String(ecs.SchedulingStrategyDaemon),
+		LaunchType:         aws.String(ecs.LaunchTypeEc2),
+	}); err != nil {
+		return err
+	}
+	logrus.WithFields(fields).Info("Created the addon service")
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package addons
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/cache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	clusterName = "default"
+	secretArn   = "arn:aws:secretsmanager:us-west-2:123456789012:secret:datadog-api-key-AbCdEf"
+	roleArnBase = "arn:aws:iam::123456789012:role/"
+	taskDefArn  = "arn:aws:ecs:us-west-2:123456789012:task-definition/ecs-cli-addon-datadog:1"
+)
+
+func newContext(secretArn string, args ...string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-addons", 0)
+	flagSet.String(flags.SecretArnFlag, secretArn, "")
+	flagSet.Parse(args)
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func newCommandConfig() *config.CommandConfig {
+	return &config.CommandConfig{
+		Cluster: clusterName,
+		Session: session.Must(session.NewSession(&aws.Config{Region: aws.String("us-west-2")})),
+	}
+}
+
+func expectRole(mockIAM *mock_iam.MockClient, roleName string, policies ...string) {
+	mockIAM.EXPECT().CreateOrFindRole(roleName, gomock.Any(), assumeRolePolicyDoc, gomock.Nil()).Return("", nil)
+	for _, policy := range policies {
+		mockIAM.EXPECT().AttachRolePolicy("arn:aws:iam::aws:policy/"+policy, roleName).Return(&iam.AttachRolePolicyOutput{}, nil)
+	}
+	mockIAM.EXPECT().GetRole(roleName).Return(&iam.GetRoleOutput{
+		Role: &iam.Role{Arn: aws.String(roleArnBase + roleName)},
+	}, nil)
+}
+
+func TestInstallDatadog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockIAM := mock_iam.NewMockClient(ctrl)
+
+	roleName := "ecs-cli-addon-datadog-execution-role"
+	gomock.InOrder(
+		mockIAM.EXPECT().CreateOrFindRole(roleName, gomock.Any(), assumeRolePolicyDoc, gomock.Nil()).Return(roleArnBase+roleName, nil),
+		mockIAM.EXPECT().AttachRolePolicy("arn:aws:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy", roleName).Return(&iam.AttachRolePolicyOutput{}, nil),
+		mockIAM.EXPECT().GetRole(roleName).Return(&iam.GetRoleOutput{Role: &iam.Role{Arn: aws.String(roleArnBase + roleName)}}, nil),
+		mockIAM.EXPECT().PutRolePolicy(roleName, secretPolicyName, gomock.Any()).Do(func(_, _, document string) {
+			assert.Contains(t, document, `"Resource":["`+secretArn+`"]`)
+			assert.Contains(t, document, "secretsmanager:GetSecretValue")
+		}).Return(&iam.PutRolePolicyOutput{}, nil),
+	)
+	gomock.InOrder(
+		mockECS.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Do(func(request *ecs.RegisterTaskDefinitionInput, _ cache.Cache) {
+			assert.Equal(t, "ecs-cli-addon-datadog", aws.StringValue(request.Family))
+			assert.Equal(t, roleArnBase+roleName, aws.StringValue(request.ExecutionRoleArn))
+			assert.Empty(t, aws.StringValue(request.TaskRoleArn))
+			agent := request.ContainerDefinitions[0]
+			assert.Equal(t, []*ecs.Secret{{Name: aws.String("DD_API_KEY"), ValueFrom: aws.String(secretArn)}}, agent.Secrets)
+			assert.Len(t, request.Volumes, 3)
+		}).Return(&ecs.TaskDefinition{TaskDefinitionArn: aws.String(taskDefArn)}, nil),
+		mockECS.EXPECT().DescribeService("ecs-cli-addon-datadog").Return(&ecs.DescribeServicesOutput{}, nil),
+		mockECS.EXPECT().CreateService(&ecs.CreateServiceInput{
+			Cluster:            aws.String(clusterName),
+			ServiceName:        aws.String("ecs-cli-addon-datadog"),
+			TaskDefinition:     aws.String(taskDefArn),
+			SchedulingStrategy: aws.String(ecs.SchedulingStrategyDaemon),
+			LaunchType:         aws.String(ecs.LaunchTypeEc2),
+		}).Return(nil),
+	)
+
+	err := install(newContext(secretArn, "datadog"), newCommandConfig(), mockECS, mockIAM, cache.NewNoopCache())
+	assert.NoError(t, err)
+}
+
+func TestInstallFluentBitUpdatesActiveService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockIAM := mock_iam.NewMockClient(ctrl)
+
+	roleName := "ecs-cli-addon-fluent-bit-task-role"
+	expectRole(mockIAM, roleName, "CloudWatchAgentServerPolicy")
+	gomock.InOrder(
+		mockECS.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Do(func(request *ecs.RegisterTaskDefinitionInput, _ cache.Cache) {
+			assert.Equal(t, roleArnBase+roleName, aws.StringValue(request.TaskRoleArn))
+			assert.Empty(t, aws.StringValue(request.ExecutionRoleArn))
+			assert.Equal(t, ecs.NetworkModeHost, aws.StringValue(request.NetworkMode))
+			command := aws.StringValueSlice(request.ContainerDefinitions[0].Command)
+			assert.Contains(t, command, "region=us-west-2")
+			assert.Contains(t, command, "log_group_name=/ecs/default/fluent-bit")
+		}).Return(&ecs.TaskDefinition{TaskDefinitionArn: aws.String(taskDefArn)}, nil),
+		mockECS.EXPECT().DescribeService("ecs-cli-addon-fluent-bit").Return(&ecs.DescribeServicesOutput{
+			Services: []*ecs.Service{
+				{ServiceName: aws.String("ecs-cli-addon-fluent-bit"), Status: aws.String("INACTIVE")},
+				{ServiceName: aws.String("ecs-cli-addon-fluent-bit"), Status: aws.String(serviceStatusActive)},
+			},
+		}, nil),
+		mockECS.EXPECT().UpdateService(&ecs.UpdateServiceInput{
+			Cluster:        aws.String(clusterName),
+			Service:        aws.String("ecs-cli-addon-fluent-bit"),
+			TaskDefinition: aws.String(taskDefArn),
+		}).Return(nil),
+	)
+
+	err := install(newContext("", "fluent-bit"), newCommandConfig(), mockECS, mockIAM, cache.NewNoopCache())
+	assert.NoError(t, err)
+}
+
+func TestInstallInvalidArguments(t *testing.T) {
+	testCases := map[string]*cli.Context{
+		"no addon":              newContext(secretArn),
+		"unknown addon":         newContext(secretArn, "splunk"),
+		"missing secret":        newContext("", "newrelic"),
+		"secret name":           newContext("newrelic-license-key", "newrelic"),
+		"secret without an API": newContext(secretArn, "fluent-bit"),
+	}
+	for name, context := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			err := install(context, newCommandConfig(), mock_ecs.NewMockECSClient(ctrl), mock_iam.NewMockClient(ctrl), cache.NewNoopCache())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestInstallRoleError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockIAM := mock_iam.NewMockClient(ctrl)
+	mockIAM.EXPECT().CreateOrFindRole(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("access denied"))
+
+	err := install(newContext(secretArn, "newrelic"), newCommandConfig(), mock_ecs.NewMockECSClient(ctrl), mockIAM, cache.NewNoopCache())
+	assert.Error(t, err)
+}
@@ -0,0 +1,188 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package agents lists the ECS agents of the container instances of a cluster, and updates outdated ones.
+package agents
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// instanceTypeAttribute is the container instance attribute set by the ECS agent to the EC2 instance type
+const instanceTypeAttribute = "ecs.instance-type"
+
+// Agents lists the ECS agents of the container instances of the cluster.
+func Agents(c *cli.Context) {
+	if c.Bool(flags.UpdateAgentFlag) {
+		if err := readonly.Check(c, "agents --"+flags.UpdateAgentFlag, "ecs:UpdateContainerAgent"); err != nil {
+			logrus.Fatal("Error executing 'agents': ", err)
+		}
+	}
+
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'agents': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'agents': ", err)
+	}
+
+	awsClients := cluster.NewAWSClients(commandConfig)
+	agents, err := clusterAgents(c, awsClients, commandConfig)
+	if err != nil {
+		logrus.Fatal("Error executing 'agents': ", err)
+	}
+	printAgents(os.Stdout, agents)
+}
+
+type agentInfo struct {
+	ContainerInstanceID string
+	EC2InstanceID       string
+	Status              string
+	Connected           bool
+	AgentVersion        string
+	DockerVersion       string
+	LatestAgentVersion  string
+	UpdateStatus        string
+}
+
+// Outdated returns true if the agent is older than the agent of the recommended ECS-optimized AMI
+func (a *agentInfo) Outdated() bool {
+	return a.LatestAgentVersion != "" && compareVersions(a.AgentVersion, a.LatestAgentVersion) < 0
+}
+
+// clusterAgents lists the ECS agents of the container instances of the cluster, and starts the update
+// of outdated connected agents if --update is specified.
+func clusterAgents(context *cli.Context, awsClients *cluster.AWSClients, commandConfig *config.CommandConfig) ([]*agentInfo, error) {
+	ecsClient := awsClients.ECSClient
+	if err := cluster.ValidateCluster(commandConfig.Cluster, ecsClient); err != nil {
+		return nil, err
+	}
+
+	containerInstanceArns, err := ecsClient.ListContainerInstances()
+	if err != nil {
+		return nil, err
+	}
+	if len(containerInstanceArns) == 0 {
+		return nil, nil
+	}
+	containerInstances, err := ecsClient.DescribeContainerInstances(containerInstanceArns)
+	if err != nil {
+		return nil, err
+	}
+
+	latestAgentVersions := make(map[string]string)
+	var agents []*agentInfo
+	for _, containerInstance := range containerInstances {
+		agent := &agentInfo{
+			ContainerInstanceID: composeutils.GetIdFromArn(aws.StringValue(containerInstance.ContainerInstanceArn)),
+			EC2InstanceID:       aws.StringValue(containerInstance.Ec2InstanceId),
+			Status:              aws.StringValue(containerInstance.Status),
+			Connected:           aws.BoolValue(containerInstance.AgentConnected),
+			UpdateStatus:        aws.StringValue(containerInstance.AgentUpdateStatus),
+		}
+		if versionInfo := containerInstance.VersionInfo; versionInfo != nil {
+			agent.AgentVersion = aws.StringValue(versionInfo.AgentVersion)
+			agent.DockerVersion = strings.TrimPrefix(aws.StringValue(versionInfo.DockerVersion), "DockerVersion: ")
+		}
+
+		instanceType := containerInstanceAttribute(containerInstance, instanceTypeAttribute)
+		latestAgentVersion, ok := latestAgentVersions[instanceType]
+		if !ok {
+			metadata, err := awsClients.AMIMetadataClient.GetRecommendedECSLinuxAMI(instanceType)
+			if err != nil {
+				return nil, err
+			}
+			latestAgentVersion = metadata.AgentVersion
+			latestAgentVersions[instanceType] = latestAgentVersion
+		}
+		agent.LatestAgentVersion = latestAgentVersion
+
+		if context.Bool(flags.UpdateAgentFlag) && agent.Outdated() && agent.Connected {
+			if err := ecsClient.UpdateContainerAgent(aws.StringValue(containerInstance.ContainerInstanceArn)); err != nil {
+				// Updates are only supported for some AMIs, e.g. not for Amazon Linux 2, so carry on with the other instances
+				logrus.Warnf("Could not update the ECS agent of container instance %s: %v", agent.ContainerInstanceID, err)
+			} else {
+				agent.UpdateStatus = ecs.AgentUpdateStatusPending
+			}
+		}
+		agents = append(agents, agent)
+	}
+	return agents, nil
+}
+
+func containerInstanceAttribute(containerInstance *ecs.ContainerInstance, name string) string {
+	for _, attribute := range containerInstance.Attributes {
+		if aws.StringValue(attribute.Name) == name {
+			return aws.StringValue(attribute.Value)
+		}
+	}
+	return ""
+}
+
+// compareVersions compares dotted numeric versions, e.g. 1.41.0, returning -1, 0 or 1
+func compareVersions(a, b string) int {
+	aParts := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bParts := strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aNum, bNum int
+		if i < len(aParts) {
+			aNum, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bNum, _ = strconv.Atoi(bParts[i])
+		}
+		if aNum < bNum {
+			return -1
+		}
+		if aNum > bNum {
+			return 1
+		}
+	}
+	return 0
+}
+
+func printAgents(out io.Writer, agents []*agentInfo) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "CONTAINER INSTANCE\tEC2 INSTANCE\tSTATUS\tCONNECTED\tAGENT VERSION\tDOCKER VERSION\tOUTDATED\tUPDATE STATUS")
+	for _, agent := range agents {
+		outdated := "no"
+		if agent.Outdated() {
+			outdated = fmt.Sprintf("yes (%s available)", agent.LatestAgentVersion)
+		}
+		updateStatus := agent.UpdateStatus
+		if updateStatus == "" {
+			updateStatus = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\t%s\t%s\t%s\n", agent.ContainerInstanceID, agent.EC2InstanceID, agent.Status, agent.Connected,
+			agent.AgentVersion, agent.DockerVersion, outdated, updateStatus)
+	}
+	w.Flush()
+}
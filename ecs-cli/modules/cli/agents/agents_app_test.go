@@ -0,0 +1,159 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package agents
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata"
+	mock_amimetadata "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata/mock"
+	mock_cloudformation "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const clusterName = "defaultCluster"
+
+type mockReadWriter struct {
+	clusterName string
+}
+
+func (rdwr *mockReadWriter) Get(cluster string, profile string) (*config.LocalConfig, error) {
+	cliConfig := config.NewLocalConfig(rdwr.clusterName)
+	cliConfig.CFNStackName = rdwr.clusterName
+	return cliConfig, nil
+}
+
+func (rdwr *mockReadWriter) SaveProfile(configName string, profile *config.Profile) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SaveCluster(configName string, cluster *config.Cluster) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultProfile(configName string) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultCluster(configName string) error {
+	return nil
+}
+
+func newMockReadWriter() *mockReadWriter {
+	return &mockReadWriter{
+		clusterName: clusterName,
+	}
+}
+
+func setupTest(t *testing.T) (*mock_ecs.MockECSClient, *mock_cloudformation.MockCloudformationClient, *mock_amimetadata.MockClient, *mock_ec2.MockEC2Client) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockSSM := mock_amimetadata.NewMockClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "secret")
+	os.Setenv("AWS_REGION", "us-west-1")
+
+	return mockECS, mockCloudformation, mockSSM, mockEC2
+}
+
+func agentContainerInstance(id, agentVersion string, connected bool) *ecs.ContainerInstance {
+	return &ecs.ContainerInstance{
+		ContainerInstanceArn: aws.String("arn:aws:ecs:us-west-1:123456789012:container-instance/" + id),
+		Ec2InstanceId:        aws.String("i-" + id),
+		Status:               aws.String(ecs.ContainerInstanceStatusActive),
+		AgentConnected:       aws.Bool(connected),
+		VersionInfo: &ecs.VersionInfo{
+			AgentVersion:  aws.String(agentVersion),
+			DockerVersion: aws.String("DockerVersion: 19.03.6-ce"),
+		},
+		Attributes: []*ecs.Attribute{
+			{Name: aws.String("ecs.instance-type"), Value: aws.String("t2.micro")},
+		},
+	}
+}
+
+func TestClusterAgents(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	containerInstanceArns := aws.StringSlice([]string{"arn1", "arn2", "arn3"})
+	containerInstances := []*ecs.ContainerInstance{
+		agentContainerInstance("current", "1.41.0", true),
+		agentContainerInstance("outdated", "1.9.0", true),
+		agentContainerInstance("disconnected", "1.40.2", false),
+	}
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockECS.EXPECT().ListContainerInstances().Return(containerInstanceArns, nil),
+		mockECS.EXPECT().DescribeContainerInstances(containerInstanceArns).Return(containerInstances, nil),
+		mockECS.EXPECT().UpdateContainerAgent(aws.StringValue(containerInstances[1].ContainerInstanceArn)).Return(nil),
+	)
+	mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(&amimetadata.AMIMetadata{AgentVersion: "1.41.0"}, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-agents", 0)
+	flagSet.Bool(flags.UpdateAgentFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	agents, err := clusterAgents(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error listing agents")
+	if assert.Len(t, agents, 3) {
+		assert.Equal(t, &agentInfo{
+			ContainerInstanceID: "current",
+			EC2InstanceID:       "i-current",
+			Status:              ecs.ContainerInstanceStatusActive,
+			Connected:           true,
+			AgentVersion:        "1.41.0",
+			DockerVersion:       "19.03.6-ce",
+			LatestAgentVersion:  "1.41.0",
+		}, agents[0])
+		assert.False(t, agents[0].Outdated())
+		assert.True(t, agents[1].Outdated())
+		assert.Equal(t, ecs.AgentUpdateStatusPending, agents[1].UpdateStatus)
+		assert.True(t, agents[2].Outdated())
+		assert.Empty(t, agents[2].UpdateStatus, "Expected disconnected agent not to be updated")
+	}
+
+	out := &bytes.Buffer{}
+	printAgents(out, agents)
+	assert.Contains(t, out.String(), "yes (1.41.0 available)")
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, 0, compareVersions("1.41.0", "1.41.0"))
+	assert.Equal(t, -1, compareVersions("1.9.0", "1.41.0"))
+	assert.Equal(t, 1, compareVersions("1.41.1", "1.41"))
+	assert.Equal(t, -1, compareVersions("v1.40.2", "1.41.0"))
+}
@@ -0,0 +1,456 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package apply creates, updates and deletes the cluster and the services of the compose projects
+// declared in a manifest, so that they can be managed declaratively.
+package apply
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/service"
+	cfnclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	cwlogsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/version"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// Actions of the steps of a plan
+const (
+	actionCreate = "create"
+	actionScale  = "scale"
+	actionUpdate = "update"
+	actionDelete = "delete"
+)
+
+// defaultAsgMaxSize is the default of the AsgMaxSize parameter of the cluster template
+const defaultAsgMaxSize = 1
+
+// step is a change of the plan, made by running an ECS CLI command
+type step struct {
+	action   string
+	resource string
+	// args are the arguments of the ECS CLI command run by the step
+	args []string
+	// service is the name of the ECS service of the step, if it creates, updates or deletes one
+	service string
+	// project is the project deployed or deleted by the step, nil for the services deleted with
+	// --prune, and current the service it updates
+	project *projectManifest
+	current *ecs.Service
+	// changes are the differences between the service updated by the step and its project
+	changes []string
+}
+
+// command returns the command line of the step, as printed in the plan
+func (s *step) command() string {
+	quoted := []string{version.AppName}
+	for _, arg := range s.args {
+		if arg == "" || strings.ContainsAny(arg, " \t\n'\"$\\") {
+			arg = "'" + strings.Replace(arg, "'", `'\''`, -1) + "'"
+		}
+		quoted = append(quoted, arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// planClients are the clients used to compare the cluster with the manifest
+type planClients struct {
+	ecsClient        ecsclient.ECSClient
+	cfnClient        cfnclient.CloudformationClient
+	logClientFactory cwlogsclient.LogClientFactory
+	// loadService returns what 'compose service up' would deploy with the arguments
+	loadService func([]string) (*declaredService, error)
+}
+
+func newPlanClients(commandConfig *config.CommandConfig, newApp func() *cli.App) *planClients {
+	return &planClients{
+		ecsClient:        ecsclient.NewECSClient(commandConfig),
+		cfnClient:        cfnclient.NewCloudformationClient(commandConfig),
+		logClientFactory: cwlogsclient.NewLogClientFactory(commandConfig),
+		loadService:      serviceLoader(newApp),
+	}
+}
+
+// Apply returns the action of the apply command, which runs the ECS CLI commands of the plan with
+// the application returned by newApp. A new application is needed for each command, since the
+// default values of the slice flags of an application keep the values they are set to.
+func Apply(newApp func() *cli.App) func(*cli.Context) {
+	return func(c *cli.Context) {
+		m, err := readManifest(c.String(flags.ManifestFileFlag))
+		if err != nil {
+			logrus.Fatal("Error executing 'apply': ", err)
+		}
+		if err := setClusterConfig(c, m.Cluster); err != nil {
+			logrus.Fatal("Error executing 'apply': ", err)
+		}
+		rdwr, err := config.NewReadWriter()
+		if err != nil {
+			logrus.Fatal("Error executing 'apply': ", err)
+		}
+		commandConfig, err := config.NewCommandConfig(c, rdwr)
+		if err != nil {
+			logrus.Fatal("Error executing 'apply': ", err)
+		}
+		run := func(args []string) error {
+			return newApp().Run(append([]string{version.AppName}, args...))
+		}
+		clients := newPlanClients(commandConfig, newApp)
+		if err := apply(c, m, clients, commandConfig, run, bufio.NewReader(os.Stdin), os.Stdout); err != nil {
+			logrus.Fatal("Error executing 'apply': ", err)
+		}
+	}
+}
+
+// setClusterConfig sets the cluster, the cluster configuration and the region of the manifest,
+// unless they are set on the command line
+func setClusterConfig(context *cli.Context, cluster *clusterManifest) error {
+	if cluster == nil {
+		return nil
+	}
+	values := map[string]string{
+		flags.ClusterFlag:       cluster.Name,
+		flags.ClusterConfigFlag: cluster.Config,
+		flags.RegionFlag:        cluster.Region,
+	}
+	for name, value := range values {
+		if value == "" || context.String(name) != "" {
+			continue
+		}
+		if err := context.Set(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func apply(context *cli.Context, m *manifest, clients *planClients, commandConfig *config.CommandConfig, run func([]string) error, reader *bufio.Reader, out io.Writer) error {
+	steps, err := plan(context, m, clients, commandConfig)
+	if err != nil {
+		return err
+	}
+	printPlan(out, steps)
+	if len(steps) == 0 {
+		logrus.Infof("Cluster '%s' already matches the manifest", commandConfig.Cluster)
+		return nil
+	}
+	if context.Bool(flags.DryRunFlag) {
+		return nil
+	}
+	if err := confirmPrune(context, steps, reader, out); err != nil {
+		return err
+	}
+	return execute(steps, run, commandConfig)
+}
+
+// confirmPrune prompts for confirmation to delete the services of the plan which are not declared
+// in the manifest, unless there are none or --force is set
+func confirmPrune(context *cli.Context, steps []*step, reader *bufio.Reader, out io.Writer) error {
+	if context.Bool(flags.ForceFlag) {
+		return nil
+	}
+	for _, s := range steps {
+		if s.action == actionDelete && s.project == nil {
+			return destroyPrompt(reader, out)
+		}
+	}
+	return nil
+}
+
+// execute runs the steps in order, stopping at the first one which fails
+func execute(steps []*step, run func([]string) error, commandConfig *config.CommandConfig) error {
+	for _, s := range steps {
+		logrus.Infof("Running '%s'", s.command())
+		if err := run(s.args); err != nil {
+			return fmt.Errorf("Error running '%s': %v", s.command(), err)
+		}
+	}
+	logrus.Infof("Applied %d changes to cluster '%s'", len(steps), commandConfig.Cluster)
+	return nil
+}
+
+// plan returns the steps which make the cluster and its services match the manifest: the cluster
+// is created or scaled, the services of the projects are created, or updated with 'compose service
+// up' if they differ from their projects, and the services of the absent projects are deleted with
+// 'compose service rm', as well as the services of undeclared projects with --prune.
+func plan(context *cli.Context, m *manifest, clients *planClients, commandConfig *config.CommandConfig) ([]*step, error) {
+	ecsClient := clients.ecsClient
+	if commandConfig.Cluster == "" {
+		return nil, fmt.Errorf("A cluster must be set in the manifest, with --%s or in the cluster configuration", flags.ClusterFlag)
+	}
+	var steps []*step
+	clusterExists, err := ecsClient.IsActiveCluster(commandConfig.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if m.Cluster != nil {
+		clusterStep, err := planCluster(m.Cluster, clusterExists, clients.cfnClient, commandConfig)
+		if err != nil {
+			return nil, err
+		}
+		if clusterStep != nil {
+			clusterStep.args = commandArgs(context, clusterStep.args...)
+			steps = append(steps, clusterStep)
+		}
+	} else if !clusterExists {
+		return nil, fmt.Errorf("Cluster '%s' does not exist. Declare the flags to create it with in the up section of the cluster of the manifest", commandConfig.Cluster)
+	}
+
+	declared := make(map[string]bool)
+	for i := range m.Projects {
+		project := &m.Projects[i]
+		serviceName := composeutils.GetServiceName(commandConfig.ComposeServiceNamePrefix, project.Name)
+		declared[serviceName] = true
+		var current *ecs.Service
+		if clusterExists {
+			if current, err = describeActiveService(ecsClient, serviceName); err != nil {
+				return nil, err
+			}
+		}
+		if project.Absent {
+			if current != nil {
+				args := commandArgs(context, composeArgs(project, "service", "rm")...)
+				steps = append(steps, &step{action: actionDelete, resource: "service " + serviceName, args: args, service: serviceName, project: project})
+			}
+			continue
+		}
+
+		serviceUpArgs, err := project.ServiceUp.args()
+		if err != nil {
+			return nil, err
+		}
+		args := commandArgs(context, composeArgs(project, append([]string{"service", "up"}, serviceUpArgs...)...)...)
+		s := &step{action: actionCreate, resource: "service " + serviceName, args: args, service: serviceName, project: project, current: current}
+		if current != nil {
+			s.action = actionUpdate
+			if s.changes, err = serviceChanges(s, clients, commandConfig); err != nil {
+				return nil, err
+			}
+			if len(s.changes) == 0 {
+				continue
+			}
+		}
+		steps = append(steps, s)
+	}
+
+	if context.Bool(flags.PruneFlag) && clusterExists {
+		pruned, err := undeclaredServices(ecsClient, declared, commandConfig.ComposeServiceNamePrefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, serviceName := range pruned {
+			// 'compose service rm' deletes the service of a project named after it, without its compose files
+			project := &projectManifest{Name: strings.TrimPrefix(serviceName, commandConfig.ComposeServiceNamePrefix)}
+			args := commandArgs(context, composeArgs(project, "service", "rm")...)
+			steps = append(steps, &step{action: actionDelete, resource: "service " + serviceName, args: args, service: serviceName})
+		}
+	}
+	return steps, nil
+}
+
+// serviceChanges returns how the service of the update step differs from what 'compose service up'
+// would deploy for its project: its task definition, desired count and load balancers, and the log
+// groups of its containers. The flags correcting the desired count and the log groups are added to
+// the step. The load balancers of a service are only set when it is created, so their differences
+// are reported without updating the service.
+func serviceChanges(s *step, clients *planClients, commandConfig *config.CommandConfig) ([]string, error) {
+	var changes []string
+	// the images built with --build are pushed again on every deploy, even if the project is unchanged
+	if s.project.ServiceUp[flags.BuildFlag] == true {
+		changes = append(changes, "images are built with --"+flags.BuildFlag)
+	}
+
+	declared, err := clients.loadService(s.args)
+	if err != nil {
+		return nil, err
+	}
+	currentTaskDefinition, err := clients.ecsClient.DescribeTaskDefinition(aws.StringValue(s.current.TaskDefinition))
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range service.TaskDefinitionChanges(currentTaskDefinition, declared.taskDefinition) {
+		changes = append(changes, "task definition "+change+" differs")
+	}
+
+	count := declaredCount(s.project, declared.ecsParams)
+	current := aws.Int64Value(s.current.DesiredCount)
+	if count != nil && *count != current && aws.StringValue(s.current.SchedulingStrategy) != ecs.SchedulingStrategyDaemon {
+		changes = append(changes, fmt.Sprintf("desired count is %d, declared %d", current, *count))
+		// a count given with --scale is always applied by 'compose service up'
+		if _, ok := s.project.ServiceUp[flags.ScaleFlag]; !ok {
+			s.args = append(s.args, "--"+flags.ScaleFlag, strconv.FormatInt(*count, 10))
+		}
+	}
+
+	missing, err := missingLogGroups(currentTaskDefinition, clients.logClientFactory, commandConfig)
+	if err != nil {
+		return nil, err
+	}
+	for _, logGroup := range missing {
+		changes = append(changes, fmt.Sprintf("log group %s does not exist", logGroup))
+	}
+	if len(missing) > 0 && s.project.ServiceUp[flags.CreateLogsFlag] != true {
+		s.args = append(s.args, "--"+flags.CreateLogsFlag)
+	}
+
+	if loadBalancerKeys(s.current.LoadBalancers) != loadBalancerKeys(declared.loadBalancers) {
+		logrus.Warnf("The load balancers of service '%s' differ from the manifest, and can only be set when the service is created. To change them, delete the service with 'ecs-cli destroy --%s %s/%s' and apply the manifest again", s.service, flags.TargetFlag, targetService, s.project.Name)
+	}
+	return changes, nil
+}
+
+// loadBalancerKeys returns the load balancers as a sorted list of their target groups or names,
+// containers and ports, to compare them
+func loadBalancerKeys(loadBalancers []*ecs.LoadBalancer) string {
+	var keys []string
+	for _, loadBalancer := range loadBalancers {
+		keys = append(keys, fmt.Sprintf("%s%s/%s:%d", aws.StringValue(loadBalancer.TargetGroupArn), aws.StringValue(loadBalancer.LoadBalancerName),
+			aws.StringValue(loadBalancer.ContainerName), aws.Int64Value(loadBalancer.ContainerPort)))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// planCluster returns the step creating or scaling the cluster, or nil if it matches the manifest.
+// Only the size of an existing cluster is compared, the other flags of up are used to create it.
+func planCluster(declared *clusterManifest, exists bool, cfnClient cfnclient.CloudformationClient, commandConfig *config.CommandConfig) (*step, error) {
+	resource := "cluster " + commandConfig.Cluster
+	if !exists {
+		if declared.Up == nil {
+			return nil, fmt.Errorf("Cluster '%s' does not exist. Declare the flags to create it with in the up section of the cluster of the manifest", commandConfig.Cluster)
+		}
+		upArgs, err := declared.Up.args()
+		if err != nil {
+			return nil, err
+		}
+		args := append([]string{"up"}, upArgs...)
+		if declared.Size != nil {
+			args = append(args, "--"+flags.AsgMaxSizeFlag, strconv.Itoa(*declared.Size))
+		}
+		return &step{action: actionCreate, resource: resource, args: args}, nil
+	}
+	if declared.Size == nil {
+		return nil, nil
+	}
+
+	parameters, err := cfnClient.GetStackParameters(commandConfig.CFNStackName)
+	if err != nil {
+		return nil, fmt.Errorf("CloudFormation stack not found for cluster '%s', so it can not be scaled", commandConfig.Cluster)
+	}
+	size := defaultAsgMaxSize
+	for _, parameter := range parameters {
+		if aws.StringValue(parameter.ParameterKey) == cluster.ParameterKeyAsgMaxSize {
+			if size, err = strconv.Atoi(aws.StringValue(parameter.ParameterValue)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if size == *declared.Size {
+		return nil, nil
+	}
+	// the IAM resources of the stack were acknowledged when the cluster was created
+	args := []string{"scale", "--" + flags.CapabilityIAMFlag, "--" + flags.AsgMaxSizeFlag, strconv.Itoa(*declared.Size)}
+	return &step{action: actionScale, resource: fmt.Sprintf("%s (%d -> %d instances)", resource, size, *declared.Size), args: args}, nil
+}
+
+// commandArgs returns the arguments running the ECS CLI command, with the global flags of apply
+// before it and its forwarded flags after it
+func commandArgs(context *cli.Context, command ...string) []string {
+	var args []string
+	if endpoint := context.GlobalString(flags.EndpointFlag); endpoint != "" {
+		args = append(args, "--"+flags.EndpointFlag, endpoint)
+	}
+	return append(append(args, command...), forwardedArgs(context)...)
+}
+
+// composeArgs returns the arguments running the compose subcommand on the project
+func composeArgs(project *projectManifest, subcommand ...string) []string {
+	args := []string{"compose", "--" + flags.ProjectNameFlag, project.Name}
+	for _, file := range project.Files {
+		args = append(args, "--"+flags.ComposeFileNameFlag, file)
+	}
+	if project.ECSParams != "" {
+		args = append(args, "--"+flags.ECSParamsFileNameFlag, project.ECSParams)
+	}
+	return append(args, subcommand...)
+}
+
+// forwardedArgs returns the flags of the cluster configuration, the cluster, the region and the
+// profiles used by apply, passed to every command it runs
+func forwardedArgs(context *cli.Context) []string {
+	var args []string
+	for _, name := range []string{flags.ClusterConfigFlag, flags.ClusterFlag, flags.RegionFlag, flags.ECSProfileFlag, flags.AWSProfileFlag} {
+		if value := context.String(name); value != "" {
+			args = append(args, "--"+name, value)
+		}
+	}
+	return args
+}
+
+// describeActiveService returns the service, or nil if it does not exist or is not active
+func describeActiveService(ecsClient ecsclient.ECSClient, serviceName string) (*ecs.Service, error) {
+	output, err := ecsClient.DescribeService(serviceName)
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Services) == 0 || aws.StringValue(output.Services[0].Status) != "ACTIVE" {
+		return nil, nil
+	}
+	return output.Services[0], nil
+}
+
+// undeclaredServices returns the names of the services of the cluster created by the compose
+// commands, i.e. with the service name prefix, which are not declared in the manifest
+func undeclaredServices(ecsClient ecsclient.ECSClient, declared map[string]bool, prefix string) ([]string, error) {
+	serviceArns, err := ecsClient.ListServices()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, serviceArn := range serviceArns {
+		name := aws.StringValue(serviceArn)
+		if parsed, err := arn.Parse(name); err == nil {
+			name = parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:]
+		}
+		if strings.HasPrefix(name, prefix) && !declared[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func printPlan(out io.Writer, steps []*step) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "ACTION\tRESOURCE\tCOMMAND")
+	for _, s := range steps {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", s.action, s.resource, s.command())
+	}
+	w.Flush()
+}
@@ -0,0 +1,348 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package apply
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+const (
+	clusterName   = "prod"
+	stackName     = "amazon-ecs-cli-setup-prod"
+	webService    = "ecscompose-service-web"
+	workerService = "ecscompose-service-worker"
+)
+
+func newContext(dryRun, prune bool) *cli.Context {
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalSet.String(flags.EndpointFlag, "", "")
+	globalContext := cli.NewContext(nil, globalSet, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-apply", 0)
+	flagSet.Bool(flags.DryRunFlag, dryRun, "")
+	flagSet.Bool(flags.PruneFlag, prune, "")
+	flagSet.Bool(flags.ForceFlag, false, "")
+	for _, name := range []string{flags.ClusterConfigFlag, flags.ClusterFlag, flags.RegionFlag, flags.ECSProfileFlag, flags.AWSProfileFlag} {
+		flagSet.String(name, "", "")
+	}
+	return cli.NewContext(nil, flagSet, globalContext)
+}
+
+func testCommandConfig() *config.CommandConfig {
+	return &config.CommandConfig{
+		Cluster:                  clusterName,
+		CFNStackName:             stackName,
+		ComposeServiceNamePrefix: "ecscompose-service-",
+	}
+}
+
+func testManifest() *manifest {
+	size := 3
+	return &manifest{
+		Version: manifestVersion,
+		Cluster: &clusterManifest{
+			Name: clusterName,
+			Size: &size,
+			Up:   flagValues{"capability-iam": true, "instance-type": "t3.medium"},
+		},
+		Projects: []projectManifest{
+			{Name: "web", Files: []string{"/srv/web/docker-compose.yml"}, ECSParams: "/srv/web/ecs-params.yml", ServiceUp: flagValues{"create-log-groups": true}},
+			{Name: "worker", Files: []string{"/srv/worker/docker-compose.yml"}},
+			{Name: "legacy", Absent: true},
+		},
+	}
+}
+
+func activeService(name string) *ecs.DescribeServicesOutput {
+	return &ecs.DescribeServicesOutput{Services: []*ecs.Service{{ServiceName: aws.String(name), Status: aws.String("ACTIVE")}}}
+}
+
+// testClients returns the clients of the plan with the mocks, loading every project as the task
+// definition of the image
+func testClients(ecsClient *mock_ecs.MockECSClient, cfnClient *mock_cloudformation.MockCloudformationClient, image string) *planClients {
+	return &planClients{
+		ecsClient: ecsClient,
+		cfnClient: cfnClient,
+		loadService: func([]string) (*declaredService, error) {
+			return &declaredService{taskDefinition: imageTaskDefinition(image)}, nil
+		},
+	}
+}
+
+func imageTaskDefinition(image string) *ecs.TaskDefinition {
+	return &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{{Name: aws.String("web"), Image: aws.String(image)}},
+	}
+}
+
+// deployedService returns the active service, running the task definition of the image
+func deployedService(mockECS *mock_ecs.MockECSClient, name, image string) *ecs.DescribeServicesOutput {
+	output := activeService(name)
+	output.Services[0].TaskDefinition = aws.String(name + ":1")
+	mockECS.EXPECT().DescribeTaskDefinition(name+":1").Return(imageTaskDefinition(image), nil)
+	return output
+}
+
+func stackParameters(size string) []*cloudformation.Parameter {
+	return []*cloudformation.Parameter{{ParameterKey: aws.String(cluster.ParameterKeyAsgMaxSize), ParameterValue: aws.String(size)}}
+}
+
+func TestApplyCreatesCluster(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	context := newContext(false, false)
+	require.NoError(t, setClusterConfig(context, testManifest().Cluster))
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(false, nil)
+
+	var commands [][]string
+	run := func(args []string) error {
+		commands = append(commands, args)
+		return nil
+	}
+	out := &bytes.Buffer{}
+	err := apply(context, testManifest(), testClients(mockECS, mockCFN, "nginx:1.25"), testCommandConfig(), run, nil, out)
+	require.NoError(t, err, "Unexpected error applying manifest")
+
+	require.Len(t, commands, 3)
+	assert.Equal(t, []string{"up", "--capability-iam", "--instance-type", "t3.medium", "--size", "3", "--cluster", clusterName}, commands[0])
+	assert.Equal(t, []string{"compose", "--project-name", "web", "--file", "/srv/web/docker-compose.yml", "--ecs-params", "/srv/web/ecs-params.yml",
+		"service", "up", "--create-log-groups", "--cluster", clusterName}, commands[1])
+	assert.Equal(t, []string{"compose", "--project-name", "worker", "--file", "/srv/worker/docker-compose.yml", "service", "up", "--cluster", clusterName}, commands[2])
+	assert.Regexp(t, `create\s+cluster prod`, out.String())
+	assert.Regexp(t, `create\s+service `+webService, out.String())
+}
+
+func TestApplyUpdatesExistingCluster(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockCFN.EXPECT().GetStackParameters(stackName).Return(stackParameters("2"), nil)
+	mockECS.EXPECT().DescribeService(webService).Return(deployedService(mockECS, webService, "nginx:1.24"), nil)
+	mockECS.EXPECT().DescribeService(workerService).Return(&ecs.DescribeServicesOutput{}, nil)
+	mockECS.EXPECT().DescribeService("ecscompose-service-legacy").Return(activeService("ecscompose-service-legacy"), nil)
+	mockECS.EXPECT().ListServices().Return(aws.StringSlice([]string{
+		"arn:aws:ecs:us-west-2:123456789012:service/prod/" + webService,
+		"arn:aws:ecs:us-west-2:123456789012:service/prod/ecscompose-service-legacy",
+		"arn:aws:ecs:us-west-2:123456789012:service/prod/ecscompose-service-old",
+		"arn:aws:ecs:us-west-2:123456789012:service/prod/console-created",
+	}), nil)
+
+	var commands [][]string
+	run := func(args []string) error {
+		commands = append(commands, args)
+		return nil
+	}
+	out := &bytes.Buffer{}
+	reader := bufio.NewReader(strings.NewReader("y\n"))
+	err := apply(newContext(false, true), testManifest(), testClients(mockECS, mockCFN, "nginx:1.25"), testCommandConfig(), run, reader, out)
+	require.NoError(t, err, "Unexpected error applying manifest")
+
+	require.Len(t, commands, 5)
+	assert.Equal(t, []string{"scale", "--capability-iam", "--size", "3"}, commands[0])
+	assert.Equal(t, []string{"compose", "--project-name", "web"}, commands[1][:3])
+	assert.Equal(t, []string{"compose", "--project-name", "legacy", "service", "rm"}, commands[3])
+	assert.Equal(t, []string{"compose", "--project-name", "old", "service", "rm"}, commands[4])
+	assert.Contains(t, out.String(), "Are you sure you want to delete the resources listed above?")
+	assert.Regexp(t, `scale\s+cluster prod \(2 -> 3 instances\)`, out.String())
+	assert.Regexp(t, `update\s+service `+webService, out.String())
+	assert.Regexp(t, `create\s+service `+workerService, out.String())
+	assert.Regexp(t, `delete\s+service ecscompose-service-old`, out.String())
+	assert.NotContains(t, out.String(), "console-created")
+}
+
+func TestApplyPruneAborted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	m := testManifest()
+	m.Cluster.Size = nil
+	m.Projects = m.Projects[:1]
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockECS.EXPECT().DescribeService(webService).Return(deployedService(mockECS, webService, "nginx:1.25"), nil)
+	mockECS.EXPECT().ListServices().Return(aws.StringSlice([]string{
+		"arn:aws:ecs:us-west-2:123456789012:service/prod/" + webService,
+		"arn:aws:ecs:us-west-2:123456789012:service/prod/ecscompose-service-old",
+	}), nil)
+
+	run := func(args []string) error {
+		t.Fatalf("Unexpected command after the prompt was refused: %v", args)
+		return nil
+	}
+	reader := bufio.NewReader(strings.NewReader("n\n"))
+	err := apply(newContext(false, true), m, testClients(mockECS, mockCFN, "nginx:1.25"), testCommandConfig(), run, reader, &bytes.Buffer{})
+	require.Error(t, err, "Expected an error when the deletions are not confirmed")
+	assert.Contains(t, err.Error(), "Aborted the deletion")
+}
+
+func TestApplyPruneWithForce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	m := testManifest()
+	m.Cluster.Size = nil
+	m.Projects = m.Projects[:1]
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockECS.EXPECT().DescribeService(webService).Return(deployedService(mockECS, webService, "nginx:1.25"), nil)
+	mockECS.EXPECT().ListServices().Return(aws.StringSlice([]string{
+		"arn:aws:ecs:us-west-2:123456789012:service/prod/ecscompose-service-old",
+	}), nil)
+
+	var commands [][]string
+	run := func(args []string) error {
+		commands = append(commands, args)
+		return nil
+	}
+	context := newContext(false, true)
+	require.NoError(t, context.Set(flags.ForceFlag, "true"))
+	err := apply(context, m, testClients(mockECS, mockCFN, "nginx:1.25"), testCommandConfig(), run, nil, &bytes.Buffer{})
+	require.NoError(t, err, "Unexpected error applying manifest")
+	assert.Equal(t, [][]string{{"compose", "--project-name", "old", "service", "rm"}}, commands)
+}
+
+func TestApplyDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	m := testManifest()
+	m.Projects = m.Projects[:1]
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockCFN.EXPECT().GetStackParameters(stackName).Return(stackParameters("3"), nil)
+	mockECS.EXPECT().DescribeService(webService).Return(deployedService(mockECS, webService, "nginx:1.24"), nil)
+
+	run := func(args []string) error {
+		t.Fatalf("Unexpected command in a dry run: %v", args)
+		return nil
+	}
+	out := &bytes.Buffer{}
+	err := apply(newContext(true, false), m, testClients(mockECS, mockCFN, "nginx:1.25"), testCommandConfig(), run, nil, out)
+	require.NoError(t, err, "Unexpected error applying manifest")
+	assert.NotContains(t, out.String(), "cluster prod")
+	assert.Contains(t, out.String(), "ecs-cli compose --project-name web --file /srv/web/docker-compose.yml --ecs-params /srv/web/ecs-params.yml service up --create-log-groups")
+}
+
+func TestApplyUnchangedManifest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockCFN.EXPECT().GetStackParameters(stackName).Return(stackParameters("3"), nil)
+	mockECS.EXPECT().DescribeService(webService).Return(deployedService(mockECS, webService, "nginx:1.25"), nil)
+	mockECS.EXPECT().DescribeService(workerService).Return(deployedService(mockECS, workerService, "nginx:1.25"), nil)
+	mockECS.EXPECT().DescribeService("ecscompose-service-legacy").Return(&ecs.DescribeServicesOutput{}, nil)
+
+	context := newContext(false, false)
+	clients := testClients(mockECS, mockCFN, "nginx:1.25")
+	steps, err := plan(context, testManifest(), clients, testCommandConfig())
+	require.NoError(t, err, "Unexpected error planning manifest")
+	assert.Empty(t, steps)
+}
+
+func TestApplyUpdatesChangedTaskDefinition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	m := testManifest()
+	m.Cluster.Size = nil
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockECS.EXPECT().DescribeService(webService).Return(deployedService(mockECS, webService, "nginx:1.24"), nil)
+	mockECS.EXPECT().DescribeService(workerService).Return(deployedService(mockECS, workerService, "nginx:1.25"), nil)
+	mockECS.EXPECT().DescribeService("ecscompose-service-legacy").Return(&ecs.DescribeServicesOutput{}, nil)
+
+	var commands [][]string
+	run := func(args []string) error {
+		commands = append(commands, args)
+		return nil
+	}
+	out := &bytes.Buffer{}
+	clients := testClients(mockECS, mockCFN, "nginx:1.25")
+	err := apply(newContext(false, false), m, clients, testCommandConfig(), run, nil, out)
+	require.NoError(t, err, "Unexpected error applying manifest")
+
+	require.Len(t, commands, 1)
+	assert.Equal(t, []string{"compose", "--project-name", "web"}, commands[0][:3])
+	assert.NotContains(t, out.String(), workerService)
+}
+
+func TestApplyStopsAtFailedStep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	m := testManifest()
+	m.Cluster.Size = nil
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockECS.EXPECT().DescribeService(webService).Return(deployedService(mockECS, webService, "nginx:1.24"), nil)
+	mockECS.EXPECT().DescribeService(workerService).Return(deployedService(mockECS, workerService, "nginx:1.24"), nil)
+	mockECS.EXPECT().DescribeService("ecscompose-service-legacy").Return(&ecs.DescribeServicesOutput{}, nil)
+
+	runs := 0
+	run := func(args []string) error {
+		runs++
+		return errors.New("Incorrect Usage")
+	}
+	err := apply(newContext(false, false), m, testClients(mockECS, mockCFN, "nginx:1.25"), testCommandConfig(), run, nil, &bytes.Buffer{})
+	require.Error(t, err, "Expected the failed step to stop the apply")
+	assert.Equal(t, 1, runs)
+	assert.Contains(t, err.Error(), "Error running 'ecs-cli compose --project-name web")
+}
+
+func TestApplyWithoutUpFlags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	m := testManifest()
+	m.Cluster.Up = nil
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(false, nil)
+
+	err := apply(newContext(false, false), m, testClients(mockECS, mockCFN, "nginx:1.25"), testCommandConfig(), nil, nil, &bytes.Buffer{})
+	require.Error(t, err, "Expected an error for a missing cluster without up flags")
+	assert.Contains(t, err.Error(), "Cluster 'prod' does not exist")
+}
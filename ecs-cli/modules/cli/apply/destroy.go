@@ -0,0 +1,200 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package apply
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/version"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// Kinds of the resources targeted by destroy, e.g. service/web or cluster
+const (
+	targetCluster = "cluster"
+	targetService = "service"
+)
+
+// Destroy returns the action of the destroy command, which runs the ECS CLI commands deleting the
+// targeted resources with the application returned by newApp.
+func Destroy(newApp func() *cli.App) func(*cli.Context) {
+	return func(c *cli.Context) {
+		m, err := readManifest(c.String(flags.ManifestFileFlag))
+		if err != nil {
+			logrus.Fatal("Error executing 'destroy': ", err)
+		}
+		if err := setClusterConfig(c, m.Cluster); err != nil {
+			logrus.Fatal("Error executing 'destroy': ", err)
+		}
+		rdwr, err := config.NewReadWriter()
+		if err != nil {
+			logrus.Fatal("Error executing 'destroy': ", err)
+		}
+		commandConfig, err := config.NewCommandConfig(c, rdwr)
+		if err != nil {
+			logrus.Fatal("Error executing 'destroy': ", err)
+		}
+		run := func(args []string) error {
+			return newApp().Run(append([]string{version.AppName}, args...))
+		}
+		if err := destroy(c, m, ecsclient.NewECSClient(commandConfig), commandConfig, run, bufio.NewReader(os.Stdin), progress.Output()); err != nil {
+			logrus.Fatal("Error executing 'destroy': ", err)
+		}
+	}
+}
+
+func destroy(context *cli.Context, m *manifest, ecsClient ecsclient.ECSClient, commandConfig *config.CommandConfig, run func([]string) error, reader *bufio.Reader, out io.Writer) error {
+	steps, err := planDestroy(context, m, ecsClient, commandConfig)
+	if err != nil {
+		return err
+	}
+	if len(steps) == 0 {
+		logrus.Info("The targeted resources do not exist, nothing to delete")
+		return nil
+	}
+	printPlan(out, steps)
+	if context.Bool(flags.DryRunFlag) {
+		return nil
+	}
+	if !context.Bool(flags.ForceFlag) {
+		if err := destroyPrompt(reader, out); err != nil {
+			return err
+		}
+	}
+	return execute(steps, run, commandConfig)
+}
+
+// planDestroy returns the steps deleting the targeted resources of the manifest, or all of them
+// without targets. The services are deleted with 'compose service rm' and the cluster with 'down',
+// after all the services declared on it, since a cluster running services can not be deleted.
+func planDestroy(context *cli.Context, m *manifest, ecsClient ecsclient.ECSClient, commandConfig *config.CommandConfig) ([]*step, error) {
+	if commandConfig.Cluster == "" {
+		return nil, fmt.Errorf("A cluster must be set in the manifest, with --%s or in the cluster configuration", flags.ClusterFlag)
+	}
+	destroyCluster, projects, err := parseTargets(context.StringSlice(flags.TargetFlag), m, commandConfig.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	clusterExists, err := ecsClient.IsActiveCluster(commandConfig.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if !clusterExists {
+		return nil, nil
+	}
+
+	var steps []*step
+	declared := make(map[string]bool)
+	for i := range m.Projects {
+		project := &m.Projects[i]
+		serviceName := composeutils.GetServiceName(commandConfig.ComposeServiceNamePrefix, project.Name)
+		declared[serviceName] = true
+		if project.Absent || !(destroyCluster || projects[project.Name]) {
+			continue
+		}
+		current, err := describeActiveService(ecsClient, serviceName)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			continue
+		}
+		args := commandArgs(context, composeArgs(project, "service", "rm")...)
+		steps = append(steps, &step{action: actionDelete, resource: "service " + serviceName, args: args, service: serviceName, project: project, current: current})
+	}
+	if !destroyCluster {
+		return steps, nil
+	}
+
+	serviceArns, err := ecsClient.ListServices()
+	if err != nil {
+		return nil, err
+	}
+	var undeclared []string
+	for _, serviceArn := range serviceArns {
+		name := aws.StringValue(serviceArn)
+		name = name[strings.LastIndex(name, "/")+1:]
+		if !declared[name] {
+			undeclared = append(undeclared, name)
+		}
+	}
+	if len(undeclared) > 0 {
+		return nil, fmt.Errorf("Cluster '%s' runs services which are not declared in the manifest: %s. Delete them before the cluster", commandConfig.Cluster, strings.Join(undeclared, ", "))
+	}
+	args := commandArgs(context, "down", "--"+flags.ForceFlag)
+	return append(steps, &step{action: actionDelete, resource: "cluster " + commandConfig.Cluster, args: args}), nil
+}
+
+// parseTargets returns whether the cluster is targeted and the names of the targeted projects.
+// Without targets, the cluster and all the projects are.
+func parseTargets(targets []string, m *manifest, clusterName string) (bool, map[string]bool, error) {
+	projects := make(map[string]bool)
+	if len(targets) == 0 {
+		if m.Cluster == nil {
+			for _, project := range m.Projects {
+				projects[project.Name] = true
+			}
+			return false, projects, nil
+		}
+		return true, projects, nil
+	}
+
+	declared := make(map[string]bool)
+	for _, project := range m.Projects {
+		declared[project.Name] = true
+	}
+	destroyCluster := false
+	for _, target := range targets {
+		parts := strings.SplitN(target, "/", 2)
+		switch {
+		case parts[0] == targetCluster && (len(parts) == 1 || parts[1] == clusterName):
+			if m.Cluster == nil {
+				return false, nil, fmt.Errorf("Cluster '%s' is not declared in the manifest", clusterName)
+			}
+			destroyCluster = true
+		case parts[0] == targetService && len(parts) == 2 && declared[parts[1]]:
+			projects[parts[1]] = true
+		case parts[0] == targetService && len(parts) == 2:
+			return false, nil, fmt.Errorf("Project '%s' is not declared in the manifest", parts[1])
+		default:
+			return false, nil, fmt.Errorf("Invalid target '%s'. Targets are %s, %s/%s or %s/<project>", target, targetCluster, targetCluster, clusterName, targetService)
+		}
+	}
+	return destroyCluster, projects, nil
+}
+
+// destroyPrompt prompts on out and checks for confirmation to delete the resources
+func destroyPrompt(reader *bufio.Reader, out io.Writer) error {
+	fmt.Fprintln(out, "Are you sure you want to delete the resources listed above? [y/N]")
+	input, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("Error reading input: %s", err.Error())
+	}
+	formattedInput := strings.ToLower(strings.TrimSpace(input))
+	if formattedInput != "yes" && formattedInput != "y" {
+		return fmt.Errorf("Aborted the deletion. To delete the resources, re-run this command and specify the '--%s' flag or confirm at the prompt.", flags.ForceFlag)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package apply
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func newDestroyContext(force bool, targets ...string) *cli.Context {
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalSet.String(flags.EndpointFlag, "", "")
+	globalContext := cli.NewContext(nil, globalSet, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-destroy", 0)
+	targetValues := &cli.StringSlice{}
+	for _, target := range targets {
+		targetValues.Set(target)
+	}
+	flagSet.Var(targetValues, flags.TargetFlag, "")
+	flagSet.Bool(flags.ForceFlag, force, "")
+	flagSet.Bool(flags.DryRunFlag, false, "")
+	for _, name := range []string{flags.ClusterConfigFlag, flags.ClusterFlag, flags.RegionFlag, flags.ECSProfileFlag, flags.AWSProfileFlag} {
+		flagSet.String(name, "", "")
+	}
+	return cli.NewContext(nil, flagSet, globalContext)
+}
+
+func TestDestroyTargetedService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockECS.EXPECT().DescribeService(webService).Return(activeService(webService), nil)
+
+	var commands [][]string
+	run := func(args []string) error {
+		commands = append(commands, args)
+		return nil
+	}
+	err := destroy(newDestroyContext(true, "service/web"), testManifest(), mockECS, testCommandConfig(), run, nil, &bytes.Buffer{})
+	require.NoError(t, err, "Unexpected error destroying service")
+
+	assert.Equal(t, [][]string{
+		{"compose", "--project-name", "web", "--file", "/srv/web/docker-compose.yml", "--ecs-params", "/srv/web/ecs-params.yml", "service", "rm"},
+	}, commands)
+}
+
+func TestDestroyClusterDeletesServicesFirst(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockECS.EXPECT().DescribeService(webService).Return(activeService(webService), nil)
+	mockECS.EXPECT().DescribeService(workerService).Return(activeService(workerService), nil)
+	mockECS.EXPECT().ListServices().Return(aws.StringSlice([]string{
+		"arn:aws:ecs:us-west-2:123456789012:service/prod/" + webService,
+		"arn:aws:ecs:us-west-2:123456789012:service/prod/" + workerService,
+	}), nil)
+
+	var commands [][]string
+	run := func(args []string) error {
+		commands = append(commands, args)
+		return nil
+	}
+	out := &bytes.Buffer{}
+	reader := bufio.NewReader(strings.NewReader("y\n"))
+	err := destroy(newDestroyContext(false), testManifest(), mockECS, testCommandConfig(), run, reader, out)
+	require.NoError(t, err, "Unexpected error destroying cluster")
+
+	require.Len(t, commands, 3)
+	assert.Equal(t, []string{"service", "rm"}, commands[0][len(commands[0])-2:])
+	assert.Equal(t, []string{"compose", "--project-name", "worker"}, commands[1][:3])
+	assert.Equal(t, []string{"down", "--force"}, commands[2])
+	assert.Regexp(t, `delete\s+cluster prod\s+ecs-cli down --force`, out.String())
+}
+
+func TestDestroyClusterWithUndeclaredServices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	m := testManifest()
+	m.Projects = m.Projects[:1]
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockECS.EXPECT().DescribeService(webService).Return(activeService(webService), nil)
+	mockECS.EXPECT().ListServices().Return(aws.StringSlice([]string{
+		"arn:aws:ecs:us-west-2:123456789012:service/prod/" + webService,
+		"arn:aws:ecs:us-west-2:123456789012:service/prod/console-created",
+	}), nil)
+
+	err := destroy(newDestroyContext(true, "cluster"), m, mockECS, testCommandConfig(), nil, nil, &bytes.Buffer{})
+	require.Error(t, err, "Expected an error for a cluster running undeclared services")
+	assert.Contains(t, err.Error(), "console-created")
+}
+
+func TestDestroyAborted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockECS.EXPECT().DescribeService(workerService).Return(activeService(workerService), nil)
+
+	run := func(args []string) error {
+		t.Fatalf("Unexpected command after the deletion was aborted: %v", args)
+		return nil
+	}
+	reader := bufio.NewReader(strings.NewReader("n\n"))
+	out := &bytes.Buffer{}
+	err := destroy(newDestroyContext(false, "service/worker"), testManifest(), mockECS, testCommandConfig(), run, reader, out)
+	require.Error(t, err, "Expected the deletion to be aborted")
+	assert.Contains(t, err.Error(), "Aborted the deletion")
+	assert.Contains(t, out.String(), "Are you sure you want to delete the resources listed above? [y/N]", "Expected the prompt to be written to the output")
+}
+
+func TestParseTargetsErrors(t *testing.T) {
+	testCases := map[string]string{
+		"service/api":  "Project 'api' is not declared in the manifest",
+		"cluster/test": "Invalid target 'cluster/test'",
+		"web":          "Invalid target 'web'",
+	}
+	for target, expected := range testCases {
+		t.Run(target, func(t *testing.T) {
+			_, _, err := parseTargets([]string{target}, testManifest(), clusterName)
+			require.Error(t, err, "Expected an invalid target")
+			assert.Contains(t, err.Error(), expected)
+		})
+	}
+}
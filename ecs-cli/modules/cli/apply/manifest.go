@@ -0,0 +1,185 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package apply
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"gopkg.in/yaml.v2"
+)
+
+const manifestVersion = "1"
+
+// manifest declares the cluster and the compose projects deployed on it
+type manifest struct {
+	Version  string            `yaml:"version"`
+	Cluster  *clusterManifest  `yaml:"cluster"`
+	Projects []projectManifest `yaml:"projects"`
+}
+
+// clusterManifest declares the cluster the projects are deployed on. The cluster is created with
+// the flags of 'ecs-cli up' if it does not exist, and scaled to its size if it does.
+type clusterManifest struct {
+	Name   string     `yaml:"name"`
+	Config string     `yaml:"config"`
+	Region string     `yaml:"region"`
+	Size   *int       `yaml:"size"`
+	Up     flagValues `yaml:"up"`
+}
+
+// projectManifest declares a compose project, deployed as an ECS service with the flags of
+// 'ecs-cli compose service up', or deleted if it is absent
+type projectManifest struct {
+	Name      string     `yaml:"name"`
+	Files     []string   `yaml:"files"`
+	ECSParams string     `yaml:"ecs_params"`
+	ServiceUp flagValues `yaml:"service_up"`
+	Absent    bool       `yaml:"absent"`
+}
+
+// flagValues maps the names of the flags of an ECS CLI command to their values
+type flagValues map[string]interface{}
+
+// forwardedFlags are set by apply for every command it runs, so they can not be set in the manifest
+var forwardedFlags = []string{flags.ClusterConfigFlag, flags.ClusterFlag, "c", flags.RegionFlag, "r", flags.ECSProfileFlag, flags.AWSProfileFlag}
+
+// readManifest reads and validates the manifest, resolving the paths of the files of the projects
+// relative to its directory
+func readManifest(fileName string) (*manifest, error) {
+	if fileName == "" {
+		return nil, fmt.Errorf("A manifest must be specified with the --%s flag", flags.ManifestFileFlag)
+	}
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	m := &manifest{}
+	if err := yaml.UnmarshalStrict(data, m); err != nil {
+		return nil, fmt.Errorf("Error parsing manifest %s: %v", fileName, err)
+	}
+	if err := m.validate(); err != nil {
+		return nil, fmt.Errorf("Invalid manifest %s: %v", fileName, err)
+	}
+
+	dir := filepath.Dir(fileName)
+	for i := range m.Projects {
+		project := &m.Projects[i]
+		for j, file := range project.Files {
+			project.Files[j] = resolvePath(dir, file)
+		}
+		if project.ECSParams != "" {
+			project.ECSParams = resolvePath(dir, project.ECSParams)
+		}
+	}
+	return m, nil
+}
+
+func resolvePath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+func (m *manifest) validate() error {
+	if m.Version != manifestVersion {
+		return fmt.Errorf("Unsupported version '%s', expected '%s'", m.Version, manifestVersion)
+	}
+	if m.Cluster != nil {
+		if m.Cluster.Size != nil && *m.Cluster.Size < 0 {
+			return fmt.Errorf("The size of the cluster must not be negative")
+		}
+		if _, ok := m.Cluster.Up[flags.AsgMaxSizeFlag]; ok {
+			return fmt.Errorf("Set the size of the cluster with the size field of the cluster, instead of the --%s flag of up", flags.AsgMaxSizeFlag)
+		}
+		if err := m.Cluster.Up.validate("up"); err != nil {
+			return err
+		}
+	}
+
+	names := make(map[string]bool)
+	for _, project := range m.Projects {
+		if project.Name == "" {
+			return fmt.Errorf("Every project must have a name")
+		}
+		if names[project.Name] {
+			return fmt.Errorf("Project '%s' is declared more than once", project.Name)
+		}
+		names[project.Name] = true
+		if project.Absent {
+			continue
+		}
+		if len(project.Files) == 0 {
+			return fmt.Errorf("Project '%s' must list its compose files", project.Name)
+		}
+		if err := project.ServiceUp.validate("service_up of project " + project.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (values flagValues) validate(section string) error {
+	for _, name := range forwardedFlags {
+		if _, ok := values[name]; ok {
+			return fmt.Errorf("The --%s flag can not be set in %s, it is set by apply for every command it runs", name, section)
+		}
+	}
+	if _, err := values.args(); err != nil {
+		return fmt.Errorf("%v, in %s", err, section)
+	}
+	return nil
+}
+
+// args returns the command line arguments setting the flags, sorted by name. A true boolean sets
+// a flag without a value, and a list repeats the flag for each of its values.
+func (values flagValues) args() ([]string, error) {
+	var names []string
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var args []string
+	for _, name := range names {
+		switch value := values[name].(type) {
+		case bool:
+			if value {
+				args = append(args, "--"+name)
+			} else {
+				args = append(args, "--"+name+"=false")
+			}
+		case string, int, float64:
+			args = append(args, "--"+name, fmt.Sprint(value))
+		case []interface{}:
+			for _, item := range value {
+				switch item.(type) {
+				case string, int, float64, bool:
+					args = append(args, "--"+name, fmt.Sprint(item))
+				default:
+					return nil, fmt.Errorf("The values of flag '%s' must be strings or numbers", name)
+				}
+			}
+		case nil:
+			return nil, fmt.Errorf("Flag '%s' has no value", name)
+		default:
+			return nil, fmt.Errorf("The value of flag '%s' must be a string, a number, a boolean or a list", name)
+		}
+	}
+	return args, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package apply
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeManifest(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "ecs-cli-apply")
+	require.NoError(t, err, "Unexpected error creating temporary directory")
+	fileName := filepath.Join(dir, "stack.yml")
+	require.NoError(t, ioutil.WriteFile(fileName, []byte(content), 0644), "Unexpected error writing manifest")
+	return fileName, func() { os.RemoveAll(dir) }
+}
+
+func TestReadManifest(t *testing.T) {
+	fileName, cleanup := writeManifest(t, `version: 1
+cluster:
+  name: prod
+  config: prod
+  size: 3
+  up:
+    capability-iam: true
+    instance-type: t3.medium
+    keypair: ops
+projects:
+  - name: web
+    files: [docker-compose.yml, /srv/web/docker-compose.prod.yml]
+    ecs_params: ecs-params.yml
+    service_up:
+      create-log-groups: true
+      target-groups:
+        - targetGroupArn=arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/web/0123456789abcdef,containerName=web,containerPort=80
+      deployment-min-healthy-percent: 50
+  - name: legacy
+    absent: true
+`)
+	defer cleanup()
+	dir := filepath.Dir(fileName)
+
+	m, err := readManifest(fileName)
+	require.NoError(t, err, "Unexpected error reading manifest")
+	require.NotNil(t, m.Cluster)
+	assert.Equal(t, "prod", m.Cluster.Name)
+	assert.Equal(t, 3, *m.Cluster.Size)
+	upArgs, err := m.Cluster.Up.args()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--capability-iam", "--instance-type", "t3.medium", "--keypair", "ops"}, upArgs)
+
+	require.Len(t, m.Projects, 2)
+	web := m.Projects[0]
+	assert.Equal(t, []string{filepath.Join(dir, "docker-compose.yml"), "/srv/web/docker-compose.prod.yml"}, web.Files)
+	assert.Equal(t, filepath.Join(dir, "ecs-params.yml"), web.ECSParams)
+	serviceUpArgs, err := web.ServiceUp.args()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"--create-log-groups",
+		"--deployment-min-healthy-percent", "50",
+		"--target-groups", "targetGroupArn=arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/web/0123456789abcdef,containerName=web,containerPort=80",
+	}, serviceUpArgs)
+	assert.True(t, m.Projects[1].Absent)
+}
+
+func TestReadManifestErrors(t *testing.T) {
+	testCases := map[string]struct {
+		content  string
+		expected string
+	}{
+		"unsupported version": {
+			content:  "version: 2\n",
+			expected: "Unsupported version '2'",
+		},
+		"unknown field": {
+			content:  "version: 1\nservices: {}\n",
+			expected: "field services not found",
+		},
+		"size flag of up": {
+			content:  "version: 1\ncluster:\n  up:\n    size: 2\n",
+			expected: "size field of the cluster",
+		},
+		"forwarded flag": {
+			content:  "version: 1\nprojects:\n  - name: web\n    files: [docker-compose.yml]\n    service_up:\n      cluster: prod\n",
+			expected: "The --cluster flag can not be set in service_up of project web",
+		},
+		"nested flag value": {
+			content:  "version: 1\ncluster:\n  up:\n    tags: {team: ops}\n",
+			expected: "The value of flag 'tags' must be a string, a number, a boolean or a list, in up",
+		},
+		"duplicate project": {
+			content:  "version: 1\nprojects:\n  - name: web\n    files: [a.yml]\n  - name: web\n    files: [b.yml]\n",
+			expected: "Project 'web' is declared more than once",
+		},
+		"project without files": {
+			content:  "version: 1\nprojects:\n  - name: web\n",
+			expected: "Project 'web' must list its compose files",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fileName, cleanup := writeManifest(t, testCase.content)
+			defer cleanup()
+
+			_, err := readManifest(fileName)
+			require.Error(t, err, "Expected an invalid manifest")
+			assert.Contains(t, err.Error(), testCase.expected)
+		})
+	}
+}
+
+func TestFlagValuesArgs(t *testing.T) {
+	args, err := flagValues{
+		"force-deployment": false,
+		"scale":            2.5,
+		"tags":             []interface{}{"team=ops", "env=prod"},
+	}.args()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--force-deployment=false", "--scale", "2.5", "--tags", "team=ops", "--tags", "env=prod"}, args)
+}
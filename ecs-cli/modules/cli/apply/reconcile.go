@@ -0,0 +1,243 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package apply
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	cwlogsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// make the pause between reconciliations easily mockable in tests
+var sleep = time.Sleep
+
+// drift is a difference between the cluster and the manifest
+type drift struct {
+	resource string
+	detail   string
+}
+
+// Reconcile returns the action of the reconcile command, which applies the manifest and reports how
+// the cluster drifted from it, once or, with --watch, every --interval seconds until interrupted. The
+// manifest is read again before each pass, so that its changes are applied, or its last valid version
+// if it is no longer valid. The projects are loaded with the application returned by newApp, and the
+// commands of the plan run in child processes, so that a failed command is reported without
+// stopping the loop.
+func Reconcile(newApp func() *cli.App) func(*cli.Context) {
+	return func(c *cli.Context) {
+		fileName := c.String(flags.ManifestFileFlag)
+		m, err := readManifest(fileName)
+		if err != nil {
+			logrus.Fatal("Error executing 'reconcile': ", err)
+		}
+		if err := setClusterConfig(c, m.Cluster); err != nil {
+			logrus.Fatal("Error executing 'reconcile': ", err)
+		}
+		rdwr, err := config.NewReadWriter()
+		if err != nil {
+			logrus.Fatal("Error executing 'reconcile': ", err)
+		}
+		commandConfig, err := config.NewCommandConfig(c, rdwr)
+		if err != nil {
+			logrus.Fatal("Error executing 'reconcile': ", err)
+		}
+		clients := newPlanClients(commandConfig, newApp)
+
+		watch := c.Bool(flags.WatchFlag)
+		interval := c.Int(flags.ReconcileIntervalFlag)
+		if watch && interval <= 0 {
+			logrus.Fatalf("Error executing 'reconcile': --%s must be greater than zero", flags.ReconcileIntervalFlag)
+		}
+		// the deletions of the services which are not declared can not be confirmed in every pass
+		if watch && c.Bool(flags.PruneFlag) && !c.Bool(flags.ForceFlag) && !c.Bool(flags.DryRunFlag) {
+			logrus.Fatalf("Error executing 'reconcile': --%s requires --%s with --%s", flags.PruneFlag, flags.ForceFlag, flags.WatchFlag)
+		}
+		reader := bufio.NewReader(os.Stdin)
+		for {
+			drifts, err := reconcile(c, m, clients, commandConfig, runChildProcess, reader, os.Stdout)
+			if !watch {
+				if err != nil {
+					logrus.Fatal("Error executing 'reconcile': ", err)
+				}
+				// a scheduled job checking for drift fails, so that it is noticed
+				if c.Bool(flags.DryRunFlag) && len(drifts) > 0 {
+					logrus.Fatalf("Cluster '%s' drifted from the manifest in %d ways", commandConfig.Cluster, len(drifts))
+				}
+				return
+			}
+			if err != nil {
+				logrus.Error("Error reconciling the cluster: ", err)
+			}
+			sleep(time.Duration(interval) * time.Second)
+			m = rereadManifest(fileName, m)
+		}
+	}
+}
+
+// rereadManifest reads the manifest again for the next pass of --watch. If it is no longer valid,
+// the error is logged and the last valid manifest is returned, so that the loop keeps running.
+func rereadManifest(fileName string, last *manifest) *manifest {
+	m, err := readManifest(fileName)
+	if err != nil {
+		logrus.Errorf("Error reading the manifest, reconciling with its last valid version: %v", err)
+		return last
+	}
+	return m
+}
+
+// runChildProcess runs an ECS CLI command in a child process of the running executable
+func runChildProcess(args []string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(executable, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// reconcile plans the manifest, reports the drift of the cluster and applies the plan. The steps
+// updating services carry the flags which correct their drift.
+func reconcile(context *cli.Context, m *manifest, clients *planClients, commandConfig *config.CommandConfig, run func([]string) error, reader *bufio.Reader, out io.Writer) ([]drift, error) {
+	steps, err := plan(context, m, clients, commandConfig)
+	if err != nil {
+		return nil, err
+	}
+	var drifts []drift
+	for _, s := range steps {
+		drifts = append(drifts, detectDrift(s)...)
+	}
+
+	if len(drifts) == 0 {
+		logrus.Infof("Cluster '%s' has not drifted from the manifest", commandConfig.Cluster)
+	} else {
+		printDrift(out, drifts)
+		fmt.Fprintln(out)
+	}
+	printPlan(out, steps)
+	if context.Bool(flags.DryRunFlag) {
+		return drifts, nil
+	}
+	if err := confirmPrune(context, steps, reader, out); err != nil {
+		return drifts, err
+	}
+	if err := execute(steps, run, commandConfig); err != nil {
+		return drifts, err
+	}
+	return drifts, nil
+}
+
+// detectDrift returns the drift of the resource of the step
+func detectDrift(s *step) []drift {
+	switch s.action {
+	case actionCreate:
+		return []drift{{resource: s.resource, detail: "does not exist"}}
+	case actionScale:
+		return []drift{{resource: s.resource, detail: "size differs from the manifest"}}
+	case actionDelete:
+		if s.project != nil {
+			return []drift{{resource: s.resource, detail: "is declared absent"}}
+		}
+		return []drift{{resource: s.resource, detail: "is not declared in the manifest"}}
+	}
+
+	var drifts []drift
+	for _, change := range s.changes {
+		drifts = append(drifts, drift{resource: s.resource, detail: change})
+	}
+	return drifts
+}
+
+// declaredCount returns the desired count of the service of the project, set with the scale flag
+// of service_up or with desired_count in its ecs-params file, or nil if it is not declared
+func declaredCount(project *projectManifest, ecsParams *composeutils.ECSParams) *int64 {
+	if scale, ok := project.ServiceUp[flags.ScaleFlag]; ok {
+		// the count of each compose service, e.g. web=2, is not compared
+		if count, err := strconv.ParseInt(fmt.Sprint(scale), 10, 64); err == nil {
+			return &count
+		}
+		return nil
+	}
+
+	if ecsParams == nil {
+		return nil
+	}
+	var count *int64
+	for _, containerDef := range ecsParams.TaskDefinition.ContainerDefinitions {
+		if containerDef.DesiredCount == nil {
+			continue
+		}
+		// 'compose service up' refuses the project if the counts of its compose services differ
+		if count != nil && *count != *containerDef.DesiredCount {
+			return nil
+		}
+		count = containerDef.DesiredCount
+	}
+	return count
+}
+
+// missingLogGroups returns the CloudWatch log groups of the containers of the task definition which
+// do not exist. The log groups created by the awslogs driver are skipped.
+func missingLogGroups(taskDef *ecs.TaskDefinition, logClientFactory cwlogsclient.LogClientFactory, commandConfig *config.CommandConfig) ([]string, error) {
+	var missing []string
+	checked := make(map[string]bool)
+	for _, container := range taskDef.ContainerDefinitions {
+		logConfig := container.LogConfiguration
+		if logConfig == nil || aws.StringValue(logConfig.LogDriver) != "awslogs" || aws.StringValue(logConfig.Options["awslogs-create-group"]) == "true" {
+			continue
+		}
+		logGroup := aws.StringValue(logConfig.Options["awslogs-group"])
+		region := aws.StringValue(logConfig.Options["awslogs-region"])
+		if region == "" {
+			region = commandConfig.Region()
+		}
+		if logGroup == "" || checked[region+"/"+logGroup] {
+			continue
+		}
+		checked[region+"/"+logGroup] = true
+		exists, err := logClientFactory.Get(region).LogGroupExists(aws.String(logGroup))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			missing = append(missing, logGroup)
+		}
+	}
+	return missing, nil
+}
+
+func printDrift(out io.Writer, drifts []drift) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "RESOURCE\tDRIFT")
+	for _, d := range drifts {
+		fmt.Fprintf(w, "%s\t%s\n", d.resource, d.detail)
+	}
+	w.Flush()
+}
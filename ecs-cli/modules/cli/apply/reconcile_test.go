@@ -0,0 +1,179 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package apply
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const webTaskDefArn = "arn:aws:ecs:us-west-2:123456789012:task-definition/web:4"
+
+// reconcileManifest returns a manifest with a single project
+func reconcileManifest() *manifest {
+	return &manifest{
+		Version:  manifestVersion,
+		Projects: []projectManifest{{Name: "web", Files: []string{"/srv/web/docker-compose.yml"}, ECSParams: "/srv/web/ecs-params.yml"}},
+	}
+}
+
+func webServiceWithCount(count int64) *ecs.DescribeServicesOutput {
+	output := activeService(webService)
+	output.Services[0].DesiredCount = aws.Int64(count)
+	output.Services[0].TaskDefinition = aws.String(webTaskDefArn)
+	return output
+}
+
+func webTaskDefinition() *ecs.TaskDefinition {
+	return &ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String(webTaskDefArn),
+		ContainerDefinitions: []*ecs.ContainerDefinition{{
+			Name: aws.String("web"),
+			LogConfiguration: &ecs.LogConfiguration{
+				LogDriver: aws.String("awslogs"),
+				Options:   aws.StringMap(map[string]string{"awslogs-group": "/ecs/web", "awslogs-region": "us-west-2"}),
+			},
+		}},
+	}
+}
+
+// reconcileClients returns the clients of the plan with the mocks, loading the project as the task
+// definition its service runs, with an ecs-params file setting the desired count of the service to 3
+func reconcileClients(mockECS *mock_ecs.MockECSClient, mockCFN *mock_cloudformation.MockCloudformationClient, mockLogFactory *mock_cloudwatchlogs.MockLogClientFactory) *planClients {
+	return &planClients{
+		ecsClient:        mockECS,
+		cfnClient:        mockCFN,
+		logClientFactory: mockLogFactory,
+		loadService: func([]string) (*declaredService, error) {
+			return &declaredService{
+				taskDefinition: webTaskDefinition(),
+				ecsParams: &composeutils.ECSParams{TaskDefinition: composeutils.EcsTaskDef{
+					ContainerDefinitions: composeutils.ContainerDefs{"web": {DesiredCount: aws.Int64(3)}},
+				}},
+			}, nil
+		},
+	}
+}
+
+func TestReconcileCorrectsDrift(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockLogFactory := mock_cloudwatchlogs.NewMockLogClientFactory(ctrl)
+	mockLogs := mock_cloudwatchlogs.NewMockClient(ctrl)
+	m := reconcileManifest()
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockECS.EXPECT().DescribeService(webService).Return(webServiceWithCount(1), nil)
+	mockECS.EXPECT().DescribeTaskDefinition(webTaskDefArn).Return(webTaskDefinition(), nil)
+	mockLogFactory.EXPECT().Get("us-west-2").Return(mockLogs)
+	mockLogs.EXPECT().LogGroupExists(aws.String("/ecs/web")).Return(false, nil)
+
+	var commands [][]string
+	run := func(args []string) error {
+		commands = append(commands, args)
+		return nil
+	}
+	out := &bytes.Buffer{}
+	drifts, err := reconcile(newContext(false, false), m, reconcileClients(mockECS, mockCFN, mockLogFactory), testCommandConfig(), run, nil, out)
+	require.NoError(t, err, "Unexpected error reconciling cluster")
+
+	assert.Equal(t, []drift{
+		{resource: "service " + webService, detail: "desired count is 1, declared 3"},
+		{resource: "service " + webService, detail: "log group /ecs/web does not exist"},
+	}, drifts)
+	assert.Regexp(t, `service `+webService+`\s+desired count is 1, declared 3`, out.String())
+	require.Len(t, commands, 1)
+	assert.Equal(t, []string{"--scale", "3", "--create-log-groups"}, commands[0][len(commands[0])-3:])
+}
+
+func TestReconcileWithoutDrift(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockLogFactory := mock_cloudwatchlogs.NewMockLogClientFactory(ctrl)
+	mockLogs := mock_cloudwatchlogs.NewMockClient(ctrl)
+	m := reconcileManifest()
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockECS.EXPECT().DescribeService(webService).Return(webServiceWithCount(3), nil)
+	mockECS.EXPECT().DescribeTaskDefinition(webTaskDefArn).Return(webTaskDefinition(), nil)
+	mockLogFactory.EXPECT().Get("us-west-2").Return(mockLogs)
+	mockLogs.EXPECT().LogGroupExists(aws.String("/ecs/web")).Return(true, nil)
+
+	var commands [][]string
+	run := func(args []string) error {
+		commands = append(commands, args)
+		return nil
+	}
+	out := &bytes.Buffer{}
+	drifts, err := reconcile(newContext(false, false), m, reconcileClients(mockECS, mockCFN, mockLogFactory), testCommandConfig(), run, nil, out)
+	require.NoError(t, err, "Unexpected error reconciling cluster")
+
+	assert.Empty(t, drifts)
+	assert.NotContains(t, out.String(), "DRIFT")
+	assert.Empty(t, commands, "Expected no command for a cluster without drift")
+}
+
+func TestReconcileDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockLogFactory := mock_cloudwatchlogs.NewMockLogClientFactory(ctrl)
+	m := reconcileManifest()
+	m.Projects = append(m.Projects, projectManifest{Name: "worker", Files: []string{"/srv/worker/docker-compose.yml"}})
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil)
+	mockECS.EXPECT().DescribeService(webService).Return(&ecs.DescribeServicesOutput{}, nil)
+	mockECS.EXPECT().DescribeService(workerService).Return(&ecs.DescribeServicesOutput{}, nil)
+
+	run := func(args []string) error {
+		t.Fatalf("Unexpected command in a dry run: %v", args)
+		return nil
+	}
+	drifts, err := reconcile(newContext(true, false), m, reconcileClients(mockECS, mockCFN, mockLogFactory), testCommandConfig(), run, nil, &bytes.Buffer{})
+	require.NoError(t, err, "Unexpected error reconciling cluster")
+	assert.Equal(t, []drift{
+		{resource: "service " + webService, detail: "does not exist"},
+		{resource: "service " + workerService, detail: "does not exist"},
+	}, drifts)
+}
+
+func TestRereadManifestKeepsLastValidManifest(t *testing.T) {
+	fileName, cleanup := writeManifest(t, "version: 1\nprojects:\n  - name: web\n    files: [docker-compose.yml]\n")
+	defer cleanup()
+	last := reconcileManifest()
+
+	m := rereadManifest(fileName, last)
+	require.Len(t, m.Projects, 1)
+	assert.Equal(t, filepath.Join(filepath.Dir(fileName), "docker-compose.yml"), m.Projects[0].Files[0])
+
+	require.NoError(t, ioutil.WriteFile(fileName, []byte("version: 1\nprojects:\n  - files: [docker-compose.yml]\n"), 0644))
+	assert.Equal(t, last, rereadManifest(fileName, last), "Expected the last valid manifest for an invalid edit")
+}
@@ -0,0 +1,93 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package apply
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/service"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/version"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/urfave/cli"
+)
+
+// declaredService is what 'compose service up' would deploy for a project: the task definition it
+// would register, the load balancers it would create the service with, and the ecs-params file of
+// the project, or nil if it has none
+type declaredService struct {
+	taskDefinition *ecs.TaskDefinition
+	loadBalancers  []*ecs.LoadBalancer
+	ecsParams      *composeutils.ECSParams
+}
+
+// serviceLoader returns a function loading what 'compose service up' would deploy with the given
+// arguments. The arguments are parsed by the application returned by newApp, with the action of
+// 'compose service up' replaced by one converting the compose files of the project. --dry-run is
+// added, so that the images of the project are not built.
+func serviceLoader(newApp func() *cli.App) func([]string) (*declaredService, error) {
+	return func(args []string) (*declaredService, error) {
+		app := newApp()
+		up := findCommand(app.Commands, "compose", "service", "up")
+		if up == nil {
+			return nil, fmt.Errorf("Command 'compose service up' not found")
+		}
+		var declared *declaredService
+		var loadErr error
+		up.Action = func(c *cli.Context) {
+			declared, loadErr = loadService(c)
+		}
+		// a usage error is returned by Run, instead of exiting
+		up.OnUsageError = nil
+		if err := app.Run(append(append([]string{version.AppName}, args...), "--"+flags.DryRunFlag)); err != nil {
+			return nil, err
+		}
+		if loadErr != nil {
+			return nil, loadErr
+		}
+		if declared == nil {
+			return nil, fmt.Errorf("Unable to load the project with '%s'", (&step{args: args}).command())
+		}
+		return declared, nil
+	}
+}
+
+// loadService converts the compose files of the project of the context, as 'compose service up' does
+func loadService(c *cli.Context) (*declaredService, error) {
+	p, err := factory.NewProjectFactory().Create(c, true)
+	if err != nil {
+		return nil, err
+	}
+	declared := &declaredService{taskDefinition: p.Entity().TaskDefinition(), ecsParams: p.Context().ECSParams}
+	if s, ok := p.Entity().(*service.Service); ok {
+		declared.loadBalancers = s.LoadBalancers()
+	}
+	return declared, nil
+}
+
+// findCommand returns the command with the given name, and then subcommand names, or nil
+func findCommand(commands []cli.Command, names ...string) *cli.Command {
+	for i := range commands {
+		if commands[i].Name != names[0] {
+			continue
+		}
+		if len(names) == 1 {
+			return &commands[i]
+		}
+		return findCommand(commands[i].Subcommands, names[1:]...)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package attributes manages the custom attributes of the container instances in a cluster.
+package attributes
+
+import (
+	"fmt"
+	"strings"
+
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// PutAttributes creates or updates custom attributes on the given container instances.
+func PutAttributes(c *cli.Context) {
+	ecsClient, commandConfig, err := newECSClient(c)
+	if err != nil {
+		logrus.Fatal("Error executing 'attributes put': ", err)
+	}
+	if err := putAttributes(c, ecsClient, commandConfig); err != nil {
+		logrus.Fatal("Error executing 'attributes put': ", err)
+	}
+}
+
+// DeleteAttributes removes custom attributes from the given container instances.
+func DeleteAttributes(c *cli.Context) {
+	ecsClient, commandConfig, err := newECSClient(c)
+	if err != nil {
+		logrus.Fatal("Error executing 'attributes delete': ", err)
+	}
+	if err := deleteAttributes(c, ecsClient, commandConfig); err != nil {
+		logrus.Fatal("Error executing 'attributes delete': ", err)
+	}
+}
+
+func newECSClient(c *cli.Context) (ecsclient.ECSClient, *config.CommandConfig, error) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		return nil, nil, err
+	}
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ecsclient.NewECSClient(commandConfig), commandConfig, nil
+}
+
+func putAttributes(context *cli.Context, ecsClient ecsclient.ECSClient, commandConfig *config.CommandConfig) error {
+	attributes, err := containerInstanceAttributes(context, ecsClient, commandConfig)
+	if err != nil {
+		return err
+	}
+	if err := ecsClient.PutAttributes(attributes); err != nil {
+		return err
+	}
+	logrus.Infof("Put %d attribute(s) on the container instances of cluster '%s'", len(attributes), commandConfig.Cluster)
+	return nil
+}
+
+func deleteAttributes(context *cli.Context, ecsClient ecsclient.ECSClient, commandConfig *config.CommandConfig) error {
+	attributes, err := containerInstanceAttributes(context, ecsClient, commandConfig)
+	if err != nil {
+		return err
+	}
+	if err := ecsClient.DeleteAttributes(attributes); err != nil {
+		return err
+	}
+	logrus.Infof("Deleted %d attribute(s) from the container instances of cluster '%s'", len(attributes), commandConfig.Cluster)
+	return nil
+}
+
+// containerInstanceAttributes returns one attribute per name given with --attributes and
+// container instance given with --container-instances.
+func containerInstanceAttributes(context *cli.Context, ecsClient ecsclient.ECSClient, commandConfig *config.CommandConfig) ([]*ecs.Attribute, error) {
+	containerInstancesVal := context.String(flags.ContainerInstancesFlag)
+	if containerInstancesVal == "" {
+		return nil, fmt.Errorf("ContainerInstance(s) must be specified with the --%s flag", flags.ContainerInstancesFlag)
+	}
+	attributesVal := context.String(flags.AttributesFlag)
+	if attributesVal == "" {
+		return nil, fmt.Errorf("Attribute(s) must be specified with the --%s flag", flags.AttributesFlag)
+	}
+	parsedAttributes, err := utils.ParseAttributes(attributesVal)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateCluster(commandConfig.Cluster, ecsClient); err != nil {
+		return nil, err
+	}
+
+	var attributes []*ecs.Attribute
+	for _, containerInstance := range strings.Split(containerInstancesVal, ",") {
+		for _, attribute := range parsedAttributes {
+			attributes = append(attributes, &ecs.Attribute{
+				Name:       attribute.Name,
+				Value:      attribute.Value,
+				TargetId:   aws.String(strings.TrimSpace(containerInstance)),
+				TargetType: aws.String(ecs.TargetTypeContainerInstance),
+			})
+		}
+	}
+	return attributes, nil
+}
+
+// validateCluster validates if the cluster exists in ECS and is in "ACTIVE" state.
+func validateCluster(clusterName string, ecsClient ecsclient.ECSClient) error {
+	isClusterActive, err := ecsClient.IsActiveCluster(clusterName)
+	if err != nil {
+		return err
+	}
+
+	if !isClusterActive {
+		return fmt.Errorf("Cluster '%s' is not active. Ensure that it exists", clusterName)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package attributes
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const clusterName = "defaultCluster"
+
+func setupTest(t *testing.T) (*mock_ecs.MockECSClient, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	return mock_ecs.NewMockECSClient(ctrl), ctrl
+}
+
+func newContext(containerInstances, attributes string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-attributes", 0)
+	flagSet.String(flags.ContainerInstancesFlag, containerInstances, "")
+	flagSet.String(flags.AttributesFlag, attributes, "")
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestPutAttributes(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	expectedAttributes := []*ecs.Attribute{
+		{Name: aws.String("stack"), Value: aws.String("prod"), TargetId: aws.String("instance1"), TargetType: aws.String(ecs.TargetTypeContainerInstance)},
+		{Name: aws.String("gpu"), TargetId: aws.String("instance1"), TargetType: aws.String(ecs.TargetTypeContainerInstance)},
+		{Name: aws.String("stack"), Value: aws.String("prod"), TargetId: aws.String("instance2"), TargetType: aws.String(ecs.TargetTypeContainerInstance)},
+		{Name: aws.String("gpu"), TargetId: aws.String("instance2"), TargetType: aws.String(ecs.TargetTypeContainerInstance)},
+	}
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil),
+		mockECS.EXPECT().PutAttributes(expectedAttributes).Return(nil),
+	)
+
+	context := newContext("instance1,instance2", "stack=prod,gpu")
+	err := putAttributes(context, mockECS, &config.CommandConfig{Cluster: clusterName})
+	assert.NoError(t, err, "Unexpected error putting attributes")
+}
+
+func TestDeleteAttributes(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	expectedAttributes := []*ecs.Attribute{
+		{Name: aws.String("stack"), TargetId: aws.String("instance1"), TargetType: aws.String(ecs.TargetTypeContainerInstance)},
+	}
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(clusterName).Return(true, nil),
+		mockECS.EXPECT().DeleteAttributes(expectedAttributes).Return(nil),
+	)
+
+	context := newContext("instance1", "stack")
+	err := deleteAttributes(context, mockECS, &config.CommandConfig{Cluster: clusterName})
+	assert.NoError(t, err, "Unexpected error deleting attributes")
+}
+
+func TestPutAttributesInactiveCluster(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	mockECS.EXPECT().IsActiveCluster(clusterName).Return(false, nil)
+
+	context := newContext("instance1", "stack=prod")
+	err := putAttributes(context, mockECS, &config.CommandConfig{Cluster: clusterName})
+	assert.Error(t, err, "Expected error putting attributes in an inactive cluster")
+}
+
+func TestPutAttributesWithoutFlags(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	err := putAttributes(newContext("", "stack=prod"), mockECS, &config.CommandConfig{Cluster: clusterName})
+	assert.Error(t, err, "Expected error when container instances are not specified")
+
+	err = putAttributes(newContext("instance1", ""), mockECS, &config.CommandConfig{Cluster: clusterName})
+	assert.Error(t, err, "Expected error when attributes are not specified")
+}
@@ -0,0 +1,195 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package capacity stops and starts the container instances of a cluster, by scaling the desired capacity
+// of its Auto Scaling group.
+package capacity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/dynamodb"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
+	"github.com/aws/aws-sdk-go/aws"
+	sdkCFN "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// sleep can be replaced in tests
+var sleep = time.Sleep
+
+const (
+	// stoppedDesiredCapacity is the desired capacity of the Auto Scaling group of a stopped cluster
+	stoppedDesiredCapacity = "0"
+
+	drainPollInterval = 10 * time.Second
+	drainTimeout      = 15 * time.Minute
+)
+
+// Stop drains the container instances of the cluster and scales its Auto Scaling group to 0 instances.
+func Stop(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'stop': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'stop': ", err)
+	}
+
+	awsClients := cluster.NewAWSClients(commandConfig)
+
+	progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusStarted, "stop")
+	if err := stopCluster(c, awsClients, commandConfig); err != nil {
+		progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusFailed, err.Error())
+		logrus.Fatal("Error executing 'stop': ", err)
+	}
+	progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusComplete, "stop")
+	cluster.RecordClusterStack(c, commandConfig, "stop", commandConfig.CFNStackName, commandConfig.Cluster, dynamodb.StackStatusActive)
+}
+
+// Start scales the Auto Scaling group of a stopped cluster back to its maximum size.
+func Start(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'start': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'start': ", err)
+	}
+
+	awsClients := cluster.NewAWSClients(commandConfig)
+
+	progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusStarted, "start")
+	if err := startCluster(c, awsClients, commandConfig); err != nil {
+		progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusFailed, err.Error())
+		logrus.Fatal("Error executing 'start': ", err)
+	}
+	progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusComplete, "start")
+	cluster.RecordClusterStack(c, commandConfig, "start", commandConfig.CFNStackName, commandConfig.Cluster, dynamodb.StackStatusActive)
+}
+
+// WaitForTasksToDrain waits until no tasks are running on the draining container instances.
+func WaitForTasksToDrain(ecsClient ecsclient.ECSClient, containerInstanceArns []*string) error {
+	for elapsed := time.Duration(0); ; elapsed += drainPollInterval {
+		count, err := ecsClient.GetRunningTasksCount(containerInstanceArns)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil
+		}
+		if elapsed >= drainTimeout {
+			return fmt.Errorf("Timed out waiting for %d tasks to stop on your container instances. Tasks that are not part of a service are not stopped by draining; specify '--%s' to stop the cluster without waiting for them", count, flags.ForceFlag)
+		}
+		logrus.Infof("Waiting for %d tasks to drain from your container instances...", count)
+		sleep(drainPollInterval)
+	}
+}
+
+// createPS executes the 'ps' command.
+// stopCluster drains the container instances of the cluster and scales the desired capacity of its
+// Auto Scaling group to 0, keeping its maximum size so that startCluster can restore it.
+func stopCluster(context *cli.Context, awsClients *cluster.AWSClients, commandConfig *config.CommandConfig) error {
+	desiredCapacity, existingParameters, err := getDesiredCapacityParameter(context, awsClients, commandConfig)
+	if err != nil {
+		return err
+	}
+	if desiredCapacity == stoppedDesiredCapacity {
+		logrus.Infof("Cluster '%s' is already stopped", commandConfig.Cluster)
+		return nil
+	}
+
+	ecsClient := awsClients.ECSClient
+	containerInstanceArns, err := ecsClient.ListContainerInstances()
+	if err != nil {
+		return err
+	}
+	if len(containerInstanceArns) > 0 {
+		logrus.Infof("Draining %d container instances...", len(containerInstanceArns))
+		if err := ecsClient.DrainContainerInstances(containerInstanceArns); err != nil {
+			return err
+		}
+		if !context.Bool(flags.ForceFlag) {
+			if err := WaitForTasksToDrain(ecsClient, containerInstanceArns); err != nil {
+				return err
+			}
+		}
+	}
+
+	return updateDesiredCapacity(awsClients.CFNClient, commandConfig.CFNStackName, existingParameters, stoppedDesiredCapacity)
+}
+
+// startCluster restores the desired capacity of the Auto Scaling group of a stopped cluster to its maximum size.
+func startCluster(context *cli.Context, awsClients *cluster.AWSClients, commandConfig *config.CommandConfig) error {
+	desiredCapacity, existingParameters, err := getDesiredCapacityParameter(context, awsClients, commandConfig)
+	if err != nil {
+		return err
+	}
+	if desiredCapacity != stoppedDesiredCapacity {
+		logrus.Infof("Cluster '%s' is not stopped", commandConfig.Cluster)
+		return nil
+	}
+
+	// An empty desired capacity makes the Auto Scaling group use its maximum size again
+	return updateDesiredCapacity(awsClients.CFNClient, commandConfig.CFNStackName, existingParameters, "")
+}
+
+// getDesiredCapacityParameter validates the cluster and its stack, and returns the current desired
+// capacity parameter of the stack along with all of its parameters.
+func getDesiredCapacityParameter(context *cli.Context, awsClients *cluster.AWSClients, commandConfig *config.CommandConfig) (string, []*sdkCFN.Parameter, error) {
+	if !context.Bool(flags.CapabilityIAMFlag) {
+		return "", nil, fmt.Errorf("Please acknowledge that this command may create IAM resources with the '--%s' flag", flags.CapabilityIAMFlag)
+	}
+
+	if err := cluster.ValidateCluster(commandConfig.Cluster, awsClients.ECSClient); err != nil {
+		return "", nil, err
+	}
+
+	existingParameters, err := awsClients.CFNClient.GetStackParameters(commandConfig.CFNStackName)
+	if err != nil {
+		return "", nil, fmt.Errorf("CloudFormation stack not found for cluster '%s'", commandConfig.Cluster)
+	}
+	for _, param := range existingParameters {
+		if aws.StringValue(param.ParameterKey) == cluster.ParameterKeyAsgDesiredCapacity {
+			return aws.StringValue(param.ParameterValue), existingParameters, nil
+		}
+	}
+	return "", nil, fmt.Errorf("The CloudFormation stack for cluster '%s' was created by an older version of the ECS CLI and cannot be stopped or started. Please recreate it with 'ecs-cli up --%s'", commandConfig.Cluster, flags.ForceFlag)
+}
+
+// updateDesiredCapacity updates the desired capacity parameter of the stack, keeping all other parameters.
+func updateDesiredCapacity(cfnClient cloudformation.CloudformationClient, stackName string, existingParameters []*sdkCFN.Parameter, desiredCapacity string) error {
+	cfnParams, err := cloudformation.NewCfnStackParamsForUpdate(cluster.RequiredParameters, existingParameters)
+	if err != nil {
+		return err
+	}
+	cfnParams.Add(cluster.ParameterKeyAsgDesiredCapacity, desiredCapacity)
+
+	if _, err := cfnClient.UpdateStack(stackName, cfnParams); err != nil {
+		return err
+	}
+
+	logrus.Info("Waiting for your cluster resources to be updated...")
+	return cfnClient.WaitUntilUpdateComplete(stackName)
+}
@@ -0,0 +1,290 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package capacity
+
+import (
+	"flag"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	mock_amimetadata "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	mock_cloudformation "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	sdkCFN "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	clusterName = "defaultCluster"
+	stackName   = "defaultCluster"
+)
+
+type mockReadWriter struct {
+	clusterName string
+}
+
+func (rdwr *mockReadWriter) Get(cluster string, profile string) (*config.LocalConfig, error) {
+	cliConfig := config.NewLocalConfig(rdwr.clusterName)
+	cliConfig.CFNStackName = rdwr.clusterName
+	return cliConfig, nil
+}
+
+func (rdwr *mockReadWriter) SaveProfile(configName string, profile *config.Profile) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SaveCluster(configName string, cluster *config.Cluster) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultProfile(configName string) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultCluster(configName string) error {
+	return nil
+}
+
+func newMockReadWriter() *mockReadWriter {
+	return &mockReadWriter{
+		clusterName: clusterName,
+	}
+}
+
+func setupTest(t *testing.T) (*mock_ecs.MockECSClient, *mock_cloudformation.MockCloudformationClient, *mock_amimetadata.MockClient, *mock_ec2.MockEC2Client) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockSSM := mock_amimetadata.NewMockClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "secret")
+	os.Setenv("AWS_REGION", "us-west-1")
+
+	return mockECS, mockCloudformation, mockSSM, mockEC2
+}
+
+func desiredCapacityParameters(desiredCapacity string) []*sdkCFN.Parameter {
+	return []*sdkCFN.Parameter{
+		&sdkCFN.Parameter{
+			ParameterKey:   aws.String(cluster.ParameterKeyAsgMaxSize),
+			ParameterValue: aws.String("3"),
+		},
+		&sdkCFN.Parameter{
+			ParameterKey:   aws.String(cluster.ParameterKeyAsgDesiredCapacity),
+			ParameterValue: aws.String(desiredCapacity),
+		},
+	}
+}
+
+func expectDesiredCapacityUpdate(t *testing.T, mockCloudformation *mock_cloudformation.MockCloudformationClient, desiredCapacity string) []*gomock.Call {
+	return []*gomock.Call{
+		mockCloudformation.EXPECT().UpdateStack(stackName, gomock.Any()).Do(func(x, y interface{}) {
+			cfnParams := y.(*cloudformation.CfnStackParams)
+			param, err := cfnParams.GetParameter(cluster.ParameterKeyAsgDesiredCapacity)
+			assert.NoError(t, err, "Expected desired capacity to be updated")
+			assert.Equal(t, desiredCapacity, aws.StringValue(param.ParameterValue))
+			assert.False(t, aws.BoolValue(param.UsePreviousValue))
+			param, err = cfnParams.GetParameter(cluster.ParameterKeyAsgMaxSize)
+			assert.NoError(t, err, "Expected maximum size to be kept")
+			assert.True(t, aws.BoolValue(param.UsePreviousValue))
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil),
+	}
+}
+
+func TestClusterStop(t *testing.T) {
+	defer os.Clearenv()
+	defer func() { sleep = time.Sleep }()
+	var slept []time.Duration
+	sleep = func(d time.Duration) { slept = append(slept, d) }
+
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+	containerInstanceArns := aws.StringSlice([]string{"arn1", "arn2"})
+
+	calls := []*gomock.Call{
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(desiredCapacityParameters(""), nil),
+		mockECS.EXPECT().ListContainerInstances().Return(containerInstanceArns, nil),
+		mockECS.EXPECT().DrainContainerInstances(containerInstanceArns).Return(nil),
+		mockECS.EXPECT().GetRunningTasksCount(containerInstanceArns).Return(int64(2), nil),
+		mockECS.EXPECT().GetRunningTasksCount(containerInstanceArns).Return(int64(0), nil),
+	}
+	gomock.InOrder(append(calls, expectDesiredCapacityUpdate(t, mockCloudformation, "0")...)...)
+
+	flagSet := flag.NewFlagSet("ecs-cli-stop", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = stopCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error stopping cluster")
+	assert.Equal(t, []time.Duration{drainPollInterval}, slept, "Expected to wait once for tasks to drain")
+}
+
+func TestClusterStopWithForce(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+	containerInstanceArns := aws.StringSlice([]string{"arn1"})
+
+	calls := []*gomock.Call{
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(desiredCapacityParameters(""), nil),
+		mockECS.EXPECT().ListContainerInstances().Return(containerInstanceArns, nil),
+		mockECS.EXPECT().DrainContainerInstances(containerInstanceArns).Return(nil),
+	}
+	gomock.InOrder(append(calls, expectDesiredCapacityUpdate(t, mockCloudformation, "0")...)...)
+
+	flagSet := flag.NewFlagSet("ecs-cli-stop", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.Bool(flags.ForceFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = stopCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error stopping cluster")
+}
+
+func TestClusterStopAlreadyStopped(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(desiredCapacityParameters("0"), nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-stop", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = stopCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error stopping a stopped cluster")
+}
+
+func TestClusterStopStackWithoutDesiredCapacity(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return([]*sdkCFN.Parameter{
+			&sdkCFN.Parameter{ParameterKey: aws.String(cluster.ParameterKeyAsgMaxSize)},
+		}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-stop", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = stopCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error stopping a cluster whose stack has no desired capacity parameter")
+}
+
+func TestClusterStopDrainTimeout(t *testing.T) {
+	defer os.Clearenv()
+	defer func() { sleep = time.Sleep }()
+	sleep = func(time.Duration) {}
+
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+	containerInstanceArns := aws.StringSlice([]string{"arn1"})
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(desiredCapacityParameters(""), nil),
+		mockECS.EXPECT().ListContainerInstances().Return(containerInstanceArns, nil),
+		mockECS.EXPECT().DrainContainerInstances(containerInstanceArns).Return(nil),
+		mockECS.EXPECT().GetRunningTasksCount(containerInstanceArns).Return(int64(1), nil).Times(int(drainTimeout/drainPollInterval)+1),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-stop", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = stopCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error when tasks do not drain")
+}
+
+func TestClusterStart(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	calls := []*gomock.Call{
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(desiredCapacityParameters("0"), nil),
+	}
+	gomock.InOrder(append(calls, expectDesiredCapacityUpdate(t, mockCloudformation, "")...)...)
+
+	flagSet := flag.NewFlagSet("ecs-cli-start", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = startCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error starting cluster")
+}
+
+func TestClusterStartWithoutIamCapability(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	flagSet := flag.NewFlagSet("ecs-cli-start", 0)
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = startCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error starting cluster without --capability-iam")
+}
@@ -0,0 +1,230 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package clone creates a cluster with the configuration of the stack of an existing cluster.
+package clone
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster/userdata"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/dynamodb"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/aws-sdk-go/aws"
+	sdkCFN "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// Clone creates a new cluster with the configuration of an existing cluster.
+func Clone(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'clone': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'clone': ", err)
+	}
+
+	awsClients := cluster.NewAWSClients(commandConfig)
+	stackName, assignPublicIP, err := cloneCluster(c, awsClients, commandConfig)
+	if err != nil {
+		logrus.Fatal("Error executing 'clone': ", err)
+	}
+	if err := cluster.DisplayStackOutputs(os.Stdout, awsClients.CFNClient, stackName, assignPublicIP); err != nil {
+		logrus.Error("Error describing Cloudformation resources: ", err)
+	}
+	cluster.RecordClusterStack(c, commandConfig, "clone", stackName, c.String(flags.CloneToFlag), dynamodb.StackStatusActive)
+
+	fmt.Println("Cluster clone succeeded.")
+}
+
+// cloneCluster executes the 'clone' command. It creates a cluster and its stack with the parameters,
+// tags and capabilities of the stack of an existing cluster, in the same VPC, so that services can be
+// moved to the new cluster before the existing one is deleted. It returns the name of the new stack,
+// and whether tasks in its subnets need a public IP to pull images.
+func cloneCluster(context *cli.Context, awsClients *cluster.AWSClients, commandConfig *config.CommandConfig) (string, bool, error) {
+	if !context.Bool(flags.CapabilityIAMFlag) {
+		return "", false, fmt.Errorf("Please acknowledge that this command may create IAM resources with the '--%s' flag", flags.CapabilityIAMFlag)
+	}
+
+	sourceCluster := context.String(flags.CloneFromFlag)
+	if sourceCluster == "" {
+		sourceCluster = commandConfig.Cluster
+	}
+	if sourceCluster == "" {
+		return "", false, cluster.ClusterNotSetError()
+	}
+	targetCluster := context.String(flags.CloneToFlag)
+	if targetCluster == "" {
+		return "", false, fmt.Errorf("Missing required flag '--%s'", flags.CloneToFlag)
+	}
+	if targetCluster == sourceCluster {
+		return "", false, fmt.Errorf("The '--%s' cluster must be different from the '--%s' cluster", flags.CloneToFlag, flags.CloneFromFlag)
+	}
+	size, err := cluster.GetClusterSize(context)
+	if err != nil {
+		return "", false, err
+	}
+
+	// Stacks are named after their cluster, with the configured prefix
+	stackNamePrefix := strings.TrimSuffix(commandConfig.CFNStackName, commandConfig.Cluster)
+	sourceStackName := stackNamePrefix + sourceCluster
+	stackName := stackNamePrefix + targetCluster
+
+	cfnClient := awsClients.CFNClient
+	if err := cfnClient.ValidateStackExists(stackName); err == nil {
+		return "", false, fmt.Errorf("A CloudFormation stack already exists for the cluster '%s'", targetCluster)
+	}
+	sourceParameters, err := cfnClient.GetStackParameters(sourceStackName)
+	if err != nil {
+		return "", false, fmt.Errorf("CloudFormation stack not found for cluster '%s'", sourceCluster)
+	}
+	output, err := cfnClient.DescribeStacks(sourceStackName)
+	if err != nil {
+		return "", false, err
+	}
+	if len(output.Stacks) == 0 {
+		return "", false, fmt.Errorf("Could not describe stack '%s'", sourceStackName)
+	}
+	sourceStack := output.Stacks[0]
+
+	tags := cluster.ConvertFromCFNTags(sourceStack.Tags)
+	template, err := cloudformation.NewClusterTemplate(tags, stackName)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "Error building cloudformation template")
+	}
+
+	cfnParams, sourceVpcID, err := cloneStackParams(sourceParameters, template, sourceCluster, targetCluster)
+	if err != nil {
+		return "", false, err
+	}
+	// Sharing the VPC created by the source stack keeps the services of both clusters reachable
+	// from the same load balancers and service discovery namespaces
+	if sourceVpcID == "" {
+		if err := cluster.ShareVpcFromStack(cfnParams, cfnClient, sourceStackName); err != nil {
+			return "", false, err
+		}
+	}
+	if size != "" {
+		cfnParams.Add(cluster.ParameterKeyAsgMaxSize, size)
+	}
+
+	isFargate := false
+	if param, err := cfnParams.GetParameter(cluster.ParameterKeyIsFargate); err == nil {
+		isFargate = aws.StringValue(param.ParameterValue) == "true"
+	}
+	imageID := context.String(flags.ImageIdFlag)
+	instanceType := context.String(flags.InstanceTypeFlag)
+	if isFargate && (imageID != "" || instanceType != "") {
+		return "", false, fmt.Errorf("You can only specify '--%s' or '--%s' when cloning a cluster with container instances", flags.ImageIdFlag, flags.InstanceTypeFlag)
+	}
+	if instanceType != "" {
+		cfnParams.Add(cluster.ParameterKeyInstanceType, instanceType)
+	}
+	if imageID != "" {
+		cfnParams.Add(cluster.ParameterKeyAmiId, imageID)
+	} else if instanceType != "" {
+		// The AMI of the source cluster may not support the architecture of the new instance type
+		if err := cluster.PopulateAMIID(cfnParams, awsClients.AMIMetadataClient); err != nil {
+			return "", false, err
+		}
+	}
+	if err := cfnParams.Validate(); err != nil {
+		return "", false, err
+	}
+
+	capabilities := aws.StringValueSlice(sourceStack.Capabilities)
+	if !utils.InSlice(sdkCFN.CapabilityCapabilityIam, capabilities) {
+		capabilities = append(capabilities, sdkCFN.CapabilityCapabilityIam)
+	}
+	templateBody, err := template.String()
+	if err != nil {
+		return "", false, errors.Wrapf(err, "Error building cloudformation template")
+	}
+	if err := cfnClient.ValidateTemplate(templateBody, capabilities); err != nil {
+		return "", false, err
+	}
+
+	if _, err := awsClients.ECSClient.CreateCluster(targetCluster, tags); err != nil {
+		return "", false, err
+	}
+	if _, err := cfnClient.CreateStack(templateBody, stackName, capabilities, cfnParams, sourceStack.Tags); err != nil {
+		return "", false, err
+	}
+
+	logrus.Infof("Waiting for the resources of cluster '%s' to be created...", targetCluster)
+	if err := cfnClient.WaitUntilCreateComplete(stackName); err != nil {
+		return "", false, err
+	}
+	return stackName, sourceVpcID == "", nil
+}
+
+// cloneStackParams returns the parameters of the source stack that are set and that the cluster
+// template still has, for the new cluster. The network of a source stack that created its own VPC is left out, to be
+// shared from the source stack. It also returns the VPC of the source stack, if it was set.
+func cloneStackParams(sourceParameters []*sdkCFN.Parameter, template *cloudformation.Template, sourceCluster, targetCluster string) (*cloudformation.CfnStackParams, string, error) {
+	var sourceVpcID string
+	for _, param := range sourceParameters {
+		if aws.StringValue(param.ParameterKey) == cluster.ParameterKeyVpcId {
+			sourceVpcID = aws.StringValue(param.ParameterValue)
+		}
+	}
+
+	cfnParams := cloudformation.NewCfnStackParams(cluster.RequiredParameters)
+	for _, param := range sourceParameters {
+		key := aws.StringValue(param.ParameterKey)
+		value := aws.StringValue(param.ParameterValue)
+		// Empty parameters are left to the defaults of the template
+		if _, ok := template.Parameters[key]; !ok || value == "" {
+			continue
+		}
+		switch key {
+		case cluster.ParameterKeyVpcId, cluster.ParameterKeySubnetIds, cluster.ParameterKeyVPCAzs, cluster.ParameterKeySecurityGroup:
+			if sourceVpcID == "" {
+				continue
+			}
+		case cluster.ParameterKeyAsgDesiredCapacity:
+			// A stopped source cluster is cloned with all of its instances running
+			continue
+		case cluster.ParameterKeyUserData:
+			userData, err := cloneUserData(value, sourceCluster, targetCluster)
+			if err != nil {
+				return nil, "", err
+			}
+			value = userData
+		}
+		cfnParams.Add(key, value)
+	}
+	cfnParams.Add(cluster.ParameterKeyCluster, targetCluster)
+	return cfnParams, sourceVpcID, nil
+}
+
+// cloneUserData makes the user data of the container instances of the source cluster register them
+// to the new cluster instead
+func cloneUserData(userData, sourceCluster, targetCluster string) (string, error) {
+	joinSourceCluster := userdata.JoinClusterCommand(sourceCluster)
+	if !strings.Contains(userData, joinSourceCluster) {
+		return "", fmt.Errorf("Unable to find the cluster '%s' in the user data of its stack", sourceCluster)
+	}
+	return strings.Replace(userData, joinSourceCluster, userdata.JoinClusterCommand(targetCluster), -1), nil
+}
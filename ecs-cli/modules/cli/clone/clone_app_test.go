@@ -0,0 +1,290 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package clone
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata"
+	mock_amimetadata "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	mock_cloudformation "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	sdkCFN "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	clusterName = "defaultCluster"
+	stackName   = "defaultCluster"
+	amiID       = "ami-deadb33f"
+	armAMIID    = "ami-baadf00d"
+)
+
+type mockReadWriter struct {
+	clusterName string
+}
+
+func (rdwr *mockReadWriter) Get(cluster string, profile string) (*config.LocalConfig, error) {
+	cliConfig := config.NewLocalConfig(rdwr.clusterName)
+	cliConfig.CFNStackName = rdwr.clusterName
+	return cliConfig, nil
+}
+
+func (rdwr *mockReadWriter) SaveProfile(configName string, profile *config.Profile) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SaveCluster(configName string, cluster *config.Cluster) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultProfile(configName string) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultCluster(configName string) error {
+	return nil
+}
+
+func newMockReadWriter() *mockReadWriter {
+	return &mockReadWriter{
+		clusterName: clusterName,
+	}
+}
+
+func setupTest(t *testing.T) (*mock_ecs.MockECSClient, *mock_cloudformation.MockCloudformationClient, *mock_amimetadata.MockClient, *mock_ec2.MockEC2Client) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockSSM := mock_amimetadata.NewMockClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "secret")
+	os.Setenv("AWS_REGION", "us-west-1")
+
+	return mockECS, mockCloudformation, mockSSM, mockEC2
+}
+
+func amiMetadata(imageID string) *amimetadata.AMIMetadata {
+	return &amimetadata.AMIMetadata{
+		ImageID:        imageID,
+		OsName:         "Amazon Linux",
+		AgentVersion:   "1.7.2",
+		RuntimeVersion: "Docker version 17.12.1-ce",
+	}
+}
+
+func clusterCloneSourceParameters() []*sdkCFN.Parameter {
+	return []*sdkCFN.Parameter{
+		{ParameterKey: aws.String(cluster.ParameterKeyCluster), ParameterValue: aws.String(clusterName)},
+		{ParameterKey: aws.String(cluster.ParameterKeyAmiId), ParameterValue: aws.String(amiID)},
+		{ParameterKey: aws.String(cluster.ParameterKeyInstanceType), ParameterValue: aws.String("t2.micro")},
+		{ParameterKey: aws.String(cluster.ParameterKeyAsgMaxSize), ParameterValue: aws.String("3")},
+		{ParameterKey: aws.String(cluster.ParameterKeyAsgDesiredCapacity), ParameterValue: aws.String("0")},
+		{ParameterKey: aws.String(cluster.ParameterKeyVpcId), ParameterValue: aws.String("")},
+		{ParameterKey: aws.String(cluster.ParameterKeySubnetIds), ParameterValue: aws.String("")},
+		{ParameterKey: aws.String(cluster.ParameterKeyVPCAzs), ParameterValue: aws.String("us-west-1a,us-west-1c")},
+		{ParameterKey: aws.String(cluster.ParameterKeySecurityGroup), ParameterValue: aws.String("")},
+		{ParameterKey: aws.String(cluster.ParameterKeyIsFargate), ParameterValue: aws.String("false")},
+		{ParameterKey: aws.String(cluster.ParameterKeyUserData), ParameterValue: aws.String("\n#!/bin/bash\necho ECS_CLUSTER=defaultCluster >> /etc/ecs/ecs.config\n")},
+		{ParameterKey: aws.String("RemovedParameter"), ParameterValue: aws.String("value")},
+	}
+}
+
+func TestClusterClone(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	cloneName := clusterName + "-green"
+	tags := []*sdkCFN.Tag{{Key: aws.String("team"), Value: aws.String("platform")}}
+
+	mockECS.EXPECT().CreateCluster(cloneName, []*ecs.Tag{{Key: aws.String("team"), Value: aws.String("platform")}}).Return(cloneName, nil)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(cloneName).Return(errors.New("does not exist")),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(clusterCloneSourceParameters(), nil),
+		mockCloudformation.EXPECT().DescribeStacks(stackName).Return(&sdkCFN.DescribeStacksOutput{
+			Stacks: []*sdkCFN.Stack{{
+				StackName:    aws.String(stackName),
+				Capabilities: aws.StringSlice([]string{sdkCFN.CapabilityCapabilityIam}),
+				Tags:         tags,
+			}},
+		}, nil),
+		mockCloudformation.EXPECT().GetStackOutputs(stackName).Return(map[string]string{
+			cloudformation.OutputKeyVpcId:           "vpc-02dd3038",
+			cloudformation.OutputKeySubnetIds:       "subnet-04726b21,subnet-04346b21",
+			cloudformation.OutputKeySecurityGroupId: "sg-c0ffeefe",
+		}, nil),
+		mockCloudformation.EXPECT().GetStackExportNames(stackName).Return(map[string]string{
+			cloudformation.OutputKeyVpcId: stackName + "-VpcId",
+		}, nil),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), cloneName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), tags).Do(func(v, w, x, y, z interface{}) {
+			cfnParams := y.(*cloudformation.CfnStackParams)
+			for key, expected := range map[string]string{
+				cluster.ParameterKeyCluster:             cloneName,
+				cluster.ParameterKeyAmiId:               amiID,
+				cluster.ParameterKeyAsgMaxSize:          "3",
+				cluster.ParameterKeyVpcId:               "vpc-02dd3038",
+				cluster.ParameterKeySubnetIds:           "subnet-04726b21,subnet-04346b21",
+				cluster.ParameterKeySecurityGroup:       "sg-c0ffeefe",
+				cluster.ParameterKeySharedVpcExportName: stackName + "-VpcId",
+				cluster.ParameterKeyUserData:            "\n#!/bin/bash\necho ECS_CLUSTER=defaultCluster-green >> /etc/ecs/ecs.config\n",
+			} {
+				param, err := cfnParams.GetParameter(key)
+				assert.NoError(t, err, "Expected parameter %s to be set", key)
+				assert.Equal(t, expected, aws.StringValue(param.ParameterValue), "Unexpected value of parameter %s", key)
+			}
+			for _, key := range []string{cluster.ParameterKeyAsgDesiredCapacity, cluster.ParameterKeyVPCAzs, "RemovedParameter"} {
+				_, err := cfnParams.GetParameter(key)
+				assert.Equal(t, cloudformation.ParameterNotFoundError, err, "Expected parameter %s not to be cloned", key)
+			}
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(cloneName).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-clone", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.CloneToFlag, cloneName, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	cloneStackName, assignPublicIP, err := cloneCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error cloning cluster")
+	assert.Equal(t, cloneName, cloneStackName)
+	assert.True(t, assignPublicIP, "Expected tasks in the subnets of the source stack to need a public IP")
+}
+
+func TestClusterCloneWithInstanceType(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	cloneName := clusterName + "-green"
+	sourceParameters := clusterCloneSourceParameters()
+	for _, param := range sourceParameters {
+		switch aws.StringValue(param.ParameterKey) {
+		case cluster.ParameterKeyVpcId:
+			param.ParameterValue = aws.String("vpc-02dd3038")
+		case cluster.ParameterKeySubnetIds:
+			param.ParameterValue = aws.String("subnet-04726b21,subnet-04346b21")
+		}
+	}
+
+	mockECS.EXPECT().CreateCluster(cloneName, gomock.Any()).Return(cloneName, nil)
+	mockSSM.EXPECT().GetRecommendedECSLinuxAMI("a1.medium").Return(amiMetadata(armAMIID), nil)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(cloneName).Return(errors.New("does not exist")),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(sourceParameters, nil),
+		mockCloudformation.EXPECT().DescribeStacks(stackName).Return(&sdkCFN.DescribeStacksOutput{
+			Stacks: []*sdkCFN.Stack{{StackName: aws.String(stackName)}},
+		}, nil),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), cloneName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			cfnParams := y.(*cloudformation.CfnStackParams)
+			for key, expected := range map[string]string{
+				cluster.ParameterKeyInstanceType: "a1.medium",
+				cluster.ParameterKeyAmiId:        armAMIID,
+				cluster.ParameterKeyAsgMaxSize:   "5",
+				cluster.ParameterKeyVpcId:        "vpc-02dd3038",
+				cluster.ParameterKeyVPCAzs:       "us-west-1a,us-west-1c",
+			} {
+				param, err := cfnParams.GetParameter(key)
+				assert.NoError(t, err, "Expected parameter %s to be set", key)
+				assert.Equal(t, expected, aws.StringValue(param.ParameterValue), "Unexpected value of parameter %s", key)
+			}
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(cloneName).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-clone", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.CloneFromFlag, clusterName, "")
+	flagSet.String(flags.CloneToFlag, cloneName, "")
+	flagSet.String(flags.InstanceTypeFlag, "a1.medium", "")
+	flagSet.String(flags.AsgMaxSizeFlag, "5", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	_, assignPublicIP, err := cloneCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error cloning cluster")
+	assert.False(t, assignPublicIP, "Expected tasks in an existing VPC not to be assigned a public IP")
+}
+
+func TestClusterCloneToExistingStack(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	mockCloudformation.EXPECT().ValidateStackExists(clusterName + "-green").Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-clone", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.CloneToFlag, clusterName+"-green", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	_, _, err = cloneCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error cloning to a cluster with a stack")
+}
+
+func TestClusterCloneToSameCluster(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	flagSet := flag.NewFlagSet("ecs-cli-clone", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.CloneToFlag, clusterName, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	_, _, err = cloneCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error cloning a cluster to itself")
+}
+
+func TestCloneUserData(t *testing.T) {
+	userData, err := cloneUserData("echo ECS_CLUSTER=dev >> /etc/ecs/ecs.config\necho ECS_CLUSTER=dev-old >> /etc/ecs/ecs.config", "dev", "dev-green")
+	assert.NoError(t, err, "Unexpected error cloning user data")
+	assert.Equal(t, "echo ECS_CLUSTER=dev-green >> /etc/ecs/ecs.config\necho ECS_CLUSTER=dev-old >> /etc/ecs/ecs.config", userData)
+
+	_, err = cloneUserData("#!/bin/bash\n", "dev", "dev-green")
+	assert.Error(t, err, "Expected error for user data without the cluster")
+}
@@ -16,33 +16,55 @@ package cluster
 import (
 	"bufio"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster/userdata"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/container"
 	ecscontext "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/task"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/servicediscovery"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/dynamodb"
 	ec2client "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2"
 	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	iamclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/interrupt"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/notify"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
 	"github.com/aws/aws-sdk-go/aws"
 	sdkCFN "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/docker/libcompose/project"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
 )
 
 // user data builder can be easily mocked in tests
 var newUserDataBuilder func(string, []*ecs.Tag) userdata.UserDataBuilder = userdata.NewBuilder
 
+// sleep and now can be replaced in tests
+var sleep = time.Sleep
+var now = time.Now
+
+// IAM client and the answers to prompts can be easily mocked in tests
+var newIAMClient = iamclient.NewIAMClient
+var promptInput io.Reader = os.Stdin
+
 // displayTitle flag is used to print the title for the fields
 const displayTitle = true
 
@@ -53,47 +75,105 @@ const (
 )
 
 const (
-	ParameterKeyAsgMaxSize               = "AsgMaxSize"
-	ParameterKeyVPCAzs                   = "VpcAvailabilityZones"
-	ParameterKeySecurityGroup            = "SecurityGroupIds"
-	ParameterKeySourceCidr               = "SourceCidr"
-	ParameterKeyEcsPort                  = "EcsPort"
-	ParameterKeySubnetIds                = "SubnetIds"
-	ParameterKeyVpcId                    = "VpcId"
-	ParameterKeyInstanceType             = "EcsInstanceType"
-	ParameterKeyKeyPairName              = "KeyName"
-	ParameterKeyCluster                  = "EcsCluster"
-	ParameterKeyAmiId                    = "EcsAmiId"
-	ParameterKeyAssociatePublicIPAddress = "AssociatePublicIpAddress"
-	ParameterKeyIsIMDSv2                 = "IsIMDSv2"
-	ParameterKeyInstanceRole             = "InstanceRole"
-	ParameterKeyIsFargate                = "IsFargate"
-	ParameterKeyUserData                 = "UserData"
-	ParameterKeySpotPrice                = "SpotPrice"
+	ParameterKeyAsgMaxSize                = "AsgMaxSize"
+	ParameterKeyAsgDesiredCapacity        = "AsgDesiredCapacity"
+	ParameterKeyVPCAzs                    = "VpcAvailabilityZones"
+	ParameterKeySecurityGroup             = "SecurityGroupIds"
+	ParameterKeySourceCidr                = "SourceCidr"
+	ParameterKeyEcsPort                   = "EcsPort"
+	ParameterKeySubnetIds                 = "SubnetIds"
+	ParameterKeyVpcId                     = "VpcId"
+	ParameterKeyInstanceType              = "EcsInstanceType"
+	ParameterKeyKeyPairName               = "KeyName"
+	ParameterKeyCluster                   = "EcsCluster"
+	ParameterKeyAmiId                     = "EcsAmiId"
+	ParameterKeyAssociatePublicIPAddress  = "AssociatePublicIpAddress"
+	ParameterKeyIsIMDSv2                  = "IsIMDSv2"
+	ParameterKeyInstanceRole              = "InstanceRole"
+	ParameterKeyIsFargate                 = "IsFargate"
+	ParameterKeyUserData                  = "UserData"
+	ParameterKeySpotPrice                 = "SpotPrice"
+	ParameterKeyCapacityReservationId     = "CapacityReservationId"
+	ParameterKeyCreateCapacityReservation = "CreateCapacityReservation"
+	ParameterKeyCapacityReservationAz     = "CapacityReservationAz"
+	ParameterKeyPlacementGroupStrategy    = "PlacementGroupStrategy"
+	ParameterKeyTenancy                   = "Tenancy"
+	ParameterKeyHostResourceGroupArn      = "HostResourceGroupArn"
+	ParameterKeyLicenseConfigurationArn   = "LicenseConfigurationArn"
+	ParameterKeyDetailedMonitoring        = "DetailedMonitoring"
+	ParameterKeyMetadataHopLimit          = "MetadataHopLimit"
+	ParameterKeyInstanceMetadataTags      = "InstanceMetadataTags"
+	ParameterKeyLaunchTemplateVersion     = "LaunchTemplateVersion"
+	ParameterKeySharedVpcExportName       = "SharedVpcExportName"
+	ParameterKeyCaptureTaskEvents         = "CaptureTaskEvents"
+	ParameterKeyCapacityProvider          = "CapacityProvider"
+	ParameterKeyManagedScaling            = "ManagedScaling"
+	ParameterKeyManagedTermination        = "ManagedTerminationProtection"
+	ParameterKeyManagedDraining           = "ManagedDraining"
+	ParameterKeyInstanceWarmupPeriod      = "InstanceWarmupPeriod"
 )
 
 const (
-	invalidInstanceTypeFmt     = "instance type %s not found in list of supported instance types %s"
-	instanceTypeUnsupportedFmt = "instance type %s not supported in region %s: %w"
+	invalidInstanceTypeFmt          = "instance type %s not found in list of supported instance types %s"
+	instanceTypeUnsupportedFmt      = "instance type %s not supported in region %s: %w"
+	instanceTypeUnsupportedInAZsFmt = "instance type %s not supported in availability zones %s"
+	instanceTypeSuggestionsFmt      = "%w; comparable supported instance types: %s"
+
+	maxInstanceTypeSuggestions = 3
+
+	placementGroupStrategyCluster = "cluster"
+	placementGroupStrategySpread  = "spread"
+
+	tenancyDedicated = "dedicated"
+	tenancyHost      = "host"
+
+	ecsServiceLinkedRoleName = "AWSServiceRoleForECS"
+	ecsServiceName           = "ecs.amazonaws.com"
+
+	minMetadataHopLimit = 1
+	maxMetadataHopLimit = 64
+
+	// latestLaunchTemplateVersion unpins the version of the launch template of the Auto Scaling group
+	latestLaunchTemplateVersion = "latest"
+
+	maxInstanceWarmupPeriod = 10000
+
+	// deleteDrainPollInterval is the interval between checks that the tasks of a cluster being
+	// deleted have stopped and released their network interfaces
+	deleteDrainPollInterval = 15 * time.Second
+
+	// clusterNameTagKey is the ECS managed tag of the network interfaces of awsvpc tasks
+	clusterNameTagKey = "aws:ecs:clusterName"
 )
 
 var flagNamesToStackParameterKeys map[string]string
-var requiredParameters []string = []string{ParameterKeyCluster}
+
+// RequiredParameters are the stack parameters every cluster stack sets
+var RequiredParameters []string = []string{ParameterKeyCluster}
+
+// templateOutput returns where the template is printed for cluster up --dry-run; can be replaced in tests
+var templateOutput = progress.Output
 
 func init() {
 	flagNamesToStackParameterKeys = map[string]string{
-		flags.AsgMaxSizeFlag:    ParameterKeyAsgMaxSize,
-		flags.VpcAzFlag:         ParameterKeyVPCAzs,
-		flags.SecurityGroupFlag: ParameterKeySecurityGroup,
-		flags.SourceCidrFlag:    ParameterKeySourceCidr,
-		flags.EcsPortFlag:       ParameterKeyEcsPort,
-		flags.SubnetIdsFlag:     ParameterKeySubnetIds,
-		flags.VpcIdFlag:         ParameterKeyVpcId,
-		flags.InstanceTypeFlag:  ParameterKeyInstanceType,
-		flags.KeypairNameFlag:   ParameterKeyKeyPairName,
-		flags.ImageIdFlag:       ParameterKeyAmiId,
-		flags.InstanceRoleFlag:  ParameterKeyInstanceRole,
-		flags.SpotPriceFlag:     ParameterKeySpotPrice,
+		flags.AsgMaxSizeFlag:              ParameterKeyAsgMaxSize,
+		flags.VpcAzFlag:                   ParameterKeyVPCAzs,
+		flags.SecurityGroupFlag:           ParameterKeySecurityGroup,
+		flags.SourceCidrFlag:              ParameterKeySourceCidr,
+		flags.EcsPortFlag:                 ParameterKeyEcsPort,
+		flags.SubnetIdsFlag:               ParameterKeySubnetIds,
+		flags.VpcIdFlag:                   ParameterKeyVpcId,
+		flags.InstanceTypeFlag:            ParameterKeyInstanceType,
+		flags.KeypairNameFlag:             ParameterKeyKeyPairName,
+		flags.ImageIdFlag:                 ParameterKeyAmiId,
+		flags.InstanceRoleFlag:            ParameterKeyInstanceRole,
+		flags.SpotPriceFlag:               ParameterKeySpotPrice,
+		flags.CapacityReservationIdFlag:   ParameterKeyCapacityReservationId,
+		flags.PlacementGroupStrategyFlag:  ParameterKeyPlacementGroupStrategy,
+		flags.TenancyFlag:                 ParameterKeyTenancy,
+		flags.HostResourceGroupArnFlag:    ParameterKeyHostResourceGroupArn,
+		flags.LicenseConfigurationArnFlag: ParameterKeyLicenseConfigurationArn,
+		flags.MetadataHopLimitFlag:        ParameterKeyMetadataHopLimit,
 	}
 }
 
@@ -104,7 +184,8 @@ type AWSClients struct {
 	EC2Client         ec2client.EC2Client
 }
 
-func newAWSClients(commandConfig *config.CommandConfig) *AWSClients {
+// NewAWSClients returns the clients of the AWS services the cluster commands use
+func NewAWSClients(commandConfig *config.CommandConfig) *AWSClients {
 	ecsClient := ecsclient.NewECSClient(commandConfig)
 	cfnClient := cloudformation.NewCloudformationClient(commandConfig)
 	metadataClient := amimetadata.NewMetadataClient(commandConfig)
@@ -127,22 +208,46 @@ func ClusterUp(c *cli.Context) {
 		logrus.Fatal("Error executing 'up': ", err)
 	}
 
-	awsClients := newAWSClients(commandConfig)
+	awsClients := NewAWSClients(commandConfig)
+
+	// A dry run changes nothing, so there is nothing to notify about
+	notifier := commandConfig.Notifier
+	if c.Bool(flags.DryRunFlag) {
+		notifier = nil
+	}
 
+	progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusStarted, "up")
+	stopInterruptHandling := func() {}
+	if !c.Bool(flags.DryRunFlag) {
+		operation := stackOperation("Creation", commandConfig)
+		operation.Rollback = func() error {
+			return cloudformation.NewCloudformationClient(commandConfig.Ungated()).DeleteStack(commandConfig.CFNStackName)
+		}
+		operation.RollbackDescription = "delete the stack"
+		stopInterruptHandling = interrupt.Handle(operation)
+	}
+	startedAt := time.Now()
 	err = createCluster(c, awsClients, commandConfig)
+	stopInterruptHandling()
+	notifyCluster(notifier, commandConfig, notify.EventClusterUp, startedAt, err)
 	if err != nil {
+		progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusFailed, err.Error())
 		logrus.Fatal("Error executing 'up': ", err)
 	}
+	progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusComplete, "up")
 
-	if !c.Bool(flags.EmptyFlag) {
-		// Displays resources create by CloudFormation, as a convenience for tasks launched
+	if !c.Bool(flags.EmptyFlag) && !c.Bool(flags.DryRunFlag) {
+		// Displays resources used by the cluster, as a convenience for tasks launched
 		// with Task Networking or in Fargate mode.
-		if err := awsClients.CFNClient.DescribeNetworkResources(commandConfig.CFNStackName); err != nil {
+		// Subnets created by the stack or of the default VPC are public, so tasks need a public IP to pull images.
+		assignPublicIP := c.String(flags.VpcIdFlag) == ""
+		if err := DisplayStackOutputs(progress.Output(), awsClients.CFNClient, commandConfig.CFNStackName, assignPublicIP); err != nil {
 			logrus.Error("Error describing Cloudformation resources: ", err)
 		}
+		RecordClusterStack(c, commandConfig, "up", commandConfig.CFNStackName, commandConfig.Cluster, dynamodb.StackStatusActive)
 	}
 
-	fmt.Println("Cluster creation succeeded.")
+	fmt.Fprintln(progress.Output(), "Cluster creation succeeded.")
 }
 
 func ClusterDown(c *cli.Context) {
@@ -156,11 +261,62 @@ func ClusterDown(c *cli.Context) {
 		logrus.Fatal("Error executing 'down': ", err)
 	}
 
-	awsClients := newAWSClients(commandConfig)
+	awsClients := NewAWSClients(commandConfig)
 
-	if err := deleteCluster(c, awsClients, commandConfig); err != nil {
+	progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusStarted, "down")
+	// a deletion cannot be undone, so it can only be left running
+	stopInterruptHandling := interrupt.Handle(stackOperation("Deletion", commandConfig))
+	startedAt := time.Now()
+	err = deleteCluster(c, awsClients, commandConfig)
+	stopInterruptHandling()
+	notifyCluster(commandConfig.Notifier, commandConfig, notify.EventClusterDown, startedAt, err)
+	if err != nil {
+		progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusFailed, err.Error())
 		logrus.Fatal("Error executing 'down': ", err)
 	}
+	progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusComplete, "down")
+	RecordClusterStack(c, commandConfig, "down", commandConfig.CFNStackName, commandConfig.Cluster, dynamodb.StackStatusDeleted)
+}
+
+// stackOperation returns the operation on the stack of the cluster, to handle the interrupts of the
+// command. If the command is interrupted, the status of the stack is described with an ungated client.
+func stackOperation(description string, commandConfig *config.CommandConfig) *interrupt.Operation {
+	stackName := commandConfig.CFNStackName
+	return &interrupt.Operation{
+		Description: fmt.Sprintf("%s of the CloudFormation stack '%s'", description, stackName),
+		Status: func() (string, error) {
+			return stackStatus(cloudformation.NewCloudformationClient(commandConfig.Ungated()), stackName)
+		},
+	}
+}
+
+// stackStatus returns the status of the stack, and how to follow its events
+func stackStatus(cfnClient cloudformation.CloudformationClient, stackName string) (string, error) {
+	output, err := cfnClient.DescribeStacks(stackName)
+	if err != nil {
+		return "", err
+	}
+	if len(output.Stacks) == 0 {
+		return fmt.Sprintf("The CloudFormation stack '%s' does not exist.", stackName), nil
+	}
+	return fmt.Sprintf("The CloudFormation stack '%s' is %s. Follow it with 'aws cloudformation describe-stack-events --stack-name %s'.",
+		stackName, aws.StringValue(output.Stacks[0].StackStatus), stackName), nil
+}
+
+// notifyCluster sends a notification of the completion of a cluster up or down started at startedAt
+func notifyCluster(notifier *notify.Notifier, commandConfig *config.CommandConfig, eventType string, startedAt time.Time, err error) {
+	event := &notify.Event{
+		Type:     eventType,
+		Status:   notify.StatusSucceeded,
+		Cluster:  commandConfig.Cluster,
+		Region:   commandConfig.Region(),
+		Duration: time.Since(startedAt).Seconds(),
+	}
+	if err != nil {
+		event.Status = notify.StatusFailed
+		event.Error = err.Error()
+	}
+	notifier.Notify(event)
 }
 
 func ClusterScale(c *cli.Context) {
@@ -174,11 +330,23 @@ func ClusterScale(c *cli.Context) {
 		logrus.Fatal("Error executing 'scale': ", err)
 	}
 
-	awsClients := newAWSClients(commandConfig)
+	awsClients := NewAWSClients(commandConfig)
 
-	if err := scaleCluster(c, awsClients, commandConfig); err != nil {
+	progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusStarted, "scale")
+	operation := stackOperation("Update", commandConfig)
+	operation.Rollback = func() error {
+		return cloudformation.NewCloudformationClient(commandConfig.Ungated()).CancelUpdateStack(commandConfig.CFNStackName)
+	}
+	operation.RollbackDescription = "cancel the update, which restores the previous size"
+	stopInterruptHandling := interrupt.Handle(operation)
+	err = scaleCluster(c, awsClients, commandConfig)
+	stopInterruptHandling()
+	if err != nil {
+		progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusFailed, err.Error())
 		logrus.Fatal("Error executing 'scale': ", err)
 	}
+	progress.Emit(progress.PhaseCluster, commandConfig.Cluster, progress.StatusComplete, "scale")
+	RecordClusterStack(c, commandConfig, "scale", commandConfig.CFNStackName, commandConfig.Cluster, dynamodb.StackStatusActive)
 }
 
 func ClusterPS(c *cli.Context) {
@@ -191,7 +359,26 @@ func ClusterPS(c *cli.Context) {
 	if err != nil {
 		logrus.Fatal("Error executing 'ps': ", err)
 	}
-	os.Stdout.WriteString(infoSet.String(container.ContainerInfoColumns, displayTitle))
+	os.Stdout.WriteString(infoSet.String(container.InfoColumns(infoSet), displayTitle))
+}
+
+func ClusterStacks(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'stacks': ", err)
+	}
+
+	commandConfig, err := newCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'stacks': ", err)
+	}
+
+	cfnClient := cloudformation.NewCloudformationClient(commandConfig)
+	stacks, err := clusterStacks(cfnClient, commandConfig)
+	if err != nil {
+		logrus.Fatal("Error executing 'stacks': ", err)
+	}
+	printStacks(os.Stdout, stacks)
 }
 
 ///////////////////////
@@ -208,10 +395,37 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 
 	// Check if cluster is specified
 	if commandConfig.Cluster == "" {
-		return clusterNotSetError()
+		return ClusterNotSetError()
+	}
+
+	dryRun := context.Bool(flags.DryRunFlag)
+	templateFormat := context.String(flags.TemplateFormatFlag)
+	if templateFormat != "" && !dryRun {
+		return fmt.Errorf("--%s can only be specified with --%s", flags.TemplateFormatFlag, flags.DryRunFlag)
+	}
+	if templateFormat != "" && templateFormat != cloudformation.TemplateFormatJSON && templateFormat != cloudformation.TemplateFormatYAML {
+		return fmt.Errorf("Invalid value for --%s: '%s'; must be one of: %s, %s", flags.TemplateFormatFlag, templateFormat, cloudformation.TemplateFormatJSON, cloudformation.TemplateFormatYAML)
+	}
+
+	scheduledActions, err := parseScheduledScaling(context.String(flags.ScheduledScalingFlag))
+	if err != nil {
+		return err
+	}
+
+	capabilities, err := stackCapabilities(context)
+	if err != nil {
+		return err
 	}
 
 	if context.Bool(flags.EmptyFlag) {
+		if dryRun {
+			return fmt.Errorf("--%s cannot be specified with --%s, since no CloudFormation stack is created for an empty cluster", flags.DryRunFlag, flags.EmptyFlag)
+		}
+		for _, flag := range []string{flags.CaptureTaskEventsFlag, flags.CapacityProviderFlag} {
+			if context.Bool(flag) {
+				return fmt.Errorf("--%s cannot be specified with --%s, since no CloudFormation stack is created for an empty cluster", flag, flags.EmptyFlag)
+			}
+		}
 		err = createEmptyCluster(context, ecsClient, cfnClient, commandConfig)
 		if err != nil {
 			return err
@@ -230,10 +444,18 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 			return err
 		}
 		// Display warning if keypair not specified
-		if context.String(flags.KeypairNameFlag) == "" {
-			logrus.Warn("You will not be able to SSH into your EC2 instances without a key pair.")
+		if context.Bool(flags.UseInstanceConnectFlag) {
+			if context.String(flags.KeypairNameFlag) != "" {
+				return fmt.Errorf("You can only specify '--%s' or '--%s'", flags.KeypairNameFlag, flags.UseInstanceConnectFlag)
+			}
+		} else if context.String(flags.KeypairNameFlag) == "" {
+			logrus.Warnf("You will not be able to SSH into your EC2 instances without a key pair, unless you specify '--%s'.", flags.UseInstanceConnectFlag)
 		}
 
+	} else if context.Bool(flags.UseInstanceConnectFlag) {
+		return fmt.Errorf("You can only specify '--%s' with the EC2 launch type", flags.UseInstanceConnectFlag)
+	} else if context.Bool(flags.GPUAttributesFlag) {
+		return fmt.Errorf("You can only specify '--%s' with the EC2 launch type", flags.GPUAttributesFlag)
 	}
 
 	// Check if cfn stack already exists
@@ -284,10 +506,48 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 		cfnParams.Add(ParameterKeyIsIMDSv2, "true")
 	}
 
+	if context.Bool(flags.InstanceMetadataTagsFlag) {
+		cfnParams.Add(ParameterKeyInstanceMetadataTags, "true")
+	}
+
+	if context.Bool(flags.EnableDetailedMonitoringFlag) {
+		cfnParams.Add(ParameterKeyDetailedMonitoring, "true")
+	}
+
+	if context.Bool(flags.CaptureTaskEventsFlag) {
+		cfnParams.Add(ParameterKeyCaptureTaskEvents, "true")
+	}
+
+	if err := addCapacityProviderParams(context, cfnParams); err != nil {
+		return err
+	}
+
 	if launchType == config.LaunchTypeFargate {
 		cfnParams.Add(ParameterKeyIsFargate, "true")
 	}
 
+	if context.Bool(flags.CreateCapacityReservationFlag) {
+		cfnParams.Add(ParameterKeyCreateCapacityReservation, "true")
+	}
+
+	if context.Bool(flags.UseDefaultVpcFlag) {
+		if err := useDefaultVpc(cfnParams, awsClients.EC2Client); err != nil {
+			return err
+		}
+	}
+
+	if sourceStackName := context.String(flags.ShareVpcFromStackFlag); sourceStackName != "" {
+		if context.Bool(flags.UseDefaultVpcFlag) {
+			return fmt.Errorf("You can only specify '--%s' or '--%s'", flags.UseDefaultVpcFlag, flags.ShareVpcFromStackFlag)
+		}
+		if sourceStackName == stackName {
+			return fmt.Errorf("The '--%s' stack must belong to a different cluster", flags.ShareVpcFromStackFlag)
+		}
+		if err := ShareVpcFromStack(cfnParams, cfnClient, sourceStackName); err != nil {
+			return err
+		}
+	}
+
 	// Check if vpc and AZs are not both specified.
 	if validateMutuallyExclusiveParams(cfnParams, ParameterKeyVPCAzs, ParameterKeyVpcId) {
 		return fmt.Errorf("You can only specify '--%s' or '--%s'", flags.VpcIdFlag, flags.VpcAzFlag)
@@ -298,6 +558,50 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 		return fmt.Errorf("You can only specify '--%s' with the EC2 launch type", flags.UserDataFlag)
 	}
 
+	// Check that options for the container instances are not specified with Fargate
+	for _, param := range []struct{ key, flag string }{
+		{ParameterKeyCapacityReservationId, flags.CapacityReservationIdFlag},
+		{ParameterKeyCreateCapacityReservation, flags.CreateCapacityReservationFlag},
+		{ParameterKeyPlacementGroupStrategy, flags.PlacementGroupStrategyFlag},
+		{ParameterKeyTenancy, flags.TenancyFlag},
+		{ParameterKeyHostResourceGroupArn, flags.HostResourceGroupArnFlag},
+		{ParameterKeyLicenseConfigurationArn, flags.LicenseConfigurationArnFlag},
+		{ParameterKeyDetailedMonitoring, flags.EnableDetailedMonitoringFlag},
+		{ParameterKeyMetadataHopLimit, flags.MetadataHopLimitFlag},
+		{ParameterKeyInstanceMetadataTags, flags.InstanceMetadataTagsFlag},
+		{ParameterKeyCapacityProvider, flags.CapacityProviderFlag},
+	} {
+		if validateMutuallyExclusiveParams(cfnParams, ParameterKeyIsFargate, param.key) {
+			return fmt.Errorf("You can only specify '--%s' with the EC2 launch type", param.flag)
+		}
+	}
+
+	// Check if an existing capacity reservation and a new one are not both specified
+	if validateMutuallyExclusiveParams(cfnParams, ParameterKeyCapacityReservationId, ParameterKeyCreateCapacityReservation) {
+		return fmt.Errorf("You can only specify '--%s' or '--%s'", flags.CapacityReservationIdFlag, flags.CreateCapacityReservationFlag)
+	}
+
+	// Check that spot instances are not launched into a capacity reservation
+	if validateMutuallyExclusiveParams(cfnParams, ParameterKeySpotPrice, ParameterKeyCapacityReservationId) ||
+		validateMutuallyExclusiveParams(cfnParams, ParameterKeySpotPrice, ParameterKeyCreateCapacityReservation) {
+		return fmt.Errorf("You cannot specify '--%s' with a capacity reservation", flags.SpotPriceFlag)
+	}
+
+	// Check the placement group strategy
+	if param, err := cfnParams.GetParameter(ParameterKeyPlacementGroupStrategy); err == nil {
+		if strategy := aws.StringValue(param.ParameterValue); strategy != placementGroupStrategyCluster && strategy != placementGroupStrategySpread {
+			return fmt.Errorf("Invalid value '%s' for '--%s'. Valid values are %s and %s", strategy, flags.PlacementGroupStrategyFlag, placementGroupStrategyCluster, placementGroupStrategySpread)
+		}
+	}
+
+	if err := validateTenancy(cfnParams); err != nil {
+		return err
+	}
+
+	if err := validateMetadataOptions(cfnParams); err != nil {
+		return err
+	}
+
 	// Check if 2 AZs are specified
 	if validateCommaSeparatedParam(cfnParams, ParameterKeyVPCAzs, 2, 2) {
 		return fmt.Errorf("You must specify 2 comma-separated availability zones with the '--%s' flag", flags.VpcAzFlag)
@@ -328,37 +632,74 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 		if err != nil {
 			return err
 		}
-		supportedInstanceTypes, err := awsClients.EC2Client.DescribeInstanceTypeOfferings(commandConfig.Region())
+		availabilityZones, err := StackAvailabilityZones(cfnParams, awsClients.EC2Client)
 		if err != nil {
-			return fmt.Errorf("describe instance type offerings: %w", err)
+			return err
+		}
+		if err = ValidateInstanceTypeOfferings(instanceType, availabilityZones, awsClients.EC2Client, commandConfig.Region()); err != nil {
+			return err
 		}
 
-		if err = validateInstanceType(instanceType, supportedInstanceTypes); err != nil {
-			// if we detect the default value is unsupported then we'll suggest to the user overriding the value with the appropriate flag
-			if instanceType == cloudformation.DefaultECSInstanceType {
-				logrus.Warnf("Default instance type %s not supported in region %s. Override the default instance type with the --%s flag and provide a supported value.",
-					instanceType, commandConfig.Region(), flags.InstanceTypeFlag)
+		// A capacity reservation created by the stack is in the availability zone of the first subnet, which
+		// is not known to CloudFormation for existing subnets
+		if _, err := cfnParams.GetParameter(ParameterKeyCreateCapacityReservation); err == nil {
+			if _, err := cfnParams.GetParameter(ParameterKeySubnetIds); err == nil && len(availabilityZones) > 0 {
+				cfnParams.Add(ParameterKeyCapacityReservationAz, availabilityZones[0])
 			}
-			return fmt.Errorf(instanceTypeUnsupportedFmt, instanceType, commandConfig.Region(), err)
 		}
 
 		// Check if image id was supplied, else populate
-		_, err = cfnParams.GetParameter(ParameterKeyAmiId)
+		imageID, err := cfnParams.GetParameter(ParameterKeyAmiId)
 		if err == cloudformation.ParameterNotFoundError {
-			err := populateAMIID(cfnParams, metadataClient)
+			err := PopulateAMIID(cfnParams, metadataClient)
 			if err != nil {
 				return err
 			}
 		} else if err != nil {
 			return err
+		} else if context.Bool(flags.GPUAttributesFlag) {
+			// the recommended AMI of a GPU instance type is the GPU variant, but a given one may not be
+			if err := validateGPUImage(aws.StringValue(imageID.ParameterValue), awsClients.EC2Client); err != nil {
+				return err
+			}
 		}
 	}
 	if err := cfnParams.Validate(); err != nil {
 		return err
 	}
+	if err := validateScheduledActions(scheduledActions, cfnParams); err != nil {
+		return err
+	}
+
+	// Build and validate the cfn template before creating any resources
+	template, err := cloudformation.NewClusterTemplate(tags, stackName)
+	if err != nil {
+		return errors.Wrapf(err, "Error building cloudformation template")
+	}
+	cloudformation.AddScheduledActions(template, scheduledActions)
+	if extraTemplateFile := context.String(flags.ExtraTemplateFileFlag); extraTemplateFile != "" {
+		if err := addExtraTemplate(template, extraTemplateFile); err != nil {
+			return err
+		}
+	}
+	templateBody, err := template.String()
+	if err != nil {
+		return errors.Wrapf(err, "Error building cloudformation template")
+	}
+	if err := cfnClient.ValidateTemplate(templateBody, capabilities); err != nil {
+		return err
+	}
+
+	if err := ensureECSServiceLinkedRole(context, newIAMClient(commandConfig), dryRun); err != nil {
+		return err
+	}
+
+	if dryRun {
+		return printTemplate(template, templateFormat)
+	}
 
 	// Create ECS cluster
-	if _, err := ecsClient.CreateCluster(commandConfig.Cluster, tags); err != nil {
+	if _, err := createECSCluster(context, ecsClient, commandConfig.Cluster, tags); err != nil {
 		return err
 	}
 
@@ -373,12 +714,7 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 		}
 	}
 	// Create cfn stack
-	template, err := cloudformation.GetClusterTemplate(tags, stackName)
-	if err != nil {
-		return errors.Wrapf(err, "Error building cloudformation template")
-	}
-
-	if _, err := cfnClient.CreateStack(template, stackName, true, cfnParams, convertToCFNTags(tags)); err != nil {
+	if _, err := cfnClient.CreateStack(templateBody, stackName, capabilities, cfnParams, convertToCFNTags(tags)); err != nil {
 		return err
 	}
 
@@ -387,142 +723,833 @@ func createCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 	return cfnClient.WaitUntilCreateComplete(stackName)
 }
 
-func canEnableContainerInstanceTagging(client ecsclient.ECSClient) (bool, error) {
-	output, err := client.ListAccountSettings(&ecs.ListAccountSettingsInput{
-		EffectiveSettings: aws.Bool(true),
-		Name:              aws.String(ecs.SettingNameContainerInstanceLongArnFormat),
-	})
-	if err != nil {
-		return false, err
+// ensureECSServiceLinkedRole creates the service-linked role of ECS if the account does not have it yet, which
+// is the case for accounts that never created a cluster. Without it, the container instances cannot register and
+// the stack creation fails late with a confusing error. The role is only created with the consent of the user,
+// and a dry run only reports that it is missing. If the role cannot be looked up, e.g. because the credentials
+// are not allowed to read IAM roles, the cluster is created anyway.
+func ensureECSServiceLinkedRole(context *cli.Context, iamClient iamclient.Client, dryRun bool) error {
+	_, err := iamClient.GetRole(ecsServiceLinkedRoleName)
+	if err == nil {
+		return nil
 	}
-
-	// This should never evaluate to true, unless there is a problem with API
-	// This if block ensures that the CLI does not panic in that case
-	if len(output.Settings) < 1 {
-		return false, fmt.Errorf("Received unexpected response from ECS Settings API: %s", output)
+	if !utils.NoSuchEntity(err) {
+		logrus.Warnf("Unable to check whether the ECS service-linked role %s exists: %v", ecsServiceLinkedRoleName, err)
+		return nil
+	}
+	if dryRun {
+		logrus.Warnf("The ECS service-linked role %s does not exist in your account. It will be created before the cluster, if you specify '--%s' or confirm at the prompt.", ecsServiceLinkedRoleName, flags.CreateServiceLinkedRoleFlag)
+		return nil
+	}
+	if !context.Bool(flags.CreateServiceLinkedRoleFlag) {
+		if err := serviceLinkedRolePrompt(bufio.NewReader(promptInput)); err != nil {
+			return err
+		}
 	}
 
-	if aws.StringValue(output.Settings[0].Value) == ecsSettingEnabled {
-		logrus.Warnf("Enabling container instance tagging because %s is enabled for your identity, %s. If this is not your account default setting, your instances will fail to join your cluster. You can use the PutAccountSettingDefault API to change your account default.", ecs.SettingNameContainerInstanceLongArnFormat, aws.StringValue(output.Settings[0].PrincipalArn))
-		return true, nil
+	logrus.Infof("Creating the ECS service-linked role %s...", ecsServiceLinkedRoleName)
+	if _, err := iamClient.CreateServiceLinkedRole(ecsServiceName); err != nil {
+		return errors.Wrapf(err, "Error creating the ECS service-linked role %s", ecsServiceLinkedRoleName)
 	}
+	return nil
+}
 
-	logrus.Warnf("Disabling container instance tagging because %s is not enabled for your identity, %s. You can use the PutAccountSettingDefault API to change your account default.", ecs.SettingNameContainerInstanceLongArnFormat, aws.StringValue(output.Settings[0].PrincipalArn))
-	return false, nil
+// serviceLinkedRolePrompt prompts and checks for consent to create the service-linked role of ECS
+func serviceLinkedRolePrompt(reader *bufio.Reader) error {
+	fmt.Fprintf(progress.Output(), "The ECS service-linked role %s, which allows ECS to manage the resources of your cluster, does not exist in your account. Do you want to create it? [y/N]\n", ecsServiceLinkedRoleName)
+	input, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("Error reading input: %s", err.Error())
+	}
+	formattedInput := strings.ToLower(strings.TrimSpace(input))
+	if formattedInput != "yes" && formattedInput != "y" {
+		return fmt.Errorf("Aborted cluster creation. To create the ECS service-linked role, re-run this command and specify the '--%s' flag or confirm at the prompt.", flags.CreateServiceLinkedRoleFlag)
+	}
+	return nil
 }
 
-func getInstanceType(cfnParams *cloudformation.CfnStackParams) (string, error) {
-	param, err := cfnParams.GetParameter(ParameterKeyInstanceType)
-	if err == cloudformation.ParameterNotFoundError {
-		logrus.Infof("Defaulting instance type to %s", cloudformation.DefaultECSInstanceType)
+// addExtraTemplate adds the resources of the extra template file to the cluster template
+func addExtraTemplate(template *cloudformation.Template, extraTemplateFile string) error {
+	body, err := ioutil.ReadFile(extraTemplateFile)
+	if err != nil {
+		return errors.Wrapf(err, "Error reading extra template file %s", extraTemplateFile)
+	}
+	extra, err := cloudformation.ParseExtraTemplate(body)
+	if err != nil {
+		return err
+	}
+	return cloudformation.AddExtraTemplate(template, extra)
+}
 
-		cfnParams.Add(ParameterKeyInstanceType, cloudformation.DefaultECSInstanceType)
+// parseScheduledScaling parses scheduled actions in the format 'cron(0 8 * * MON-FRI)=5;cron(0 20 * * *)=0'
+func parseScheduledScaling(value string) ([]*cloudformation.ScheduledAction, error) {
+	var actions []*cloudformation.ScheduledAction
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		invalidErr := fmt.Errorf("Invalid value for --%s: '%s'; expected 'cron(<minute> <hour> <day of month> <month> <day of week>)=<number of instances>'", flags.ScheduledScalingFlag, entry)
 
-		return cloudformation.DefaultECSInstanceType, nil
-	} else if err != nil {
-		return "", err
+		separator := strings.LastIndex(entry, "=")
+		if separator == -1 {
+			return nil, invalidErr
+		}
+		schedule := strings.TrimSpace(entry[:separator])
+		if !strings.HasPrefix(schedule, "cron(") || !strings.HasSuffix(schedule, ")") {
+			return nil, invalidErr
+		}
+		recurrence := strings.Join(strings.Fields(schedule[len("cron("):len(schedule)-1]), " ")
+		if len(strings.Fields(recurrence)) != 5 {
+			return nil, invalidErr
+		}
+		desiredCapacity, err := strconv.ParseInt(strings.TrimSpace(entry[separator+1:]), 10, 64)
+		if err != nil || desiredCapacity < 0 {
+			return nil, invalidErr
+		}
+		actions = append(actions, &cloudformation.ScheduledAction{
+			Recurrence:      recurrence,
+			DesiredCapacity: desiredCapacity,
+		})
 	}
-
-	return aws.StringValue(param.ParameterValue), nil
+	return actions, nil
 }
 
-func validateInstanceType(instanceType string, supportedInstanceTypes []string) error {
-	found := false
-	for _, it := range supportedInstanceTypes {
-		if it == instanceType {
-			found = true
-			break
+// validateScheduledActions checks that the scheduled actions do not exceed the maximum size of the Auto Scaling group
+func validateScheduledActions(actions []*cloudformation.ScheduledAction, cfnParams *cloudformation.CfnStackParams) error {
+	maxSize := int64(1) // default of the AsgMaxSize parameter of the template
+	if param, err := cfnParams.GetParameter(ParameterKeyAsgMaxSize); err == nil {
+		if maxSize, err = strconv.ParseInt(aws.StringValue(param.ParameterValue), 10, 64); err != nil {
+			return err
 		}
 	}
-	if !found {
-		return fmt.Errorf(invalidInstanceTypeFmt, instanceType, supportedInstanceTypes)
+	for _, action := range actions {
+		if action.DesiredCapacity > maxSize {
+			return fmt.Errorf("The number of instances %d scheduled at '%s' exceeds the size of your cluster, %d. Please specify a larger '--%s'", action.DesiredCapacity, action.Recurrence, maxSize, flags.AsgMaxSizeFlag)
+		}
 	}
-
 	return nil
 }
 
-func populateAMIID(cfnParams *cloudformation.CfnStackParams, client amimetadata.Client) error {
-	instanceType, err := getInstanceType(cfnParams)
-	if err != nil {
-		return err
-	}
-
-	amiMetadata, err := client.GetRecommendedECSLinuxAMI(instanceType)
+// printTemplate prints the cluster template in the given format, for cluster up --dry-run
+func printTemplate(template *cloudformation.Template, format string) error {
+	body, err := template.Format(format)
 	if err != nil {
 		return err
 	}
-	logrus.Infof("Using recommended %s AMI with ECS Agent %s and %s",
-		amiMetadata.OsName, amiMetadata.AgentVersion, amiMetadata.RuntimeVersion)
-	cfnParams.Add(ParameterKeyAmiId, amiMetadata.ImageID)
+	logrus.Info("Dry run: CloudFormation template is valid; skipping the creation of the cluster and stack")
+	fmt.Fprintln(templateOutput(), strings.TrimSuffix(body, "\n"))
 	return nil
 }
 
-// unfortunately go SDK lacks a unified Tag type
-func convertToCFNTags(tags []*ecs.Tag) []*sdkCFN.Tag {
-	var cfnTags []*sdkCFN.Tag
-	for _, tag := range tags {
-		cfnTags = append(cfnTags, &sdkCFN.Tag{
-			Key:   tag.Key,
-			Value: tag.Value,
-		})
+// useDefaultVpc discovers the default VPC and its default subnets and sets them as the VPC and subnets of the stack
+func useDefaultVpc(cfnParams *cloudformation.CfnStackParams, ec2Client ec2client.EC2Client) error {
+	for _, key := range []string{ParameterKeyVpcId, ParameterKeySubnetIds, ParameterKeyVPCAzs} {
+		if _, err := cfnParams.GetParameter(key); err == nil {
+			return fmt.Errorf("You cannot specify '--%s' with '--%s', '--%s' or '--%s'", flags.UseDefaultVpcFlag, flags.VpcIdFlag, flags.SubnetIdsFlag, flags.VpcAzFlag)
+		}
 	}
-	return cfnTags
-}
 
-var newCommandConfig = func(context *cli.Context, rdwr config.ReadWriter) (*config.CommandConfig, error) {
-	return config.NewCommandConfig(context, rdwr)
+	vpcID, err := ec2Client.GetDefaultVpc()
+	if err != nil {
+		return errors.Wrap(err, "Error finding default VPC")
+	}
+	subnetIDs, err := ec2Client.GetDefaultSubnets(vpcID)
+	if err != nil {
+		return errors.Wrap(err, "Error finding default subnets")
+	}
+
+	logrus.Infof("Using default VPC %s with subnets %s", vpcID, strings.Join(subnetIDs, ","))
+	cfnParams.Add(ParameterKeyVpcId, vpcID)
+	cfnParams.Add(ParameterKeySubnetIds, strings.Join(subnetIDs, ","))
+	return nil
 }
 
-func createEmptyCluster(context *cli.Context, ecsClient ecsclient.ECSClient, cfnClient cloudformation.CloudformationClient, commandConfig *config.CommandConfig) error {
-	for _, flag := range flags.CFNResourceFlags() {
-		if context.String(flag) != "" {
-			logrus.Warnf("Value for flag '%v' will be ignored when creating an empty cluster", flag)
+// ShareVpcFromStack sets the VPC, subnets and security group of the stack to those used by the source stack,
+// which must have been created by the ECS CLI.
+func ShareVpcFromStack(cfnParams *cloudformation.CfnStackParams, cfnClient cloudformation.CloudformationClient, sourceStackName string) error {
+	for _, key := range []string{ParameterKeyVpcId, ParameterKeySubnetIds, ParameterKeyVPCAzs, ParameterKeySecurityGroup} {
+		if _, err := cfnParams.GetParameter(key); err == nil {
+			return fmt.Errorf("You cannot specify '--%s' with '--%s', '--%s', '--%s' or '--%s'", flags.ShareVpcFromStackFlag, flags.VpcIdFlag, flags.SubnetIdsFlag, flags.VpcAzFlag, flags.SecurityGroupFlag)
 		}
 	}
-	if isIAMAcknowledged(context) {
-		logrus.Warnf("The '--%v' flag will be ignored when creating an empty cluster", flags.CapabilityIAMFlag)
-	}
 
-	if isForceSet(context) {
-		logrus.Warn("Force flag is unsupported when creating an empty cluster.")
+	outputs, err := cfnClient.GetStackOutputs(sourceStackName)
+	if err != nil {
+		return errors.Wrapf(err, "Error describing outputs of stack '%s'", sourceStackName)
 	}
-
-	// Check if non-empty cluster with same name already exists
-	stackName := commandConfig.CFNStackName
-	if err := cfnClient.ValidateStackExists(stackName); err == nil {
-		return fmt.Errorf("A CloudFormation stack already exists for the cluster '%s'.", commandConfig.Cluster)
+	vpcID := outputs[cloudformation.OutputKeyVpcId]
+	var subnetIDs []string
+	if subnets := outputs[cloudformation.OutputKeySubnetIds]; subnets != "" {
+		subnetIDs = strings.Split(subnets, ",")
 	}
+	securityGroupID := outputs[cloudformation.OutputKeySecurityGroupId]
 
-	tags := make([]*ecs.Tag, 0)
-	var err error
-	if tagVal := context.String(flags.ResourceTagsFlag); tagVal != "" {
-		tags, err = utils.ParseTags(tagVal, tags)
+	var vpcExportName string
+	if vpcID != "" {
+		exportNames, err := cfnClient.GetStackExportNames(sourceStackName)
+		if err != nil {
+			return errors.Wrapf(err, "Error describing exports of stack '%s'", sourceStackName)
+		}
+		vpcExportName = exportNames[cloudformation.OutputKeyVpcId]
+	} else {
+		// Stacks created by older versions of the ECS CLI have no outputs
+		vpcID, subnetIDs, securityGroupID, err = networkFromStackResources(cfnClient, sourceStackName)
 		if err != nil {
 			return err
 		}
 	}
 
-	if _, err := ecsClient.CreateCluster(commandConfig.Cluster, tags); err != nil {
-		return err
+	if vpcID == "" || len(subnetIDs) == 0 {
+		return fmt.Errorf("Unable to find a VPC and subnets in stack '%s'", sourceStackName)
 	}
 
-	return nil
-}
-
-var deleteCFNStack = func(cfnClient cloudformation.CloudformationClient, commandConfig *config.CommandConfig) error {
-	stackName := commandConfig.CFNStackName
-	if err := cfnClient.DeleteStack(stackName); err != nil {
-		return err
+	logrus.Infof("Using VPC %s with subnets %s from stack %s", vpcID, strings.Join(subnetIDs, ","), sourceStackName)
+	cfnParams.Add(ParameterKeyVpcId, vpcID)
+	cfnParams.Add(ParameterKeySubnetIds, strings.Join(subnetIDs, ","))
+	if securityGroupID != "" {
+		cfnParams.Add(ParameterKeySecurityGroup, securityGroupID)
 	}
 
-	logrus.Info("Waiting for your cluster resources to be deleted...")
-	if err := cfnClient.WaitUntilDeleteComplete(stackName); err != nil {
-		return err
+	// Importing the export of the VPC ID makes CloudFormation refuse to delete the source stack
+	// while this one exists. Stacks created by older versions of the ECS CLI have no exports.
+	if vpcExportName != "" {
+		cfnParams.Add(ParameterKeySharedVpcExportName, vpcExportName)
+		logrus.Infof("The stack %s cannot be deleted while this cluster is using its VPC", sourceStackName)
+	} else {
+		logrus.Warnf("The stack %s cannot be deleted while this cluster is using its VPC", sourceStackName)
 	}
-
 	return nil
 }
 
+// networkFromStackResources returns the VPC, subnets and security group used by a stack which has no outputs.
+func networkFromStackResources(cfnClient cloudformation.CloudformationClient, stackName string) (string, []string, string, error) {
+	resourceIds, err := cfnClient.GetStackResourceIds(stackName)
+	if err != nil {
+		return "", nil, "", errors.Wrapf(err, "Error describing resources of stack '%s'", stackName)
+	}
+	vpcID := resourceIds[cloudformation.VPCLogicalResourceId]
+	var subnetIDs []string
+	for _, logicalID := range []string{cloudformation.Subnet1LogicalResourceId, cloudformation.Subnet2LogicalResourceId} {
+		if id := resourceIds[logicalID]; id != "" {
+			subnetIDs = append(subnetIDs, id)
+		}
+	}
+	securityGroupID := resourceIds[cloudformation.SecurityGroupLogicalResourceId]
+
+	// The stack did not create its own network resources if it was itself launched into
+	// an existing VPC, in which case they are found in its parameters instead
+	if vpcID == "" || securityGroupID == "" {
+		params, err := cfnClient.GetStackParameters(stackName)
+		if err != nil {
+			return "", nil, "", errors.Wrapf(err, "Error describing parameters of stack '%s'", stackName)
+		}
+		for _, param := range params {
+			value := aws.StringValue(param.ParameterValue)
+			switch aws.StringValue(param.ParameterKey) {
+			case ParameterKeyVpcId:
+				if vpcID == "" {
+					vpcID = value
+				}
+			case ParameterKeySubnetIds:
+				if len(subnetIDs) == 0 && value != "" {
+					subnetIDs = strings.Split(value, ",")
+				}
+			case ParameterKeySecurityGroup:
+				if securityGroupID == "" {
+					securityGroupID = value
+				}
+			}
+		}
+	}
+	return vpcID, subnetIDs, securityGroupID, nil
+}
+
+// DisplayStackOutputs prints the outputs of the cluster stack, followed by the network configuration
+// of tasks using task networking in the subnets of the cluster
+func DisplayStackOutputs(out io.Writer, cfnClient cloudformation.CloudformationClient, stackName string, assignPublicIP bool) error {
+	outputs, err := cfnClient.GetStackOutputs(stackName)
+	if err != nil {
+		return err
+	}
+	for _, key := range cloudformation.StackOutputKeys {
+		if value, ok := outputs[key]; ok && value != "" {
+			fmt.Fprintf(out, "%v: %v\n", key, value)
+		}
+	}
+
+	if outputs[cloudformation.OutputKeySubnetIds] == "" {
+		return nil
+	}
+	subnets := splitAndTrim(outputs[cloudformation.OutputKeySubnetIds])
+	var securityGroups []string
+	if value := outputs[cloudformation.OutputKeySecurityGroupId]; value != "" {
+		securityGroups = splitAndTrim(value)
+	}
+	return printNetworkConfiguration(out, subnets, securityGroups, assignPublicIP)
+}
+
+// ecsParamsNetworkConfiguration is the part of an ECS params file that configures task networking
+type ecsParamsNetworkConfiguration struct {
+	Version   int `yaml:"version"`
+	RunParams struct {
+		NetworkConfiguration composeutils.NetworkConfiguration `yaml:"network_configuration"`
+	} `yaml:"run_params"`
+}
+
+// printNetworkConfiguration prints the network configuration of tasks using task networking
+// as an ECS params file and as the equivalent 'aws ecs run-task' option
+func printNetworkConfiguration(out io.Writer, subnets, securityGroups []string, assignPublicIP bool) error {
+	assignPublicIPValue := ecs.AssignPublicIpDisabled
+	if assignPublicIP {
+		assignPublicIPValue = ecs.AssignPublicIpEnabled
+	}
+
+	params := ecsParamsNetworkConfiguration{Version: 1}
+	params.RunParams.NetworkConfiguration.AwsVpcConfiguration = composeutils.AwsVpcConfiguration{
+		Subnets:        subnets,
+		SecurityGroups: securityGroups,
+		AssignPublicIp: composeutils.AssignPublicIp(assignPublicIPValue),
+	}
+	ecsParams, err := yaml.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "\nNetwork configuration for tasks with task networking, in an ECS params file (ecs-params.yml):\n\n%s", ecsParams)
+	fmt.Fprintf(out, "\nor as an 'aws ecs run-task' option:\n\n--network-configuration \"awsvpcConfiguration={subnets=[%s],securityGroups=[%s],assignPublicIp=%s}\"\n",
+		strings.Join(subnets, ","), strings.Join(securityGroups, ","), assignPublicIPValue)
+	return nil
+}
+
+func canEnableContainerInstanceTagging(client ecsclient.ECSClient) (bool, error) {
+	output, err := client.ListAccountSettings(&ecs.ListAccountSettingsInput{
+		EffectiveSettings: aws.Bool(true),
+		Name:              aws.String(ecs.SettingNameContainerInstanceLongArnFormat),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	// This should never evaluate to true, unless there is a problem with API
+	// This if block ensures that the CLI does not panic in that case
+	if len(output.Settings) < 1 {
+		return false, fmt.Errorf("Received unexpected response from ECS Settings API: %s", output)
+	}
+
+	if aws.StringValue(output.Settings[0].Value) == ecsSettingEnabled {
+		logrus.Warnf("Enabling container instance tagging because %s is enabled for your identity, %s. If this is not your account default setting, your instances will fail to join your cluster. You can use the PutAccountSettingDefault API to change your account default.", ecs.SettingNameContainerInstanceLongArnFormat, aws.StringValue(output.Settings[0].PrincipalArn))
+		return true, nil
+	}
+
+	logrus.Warnf("Disabling container instance tagging because %s is not enabled for your identity, %s. You can use the PutAccountSettingDefault API to change your account default.", ecs.SettingNameContainerInstanceLongArnFormat, aws.StringValue(output.Settings[0].PrincipalArn))
+	return false, nil
+}
+
+func getInstanceType(cfnParams *cloudformation.CfnStackParams) (string, error) {
+	param, err := cfnParams.GetParameter(ParameterKeyInstanceType)
+	if err == cloudformation.ParameterNotFoundError {
+		logrus.Infof("Defaulting instance type to %s", cloudformation.DefaultECSInstanceType)
+
+		cfnParams.Add(ParameterKeyInstanceType, cloudformation.DefaultECSInstanceType)
+
+		return cloudformation.DefaultECSInstanceType, nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(param.ParameterValue), nil
+}
+
+func validateInstanceType(instanceType string, supportedInstanceTypes []string) error {
+	found := false
+	for _, it := range supportedInstanceTypes {
+		if it == instanceType {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf(invalidInstanceTypeFmt, instanceType, supportedInstanceTypes)
+	}
+
+	return nil
+}
+
+// addCapacityProviderParams adds the parameters creating a capacity provider for the Auto Scaling
+// group of the cluster, with the managed scaling, managed termination protection, managed draining
+// and instance warmup period specified.
+func addCapacityProviderParams(context *cli.Context, cfnParams *cloudformation.CfnStackParams) error {
+	managedScaling := context.Bool(flags.ManagedScalingFlag)
+	managedTermination := context.Bool(flags.ManagedTerminationFlag)
+	if !context.Bool(flags.CapacityProviderFlag) {
+		for _, flag := range []string{flags.ManagedScalingFlag, flags.ManagedTerminationFlag, flags.ManagedDrainingFlag, flags.InstanceWarmupPeriodFlag} {
+			if context.IsSet(flag) {
+				return fmt.Errorf("--%s requires --%s", flag, flags.CapacityProviderFlag)
+			}
+		}
+		return nil
+	}
+	// ECS only protects the instances running tasks from the scale-in it manages itself
+	if managedTermination && !managedScaling {
+		return fmt.Errorf("--%s requires --%s", flags.ManagedTerminationFlag, flags.ManagedScalingFlag)
+	}
+	// the warmup period only delays the use of the metrics of new instances by managed scaling
+	if context.IsSet(flags.InstanceWarmupPeriodFlag) && !managedScaling {
+		return fmt.Errorf("--%s requires --%s", flags.InstanceWarmupPeriodFlag, flags.ManagedScalingFlag)
+	}
+
+	cfnParams.Add(ParameterKeyCapacityProvider, "true")
+	if managedScaling {
+		cfnParams.Add(ParameterKeyManagedScaling, ecs.ManagedScalingStatusEnabled)
+	}
+	if managedTermination {
+		cfnParams.Add(ParameterKeyManagedTermination, ecs.ManagedTerminationProtectionEnabled)
+	}
+	return addManagedDrainingAndWarmupParams(context, cfnParams)
+}
+
+// addManagedDrainingAndWarmupParams adds the managed draining and the instance warmup period of
+// the capacity provider if they are specified, so that the ECS defaults are used otherwise.
+func addManagedDrainingAndWarmupParams(context *cli.Context, cfnParams *cloudformation.CfnStackParams) error {
+	if context.IsSet(flags.ManagedDrainingFlag) {
+		status := ecs.ManagedDrainingDisabled
+		if context.Bool(flags.ManagedDrainingFlag) {
+			status = ecs.ManagedDrainingEnabled
+		}
+		cfnParams.Add(ParameterKeyManagedDraining, status)
+	}
+	if context.IsSet(flags.InstanceWarmupPeriodFlag) {
+		warmup := context.Int(flags.InstanceWarmupPeriodFlag)
+		if warmup < 0 || warmup > maxInstanceWarmupPeriod {
+			return fmt.Errorf("Invalid value '%d' for '--%s'. The instance warmup period must be a number of seconds from 0 to %d", warmup, flags.InstanceWarmupPeriodFlag, maxInstanceWarmupPeriod)
+		}
+		cfnParams.Add(ParameterKeyInstanceWarmupPeriod, strconv.Itoa(warmup))
+	}
+	return nil
+}
+
+// validateMetadataOptions checks the instance metadata options of the container instances.
+func validateMetadataOptions(cfnParams *cloudformation.CfnStackParams) error {
+	param, err := cfnParams.GetParameter(ParameterKeyMetadataHopLimit)
+	if err != nil {
+		// With IMDSv2 and the default hop limit of 1, containers using bridge networking cannot reach the instance metadata
+		if _, err := cfnParams.GetParameter(ParameterKeyIsIMDSv2); err == nil {
+			logrus.Warnf("Containers using bridge networking will not be able to access the instance metadata with '--%s' unless '--%s' is at least 2", flags.IMDSv2Flag, flags.MetadataHopLimitFlag)
+		}
+		return nil
+	}
+
+	hopLimit, err := strconv.Atoi(aws.StringValue(param.ParameterValue))
+	if err != nil || hopLimit < minMetadataHopLimit || hopLimit > maxMetadataHopLimit {
+		return fmt.Errorf("Invalid value '%s' for '--%s'. The hop limit must be an integer from %d to %d", aws.StringValue(param.ParameterValue), flags.MetadataHopLimitFlag, minMetadataHopLimit, maxMetadataHopLimit)
+	}
+	return nil
+}
+
+// validateTenancy checks the tenancy of the container instances against the options which depend on it.
+func validateTenancy(cfnParams *cloudformation.CfnStackParams) error {
+	tenancy := ""
+	if param, err := cfnParams.GetParameter(ParameterKeyTenancy); err == nil {
+		tenancy = aws.StringValue(param.ParameterValue)
+		if tenancy != tenancyDedicated && tenancy != tenancyHost {
+			return fmt.Errorf("Invalid value '%s' for '--%s'. Valid values are %s and %s", tenancy, flags.TenancyFlag, tenancyDedicated, tenancyHost)
+		}
+	}
+
+	if _, err := cfnParams.GetParameter(ParameterKeyHostResourceGroupArn); err == nil && tenancy != tenancyHost {
+		return fmt.Errorf("You must specify '--%s %s' with '--%s'", flags.TenancyFlag, tenancyHost, flags.HostResourceGroupArnFlag)
+	}
+
+	if tenancy == tenancyHost {
+		if _, err := cfnParams.GetParameter(ParameterKeySpotPrice); err == nil {
+			return fmt.Errorf("You cannot specify '--%s' with '--%s %s'", flags.SpotPriceFlag, flags.TenancyFlag, tenancyHost)
+		}
+		if _, err := cfnParams.GetParameter(ParameterKeyCreateCapacityReservation); err == nil {
+			return fmt.Errorf("You cannot specify '--%s' with '--%s %s'", flags.CreateCapacityReservationFlag, flags.TenancyFlag, tenancyHost)
+		}
+	}
+	return nil
+}
+
+// ValidateInstanceTypeOfferings checks that the instance type is offered in every availability zone the stack
+// launches instances into. When the availability zones are chosen by CloudFormation, the region is checked instead.
+func ValidateInstanceTypeOfferings(instanceType string, availabilityZones []string, ec2Client ec2client.EC2Client, region string) error {
+	if len(availabilityZones) == 0 {
+		supportedInstanceTypes, err := ec2Client.DescribeInstanceTypeOfferings(region)
+		if err != nil {
+			return fmt.Errorf("describe instance type offerings: %w", err)
+		}
+		if err = validateInstanceType(instanceType, supportedInstanceTypes); err != nil {
+			warnDefaultInstanceTypeUnsupported(instanceType, "region "+region)
+			err = fmt.Errorf(instanceTypeUnsupportedFmt, instanceType, region, err)
+			return suggestInstanceTypes(err, instanceType, supportedInstanceTypes, ec2Client)
+		}
+		return nil
+	}
+
+	offerings, err := ec2Client.DescribeInstanceTypeOfferingsByAZ(availabilityZones)
+	if err != nil {
+		return fmt.Errorf("describe instance type offerings: %w", err)
+	}
+	var unsupportedAZs []string
+	for _, az := range availabilityZones {
+		if validateInstanceType(instanceType, offerings[az]) != nil {
+			unsupportedAZs = append(unsupportedAZs, az)
+		}
+	}
+	if len(unsupportedAZs) == 0 {
+		return nil
+	}
+
+	warnDefaultInstanceTypeUnsupported(instanceType, "availability zones "+strings.Join(unsupportedAZs, ", "))
+	// Only instance types offered in all of the availability zones are suggested
+	supportedInstanceTypes := offerings[availabilityZones[0]]
+	for _, az := range availabilityZones[1:] {
+		supportedInstanceTypes = intersectStrings(supportedInstanceTypes, offerings[az])
+	}
+	err = fmt.Errorf(instanceTypeUnsupportedInAZsFmt, instanceType, strings.Join(unsupportedAZs, ", "))
+	return suggestInstanceTypes(err, instanceType, supportedInstanceTypes, ec2Client)
+}
+
+// if we detect the default value is unsupported then we'll suggest to the user overriding the value with the appropriate flag
+func warnDefaultInstanceTypeUnsupported(instanceType, location string) {
+	if instanceType == cloudformation.DefaultECSInstanceType {
+		logrus.Warnf("Default instance type %s not supported in %s. Override the default instance type with the --%s flag and provide a supported value.",
+			instanceType, location, flags.InstanceTypeFlag)
+	}
+}
+
+// StackAvailabilityZones returns the availability zones the stack launches instances into, or nil if
+// they are chosen by CloudFormation.
+func StackAvailabilityZones(cfnParams *cloudformation.CfnStackParams, ec2Client ec2client.EC2Client) ([]string, error) {
+	if param, err := cfnParams.GetParameter(ParameterKeyVPCAzs); err == nil {
+		return splitAndTrim(aws.StringValue(param.ParameterValue)), nil
+	}
+	if param, err := cfnParams.GetParameter(ParameterKeySubnetIds); err == nil {
+		availabilityZones, err := ec2Client.GetSubnetAvailabilityZones(splitAndTrim(aws.StringValue(param.ParameterValue)))
+		if err != nil {
+			return nil, errors.Wrap(err, "Error describing subnets")
+		}
+		return availabilityZones, nil
+	}
+	return nil, nil
+}
+
+// suggestInstanceTypes adds supported instance types comparable to the instance type to the error, if there are any.
+func suggestInstanceTypes(err error, instanceType string, supportedInstanceTypes []string, ec2Client ec2client.EC2Client) error {
+	comparableInstanceTypes, describeErr := ec2Client.GetComparableInstanceTypes(instanceType)
+	if describeErr != nil {
+		logrus.Debugf("Unable to find instance types comparable to %s: %v", instanceType, describeErr)
+		return err
+	}
+
+	var suggestions []string
+	for _, it := range intersectStrings(comparableInstanceTypes, supportedInstanceTypes) {
+		suggestions = append(suggestions, it)
+		if len(suggestions) == maxInstanceTypeSuggestions {
+			break
+		}
+	}
+	if len(suggestions) == 0 {
+		return err
+	}
+	return fmt.Errorf(instanceTypeSuggestionsFmt, err, strings.Join(suggestions, ", "))
+}
+
+// intersectStrings returns the elements of a which are also in b, in the order of a.
+func intersectStrings(a, b []string) []string {
+	inB := make(map[string]bool, len(b))
+	for _, s := range b {
+		inB[s] = true
+	}
+	var intersection []string
+	for _, s := range a {
+		if inB[s] {
+			intersection = append(intersection, s)
+		}
+	}
+	return intersection
+}
+
+func splitAndTrim(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		values = append(values, strings.TrimSpace(v))
+	}
+	return values
+}
+
+// PopulateAMIID sets the AMI of the stack to the recommended ECS-optimized AMI for its instance type
+func PopulateAMIID(cfnParams *cloudformation.CfnStackParams, client amimetadata.Client) error {
+	instanceType, err := getInstanceType(cfnParams)
+	if err != nil {
+		return err
+	}
+
+	amiMetadata, err := client.GetRecommendedECSLinuxAMI(instanceType)
+	if err != nil {
+		return err
+	}
+	logrus.Infof("Using recommended %s AMI with ECS Agent %s and %s",
+		amiMetadata.OsName, amiMetadata.AgentVersion, amiMetadata.RuntimeVersion)
+	cfnParams.Add(ParameterKeyAmiId, amiMetadata.ImageID)
+	return nil
+}
+
+// unfortunately go SDK lacks a unified Tag type
+func convertToCFNTags(tags []*ecs.Tag) []*sdkCFN.Tag {
+	var cfnTags []*sdkCFN.Tag
+	for _, tag := range tags {
+		cfnTags = append(cfnTags, &sdkCFN.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return cfnTags
+}
+
+// ConvertFromCFNTags converts the tags of a stack to ECS tags
+func ConvertFromCFNTags(cfnTags []*sdkCFN.Tag) []*ecs.Tag {
+	tags := make([]*ecs.Tag, 0)
+	for _, tag := range cfnTags {
+		tags = append(tags, &ecs.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return tags
+}
+
+var newCommandConfig = func(context *cli.Context, rdwr config.ReadWriter) (*config.CommandConfig, error) {
+	return config.NewCommandConfig(context, rdwr)
+}
+
+func createEmptyCluster(context *cli.Context, ecsClient ecsclient.ECSClient, cfnClient cloudformation.CloudformationClient, commandConfig *config.CommandConfig) error {
+	for _, flag := range flags.CFNResourceFlags() {
+		if context.String(flag) != "" {
+			logrus.Warnf("Value for flag '%v' will be ignored when creating an empty cluster", flag)
+		}
+	}
+	if isIAMAcknowledged(context) {
+		logrus.Warnf("The '--%v' flag will be ignored when creating an empty cluster", flags.CapabilityIAMFlag)
+	}
+	if context.String(flags.CapabilitiesFlag) != "" {
+		logrus.Warnf("The '--%v' flag will be ignored when creating an empty cluster", flags.CapabilitiesFlag)
+	}
+	if context.String(flags.ExtraTemplateFileFlag) != "" {
+		logrus.Warnf("The '--%v' flag will be ignored when creating an empty cluster", flags.ExtraTemplateFileFlag)
+	}
+	if context.Bool(flags.UseDefaultVpcFlag) {
+		logrus.Warnf("The '--%v' flag will be ignored when creating an empty cluster", flags.UseDefaultVpcFlag)
+	}
+
+	if isForceSet(context) {
+		logrus.Warn("Force flag is unsupported when creating an empty cluster.")
+	}
+
+	// Check if non-empty cluster with same name already exists
+	stackName := commandConfig.CFNStackName
+	if err := cfnClient.ValidateStackExists(stackName); err == nil {
+		return fmt.Errorf("A CloudFormation stack already exists for the cluster '%s'.", commandConfig.Cluster)
+	}
+
+	tags := make([]*ecs.Tag, 0)
+	var err error
+	if tagVal := context.String(flags.ResourceTagsFlag); tagVal != "" {
+		tags, err = utils.ParseTags(tagVal, tags)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := ensureECSServiceLinkedRole(context, newIAMClient(commandConfig), false); err != nil {
+		return err
+	}
+
+	if _, err := createECSCluster(context, ecsClient, commandConfig.Cluster, tags); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// createECSCluster creates the ECS cluster, with the default Service Connect namespace of --service-connect-namespace
+func createECSCluster(context *cli.Context, ecsClient ecsclient.ECSClient, cluster string, tags []*ecs.Tag) (string, error) {
+	if namespace := context.String(flags.ServiceConnectNamespaceFlag); namespace != "" {
+		return ecsClient.CreateClusterWithServiceConnectNamespace(cluster, tags, namespace)
+	}
+	return ecsClient.CreateCluster(cluster, tags)
+}
+
+// waitForClusterToDrain waits until the cluster has no running or pending tasks, and the network
+// interfaces of its awsvpc tasks have been released.
+func waitForClusterToDrain(ecsClient ecsclient.ECSClient, ec2Client ec2client.EC2Client, cluster string, timeout time.Duration) error {
+	for elapsed := time.Duration(0); ; elapsed += deleteDrainPollInterval {
+		running, pending, err := ecsClient.GetClusterTaskCounts(cluster)
+		if err != nil {
+			return err
+		}
+		var networkInterfaces []*ec2.NetworkInterface
+		if running+pending == 0 {
+			networkInterfaces, err = ec2Client.DescribeNetworkInterfacesByFilter("tag:"+clusterNameTagKey, cluster)
+			if err != nil {
+				return err
+			}
+			if len(networkInterfaces) == 0 {
+				return nil
+			}
+		}
+
+		var waitingFor string
+		if running+pending > 0 {
+			waitingFor = fmt.Sprintf("%d running and %d pending tasks to stop", running, pending)
+		} else {
+			waitingFor = fmt.Sprintf("%d task network interfaces to be released", len(networkInterfaces))
+		}
+		if elapsed >= timeout {
+			return fmt.Errorf("Timed out waiting for %s before deleting your cluster resources. Stop the services and tasks of the cluster, or specify a '--%s' of 0 to delete them without waiting", waitingFor, flags.DrainTimeoutFlag)
+		}
+		logrus.Infof("Waiting for %s...", waitingFor)
+		sleep(deleteDrainPollInterval)
+	}
+}
+
+// maxDeleteStackAttempts is the number of times the deletion of the cluster stack is attempted when
+// the network interfaces blocking it are deleted
+const maxDeleteStackAttempts = 3
+
+var deleteCFNStack = func(context *cli.Context, awsClients *AWSClients, commandConfig *config.CommandConfig) error {
+	cfnClient := awsClients.CFNClient
+	stackName := commandConfig.CFNStackName
+	for attempt := 1; ; attempt++ {
+		if err := cfnClient.DeleteStack(stackName); err != nil {
+			return err
+		}
+
+		logrus.Info("Waiting for your cluster resources to be deleted...")
+		err := cfnClient.WaitUntilDeleteComplete(stackName)
+		if err == nil {
+			return nil
+		}
+
+		// Network interfaces left behind by awsvpc tasks prevent the deletion of the security
+		// group, subnets and VPC of the cluster
+		networkInterfaces, findErr := blockingNetworkInterfaces(cfnClient, awsClients.EC2Client, stackName)
+		if findErr != nil {
+			logrus.Debugf("Unable to find the network interfaces blocking the deletion of the stack: %v", findErr)
+			return err
+		}
+		if len(networkInterfaces) == 0 {
+			return err
+		}
+		for _, networkInterface := range networkInterfaces {
+			logrus.Warnf("Network interface %s (%s) is %s and blocks the deletion of your cluster resources", aws.StringValue(networkInterface.NetworkInterfaceId),
+				aws.StringValue(networkInterface.Description), aws.StringValue(networkInterface.Status))
+		}
+		if !context.Bool(flags.DeleteNetworkInterfacesFlag) {
+			return fmt.Errorf("%v. The deletion is blocked by network interfaces left in your cluster resources; rerun the command with '--%s' to delete them", err, flags.DeleteNetworkInterfacesFlag)
+		}
+		if attempt == maxDeleteStackAttempts {
+			return err
+		}
+		if deleted := deleteNetworkInterfaces(awsClients.EC2Client, networkInterfaces); deleted == 0 {
+			return fmt.Errorf("%v. None of the network interfaces blocking the deletion could be deleted; wait until they are released and rerun the command", err)
+		}
+		logrus.Info("Retrying the deletion of your cluster resources...")
+	}
+}
+
+// blockingNetworkInterfaces returns the network interfaces in the resources of the stack that failed
+// to be deleted.
+func blockingNetworkInterfaces(cfnClient cloudformation.CloudformationClient, ec2Client ec2client.EC2Client, stackName string) ([]*ec2.NetworkInterface, error) {
+	resources, err := cfnClient.GetStackResources(stackName)
+	if err != nil {
+		return nil, err
+	}
+	var vpcID string
+	for _, resource := range resources {
+		if aws.StringValue(resource.LogicalResourceId) == cloudformation.VPCLogicalResourceId {
+			vpcID = aws.StringValue(resource.PhysicalResourceId)
+		}
+	}
+
+	found := make(map[string]*ec2.NetworkInterface)
+	for _, resource := range resources {
+		if aws.StringValue(resource.ResourceStatus) != sdkCFN.ResourceStatusDeleteFailed {
+			continue
+		}
+		var filterName, value string
+		switch aws.StringValue(resource.ResourceType) {
+		case "AWS::EC2::SecurityGroup":
+			filterName, value = "group-id", aws.StringValue(resource.PhysicalResourceId)
+		case "AWS::EC2::Subnet":
+			filterName, value = "subnet-id", aws.StringValue(resource.PhysicalResourceId)
+		case "AWS::EC2::VPC":
+			filterName, value = "vpc-id", aws.StringValue(resource.PhysicalResourceId)
+		case "AWS::EC2::VPCGatewayAttachment":
+			// the public and Elastic IP addresses of the network interfaces in the VPC prevent
+			// detaching the internet gateway
+			filterName, value = "vpc-id", vpcID
+		default:
+			continue
+		}
+		if value == "" {
+			continue
+		}
+		networkInterfaces, err := ec2Client.DescribeNetworkInterfacesByFilter(filterName, value)
+		if err != nil {
+			return nil, err
+		}
+		for _, networkInterface := range networkInterfaces {
+			found[aws.StringValue(networkInterface.NetworkInterfaceId)] = networkInterface
+		}
+	}
+
+	var ids []string
+	for id := range found {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var networkInterfaces []*ec2.NetworkInterface
+	for _, id := range ids {
+		networkInterfaces = append(networkInterfaces, found[id])
+	}
+	return networkInterfaces, nil
+}
+
+// deleteNetworkInterfaces deletes the network interfaces, detaching them first if needed, and returns
+// the number of network interfaces deleted. Network interfaces in use by other AWS services and the
+// primary network interfaces of instances are skipped, since they can't be detached.
+func deleteNetworkInterfaces(ec2Client ec2client.EC2Client, networkInterfaces []*ec2.NetworkInterface) int {
+	deleted := 0
+	for _, networkInterface := range networkInterfaces {
+		id := aws.StringValue(networkInterface.NetworkInterfaceId)
+		if attachment := networkInterface.Attachment; aws.StringValue(networkInterface.Status) != ec2.NetworkInterfaceStatusAvailable && attachment != nil {
+			if aws.BoolValue(networkInterface.RequesterManaged) {
+				logrus.Warnf("Network interface %s is in use by %s and cannot be detached; it is released when the resource using it is deleted", id, aws.StringValue(networkInterface.RequesterId))
+				continue
+			}
+			if aws.Int64Value(attachment.DeviceIndex) == 0 {
+				logrus.Warnf("Network interface %s is the primary network interface of instance %s and cannot be detached", id, aws.StringValue(attachment.InstanceId))
+				continue
+			}
+			logrus.Infof("Detaching network interface %s from instance %s...", id, aws.StringValue(attachment.InstanceId))
+			if err := ec2Client.DetachNetworkInterface(id, aws.StringValue(attachment.AttachmentId)); err != nil {
+				logrus.Warnf("Unable to detach network interface %s: %v", id, err)
+				continue
+			}
+		}
+		if err := ec2Client.DeleteNetworkInterface(id); err != nil {
+			logrus.Warnf("Unable to delete network interface %s: %v", id, err)
+			continue
+		}
+		logrus.Infof("Deleted network interface %s", id)
+		deleted++
+	}
+	return deleted
+}
+
 // deleteCluster executes the 'down' command.
 func deleteCluster(context *cli.Context, awsClients *AWSClients, commandConfig *config.CommandConfig) error {
 	// Validate cli flags
@@ -535,7 +1562,7 @@ func deleteCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 
 	// Validate that cluster exists in ECS
 	ecsClient := awsClients.ECSClient
-	if err := validateCluster(commandConfig.Cluster, ecsClient); err != nil {
+	if err := ValidateCluster(commandConfig.Cluster, ecsClient); err != nil {
 		return err
 	}
 
@@ -546,7 +1573,15 @@ func deleteCluster(context *cli.Context, awsClients *AWSClients, commandConfig *
 	if err := cfnClient.ValidateStackExists(stackName); err != nil {
 		logrus.Infof("No CloudFormation stack found for cluster '%s'.", commandConfig.Cluster)
 	} else {
-		if err := deleteCFNStack(cfnClient, commandConfig); err != nil {
+		// Tasks still running, and the network interfaces of awsvpc tasks that have not been released
+		// yet, are the most common causes of stack deletion failures
+		timeout := time.Duration(context.Float64(flags.DrainTimeoutFlag) * float64(time.Minute))
+		if timeout > 0 {
+			if err := waitForClusterToDrain(ecsClient, awsClients.EC2Client, commandConfig.Cluster, timeout); err != nil {
+				return err
+			}
+		}
+		if err := deleteCFNStack(context, awsClients, commandConfig); err != nil {
 			return err
 		}
 	}
@@ -566,17 +1601,22 @@ func scaleCluster(context *cli.Context, awsClients *AWSClients, commandConfig *c
 		return fmt.Errorf("Please acknowledge that this command may create IAM resources with the '--%s' flag", flags.CapabilityIAMFlag)
 	}
 
-	size, err := getClusterSize(context)
+	size, err := GetClusterSize(context)
+	if err != nil {
+		return err
+	}
+	launchTemplateVersion, err := getLaunchTemplateVersion(context)
 	if err != nil {
 		return err
 	}
-	if size == "" {
-		return fmt.Errorf("Missing required flag '--%s'", flags.AsgMaxSizeFlag)
+	updatesCapacityProvider := context.IsSet(flags.ManagedDrainingFlag) || context.IsSet(flags.InstanceWarmupPeriodFlag)
+	if size == "" && !context.IsSet(flags.LaunchTemplateVersionFlag) && !updatesCapacityProvider {
+		return fmt.Errorf("Missing required flag '--%s', '--%s', '--%s' or '--%s'", flags.AsgMaxSizeFlag, flags.LaunchTemplateVersionFlag, flags.ManagedDrainingFlag, flags.InstanceWarmupPeriodFlag)
 	}
 
 	// Validate that cluster exists in ECS
 	ecsClient := awsClients.ECSClient
-	if err := validateCluster(commandConfig.Cluster, ecsClient); err != nil {
+	if err := ValidateCluster(commandConfig.Cluster, ecsClient); err != nil {
 		return err
 	}
 
@@ -589,14 +1629,35 @@ func scaleCluster(context *cli.Context, awsClients *AWSClients, commandConfig *c
 	}
 
 	// Populate update params for the cfn stack
-	cfnParams, err := cloudformation.NewCfnStackParamsForUpdate(requiredParameters, existingParameters)
+	cfnParams, err := cloudformation.NewCfnStackParamsForUpdate(RequiredParameters, existingParameters)
 	if err != nil {
 		return err
 	}
-	cfnParams.Add(ParameterKeyAsgMaxSize, size)
+	if size != "" {
+		cfnParams.Add(ParameterKeyAsgMaxSize, size)
+	}
+	if context.IsSet(flags.LaunchTemplateVersionFlag) {
+		cfnParams.Add(ParameterKeyLaunchTemplateVersion, launchTemplateVersion)
+	}
+	if updatesCapacityProvider {
+		if err := updateCapacityProviderParams(context, existingParameters, cfnParams, commandConfig); err != nil {
+			return err
+		}
+	}
 
-	// Update the stack.
-	if _, err := cfnClient.UpdateStack(stackName, cfnParams); err != nil {
+	// Stacks created before the launch template version was a parameter are updated to the current
+	// template, which replaces the launch configuration of their instances with a launch template.
+	// The parameters the older template did not have take their default values.
+	if !hasStackParameter(existingParameters, ParameterKeyLaunchTemplateVersion) {
+		template, err := currentClusterTemplate(cfnClient, stackName, commandConfig.Cluster)
+		if err != nil {
+			return err
+		}
+		logrus.Infof("Updating the CloudFormation stack for cluster '%s' to the current template of the ECS CLI", commandConfig.Cluster)
+		if _, err := cfnClient.UpdateStackWithTemplate(stackName, template, cfnParams); err != nil {
+			return err
+		}
+	} else if _, err := cfnClient.UpdateStack(stackName, cfnParams); err != nil {
 		return err
 	}
 
@@ -604,7 +1665,59 @@ func scaleCluster(context *cli.Context, awsClients *AWSClients, commandConfig *c
 	return cfnClient.WaitUntilUpdateComplete(stackName)
 }
 
-// createPS executes the 'ps' command.
+// currentClusterTemplate builds the current template of an existing cluster stack, with the tags of
+// the stack. It refuses stacks with resources the current template does not have, other than the
+// launch configuration it replaces, since updating them would delete those resources.
+func currentClusterTemplate(cfnClient cloudformation.CloudformationClient, stackName, cluster string) (string, error) {
+	output, err := cfnClient.DescribeStacks(stackName)
+	if err != nil {
+		return "", err
+	}
+	if len(output.Stacks) == 0 {
+		return "", fmt.Errorf("Could not describe stack '%s'", stackName)
+	}
+	template, err := cloudformation.NewClusterTemplate(ConvertFromCFNTags(output.Stacks[0].Tags), stackName)
+	if err != nil {
+		return "", errors.Wrapf(err, "Error building cloudformation template")
+	}
+
+	resourceIds, err := cfnClient.GetStackResourceIds(stackName)
+	if err != nil {
+		return "", err
+	}
+	var dropped []string
+	for logicalID := range resourceIds {
+		if _, ok := template.Resources[logicalID]; !ok && logicalID != cloudformation.LaunchConfigurationLogicalResourceId {
+			dropped = append(dropped, logicalID)
+		}
+	}
+	if len(dropped) > 0 {
+		sort.Strings(dropped)
+		return "", fmt.Errorf("The CloudFormation stack for cluster '%s' was created by an older version of the ECS CLI, and updating it would delete %s. Please recreate it with 'ecs-cli up --%s'", cluster, strings.Join(dropped, ", "), flags.ForceFlag)
+	}
+	return template.String()
+}
+
+// updateCapacityProviderParams changes the managed draining and the instance warmup period of the
+// capacity provider of an existing cluster.
+func updateCapacityProviderParams(context *cli.Context, existingParameters []*sdkCFN.Parameter, cfnParams *cloudformation.CfnStackParams, commandConfig *config.CommandConfig) error {
+	for _, flag := range []string{flags.ManagedDrainingFlag, flags.InstanceWarmupPeriodFlag} {
+		if !context.IsSet(flag) {
+			continue
+		}
+		if stackParameterValue(existingParameters, ParameterKeyCapacityProvider) != "true" {
+			return fmt.Errorf("Cluster '%s' has no capacity provider to use '--%s'. Please recreate it with 'ecs-cli up --%s --%s'", commandConfig.Cluster, flag, flags.CapacityProviderFlag, flags.ForceFlag)
+		}
+		if !hasStackParameter(existingParameters, ParameterKeyManagedDraining) {
+			return fmt.Errorf("The CloudFormation stack for cluster '%s' was created by an older version of the ECS CLI and cannot use '--%s'. Please recreate it with 'ecs-cli up --%s'", commandConfig.Cluster, flag, flags.ForceFlag)
+		}
+	}
+	if context.IsSet(flags.InstanceWarmupPeriodFlag) && stackParameterValue(existingParameters, ParameterKeyManagedScaling) != ecs.ManagedScalingStatusEnabled {
+		return fmt.Errorf("--%s requires the managed scaling of the capacity provider of cluster '%s'. Please recreate it with 'ecs-cli up --%s --%s --%s'", flags.InstanceWarmupPeriodFlag, commandConfig.Cluster, flags.CapacityProviderFlag, flags.ManagedScalingFlag, flags.ForceFlag)
+	}
+	return addManagedDrainingAndWarmupParams(context, cfnParams)
+}
+
 func clusterPS(context *cli.Context, rdwr config.ReadWriter) (project.InfoSet, error) {
 	commandConfig, err := newCommandConfig(context, rdwr)
 	if err != nil {
@@ -613,20 +1726,112 @@ func clusterPS(context *cli.Context, rdwr config.ReadWriter) (project.InfoSet, e
 
 	// Validate that cluster exists in ECS
 	ecsClient := ecsclient.NewECSClient(commandConfig)
-	if err := validateCluster(commandConfig.Cluster, ecsClient); err != nil {
+	if err := ValidateCluster(commandConfig.Cluster, ecsClient); err != nil {
 		return nil, err
 	}
 	ec2Client := ec2client.NewEC2Client(commandConfig)
 
-	ecsContext := &ecscontext.ECSContext{ECSClient: ecsClient, EC2Client: ec2Client}
+	ecsContext := &ecscontext.ECSContext{ECSClient: ecsClient, EC2Client: ec2Client, CLIContext: context}
 	task := task.NewTask(ecsContext)
 	return task.Info(false, context.String(flags.DesiredTaskStatus))
 }
 
-// validateCluster validates if the cluster exists in ECS and is in "ACTIVE" state.
-func validateCluster(clusterName string, ecsClient ecsclient.ECSClient) error {
+// stackInfo describes a CloudFormation stack managed by the ECS CLI and the stacks
+// it is linked to through exports and imports
+type stackInfo struct {
+	Name      string
+	Status    string
+	DependsOn []string
+	UsedBy    []string
+}
+
+// clusterStacks executes the 'stacks' command. It returns the cluster stack and the
+// Service Discovery stacks of the cluster, in the order they were described.
+func clusterStacks(cfnClient cloudformation.CloudformationClient, commandConfig *config.CommandConfig) ([]*stackInfo, error) {
+	serviceDiscoveryPrefixes := servicediscovery.ClusterStackNamePrefixes(commandConfig.Cluster)
+	stacks, err := cfnClient.DescribeStacksWithNamePrefix(append([]string{commandConfig.CFNStackName}, serviceDiscoveryPrefixes...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []*stackInfo
+	for _, stack := range stacks {
+		stackName := aws.StringValue(stack.StackName)
+		// the cluster stack name is also a prefix of the stack names of other clusters
+		if stackName != commandConfig.CFNStackName && !hasAnyPrefix(stackName, serviceDiscoveryPrefixes) {
+			continue
+		}
+		info := &stackInfo{
+			Name:   stackName,
+			Status: aws.StringValue(stack.StackStatus),
+		}
+		for _, param := range stack.Parameters {
+			if aws.StringValue(param.ParameterKey) == ParameterKeySharedVpcExportName && aws.StringValue(param.ParameterValue) != "" {
+				exportingStack := strings.TrimSuffix(aws.StringValue(param.ParameterValue), cloudformation.ExportName("", cloudformation.OutputKeyVpcId))
+				info.DependsOn = append(info.DependsOn, exportingStack)
+			}
+		}
+		for _, output := range stack.Outputs {
+			exportName := aws.StringValue(output.ExportName)
+			if exportName == "" {
+				continue
+			}
+			importingStacks, err := cfnClient.ListImports(exportName)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Error listing imports of export '%s'", exportName)
+			}
+			info.UsedBy = appendUnique(info.UsedBy, importingStacks...)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func printStacks(out io.Writer, stacks []*stackInfo) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "STACK NAME\tSTATUS\tDEPENDS ON\tUSED BY")
+	for _, stack := range stacks {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", stack.Name, stack.Status, joinOrDash(stack.DependsOn), joinOrDash(stack.UsedBy))
+	}
+	w.Flush()
+}
+
+func joinOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ",")
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(values []string, newValues ...string) []string {
+	for _, newValue := range newValues {
+		found := false
+		for _, value := range values {
+			if value == newValue {
+				found = true
+				break
+			}
+		}
+		if !found {
+			values = append(values, newValue)
+		}
+	}
+	return values
+}
+
+// ValidateCluster validates if the cluster exists in ECS and is in "ACTIVE" state.
+func ValidateCluster(clusterName string, ecsClient ecsclient.ECSClient) error {
 	if clusterName == "" {
-		return clusterNotSetError()
+		return ClusterNotSetError()
 	}
 	isClusterActive, err := ecsClient.IsActiveCluster(clusterName)
 	if err != nil {
@@ -641,7 +1846,7 @@ func validateCluster(clusterName string, ecsClient ecsclient.ECSClient) error {
 
 // deleteClusterPrompt prompts and checks for confirmation to delete the cluster
 func deleteClusterPrompt(reader *bufio.Reader) error {
-	fmt.Println("Are you sure you want to delete your cluster? [y/N]")
+	fmt.Fprintln(progress.Output(), "Are you sure you want to delete your cluster? [y/N]")
 	input, err := reader.ReadString('\n')
 	if err != nil {
 		return fmt.Errorf("Error reading input: %s", err.Error())
@@ -655,7 +1860,7 @@ func deleteClusterPrompt(reader *bufio.Reader) error {
 
 // cliFlagsToCfnStackParams converts values set for CLI flags to cloudformation stack parameters.
 func cliFlagsToCfnStackParams(context *cli.Context, cluster, launchType string, tags []*ecs.Tag) (*cloudformation.CfnStackParams, error) {
-	cfnParams := cloudformation.NewCfnStackParams(requiredParameters)
+	cfnParams := cloudformation.NewCfnStackParams(RequiredParameters)
 	for cliFlag, cfnParamKeyName := range flagNamesToStackParameterKeys {
 		cfnParamKeyValue := context.String(cliFlag)
 		if cfnParamKeyValue != "" {
@@ -674,6 +1879,33 @@ func cliFlagsToCfnStackParams(context *cli.Context, cluster, launchType string,
 				}
 			}
 		}
+		var attributes map[string]string
+		if attributesVal := context.String(flags.InstanceAttributesFlag); attributesVal != "" {
+			var err error
+			if attributes, err = instanceAttributes(attributesVal); err != nil {
+				return nil, err
+			}
+		}
+		if context.Bool(flags.GPUAttributesFlag) {
+			instanceType := context.String(flags.InstanceTypeFlag)
+			if instanceType == "" {
+				instanceType = cloudformation.DefaultECSInstanceType
+			}
+			var err error
+			if attributes, err = gpuAttributes(instanceType, attributes); err != nil {
+				return nil, err
+			}
+			builder.EnableGPUSupport()
+		}
+		if len(attributes) > 0 {
+			builder.SetInstanceAttributes(attributes)
+		}
+		if amimetadata.IsNeuronInstance(context.String(flags.InstanceTypeFlag)) {
+			builder.EnableNeuronSupport()
+		}
+		if context.Bool(flags.UseInstanceConnectFlag) {
+			builder.EnableInstanceConnect()
+		}
 		userData, err := builder.Build()
 		if err != nil {
 			return nil, err
@@ -683,11 +1915,130 @@ func cliFlagsToCfnStackParams(context *cli.Context, cluster, launchType string,
 	return cfnParams, nil
 }
 
+// instanceAttributes parses the value of the --instance-attributes flag into the
+// name/value map the ECS agent reads from ECS_INSTANCE_ATTRIBUTES.
+func instanceAttributes(flagValue string) (map[string]string, error) {
+	attributes, err := utils.ParseAttributes(flagValue)
+	if err != nil {
+		return nil, err
+	}
+	attributeMap := make(map[string]string)
+	for _, attribute := range attributes {
+		if attribute.Value == nil {
+			return nil, fmt.Errorf("Instance attribute '%s' must have a value, in the format name=value", aws.StringValue(attribute.Name))
+		}
+		attributeMap[aws.StringValue(attribute.Name)] = aws.StringValue(attribute.Value)
+	}
+	return attributeMap, nil
+}
+
+// Instance attributes registered by the container instances of a cluster created with --gpu-attributes
+const (
+	gpuCountAttribute = "gpu.count"
+	gpuTypeAttribute  = "gpu.type"
+)
+
+// gpuAMINameInfix is part of the names of the GPU variants of the ECS optimized AMI, such as
+// amzn2-ami-ecs-gpu-hvm-2.0.20230301-x86_64-ebs
+const gpuAMINameInfix = "-ecs-gpu-"
+
+// gpuTypes are the GPUs of the instance classes supported by the GPU variant of the ECS optimized AMI.
+// See: https://aws.amazon.com/ec2/instance-types/#Accelerated_Computing
+var gpuTypes = map[string]string{
+	"p2":   "k80",
+	"p3":   "v100",
+	"p3dn": "v100",
+	"g3":   "m60",
+	"g3s":  "m60",
+	"g4dn": "t4",
+}
+
+// gpuCounts are the numbers of GPUs of the instance types of the classes in gpuTypes
+var gpuCounts = map[string]int{
+	"p2.xlarge":     1,
+	"p2.8xlarge":    8,
+	"p2.16xlarge":   16,
+	"p3.2xlarge":    1,
+	"p3.8xlarge":    4,
+	"p3.16xlarge":   8,
+	"p3dn.24xlarge": 8,
+	"g3s.xlarge":    1,
+	"g3.4xlarge":    1,
+	"g3.8xlarge":    2,
+	"g3.16xlarge":   4,
+	"g4dn.xlarge":   1,
+	"g4dn.2xlarge":  1,
+	"g4dn.4xlarge":  1,
+	"g4dn.8xlarge":  1,
+	"g4dn.16xlarge": 1,
+	"g4dn.12xlarge": 4,
+	"g4dn.metal":    8,
+}
+
+// gpuAttributes returns the gpu.count and gpu.type attributes of the GPU instance type, overridden
+// by the attributes given with --instance-attributes
+func gpuAttributes(instanceType string, custom map[string]string) (map[string]string, error) {
+	if amimetadata.Flavor(instanceType) != amimetadata.FlavorGPU {
+		return nil, fmt.Errorf("You can only specify '--%s' with a GPU instance type, such as g4dn.xlarge; %s is not one", flags.GPUAttributesFlag, instanceType)
+	}
+	attributes := map[string]string{
+		gpuTypeAttribute: gpuTypes[strings.SplitN(instanceType, ".", 2)[0]],
+	}
+	if count, ok := gpuCounts[instanceType]; ok {
+		attributes[gpuCountAttribute] = strconv.Itoa(count)
+	}
+	for name, value := range custom {
+		attributes[name] = value
+	}
+	if _, ok := attributes[gpuCountAttribute]; !ok {
+		return nil, fmt.Errorf("The number of GPUs of instance type %s is unknown. Specify it with '--%s %s=<count>'", instanceType, flags.InstanceAttributesFlag, gpuCountAttribute)
+	}
+	return attributes, nil
+}
+
+// validateGPUImage checks that the AMI given with --image-id is the GPU variant of the ECS optimized
+// AMI, which includes the NVIDIA drivers and container runtime
+func validateGPUImage(imageID string, ec2Client ec2client.EC2Client) error {
+	image, err := ec2Client.DescribeImage(imageID)
+	if err != nil {
+		return err
+	}
+	if name := aws.StringValue(image.Name); !strings.Contains(name, gpuAMINameInfix) {
+		return fmt.Errorf("AMI %s (%s) is not the GPU variant of the ECS optimized AMI, which '--%s' requires. Remove '--%s' to use the recommended GPU AMI", imageID, name, flags.GPUAttributesFlag, flags.ImageIdFlag)
+	}
+	return nil
+}
+
 // isIAMAcknowledged returns true if the 'capability-iam' flag is set from CLI.
 func isIAMAcknowledged(context *cli.Context) bool {
 	return context.Bool(flags.CapabilityIAMFlag)
 }
 
+// stackCapabilities returns the capabilities acknowledged when creating the cluster stack: the
+// IAM capability needed by the cluster template, and those specified with the 'capabilities' flag.
+func stackCapabilities(context *cli.Context) ([]string, error) {
+	capabilities := []string{sdkCFN.CapabilityCapabilityIam}
+	value := context.String(flags.CapabilitiesFlag)
+	if value == "" {
+		return capabilities, nil
+	}
+	for _, capability := range strings.Split(value, ",") {
+		capability = strings.ToUpper(strings.TrimSpace(capability))
+		switch capability {
+		case sdkCFN.CapabilityCapabilityIam:
+			continue
+		case sdkCFN.CapabilityCapabilityNamedIam, sdkCFN.CapabilityCapabilityAutoExpand:
+			if !utils.InSlice(capability, capabilities) {
+				capabilities = append(capabilities, capability)
+			}
+		default:
+			return nil, fmt.Errorf("Invalid value for --%s: '%s'; valid values are %s, %s and %s", flags.CapabilitiesFlag, capability,
+				sdkCFN.CapabilityCapabilityIam, sdkCFN.CapabilityCapabilityNamedIam, sdkCFN.CapabilityCapabilityAutoExpand)
+		}
+	}
+	return capabilities, nil
+}
+
 // returns true if customer specifies a custom instance role via 'role' flag.
 func hasCustomRole(context *cli.Context) bool {
 	return context.String(flags.InstanceRoleFlag) != "" // validate arn?
@@ -711,13 +2062,13 @@ func isForceSet(context *cli.Context) bool {
 	return context.Bool(flags.ForceFlag)
 }
 
-// clusterNotSetError recommends that users either configure or provide a cluster flag
-func clusterNotSetError() error {
+// ClusterNotSetError recommends that users either configure or provide a cluster flag
+func ClusterNotSetError() error {
 	return fmt.Errorf("Please configure a cluster using the configure command or the '--%s' flag", flags.ClusterFlag)
 }
 
-// getClusterSize gets the value for the 'size' flag from CLI.
-func getClusterSize(context *cli.Context) (string, error) {
+// GetClusterSize gets the value for the 'size' flag from CLI.
+func GetClusterSize(context *cli.Context) (string, error) {
 	size := context.String(flags.AsgMaxSizeFlag)
 	if size != "" {
 		if _, err := strconv.Atoi(size); err != nil {
@@ -728,6 +2079,40 @@ func getClusterSize(context *cli.Context) (string, error) {
 	return size, nil
 }
 
+// getLaunchTemplateVersion returns the version of the launch template to pin the Auto Scaling group
+// to, or an empty string to follow the latest version of the launch template.
+func getLaunchTemplateVersion(context *cli.Context) (string, error) {
+	version := context.String(flags.LaunchTemplateVersionFlag)
+	if version == "" || version == latestLaunchTemplateVersion {
+		return "", nil
+	}
+	if number, err := strconv.Atoi(version); err != nil || number < 1 {
+		return "", fmt.Errorf("Invalid value '%s' for '--%s'. Specify a version number or '%s'", version, flags.LaunchTemplateVersionFlag, latestLaunchTemplateVersion)
+	}
+	return version, nil
+}
+
+// hasStackParameter returns whether the parameters of a stack contain the key.
+func hasStackParameter(parameters []*sdkCFN.Parameter, key string) bool {
+	for _, param := range parameters {
+		if aws.StringValue(param.ParameterKey) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// stackParameterValue returns the value of the parameter of a stack, or an empty string if the
+// stack has no such parameter.
+func stackParameterValue(parameters []*sdkCFN.Parameter, key string) string {
+	for _, param := range parameters {
+		if aws.StringValue(param.ParameterKey) == key {
+			return aws.StringValue(param.ParameterValue)
+		}
+	}
+	return ""
+}
+
 // If param1 exists, param2 is not allowed.
 func validateMutuallyExclusiveParams(cfnParams *cloudformation.CfnStackParams, param1, param2 string) bool {
 	if _, err := cfnParams.GetParameter(param1); err != nil {
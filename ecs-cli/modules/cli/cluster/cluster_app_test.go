@@ -19,8 +19,11 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster/userdata"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata"
@@ -29,12 +32,18 @@ import (
 	mock_cloudformation "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
 	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
 	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	iamclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam"
+	mock_iam "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	sdkCFN "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli"
@@ -84,9 +93,13 @@ func newMockReadWriter() *mockReadWriter {
 }
 
 type mockUserDataBuilder struct {
-	userdata string
-	files    []string
-	tags     []*ecs.Tag
+	userdata        string
+	files           []string
+	tags            []*ecs.Tag
+	attributes      map[string]string
+	instanceConnect bool
+	gpuSupport      bool
+	neuronSupport   bool
 }
 
 func (b *mockUserDataBuilder) AddFile(fileName string) error {
@@ -94,6 +107,22 @@ func (b *mockUserDataBuilder) AddFile(fileName string) error {
 	return nil
 }
 
+func (b *mockUserDataBuilder) SetInstanceAttributes(attributes map[string]string) {
+	b.attributes = attributes
+}
+
+func (b *mockUserDataBuilder) EnableInstanceConnect() {
+	b.instanceConnect = true
+}
+
+func (b *mockUserDataBuilder) EnableGPUSupport() {
+	b.gpuSupport = true
+}
+
+func (b *mockUserDataBuilder) EnableNeuronSupport() {
+	b.neuronSupport = true
+}
+
 func (b *mockUserDataBuilder) Build() (string, error) {
 	return b.userdata, nil
 }
@@ -106,27 +135,784 @@ func setupTest(t *testing.T) (*mock_ecs.MockECSClient, *mock_cloudformation.Mock
 	mockSSM := mock_amimetadata.NewMockClient(ctrl)
 	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
 
-	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
-	os.Setenv("AWS_SECRET_KEY", "secret")
-	os.Setenv("AWS_REGION", "us-west-1")
+	// the ECS service-linked role exists, unless a test replaces the IAM client
+	mockIAM := mock_iam.NewMockClient(ctrl)
+	mockIAM.EXPECT().GetRole(ecsServiceLinkedRoleName).Return(&iam.GetRoleOutput{}, nil).AnyTimes()
+	newIAMClient = func(*config.CommandConfig) iamclient.Client { return mockIAM }
+
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "secret")
+	os.Setenv("AWS_REGION", "us-west-1")
+
+	return mockECS, mockCloudformation, mockSSM, mockEC2
+}
+
+/////////////////
+// Cluster Up //
+////////////////
+
+func TestClusterUp(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	mocksForSuccessfulClusterUp(mockECS, mockCloudformation, mockSSM, mockEC2)
+
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+}
+
+func TestClusterUpWithDryRun(t *testing.T) {
+	defer os.Clearenv()
+	defer func() { templateOutput = progress.Output }()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+	)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Do(func(x, y interface{}) {
+			_, err := cloudformation.ParseTemplate(x.(string))
+			assert.NoError(t, err, "Expected JSON template to be validated")
+		}).Return(nil),
+	)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.Bool(flags.DryRunFlag, true, "")
+	flagSet.String(flags.TemplateFormatFlag, "yaml", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	out := &bytes.Buffer{}
+	templateOutput = func() io.Writer { return out }
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster with --dry-run")
+	assert.True(t, strings.HasPrefix(out.String(), "AWSTemplateFormatVersion: "), "Expected template to be printed in YAML format")
+	assert.Contains(t, out.String(), "Type: AWS::AutoScaling::AutoScalingGroup")
+}
+
+func TestClusterUpWithInvalidTemplate(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+	)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(errors.New("CloudFormation template is invalid: Template format error")),
+	)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error bringing up cluster with an invalid template")
+}
+
+func TestClusterUpWithTemplateFormatInvalidFlags(t *testing.T) {
+	testCases := map[string]struct {
+		dryRun         bool
+		empty          bool
+		templateFormat string
+	}{
+		"template format without dry run": {templateFormat: "yaml"},
+		"unsupported template format":     {dryRun: true, templateFormat: "xml"},
+		"dry run with empty cluster":      {dryRun: true, empty: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer os.Clearenv()
+			mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+			awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+			flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+			flagSet.Bool(flags.DryRunFlag, tc.dryRun, "")
+			flagSet.Bool(flags.EmptyFlag, tc.empty, "")
+			flagSet.String(flags.TemplateFormatFlag, tc.templateFormat, "")
+
+			context := cli.NewContext(nil, flagSet, nil)
+			rdwr := newMockReadWriter()
+			commandConfig, err := newCommandConfig(context, rdwr)
+			assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+			err = createCluster(context, awsClients, commandConfig)
+			assert.Error(t, err, "Expected error bringing up cluster")
+		})
+	}
+}
+
+func TestClusterUpWithScheduledScaling(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+	)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			template, err := cloudformation.ParseTemplate(v.(string))
+			assert.NoError(t, err, "Unexpected error parsing template")
+			action := template.Resources["EcsInstanceAsgScheduledAction1"]
+			if assert.NotNil(t, action, "Expected scheduled action to be in template") {
+				assert.Equal(t, "0 8 * * MON-FRI", action.Properties["Recurrence"])
+				assert.Equal(t, float64(5), action.Properties["DesiredCapacity"])
+			}
+			action = template.Resources["EcsInstanceAsgScheduledAction2"]
+			if assert.NotNil(t, action, "Expected scheduled action to be in template") {
+				assert.Equal(t, "0 20 * * *", action.Properties["Recurrence"])
+				assert.Equal(t, float64(0), action.Properties["DesiredCapacity"])
+			}
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.String(flags.AsgMaxSizeFlag, "5", "")
+	flagSet.String(flags.ScheduledScalingFlag, "cron(0 8 * * MON-FRI)=5; cron(0 20 * * *)=0", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+}
+
+func TestClusterUpWithScheduledScalingExceedingSize(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+	)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+	)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.String(flags.ScheduledScalingFlag, "cron(0 8 * * MON-FRI)=5", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error when a scheduled action exceeds the cluster size")
+}
+
+func TestStackCapabilities(t *testing.T) {
+	testCases := map[string]struct {
+		value    string
+		expected []string
+	}{
+		"no capabilities":     {expected: []string{sdkCFN.CapabilityCapabilityIam}},
+		"named IAM":           {value: "CAPABILITY_NAMED_IAM", expected: []string{sdkCFN.CapabilityCapabilityIam, sdkCFN.CapabilityCapabilityNamedIam}},
+		"several, lower case": {value: "capability_auto_expand, CAPABILITY_IAM,CAPABILITY_AUTO_EXPAND", expected: []string{sdkCFN.CapabilityCapabilityIam, sdkCFN.CapabilityCapabilityAutoExpand}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+			flagSet.String(flags.CapabilitiesFlag, tc.value, "")
+			context := cli.NewContext(nil, flagSet, nil)
+
+			capabilities, err := stackCapabilities(context)
+			assert.NoError(t, err, "Unexpected error parsing capabilities")
+			assert.Equal(t, tc.expected, capabilities, "Expected capabilities to match")
+		})
+	}
+}
+
+func TestStackCapabilitiesInvalid(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.CapabilitiesFlag, "CAPABILITY_RESOURCE_POLICY", "")
+	context := cli.NewContext(nil, flagSet, nil)
+
+	_, err := stackCapabilities(context)
+	assert.Error(t, err, "Expected error parsing an unsupported capability")
+}
+
+func TestParseScheduledScaling(t *testing.T) {
+	actions, err := parseScheduledScaling("cron(0 8 * * MON-FRI)=5;cron(0  20 * * *) = 0;")
+	assert.NoError(t, err, "Unexpected error parsing scheduled scaling")
+	assert.Equal(t, []*cloudformation.ScheduledAction{
+		{Recurrence: "0 8 * * MON-FRI", DesiredCapacity: 5},
+		{Recurrence: "0 20 * * *", DesiredCapacity: 0},
+	}, actions)
+
+	actions, err = parseScheduledScaling("")
+	assert.NoError(t, err, "Unexpected error parsing empty scheduled scaling")
+	assert.Empty(t, actions)
+
+	for _, value := range []string{
+		"0 8 * * MON-FRI=5",
+		"cron(0 8 * * MON-FRI)",
+		"cron(0 8 * *)=5",
+		"cron(0 8 * * MON-FRI)=-1",
+		"cron(0 8 * * MON-FRI)=five",
+		"rate(1 day)=5",
+	} {
+		_, err := parseScheduledScaling(value)
+		assert.Error(t, err, "Expected error parsing scheduled scaling '%s'", value)
+	}
+}
+
+func TestClusterUpWithForce(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+	)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(nil),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().DeleteStack(stackName).Return(nil),
+		mockCloudformation.EXPECT().WaitUntilDeleteComplete(stackName).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.Bool(flags.ForceFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+}
+
+func TestClusterUpWithoutPublicIP(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+	)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			capabilities := x.([]string)
+			cfnParams := y.(*cloudformation.CfnStackParams)
+			associateIPAddress, err := cfnParams.GetParameter(ParameterKeyAssociatePublicIPAddress)
+			assert.NoError(t, err, "Unexpected error getting cfn parameter")
+			assert.Equal(t, "false", aws.StringValue(associateIPAddress.ParameterValue), "Should not associate public IP address")
+			assert.Equal(t, []string{sdkCFN.CapabilityCapabilityIam}, capabilities, "Expected the IAM capability to be acknowledged")
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalContext := cli.NewContext(nil, globalSet, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.Bool(flags.NoAutoAssignPublicIPAddressFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, globalContext)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+}
+
+func TestClusterUpWithUserData(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	oldNewUserDataBuilder := newUserDataBuilder
+	defer func() { newUserDataBuilder = oldNewUserDataBuilder }()
+	userdataMock := &mockUserDataBuilder{
+		userdata: mockedUserData,
+	}
+	newUserDataBuilder = func(clusterName string, tags []*ecs.Tag) userdata.UserDataBuilder {
+		userdataMock.tags = tags
+		return userdataMock
+	}
+
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+	)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			cfnParams := y.(*cloudformation.CfnStackParams)
+			param, err := cfnParams.GetParameter(ParameterKeyUserData)
+			assert.NoError(t, err, "Expected User Data parameter to be set")
+			assert.Equal(t, mockedUserData, aws.StringValue(param.ParameterValue), "Expected user data to match")
+			assert.Nil(t, userdataMock.tags, "Expected container instance tagging to be disabled")
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalContext := cli.NewContext(nil, globalSet, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	userDataFiles := &cli.StringSlice{}
+	userDataFiles.Set("some_file")
+	userDataFiles.Set("some_file2")
+	flagSet.Var(userDataFiles, flags.UserDataFlag, "")
+
+	context := cli.NewContext(nil, flagSet, globalContext)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+
+	assert.ElementsMatch(t, []string{"some_file", "some_file2"}, userdataMock.files, "Expected userdata file list to match")
+}
+
+func TestClusterUpWithInstanceAttributes(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	oldNewUserDataBuilder := newUserDataBuilder
+	defer func() { newUserDataBuilder = oldNewUserDataBuilder }()
+	userdataMock := &mockUserDataBuilder{
+		userdata: mockedUserData,
+	}
+	newUserDataBuilder = func(clusterName string, tags []*ecs.Tag) userdata.UserDataBuilder {
+		return userdataMock
+	}
+
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+	)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalContext := cli.NewContext(nil, globalSet, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.String(flags.InstanceAttributesFlag, "stack=prod,gpu=true", "")
+
+	context := cli.NewContext(nil, flagSet, globalContext)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+
+	expectedAttributes := map[string]string{
+		"stack": "prod",
+		"gpu":   "true",
+	}
+	assert.Equal(t, expectedAttributes, userdataMock.attributes, "Expected instance attributes to match")
+}
+
+func TestInstanceAttributesWithoutValue(t *testing.T) {
+	_, err := instanceAttributes("stack=prod,gpu")
+	assert.Error(t, err, "Expected error for an instance attribute without a value")
+}
+
+func TestClusterUpWithGPUAttributes(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	oldNewUserDataBuilder := newUserDataBuilder
+	defer func() { newUserDataBuilder = oldNewUserDataBuilder }()
+	userdataMock := &mockUserDataBuilder{
+		userdata: mockedUserData,
+	}
+	newUserDataBuilder = func(clusterName string, tags []*ecs.Tag) userdata.UserDataBuilder {
+		return userdataMock
+	}
+
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("g4dn.xlarge").Return(amiMetadata(amiID), nil),
+	)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"g4dn.xlarge"}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.String(flags.InstanceTypeFlag, "g4dn.xlarge", "")
+	flagSet.String(flags.InstanceAttributesFlag, "stack=prod", "")
+	flagSet.Bool(flags.GPUAttributesFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+
+	expectedAttributes := map[string]string{
+		"gpu.count": "1",
+		"gpu.type":  "t4",
+		"stack":     "prod",
+	}
+	assert.Equal(t, expectedAttributes, userdataMock.attributes, "Expected instance attributes to match")
+	assert.True(t, userdataMock.gpuSupport, "Expected the GPU support to be enabled by the user data")
+}
+
+func TestClusterUpWithNeuronInstanceType(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	oldNewUserDataBuilder := newUserDataBuilder
+	defer func() { newUserDataBuilder = oldNewUserDataBuilder }()
+	userdataMock := &mockUserDataBuilder{
+		userdata: mockedUserData,
+	}
+	newUserDataBuilder = func(clusterName string, tags []*ecs.Tag) userdata.UserDataBuilder {
+		return userdataMock
+	}
+
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("inf2.xlarge").Return(amiMetadata(amiID), nil),
+	)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"inf2.xlarge"}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.String(flags.InstanceTypeFlag, "inf2.xlarge", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+	assert.True(t, userdataMock.neuronSupport, "Expected the Neuron driver to be installed by the user data")
+	assert.False(t, userdataMock.gpuSupport, "Expected the GPU support to be disabled")
+}
+
+func TestClusterUpWithGPUAttributesAndNonGPUImage(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error"))
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"g4dn.xlarge"}, nil),
+		mockEC2.EXPECT().DescribeImage(amiID).Return(&ec2.Image{
+			ImageId: aws.String(amiID),
+			Name:    aws.String("amzn2-ami-ecs-hvm-2.0.20230301-x86_64-ebs"),
+		}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.String(flags.InstanceTypeFlag, "g4dn.xlarge", "")
+	flagSet.String(flags.ImageIdFlag, amiID, "")
+	flagSet.Bool(flags.GPUAttributesFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error for an AMI which is not the GPU variant")
+	assert.Contains(t, err.Error(), "is not the GPU variant")
+}
+
+func TestGPUAttributes(t *testing.T) {
+	testCases := []struct {
+		instanceType string
+		custom       map[string]string
+		expected     map[string]string
+	}{
+		{"p3.8xlarge", nil, map[string]string{"gpu.count": "4", "gpu.type": "v100"}},
+		{"g3s.xlarge", map[string]string{"team": "ml"}, map[string]string{"gpu.count": "1", "gpu.type": "m60", "team": "ml"}},
+		{"g4dn.24xlarge", map[string]string{"gpu.count": "8"}, map[string]string{"gpu.count": "8", "gpu.type": "t4"}},
+		{"g4dn.xlarge", map[string]string{"gpu.type": "t4-shared"}, map[string]string{"gpu.count": "1", "gpu.type": "t4-shared"}},
+	}
+	for _, testCase := range testCases {
+		attributes, err := gpuAttributes(testCase.instanceType, testCase.custom)
+		assert.NoError(t, err, "Unexpected error for instance type %s", testCase.instanceType)
+		assert.Equal(t, testCase.expected, attributes, "Expected attributes to match for instance type %s", testCase.instanceType)
+	}
+}
+
+func TestGPUAttributesErrors(t *testing.T) {
+	_, err := gpuAttributes("t2.micro", nil)
+	assert.Error(t, err, "Expected error for an instance type without GPU")
+
+	_, err = gpuAttributes("g4dn.24xlarge", nil)
+	assert.Error(t, err, "Expected error for an instance type with an unknown number of GPUs")
+}
+
+func TestClusterUpWithInstanceConnect(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	mocksForSuccessfulClusterUp(mockECS, mockCloudformation, mockSSM, mockEC2)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	oldNewUserDataBuilder := newUserDataBuilder
+	defer func() { newUserDataBuilder = oldNewUserDataBuilder }()
+	userdataMock := &mockUserDataBuilder{
+		userdata: mockedUserData,
+	}
+	newUserDataBuilder = func(clusterName string, tags []*ecs.Tag) userdata.UserDataBuilder {
+		return userdataMock
+	}
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.Bool(flags.UseInstanceConnectFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+	assert.True(t, userdataMock.instanceConnect, "Expected EC2 Instance Connect to be installed by the user data")
+}
+
+func TestClusterUpWithInstanceConnectAndKeypair(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.Bool(flags.UseInstanceConnectFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error for --use-instance-connect with a key pair")
+}
+
+func TestClusterUpWithSpotPrice(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	spotPrice := "0.03"
+
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+	)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			cfnParams := y.(*cloudformation.CfnStackParams)
+			param, err := cfnParams.GetParameter(ParameterKeySpotPrice)
+			assert.NoError(t, err, "Expected Spot Price parameter to be set")
+			assert.Equal(t, spotPrice, aws.StringValue(param.ParameterValue), "Expected spot price to match")
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalContext := cli.NewContext(nil, globalSet, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.String(flags.SpotPriceFlag, spotPrice, "")
+
+	context := cli.NewContext(nil, flagSet, globalContext)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
 
-	return mockECS, mockCloudformation, mockSSM, mockEC2
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
 }
 
-/////////////////
-// Cluster Up //
-////////////////
-
-func TestClusterUp(t *testing.T) {
+func TestClusterUpWithDetailedMonitoring(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
-	mocksForSuccessfulClusterUp(mockECS, mockCloudformation, mockSSM, mockEC2)
-
 	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
 
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+	)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			cfnParams := y.(*cloudformation.CfnStackParams)
+			param, err := cfnParams.GetParameter(ParameterKeyDetailedMonitoring)
+			assert.NoError(t, err, "Expected detailed monitoring parameter to be set")
+			assert.Equal(t, "true", aws.StringValue(param.ParameterValue), "Expected detailed monitoring to be enabled")
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+	)
+
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
 	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.Bool(flags.EnableDetailedMonitoringFlag, true, "")
 
 	context := cli.NewContext(nil, flagSet, nil)
 	rdwr := newMockReadWriter()
@@ -137,7 +923,7 @@ func TestClusterUp(t *testing.T) {
 	assert.NoError(t, err, "Unexpected error bringing up cluster")
 }
 
-func TestClusterUpWithForce(t *testing.T) {
+func TestClusterUpWithCaptureTaskEvents(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
 	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
@@ -151,10 +937,14 @@ func TestClusterUpWithForce(t *testing.T) {
 	)
 
 	gomock.InOrder(
-		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(nil),
-		mockCloudformation.EXPECT().DeleteStack(stackName).Return(nil),
-		mockCloudformation.EXPECT().WaitUntilDeleteComplete(stackName).Return(nil),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Return("", nil),
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			cfnParams := y.(*cloudformation.CfnStackParams)
+			param, err := cfnParams.GetParameter(ParameterKeyCaptureTaskEvents)
+			assert.NoError(t, err, "Expected task events capture parameter to be set")
+			assert.Equal(t, "true", aws.StringValue(param.ParameterValue), "Expected task events capture to be enabled")
+		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
 	)
 
@@ -165,7 +955,7 @@ func TestClusterUpWithForce(t *testing.T) {
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
 	flagSet.String(flags.KeypairNameFlag, "default", "")
-	flagSet.Bool(flags.ForceFlag, true, "")
+	flagSet.Bool(flags.CaptureTaskEventsFlag, true, "")
 
 	context := cli.NewContext(nil, flagSet, nil)
 	rdwr := newMockReadWriter()
@@ -176,7 +966,7 @@ func TestClusterUpWithForce(t *testing.T) {
 	assert.NoError(t, err, "Unexpected error bringing up cluster")
 }
 
-func TestClusterUpWithoutPublicIP(t *testing.T) {
+func TestClusterUpWithCapacityProvider(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
 	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
@@ -191,13 +981,18 @@ func TestClusterUpWithoutPublicIP(t *testing.T) {
 
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
-			capabilityIAM := x.(bool)
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
 			cfnParams := y.(*cloudformation.CfnStackParams)
-			associateIPAddress, err := cfnParams.GetParameter(ParameterKeyAssociatePublicIPAddress)
-			assert.NoError(t, err, "Unexpected error getting cfn parameter")
-			assert.Equal(t, "false", aws.StringValue(associateIPAddress.ParameterValue), "Should not associate public IP address")
-			assert.True(t, capabilityIAM, "Expected capability capabilityIAM to be true")
+			for key, expected := range map[string]string{
+				ParameterKeyCapacityProvider:   "true",
+				ParameterKeyManagedScaling:     "ENABLED",
+				ParameterKeyManagedTermination: "ENABLED",
+			} {
+				param, err := cfnParams.GetParameter(key)
+				assert.NoError(t, err, "Expected parameter %s to be set", key)
+				assert.Equal(t, expected, aws.StringValue(param.ParameterValue), "Unexpected value of parameter %s", key)
+			}
 		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
 	)
@@ -206,15 +1001,115 @@ func TestClusterUpWithoutPublicIP(t *testing.T) {
 		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
 	)
 
-	globalSet := flag.NewFlagSet("ecs-cli", 0)
-	globalContext := cli.NewContext(nil, globalSet, nil)
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.Bool(flags.CapacityProviderFlag, true, "")
+	flagSet.Bool(flags.ManagedScalingFlag, true, "")
+	flagSet.Bool(flags.ManagedTerminationFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+}
+
+func TestAddCapacityProviderParams(t *testing.T) {
+	testCases := map[string]struct {
+		flags         []string
+		values        map[string]string
+		expectedError string
+		expectedKeys  []string
+	}{
+		"no capacity provider": {},
+		"capacity provider only": {
+			flags:        []string{flags.CapacityProviderFlag},
+			expectedKeys: []string{ParameterKeyCapacityProvider},
+		},
+		"managed scaling without capacity provider": {
+			flags:         []string{flags.ManagedScalingFlag},
+			expectedError: "--managed-scaling requires --capacity-provider",
+		},
+		"termination protection without managed scaling": {
+			flags:         []string{flags.CapacityProviderFlag, flags.ManagedTerminationFlag},
+			expectedError: "--managed-termination-protection requires --managed-scaling",
+		},
+		"managed draining without capacity provider": {
+			values:        map[string]string{flags.ManagedDrainingFlag: "true"},
+			expectedError: "--managed-draining requires --capacity-provider",
+		},
+		"managed draining disabled": {
+			flags:        []string{flags.CapacityProviderFlag},
+			values:       map[string]string{flags.ManagedDrainingFlag: "false"},
+			expectedKeys: []string{ParameterKeyCapacityProvider, ParameterKeyManagedDraining},
+		},
+		"instance warmup without managed scaling": {
+			flags:         []string{flags.CapacityProviderFlag},
+			values:        map[string]string{flags.InstanceWarmupPeriodFlag: "300"},
+			expectedError: "--instance-warmup-period requires --managed-scaling",
+		},
+		"instance warmup out of range": {
+			flags:         []string{flags.CapacityProviderFlag, flags.ManagedScalingFlag},
+			values:        map[string]string{flags.InstanceWarmupPeriodFlag: "20000"},
+			expectedError: "Invalid value '20000' for '--instance-warmup-period'. The instance warmup period must be a number of seconds from 0 to 10000",
+		},
+		"managed draining and instance warmup": {
+			flags:        []string{flags.CapacityProviderFlag, flags.ManagedScalingFlag},
+			values:       map[string]string{flags.ManagedDrainingFlag: "true", flags.InstanceWarmupPeriodFlag: "300"},
+			expectedKeys: []string{ParameterKeyCapacityProvider, ParameterKeyManagedScaling, ParameterKeyManagedDraining, ParameterKeyInstanceWarmupPeriod},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+			for _, name := range []string{flags.CapacityProviderFlag, flags.ManagedScalingFlag, flags.ManagedTerminationFlag, flags.ManagedDrainingFlag} {
+				flagSet.Bool(name, false, "")
+			}
+			flagSet.Int(flags.InstanceWarmupPeriodFlag, 0, "")
+			for _, name := range tc.flags {
+				flagSet.Set(name, "true")
+			}
+			for name, value := range tc.values {
+				flagSet.Set(name, value)
+			}
+			cfnParams := cloudformation.NewCfnStackParams(nil)
+
+			err := addCapacityProviderParams(cli.NewContext(nil, flagSet, nil), cfnParams)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err, "Unexpected error adding capacity provider parameters")
+			var keys []string
+			for _, param := range cfnParams.Get() {
+				keys = append(keys, aws.StringValue(param.ParameterKey))
+			}
+			assert.Equal(t, tc.expectedKeys, keys)
+		})
+	}
+}
+
+func TestClusterUpWithVPC(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	vpcID := "vpc-02dd3038"
+	subnetIds := "subnet-04726b21,subnet-04346b21"
+
+	mocksForSuccessfulClusterUp(mockECS, mockCloudformation, mockSSM, mockEC2)
+	mocksForInstanceTypeOfferingsInSubnets(mockEC2, strings.Split(subnetIds, ","))
 
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
 	flagSet.String(flags.KeypairNameFlag, "default", "")
-	flagSet.Bool(flags.NoAutoAssignPublicIPAddressFlag, true, "")
+	flagSet.String(flags.VpcIdFlag, vpcID, "")
+	flagSet.String(flags.SubnetIdsFlag, subnetIds, "")
 
-	context := cli.NewContext(nil, flagSet, globalContext)
+	context := cli.NewContext(nil, flagSet, nil)
 	rdwr := newMockReadWriter()
 	commandConfig, err := newCommandConfig(context, rdwr)
 	assert.NoError(t, err, "Unexpected error creating CommandConfig")
@@ -223,20 +1118,13 @@ func TestClusterUpWithoutPublicIP(t *testing.T) {
 	assert.NoError(t, err, "Unexpected error bringing up cluster")
 }
 
-func TestClusterUpWithUserData(t *testing.T) {
+func TestClusterUpWithDefaultVPC(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
 	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
 
-	oldNewUserDataBuilder := newUserDataBuilder
-	defer func() { newUserDataBuilder = oldNewUserDataBuilder }()
-	userdataMock := &mockUserDataBuilder{
-		userdata: mockedUserData,
-	}
-	newUserDataBuilder = func(clusterName string, tags []*ecs.Tag) userdata.UserDataBuilder {
-		userdataMock.tags = tags
-		return userdataMock
-	}
+	vpcID := "vpc-02dd3038"
+	subnetIDs := []string{"subnet-04726b21", "subnet-04346b21"}
 
 	gomock.InOrder(
 		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
@@ -248,48 +1136,70 @@ func TestClusterUpWithUserData(t *testing.T) {
 
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
 			cfnParams := y.(*cloudformation.CfnStackParams)
-			param, err := cfnParams.GetParameter(ParameterKeyUserData)
-			assert.NoError(t, err, "Expected User Data parameter to be set")
-			assert.Equal(t, mockedUserData, aws.StringValue(param.ParameterValue), "Expected user data to match")
-			assert.Nil(t, userdataMock.tags, "Expected container instance tagging to be disabled")
+			param, err := cfnParams.GetParameter(ParameterKeyVpcId)
+			assert.NoError(t, err, "Expected VPC ID parameter to be set")
+			assert.Equal(t, vpcID, aws.StringValue(param.ParameterValue), "Expected VPC ID to match")
+			param, err = cfnParams.GetParameter(ParameterKeySubnetIds)
+			assert.NoError(t, err, "Expected subnet IDs parameter to be set")
+			assert.Equal(t, "subnet-04726b21,subnet-04346b21", aws.StringValue(param.ParameterValue), "Expected subnet IDs to match")
 		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
 	)
 
 	gomock.InOrder(
-		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+		mockEC2.EXPECT().GetDefaultVpc().Return(vpcID, nil),
+		mockEC2.EXPECT().GetDefaultSubnets(vpcID).Return(subnetIDs, nil),
 	)
-
-	globalSet := flag.NewFlagSet("ecs-cli", 0)
-	globalContext := cli.NewContext(nil, globalSet, nil)
+	mocksForInstanceTypeOfferingsInSubnets(mockEC2, subnetIDs)
 
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
 	flagSet.String(flags.KeypairNameFlag, "default", "")
-	userDataFiles := &cli.StringSlice{}
-	userDataFiles.Set("some_file")
-	userDataFiles.Set("some_file2")
-	flagSet.Var(userDataFiles, flags.UserDataFlag, "")
+	flagSet.Bool(flags.UseDefaultVpcFlag, true, "")
 
-	context := cli.NewContext(nil, flagSet, globalContext)
+	context := cli.NewContext(nil, flagSet, nil)
 	rdwr := newMockReadWriter()
 	commandConfig, err := newCommandConfig(context, rdwr)
 	assert.NoError(t, err, "Unexpected error creating CommandConfig")
 
 	err = createCluster(context, awsClients, commandConfig)
 	assert.NoError(t, err, "Unexpected error bringing up cluster")
+}
 
-	assert.ElementsMatch(t, []string{"some_file", "some_file2"}, userdataMock.files, "Expected userdata file list to match")
+func TestClusterUpWithDefaultVPCAndVPC(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.Bool(flags.UseDefaultVpcFlag, true, "")
+	flagSet.String(flags.VpcIdFlag, "vpc-02dd3038", "")
+	flagSet.String(flags.SubnetIdsFlag, "subnet-04726b21", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error for --use-default-vpc with a VPC")
 }
 
-func TestClusterUpWithSpotPrice(t *testing.T) {
+func TestClusterUpWithShareVPCFromStack(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
 	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
 
-	spotPrice := "0.03"
+	sourceStackName := "amazon-ecs-cli-setup-dev"
 
 	gomock.InOrder(
 		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
@@ -301,51 +1211,183 @@ func TestClusterUpWithSpotPrice(t *testing.T) {
 
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+		mockCloudformation.EXPECT().GetStackOutputs(sourceStackName).Return(map[string]string{
+			cloudformation.OutputKeyVpcId:           "vpc-02dd3038",
+			cloudformation.OutputKeySubnetIds:       "subnet-04726b21,subnet-04346b21",
+			cloudformation.OutputKeySecurityGroupId: "sg-c0ffeefe",
+			cloudformation.OutputKeyAsgName:         "asg",
+		}, nil),
+		mockCloudformation.EXPECT().GetStackExportNames(sourceStackName).Return(map[string]string{
+			cloudformation.OutputKeyVpcId: sourceStackName + "-VpcId",
+		}, nil),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
 			cfnParams := y.(*cloudformation.CfnStackParams)
-			param, err := cfnParams.GetParameter(ParameterKeySpotPrice)
-			assert.NoError(t, err, "Expected Spot Price parameter to be set")
-			assert.Equal(t, spotPrice, aws.StringValue(param.ParameterValue), "Expected spot price to match")
+			param, err := cfnParams.GetParameter(ParameterKeySharedVpcExportName)
+			assert.NoError(t, err, "Expected shared VPC export name parameter to be set")
+			assert.Equal(t, sourceStackName+"-VpcId", aws.StringValue(param.ParameterValue), "Expected shared VPC export name to match")
+			param, err = cfnParams.GetParameter(ParameterKeyVpcId)
+			assert.NoError(t, err, "Expected VPC ID parameter to be set")
+			assert.Equal(t, "vpc-02dd3038", aws.StringValue(param.ParameterValue), "Expected VPC ID to match")
+			param, err = cfnParams.GetParameter(ParameterKeySubnetIds)
+			assert.NoError(t, err, "Expected subnet IDs parameter to be set")
+			assert.Equal(t, "subnet-04726b21,subnet-04346b21", aws.StringValue(param.ParameterValue), "Expected subnet IDs to match")
+			param, err = cfnParams.GetParameter(ParameterKeySecurityGroup)
+			assert.NoError(t, err, "Expected security group parameter to be set")
+			assert.Equal(t, "sg-c0ffeefe", aws.StringValue(param.ParameterValue), "Expected security group to match")
 		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
 	)
 
+	mocksForInstanceTypeOfferingsInSubnets(mockEC2, []string{"subnet-04726b21", "subnet-04346b21"})
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.String(flags.ShareVpcFromStackFlag, sourceStackName, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error bringing up cluster")
+}
+
+func TestShareVpcFromStackWithoutOutputs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	sourceStackName := "amazon-ecs-cli-setup-dev"
+
 	gomock.InOrder(
-		mockEC2.EXPECT().DescribeInstanceTypeOfferings("us-west-1").Return([]string{"t2.micro"}, nil),
+		mockCloudformation.EXPECT().GetStackOutputs(sourceStackName).Return(map[string]string{}, nil),
+		mockCloudformation.EXPECT().GetStackResourceIds(sourceStackName).Return(map[string]string{
+			cloudformation.VPCLogicalResourceId:           "vpc-02dd3038",
+			cloudformation.Subnet1LogicalResourceId:       "subnet-04726b21",
+			cloudformation.Subnet2LogicalResourceId:       "subnet-04346b21",
+			cloudformation.SecurityGroupLogicalResourceId: "sg-c0ffeefe",
+			"EcsInstanceAsg":                              "asg",
+		}, nil),
 	)
 
-	globalSet := flag.NewFlagSet("ecs-cli", 0)
-	globalContext := cli.NewContext(nil, globalSet, nil)
+	cfnParams := cloudformation.NewCfnStackParams(RequiredParameters)
+	err := ShareVpcFromStack(cfnParams, mockCloudformation, sourceStackName)
+	assert.NoError(t, err, "Unexpected error sharing VPC from stack")
+
+	param, err := cfnParams.GetParameter(ParameterKeyVpcId)
+	assert.NoError(t, err, "Expected VPC ID parameter to be set")
+	assert.Equal(t, "vpc-02dd3038", aws.StringValue(param.ParameterValue), "Expected VPC ID to match")
+	param, err = cfnParams.GetParameter(ParameterKeySubnetIds)
+	assert.NoError(t, err, "Expected subnet IDs parameter to be set")
+	assert.Equal(t, "subnet-04726b21,subnet-04346b21", aws.StringValue(param.ParameterValue), "Expected subnet IDs to match")
+	param, err = cfnParams.GetParameter(ParameterKeySecurityGroup)
+	assert.NoError(t, err, "Expected security group parameter to be set")
+	assert.Equal(t, "sg-c0ffeefe", aws.StringValue(param.ParameterValue), "Expected security group to match")
+}
+
+func TestShareVpcFromStackWithExistingVPC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	sourceStackName := "amazon-ecs-cli-setup-dev"
+
+	// The source stack was itself launched into an existing VPC and security group
+	gomock.InOrder(
+		mockCloudformation.EXPECT().GetStackOutputs(sourceStackName).Return(map[string]string{}, nil),
+		mockCloudformation.EXPECT().GetStackResourceIds(sourceStackName).Return(map[string]string{"EcsInstanceAsg": "asg"}, nil),
+		mockCloudformation.EXPECT().GetStackParameters(sourceStackName).Return([]*sdkCFN.Parameter{
+			{ParameterKey: aws.String(ParameterKeyVpcId), ParameterValue: aws.String("vpc-02dd3038")},
+			{ParameterKey: aws.String(ParameterKeySubnetIds), ParameterValue: aws.String("subnet-04726b21,subnet-04346b21")},
+			{ParameterKey: aws.String(ParameterKeySecurityGroup), ParameterValue: aws.String("sg-c0ffeefe")},
+		}, nil),
+	)
+
+	cfnParams := cloudformation.NewCfnStackParams(RequiredParameters)
+	err := ShareVpcFromStack(cfnParams, mockCloudformation, sourceStackName)
+	assert.NoError(t, err, "Unexpected error sharing VPC from stack")
+
+	param, err := cfnParams.GetParameter(ParameterKeyVpcId)
+	assert.NoError(t, err, "Expected VPC ID parameter to be set")
+	assert.Equal(t, "vpc-02dd3038", aws.StringValue(param.ParameterValue), "Expected VPC ID to match")
+	param, err = cfnParams.GetParameter(ParameterKeySubnetIds)
+	assert.NoError(t, err, "Expected subnet IDs parameter to be set")
+	assert.Equal(t, "subnet-04726b21,subnet-04346b21", aws.StringValue(param.ParameterValue), "Expected subnet IDs to match")
+	param, err = cfnParams.GetParameter(ParameterKeySecurityGroup)
+	assert.NoError(t, err, "Expected security group parameter to be set")
+	assert.Equal(t, "sg-c0ffeefe", aws.StringValue(param.ParameterValue), "Expected security group to match")
+}
+
+func TestClusterUpWithShareVPCFromStackAndVPC(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+	)
 
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
 	flagSet.String(flags.KeypairNameFlag, "default", "")
-	flagSet.String(flags.SpotPriceFlag, spotPrice, "")
+	flagSet.String(flags.ShareVpcFromStackFlag, "amazon-ecs-cli-setup-dev", "")
+	flagSet.String(flags.VpcIdFlag, "vpc-02dd3038", "")
+	flagSet.String(flags.SubnetIdsFlag, "subnet-04726b21", "")
 
-	context := cli.NewContext(nil, flagSet, globalContext)
+	context := cli.NewContext(nil, flagSet, nil)
 	rdwr := newMockReadWriter()
 	commandConfig, err := newCommandConfig(context, rdwr)
 	assert.NoError(t, err, "Unexpected error creating CommandConfig")
 
 	err = createCluster(context, awsClients, commandConfig)
-	assert.NoError(t, err, "Unexpected error bringing up cluster")
+	assert.Error(t, err, "Expected error for --share-vpc-from-stack with a VPC")
 }
 
-func TestClusterUpWithVPC(t *testing.T) {
+func TestClusterUpWithCreateCapacityReservationAndPlacementGroup(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
 	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
 
-	vpcID := "vpc-02dd3038"
 	subnetIds := "subnet-04726b21,subnet-04346b21"
 
-	mocksForSuccessfulClusterUp(mockECS, mockCloudformation, mockSSM, mockEC2)
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("t2.micro").Return(amiMetadata(amiID), nil),
+	)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			cfnParams := y.(*cloudformation.CfnStackParams)
+			param, err := cfnParams.GetParameter(ParameterKeyCreateCapacityReservation)
+			assert.NoError(t, err, "Expected create capacity reservation parameter to be set")
+			assert.Equal(t, "true", aws.StringValue(param.ParameterValue))
+			param, err = cfnParams.GetParameter(ParameterKeyCapacityReservationAz)
+			assert.NoError(t, err, "Expected capacity reservation availability zone parameter to be set")
+			assert.Equal(t, "us-west-1a", aws.StringValue(param.ParameterValue), "Expected availability zone of the first subnet")
+			param, err = cfnParams.GetParameter(ParameterKeyPlacementGroupStrategy)
+			assert.NoError(t, err, "Expected placement group strategy parameter to be set")
+			assert.Equal(t, "cluster", aws.StringValue(param.ParameterValue))
+		}).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+
+	mocksForInstanceTypeOfferingsInSubnets(mockEC2, strings.Split(subnetIds, ","))
 
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
 	flagSet.String(flags.KeypairNameFlag, "default", "")
-	flagSet.String(flags.VpcIdFlag, vpcID, "")
+	flagSet.String(flags.VpcIdFlag, "vpc-02dd3038", "")
 	flagSet.String(flags.SubnetIdsFlag, subnetIds, "")
+	flagSet.Bool(flags.CreateCapacityReservationFlag, true, "")
+	flagSet.String(flags.PlacementGroupStrategyFlag, "cluster", "")
 
 	context := cli.NewContext(nil, flagSet, nil)
 	rdwr := newMockReadWriter()
@@ -356,6 +1398,110 @@ func TestClusterUpWithVPC(t *testing.T) {
 	assert.NoError(t, err, "Unexpected error bringing up cluster")
 }
 
+func TestClusterUpWithInvalidCapacityOptions(t *testing.T) {
+	testCases := map[string]func(flagSet *flag.FlagSet){
+		"existing and new capacity reservation": func(flagSet *flag.FlagSet) {
+			flagSet.String(flags.CapacityReservationIdFlag, "cr-0123456789abcdef0", "")
+			flagSet.Bool(flags.CreateCapacityReservationFlag, true, "")
+		},
+		"spot instances in capacity reservation": func(flagSet *flag.FlagSet) {
+			flagSet.String(flags.CapacityReservationIdFlag, "cr-0123456789abcdef0", "")
+			flagSet.String(flags.SpotPriceFlag, "0.03", "")
+		},
+		"invalid placement group strategy": func(flagSet *flag.FlagSet) {
+			flagSet.String(flags.PlacementGroupStrategyFlag, "partition", "")
+		},
+		"placement group with Fargate": func(flagSet *flag.FlagSet) {
+			flagSet.String(flags.PlacementGroupStrategyFlag, "spread", "")
+			flagSet.String(flags.LaunchTypeFlag, config.LaunchTypeFargate, "")
+		},
+	}
+
+	for name, addFlags := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer os.Clearenv()
+			mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+			awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+			mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error"))
+
+			flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+			flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+			flagSet.String(flags.KeypairNameFlag, "default", "")
+			addFlags(flagSet)
+
+			context := cli.NewContext(nil, flagSet, nil)
+			rdwr := newMockReadWriter()
+			commandConfig, err := newCommandConfig(context, rdwr)
+			assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+			err = createCluster(context, awsClients, commandConfig)
+			assert.Error(t, err, "Expected error for invalid capacity options")
+		})
+	}
+}
+
+func TestValidateTenancy(t *testing.T) {
+	testCases := []struct {
+		name          string
+		params        map[string]string
+		expectedError bool
+	}{
+		{"shared tenancy", map[string]string{}, false},
+		{"dedicated tenancy", map[string]string{ParameterKeyTenancy: "dedicated", ParameterKeySpotPrice: "0.03"}, false},
+		{"host tenancy with host resource group", map[string]string{ParameterKeyTenancy: "host", ParameterKeyHostResourceGroupArn: "arn:aws:resource-groups:us-west-2:123456789012:group/hosts"}, false},
+		{"invalid tenancy", map[string]string{ParameterKeyTenancy: "default"}, true},
+		{"host resource group without host tenancy", map[string]string{ParameterKeyTenancy: "dedicated", ParameterKeyHostResourceGroupArn: "arn:aws:resource-groups:us-west-2:123456789012:group/hosts"}, true},
+		{"host tenancy with spot instances", map[string]string{ParameterKeyTenancy: "host", ParameterKeySpotPrice: "0.03"}, true},
+		{"host tenancy with new capacity reservation", map[string]string{ParameterKeyTenancy: "host", ParameterKeyCreateCapacityReservation: "true"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfnParams := cloudformation.NewCfnStackParams(RequiredParameters)
+			for key, value := range tc.params {
+				cfnParams.Add(key, value)
+			}
+			err := validateTenancy(cfnParams)
+			if tc.expectedError {
+				assert.Error(t, err, "Expected error validating tenancy")
+			} else {
+				assert.NoError(t, err, "Unexpected error validating tenancy")
+			}
+		})
+	}
+}
+
+func TestValidateMetadataOptions(t *testing.T) {
+	testCases := map[string]struct {
+		hopLimit      string
+		expectedError bool
+	}{
+		"no hop limit":      {"", false},
+		"minimum hop limit": {"1", false},
+		"maximum hop limit": {"64", false},
+		"zero hop limit":    {"0", true},
+		"large hop limit":   {"65", true},
+		"invalid hop limit": {"two", true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cfnParams := cloudformation.NewCfnStackParams(RequiredParameters)
+			cfnParams.Add(ParameterKeyIsIMDSv2, "true")
+			if tc.hopLimit != "" {
+				cfnParams.Add(ParameterKeyMetadataHopLimit, tc.hopLimit)
+			}
+			err := validateMetadataOptions(cfnParams)
+			if tc.expectedError {
+				assert.Error(t, err, "Expected error validating metadata options")
+			} else {
+				assert.NoError(t, err, "Unexpected error validating metadata options")
+			}
+		})
+	}
+}
+
 func TestClusterUpWithAvailabilityZones(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
@@ -364,6 +1510,12 @@ func TestClusterUpWithAvailabilityZones(t *testing.T) {
 	vpcAZs := "us-west-2c,us-west-2a"
 
 	mocksForSuccessfulClusterUp(mockECS, mockCloudformation, mockSSM, mockEC2)
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferingsByAZ([]string{"us-west-2c", "us-west-2a"}).Return(map[string][]string{
+			"us-west-2c": {"t2.micro"},
+			"us-west-2a": {"t2.micro"},
+		}, nil),
+	)
 
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
@@ -512,6 +1664,7 @@ func TestClusterUpWith2SecurityGroups(t *testing.T) {
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
 
 	mocksForSuccessfulClusterUp(mockECS, mockCloudformation, mockSSM, mockEC2)
+	mocksForInstanceTypeOfferingsInSubnets(mockEC2, []string{"subnet-04726b21", "subnet-04346b21"})
 	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
 
 	securityGroupIds := "sg-eeaabc8d,sg-eaaebc8d"
@@ -680,8 +1833,9 @@ func TestClusterUpForImageIdInput_And_IMDSv2(t *testing.T) {
 
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
-			capabilityIAM := x.(bool)
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			capabilities := x.([]string)
 			cfnStackParams := y.(*cloudformation.CfnStackParams)
 			actualAMIID, err := cfnStackParams.GetParameter(ParameterKeyAmiId)
 			assert.NoError(t, err, "Expected image id params to be present")
@@ -689,7 +1843,7 @@ func TestClusterUpForImageIdInput_And_IMDSv2(t *testing.T) {
 			assert.NoError(t, err, "Expected IsIMDSv2 parameter to be present")
 
 			assert.Equal(t, imageID, aws.StringValue(actualAMIID.ParameterValue), "Expected image id to match")
-			assert.True(t, capabilityIAM, "Expected capability capabilityIAM to be true")
+			assert.Equal(t, []string{sdkCFN.CapabilityCapabilityIam}, capabilities, "Expected the IAM capability to be acknowledged")
 			assert.Equal(t, "true", aws.StringValue(actualIsIMDSv2.ParameterValue), "Expected IMDS v2 to be enabled")
 		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
@@ -738,6 +1892,8 @@ func TestClusterUpWithClusterNameEmpty(t *testing.T) {
 func TestClusterUpWithoutRegion(t *testing.T) {
 	defer os.Clearenv()
 	os.Unsetenv("AWS_REGION")
+	// not an EC2 instance either
+	os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
 
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 
@@ -760,7 +1916,8 @@ func TestClusterUpWithFargateLaunchTypeFlag(t *testing.T) {
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
 			cfnParams := y.(*cloudformation.CfnStackParams)
 			isFargate, err := cfnParams.GetParameter(ParameterKeyIsFargate)
 			assert.NoError(t, err, "Unexpected error getting cfn parameter")
@@ -804,13 +1961,14 @@ func TestClusterUpWithFargateDefaultLaunchTypeConfig(t *testing.T) {
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
-			capabilityIAM := x.(bool)
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			capabilities := x.([]string)
 			cfnParams := y.(*cloudformation.CfnStackParams)
 			isFargate, err := cfnParams.GetParameter(ParameterKeyIsFargate)
 			assert.NoError(t, err, "Unexpected error getting cfn parameter")
 			assert.Equal(t, "true", aws.StringValue(isFargate.ParameterValue), "Should have Fargate launch type.")
-			assert.True(t, capabilityIAM, "Expected capability capabilityIAM to be true")
+			assert.Equal(t, []string{sdkCFN.CapabilityCapabilityIam}, capabilities, "Expected the IAM capability to be acknowledged")
 		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
 		mockCloudformation.EXPECT().DescribeNetworkResources(stackName).Return(nil),
@@ -852,13 +2010,14 @@ func TestClusterUpWithFargateLaunchTypeFlagOverride(t *testing.T) {
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
-			capabilityIAM := x.(bool)
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			capabilities := x.([]string)
 			cfnParams := y.(*cloudformation.CfnStackParams)
 			isFargate, err := cfnParams.GetParameter(ParameterKeyIsFargate)
 			assert.NoError(t, err, "Unexpected error getting cfn parameter")
 			assert.Equal(t, "true", aws.StringValue(isFargate.ParameterValue), "Should have Fargate launch type.")
-			assert.True(t, capabilityIAM, "Expected capability capabilityIAM to be true")
+			assert.Equal(t, []string{sdkCFN.CapabilityCapabilityIam}, capabilities, "Expected the IAM capability to be acknowledged")
 		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
 		mockCloudformation.EXPECT().DescribeNetworkResources(stackName).Return(nil),
@@ -900,7 +2059,8 @@ func TestClusterUpWithEC2LaunchTypeFlagOverride(t *testing.T) {
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Return("", nil),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
 	)
 	globalSet := flag.NewFlagSet("ecs-cli", 0)
@@ -925,40 +2085,66 @@ func TestClusterUpWithBlankDefaultLaunchTypeConfig(t *testing.T) {
 		defaultLaunchType: "",
 	}
 
-	defer os.Clearenv()
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	gomock.InOrder(
+		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+	)
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Return("", nil),
+		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
+	)
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalContext := cli.NewContext(nil, globalSet, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, false, "")
+
+	context := cli.NewContext(nil, flagSet, globalContext)
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+
+	// This is kind of hack - this error will only get checked if launch type is EC2
+	assert.Error(t, err, "Expected error for bringing up cluster with empty default launch type.")
+}
+
+func TestClusterUpWithEmptyCluster(t *testing.T) {
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
 	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
 
 	gomock.InOrder(
 		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
 	)
+	gomock.InOrder(
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("x86").Return(amiMetadata(amiID), nil),
+	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Return("", nil),
-		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
 	)
-	globalSet := flag.NewFlagSet("ecs-cli", 0)
-	globalContext := cli.NewContext(nil, globalSet, nil)
 
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
-	flagSet.Bool(flags.CapabilityIAMFlag, false, "")
-
-	context := cli.NewContext(nil, flagSet, globalContext)
+	flagSet.Bool(flags.EmptyFlag, true, "")
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
 	commandConfig, err := newCommandConfig(context, rdwr)
 	assert.NoError(t, err, "Unexpected error creating CommandConfig")
 
 	err = createCluster(context, awsClients, commandConfig)
-
-	// This is kind of hack - this error will only get checked if launch type is EC2
-	assert.Error(t, err, "Expected error for bringing up cluster with empty default launch type.")
+	assert.NoError(t, err, "Unexpected error bringing up empty cluster")
 }
 
-func TestClusterUpWithEmptyCluster(t *testing.T) {
+func TestClusterUpWithEmptyClusterWithServiceConnectNamespace(t *testing.T) {
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
 	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
 
 	gomock.InOrder(
-		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
+		mockECS.EXPECT().CreateClusterWithServiceConnectNamespace(clusterName, gomock.Any(), "internal").Return(clusterName, nil),
 	)
 	gomock.InOrder(
 		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("x86").Return(amiMetadata(amiID), nil),
@@ -969,6 +2155,7 @@ func TestClusterUpWithEmptyCluster(t *testing.T) {
 
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 	flagSet.Bool(flags.EmptyFlag, true, "")
+	flagSet.String(flags.ServiceConnectNamespaceFlag, "internal", "")
 	context := cli.NewContext(nil, flagSet, nil)
 	rdwr := newMockReadWriter()
 	commandConfig, err := newCommandConfig(context, rdwr)
@@ -1003,6 +2190,71 @@ func TestClusterUpWithEmptyClusterWithExistingStack(t *testing.T) {
 	assert.Error(t, err, "Unexpected error bringing up empty cluster")
 }
 
+func TestClusterUpWithMissingServiceLinkedRole(t *testing.T) {
+	defer func() { promptInput = os.Stdin }()
+	testCases := map[string]struct {
+		getRoleErr    error
+		createFlag    bool
+		input         string
+		expectCreated bool
+		expectErr     bool
+	}{
+		"created with the flag": {
+			getRoleErr:    awserr.New("NoSuchEntity", "The role with name AWSServiceRoleForECS cannot be found.", nil),
+			createFlag:    true,
+			expectCreated: true,
+		},
+		"created when confirmed": {
+			getRoleErr:    awserr.New("NoSuchEntity", "The role with name AWSServiceRoleForECS cannot be found.", nil),
+			input:         "y\n",
+			expectCreated: true,
+		},
+		"not confirmed": {
+			getRoleErr: awserr.New("NoSuchEntity", "The role with name AWSServiceRoleForECS cannot be found.", nil),
+			input:      "\n",
+			expectErr:  true,
+		},
+		"role cannot be looked up": {
+			getRoleErr: awserr.New("AccessDenied", "User is not authorized to perform: iam:GetRole", nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer os.Clearenv()
+			mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+			mocksForSuccessfulClusterUp(mockECS, mockCloudformation, mockSSM, mockEC2)
+			awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockIAM := mock_iam.NewMockClient(ctrl)
+			newIAMClient = func(*config.CommandConfig) iamclient.Client { return mockIAM }
+			mockIAM.EXPECT().GetRole(ecsServiceLinkedRoleName).Return(nil, tc.getRoleErr)
+			if tc.expectCreated {
+				mockIAM.EXPECT().CreateServiceLinkedRole("ecs.amazonaws.com").Return(&iam.CreateServiceLinkedRoleOutput{}, nil)
+			}
+			promptInput = strings.NewReader(tc.input)
+
+			flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+			flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+			flagSet.Bool(flags.CreateServiceLinkedRoleFlag, tc.createFlag, "")
+
+			context := cli.NewContext(nil, flagSet, nil)
+			rdwr := newMockReadWriter()
+			commandConfig, err := newCommandConfig(context, rdwr)
+			assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+			err = createCluster(context, awsClients, commandConfig)
+			if tc.expectErr {
+				assert.Error(t, err, "Expected error bringing up cluster without the service-linked role")
+			} else {
+				assert.NoError(t, err, "Unexpected error bringing up cluster")
+			}
+		})
+	}
+}
+
 func TestClusterUpARM64(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
@@ -1018,13 +2270,14 @@ func TestClusterUpARM64(t *testing.T) {
 
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
-			capabilityIAM := x.(bool)
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			capabilities := x.([]string)
 			cfnParams := y.(*cloudformation.CfnStackParams)
 			amiIDParam, err := cfnParams.GetParameter(ParameterKeyAmiId)
 			assert.NoError(t, err, "Unexpected error getting cfn parameter")
 			assert.Equal(t, armAMIID, aws.StringValue(amiIDParam.ParameterValue), "Expected ami ID to be set to recommended for arm64")
-			assert.True(t, capabilityIAM, "Expected capability capabilityIAM to be true")
+			assert.Equal(t, []string{sdkCFN.CapabilityCapabilityIam}, capabilities, "Expected the IAM capability to be acknowledged")
 		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
 	)
@@ -1070,19 +2323,21 @@ func TestClusterUpWithUnsupportedInstanceType(t *testing.T) {
 
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
-			capabilityIAM := x.(bool)
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			capabilities := x.([]string)
 			cfnParams := y.(*cloudformation.CfnStackParams)
 			amiIDParam, err := cfnParams.GetParameter(ParameterKeyAmiId)
 			assert.NoError(t, err, "Unexpected error getting cfn parameter")
 			assert.Equal(t, armAMIID, aws.StringValue(amiIDParam.ParameterValue), "Expected ami ID to be set to recommended for arm64")
-			assert.True(t, capabilityIAM, "Expected capability capabilityIAM to be true")
+			assert.Equal(t, []string{sdkCFN.CapabilityCapabilityIam}, capabilities, "Expected the IAM capability to be acknowledged")
 		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
 	)
 
 	gomock.InOrder(
 		mockEC2.EXPECT().DescribeInstanceTypeOfferings(region).Return(supportedInstanceTypes, nil),
+		mockEC2.EXPECT().GetComparableInstanceTypes(instanceType).Return(nil, errors.New("some error")),
 	)
 
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
@@ -1099,6 +2354,53 @@ func TestClusterUpWithUnsupportedInstanceType(t *testing.T) {
 	assert.Equal(t, err, expectedError)
 }
 
+func TestClusterUpWithInstanceTypeUnsupportedInAvailabilityZone(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+
+	instanceType := "m5.large"
+	vpcAZs := "us-west-1a,us-west-1b"
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
+	)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypeOfferingsByAZ([]string{"us-west-1a", "us-west-1b"}).Return(map[string][]string{
+			"us-west-1a": {"m5.large", "m5a.large", "m5d.large", "m4.large", "t3.large"},
+			"us-west-1b": {"m5a.large", "m4.large", "m5d.large", "t3.large"},
+		}, nil),
+		mockEC2.EXPECT().GetComparableInstanceTypes(instanceType).Return([]string{"m4.large", "m5a.large", "m5ad.large", "m5d.large", "t3.large"}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.KeypairNameFlag, "default", "")
+	flagSet.String(flags.InstanceTypeFlag, instanceType, "")
+	flagSet.String(flags.VpcAzFlag, vpcAZs, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = createCluster(context, awsClients, commandConfig)
+	assert.EqualError(t, err, "instance type m5.large not supported in availability zones us-west-1b; comparable supported instance types: m4.large, m5a.large, m5d.large")
+}
+
+func TestSuggestInstanceTypesWithNoComparableTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+
+	mockEC2.EXPECT().GetComparableInstanceTypes("x1e.32xlarge").Return([]string{"x1.32xlarge"}, nil)
+
+	unsupportedErr := errors.New("unsupported")
+	err := suggestInstanceTypes(unsupportedErr, "x1e.32xlarge", []string{"t2.micro"}, mockEC2)
+	assert.Equal(t, unsupportedErr, err, "Expected error without suggestions")
+}
+
 func TestClusterUpWithTags(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
@@ -1147,7 +2449,8 @@ func TestClusterUpWithTags(t *testing.T) {
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
 			actualTags := z.([]*sdkCFN.Tag)
 			assert.ElementsMatch(t, expectedCFNTags, actualTags, "Expected tags to match")
 		}).Return("", nil),
@@ -1231,7 +2534,8 @@ func TestClusterUpWithTagsContainerInstanceTaggingEnabled(t *testing.T) {
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
 			actualTags := z.([]*sdkCFN.Tag)
 			assert.ElementsMatch(t, expectedCFNTags, actualTags, "Expected tags to match")
 
@@ -1291,6 +2595,168 @@ func TestClusterDown(t *testing.T) {
 	assert.NoError(t, err, "Unexpected error deleting cluster")
 }
 
+func blockedStackResources() []*sdkCFN.StackResource {
+	return []*sdkCFN.StackResource{
+		{
+			LogicalResourceId:  aws.String(cloudformation.VPCLogicalResourceId),
+			PhysicalResourceId: aws.String("vpc-1"),
+			ResourceType:       aws.String("AWS::EC2::VPC"),
+			ResourceStatus:     aws.String(sdkCFN.ResourceStatusDeleteFailed),
+		},
+		{
+			LogicalResourceId:  aws.String(cloudformation.SecurityGroupLogicalResourceId),
+			PhysicalResourceId: aws.String("sg-1"),
+			ResourceType:       aws.String("AWS::EC2::SecurityGroup"),
+			ResourceStatus:     aws.String(sdkCFN.ResourceStatusDeleteFailed),
+		},
+	}
+}
+
+func blockingENIs() []*ec2.NetworkInterface {
+	return []*ec2.NetworkInterface{
+		{
+			NetworkInterfaceId: aws.String("eni-available"),
+			Status:             aws.String(ec2.NetworkInterfaceStatusAvailable),
+		},
+		{
+			NetworkInterfaceId: aws.String("eni-attached"),
+			Status:             aws.String(ec2.NetworkInterfaceStatusInUse),
+			Attachment: &ec2.NetworkInterfaceAttachment{
+				AttachmentId: aws.String("eni-attach-1"),
+				DeviceIndex:  aws.Int64(1),
+				InstanceId:   aws.String("i-1"),
+			},
+		},
+		{
+			NetworkInterfaceId: aws.String("eni-managed"),
+			Status:             aws.String(ec2.NetworkInterfaceStatusInUse),
+			RequesterManaged:   aws.Bool(true),
+			Attachment:         &ec2.NetworkInterfaceAttachment{AttachmentId: aws.String("eni-attach-2")},
+		},
+	}
+}
+
+func TestClusterDownDeletesBlockingNetworkInterfaces(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+	defer os.Clearenv()
+
+	networkInterfaces := blockingENIs()
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(nil),
+		mockCloudformation.EXPECT().DeleteStack(stackName).Return(nil),
+		mockCloudformation.EXPECT().WaitUntilDeleteComplete(stackName).Return(errors.New("DELETE_FAILED")),
+		mockCloudformation.EXPECT().GetStackResources(stackName).Return(blockedStackResources(), nil),
+		mockEC2.EXPECT().DescribeNetworkInterfacesByFilter("vpc-id", "vpc-1").Return(networkInterfaces, nil),
+		mockEC2.EXPECT().DescribeNetworkInterfacesByFilter("group-id", "sg-1").Return(networkInterfaces[:1], nil),
+		mockEC2.EXPECT().DeleteNetworkInterface("eni-attached").Return(nil).After(
+			mockEC2.EXPECT().DetachNetworkInterface("eni-attached", "eni-attach-1").Return(nil)),
+		mockEC2.EXPECT().DeleteNetworkInterface("eni-available").Return(nil),
+		mockCloudformation.EXPECT().DeleteStack(stackName).Return(nil),
+		mockCloudformation.EXPECT().WaitUntilDeleteComplete(stackName).Return(nil),
+		mockECS.EXPECT().DeleteCluster(clusterName).Return(clusterName, nil),
+	)
+	flagSet := flag.NewFlagSet("ecs-cli-down", 0)
+	flagSet.Bool(flags.ForceFlag, true, "")
+	flagSet.Bool(flags.DeleteNetworkInterfacesFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = deleteCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error deleting cluster")
+}
+
+func TestClusterDownReportsBlockingNetworkInterfaces(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+	defer os.Clearenv()
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(nil),
+		mockCloudformation.EXPECT().DeleteStack(stackName).Return(nil),
+		mockCloudformation.EXPECT().WaitUntilDeleteComplete(stackName).Return(errors.New("DELETE_FAILED")),
+		mockCloudformation.EXPECT().GetStackResources(stackName).Return(blockedStackResources()[1:], nil),
+		mockEC2.EXPECT().DescribeNetworkInterfacesByFilter("group-id", "sg-1").Return(blockingENIs(), nil),
+	)
+	flagSet := flag.NewFlagSet("ecs-cli-down", 0)
+	flagSet.Bool(flags.ForceFlag, true, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = deleteCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error deleting cluster")
+	assert.Contains(t, err.Error(), flags.DeleteNetworkInterfacesFlag, "Expected error to suggest deleting the network interfaces")
+}
+
+func TestClusterDownWaitsForTasksToDrain(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+	defer os.Clearenv()
+	var slept []time.Duration
+	defer func() { sleep = time.Sleep }()
+	sleep = func(d time.Duration) { slept = append(slept, d) }
+
+	taskENIs := []*ec2.NetworkInterface{{NetworkInterfaceId: aws.String("eni-1")}}
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(nil),
+		mockECS.EXPECT().GetClusterTaskCounts(clusterName).Return(int64(1), int64(1), nil),
+		mockECS.EXPECT().GetClusterTaskCounts(clusterName).Return(int64(0), int64(0), nil),
+		mockEC2.EXPECT().DescribeNetworkInterfacesByFilter("tag:aws:ecs:clusterName", clusterName).Return(taskENIs, nil),
+		mockECS.EXPECT().GetClusterTaskCounts(clusterName).Return(int64(0), int64(0), nil),
+		mockEC2.EXPECT().DescribeNetworkInterfacesByFilter("tag:aws:ecs:clusterName", clusterName).Return(nil, nil),
+		mockCloudformation.EXPECT().DeleteStack(stackName).Return(nil),
+		mockCloudformation.EXPECT().WaitUntilDeleteComplete(stackName).Return(nil),
+		mockECS.EXPECT().DeleteCluster(clusterName).Return(clusterName, nil),
+	)
+	flagSet := flag.NewFlagSet("ecs-cli-down", 0)
+	flagSet.Bool(flags.ForceFlag, true, "")
+	flagSet.Float64(flags.DrainTimeoutFlag, 10, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = deleteCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error deleting cluster")
+	assert.Equal(t, []time.Duration{deleteDrainPollInterval, deleteDrainPollInterval}, slept, "Expected to wait until the tasks stopped and their network interfaces were released")
+}
+
+func TestClusterDownDrainTimeout(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+	defer os.Clearenv()
+	defer func() { sleep = time.Sleep }()
+	sleep = func(time.Duration) {}
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(nil),
+		mockECS.EXPECT().GetClusterTaskCounts(clusterName).Return(int64(2), int64(0), nil).Times(5),
+	)
+	flagSet := flag.NewFlagSet("ecs-cli-down", 0)
+	flagSet.Bool(flags.ForceFlag, true, "")
+	flagSet.Float64(flags.DrainTimeoutFlag, 1, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := newCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = deleteCluster(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error when the tasks of the cluster don't stop")
+	assert.Contains(t, err.Error(), flags.DrainTimeoutFlag, "Expected error to mention the drain timeout")
+}
+
 func TestClusterDownWithoutForce(t *testing.T) {
 	defer os.Clearenv()
 	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
@@ -1359,6 +2825,9 @@ func TestClusterScale(t *testing.T) {
 		&sdkCFN.Parameter{
 			ParameterKey: aws.String("SomeParam2"),
 		},
+		&sdkCFN.Parameter{
+			ParameterKey: aws.String(ParameterKeyLaunchTemplateVersion),
+		},
 	}
 
 	mockCloudformation.EXPECT().GetStackParameters(stackName).Return(existingParameters, nil)
@@ -1423,6 +2892,247 @@ func TestClusterScaleWithoutSize(t *testing.T) {
 	assert.Error(t, err, "Expected error scaling cluster when size is not specified")
 }
 
+func TestClusterScaleLaunchTemplateVersion(t *testing.T) {
+	testCases := map[string]struct {
+		version         string
+		expectedVersion string
+	}{
+		"pins a version":         {version: "3", expectedVersion: "3"},
+		"unpins with latest":     {version: "latest", expectedVersion: ""},
+		"unpins with empty flag": {version: "", expectedVersion: ""},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+			awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+			defer os.Clearenv()
+
+			mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil)
+			existingParameters := []*sdkCFN.Parameter{
+				{ParameterKey: aws.String(ParameterKeyAsgMaxSize)},
+				{ParameterKey: aws.String(ParameterKeyLaunchTemplateVersion)},
+			}
+			mockCloudformation.EXPECT().GetStackParameters(stackName).Return(existingParameters, nil)
+			mockCloudformation.EXPECT().UpdateStack(stackName, gomock.Any()).Do(func(_, y interface{}) {
+				cfnParams := y.(*cloudformation.CfnStackParams)
+				param, err := cfnParams.GetParameter(ParameterKeyAsgMaxSize)
+				assert.NoError(t, err)
+				assert.True(t, aws.BoolValue(param.UsePreviousValue), "Expected the size to be unchanged")
+				param, err = cfnParams.GetParameter(ParameterKeyLaunchTemplateVersion)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedVersion, aws.StringValue(param.ParameterValue))
+			}).Return("", nil)
+			mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil)
+
+			flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+			flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+			flagSet.String(flags.LaunchTemplateVersionFlag, "", "")
+			flagSet.Set(flags.LaunchTemplateVersionFlag, tc.version)
+
+			context := cli.NewContext(nil, flagSet, nil)
+			commandConfig, err := newCommandConfig(context, newMockReadWriter())
+			assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+			err = scaleCluster(context, awsClients, commandConfig)
+			assert.NoError(t, err, "Unexpected error scaling cluster")
+		})
+	}
+}
+
+func TestClusterScaleLaunchConfigurationStack(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+	defer os.Clearenv()
+
+	mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil)
+	existingParameters := []*sdkCFN.Parameter{
+		{ParameterKey: aws.String(ParameterKeyAsgMaxSize)},
+		{ParameterKey: aws.String(ParameterKeyInstanceType)},
+	}
+	mockCloudformation.EXPECT().GetStackParameters(stackName).Return(existingParameters, nil)
+	mockCloudformation.EXPECT().DescribeStacks(stackName).Return(&sdkCFN.DescribeStacksOutput{
+		Stacks: []*sdkCFN.Stack{
+			{Tags: []*sdkCFN.Tag{{Key: aws.String("team"), Value: aws.String("firmware")}}},
+		},
+	}, nil)
+	mockCloudformation.EXPECT().GetStackResourceIds(stackName).Return(map[string]string{
+		"Vpc":            "vpc-1",
+		"EcsInstanceLc":  "lc-1",
+		"EcsInstanceAsg": "asg-1",
+	}, nil)
+	mockCloudformation.EXPECT().UpdateStackWithTemplate(stackName, gomock.Any(), gomock.Any()).Do(func(_, x, y interface{}) {
+		template, err := cloudformation.ParseTemplate(x.(string))
+		assert.NoError(t, err, "Unexpected error parsing the template")
+		assert.Contains(t, template.Resources, "EcsInstanceLt")
+		assert.NotContains(t, template.Resources, cloudformation.LaunchConfigurationLogicalResourceId)
+		assert.Equal(t, []interface{}{map[string]interface{}{"Key": "team", "Value": "firmware"}}, template.Resources["Vpc"].Properties["Tags"])
+
+		cfnParams := y.(*cloudformation.CfnStackParams)
+		param, err := cfnParams.GetParameter(ParameterKeyInstanceType)
+		assert.NoError(t, err)
+		assert.True(t, aws.BoolValue(param.UsePreviousValue), "Expected the instance type to be unchanged")
+		param, err = cfnParams.GetParameter(ParameterKeyLaunchTemplateVersion)
+		assert.NoError(t, err)
+		assert.Equal(t, "2", aws.StringValue(param.ParameterValue))
+	}).Return("", nil)
+	mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.LaunchTemplateVersionFlag, "2", "")
+	flagSet.Set(flags.LaunchTemplateVersionFlag, "2")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = scaleCluster(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error scaling cluster")
+}
+
+func TestClusterScaleOlderStackWithDroppedResources(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+	defer os.Clearenv()
+
+	mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil)
+	existingParameters := []*sdkCFN.Parameter{
+		{ParameterKey: aws.String(ParameterKeyAsgMaxSize)},
+	}
+	mockCloudformation.EXPECT().GetStackParameters(stackName).Return(existingParameters, nil)
+	mockCloudformation.EXPECT().DescribeStacks(stackName).Return(&sdkCFN.DescribeStacksOutput{
+		Stacks: []*sdkCFN.Stack{{}},
+	}, nil)
+	mockCloudformation.EXPECT().GetStackResourceIds(stackName).Return(map[string]string{
+		"EcsInstanceLt":                  "lt-1",
+		"EcsInstanceAsg":                 "asg-1",
+		"EcsInstanceAsgScheduledAction1": "action-1",
+	}, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.AsgMaxSizeFlag, "3", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig, err := newCommandConfig(context, newMockReadWriter())
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = scaleCluster(context, awsClients, commandConfig)
+	assert.EqualError(t, err, "The CloudFormation stack for cluster 'defaultCluster' was created by an older version of the ECS CLI, and updating it would delete EcsInstanceAsgScheduledAction1. Please recreate it with 'ecs-cli up --force'")
+}
+
+func TestClusterScaleCapacityProvider(t *testing.T) {
+	capacityProviderParameters := func(capacityProvider, managedScaling string) []*sdkCFN.Parameter {
+		return []*sdkCFN.Parameter{
+			{ParameterKey: aws.String(ParameterKeyAsgMaxSize), ParameterValue: aws.String("3")},
+			{ParameterKey: aws.String(ParameterKeyLaunchTemplateVersion), ParameterValue: aws.String("")},
+			{ParameterKey: aws.String(ParameterKeyCapacityProvider), ParameterValue: aws.String(capacityProvider)},
+			{ParameterKey: aws.String(ParameterKeyManagedScaling), ParameterValue: aws.String(managedScaling)},
+			{ParameterKey: aws.String(ParameterKeyManagedDraining), ParameterValue: aws.String("")},
+			{ParameterKey: aws.String(ParameterKeyInstanceWarmupPeriod), ParameterValue: aws.String("")},
+		}
+	}
+	testCases := map[string]struct {
+		existingParameters []*sdkCFN.Parameter
+		values             map[string]string
+		expectedDraining   string
+		expectedWarmup     string
+		expectedError      string
+	}{
+		"disables managed draining": {
+			existingParameters: capacityProviderParameters("true", "DISABLED"),
+			values:             map[string]string{flags.ManagedDrainingFlag: "false"},
+			expectedDraining:   ecs.ManagedDrainingDisabled,
+		},
+		"enables managed draining and sets the instance warmup": {
+			existingParameters: capacityProviderParameters("true", "ENABLED"),
+			values:             map[string]string{flags.ManagedDrainingFlag: "true", flags.InstanceWarmupPeriodFlag: "120"},
+			expectedDraining:   ecs.ManagedDrainingEnabled,
+			expectedWarmup:     "120",
+		},
+		"no capacity provider": {
+			existingParameters: capacityProviderParameters("false", "DISABLED"),
+			values:             map[string]string{flags.ManagedDrainingFlag: "true"},
+			expectedError:      "Cluster 'defaultCluster' has no capacity provider to use '--managed-draining'. Please recreate it with 'ecs-cli up --capacity-provider --force'",
+		},
+		"instance warmup without managed scaling": {
+			existingParameters: capacityProviderParameters("true", "DISABLED"),
+			values:             map[string]string{flags.InstanceWarmupPeriodFlag: "120"},
+			expectedError:      "--instance-warmup-period requires the managed scaling of the capacity provider of cluster 'defaultCluster'. Please recreate it with 'ecs-cli up --capacity-provider --managed-scaling --force'",
+		},
+		"launch configuration stack": {
+			existingParameters: []*sdkCFN.Parameter{{ParameterKey: aws.String(ParameterKeyAsgMaxSize)}},
+			values:             map[string]string{flags.ManagedDrainingFlag: "true"},
+			expectedError:      "Cluster 'defaultCluster' has no capacity provider to use '--managed-draining'. Please recreate it with 'ecs-cli up --capacity-provider --force'",
+		},
+		"older stack": {
+			existingParameters: []*sdkCFN.Parameter{
+				{ParameterKey: aws.String(ParameterKeyAsgMaxSize)},
+				{ParameterKey: aws.String(ParameterKeyCapacityProvider), ParameterValue: aws.String("true")},
+			},
+			values:        map[string]string{flags.ManagedDrainingFlag: "true"},
+			expectedError: "The CloudFormation stack for cluster 'defaultCluster' was created by an older version of the ECS CLI and cannot use '--managed-draining'. Please recreate it with 'ecs-cli up --force'",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+			awsClients := &AWSClients{mockECS, mockCloudformation, mockSSM, mockEC2}
+			defer os.Clearenv()
+
+			mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil)
+			mockCloudformation.EXPECT().GetStackParameters(stackName).Return(tc.existingParameters, nil)
+			if tc.expectedError == "" {
+				mockCloudformation.EXPECT().UpdateStack(stackName, gomock.Any()).Do(func(_, y interface{}) {
+					cfnParams := y.(*cloudformation.CfnStackParams)
+					param, err := cfnParams.GetParameter(ParameterKeyAsgMaxSize)
+					assert.NoError(t, err)
+					assert.True(t, aws.BoolValue(param.UsePreviousValue), "Expected the size to be unchanged")
+					param, err = cfnParams.GetParameter(ParameterKeyManagedDraining)
+					assert.NoError(t, err)
+					assert.Equal(t, tc.expectedDraining, aws.StringValue(param.ParameterValue))
+					param, err = cfnParams.GetParameter(ParameterKeyInstanceWarmupPeriod)
+					assert.NoError(t, err)
+					if tc.expectedWarmup == "" {
+						assert.True(t, aws.BoolValue(param.UsePreviousValue), "Expected the instance warmup period to be unchanged")
+					} else {
+						assert.Equal(t, tc.expectedWarmup, aws.StringValue(param.ParameterValue))
+					}
+				}).Return("", nil)
+				mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil)
+			}
+
+			flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+			flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+			flagSet.Bool(flags.ManagedDrainingFlag, false, "")
+			flagSet.Int(flags.InstanceWarmupPeriodFlag, 0, "")
+			for name, value := range tc.values {
+				flagSet.Set(name, value)
+			}
+
+			context := cli.NewContext(nil, flagSet, nil)
+			commandConfig, err := newCommandConfig(context, newMockReadWriter())
+			assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+			err = scaleCluster(context, awsClients, commandConfig)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err, "Unexpected error scaling cluster")
+		})
+	}
+}
+
+func TestGetLaunchTemplateVersion(t *testing.T) {
+	for _, version := range []string{"0", "-1", "$Latest", "1.5"} {
+		flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+		flagSet.String(flags.LaunchTemplateVersionFlag, version, "")
+		_, err := getLaunchTemplateVersion(cli.NewContext(nil, flagSet, nil))
+		assert.Error(t, err, "Expected error for launch template version %s", version)
+	}
+}
+
 /////////////////
 // Cluster PS //
 ////////////////
@@ -1451,6 +3161,134 @@ func TestClusterPSTaskGetInfoFail(t *testing.T) {
 	assert.Error(t, err, "Expected error in cluster ps")
 }
 
+func TestDisplayStackOutputs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	mockCloudformation.EXPECT().GetStackOutputs(stackName).Return(map[string]string{
+		cloudformation.OutputKeyVpcId:           "vpc-1",
+		cloudformation.OutputKeySubnetIds:       "subnet-1,subnet-2",
+		cloudformation.OutputKeySecurityGroupId: "sg-1",
+	}, nil)
+
+	out := new(bytes.Buffer)
+	err := DisplayStackOutputs(out, mockCloudformation, stackName, true)
+	assert.NoError(t, err, "Unexpected error displaying stack outputs")
+
+	expected := `VpcId: vpc-1
+SubnetIds: subnet-1,subnet-2
+SecurityGroupId: sg-1
+
+Network configuration for tasks with task networking, in an ECS params file (ecs-params.yml):
+
+version: 1
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets:
+      - subnet-1
+      - subnet-2
+      security_groups:
+      - sg-1
+      assign_public_ip: ENABLED
+
+or as an 'aws ecs run-task' option:
+
+--network-configuration "awsvpcConfiguration={subnets=[subnet-1,subnet-2],securityGroups=[sg-1],assignPublicIp=ENABLED}"
+`
+	assert.Equal(t, expected, out.String())
+}
+
+func TestDisplayStackOutputsWithoutSubnets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	mockCloudformation.EXPECT().GetStackOutputs(stackName).Return(map[string]string{
+		cloudformation.OutputKeyAsgName: "asg",
+	}, nil)
+
+	out := new(bytes.Buffer)
+	err := DisplayStackOutputs(out, mockCloudformation, stackName, false)
+	assert.NoError(t, err, "Unexpected error displaying stack outputs")
+	assert.Equal(t, "AsgName: asg\n", out.String())
+}
+
+func TestClusterStacks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+
+	commandConfig := &config.CommandConfig{
+		Cluster:      "dev",
+		CFNStackName: "amazon-ecs-cli-setup-dev",
+	}
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().DescribeStacksWithNamePrefix(
+			"amazon-ecs-cli-setup-dev",
+			"amazon-ecs-cli-setup-private-dns-namespace-dev-",
+			"amazon-ecs-cli-setup-service-discovery-service-dev-",
+		).Return([]*sdkCFN.Stack{
+			{
+				StackName:   aws.String("amazon-ecs-cli-setup-dev"),
+				StackStatus: aws.String(sdkCFN.StackStatusCreateComplete),
+				Parameters: []*sdkCFN.Parameter{
+					{ParameterKey: aws.String(ParameterKeySharedVpcExportName), ParameterValue: aws.String("amazon-ecs-cli-setup-shared-VpcId")},
+				},
+				Outputs: []*sdkCFN.Output{
+					{OutputKey: aws.String(cloudformation.OutputKeyVpcId), ExportName: aws.String("amazon-ecs-cli-setup-dev-VpcId")},
+					{OutputKey: aws.String(cloudformation.OutputKeySubnetIds), ExportName: aws.String("amazon-ecs-cli-setup-dev-SubnetIds")},
+					{OutputKey: aws.String(cloudformation.OutputKeyAsgName)},
+				},
+			},
+			{
+				// cluster stack of another cluster whose name starts with the cluster name
+				StackName:   aws.String("amazon-ecs-cli-setup-dev2"),
+				StackStatus: aws.String(sdkCFN.StackStatusCreateComplete),
+			},
+			{
+				StackName:   aws.String("amazon-ecs-cli-setup-private-dns-namespace-dev-web"),
+				StackStatus: aws.String(sdkCFN.StackStatusCreateComplete),
+			},
+		}, nil),
+		mockCloudformation.EXPECT().ListImports("amazon-ecs-cli-setup-dev-VpcId").Return([]string{"amazon-ecs-cli-setup-test"}, nil),
+		mockCloudformation.EXPECT().ListImports("amazon-ecs-cli-setup-dev-SubnetIds").Return(nil, nil),
+	)
+
+	stacks, err := clusterStacks(mockCloudformation, commandConfig)
+	assert.NoError(t, err, "Unexpected error listing cluster stacks")
+	assert.Equal(t, []*stackInfo{
+		{
+			Name:      "amazon-ecs-cli-setup-dev",
+			Status:    sdkCFN.StackStatusCreateComplete,
+			DependsOn: []string{"amazon-ecs-cli-setup-shared"},
+			UsedBy:    []string{"amazon-ecs-cli-setup-test"},
+		},
+		{
+			Name:   "amazon-ecs-cli-setup-private-dns-namespace-dev-web",
+			Status: sdkCFN.StackStatusCreateComplete,
+		},
+	}, stacks)
+
+	out := &bytes.Buffer{}
+	printStacks(out, stacks)
+	assert.Contains(t, out.String(), "amazon-ecs-cli-setup-shared", "Expected dependency to be printed")
+	assert.Contains(t, out.String(), "amazon-ecs-cli-setup-test", "Expected dependent stack to be printed")
+}
+
+func TestStackStatus(t *testing.T) {
+	_, mockCloudformation, _, _ := setupTest(t)
+	mockCloudformation.EXPECT().DescribeStacks(stackName).Return(&sdkCFN.DescribeStacksOutput{
+		Stacks: []*sdkCFN.Stack{{StackStatus: aws.String(sdkCFN.StackStatusCreateInProgress)}},
+	}, nil)
+
+	status, err := stackStatus(mockCloudformation, stackName)
+	assert.NoError(t, err, "Unexpected error describing the stack status")
+	assert.Equal(t, "The CloudFormation stack 'defaultCluster' is CREATE_IN_PROGRESS. Follow it with 'aws cloudformation describe-stack-events --stack-name defaultCluster'.", status)
+}
+
 /////////////////////
 // private methods //
 /////////////////////
@@ -1464,6 +3302,16 @@ func amiMetadata(imageID string) *amimetadata.AMIMetadata {
 	}
 }
 
+func mocksForInstanceTypeOfferingsInSubnets(mockEC2 *mock_ec2.MockEC2Client, subnetIds []string) {
+	gomock.InOrder(
+		mockEC2.EXPECT().GetSubnetAvailabilityZones(subnetIds).Return([]string{"us-west-1a", "us-west-1b"}, nil),
+		mockEC2.EXPECT().DescribeInstanceTypeOfferingsByAZ([]string{"us-west-1a", "us-west-1b"}).Return(map[string][]string{
+			"us-west-1a": {"t2.micro"},
+			"us-west-1b": {"t2.micro"},
+		}, nil),
+	)
+}
+
 func mocksForSuccessfulClusterUp(mockECS *mock_ecs.MockECSClient, mockCloudformation *mock_cloudformation.MockCloudformationClient, mockSSM *mock_amimetadata.MockClient, mockEC2 *mock_ec2.MockEC2Client) {
 	gomock.InOrder(
 		mockECS.EXPECT().CreateCluster(clusterName, gomock.Any()).Return(clusterName, nil),
@@ -1473,7 +3321,8 @@ func mocksForSuccessfulClusterUp(mockECS *mock_ecs.MockECSClient, mockCloudforma
 	)
 	gomock.InOrder(
 		mockCloudformation.EXPECT().ValidateStackExists(stackName).Return(errors.New("error")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, true, gomock.Any(), gomock.Any()).Return("", nil),
+		mockCloudformation.EXPECT().ValidateTemplate(gomock.Any(), []string{sdkCFN.CapabilityCapabilityIam}).Return(nil),
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), stackName, []string{sdkCFN.CapabilityCapabilityIam}, gomock.Any(), gomock.Any()).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(stackName).Return(nil),
 	)
 	gomock.InOrder(
@@ -0,0 +1,156 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/dynamodb"
+	stsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// make the stack inventory easily mockable in tests
+var recordStack dynamodb.RecordStackFunc = dynamodb.RecordStack
+var listStacks dynamodb.ListStacksFunc = dynamodb.ListStacks
+var newSTSClient = stsclient.NewClient
+
+// inventoryOutput is where the inventory is printed; can be replaced in tests
+var inventoryOutput io.Writer = os.Stdout
+
+// unrecordedFlags are the flags whose values are not recorded in the inventory, because they are credentials
+var unrecordedFlags = map[string]bool{
+	flags.AccessKeyFlag:    true,
+	flags.SecretKeyFlag:    true,
+	flags.SessionTokenFlag: true,
+}
+
+// ClusterInventory prints the stacks recorded in the inventory table
+func ClusterInventory(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'inventory': ", err)
+	}
+
+	commandConfig, err := newCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'inventory': ", err)
+	}
+
+	if err := inventory(c, commandConfig); err != nil {
+		logrus.Fatal("Error executing 'inventory': ", err)
+	}
+}
+
+func inventory(context *cli.Context, commandConfig *config.CommandConfig) error {
+	table := commandConfig.InventoryTable
+	if table == "" {
+		return fmt.Errorf("No inventory table is configured. Specify it with --%s, or configure it for the cluster with 'ecs-cli configure --%s'", flags.InventoryTableFlag, flags.InventoryTableFlag)
+	}
+	records, err := listStacks(table, commandConfig)
+	if err != nil {
+		return err
+	}
+
+	var matches []*dynamodb.StackRecord
+	for _, record := range records {
+		if record.Status == dynamodb.StackStatusDeleted && !context.Bool(flags.IncludeDeletedFlag) {
+			continue
+		}
+		matches = append(matches, record)
+	}
+	printInventory(inventoryOutput, matches)
+	return nil
+}
+
+func printInventory(out io.Writer, records []*dynamodb.StackRecord) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "STACK NAME\tCLUSTER\tREGION\tSTATUS\tOPERATION\tMODIFIED AT\tMODIFIED BY\tPARAMETERS")
+	for _, record := range records {
+		modifiedAt := "-"
+		if !record.ModifiedAt.IsZero() {
+			modifiedAt = record.ModifiedAt.Format(time.RFC3339)
+		}
+		modifiedBy := record.ModifiedBy
+		if modifiedBy == "" {
+			modifiedBy = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", record.StackName, record.Cluster, record.Region, record.Status,
+			record.Operation, modifiedAt, modifiedBy, joinOrDash(formatParameters(record.Parameters)))
+	}
+	w.Flush()
+}
+
+// formatParameters returns the parameters as name=value pairs, sorted by name
+func formatParameters(parameters map[string]string) []string {
+	var pairs []string
+	for name, value := range parameters {
+		pairs = append(pairs, name+"="+value)
+	}
+	sort.Strings(pairs)
+	return pairs
+}
+
+// RecordClusterStack records the stack in the inventory table, if one is configured. The stack
+// operation already completed, so an error is only logged.
+func RecordClusterStack(context *cli.Context, commandConfig *config.CommandConfig, operation, stackName, cluster, status string) {
+	table := commandConfig.InventoryTable
+	if table == "" {
+		return
+	}
+	record := &dynamodb.StackRecord{
+		StackName:  stackName,
+		Cluster:    cluster,
+		Region:     commandConfig.Region(),
+		ModifiedAt: now().UTC(),
+		Operation:  operation,
+		Status:     status,
+		Parameters: commandFlags(context),
+	}
+	callerArn, err := newSTSClient(commandConfig).GetCallerArn()
+	if err != nil {
+		logrus.Warnf("Error getting the caller identity to record in the inventory: %s", err)
+	} else {
+		record.ModifiedBy = callerArn
+		if parsed, err := arn.Parse(callerArn); err == nil {
+			record.Account = parsed.AccountID
+		}
+	}
+	if err := recordStack(table, record, commandConfig); err != nil {
+		logrus.Warnf("Error recording the stack '%s' in the inventory table '%s': %s", stackName, table, err)
+		return
+	}
+	logrus.Debugf("Recorded the stack '%s' in the inventory table '%s'", stackName, table)
+}
+
+// commandFlags returns the values of the flags set on the command line, except credentials
+func commandFlags(context *cli.Context) map[string]string {
+	parameters := make(map[string]string)
+	for _, name := range context.FlagNames() {
+		if unrecordedFlags[name] || !context.IsSet(name) {
+			continue
+		}
+		parameters[name] = fmt.Sprint(context.Generic(name))
+	}
+	return parameters
+}
@@ -0,0 +1,209 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/dynamodb"
+	stsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts"
+	mock_sts "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func inventoryCommandConfig(table string) *config.CommandConfig {
+	return &config.CommandConfig{
+		Cluster:        "dev",
+		CFNStackName:   "amazon-ecs-cli-setup-dev",
+		InventoryTable: table,
+		Session:        session.Must(session.NewSession(&aws.Config{Region: aws.String("us-west-2")})),
+	}
+}
+
+// scaleContext returns the context of 'ecs-cli scale --size 4 --capability-iam --secret-key secret'
+func scaleContext(t *testing.T) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+	flagSet.String(flags.AsgMaxSizeFlag, "", "")
+	flagSet.Bool(flags.CapabilityIAMFlag, false, "")
+	flagSet.String(flags.SecretKeyFlag, "", "")
+	flagSet.String(flags.InstanceTypeFlag, "", "")
+	require.NoError(t, flagSet.Parse([]string{"--" + flags.AsgMaxSizeFlag, "4", "--" + flags.CapabilityIAMFlag, "--" + flags.SecretKeyFlag, "secret"}))
+	context := cli.NewContext(nil, flagSet, nil)
+	context.Command = cli.Command{
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: flags.AsgMaxSizeFlag},
+			cli.BoolFlag{Name: flags.CapabilityIAMFlag},
+			cli.StringFlag{Name: flags.SecretKeyFlag},
+			cli.StringFlag{Name: flags.InstanceTypeFlag},
+		},
+	}
+	return context
+}
+
+func TestRecordClusterStack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSTS := mock_sts.NewMockClient(ctrl)
+	mockSTS.EXPECT().GetCallerArn().Return("arn:aws:sts::123456789012:assumed-role/platform/alice", nil)
+	newSTSClient = func(*config.CommandConfig) stsclient.Client { return mockSTS }
+	defer func() { newSTSClient = stsclient.NewClient }()
+	now = func() time.Time { return time.Date(2020, 5, 1, 12, 30, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	var table string
+	var record *dynamodb.StackRecord
+	recordStack = func(t string, r *dynamodb.StackRecord, config *config.CommandConfig) error {
+		table = t
+		record = r
+		return nil
+	}
+	defer func() { recordStack = dynamodb.RecordStack }()
+
+	commandConfig := inventoryCommandConfig("inventory")
+	RecordClusterStack(scaleContext(t), commandConfig, "scale", commandConfig.CFNStackName, commandConfig.Cluster, dynamodb.StackStatusActive)
+
+	assert.Equal(t, "inventory", table, "Expected inventory table to match")
+	require.NotNil(t, record, "Expected stack to be recorded")
+	assert.Equal(t, &dynamodb.StackRecord{
+		StackName:  "amazon-ecs-cli-setup-dev",
+		Cluster:    "dev",
+		Region:     "us-west-2",
+		Account:    "123456789012",
+		ModifiedBy: "arn:aws:sts::123456789012:assumed-role/platform/alice",
+		ModifiedAt: time.Date(2020, 5, 1, 12, 30, 0, 0, time.UTC),
+		Operation:  "scale",
+		Status:     dynamodb.StackStatusActive,
+		Parameters: map[string]string{
+			flags.AsgMaxSizeFlag:    "4",
+			flags.CapabilityIAMFlag: "true",
+		},
+	}, record, "Expected the flags set on the command line, except credentials, to be recorded")
+}
+
+func TestRecordClusterStackWithoutInventoryTable(t *testing.T) {
+	recordStack = func(string, *dynamodb.StackRecord, *config.CommandConfig) error {
+		t.Fatal("Expected no stack to be recorded")
+		return nil
+	}
+	defer func() { recordStack = dynamodb.RecordStack }()
+
+	commandConfig := inventoryCommandConfig("")
+	RecordClusterStack(scaleContext(t), commandConfig, "scale", commandConfig.CFNStackName, commandConfig.Cluster, dynamodb.StackStatusActive)
+}
+
+func TestRecordClusterStackWithoutCallerIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSTS := mock_sts.NewMockClient(ctrl)
+	mockSTS.EXPECT().GetCallerArn().Return("", errors.New("AccessDenied"))
+	newSTSClient = func(*config.CommandConfig) stsclient.Client { return mockSTS }
+	defer func() { newSTSClient = stsclient.NewClient }()
+
+	var record *dynamodb.StackRecord
+	recordStack = func(t string, r *dynamodb.StackRecord, config *config.CommandConfig) error {
+		record = r
+		return errors.New("ResourceNotFoundException")
+	}
+	defer func() { recordStack = dynamodb.RecordStack }()
+
+	commandConfig := inventoryCommandConfig("inventory")
+	RecordClusterStack(scaleContext(t), commandConfig, "down", commandConfig.CFNStackName, commandConfig.Cluster, dynamodb.StackStatusDeleted)
+
+	require.NotNil(t, record, "Expected stack to be recorded without the caller")
+	assert.Empty(t, record.ModifiedBy, "Expected unknown caller")
+	assert.Empty(t, record.Account, "Expected unknown account")
+	assert.Equal(t, dynamodb.StackStatusDeleted, record.Status, "Expected status to match")
+}
+
+func TestInventory(t *testing.T) {
+	listStacks = func(table string, config *config.CommandConfig) ([]*dynamodb.StackRecord, error) {
+		assert.Equal(t, "inventory", table, "Expected inventory table to match")
+		return []*dynamodb.StackRecord{
+			{
+				StackName:  "amazon-ecs-cli-setup-staging",
+				Cluster:    "staging",
+				Region:     "eu-west-1",
+				Operation:  "down",
+				Status:     dynamodb.StackStatusDeleted,
+				ModifiedAt: time.Date(2020, 4, 2, 9, 0, 0, 0, time.UTC),
+			},
+			{
+				StackName:  "amazon-ecs-cli-setup-prod",
+				Cluster:    "prod",
+				Region:     "us-west-2",
+				ModifiedBy: "arn:aws:sts::123456789012:assumed-role/platform/alice",
+				Operation:  "scale",
+				Status:     dynamodb.StackStatusActive,
+				ModifiedAt: time.Date(2020, 5, 1, 12, 30, 0, 0, time.UTC),
+				Parameters: map[string]string{"size": "4", "capability-iam": "true"},
+			},
+		}, nil
+	}
+	defer func() { listStacks = dynamodb.ListStacks }()
+
+	testCases := map[string]struct {
+		includeDeleted bool
+		expected       []string
+		notExpected    []string
+	}{
+		"active stacks": {
+			expected:    []string{"amazon-ecs-cli-setup-prod", "2020-05-01T12:30:00Z", "assumed-role/platform/alice", "capability-iam=true,size=4"},
+			notExpected: []string{"amazon-ecs-cli-setup-staging"},
+		},
+		"with deleted stacks": {
+			includeDeleted: true,
+			expected:       []string{"amazon-ecs-cli-setup-prod", "amazon-ecs-cli-setup-staging", dynamodb.StackStatusDeleted},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			inventoryOutput = out
+			defer func() { inventoryOutput = os.Stdout }()
+
+			flagSet := flag.NewFlagSet("ecs-cli-inventory", 0)
+			flagSet.Bool(flags.IncludeDeletedFlag, testCase.includeDeleted, "")
+			context := cli.NewContext(nil, flagSet, nil)
+
+			err := inventory(context, inventoryCommandConfig("inventory"))
+			require.NoError(t, err, "Unexpected error listing the inventory")
+			for _, value := range testCase.expected {
+				assert.Contains(t, out.String(), value)
+			}
+			for _, value := range testCase.notExpected {
+				assert.NotContains(t, out.String(), value)
+			}
+		})
+	}
+}
+
+func TestInventoryWithoutInventoryTable(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-inventory", 0)
+	context := cli.NewContext(nil, flagSet, nil)
+
+	err := inventory(context, inventoryCommandConfig(""))
+	assert.Error(t, err, "Expected error when no inventory table is configured")
+}
@@ -32,6 +32,10 @@ import (
 // UserDataBuilder contains functionality to create user data scripts for Container Instances
 type UserDataBuilder interface {
 	AddFile(fileName string) error
+	SetInstanceAttributes(attributes map[string]string)
+	EnableInstanceConnect()
+	EnableGPUSupport()
+	EnableNeuronSupport()
 	Build() (string, error)
 }
 
@@ -41,6 +45,13 @@ type Builder struct {
 	clusterName string
 	userdata    *bytes.Buffer
 	tags        []*ecs.Tag
+	attributes  map[string]string
+	// installs EC2 Instance Connect, for SSH access without a key pair
+	instanceConnect bool
+	// enables the GPU support of the ECS agent and makes the NVIDIA runtime the default one of Docker
+	gpuSupport bool
+	// installs the Neuron driver and OCI hook on Inferentia and Trainium instances
+	neuronSupport bool
 }
 
 // NewBuilder creates a Builder object for a given clusterName
@@ -78,6 +89,29 @@ func (b *Builder) AddFile(fileName string) error {
 	return nil
 }
 
+// SetInstanceAttributes sets custom attributes that the ECS agent registers its container instance with
+func (b *Builder) SetInstanceAttributes(attributes map[string]string) {
+	b.attributes = attributes
+}
+
+// EnableInstanceConnect installs EC2 Instance Connect on the container instances if their AMI does not
+// include it, so that one-time SSH keys can be pushed to them
+func (b *Builder) EnableInstanceConnect() {
+	b.instanceConnect = true
+}
+
+// EnableGPUSupport enables the GPU support of the ECS agent, and makes the NVIDIA container runtime
+// the default runtime of Docker so that the containers which do not reserve a GPU can share them
+func (b *Builder) EnableGPUSupport() {
+	b.gpuSupport = true
+}
+
+// EnableNeuronSupport installs the Neuron driver and the OCI hook exposing the Neuron devices to the
+// containers, if the AMI does not include them
+func (b *Builder) EnableNeuronSupport() {
+	b.neuronSupport = true
+}
+
 // Build the userdata for the given cluster
 // Build() is not idempotent and can only be called once
 func (b *Builder) Build() (string, error) {
@@ -149,11 +183,30 @@ func isMultipart(data string) (bool, map[string]string, io.Reader) {
 	return false, nil, nil
 }
 
+// JoinClusterCommand returns the user data command that configures the ECS agent to register the
+// container instance to the cluster
+func JoinClusterCommand(clusterName string) string {
+	return fmt.Sprintf("echo ECS_CLUSTER=%s >> /etc/ecs/ecs.config", clusterName)
+}
+
+// gpuSupportCommands enable the GPU support of the ECS agent and make the NVIDIA runtime the default
+// runtime of Docker, unless the AMI already configures a default runtime
+const gpuSupportCommands = `echo ECS_ENABLE_GPU_SUPPORT=true >> /etc/ecs/ecs.config
+if ! grep -qs default-runtime /etc/docker/daemon.json /etc/sysconfig/docker; then
+  sed -i 's/^OPTIONS="/OPTIONS="--default-runtime nvidia /' /etc/sysconfig/docker
+  systemctl try-restart docker
+fi`
+
+// neuronSupportCommands install the Neuron driver and OCI hook from the Neuron repository, unless the
+// AMI already includes them like the Neuron variant of the ECS optimized AMI
+const neuronSupportCommands = `if ! rpm -q aws-neuronx-dkms aws-neuronx-oci-hook > /dev/null; then
+  printf '[neuron]\nname=Neuron YUM Repository\nbaseurl=https://yum.repos.neuron.amazonaws.com\nenabled=1\nmetadata_expire=0\n' > /etc/yum.repos.d/neuron.repo
+  rpm --import https://yum.repos.neuron.amazonaws.com/GPG-PUB-KEY-AMAZON-AWS-NEURON.PUB
+  yum install -y kernel-devel-$(uname -r) kernel-headers-$(uname -r) aws-neuronx-dkms aws-neuronx-oci-hook
+fi`
+
 func (b *Builder) getClusterUserData() (string, error) {
-	joinClusterUserData := `
-#!/bin/bash
-echo ECS_CLUSTER=%s >> /etc/ecs/ecs.config
-`
+	joinClusterUserData := "\n#!/bin/bash\n" + JoinClusterCommand(b.clusterName) + "\n"
 	if len(b.tags) > 0 {
 		tags := convertTags(b.tags)
 		bits, err := json.Marshal(tags)
@@ -162,7 +215,35 @@ echo ECS_CLUSTER=%s >> /etc/ecs/ecs.config
 		}
 		joinClusterUserData += fmt.Sprintf("echo 'ECS_CONTAINER_INSTANCE_TAGS=%s' >> /etc/ecs/ecs.config", string(bits))
 	}
-	return fmt.Sprintf(joinClusterUserData, b.clusterName), nil
+	if len(b.attributes) > 0 {
+		bits, err := json.Marshal(b.attributes)
+		if err != nil {
+			return "", err
+		}
+		if !strings.HasSuffix(joinClusterUserData, "\n") {
+			joinClusterUserData += "\n"
+		}
+		joinClusterUserData += fmt.Sprintf("echo 'ECS_INSTANCE_ATTRIBUTES=%s' >> /etc/ecs/ecs.config", string(bits))
+	}
+	if b.instanceConnect {
+		if !strings.HasSuffix(joinClusterUserData, "\n") {
+			joinClusterUserData += "\n"
+		}
+		joinClusterUserData += "rpm -q ec2-instance-connect || yum install -y ec2-instance-connect"
+	}
+	if b.gpuSupport {
+		if !strings.HasSuffix(joinClusterUserData, "\n") {
+			joinClusterUserData += "\n"
+		}
+		joinClusterUserData += gpuSupportCommands
+	}
+	if b.neuronSupport {
+		if !strings.HasSuffix(joinClusterUserData, "\n") {
+			joinClusterUserData += "\n"
+		}
+		joinClusterUserData += neuronSupportCommands
+	}
+	return joinClusterUserData, nil
 }
 
 func convertTags(tags []*ecs.Tag) map[string]string {
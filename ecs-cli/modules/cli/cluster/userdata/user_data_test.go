@@ -226,6 +226,135 @@ echo 'ECS_CONTAINER_INSTANCE_TAGS={"mitchell":"webb"}' >> /etc/ecs/ecs.config
 	assert.Equal(t, expected, actual, "Expected resulting mime multipart archive to match")
 }
 
+func TestBuildUserDataWithTagsAndInstanceAttributes(t *testing.T) {
+	var expectedUserData = `Content-Type: multipart/mixed; boundary="========multipart-boundary=="
+MIME-Version: 1.0
+
+--========multipart-boundary==
+Content-Type: text/text/x-shellscript; charset="utf-8"
+Mime-Version: 1.0
+
+
+#!/bin/bash
+echo ECS_CLUSTER=cluster >> /etc/ecs/ecs.config
+echo 'ECS_CONTAINER_INSTANCE_TAGS={"mitchell":"webb"}' >> /etc/ecs/ecs.config
+echo 'ECS_INSTANCE_ATTRIBUTES={"stack":"prod","team":"firmware"}' >> /etc/ecs/ecs.config
+--========multipart-boundary==--
+`
+	tags := []*ecs.Tag{
+		&ecs.Tag{
+			Key:   aws.String("mitchell"),
+			Value: aws.String("webb"),
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+	// set the boundary between parts so that output is deterministic
+	writer.SetBoundary(testBoundary)
+	builder := newBuilderInTest(buf, writer, tags)
+	builder.SetInstanceAttributes(map[string]string{"team": "firmware", "stack": "prod"})
+
+	actual, err := builder.Build()
+	assert.NoError(t, err, "Unexpected error calling Build()")
+	expected := unixifyLineEndings(expectedUserData)
+	assert.Equal(t, expected, actual, "Expected resulting mime multipart archive to match")
+}
+
+func TestBuildUserDataWithInstanceConnect(t *testing.T) {
+	var expectedUserData = `Content-Type: multipart/mixed; boundary="========multipart-boundary=="
+MIME-Version: 1.0
+
+--========multipart-boundary==
+Content-Type: text/text/x-shellscript; charset="utf-8"
+Mime-Version: 1.0
+
+
+#!/bin/bash
+echo ECS_CLUSTER=cluster >> /etc/ecs/ecs.config
+rpm -q ec2-instance-connect || yum install -y ec2-instance-connect
+--========multipart-boundary==--
+`
+
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+	// set the boundary between parts so that output is deterministic
+	writer.SetBoundary(testBoundary)
+	builder := newBuilderInTest(buf, writer, nil)
+	builder.EnableInstanceConnect()
+
+	actual, err := builder.Build()
+	assert.NoError(t, err, "Unexpected error calling Build()")
+	expected := unixifyLineEndings(expectedUserData)
+	assert.Equal(t, expected, actual, "Expected resulting mime multipart archive to match")
+}
+
+func TestBuildUserDataWithGPUSupport(t *testing.T) {
+	var expectedUserData = `Content-Type: multipart/mixed; boundary="========multipart-boundary=="
+MIME-Version: 1.0
+
+--========multipart-boundary==
+Content-Type: text/text/x-shellscript; charset="utf-8"
+Mime-Version: 1.0
+
+
+#!/bin/bash
+echo ECS_CLUSTER=cluster >> /etc/ecs/ecs.config
+echo 'ECS_INSTANCE_ATTRIBUTES={"gpu.count":"1","gpu.type":"t4"}' >> /etc/ecs/ecs.config
+echo ECS_ENABLE_GPU_SUPPORT=true >> /etc/ecs/ecs.config
+if ! grep -qs default-runtime /etc/docker/daemon.json /etc/sysconfig/docker; then
+  sed -i 's/^OPTIONS="/OPTIONS="--default-runtime nvidia /' /etc/sysconfig/docker
+  systemctl try-restart docker
+fi
+--========multipart-boundary==--
+`
+
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+	// set the boundary between parts so that output is deterministic
+	writer.SetBoundary(testBoundary)
+	builder := newBuilderInTest(buf, writer, nil)
+	builder.SetInstanceAttributes(map[string]string{"gpu.count": "1", "gpu.type": "t4"})
+	builder.EnableGPUSupport()
+
+	actual, err := builder.Build()
+	assert.NoError(t, err, "Unexpected error calling Build()")
+	expected := unixifyLineEndings(expectedUserData)
+	assert.Equal(t, expected, actual, "Expected resulting mime multipart archive to match")
+}
+
+func TestBuildUserDataWithNeuronSupport(t *testing.T) {
+	var expectedUserData = `Content-Type: multipart/mixed; boundary="========multipart-boundary=="
+MIME-Version: 1.0
+
+--========multipart-boundary==
+Content-Type: text/text/x-shellscript; charset="utf-8"
+Mime-Version: 1.0
+
+
+#!/bin/bash
+echo ECS_CLUSTER=cluster >> /etc/ecs/ecs.config
+if ! rpm -q aws-neuronx-dkms aws-neuronx-oci-hook > /dev/null; then
+  printf '[neuron]\nname=Neuron YUM Repository\nbaseurl=https://yum.repos.neuron.amazonaws.com\nenabled=1\nmetadata_expire=0\n' > /etc/yum.repos.d/neuron.repo
+  rpm --import https://yum.repos.neuron.amazonaws.com/GPG-PUB-KEY-AMAZON-AWS-NEURON.PUB
+  yum install -y kernel-devel-$(uname -r) kernel-headers-$(uname -r) aws-neuronx-dkms aws-neuronx-oci-hook
+fi
+--========multipart-boundary==--
+`
+
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+	// set the boundary between parts so that output is deterministic
+	writer.SetBoundary(testBoundary)
+	builder := newBuilderInTest(buf, writer, nil)
+	builder.EnableNeuronSupport()
+
+	actual, err := builder.Build()
+	assert.NoError(t, err, "Unexpected error calling Build()")
+	expected := unixifyLineEndings(expectedUserData)
+	assert.Equal(t, expected, actual, "Expected resulting mime multipart archive to match")
+}
+
 func writeTempFile(t *testing.T, name, content string) string {
 	tmpfile, err := ioutil.TempFile("", name)
 	assert.NoError(t, err, "Could not create tempfile")
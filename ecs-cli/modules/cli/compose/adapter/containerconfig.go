@@ -44,4 +44,16 @@ type ContainerConfig struct {
 	VolumesFrom      []*ecs.VolumeFrom
 	User             string
 	WorkingDirectory string
+
+	// Build is only used to build the image of the container with --build, and is nil
+	// for services without a build section
+	Build *BuildConfig
+}
+
+// BuildConfig is the build section of a compose service
+type BuildConfig struct {
+	Context    string // absolute path of the build context, or a remote URL
+	Dockerfile string
+	Args       map[string]*string // arguments without a value are taken from the environment
+	Target     string
 }
@@ -17,7 +17,6 @@ package adapter
 import (
 	"encoding/json"
 	"fmt"
-	"reflect"
 	"regexp"
 	"sort"
 	"strconv"
@@ -40,6 +39,9 @@ const (
 	kiB = 1024
 	miB = kiB * kiB // 1048576 bytes
 
+	// CPUUnitsPerVCPU is the number of ECS CPU units in one vCPU
+	CPUUnitsPerVCPU = 1024
+
 	// access mode with which the volume is mounted
 	readOnlyVolumeAccessMode  = "ro"
 	readWriteVolumeAccessMode = "rw"
@@ -217,6 +219,18 @@ func ConvertToMemoryInMB(bytes int64) int64 {
 	return memory
 }
 
+// ConvertToCPUUnits converts a compose cpus value, a number of vCPUs such as "0.5", to ECS CPU units
+func ConvertToCPUUnits(cpus string) (int64, error) {
+	if cpus == "" {
+		return 0, nil
+	}
+	vCPUs, err := strconv.ParseFloat(cpus, 64)
+	if err != nil || vCPUs < 0 {
+		return 0, fmt.Errorf("cpus must be a positive number of vCPUs, but got %s", cpus)
+	}
+	return int64(vCPUs * CPUUnitsPerVCPU), nil
+}
+
 // ConvertToTimeInSeconds converts a duration to an int64 number of seconds
 func ConvertToTimeInSeconds(d *time.Duration) *int64 {
 	val := d.Nanoseconds() / 1E9
@@ -432,13 +446,15 @@ func ConvertToVolumes(volumeConfigs map[string]*config.VolumeConfig) (*Volumes,
 	volumes := NewVolumes()
 
 	// Add named volume configs:
-	if volumeConfigs != nil {
-		for name, config := range volumeConfigs {
-			if config != nil {
-				return nil, logOutUnsupportedVolumeFields(config.Driver, config.DriverOpts, nil)
-			}
-			volumes.VolumeEmptyHost = append(volumes.VolumeEmptyHost, name)
+	for name, config := range volumeConfigs {
+		if config == nil {
+			volumes.addNamedVolume(name, nil)
+			continue
 		}
+		if config.External.Name != "" && config.External.Name != name {
+			return nil, fmt.Errorf("volume %s: external volume names are not supported", name)
+		}
+		volumes.addNamedVolume(name, convertToDockerVolumeConfiguration(config.Driver, config.DriverOpts, nil, config.External.External))
 	}
 	return volumes, nil
 }
@@ -449,33 +465,16 @@ func ConvertToV3Volumes(volConfig map[string]types.VolumeConfig) (*Volumes, erro
 	volumes := NewVolumes()
 
 	// Add named volume configs:
-	if len(volConfig) != 0 {
-		for name, config := range volConfig {
-			if !reflect.DeepEqual(config, types.VolumeConfig{}) {
-				return nil, logOutUnsupportedVolumeFields(config.Driver, config.DriverOpts, config.Labels)
-			}
-			volumes.VolumeEmptyHost = append(volumes.VolumeEmptyHost, name)
+	for name, config := range volConfig {
+		// ECS names the Docker volume after the task definition volume
+		if config.Name != "" && config.Name != name {
+			return nil, fmt.Errorf("volume %s: custom volume names are not supported", name)
 		}
+		volumes.addNamedVolume(name, convertToDockerVolumeConfiguration(config.Driver, config.DriverOpts, config.Labels, config.External.External))
 	}
 	return volumes, nil
 }
 
-func logOutUnsupportedVolumeFields(driver string, driverOpts map[string]string, labels map[string]string) error {
-	// NOTE: If Driver field is not empty, this
-	// will add a prefix to the named volume on the container
-	if driver != "" {
-		return errors.New("Volume driver is not supported")
-	}
-	// Driver Options must relate to a specific volume driver
-	if len(driverOpts) != 0 {
-		return errors.New("Volume driver options is not supported")
-	}
-	if labels != nil && len(labels) != 0 {
-		return errors.New("Volume labels are not supported")
-	}
-	return errors.New("External option is not supported")
-}
-
 // ConvertToVolumesFrom transforms the yml volumes from to ecs compatible VolumesFrom slice
 // Examples for compose format v2:
 // volumes_from:
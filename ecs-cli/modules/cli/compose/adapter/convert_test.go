@@ -521,55 +521,104 @@ func TestConvertToVolumes(t *testing.T) {
 	assert.Equal(t, expected, actual, "Named volumes should match")
 }
 
-func TestConvertToVolumes_ErrorsWithDriverSubfield(t *testing.T) {
+func TestConvertToVolumesWithDriverOpts(t *testing.T) {
+	driverOpts := map[string]string{
+		"type":   "nfs",
+		"o":      "addr=10.0.0.1,rw",
+		"device": ":/exports",
+	}
 	libcomposeVolumeConfigs := map[string]*config.VolumeConfig{
 		namedVolume: &config.VolumeConfig{
-			Driver: "noodles",
+			Driver:     "local",
+			DriverOpts: driverOpts,
 		},
 	}
 
-	_, err := ConvertToVolumes(libcomposeVolumeConfigs)
+	actual, err := ConvertToVolumes(libcomposeVolumeConfigs)
 
-	assert.Error(t, err, "Expected error converting libcompose volume configs when driver is specified")
+	assert.NoError(t, err, "Unexpected error converting libcompose volume configs")
+	assert.Equal(t, []string{namedVolume}, actual.VolumeEmptyHost, "Named volumes should match")
+	expected := &ecs.DockerVolumeConfiguration{
+		Driver:     aws.String("local"),
+		DriverOpts: aws.StringMap(driverOpts),
+	}
+	assert.Equal(t, expected, actual.VolumeConfigs[namedVolume], "Docker volume configuration should match")
 }
 
-func TestConvertToVolumes_ErrorsWithDriverOptsSubfield(t *testing.T) {
-	driverOpts := map[string]string{"foo": "bar"}
+func TestConvertToVolumesWithEmptyConfig(t *testing.T) {
+	libcomposeVolumeConfigs := map[string]*config.VolumeConfig{
+		namedVolume: &config.VolumeConfig{},
+	}
+
+	actual, err := ConvertToVolumes(libcomposeVolumeConfigs)
+
+	assert.NoError(t, err, "Unexpected error converting libcompose volume configs")
+	assert.Equal(t, []string{namedVolume}, actual.VolumeEmptyHost, "Named volumes should match")
+	assert.Empty(t, actual.VolumeConfigs, "Expected no Docker volume configuration")
+}
 
+func TestConvertToVolumesWithExternal(t *testing.T) {
 	libcomposeVolumeConfigs := map[string]*config.VolumeConfig{
 		namedVolume: &config.VolumeConfig{
-			DriverOpts: driverOpts,
+			External: yaml.External{External: true},
 		},
 	}
 
-	_, err := ConvertToVolumes(libcomposeVolumeConfigs)
+	actual, err := ConvertToVolumes(libcomposeVolumeConfigs)
 
-	assert.Error(t, err, "Expected error converting libcompose volume configs when driver options are specified")
+	assert.NoError(t, err, "Unexpected error converting libcompose volume configs")
+	expected := &ecs.DockerVolumeConfiguration{
+		Scope:         aws.String("shared"),
+		Autoprovision: aws.Bool(false),
+	}
+	assert.Equal(t, expected, actual.VolumeConfigs[namedVolume], "Expected external volume to be shared and not autoprovisioned")
 }
 
-func TestConvertToVolumes_ErrorsWithExternalSubfield(t *testing.T) {
-	external := yaml.External{External: false}
-
+func TestConvertToVolumes_ErrorsWithExternalName(t *testing.T) {
 	libcomposeVolumeConfigs := map[string]*config.VolumeConfig{
 		namedVolume: &config.VolumeConfig{
-			External: external,
+			External: yaml.External{External: true, Name: "other_volume"},
 		},
 	}
 
 	_, err := ConvertToVolumes(libcomposeVolumeConfigs)
 
-	assert.Error(t, err, "Expected error converting libcompose volume configs when external is specified")
+	assert.Error(t, err, "Expected error converting libcompose volume configs when external name is specified")
+}
+
+func TestConvertToV3Volumes(t *testing.T) {
+	volumeConfigs := map[string]types.VolumeConfig{
+		namedVolume: types.VolumeConfig{
+			Driver:     "rexray/ebs",
+			DriverOpts: map[string]string{"size": "5"},
+			Labels:     types.Labels{"team": "web"},
+		},
+		"plain": types.VolumeConfig{},
+	}
 
-	external = yaml.External{External: true}
-	libcomposeVolumeConfigs = map[string]*config.VolumeConfig{
-		namedVolume: &config.VolumeConfig{
-			External: external,
+	actual, err := ConvertToV3Volumes(volumeConfigs)
+
+	assert.NoError(t, err, "Unexpected error converting volume configs")
+	assert.ElementsMatch(t, []string{namedVolume, "plain"}, actual.VolumeEmptyHost, "Named volumes should match")
+	expected := &ecs.DockerVolumeConfiguration{
+		Driver:     aws.String("rexray/ebs"),
+		DriverOpts: aws.StringMap(map[string]string{"size": "5"}),
+		Labels:     aws.StringMap(map[string]string{"team": "web"}),
+	}
+	assert.Equal(t, expected, actual.VolumeConfigs[namedVolume], "Docker volume configuration should match")
+	assert.Nil(t, actual.VolumeConfigs["plain"], "Expected no Docker volume configuration for plain volume")
+}
+
+func TestConvertToV3Volumes_ErrorsWithCustomName(t *testing.T) {
+	volumeConfigs := map[string]types.VolumeConfig{
+		namedVolume: types.VolumeConfig{
+			Name: "other_volume",
 		},
 	}
 
-	_, err = ConvertToVolumes(libcomposeVolumeConfigs)
+	_, err := ConvertToV3Volumes(volumeConfigs)
 
-	assert.Error(t, err, "Expected error converting libcompose volume configs when external is specified")
+	assert.Error(t, err, "Expected error converting volume configs when a custom name is specified")
 }
 
 func TestRegisterTaskDefinitionInputEquivalence(t *testing.T) {
@@ -691,6 +740,19 @@ func TestConvertToHealthCheck(t *testing.T) {
 	assert.Equal(t, aws.Int64(120), output.StartPeriod)
 }
 
+func TestConvertToCPUUnits(t *testing.T) {
+	cpu, err := ConvertToCPUUnits("0.5")
+	assert.NoError(t, err, "Unexpected error converting cpus")
+	assert.Equal(t, int64(512), cpu)
+
+	cpu, err = ConvertToCPUUnits("")
+	assert.NoError(t, err, "Unexpected error converting empty cpus")
+	assert.Equal(t, int64(0), cpu)
+
+	_, err = ConvertToCPUUnits("half")
+	assert.Error(t, err, "Expected error converting invalid cpus")
+}
+
 func TestConvertDurationStrToSecondsEmptyString(t *testing.T) {
 	res, err := ConvertDurationStrToSeconds("")
 	assert.NoError(t, err, "empty string should not result in conversion error")
@@ -15,16 +15,25 @@ package adapter
 
 import (
 	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 )
 
 const (
 	// prefix for the autogenerated volume names in the task definition
 	ecsVolumeNamePrefix = "volume"
+
+	// scope of docker volumes that persist after the task stops
+	dockerVolumeScopeShared = "shared"
 )
 
 type Volumes struct {
 	VolumeWithHost  map[string]string
 	VolumeEmptyHost []string
+	// VolumeConfigs holds the Docker volume configuration of named volumes declared with a driver,
+	// driver options, labels, or as external
+	VolumeConfigs map[string]*ecs.DockerVolumeConfiguration
 }
 
 // getVolumeName returns an autogenerated name for the ecs volume
@@ -38,3 +47,39 @@ func NewVolumes() *Volumes {
 		VolumeEmptyHost: []string{},
 	}
 }
+
+// addNamedVolume declares a named volume, along with its Docker volume configuration if it has one
+func (v *Volumes) addNamedVolume(name string, volumeConfig *ecs.DockerVolumeConfiguration) {
+	v.VolumeEmptyHost = append(v.VolumeEmptyHost, name)
+	if volumeConfig == nil {
+		return
+	}
+	if v.VolumeConfigs == nil {
+		v.VolumeConfigs = make(map[string]*ecs.DockerVolumeConfiguration)
+	}
+	v.VolumeConfigs[name] = volumeConfig
+}
+
+// convertToDockerVolumeConfiguration converts the fields of a compose named volume to an ECS
+// Docker volume configuration, or returns nil if none are set. External volumes must already
+// exist, so they are shared volumes that ECS doesn't create.
+func convertToDockerVolumeConfiguration(driver string, driverOpts, labels map[string]string, external bool) *ecs.DockerVolumeConfiguration {
+	if driver == "" && len(driverOpts) == 0 && len(labels) == 0 && !external {
+		return nil
+	}
+	volumeConfig := &ecs.DockerVolumeConfiguration{}
+	if driver != "" {
+		volumeConfig.Driver = aws.String(driver)
+	}
+	if len(driverOpts) > 0 {
+		volumeConfig.DriverOpts = aws.StringMap(driverOpts)
+	}
+	if len(labels) > 0 {
+		volumeConfig.Labels = aws.StringMap(labels)
+	}
+	if external {
+		volumeConfig.Scope = aws.String(dockerVolumeScopeShared)
+		volumeConfig.Autoprovision = aws.Bool(false)
+	}
+	return volumeConfig
+}
@@ -0,0 +1,325 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package compose
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	composeFactory "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory"
+	ecscompose "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/notify"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// Results of the deploy of the project to a cluster
+const (
+	clusterDeployed       = "deployed"
+	clusterFailed         = "failed"
+	clusterSkipped        = "skipped"
+	clusterRolledBack     = "rolled back"
+	clusterRollbackFailed = "rollback failed"
+)
+
+// clusterOverrides are the parameters of the project overridden for a cluster configuration in the
+// file of --cluster-overrides
+type clusterOverrides struct {
+	ImageTag    string            `yaml:"image_tag"`
+	Environment map[string]string `yaml:"environment"`
+}
+
+// clusterDeploy is the deploy of the project to one of the cluster configurations of --clusters
+type clusterDeploy struct {
+	clusterConfig  string
+	cluster        string
+	region         string
+	taskDefinition string
+	result         string
+	err            error
+	// rollback redeploys the service as it was before the deploy, nil if the deploy created it
+	rollback func() error
+}
+
+// ServiceUp is the action of 'compose service up'. With --clusters, the project is deployed to each
+// of the cluster configurations in turn; otherwise to the cluster of the command.
+func ServiceUp(factory composeFactory.ProjectFactory) func(context *cli.Context) {
+	up := WithProject(factory, ProjectUp, true)
+	return func(context *cli.Context) {
+		if context.String(flags.ClustersFlag) == "" {
+			if context.String(flags.ClusterOverridesFlag) != "" || context.Bool(flags.BestEffortFlag) {
+				log.Fatalf("--%s and --%s require --%s", flags.ClusterOverridesFlag, flags.BestEffortFlag, flags.ClustersFlag)
+			}
+			up(context)
+			return
+		}
+		if err := upClusters(factory, context, progress.Output()); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// upClusters deploys the project to the cluster configurations of --clusters in turn and prints the
+// result of each deploy. Unless --best-effort is used, the first failure skips the remaining clusters
+// and rolls back the services deployed so far, the failed one included.
+func upClusters(factory composeFactory.ProjectFactory, context *cli.Context, out io.Writer) error {
+	clusterConfigs := parseClusterConfigs(context.String(flags.ClustersFlag))
+	if len(clusterConfigs) == 0 {
+		return fmt.Errorf("--%s requires at least one cluster configuration", flags.ClustersFlag)
+	}
+	for _, flag := range []string{flags.ClusterConfigFlag, flags.ClusterFlag, flags.RegionFlag} {
+		if config.RecursiveFlagSearch(context, flag) != "" {
+			return fmt.Errorf("--%s cannot be combined with --%s, since the cluster and the region come from the cluster configurations", flag, flags.ClustersFlag)
+		}
+	}
+	overrides, err := readClusterOverrides(context.String(flags.ClusterOverridesFlag), clusterConfigs)
+	if err != nil {
+		return err
+	}
+	bestEffort := context.Bool(flags.BestEffortFlag)
+
+	var deploys []*clusterDeploy
+	failures := 0
+	for _, clusterConfig := range clusterConfigs {
+		deploy := &clusterDeploy{clusterConfig: clusterConfig}
+		deploys = append(deploys, deploy)
+		if failures > 0 && !bestEffort {
+			deploy.result = clusterSkipped
+			continue
+		}
+		log.WithFields(log.Fields{"clusterConfig": clusterConfig}).Info("Deploying to cluster configuration")
+		if err := upCluster(factory, context, deploy, overrides[clusterConfig]); err != nil {
+			log.WithFields(log.Fields{
+				"clusterConfig": clusterConfig,
+				"error":         err,
+			}).Error("Deploy failed")
+			deploy.result, deploy.err = clusterFailed, err
+			failures++
+			continue
+		}
+		deploy.result = clusterDeployed
+	}
+	if failures > 0 && !bestEffort {
+		rollBackClusters(deploys)
+	}
+
+	printClusterDeploys(out, deploys)
+	if failures > 0 {
+		return fmt.Errorf("The deploy failed on %d of the %d clusters", failures, len(clusterConfigs))
+	}
+	return nil
+}
+
+// upCluster deploys the project to the cluster configuration of the deploy, with its overrides
+func upCluster(factory composeFactory.ProjectFactory, context *cli.Context, deploy *clusterDeploy, overrides clusterOverrides) error {
+	if err := context.Set(flags.ClusterConfigFlag, deploy.clusterConfig); err != nil {
+		return err
+	}
+	project, err := factory.Create(context, true)
+	if err != nil {
+		return err
+	}
+	commandConfig := project.Entity().Context().CommandConfig
+	deploy.cluster, deploy.region = commandConfig.Cluster, commandConfig.Region()
+
+	applyClusterOverrides(project, overrides)
+	if !context.Bool(flags.DryRunFlag) {
+		deploy.rollback = currentDeployment(project.Entity())
+	}
+	if err := project.Up(); err != nil {
+		return err
+	}
+	deploy.taskDefinition = entity.GetIdFromArn(project.Entity().TaskDefinition().TaskDefinitionArn)
+	return nil
+}
+
+// parseClusterConfigs returns the distinct cluster configurations of --clusters, in order
+func parseClusterConfigs(value string) []string {
+	var clusterConfigs []string
+	seen := make(map[string]bool)
+	for _, clusterConfig := range strings.Split(value, ",") {
+		clusterConfig = strings.TrimSpace(clusterConfig)
+		if clusterConfig != "" && !seen[clusterConfig] {
+			seen[clusterConfig] = true
+			clusterConfigs = append(clusterConfigs, clusterConfig)
+		}
+	}
+	return clusterConfigs
+}
+
+// readClusterOverrides reads the file of --cluster-overrides, whose cluster configurations must all
+// be deployed to
+func readClusterOverrides(filename string, clusterConfigs []string) (map[string]clusterOverrides, error) {
+	overrides := make(map[string]clusterOverrides)
+	if filename == "" {
+		return overrides, nil
+	}
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error reading the cluster overrides file %s", filename)
+	}
+	if err := yaml.UnmarshalStrict(data, &overrides); err != nil {
+		return nil, errors.Wrapf(err, "Error parsing the cluster overrides file %s", filename)
+	}
+	for clusterConfig := range overrides {
+		found := false
+		for _, name := range clusterConfigs {
+			found = found || name == clusterConfig
+		}
+		if !found {
+			return nil, fmt.Errorf("The cluster overrides file %s has overrides for %s, which is not one of the clusters of --%s", filename, clusterConfig, flags.ClustersFlag)
+		}
+	}
+	return overrides, nil
+}
+
+// applyClusterOverrides sets the image tag and the environment variables of the overrides on the
+// containers of the compose file; the sidecars added to the task definition are left as is
+func applyClusterOverrides(project ecscompose.Project, overrides clusterOverrides) {
+	composeContainers := make(map[string]bool)
+	for _, containerConfig := range project.ContainerConfigs() {
+		composeContainers[containerConfig.Name] = true
+	}
+	var names []string
+	for name := range overrides.Environment {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, containerDef := range project.Entity().TaskDefinition().ContainerDefinitions {
+		if !composeContainers[aws.StringValue(containerDef.Name)] {
+			continue
+		}
+		if overrides.ImageTag != "" {
+			containerDef.Image = aws.String(imageWithTag(aws.StringValue(containerDef.Image), overrides.ImageTag))
+		}
+		for _, name := range names {
+			setEnvironment(containerDef, name, overrides.Environment[name])
+		}
+	}
+}
+
+// imageWithTag replaces the tag or the digest of the image with the tag
+func imageWithTag(image, tag string) string {
+	repository := image
+	if i := strings.Index(repository, "@"); i >= 0 {
+		repository = repository[:i]
+	}
+	// a colon before the last slash separates the port of the registry, not a tag
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository = repository[:i]
+	}
+	return repository + ":" + tag
+}
+
+// setEnvironment sets the environment variable of the container, replacing its value if it is already set
+func setEnvironment(containerDef *ecs.ContainerDefinition, name, value string) {
+	for _, env := range containerDef.Environment {
+		if aws.StringValue(env.Name) == name {
+			env.Value = aws.String(value)
+			return
+		}
+	}
+	containerDef.Environment = append(containerDef.Environment, &ecs.KeyValuePair{
+		Name:  aws.String(name),
+		Value: aws.String(value),
+	})
+}
+
+// currentDeployment returns the redeploy of the current task definition and desired count of the
+// service of the project, or nil if the service does not exist yet. A successful redeploy is
+// notified as a rollback of the deploy.
+func currentDeployment(projectEntity entity.ProjectEntity) func() error {
+	ecsContext := projectEntity.Context()
+	serviceName := entity.GetServiceName(projectEntity)
+	output, err := ecsContext.ECSClient.DescribeService(serviceName)
+	if err != nil || len(output.Services) == 0 || aws.StringValue(output.Services[0].Status) != "ACTIVE" {
+		return nil
+	}
+	ecsService := output.Services[0]
+	input := &ecs.UpdateServiceInput{
+		Cluster:        aws.String(ecsContext.CommandConfig.Cluster),
+		Service:        aws.String(serviceName),
+		TaskDefinition: ecsService.TaskDefinition,
+	}
+	if aws.StringValue(ecsService.SchedulingStrategy) != ecs.SchedulingStrategyDaemon {
+		input.DesiredCount = ecsService.DesiredCount
+	}
+	return func() error {
+		if err := ecsContext.ECSClient.UpdateService(input); err != nil {
+			return err
+		}
+		commandConfig := ecsContext.CommandConfig
+		commandConfig.Notifier.Notify(&notify.Event{
+			Type:           notify.EventDeploy,
+			Status:         notify.StatusRolledBack,
+			Cluster:        commandConfig.Cluster,
+			Region:         commandConfig.Region(),
+			Service:        serviceName,
+			TaskDefinition: entity.GetIdFromArn(ecsService.TaskDefinition),
+		})
+		return nil
+	}
+}
+
+// rollBackClusters redeploys the services of the deployed and failed clusters as they were before,
+// the most recent deploy first. The services created by the deploys are left in place.
+func rollBackClusters(deploys []*clusterDeploy) {
+	for i := len(deploys) - 1; i >= 0; i-- {
+		deploy := deploys[i]
+		if deploy.result != clusterDeployed && deploy.result != clusterFailed {
+			continue
+		}
+		if deploy.rollback == nil {
+			if deploy.result == clusterDeployed {
+				log.WithFields(log.Fields{"clusterConfig": deploy.clusterConfig}).Warn("The service was created by this deploy and is not rolled back")
+			}
+			continue
+		}
+		log.WithFields(log.Fields{"clusterConfig": deploy.clusterConfig}).Info("Rolling back the service")
+		if err := deploy.rollback(); err != nil {
+			if deploy.err != nil {
+				err = fmt.Errorf("%v; %v", deploy.err, err)
+			}
+			deploy.result, deploy.err = deploy.result+", "+clusterRollbackFailed, err
+			continue
+		}
+		deploy.result += ", " + clusterRolledBack
+	}
+}
+
+func printClusterDeploys(out io.Writer, deploys []*clusterDeploy) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "CLUSTER CONFIG\tCLUSTER\tREGION\tTASK DEFINITION\tRESULT")
+	for _, deploy := range deploys {
+		result := deploy.result
+		if deploy.err != nil {
+			result = fmt.Sprintf("%s: %v", result, deploy.err)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", deploy.clusterConfig, deploy.cluster, deploy.region, deploy.taskDefinition, result)
+	}
+	w.Flush()
+}
@@ -0,0 +1,252 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package compose
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/docker/libcompose/project"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+// clusterTarget is a cluster configuration of the tests and the service deployed to it
+type clusterTarget struct {
+	clusterConfig  string
+	cluster        string
+	region         string
+	taskDefinition *ecs.TaskDefinition
+	project        *mock_project.MockProject
+	ecsClient      *mock_ecs.MockECSClient
+}
+
+func newClustersContext(t *testing.T, args ...string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	for _, name := range []string{flags.ClustersFlag, flags.ClusterOverridesFlag, flags.ClusterConfigFlag, flags.ClusterFlag, flags.RegionFlag} {
+		flagSet.String(name, "", "")
+	}
+	flagSet.Bool(flags.BestEffortFlag, false, "")
+	flagSet.Bool(flags.DryRunFlag, false, "")
+	require.NoError(t, flagSet.Parse(args))
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func newClusterTarget(ctrl *gomock.Controller, clusterConfig, cluster, region string) *clusterTarget {
+	target := &clusterTarget{
+		clusterConfig: clusterConfig,
+		cluster:       cluster,
+		region:        region,
+		taskDefinition: &ecs.TaskDefinition{
+			ContainerDefinitions: []*ecs.ContainerDefinition{
+				{Name: aws.String("web"), Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/web:1.0")},
+				{Name: aws.String("xray-daemon"), Image: aws.String("public.ecr.aws/xray/aws-xray-daemon:latest")},
+			},
+		},
+		project:   mock_project.NewMockProject(ctrl),
+		ecsClient: mock_ecs.NewMockECSClient(ctrl),
+	}
+	mockEntity := mock_entity.NewMockProjectEntity(ctrl)
+	mockEntity.EXPECT().Context().Return(&context.ECSContext{
+		Context: project.Context{ProjectName: "web"},
+		CommandConfig: &config.CommandConfig{
+			Cluster:                  cluster,
+			Session:                  session.Must(session.NewSession(&aws.Config{Region: aws.String(region)})),
+			ComposeServiceNamePrefix: "",
+		},
+		ECSClient: target.ecsClient,
+	}).AnyTimes()
+	mockEntity.EXPECT().TaskDefinition().Return(target.taskDefinition).AnyTimes()
+	target.project.EXPECT().Entity().Return(mockEntity).AnyTimes()
+	target.project.EXPECT().ContainerConfigs().Return([]adapter.ContainerConfig{{Name: "web"}}).AnyTimes()
+	return target
+}
+
+// expectCreate expects the project of the target to be created for its cluster configuration
+func expectCreate(t *testing.T, mockFactory *mock_factory.MockProjectFactory, target *clusterTarget) *gomock.Call {
+	return mockFactory.EXPECT().Create(gomock.Any(), true).DoAndReturn(func(c *cli.Context, isService bool) (*mock_project.MockProject, error) {
+		assert.Equal(t, target.clusterConfig, c.String(flags.ClusterConfigFlag))
+		return target.project, nil
+	}).Return(target.project, nil)
+}
+
+func activeService(taskDefinitionArn string, desiredCount int64) *ecs.DescribeServicesOutput {
+	return &ecs.DescribeServicesOutput{
+		Services: []*ecs.Service{{
+			Status:         aws.String("ACTIVE"),
+			TaskDefinition: aws.String(taskDefinitionArn),
+			DesiredCount:   aws.Int64(desiredCount),
+		}},
+	}
+}
+
+func TestUpClustersRollsBackOnFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockFactory := mock_factory.NewMockProjectFactory(ctrl)
+	eu := newClusterTarget(ctrl, "staging-eu", "staging", "eu-west-1")
+	us := newClusterTarget(ctrl, "staging-us", "staging", "us-east-1")
+
+	gomock.InOrder(
+		expectCreate(t, mockFactory, eu),
+		eu.ecsClient.EXPECT().DescribeService("web").Return(activeService("arn:aws:ecs:eu-west-1:123456789012:task-definition/web:4", 2), nil),
+		eu.project.EXPECT().Up().Do(func() {
+			eu.taskDefinition.TaskDefinitionArn = aws.String("arn:aws:ecs:eu-west-1:123456789012:task-definition/web:5")
+		}).Return(nil),
+		expectCreate(t, mockFactory, us),
+		us.ecsClient.EXPECT().DescribeService("web").Return(activeService("arn:aws:ecs:us-east-1:123456789012:task-definition/web:7", 3), nil),
+		us.project.EXPECT().Up().Return(errors.New("service web did not reach a steady state")),
+		us.ecsClient.EXPECT().UpdateService(&ecs.UpdateServiceInput{
+			Cluster:        aws.String("staging"),
+			Service:        aws.String("web"),
+			TaskDefinition: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:7"),
+			DesiredCount:   aws.Int64(3),
+		}).Return(nil),
+		eu.ecsClient.EXPECT().UpdateService(&ecs.UpdateServiceInput{
+			Cluster:        aws.String("staging"),
+			Service:        aws.String("web"),
+			TaskDefinition: aws.String("arn:aws:ecs:eu-west-1:123456789012:task-definition/web:4"),
+			DesiredCount:   aws.Int64(2),
+		}).Return(nil),
+	)
+
+	out := &bytes.Buffer{}
+	err := upClusters(mockFactory, newClustersContext(t, "--clusters", "staging-eu, staging-us,staging-ap"), out)
+
+	assert.EqualError(t, err, "The deploy failed on 1 of the 3 clusters")
+	assert.Regexp(t, `staging-eu\s+staging\s+eu-west-1\s+web:5\s+deployed, rolled back\n`, out.String())
+	assert.Regexp(t, `staging-us\s+staging\s+us-east-1\s+failed, rolled back: service web did not reach a steady state\n`, out.String())
+	assert.Regexp(t, `staging-ap\s+skipped\n`, out.String())
+}
+
+func TestUpClustersBestEffort(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockFactory := mock_factory.NewMockProjectFactory(ctrl)
+	us := newClusterTarget(ctrl, "staging-us", "staging", "us-east-1")
+
+	gomock.InOrder(
+		mockFactory.EXPECT().Create(gomock.Any(), true).Return(nil, errors.New("Error loading config")),
+		expectCreate(t, mockFactory, us),
+		us.ecsClient.EXPECT().DescribeService("web").Return(&ecs.DescribeServicesOutput{}, nil),
+		us.project.EXPECT().Up().Do(func() {
+			us.taskDefinition.TaskDefinitionArn = aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:1")
+		}).Return(nil),
+	)
+
+	out := &bytes.Buffer{}
+	err := upClusters(mockFactory, newClustersContext(t, "--clusters", "staging-eu,staging-us", "--best-effort"), out)
+
+	assert.EqualError(t, err, "The deploy failed on 1 of the 2 clusters")
+	assert.Regexp(t, `staging-eu\s+failed: Error loading config\n`, out.String())
+	assert.Regexp(t, `staging-us\s+staging\s+us-east-1\s+web:1\s+deployed\n`, out.String())
+}
+
+func TestUpClustersWithOverrides(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockFactory := mock_factory.NewMockProjectFactory(ctrl)
+	eu := newClusterTarget(ctrl, "staging-eu", "staging", "eu-west-1")
+	us := newClusterTarget(ctrl, "staging-us", "staging", "us-east-1")
+	eu.taskDefinition.ContainerDefinitions[0].Environment = []*ecs.KeyValuePair{{Name: aws.String("DEPLOY_ENV"), Value: aws.String("staging")}}
+
+	dir, err := ioutil.TempDir("", "cluster-overrides")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	overridesFile := filepath.Join(dir, "overrides.yml")
+	require.NoError(t, ioutil.WriteFile(overridesFile, []byte(`
+staging-eu:
+  image_tag: 1.1-eu
+  environment:
+    DEPLOY_ENV: staging-eu
+    SENTRY_ENVIRONMENT: staging-eu
+`), 0644))
+
+	gomock.InOrder(
+		expectCreate(t, mockFactory, eu),
+		eu.project.EXPECT().Up().Return(nil),
+		expectCreate(t, mockFactory, us),
+		us.project.EXPECT().Up().Return(nil),
+	)
+
+	out := &bytes.Buffer{}
+	err = upClusters(mockFactory, newClustersContext(t, "--clusters", "staging-eu,staging-us", "--cluster-overrides", overridesFile, "--dry-run"), out)
+	assert.NoError(t, err)
+
+	web, xray := eu.taskDefinition.ContainerDefinitions[0], eu.taskDefinition.ContainerDefinitions[1]
+	assert.Equal(t, "123456789012.dkr.ecr.us-west-2.amazonaws.com/web:1.1-eu", aws.StringValue(web.Image))
+	assert.Equal(t, []*ecs.KeyValuePair{
+		{Name: aws.String("DEPLOY_ENV"), Value: aws.String("staging-eu")},
+		{Name: aws.String("SENTRY_ENVIRONMENT"), Value: aws.String("staging-eu")},
+	}, web.Environment)
+	assert.Equal(t, "public.ecr.aws/xray/aws-xray-daemon:latest", aws.StringValue(xray.Image), "Expected the sidecar to keep its image")
+	assert.Empty(t, xray.Environment)
+	assert.Equal(t, "123456789012.dkr.ecr.us-west-2.amazonaws.com/web:1.0", aws.StringValue(us.taskDefinition.ContainerDefinitions[0].Image))
+}
+
+func TestUpClustersOverridesOfUnknownCluster(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cluster-overrides")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	overridesFile := filepath.Join(dir, "overrides.yml")
+	require.NoError(t, ioutil.WriteFile(overridesFile, []byte("staging-ue:\n  image_tag: 1.1\n"), 0644))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	err = upClusters(mock_factory.NewMockProjectFactory(ctrl), newClustersContext(t, "--clusters", "staging-eu,staging-us", "--cluster-overrides", overridesFile), &bytes.Buffer{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "staging-ue")
+	}
+}
+
+func TestUpClustersWithClusterFlag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	err := upClusters(mock_factory.NewMockProjectFactory(ctrl), newClustersContext(t, "--clusters", "staging-eu,staging-us", "--region", "eu-west-1"), &bytes.Buffer{})
+	assert.Error(t, err, "Expected an error for --region with --clusters")
+}
+
+func TestImageWithTag(t *testing.T) {
+	tests := []struct {
+		image    string
+		expected string
+	}{
+		{"nginx", "nginx:2.0"},
+		{"nginx:1.19", "nginx:2.0"},
+		{"registry.example.com:5000/team/web", "registry.example.com:5000/team/web:2.0"},
+		{"registry.example.com:5000/team/web:1.0", "registry.example.com:5000/team/web:2.0"},
+		{"123456789012.dkr.ecr.us-west-2.amazonaws.com/web@sha256:0123abcd", "123456789012.dkr.ecr.us-west-2.amazonaws.com/web:2.0"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, imageWithTag(test.image, "2.0"), test.image)
+	}
+}
@@ -14,13 +14,19 @@
 package compose
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/container"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/service"
 	composeFactory "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory"
 	ecscompose "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
+	"github.com/docker/libcompose/cli/command"
+	"github.com/docker/libcompose/project"
 	"github.com/flynn/go-shlex"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -56,6 +62,38 @@ func WithProject(factory composeFactory.ProjectFactory, action ProjectAction, is
 	}
 }
 
+// WithNamedProject is WithProject for the actions which only delete the resources of the project.
+// If the project is named with --project-name and none of its compose files exist, the action runs
+// on the named project, so that its resources can still be deleted once its compose files are.
+func WithNamedProject(factory composeFactory.ProjectFactory, action ProjectAction, isService bool) func(context *cli.Context) {
+	return func(context *cli.Context) {
+		if context.GlobalString(flags.ProjectNameFlag) == "" || composeFilesExist(context) {
+			WithProject(factory, action, isService)(context)
+			return
+		}
+		p, err := factory.CreateNamed(context, isService)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).Fatal("Unable to create ECS Compose Project")
+		}
+		action(p, context)
+	}
+}
+
+// composeFilesExist returns whether any of the compose files of the context exists, those listed
+// with --file or else the default ones
+func composeFilesExist(context *cli.Context) bool {
+	composeContext := &project.Context{}
+	command.Populate(composeContext, context)
+	for _, file := range composeContext.ComposeFiles {
+		if _, err := os.Stat(file); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
 // ProjectCreate creates the task definition required for the containers but does not start them.
 func ProjectCreate(p ecscompose.Project, c *cli.Context) {
 	err := p.Create()
@@ -86,7 +124,7 @@ func ProjectPs(p ecscompose.Project, c *cli.Context) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	os.Stdout.WriteString(allInfo.String(container.ContainerInfoColumns, displayTitle))
+	os.Stdout.WriteString(allInfo.String(container.InfoColumns(allInfo), displayTitle))
 }
 
 // ProjectRun starts containers and executes one-time command against the container
@@ -128,6 +166,74 @@ func ProjectScale(p ecscompose.Project, c *cli.Context) {
 	}
 }
 
+// ProjectRestart replaces the running containers with new ones.
+func ProjectRestart(p ecscompose.Project, c *cli.Context) {
+	err := p.Restart()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ProjectHistory lists the last deployments of the service.
+func ProjectHistory(p ecscompose.Project, c *cli.Context) {
+	allInfo, err := p.History(c.Int(flags.HistoryLimitFlag))
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Stdout.WriteString(allInfo.String(service.HistoryColumns, displayTitle))
+}
+
+// ProjectDiff prints the changes between the running task definition of the service and a revision
+// or the compose files.
+func ProjectDiff(p ecscompose.Project, c *cli.Context) {
+	if err := p.Diff(c.String(flags.DiffRevisionFlag)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ProjectVerify checks that the service responds to HTTP requests with the expected status.
+func ProjectVerify(p ecscompose.Project, c *cli.Context) {
+	timeout, err := parseVerifyTimeout(c.String(flags.ComposeServiceTimeOutFlag))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := p.Verify(c.String(flags.URLPathFlag), c.Int(flags.ExpectStatusFlag), timeout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseVerifyTimeout parses the timeout of verify as a duration, e.g. 3m or 90s, or as a number of
+// minutes like the timeout of the other service commands
+func parseVerifyTimeout(value string) (time.Duration, error) {
+	if timeout, err := time.ParseDuration(value); err == nil {
+		return timeout, nil
+	}
+	minutes, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Error with timeout flag: %s is not a valid duration", value)
+	}
+	return time.Duration(minutes * float64(time.Minute)), nil
+}
+
+// ProjectRebalance reports the spread of the tasks of the service, and replaces the
+// over-concentrated ones with --fix.
+func ProjectRebalance(p ecscompose.Project, c *cli.Context) {
+	if err := rebalance(p, c); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// rebalance only reports the spread in read-only mode, since --fix stops tasks
+func rebalance(p ecscompose.Project, c *cli.Context) error {
+	fix := c.Bool(flags.FixFlag)
+	if fix {
+		if err := readonly.Check(c, "compose service rebalance --"+flags.FixFlag, "ecs:StopTask"); err != nil {
+			return err
+		}
+	}
+	return p.Rebalance(fix)
+}
+
 // ProjectStop brings all containers down.
 func ProjectStop(p ecscompose.Project, c *cli.Context) {
 	err := p.Stop()
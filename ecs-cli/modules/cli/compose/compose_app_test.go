@@ -17,11 +17,14 @@ import (
 	"flag"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory/mock"
 	ecscompose "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli"
 )
 
@@ -48,6 +51,43 @@ func TestWithProject(t *testing.T) {
 	}
 }
 
+func TestWithNamedProjectWithoutComposeFiles(t *testing.T) {
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalSet.String(flags.ProjectNameFlag, "", "")
+	globalSet.Var(&cli.StringSlice{}, flags.ComposeFileNameFlag, "")
+	globalSet.Parse([]string{"--" + flags.ProjectNameFlag, "web", "--" + flags.ComposeFileNameFlag, "/nonexistent/docker-compose.yml"})
+	globalContext := cli.NewContext(nil, globalSet, nil)
+	cliContext := cli.NewContext(nil, nil, globalContext)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProjectFactory := mock_factory.NewMockProjectFactory(ctrl)
+	mockProjectFactory.EXPECT().CreateNamed(cliContext, true).Return(nil, nil)
+
+	testFuncVisited := false
+	testFunc := func(project ecscompose.Project, c *cli.Context) {
+		testFuncVisited = true
+	}
+
+	WithNamedProject(mockProjectFactory, testFunc, true)(cliContext)
+	assert.True(t, testFuncVisited, "Expected test function to be visited")
+}
+
+func TestWithNamedProjectWithoutProjectName(t *testing.T) {
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalSet.Var(&cli.StringSlice{}, flags.ComposeFileNameFlag, "")
+	globalSet.Parse([]string{"--" + flags.ComposeFileNameFlag, "/nonexistent/docker-compose.yml"})
+	globalContext := cli.NewContext(nil, globalSet, nil)
+	cliContext := cli.NewContext(nil, nil, globalContext)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProjectFactory := mock_factory.NewMockProjectFactory(ctrl)
+	mockProjectFactory.EXPECT().Create(cliContext, true).Return(nil, nil)
+
+	WithNamedProject(mockProjectFactory, func(ecscompose.Project, *cli.Context) {}, true)(cliContext)
+}
+
 func TestRun(t *testing.T) {
 	containers := []string{"cont1", "cont2"}
 	commands := []string{"cmd1 cmd2", "cmd3"}
@@ -79,3 +119,68 @@ func TestScale(t *testing.T) {
 
 	ProjectScale(mockProject, cliContext)
 }
+
+func TestVerify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProject := mock_project.NewMockProject(ctrl)
+	mockProject.EXPECT().Verify("/healthz", 204, 3*time.Minute).Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.String(flags.URLPathFlag, "/healthz", "")
+	flagSet.Int(flags.ExpectStatusFlag, 204, "")
+	flagSet.String(flags.ComposeServiceTimeOutFlag, "3m", "")
+	cliContext := cli.NewContext(nil, flagSet, nil)
+
+	ProjectVerify(mockProject, cliContext)
+}
+
+func newRebalanceContext(fix, readOnly bool) *cli.Context {
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalSet.Bool(flags.ReadOnlyFlag, readOnly, "")
+	globalContext := cli.NewContext(nil, globalSet, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.FixFlag, fix, "")
+	return cli.NewContext(nil, flagSet, globalContext)
+}
+
+func TestRebalance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProject := mock_project.NewMockProject(ctrl)
+	gomock.InOrder(
+		mockProject.EXPECT().Rebalance(true).Return(nil),
+		mockProject.EXPECT().Rebalance(false).Return(nil),
+	)
+
+	assert.NoError(t, rebalance(mockProject, newRebalanceContext(true, false)), "Unexpected error rebalancing the service")
+	assert.NoError(t, rebalance(mockProject, newRebalanceContext(false, true)), "Expected the spread to be reported in read-only mode")
+}
+
+func TestRebalanceFixReadOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProject := mock_project.NewMockProject(ctrl)
+
+	err := rebalance(mockProject, newRebalanceContext(true, true))
+	assert.EqualError(t, err, "'compose service rebalance --fix' is not allowed in read-only mode, since it would call the write APIs ecs:StopTask")
+}
+
+func TestParseVerifyTimeout(t *testing.T) {
+	testCases := map[string]time.Duration{
+		"3m":  3 * time.Minute,
+		"90s": 90 * time.Second,
+		"5":   5 * time.Minute,
+		"1.5": 90 * time.Second,
+	}
+	for value, expected := range testCases {
+		timeout, err := parseVerifyTimeout(value)
+		if err != nil || timeout != expected {
+			t.Errorf("Expected %s to be parsed as %s, got %s (%v)", value, expected, timeout, err)
+		}
+	}
+	if _, err := parseVerifyTimeout("soon"); err == nil {
+		t.Error("Expected error parsing an invalid timeout")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"strings"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/git"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/docker/libcompose/project"
@@ -29,18 +30,46 @@ const (
 	containerPortsKey = "Ports"
 	taskDefinitionKey = "TaskDefinition"
 	healthKey         = "Health"
+	capacityKey       = "Capacity"
+	targetHealthKey   = "TargetHealth"
+	urlKey            = "URL"
+	commitKey         = "Commit"
+
+	capacitySpot     = "spot"
+	capacityOnDemand = "on-demand"
+
+	fargateSpotCapacityProvider = "FARGATE_SPOT"
 )
 
 // ContainerInfoColumns is the ordered list of info columns for the ps commands
-var ContainerInfoColumns = []string{containerNameKey, containerStateKey, containerPortsKey, taskDefinitionKey, healthKey}
+var ContainerInfoColumns = []string{containerNameKey, containerStateKey, containerPortsKey, taskDefinitionKey, healthKey, capacityKey, targetHealthKey, urlKey}
+
+// InfoColumns returns the info columns for the ps commands, with the git commit column if any
+// of the tasks was deployed with git tags
+func InfoColumns(infoSet project.InfoSet) []string {
+	for _, info := range infoSet {
+		if info[commitKey] != "" {
+			return append(append([]string{}, ContainerInfoColumns...), commitKey)
+		}
+	}
+	return ContainerInfoColumns
+}
 
 // Container is a wrapper around ecsContainer
 type Container struct {
-	task            *ecs.Task
-	EC2IPAddress    string
+	task         *ecs.Task
+	EC2IPAddress string
+	// EC2InstanceID is the ID of the EC2 instance the container runs on, if it does not use task networking
+	EC2InstanceID string
+	// EC2Spot is true if the container runs on a Spot EC2 instance
+	EC2Spot bool
+	// TargetHealth is the health of the container in the target group of its service, if any
+	TargetHealth string
+	// URL is the address of the load balancer of its service, if any
+	URL             string
 	networkBindings []*ecs.NetworkBinding
 
-	ecsContainer    *ecs.Container
+	ecsContainer *ecs.Container
 }
 
 // NewContainer creates a new instance of the container and sets the task id and ecs container to it
@@ -66,6 +95,29 @@ func (c *Container) Name() string {
 	return utils.GetFormattedContainerName(taskID, aws.StringValue(c.ecsContainer.Name))
 }
 
+// ContainerName returns the name of the container in its task definition
+func (c *Container) ContainerName() string {
+	return aws.StringValue(c.ecsContainer.Name)
+}
+
+// PrivateIPAddress returns the private IP address of the task, if it uses task networking
+func (c *Container) PrivateIPAddress() string {
+	if len(c.ecsContainer.NetworkInterfaces) == 0 {
+		return ""
+	}
+	return aws.StringValue(c.ecsContainer.NetworkInterfaces[0].PrivateIpv4Address)
+}
+
+// HostPort returns the host port bound to the container port, or 0 if it is not bound
+func (c *Container) HostPort(containerPort int64) int64 {
+	for _, binding := range c.networkBindings {
+		if aws.Int64Value(binding.ContainerPort) == containerPort {
+			return aws.Int64Value(binding.HostPort)
+		}
+	}
+	return 0
+}
+
 // TaskDefinition returns the ECS task definition id which encompasses the container definition, with
 // which this container was created
 func (c *Container) TaskDefinition() string {
@@ -120,6 +172,24 @@ func (c *Container) HealthStatus() string {
 	return aws.StringValue(c.ecsContainer.HealthStatus)
 }
 
+// Commit returns the git commit the task was deployed from, if it was tagged with it
+func (c *Container) Commit() string {
+	for _, tag := range c.task.Tags {
+		if aws.StringValue(tag.Key) == git.CommitTagKey {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
+
+// Capacity returns whether the container runs on Spot capacity, either a Spot EC2 instance or Fargate Spot
+func (c *Container) Capacity() string {
+	if c.EC2Spot || aws.StringValue(c.task.CapacityProviderName) == fargateSpotCapacityProvider {
+		return capacitySpot
+	}
+	return capacityOnDemand
+}
+
 // ConvertContainersToInfoSet transforms the list of containers into a formatted set of fields
 func ConvertContainersToInfoSet(containers []Container) project.InfoSet {
 	result := project.InfoSet{}
@@ -131,6 +201,10 @@ func ConvertContainersToInfoSet(containers []Container) project.InfoSet {
 			containerPortsKey: cont.PortString(),
 			taskDefinitionKey: cont.TaskDefinition(),
 			healthKey:         cont.HealthStatus(),
+			capacityKey:       cont.Capacity(),
+			targetHealthKey:   cont.TargetHealth,
+			urlKey:            cont.URL,
+			commitKey:         cont.Commit(),
 		}
 		result = append(result, info)
 	}
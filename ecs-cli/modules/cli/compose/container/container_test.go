@@ -136,6 +136,52 @@ func TestHealthStatus(t *testing.T) {
 	assert.Equal(t, containerHealth, container.HealthStatus())
 }
 
+func TestCapacity(t *testing.T) {
+	container := setupContainer()
+	assert.Equal(t, "on-demand", container.Capacity())
+
+	container.EC2Spot = true
+	assert.Equal(t, "spot", container.Capacity())
+
+	container = setupContainer()
+	container.task.CapacityProviderName = aws.String("FARGATE_SPOT")
+	assert.Equal(t, "spot", container.Capacity())
+}
+
+func TestCommit(t *testing.T) {
+	container := setupContainer()
+	assert.Equal(t, "", container.Commit())
+	assert.Equal(t, ContainerInfoColumns, InfoColumns(ConvertContainersToInfoSet([]Container{container})))
+
+	container.task.Tags = []*ecs.Tag{
+		{Key: aws.String("team"), Value: aws.String("firmware")},
+		{Key: aws.String("git-commit"), Value: aws.String("4f2a7c1e9b")},
+	}
+	assert.Equal(t, "4f2a7c1e9b", container.Commit())
+	columns := InfoColumns(ConvertContainersToInfoSet([]Container{container}))
+	assert.Equal(t, "Commit", columns[len(columns)-1], "Expected the commit column to be shown")
+	assert.Len(t, ContainerInfoColumns, len(columns)-1, "Expected the default columns to be unchanged")
+}
+
+func TestHostPort(t *testing.T) {
+	container := setupContainer()
+	container.networkBindings = []*ecs.NetworkBinding{
+		{ContainerPort: aws.Int64(80), HostPort: aws.Int64(32768)},
+	}
+	assert.Equal(t, int64(32768), container.HostPort(80))
+	assert.Equal(t, int64(0), container.HostPort(443))
+}
+
+func TestPrivateIPAddress(t *testing.T) {
+	container := setupContainer()
+	assert.Equal(t, "", container.PrivateIPAddress())
+
+	container.ecsContainer.NetworkInterfaces = []*ecs.NetworkInterface{
+		{PrivateIpv4Address: aws.String("10.0.0.1")},
+	}
+	assert.Equal(t, "10.0.0.1", container.PrivateIPAddress())
+}
+
 func setupContainer() Container {
 	ecsContainer := &ecs.Container{
 		ContainerArn: aws.String(contArn),
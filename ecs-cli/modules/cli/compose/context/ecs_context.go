@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	ec2client "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
 	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
@@ -44,16 +45,18 @@ type ECSContext struct {
 	// AWS Service Clients
 	ECSClient ecsclient.ECSClient
 	EC2Client ec2client.EC2Client
+	ECRClient ecrclient.Client
 
 	// IsService would decide if the resource created by this compose project would be ECS Tasks directly or through ECS Services
 	IsService bool
 }
 
-// Open populates the ECSContext with new ECS and EC2 Clients
+// Open populates the ECSContext with new ECS, EC2 and ECR Clients
 func (ecsContext *ECSContext) Open() error {
 	// setup AWS service clients
 	ecsContext.ECSClient = ecsclient.NewECSClient(ecsContext.CommandConfig)
 	ecsContext.EC2Client = ec2client.NewEC2Client(ecsContext.CommandConfig)
+	ecsContext.ECRClient = ecrclient.NewClient(ecsContext.CommandConfig)
 
 	return nil
 }
@@ -0,0 +1,73 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/redact"
+	"github.com/aws/aws-sdk-go/aws"
+	log "github.com/sirupsen/logrus"
+)
+
+// dryRunOutput returns where dry run requests are printed; can be replaced in tests
+var dryRunOutput = progress.Output
+
+// IsDryRun returns true if the requests for the command should be printed instead of sent to ECS.
+func IsDryRun(entity ProjectEntity) bool {
+	return entity.Context().CLIContext.Bool(flags.DryRunFlag)
+}
+
+// DryRunRegisterTaskDefinition prints the RegisterTaskDefinition request for the entity
+// without calling ECS. It returns the task definition family, which ECS resolves to the
+// latest revision when used in later requests.
+func DryRunRegisterTaskDefinition(entity ProjectEntity) (string, error) {
+	tags, err := entity.GetTags()
+	if err != nil {
+		return "", err
+	}
+	request := createRegisterTaskDefinitionRequest(entity.TaskDefinition(), tags)
+	if err := NameServiceConnectPortMappings(entity, request); err != nil {
+		return "", err
+	}
+	if err := PrintDryRunRequest("RegisterTaskDefinition", request); err != nil {
+		return "", err
+	}
+	return aws.StringValue(request.Family), nil
+}
+
+// PrintDryRunRequest prints the request for the ECS API operation as JSON, with secrets redacted.
+func PrintDryRunRequest(operation string, request interface{}) error {
+	payload, err := redact.Request(request)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{"operation": operation}).Info("Dry run: skipping ECS API call")
+	fmt.Fprintf(dryRunOutput(), "%s\n%s\n", operation, string(data))
+	return nil
+}
+
+// RedactedValue returns the value of the environment variable or option with the given name,
+// or a placeholder if its name suggests that it contains a secret.
+func RedactedValue(name, value string) string {
+	return redact.Value(name, value)
+}
@@ -0,0 +1,71 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintDryRunRequestRedactsSecrets(t *testing.T) {
+	buf := &bytes.Buffer{}
+	oldOutput := dryRunOutput
+	dryRunOutput = func() io.Writer { return buf }
+	defer func() { dryRunOutput = oldOutput }()
+
+	containerDef := &ecs.ContainerDefinition{
+		Name:  aws.String("web"),
+		Image: aws.String("nginx"),
+		Environment: []*ecs.KeyValuePair{
+			{Name: aws.String("LOG_LEVEL"), Value: aws.String("debug")},
+			{Name: aws.String("DB_PASSWORD"), Value: aws.String("hunter2")},
+			{Name: aws.String("STRIPE_API_KEY"), Value: aws.String("sk_live_123")},
+		},
+		Secrets: []*ecs.Secret{
+			{Name: aws.String("OTHER_SECRET"), ValueFrom: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/other")},
+		},
+		LogConfiguration: &ecs.LogConfiguration{
+			LogDriver: aws.String("splunk"),
+			Options: map[string]*string{
+				"splunk-url":   aws.String("https://splunk.example.com"),
+				"splunk-token": aws.String("abcdef"),
+			},
+		},
+	}
+	request := &ecs.RegisterTaskDefinitionInput{
+		Family:               aws.String("family"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{containerDef},
+	}
+
+	err := PrintDryRunRequest("RegisterTaskDefinition", request)
+	assert.NoError(t, err, "Unexpected error printing dry run request")
+
+	output := buf.String()
+	assert.True(t, strings.HasPrefix(output, "RegisterTaskDefinition\n"), "Expected output to start with the operation name")
+	assert.Contains(t, output, "debug")
+	assert.Contains(t, output, "https://splunk.example.com")
+	assert.Contains(t, output, "arn:aws:ssm:us-west-2:123456789012:parameter/other", "Secret references are not sensitive")
+	assert.NotContains(t, output, "hunter2")
+	assert.NotContains(t, output, "sk_live_123")
+	assert.NotContains(t, output, "abcdef")
+
+	// The request itself must not be modified
+	assert.Equal(t, "hunter2", aws.StringValue(containerDef.Environment[1].Value))
+}
@@ -14,6 +14,8 @@
 package entity
 
 import (
+	"time"
+
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/types"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/cache"
@@ -30,6 +32,11 @@ type ProjectEntity interface {
 	Info(filterComposeTasks bool, desiredStatus string) (project.InfoSet, error)
 	Run(commandOverrides map[string][]string) error
 	Scale(count int) error
+	Restart() error
+	History(limit int) (project.InfoSet, error)
+	Diff(revision string) error
+	Verify(urlPath string, expectStatus int, timeout time.Duration) error
+	Rebalance(fix bool) error
 	Stop() error
 	Down() error
 
@@ -22,9 +22,12 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/cache"
 	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/pagination"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/docker/libcompose/project"
 	log "github.com/sirupsen/logrus"
@@ -34,6 +37,9 @@ const (
 	eniIDKey          = "networkInterfaceId"
 	ENIStatusAttached = "ATTACHED"
 	ENIAttachmentType = "ElasticNetworkInterface"
+
+	// listTasksMaxResults is the maximum number of tasks returned by a single ListTasks call
+	listTasksMaxResults = 100
 )
 
 // TaskDefinitionStore is an in memory cache of Task definitions
@@ -93,6 +99,14 @@ func GetOrCreateTaskDefinition(entity ProjectEntity) (*ecs.TaskDefinition, error
 		"TaskDefinition": taskDefinition,
 	}).Debug("Finding task definition in cache or creating if needed")
 
+	if err := ValidateImages(entity); err != nil {
+		return nil, err
+	}
+
+	if err := EnsureSidecarPermissions(entity); err != nil {
+		return nil, err
+	}
+
 	tags, err := entity.GetTags()
 	if err != nil {
 		return nil, err
@@ -101,8 +115,12 @@ func GetOrCreateTaskDefinition(entity ProjectEntity) (*ecs.TaskDefinition, error
 	// Unfortunately, tags are not part of the task definition, rather they are a field on the Register Task Definition API
 	request := createRegisterTaskDefinitionRequest(taskDefinition, tags)
 
-	resp, err := entity.Context().ECSClient.RegisterTaskDefinitionIfNeeded(request, entity.TaskDefinitionCache())
+	// the port mappings Service Connect refers to are named
+	if err := NameServiceConnectPortMappings(entity, request); err != nil {
+		return nil, err
+	}
 
+	resp, err := entity.Context().ECSClient.RegisterTaskDefinitionIfNeeded(request, entity.TaskDefinitionCache())
 	if err != nil {
 		composeutils.LogError(err, "Create task definition failed")
 		return nil, err
@@ -132,6 +150,7 @@ func createRegisterTaskDefinitionRequest(taskDefinition *ecs.TaskDefinition, tag
 		PidMode:                 taskDefinition.PidMode,
 		IpcMode:                 taskDefinition.IpcMode,
 		PlacementConstraints:    taskDefinition.PlacementConstraints,
+		InferenceAccelerators:   taskDefinition.InferenceAccelerators,
 	}
 
 	if networkMode := taskDefinition.NetworkMode; aws.StringValue(networkMode) != "" {
@@ -171,16 +190,22 @@ func createRegisterTaskDefinitionRequest(taskDefinition *ecs.TaskDefinition, tag
 // Info returns a formatted list of containers (running and stopped) in the current cluster
 // filtered by this project if filterLocal is set to true
 func Info(entity ProjectEntity, filterLocal bool, desiredStatus string) (project.InfoSet, error) {
-	if err := validateDesiredStatus(desiredStatus); err != nil {
-		return nil, err
-	}
-	containers, err := collectContainers(entity, filterLocal, desiredStatus)
+	containers, err := Containers(entity, filterLocal, desiredStatus)
 	if err != nil {
 		return nil, err
 	}
 	return composecontainer.ConvertContainersToInfoSet(containers), nil
 }
 
+// Containers returns the list of containers (running and stopped) in the current cluster
+// filtered by this project if filterLocal is set to true
+func Containers(entity ProjectEntity, filterLocal bool, desiredStatus string) ([]composecontainer.Container, error) {
+	if err := validateDesiredStatus(desiredStatus); err != nil {
+		return nil, err
+	}
+	return collectContainers(entity, filterLocal, desiredStatus)
+}
+
 func validateDesiredStatus(desiredStatus string) error {
 	if desiredStatus != "" && desiredStatus != ecs.DesiredStatusRunning && desiredStatus != ecs.DesiredStatusStopped {
 		return fmt.Errorf("%s is not a valid value for desired status. Please use %s or %s.", desiredStatus, ecs.DesiredStatusRunning, ecs.DesiredStatusStopped)
@@ -205,6 +230,14 @@ func collectContainers(entity ProjectEntity, filterLocal bool, desiredStatus str
 // collectTasks gets all the desiredStatus=RUNNING and STOPPED tasks
 // if filterLocal is set to true, it filters tasks created by this project
 func collectTasks(entity ProjectEntity, filterLocal bool, desiredStatus string) ([]*ecs.Task, error) {
+	paginator, err := pagination.NewPaginator(entity.Context().CLIContext)
+	if err != nil {
+		return nil, err
+	}
+	if paginator.Enabled() {
+		return collectTasksPages(entity, filterLocal, desiredStatus, paginator)
+	}
+
 	// TODO, parallelize, perhaps using channels
 	result := []*ecs.Task{}
 	if desiredStatus == "" || desiredStatus == ecs.DesiredStatusRunning {
@@ -235,24 +268,69 @@ func CollectTasksWithStatus(entity ProjectEntity, status string, filterLocal boo
 	result := []*ecs.Task{}
 
 	err := entity.Context().ECSClient.GetTasksPages(request, func(respTasks []*ecs.Task) error {
-		// Filter the results by task.Group
-		if entity.EntityType() == types.Task && filterLocal {
-			for _, task := range respTasks {
-				if aws.StringValue(task.Group) == GetTaskGroup(entity) {
-					result = append(result, task)
-				} else if aws.StringValue(task.StartedBy) == GetTaskDefinitionFamily(entity) { // Deprecated, filter by StartedBy
-					result = append(result, task)
-				}
-			}
-		} else {
-			result = append(result, respTasks...)
-		}
+		result = append(result, filterProjectTasks(entity, filterLocal, respTasks)...)
 		return nil
 	})
 
 	return result, err
 }
 
+// collectTasksPages gets the tasks with the desired status page by page, until the number
+// of tasks listed reaches --max-items. The RUNNING tasks are listed before the STOPPED tasks.
+func collectTasksPages(entity ProjectEntity, filterLocal bool, desiredStatus string, paginator *pagination.Paginator) ([]*ecs.Task, error) {
+	var statuses []string
+	if desiredStatus == "" || desiredStatus == ecs.DesiredStatusRunning {
+		statuses = append(statuses, ecs.DesiredStatusRunning)
+	}
+	if desiredStatus == "" || desiredStatus == ecs.DesiredStatusStopped {
+		statuses = append(statuses, ecs.DesiredStatusStopped)
+	}
+	statuses, nextToken, err := paginator.Resume(statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*ecs.Task{}
+	for i, status := range statuses {
+		request := constructListPagesRequest(entity, status, filterLocal)
+		request.NextToken = nextToken
+		nextToken = nil
+		for {
+			request.MaxResults = paginator.PageSize(listTasksMaxResults)
+			tasks, pageToken, err := entity.Context().ECSClient.ListTasksPage(request)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, filterProjectTasks(entity, filterLocal, tasks)...)
+			if paginator.Add(len(tasks)) {
+				paginator.Stop("tasks", status, pageToken, statuses[i+1:])
+				return result, nil
+			}
+			if pageToken == nil {
+				break
+			}
+			request.NextToken = pageToken
+		}
+	}
+	return result, nil
+}
+
+// filterProjectTasks filters the tasks by task.Group if filterLocal is true and the entity is a task
+func filterProjectTasks(entity ProjectEntity, filterLocal bool, tasks []*ecs.Task) []*ecs.Task {
+	if entity.EntityType() != types.Task || !filterLocal {
+		return tasks
+	}
+	var result []*ecs.Task
+	for _, task := range tasks {
+		if aws.StringValue(task.Group) == GetTaskGroup(entity) {
+			result = append(result, task)
+		} else if aws.StringValue(task.StartedBy) == GetTaskDefinitionFamily(entity) { // Deprecated, filter by StartedBy
+			result = append(result, task)
+		}
+	}
+	return result
+}
+
 // constructListPagesRequest constructs the request based on the entity type and function parameters
 func constructListPagesRequest(entity ProjectEntity, status string, filterLocal bool) *ecs.ListTasksInput {
 	request := &ecs.ListTasksInput{}
@@ -306,12 +384,18 @@ func processAttachment(taskENIs map[string]string, eniIDs *[]*string, ecsTask *e
 	}
 }
 
-func getPublicIPsFromENIs(entity ProjectEntity, ecsTasks []*ecs.Task) (map[string]string, error) {
-	taskPublicIPs := make(map[string]string)
+// getIPsFromENIs returns the IP addresses of the ENIs of running tasks that use task networking, keyed
+// by task ARN. ENIs are only described for Fargate tasks, which may have a public IP address, and for
+// tasks whose containers do not report the private IP address of the ENI.
+func getIPsFromENIs(entity ProjectEntity, ecsTasks []*ecs.Task) (map[string]string, error) {
+	taskIPs := make(map[string]string)
 	var eniIDs []*string
 	taskENIs := make(map[string]string)
 	for _, ecsTask := range ecsTasks {
-		if aws.StringValue(ecsTask.LaunchType) == config.LaunchTypeFargate && aws.StringValue(ecsTask.LastStatus) == ecs.DesiredStatusRunning {
+		if aws.StringValue(ecsTask.LastStatus) != ecs.DesiredStatusRunning {
+			continue
+		}
+		if aws.StringValue(ecsTask.LaunchType) == config.LaunchTypeFargate || !containersHaveNetworkInterfaces(ecsTask) {
 			for _, attachment := range ecsTask.Attachments {
 				processAttachment(taskENIs, &eniIDs, ecsTask, attachment)
 			}
@@ -319,23 +403,34 @@ func getPublicIPsFromENIs(entity ProjectEntity, ecsTasks []*ecs.Task) (map[strin
 	}
 
 	if len(eniIDs) == 0 {
-		return taskPublicIPs, nil
+		return taskIPs, nil
 	}
 
 	netInterfaces, err := entity.Context().EC2Client.DescribeNetworkInterfaces(eniIDs)
 	if err != nil {
 		log.Warnf("Failed to describe Elastic Network Interfaces; falling back to private IP obtained from DescribeTask. Reason: %s", err)
-		return taskPublicIPs, nil
+		return taskIPs, nil
 	}
 
 	for _, eni := range netInterfaces {
-		if eni.Association != nil {
-			taskArn := taskENIs[aws.StringValue(eni.NetworkInterfaceId)]
-			taskPublicIPs[taskArn] = aws.StringValue(eni.Association.PublicIp)
+		taskArn := taskENIs[aws.StringValue(eni.NetworkInterfaceId)]
+		if eni.Association != nil && aws.StringValue(eni.Association.PublicIp) != "" {
+			taskIPs[taskArn] = aws.StringValue(eni.Association.PublicIp)
+		} else if ip := aws.StringValue(eni.PrivateIpAddress); ip != "" {
+			taskIPs[taskArn] = ip
 		}
 	}
 
-	return taskPublicIPs, nil
+	return taskIPs, nil
+}
+
+func containersHaveNetworkInterfaces(ecsTask *ecs.Task) bool {
+	for _, container := range ecsTask.Containers {
+		if len(container.NetworkInterfaces) == 0 {
+			return false
+		}
+	}
+	return true
 }
 
 func getContainersForTasksWithTaskNetworking(entity ProjectEntity, ecsTasks []*ecs.Task) ([]composecontainer.Container, []*ecs.Task, error) {
@@ -347,8 +442,8 @@ func getContainersForTasksWithTaskNetworking(entity ProjectEntity, ecsTasks []*e
 		return info, ecsTasks, nil
 	}
 
-	// For Fargate tasks
-	taskENIPublicIPs, err := getPublicIPsFromENIs(entity, ecsTasks)
+	// For Fargate tasks, and tasks whose containers do not report their IP address
+	taskENIIPs, err := getIPsFromENIs(entity, ecsTasks)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -372,12 +467,12 @@ func getContainersForTasksWithTaskNetworking(entity ProjectEntity, ecsTasks []*e
 				}
 
 				// Get IPs from ENIs if they have been provisioned and the task is still running
-				if len(container.NetworkInterfaces) > 0 && status != "STOPPED" {
-					ipAddress = aws.StringValue(container.NetworkInterfaces[0].PrivateIpv4Address)
-					if aws.StringValue(ecsTask.LaunchType) == config.LaunchTypeFargate {
-						if ip := taskENIPublicIPs[aws.StringValue(ecsTask.TaskArn)]; ip != "" {
-							ipAddress = ip
-						}
+				if status != "STOPPED" {
+					if len(container.NetworkInterfaces) > 0 {
+						ipAddress = aws.StringValue(container.NetworkInterfaces[0].PrivateIpv4Address)
+					}
+					if ip := taskENIIPs[aws.StringValue(ecsTask.TaskArn)]; ip != "" {
+						ipAddress = ip
 					}
 				}
 				info = append(info, composecontainer.NewContainer(ecsTask, ipAddress, container, bindings))
@@ -412,21 +507,30 @@ func getContainersForTasks(entity ProjectEntity, ecsTasks []*ecs.Task, info []co
 
 	ec2Instances, err := entity.Context().EC2Client.DescribeInstances(ec2InstanceIds)
 	if err != nil {
-		return nil, err
+		if !utils.IsAccessDenied(err) {
+			return nil, err
+		}
+		// read-only credentials are often scoped to ECS, the containers can be listed without their IP addresses
+		log.Warnf("Not allowed to describe EC2 instances; the IP addresses of containers on EC2 instances are not shown. Reason: %s", err)
 	}
 
 	for _, ecsTask := range ecsTasks {
 		ec2ID := containerToEC2InstanceIDs[aws.StringValue(ecsTask.ContainerInstanceArn)]
 
 		var ec2IPAddress string
+		var ec2Spot bool
 		if ec2ID != "" && ec2Instances[ec2ID] != nil {
 			ec2IPAddress = aws.StringValue(ec2Instances[ec2ID].PublicIpAddress)
 			if ec2IPAddress == "" {
 				ec2IPAddress = aws.StringValue(ec2Instances[ec2ID].PrivateIpAddress)
 			}
+			ec2Spot = aws.StringValue(ec2Instances[ec2ID].InstanceLifecycle) == ec2.InstanceLifecycleTypeSpot
 		}
 		for _, container := range ecsTask.Containers {
-			info = append(info, composecontainer.NewContainer(ecsTask, ec2IPAddress, container, container.NetworkBindings))
+			cont := composecontainer.NewContainer(ecsTask, ec2IPAddress, container, container.NetworkBindings)
+			cont.EC2InstanceID = ec2ID
+			cont.EC2Spot = ec2Spot
+			info = append(info, cont)
 		}
 	}
 	return info, nil
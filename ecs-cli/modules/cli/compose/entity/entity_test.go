@@ -15,18 +15,24 @@ package entity
 
 import (
 	"errors"
+	"flag"
 	"fmt"
 	"testing"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/types"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/pagination"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
 )
 
 const (
@@ -65,6 +71,38 @@ func TestGetContainersForTasks(t *testing.T) {
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasks")
 	assert.Len(t, containers, 1, "Expected to have 1 container")
 	assert.Equal(t, aws.StringValue(ec2Instance.PublicIpAddress), containers[0].EC2IPAddress, "Expects PublicIpAddress to match")
+	assert.False(t, containers[0].EC2Spot, "Expects container not to run on a Spot instance")
+}
+
+func TestGetContainersForTasksOnSpotInstance(t *testing.T) {
+	containerInstanceArn := "containerInstanceArn"
+	ec2InstanceID := "ec2InstanceId"
+	ec2Instance := &ec2.Instance{
+		PrivateIpAddress:  aws.String(privateIPAddress),
+		InstanceLifecycle: aws.String(ec2.InstanceLifecycleTypeSpot),
+	}
+
+	ecsTasks := []*ecs.Task{
+		&ecs.Task{
+			Containers: []*ecs.Container{
+				&ecs.Container{
+					Name: aws.String("containerName"),
+				},
+			},
+			ContainerInstanceArn: aws.String(containerInstanceArn),
+		},
+	}
+	containerInstances := map[string]string{containerInstanceArn: ec2InstanceID}
+	ec2Instances := map[string]*ec2.Instance{ec2InstanceID: ec2Instance}
+
+	mockProjectEntity := setupMocks(t, []*string{aws.String(containerInstanceArn)}, containerInstances,
+		[]*string{aws.String(ec2InstanceID)}, ec2Instances)
+
+	containers, err := getContainersForTasks(mockProjectEntity, ecsTasks, nil)
+	assert.NoError(t, err, "Unexpected error when calling getContainersForTasks")
+	assert.Len(t, containers, 1, "Expected to have 1 container")
+	assert.True(t, containers[0].EC2Spot, "Expects container to run on a Spot instance")
+	assert.Equal(t, "spot", containers[0].Capacity())
 }
 
 func ecsTask(launchType string) *ecs.Task {
@@ -312,6 +350,43 @@ func TestGetContainersForTasksWithTaskNetworkingEC2(t *testing.T) {
 	assert.Equal(t, privateIPAddress+":80->80/tcp", containers[0].PortString())
 }
 
+func TestGetContainersForTasksWithTaskNetworkingEC2WithoutContainerNetworkInterfaces(t *testing.T) {
+	ecsTask := ecsTask("EC2")
+	ecsTask.Containers[0].NetworkInterfaces = nil
+	ecsTasks := []*ecs.Task{ecsTask}
+
+	taskDef := taskDefinition(ecs.NetworkModeAwsvpc)
+
+	eni := &ec2.NetworkInterface{
+		NetworkInterfaceId: aws.String(eniIdentifier),
+		PrivateIpAddress:   aws.String(privateIPAddress),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEc2 := mock_ec2.NewMockEC2Client(ctrl)
+	mockProjectEntity := mock_entity.NewMockProjectEntity(ctrl)
+	mockContext := &context.ECSContext{
+		ECSClient: mockEcs,
+		EC2Client: mockEc2,
+	}
+
+	gomock.InOrder(
+		mockProjectEntity.EXPECT().Context().Return(mockContext),
+		mockEc2.EXPECT().DescribeNetworkInterfaces(gomock.Any()).Return([]*ec2.NetworkInterface{eni}, nil),
+		mockProjectEntity.EXPECT().Context().Return(mockContext),
+		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDef, nil),
+	)
+
+	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks)
+	assert.NoError(t, err, "Unexpected error when calling getContainersForTasksWithTaskNetworking")
+	assert.Len(t, containers, 1, "Expected to have 1 container")
+	assert.Len(t, tasks, 0, "Expected to have 0 tasks without task networking")
+	assert.Equal(t, privateIPAddress, containers[0].EC2IPAddress)
+	assert.Equal(t, privateIPAddress+":80->80/tcp", containers[0].PortString())
+}
+
 func TestGetContainersForTasksWithTaskNetworkingFargate(t *testing.T) {
 	ecsTasks := []*ecs.Task{
 		ecsTask("FARGATE"),
@@ -529,6 +604,105 @@ func TestGetContainersForTasksErrorCases(t *testing.T) {
 	assert.Error(t, err, "Expected error when calling getContainersForTasks")
 }
 
+func TestGetContainersForTasksWithoutEC2Access(t *testing.T) {
+	containerInstanceArn := "containerInstanceArn"
+	ecsTasks := []*ecs.Task{
+		&ecs.Task{
+			Containers: []*ecs.Container{
+				&ecs.Container{
+					Name: aws.String("containerName"),
+				},
+			},
+			ContainerInstanceArn: aws.String(containerInstanceArn),
+		},
+	}
+
+	mockEc2, mockEcs, mockProjectEntity := setupTest(t)
+	mockContext := &context.ECSContext{
+		ECSClient: mockEcs,
+		EC2Client: mockEc2,
+	}
+	gomock.InOrder(
+		mockProjectEntity.EXPECT().Context().Return(mockContext),
+		mockEcs.EXPECT().GetEC2InstanceIDs(gomock.Any()).Return(map[string]string{containerInstanceArn: "ec2InstanceId"}, nil),
+		mockProjectEntity.EXPECT().Context().Return(mockContext),
+		mockEc2.EXPECT().DescribeInstances(gomock.Any()).Return(nil, awserr.New("UnauthorizedOperation", "not authorized", nil)),
+	)
+
+	containers, err := getContainersForTasks(mockProjectEntity, ecsTasks, nil)
+	assert.NoError(t, err, "Unexpected error when EC2 instances cannot be described")
+	assert.Len(t, containers, 1, "Expected the container to be listed")
+	assert.Equal(t, "ec2InstanceId", containers[0].EC2InstanceID)
+}
+
+func TestCollectTasksWithMaxItems(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-ps", 0)
+	flagSet.Int(flags.MaxItemsFlag, 3, "")
+	flagSet.String(flags.StartingTokenFlag, "", "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockProjectEntity := mock_entity.NewMockProjectEntity(ctrl)
+	mockContext := &context.ECSContext{
+		ECSClient:  mockEcs,
+		CLIContext: cli.NewContext(nil, flagSet, nil),
+	}
+	mockProjectEntity.EXPECT().Context().Return(mockContext).AnyTimes()
+	mockProjectEntity.EXPECT().EntityType().Return(types.Task).AnyTimes()
+
+	newTask := func(id string) *ecs.Task {
+		return &ecs.Task{TaskArn: aws.String(id)}
+	}
+	gomock.InOrder(
+		mockEcs.EXPECT().ListTasksPage(&ecs.ListTasksInput{
+			DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+			MaxResults:    aws.Int64(3),
+		}).Return([]*ecs.Task{newTask("1"), newTask("2")}, nil, nil),
+		mockEcs.EXPECT().ListTasksPage(&ecs.ListTasksInput{
+			DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+			MaxResults:    aws.Int64(1),
+		}).Return([]*ecs.Task{newTask("3")}, aws.String("next"), nil),
+	)
+
+	tasks, err := collectTasks(mockProjectEntity, false, "")
+	assert.NoError(t, err, "Unexpected error collecting tasks")
+	assert.Len(t, tasks, 3, "Expected the listing to stop at --max-items")
+}
+
+func TestCollectTasksWithStartingToken(t *testing.T) {
+	token := &pagination.Token{Listing: ecs.DesiredStatusStopped, NextToken: "next"}
+	flagSet := flag.NewFlagSet("ecs-cli-ps", 0)
+	flagSet.Int(flags.MaxItemsFlag, 0, "")
+	flagSet.String(flags.StartingTokenFlag, token.String(), "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockProjectEntity := mock_entity.NewMockProjectEntity(ctrl)
+	mockContext := &context.ECSContext{
+		ECSClient:  mockEcs,
+		CLIContext: cli.NewContext(nil, flagSet, nil),
+	}
+	mockProjectEntity.EXPECT().Context().Return(mockContext).AnyTimes()
+	mockProjectEntity.EXPECT().EntityType().Return(types.Task).AnyTimes()
+
+	gomock.InOrder(
+		mockEcs.EXPECT().ListTasksPage(&ecs.ListTasksInput{
+			DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+			NextToken:     aws.String("next"),
+		}).Return([]*ecs.Task{&ecs.Task{TaskArn: aws.String("4")}}, aws.String("last"), nil),
+		mockEcs.EXPECT().ListTasksPage(&ecs.ListTasksInput{
+			DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+			NextToken:     aws.String("last"),
+		}).Return(nil, nil, nil),
+	)
+
+	tasks, err := collectTasks(mockProjectEntity, false, "")
+	assert.NoError(t, err, "Unexpected error collecting tasks")
+	assert.Len(t, tasks, 1, "Expected the listing to resume with the STOPPED tasks")
+}
+
 func setupTest(t *testing.T) (*mock_ec2.MockEC2Client, *mock_ecs.MockECSClient, *mock_entity.MockProjectEntity) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
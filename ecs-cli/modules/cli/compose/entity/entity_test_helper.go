@@ -97,6 +97,11 @@ func TestInfo(setupEntity setupEntityForTestInfo, validateFunc validateListTasks
 	mockEcs := mock_ecs.NewMockECSClient(ctrl)
 	mockEc2 := mock_ec2.NewMockEC2Client(ctrl)
 
+	// Services are described to look up the target health of their containers
+	mockEcs.EXPECT().DescribeService(gomock.Any()).Return(&ecs.DescribeServicesOutput{
+		Services: []*ecs.Service{&ecs.Service{}},
+	}, nil).AnyTimes()
+
 	var expectedCalls []*gomock.Call
 
 	logrus.Info("desiredStatus in TestInfo: " + desiredStatus)
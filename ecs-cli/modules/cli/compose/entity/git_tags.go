@@ -0,0 +1,98 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/git"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// detectGitMetadata detects the git metadata of a directory; can be replaced in tests
+var detectGitMetadata = git.Detect
+
+// GitTags returns the tags with the git metadata of the deployment, if it was requested with --git-tags
+// or one of the flags providing it. With --git-tags, the metadata is detected from the working tree of
+// the compose file, and the flags override the detected values.
+func GitTags(entity ProjectEntity) ([]*ecs.Tag, error) {
+	ecsContext := entity.Context()
+	cliContext := ecsContext.CLIContext
+	metadata := &git.Metadata{}
+	if cliContext.Bool(flags.GitTagsFlag) {
+		dir := "."
+		if len(ecsContext.ComposeFiles) > 0 {
+			dir = filepath.Dir(ecsContext.ComposeFiles[0])
+		}
+		detected, err := detectGitMetadata(dir)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to detect the git metadata for --%s; run the command from a git working tree or specify --%s: %v", flags.GitTagsFlag, flags.GitCommitFlag, err)
+		}
+		if detected.Dirty {
+			log.WithFields(log.Fields{"commit": detected.Commit}).Warn("The working tree has uncommitted changes, which are not part of the tagged commit")
+		}
+		metadata = detected
+	}
+	metadata.Commit = gitFlagValue(cliContext, flags.GitCommitFlag, metadata.Commit)
+	metadata.Branch = gitFlagValue(cliContext, flags.GitBranchFlag, metadata.Branch)
+	metadata.Repository = gitFlagValue(cliContext, flags.GitRepositoryFlag, metadata.Repository)
+
+	var tags []*ecs.Tag
+	for _, tag := range []struct{ key, value string }{
+		{git.CommitTagKey, metadata.Commit},
+		{git.BranchTagKey, metadata.Branch},
+		{git.RepositoryTagKey, git.RedactRepositoryURL(metadata.Repository)},
+	} {
+		if tag.value != "" {
+			tags = append(tags, &ecs.Tag{Key: aws.String(tag.key), Value: aws.String(git.TagValue(tag.value))})
+		}
+	}
+	return tags, nil
+}
+
+// AppendGitTags appends the git tags of the deployment to tags, unless tags with the same keys were
+// specified with --resource-tags.
+func AppendGitTags(entity ProjectEntity, tags []*ecs.Tag) ([]*ecs.Tag, error) {
+	gitTags, err := GitTags(entity)
+	if err != nil {
+		return nil, err
+	}
+	for _, gitTag := range gitTags {
+		if !hasTag(tags, aws.StringValue(gitTag.Key)) {
+			tags = append(tags, gitTag)
+		}
+	}
+	return tags, nil
+}
+
+func gitFlagValue(cliContext *cli.Context, flagName, detected string) string {
+	if value := cliContext.String(flagName); value != "" {
+		return value
+	}
+	return detected
+}
+
+func hasTag(tags []*ecs.Tag, key string) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == key {
+			return true
+		}
+	}
+	return false
+}
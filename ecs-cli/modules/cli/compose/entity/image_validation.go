@@ -0,0 +1,154 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	cpuArchitectureAttribute = "ecs.cpu-architecture"
+	fargateArchitecture      = "amd64"
+)
+
+// ecrImagePattern matches images in ECR, capturing the registry ID, region, repository and the tag or digest
+var ecrImagePattern = regexp.MustCompile(`^(\d{12})\.dkr\.ecr(?:-fips)?\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?/([^:@]+)(?::([^@]+))?(?:@(sha256:[0-9a-f]+))?$`)
+
+// ecsArchitectures maps the values of the ecs.cpu-architecture attribute to image architectures
+var ecsArchitectures = map[string]string{
+	"x86_64": "amd64",
+	"arm64":  "arm64",
+}
+
+// ValidateImages checks that the ECR images of the task definition exist and are built for the CPU
+// architecture of the cluster's capacity, so that the command fails before any task is started rather
+// than tasks failing with CannotPullContainerError. Images in other registries are not checked.
+func ValidateImages(entity ProjectEntity) error {
+	ecsContext := entity.Context()
+	if ecsContext.ECRClient == nil || ecsContext.CLIContext.Bool(flags.SkipImageValidationFlag) {
+		return nil
+	}
+	return validateImages(ecsContext, entity.TaskDefinition())
+}
+
+func validateImages(ecsContext *context.ECSContext, taskDefinition *ecs.TaskDefinition) error {
+	var clusterArchitectures []string
+	clusterArchitecturesLoaded := false
+
+	for _, container := range taskDefinition.ContainerDefinitions {
+		image := aws.StringValue(container.Image)
+		match := ecrImagePattern.FindStringSubmatch(image)
+		if match == nil || match[2] != ecsContext.CommandConfig.Region() {
+			log.WithFields(log.Fields{"image": image}).Debug("Skipping validation of image outside of the ECR registries of the region")
+			continue
+		}
+		registryID, repository, reference := match[1], match[3], match[5]
+		if reference == "" {
+			reference = match[4]
+		}
+		if reference == "" {
+			reference = "latest"
+		}
+
+		imageArchitectures, err := ecsContext.ECRClient.GetImageArchitectures(registryID, repository, reference)
+		if err != nil {
+			if errors.Cause(err) == ecrclient.ErrImageNotFound {
+				return fmt.Errorf("Image %s of container %s was not found in ECR; push it before deploying, or use --%s to skip this check", image, aws.StringValue(container.Name), flags.SkipImageValidationFlag)
+			}
+			return fmt.Errorf("Error validating image %s of container %s: %v. Use --%s to skip this check", image, aws.StringValue(container.Name), err, flags.SkipImageValidationFlag)
+		}
+		if len(imageArchitectures) == 0 {
+			continue
+		}
+
+		if !clusterArchitecturesLoaded {
+			if clusterArchitectures, err = getClusterArchitectures(ecsContext); err != nil {
+				return err
+			}
+			clusterArchitecturesLoaded = true
+		}
+		if len(clusterArchitectures) == 0 {
+			continue
+		}
+
+		var unsupported []string
+		for _, architecture := range clusterArchitectures {
+			if !utils.InSlice(architecture, imageArchitectures) {
+				unsupported = append(unsupported, architecture)
+			}
+		}
+		if len(unsupported) == len(clusterArchitectures) {
+			return fmt.Errorf("Image %s of container %s is built for %s, but the capacity of cluster %s is %s; build the image for %s, or use --%s to skip this check",
+				image, aws.StringValue(container.Name), strings.Join(imageArchitectures, ", "), ecsContext.CommandConfig.Cluster,
+				strings.Join(clusterArchitectures, ", "), strings.Join(clusterArchitectures, " or "), flags.SkipImageValidationFlag)
+		}
+		if len(unsupported) > 0 {
+			log.WithFields(log.Fields{
+				"image":                    image,
+				"imageArchitectures":       strings.Join(imageArchitectures, ", "),
+				"unsupportedArchitectures": strings.Join(unsupported, ", "),
+			}).Warnf("Tasks placed on %s container instances will fail to start; add a placement constraint on the %s attribute", strings.Join(unsupported, ", "), cpuArchitectureAttribute)
+		}
+	}
+	return nil
+}
+
+// getClusterArchitectures returns the image architectures of the capacity tasks are placed on. FARGATE
+// tasks run on x86_64; for the EC2 launch type, the architectures of the registered container instances
+// are returned, or none if the cluster has no instances yet.
+func getClusterArchitectures(ecsContext *context.ECSContext) ([]string, error) {
+	if ecsContext.CommandConfig.LaunchType == config.LaunchTypeFargate {
+		return []string{fargateArchitecture}, nil
+	}
+
+	containerInstanceArns, err := ecsContext.ECSClient.ListContainerInstances()
+	if err != nil {
+		return nil, err
+	}
+	if len(containerInstanceArns) == 0 {
+		return nil, nil
+	}
+	containerInstances, err := ecsContext.ECSClient.DescribeContainerInstances(containerInstanceArns)
+	if err != nil {
+		return nil, err
+	}
+
+	var architectures []string
+	for _, containerInstance := range containerInstances {
+		for _, attribute := range containerInstance.Attributes {
+			if aws.StringValue(attribute.Name) != cpuArchitectureAttribute {
+				continue
+			}
+			architecture, ok := ecsArchitectures[aws.StringValue(attribute.Value)]
+			if ok && !utils.InSlice(architecture, architectures) {
+				architectures = append(architectures, architecture)
+			}
+		}
+	}
+	sort.Strings(architectures)
+	return architectures, nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testRegistryID = "123456789012"
+	testECRImage   = testRegistryID + ".dkr.ecr.us-west-2.amazonaws.com/web:1.0"
+)
+
+func setupImageValidationContext(t *testing.T, launchType string) (*context.ECSContext, *mock_ecr.MockClient, *mock_ecs.MockECSClient, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockECR := mock_ecr.NewMockClient(ctrl)
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	sess, err := session.NewSession(&aws.Config{Region: aws.String("us-west-2")})
+	assert.NoError(t, err, "Unexpected error creating session")
+
+	ecsContext := &context.ECSContext{
+		CommandConfig: &config.CommandConfig{
+			Cluster:    "cluster",
+			LaunchType: launchType,
+			Session:    sess,
+		},
+		ECRClient: mockECR,
+		ECSClient: mockECS,
+	}
+	return ecsContext, mockECR, mockECS, ctrl
+}
+
+func taskDefinitionWithImages(images ...string) *ecs.TaskDefinition {
+	taskDefinition := &ecs.TaskDefinition{}
+	for _, image := range images {
+		taskDefinition.ContainerDefinitions = append(taskDefinition.ContainerDefinitions, &ecs.ContainerDefinition{
+			Name:  aws.String("web"),
+			Image: aws.String(image),
+		})
+	}
+	return taskDefinition
+}
+
+func containerInstancesWithArchitectures(architectures ...string) []*ecs.ContainerInstance {
+	var containerInstances []*ecs.ContainerInstance
+	for _, architecture := range architectures {
+		containerInstances = append(containerInstances, &ecs.ContainerInstance{
+			Attributes: []*ecs.Attribute{{
+				Name:  aws.String(cpuArchitectureAttribute),
+				Value: aws.String(architecture),
+			}},
+		})
+	}
+	return containerInstances
+}
+
+func TestValidateImagesSkipsOtherRegistries(t *testing.T) {
+	ecsContext, _, _, ctrl := setupImageValidationContext(t, config.LaunchTypeEC2)
+	defer ctrl.Finish()
+
+	err := validateImages(ecsContext, taskDefinitionWithImages(
+		"nginx:latest",
+		"quay.io/prometheus/prometheus",
+		testRegistryID+".dkr.ecr.eu-west-1.amazonaws.com/web:1.0",
+	))
+	assert.NoError(t, err, "Expected images outside of the region's ECR registries to be skipped")
+}
+
+func TestValidateImagesImageNotFound(t *testing.T) {
+	ecsContext, mockECR, _, ctrl := setupImageValidationContext(t, config.LaunchTypeEC2)
+	defer ctrl.Finish()
+
+	mockECR.EXPECT().GetImageArchitectures(testRegistryID, "web", "1.0").Return(nil, errors.Wrap(ecrclient.ErrImageNotFound, "web:1.0"))
+
+	err := validateImages(ecsContext, taskDefinitionWithImages(testECRImage))
+	assert.Error(t, err, "Expected error for missing image")
+	assert.Contains(t, err.Error(), "was not found in ECR")
+}
+
+func TestValidateImagesFargateArchitectureMismatch(t *testing.T) {
+	ecsContext, mockECR, _, ctrl := setupImageValidationContext(t, config.LaunchTypeFargate)
+	defer ctrl.Finish()
+
+	mockECR.EXPECT().GetImageArchitectures(testRegistryID, "web", "1.0").Return([]string{"arm64"}, nil)
+
+	err := validateImages(ecsContext, taskDefinitionWithImages(testECRImage))
+	assert.Error(t, err, "Expected error for arm64 image on FARGATE")
+	assert.Contains(t, err.Error(), "is built for arm64")
+}
+
+func TestValidateImagesEC2Architectures(t *testing.T) {
+	testCases := map[string]struct {
+		imageArchitectures    []string
+		instanceArchitectures []string
+		expectError           bool
+	}{
+		"matching architecture":       {[]string{"arm64"}, []string{"arm64"}, false},
+		"multi-architecture image":    {[]string{"amd64", "arm64"}, []string{"arm64", "x86_64"}, false},
+		"architecture mismatch":       {[]string{"amd64"}, []string{"arm64", "arm64"}, true},
+		"partially supported cluster": {[]string{"amd64"}, []string{"arm64", "x86_64"}, false},
+		"no container instances":      {[]string{"amd64"}, nil, false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ecsContext, mockECR, mockECS, ctrl := setupImageValidationContext(t, config.LaunchTypeEC2)
+			defer ctrl.Finish()
+
+			var containerInstanceArns []*string
+			for range tc.instanceArchitectures {
+				containerInstanceArns = append(containerInstanceArns, aws.String("containerInstanceArn"))
+			}
+			gomock.InOrder(
+				mockECR.EXPECT().GetImageArchitectures(testRegistryID, "web", "1.0").Return(tc.imageArchitectures, nil),
+				mockECS.EXPECT().ListContainerInstances().Return(containerInstanceArns, nil),
+			)
+			if len(containerInstanceArns) > 0 {
+				mockECS.EXPECT().DescribeContainerInstances(containerInstanceArns).Return(containerInstancesWithArchitectures(tc.instanceArchitectures...), nil)
+			}
+
+			err := validateImages(ecsContext, taskDefinitionWithImages(testECRImage))
+			if tc.expectError {
+				assert.Error(t, err, "Expected error validating images")
+			} else {
+				assert.NoError(t, err, "Unexpected error validating images")
+			}
+		})
+	}
+}
+
+func TestValidateImagesDigestReference(t *testing.T) {
+	ecsContext, mockECR, mockECS, ctrl := setupImageValidationContext(t, config.LaunchTypeEC2)
+	defer ctrl.Finish()
+
+	digest := "sha256:0d9f3c4ae2e8c2ef4bf6b5bb2bb7fa1a17b67e0a0a4c0f2a1c9d9c1ae2a3b4c5"
+	mockECR.EXPECT().GetImageArchitectures(testRegistryID, "team/web", digest).Return([]string{"amd64"}, nil)
+	mockECR.EXPECT().GetImageArchitectures(testRegistryID, "team/worker", "latest").Return([]string{"amd64"}, nil)
+	mockECS.EXPECT().ListContainerInstances().Return(nil, nil).Times(1)
+
+	err := validateImages(ecsContext, taskDefinitionWithImages(
+		testRegistryID+".dkr.ecr.us-west-2.amazonaws.com/team/web:1.0@"+digest,
+		testRegistryID+".dkr.ecr.us-west-2.amazonaws.com/team/worker",
+	))
+	assert.NoError(t, err, "Unexpected error validating images")
+}
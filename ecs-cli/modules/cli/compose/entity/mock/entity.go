@@ -19,6 +19,7 @@ package mock_entity
 
 import (
 	reflect "reflect"
+	time "time"
 
 	context "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	types "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/types"
@@ -79,6 +80,20 @@ func (mr *MockProjectEntityMockRecorder) Create() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectEntity)(nil).Create))
 }
 
+// Diff mocks base method
+func (m *MockProjectEntity) Diff(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diff", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Diff indicates an expected call of Diff
+func (mr *MockProjectEntityMockRecorder) Diff(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*MockProjectEntity)(nil).Diff), arg0)
+}
+
 // Down mocks base method
 func (m *MockProjectEntity) Down() error {
 	m.ctrl.T.Helper()
@@ -122,6 +137,21 @@ func (mr *MockProjectEntityMockRecorder) GetTags() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockProjectEntity)(nil).GetTags))
 }
 
+// History mocks base method
+func (m *MockProjectEntity) History(arg0 int) (project.InfoSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", arg0)
+	ret0, _ := ret[0].(project.InfoSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History
+func (mr *MockProjectEntityMockRecorder) History(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockProjectEntity)(nil).History), arg0)
+}
+
 // Info mocks base method
 func (m *MockProjectEntity) Info(arg0 bool, arg1 string) (project.InfoSet, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +181,34 @@ func (mr *MockProjectEntityMockRecorder) LoadContext() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadContext", reflect.TypeOf((*MockProjectEntity)(nil).LoadContext))
 }
 
+// Rebalance mocks base method
+func (m *MockProjectEntity) Rebalance(arg0 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebalance", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rebalance indicates an expected call of Rebalance
+func (mr *MockProjectEntityMockRecorder) Rebalance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebalance", reflect.TypeOf((*MockProjectEntity)(nil).Rebalance), arg0)
+}
+
+// Restart mocks base method
+func (m *MockProjectEntity) Restart() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restart")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restart indicates an expected call of Restart
+func (mr *MockProjectEntityMockRecorder) Restart() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockProjectEntity)(nil).Restart))
+}
+
 // Run mocks base method
 func (m *MockProjectEntity) Run(arg0 map[string][]string) error {
 	m.ctrl.T.Helper()
@@ -260,3 +318,17 @@ func (mr *MockProjectEntityMockRecorder) Up() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Up", reflect.TypeOf((*MockProjectEntity)(nil).Up))
 }
+
+// Verify mocks base method
+func (m *MockProjectEntity) Verify(arg0 string, arg1 int, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify
+func (mr *MockProjectEntityMockRecorder) Verify(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockProjectEntity)(nil).Verify), arg0, arg1, arg2)
+}
@@ -0,0 +1,163 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"fmt"
+	"strings"
+
+	cfnclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
+)
+
+// vpcIdParameterKey is the parameter of the cluster stack which is empty when the stack created the VPC
+const vpcIdParameterKey = "VpcId"
+
+// newCloudformationClient can be replaced in tests
+var newCloudformationClient = cfnclient.NewCloudformationClient
+
+// ResolveNetworkConfiguration completes the awsvpc configuration of the ecs-params file with the
+// --assign-public-ip flag and the defaults of the cluster stack, then checks that the public IP
+// assignment of Fargate tasks suits their subnets.
+func ResolveNetworkConfiguration(entity ProjectEntity) error {
+	if err := applyAssignPublicIPFlag(entity); err != nil {
+		return err
+	}
+	defaultNetworkConfiguration(entity)
+	return validatePublicIPAssignment(entity)
+}
+
+// applyAssignPublicIPFlag overrides assign_public_ip of the ecs-params file with the --assign-public-ip flag
+func applyAssignPublicIPFlag(entity ProjectEntity) error {
+	value := strings.ToUpper(entity.Context().CLIContext.String(flags.AssignPublicIPFlag))
+	if value == "" {
+		return nil
+	}
+	if value != string(composeutils.Enabled) && value != string(composeutils.Disabled) {
+		return fmt.Errorf("--%s must be %s or %s", flags.AssignPublicIPFlag, composeutils.Enabled, composeutils.Disabled)
+	}
+	ecsParams := entity.Context().ECSParams
+	if ecsParams == nil || ecsParams.TaskDefinition.NetworkMode != ecs.NetworkModeAwsvpc {
+		return fmt.Errorf("--%s requires the awsvpc network mode. Set the network mode using an ECS Params file.", flags.AssignPublicIPFlag)
+	}
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.AssignPublicIp = composeutils.AssignPublicIp(value)
+	return nil
+}
+
+// validatePublicIPAssignment rejects a public IP for Fargate tasks in private subnets, where it is
+// unreachable, and warns about Fargate tasks without a public IP in public subnets, which cannot reach
+// the internet to pull their images. The check is skipped if the route tables cannot be read.
+func validatePublicIPAssignment(entity ProjectEntity) error {
+	commandConfig := entity.Context().CommandConfig
+	ecsParams := entity.Context().ECSParams
+	if commandConfig.LaunchType != config.LaunchTypeFargate || ecsParams == nil || ecsParams.TaskDefinition.NetworkMode != ecs.NetworkModeAwsvpc {
+		return nil
+	}
+	awsvpcConfig := ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration
+	if len(awsvpcConfig.Subnets) == 0 {
+		return nil
+	}
+
+	publicSubnets, err := entity.Context().EC2Client.GetPublicSubnets(awsvpcConfig.Subnets)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"subnets": strings.Join(awsvpcConfig.Subnets, ","),
+			"error":   err,
+		}).Warn("Could not check whether the subnets of the tasks are public")
+		return nil
+	}
+	var public, private []string
+	for _, subnet := range awsvpcConfig.Subnets {
+		if publicSubnets[subnet] {
+			public = append(public, subnet)
+		} else {
+			private = append(private, subnet)
+		}
+	}
+
+	if awsvpcConfig.AssignPublicIp == composeutils.Enabled {
+		if len(private) > 0 {
+			return fmt.Errorf("A public IP cannot be assigned to the tasks in the private subnets %s, which have no route to an internet gateway. Use public subnets, or set assign_public_ip to %s and reach the internet through a NAT gateway", strings.Join(private, ", "), composeutils.Disabled)
+		}
+		return nil
+	}
+	if len(public) > 0 {
+		log.WithFields(log.Fields{
+			"subnets": strings.Join(public, ","),
+		}).Warnf("Tasks without a public IP in public subnets cannot pull images from the internet. Set assign_public_ip in the ECS Params file or use --%s %s", flags.AssignPublicIPFlag, composeutils.Enabled)
+	}
+	return nil
+}
+
+// defaultNetworkConfiguration fills the subnets and the security groups of the awsvpc configuration
+// of a Fargate task from the outputs of the cluster stack, when the ecs-params file does not set the
+// subnets. The tasks are assigned a public IP if the stack created the VPC, whose subnets are public.
+// If the cluster has no stack, the network configuration is left as is.
+func defaultNetworkConfiguration(entity ProjectEntity) {
+	commandConfig := entity.Context().CommandConfig
+	ecsParams := entity.Context().ECSParams
+	if commandConfig.LaunchType != config.LaunchTypeFargate || ecsParams == nil || ecsParams.TaskDefinition.NetworkMode != ecs.NetworkModeAwsvpc {
+		return
+	}
+	awsvpcConfig := &ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration
+	if len(awsvpcConfig.Subnets) > 0 {
+		return
+	}
+
+	output, err := newCloudformationClient(commandConfig).DescribeStacks(commandConfig.CFNStackName)
+	if err != nil || len(output.Stacks) == 0 {
+		log.WithFields(log.Fields{
+			"stack": commandConfig.CFNStackName,
+			"error": err,
+		}).Debug("Could not read the network configuration of the cluster stack")
+		return
+	}
+	stack := output.Stacks[0]
+	outputs := make(map[string]string)
+	for _, stackOutput := range stack.Outputs {
+		outputs[aws.StringValue(stackOutput.OutputKey)] = aws.StringValue(stackOutput.OutputValue)
+	}
+	subnets := outputs[cfnclient.OutputKeySubnetIds]
+	if subnets == "" {
+		return
+	}
+
+	fields := log.Fields{"subnets": subnets}
+	awsvpcConfig.Subnets = strings.Split(subnets, ",")
+	// the security group is only an output of the stacks launching container instances
+	if securityGroups := outputs[cfnclient.OutputKeySecurityGroupId]; len(awsvpcConfig.SecurityGroups) == 0 && securityGroups != "" {
+		awsvpcConfig.SecurityGroups = strings.Split(securityGroups, ",")
+		fields["securityGroups"] = securityGroups
+	}
+	if awsvpcConfig.AssignPublicIp == "" && createdVpc(stack.Parameters) {
+		awsvpcConfig.AssignPublicIp = composeutils.Enabled
+		fields["assignPublicIp"] = composeutils.Enabled
+	}
+	log.WithFields(fields).Info("Using the network configuration of the cluster stack, as the ECS Params file sets no subnets")
+}
+
+func createdVpc(parameters []*cloudformation.Parameter) bool {
+	for _, parameter := range parameters {
+		if aws.StringValue(parameter.ParameterKey) == vpcIdParameterKey {
+			return aws.StringValue(parameter.ParameterValue) == ""
+		}
+	}
+	return false
+}
@@ -0,0 +1,258 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/mock"
+	cfnclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const networkDefaultsStackName = "amazon-ecs-cli-setup-default"
+
+func setupNetworkDefaultsEntity(t *testing.T, launchType string, ecsParams *composeutils.ECSParams) (*mock_entity.MockProjectEntity, *mock_cloudformation.MockCloudformationClient, func()) {
+	mockEntity, mockCFN, _, teardown := setupNetworkEntity(t, launchType, ecsParams, "")
+	return mockEntity, mockCFN, teardown
+}
+
+func setupNetworkEntity(t *testing.T, launchType string, ecsParams *composeutils.ECSParams, assignPublicIP string) (*mock_entity.MockProjectEntity, *mock_cloudformation.MockCloudformationClient, *mock_ec2.MockEC2Client, func()) {
+	ctrl := gomock.NewController(t)
+	mockEntity := mock_entity.NewMockProjectEntity(ctrl)
+	mockCFN := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.String(flags.AssignPublicIPFlag, assignPublicIP, "")
+	mockEntity.EXPECT().Context().Return(&context.ECSContext{
+		CLIContext: cli.NewContext(nil, flagSet, nil),
+		CommandConfig: &config.CommandConfig{
+			LaunchType:   launchType,
+			CFNStackName: networkDefaultsStackName,
+		},
+		ECSParams: ecsParams,
+		EC2Client: mockEC2,
+	}).AnyTimes()
+
+	oldNewCloudformationClient := newCloudformationClient
+	newCloudformationClient = func(*config.CommandConfig) cfnclient.CloudformationClient {
+		return mockCFN
+	}
+	return mockEntity, mockCFN, mockEC2, func() {
+		newCloudformationClient = oldNewCloudformationClient
+		ctrl.Finish()
+	}
+}
+
+func awsvpcECSParams() *composeutils.ECSParams {
+	ecsParams := &composeutils.ECSParams{}
+	ecsParams.TaskDefinition.NetworkMode = "awsvpc"
+	return ecsParams
+}
+
+func clusterStack(vpcID string, outputs map[string]string) *cloudformation.DescribeStacksOutput {
+	stack := &cloudformation.Stack{
+		Parameters: []*cloudformation.Parameter{
+			{ParameterKey: aws.String("VpcId"), ParameterValue: aws.String(vpcID)},
+		},
+	}
+	for key, value := range outputs {
+		stack.Outputs = append(stack.Outputs, &cloudformation.Output{OutputKey: aws.String(key), OutputValue: aws.String(value)})
+	}
+	return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{stack}}
+}
+
+func TestDefaultNetworkConfigurationFromCreatedVpc(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	mockEntity, mockCFN, teardown := setupNetworkDefaultsEntity(t, config.LaunchTypeFargate, ecsParams)
+	defer teardown()
+
+	mockCFN.EXPECT().DescribeStacks(networkDefaultsStackName).Return(clusterStack("", map[string]string{
+		cfnclient.OutputKeyVpcId:     "vpc-feedface",
+		cfnclient.OutputKeySubnetIds: "subnet-baff1ed,subnet-baff2ed",
+	}), nil)
+
+	defaultNetworkConfiguration(mockEntity)
+
+	awsvpcConfig := ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration
+	assert.Equal(t, []string{"subnet-baff1ed", "subnet-baff2ed"}, awsvpcConfig.Subnets)
+	assert.Empty(t, awsvpcConfig.SecurityGroups, "Expected no security group, the stack does not launch instances")
+	assert.Equal(t, composeutils.Enabled, awsvpcConfig.AssignPublicIp)
+}
+
+func TestDefaultNetworkConfigurationFromExistingVpc(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	mockEntity, mockCFN, teardown := setupNetworkDefaultsEntity(t, config.LaunchTypeFargate, ecsParams)
+	defer teardown()
+
+	mockCFN.EXPECT().DescribeStacks(networkDefaultsStackName).Return(clusterStack("vpc-feedface", map[string]string{
+		cfnclient.OutputKeySubnetIds:       "subnet-baff1ed",
+		cfnclient.OutputKeySecurityGroupId: "sg-c0ffee",
+	}), nil)
+
+	defaultNetworkConfiguration(mockEntity)
+
+	awsvpcConfig := ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration
+	assert.Equal(t, []string{"subnet-baff1ed"}, awsvpcConfig.Subnets)
+	assert.Equal(t, []string{"sg-c0ffee"}, awsvpcConfig.SecurityGroups)
+	assert.Empty(t, awsvpcConfig.AssignPublicIp, "Expected no public IP in an existing VPC")
+}
+
+func TestDefaultNetworkConfigurationKeepsExplicitValues(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.SecurityGroups = []string{"sg-explicit"}
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.AssignPublicIp = composeutils.Disabled
+	mockEntity, mockCFN, teardown := setupNetworkDefaultsEntity(t, config.LaunchTypeFargate, ecsParams)
+	defer teardown()
+
+	mockCFN.EXPECT().DescribeStacks(networkDefaultsStackName).Return(clusterStack("", map[string]string{
+		cfnclient.OutputKeySubnetIds:       "subnet-baff1ed",
+		cfnclient.OutputKeySecurityGroupId: "sg-c0ffee",
+	}), nil)
+
+	defaultNetworkConfiguration(mockEntity)
+
+	awsvpcConfig := ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration
+	assert.Equal(t, []string{"subnet-baff1ed"}, awsvpcConfig.Subnets)
+	assert.Equal(t, []string{"sg-explicit"}, awsvpcConfig.SecurityGroups)
+	assert.Equal(t, composeutils.Disabled, awsvpcConfig.AssignPublicIp)
+}
+
+func TestDefaultNetworkConfigurationWithSubnets(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets = []string{"subnet-explicit"}
+	mockEntity, _, teardown := setupNetworkDefaultsEntity(t, config.LaunchTypeFargate, ecsParams)
+	defer teardown()
+
+	defaultNetworkConfiguration(mockEntity)
+
+	awsvpcConfig := ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration
+	assert.Equal(t, []string{"subnet-explicit"}, awsvpcConfig.Subnets)
+	assert.Empty(t, awsvpcConfig.SecurityGroups)
+}
+
+func TestDefaultNetworkConfigurationEC2LaunchType(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	mockEntity, _, teardown := setupNetworkDefaultsEntity(t, config.LaunchTypeEC2, ecsParams)
+	defer teardown()
+
+	defaultNetworkConfiguration(mockEntity)
+
+	assert.Empty(t, ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets)
+}
+
+func TestDefaultNetworkConfigurationWithoutStack(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	mockEntity, mockCFN, teardown := setupNetworkDefaultsEntity(t, config.LaunchTypeFargate, ecsParams)
+	defer teardown()
+
+	mockCFN.EXPECT().DescribeStacks(networkDefaultsStackName).Return(nil, errors.New("Stack does not exist"))
+
+	defaultNetworkConfiguration(mockEntity)
+
+	_, err := composeutils.ConvertToECSNetworkConfiguration(ecsParams)
+	assert.Error(t, err, "Expected the missing subnets to be reported")
+}
+
+func TestResolveNetworkConfigurationFlagOverridesECSParams(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets = []string{"subnet-public"}
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.AssignPublicIp = composeutils.Disabled
+	mockEntity, _, mockEC2, teardown := setupNetworkEntity(t, config.LaunchTypeFargate, ecsParams, "enabled")
+	defer teardown()
+
+	mockEC2.EXPECT().GetPublicSubnets([]string{"subnet-public"}).Return(map[string]bool{"subnet-public": true}, nil)
+
+	assert.NoError(t, ResolveNetworkConfiguration(mockEntity))
+	assert.Equal(t, composeutils.Enabled, ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.AssignPublicIp)
+}
+
+func TestResolveNetworkConfigurationInvalidFlag(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	mockEntity, _, _, teardown := setupNetworkEntity(t, config.LaunchTypeFargate, ecsParams, "yes")
+	defer teardown()
+
+	assert.Error(t, ResolveNetworkConfiguration(mockEntity), "Expected an error for an invalid --assign-public-ip")
+}
+
+func TestResolveNetworkConfigurationFlagWithoutAwsvpc(t *testing.T) {
+	ecsParams := &composeutils.ECSParams{}
+	ecsParams.TaskDefinition.NetworkMode = "bridge"
+	mockEntity, _, _, teardown := setupNetworkEntity(t, config.LaunchTypeEC2, ecsParams, "ENABLED")
+	defer teardown()
+
+	assert.Error(t, ResolveNetworkConfiguration(mockEntity), "Expected an error for --assign-public-ip without the awsvpc network mode")
+}
+
+func TestResolveNetworkConfigurationPublicIPInPrivateSubnet(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets = []string{"subnet-public", "subnet-private"}
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.AssignPublicIp = composeutils.Enabled
+	mockEntity, _, mockEC2, teardown := setupNetworkEntity(t, config.LaunchTypeFargate, ecsParams, "")
+	defer teardown()
+
+	mockEC2.EXPECT().GetPublicSubnets([]string{"subnet-public", "subnet-private"}).Return(map[string]bool{"subnet-public": true}, nil)
+
+	err := ResolveNetworkConfiguration(mockEntity)
+	if assert.Error(t, err, "Expected an error for a public IP in a private subnet") {
+		assert.Contains(t, err.Error(), "subnet-private")
+		assert.NotContains(t, err.Error(), "subnet-public")
+	}
+}
+
+func TestResolveNetworkConfigurationNoPublicIPInPublicSubnet(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets = []string{"subnet-public"}
+	mockEntity, _, mockEC2, teardown := setupNetworkEntity(t, config.LaunchTypeFargate, ecsParams, "")
+	defer teardown()
+
+	mockEC2.EXPECT().GetPublicSubnets([]string{"subnet-public"}).Return(map[string]bool{"subnet-public": true}, nil)
+
+	assert.NoError(t, ResolveNetworkConfiguration(mockEntity), "Expected only a warning for tasks without a public IP in a public subnet")
+}
+
+func TestResolveNetworkConfigurationRouteTablesUnreadable(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets = []string{"subnet-private"}
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.AssignPublicIp = composeutils.Enabled
+	mockEntity, _, mockEC2, teardown := setupNetworkEntity(t, config.LaunchTypeFargate, ecsParams, "")
+	defer teardown()
+
+	mockEC2.EXPECT().GetPublicSubnets(gomock.Any()).Return(nil, errors.New("UnauthorizedOperation"))
+
+	assert.NoError(t, ResolveNetworkConfiguration(mockEntity), "Expected the check to be skipped")
+}
+
+func TestResolveNetworkConfigurationEC2LaunchType(t *testing.T) {
+	ecsParams := awsvpcECSParams()
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets = []string{"subnet-private"}
+	mockEntity, _, _, teardown := setupNetworkEntity(t, config.LaunchTypeEC2, ecsParams, "DISABLED")
+	defer teardown()
+
+	assert.NoError(t, ResolveNetworkConfiguration(mockEntity))
+	assert.Equal(t, composeutils.Disabled, ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.AssignPublicIp)
+}
@@ -0,0 +1,100 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
+)
+
+// ValidateNetworkResources checks that the subnets of awsvpc tasks are in a single VPC with a free IP
+// address for each task, and that their security groups are in the same VPC, which RunTask would
+// otherwise reject with an InvalidParameterException. Subnets or security groups which do not exist
+// are reported, whereas other errors describing them, e.g. missing permissions, skip the check.
+func ValidateNetworkResources(entity ProjectEntity, taskCount int) error {
+	ecsParams := entity.Context().ECSParams
+	if ecsParams == nil || ecsParams.TaskDefinition.NetworkMode != ecs.NetworkModeAwsvpc {
+		return nil
+	}
+	awsvpcConfig := ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration
+	if len(awsvpcConfig.Subnets) == 0 {
+		return nil
+	}
+	ec2Client := entity.Context().EC2Client
+
+	subnets, err := ec2Client.DescribeSubnets(awsvpcConfig.Subnets)
+	if err != nil {
+		return describeNetworkResourcesError(err, "subnets", awsvpcConfig.Subnets)
+	}
+	var vpcIDs []string
+	vpcSubnets := make(map[string][]string)
+	var freeIPs int64
+	for _, subnet := range subnets {
+		subnetID, vpcID := aws.StringValue(subnet.SubnetId), aws.StringValue(subnet.VpcId)
+		if _, ok := vpcSubnets[vpcID]; !ok {
+			vpcIDs = append(vpcIDs, vpcID)
+		}
+		vpcSubnets[vpcID] = append(vpcSubnets[vpcID], subnetID)
+		freeIPs += aws.Int64Value(subnet.AvailableIpAddressCount)
+		if aws.Int64Value(subnet.AvailableIpAddressCount) == 0 {
+			log.WithFields(log.Fields{"subnet": subnetID}).Warn("The subnet has no free IP address left for the tasks")
+		}
+	}
+	if len(vpcIDs) > 1 {
+		var spread []string
+		for _, vpcID := range vpcIDs {
+			spread = append(spread, fmt.Sprintf("%s in %s", strings.Join(vpcSubnets[vpcID], ", "), vpcID))
+		}
+		return fmt.Errorf("The subnets of the tasks must be in a single VPC, but they are spread across VPCs: %s", strings.Join(spread, "; "))
+	}
+	if freeIPs < int64(taskCount) {
+		return fmt.Errorf("The subnets %s have %d free IP addresses left, fewer than the %d tasks to run. Add subnets with free IP addresses to the network configuration", strings.Join(awsvpcConfig.Subnets, ", "), freeIPs, taskCount)
+	}
+
+	if len(awsvpcConfig.SecurityGroups) == 0 || len(vpcIDs) == 0 {
+		return nil
+	}
+	groups, err := ec2Client.DescribeSecurityGroups(awsvpcConfig.SecurityGroups)
+	if err != nil {
+		return describeNetworkResourcesError(err, "security groups", awsvpcConfig.SecurityGroups)
+	}
+	var mismatched []string
+	for _, group := range groups {
+		if groupVpcID := aws.StringValue(group.VpcId); groupVpcID != vpcIDs[0] {
+			mismatched = append(mismatched, fmt.Sprintf("%s (in %s)", aws.StringValue(group.GroupId), groupVpcID))
+		}
+	}
+	if len(mismatched) > 0 {
+		return fmt.Errorf("The security groups %s are not in %s, the VPC of the subnets %s. Use security groups of that VPC", strings.Join(mismatched, ", "), vpcIDs[0], strings.Join(awsvpcConfig.Subnets, ", "))
+	}
+	return nil
+}
+
+// describeNetworkResourcesError reports the subnets or security groups which do not exist, and skips
+// the validation for the other errors
+func describeNetworkResourcesError(err error, resources string, ids []string) error {
+	if awsErr, ok := err.(awserr.Error); ok && strings.HasSuffix(awsErr.Code(), ".NotFound") {
+		return fmt.Errorf("The %s %s of the tasks cannot be used: %s", resources, strings.Join(ids, ", "), awsErr.Message())
+	}
+	log.WithFields(log.Fields{
+		"resources": strings.Join(ids, ","),
+		"error":     err,
+	}).Warnf("Could not describe the %s of the tasks, skipping their validation", resources)
+	return nil
+}
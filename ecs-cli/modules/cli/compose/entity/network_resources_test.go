@@ -0,0 +1,139 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+)
+
+func networkResourcesECSParams(subnets, securityGroups []string) *composeutils.ECSParams {
+	ecsParams := awsvpcECSParams()
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets = subnets
+	ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.SecurityGroups = securityGroups
+	return ecsParams
+}
+
+func subnet(subnetID, vpcID string, freeIPs int64) *ec2.Subnet {
+	return &ec2.Subnet{SubnetId: aws.String(subnetID), VpcId: aws.String(vpcID), AvailableIpAddressCount: aws.Int64(freeIPs)}
+}
+
+func securityGroup(groupID, vpcID string) *ec2.SecurityGroup {
+	return &ec2.SecurityGroup{GroupId: aws.String(groupID), VpcId: aws.String(vpcID)}
+}
+
+func TestValidateNetworkResources(t *testing.T) {
+	ecsParams := networkResourcesECSParams([]string{"subnet-1", "subnet-2"}, []string{"sg-1"})
+	mockEntity, _, mockEC2, teardown := setupNetworkEntity(t, config.LaunchTypeFargate, ecsParams, "")
+	defer teardown()
+
+	mockEC2.EXPECT().DescribeSubnets([]string{"subnet-1", "subnet-2"}).Return([]*ec2.Subnet{
+		subnet("subnet-1", "vpc-feedface", 1),
+		subnet("subnet-2", "vpc-feedface", 2),
+	}, nil)
+	mockEC2.EXPECT().DescribeSecurityGroups([]string{"sg-1"}).Return([]*ec2.SecurityGroup{securityGroup("sg-1", "vpc-feedface")}, nil)
+
+	assert.NoError(t, ValidateNetworkResources(mockEntity, 3))
+}
+
+func TestValidateNetworkResourcesSecurityGroupInOtherVpc(t *testing.T) {
+	ecsParams := networkResourcesECSParams([]string{"subnet-1"}, []string{"sg-1", "sg-2"})
+	mockEntity, _, mockEC2, teardown := setupNetworkEntity(t, config.LaunchTypeEC2, ecsParams, "")
+	defer teardown()
+
+	mockEC2.EXPECT().DescribeSubnets([]string{"subnet-1"}).Return([]*ec2.Subnet{subnet("subnet-1", "vpc-feedface", 10)}, nil)
+	mockEC2.EXPECT().DescribeSecurityGroups([]string{"sg-1", "sg-2"}).Return([]*ec2.SecurityGroup{
+		securityGroup("sg-1", "vpc-feedface"),
+		securityGroup("sg-2", "vpc-c0ffee"),
+	}, nil)
+
+	err := ValidateNetworkResources(mockEntity, 1)
+	if assert.Error(t, err, "Expected an error for a security group of another VPC") {
+		assert.Contains(t, err.Error(), "sg-2 (in vpc-c0ffee)")
+		assert.NotContains(t, err.Error(), "sg-1")
+		assert.Contains(t, err.Error(), "vpc-feedface")
+	}
+}
+
+func TestValidateNetworkResourcesSubnetsInSeveralVpcs(t *testing.T) {
+	ecsParams := networkResourcesECSParams([]string{"subnet-1", "subnet-2"}, nil)
+	mockEntity, _, mockEC2, teardown := setupNetworkEntity(t, config.LaunchTypeFargate, ecsParams, "")
+	defer teardown()
+
+	mockEC2.EXPECT().DescribeSubnets([]string{"subnet-1", "subnet-2"}).Return([]*ec2.Subnet{
+		subnet("subnet-1", "vpc-feedface", 10),
+		subnet("subnet-2", "vpc-c0ffee", 10),
+	}, nil)
+
+	err := ValidateNetworkResources(mockEntity, 1)
+	if assert.Error(t, err, "Expected an error for subnets of several VPCs") {
+		assert.Contains(t, err.Error(), "subnet-1 in vpc-feedface; subnet-2 in vpc-c0ffee")
+	}
+}
+
+func TestValidateNetworkResourcesNotEnoughFreeIPs(t *testing.T) {
+	ecsParams := networkResourcesECSParams([]string{"subnet-1", "subnet-2"}, []string{"sg-1"})
+	mockEntity, _, mockEC2, teardown := setupNetworkEntity(t, config.LaunchTypeFargate, ecsParams, "")
+	defer teardown()
+
+	mockEC2.EXPECT().DescribeSubnets([]string{"subnet-1", "subnet-2"}).Return([]*ec2.Subnet{
+		subnet("subnet-1", "vpc-feedface", 0),
+		subnet("subnet-2", "vpc-feedface", 2),
+	}, nil)
+
+	err := ValidateNetworkResources(mockEntity, 3)
+	if assert.Error(t, err, "Expected an error for subnets without enough free IP addresses") {
+		assert.Contains(t, err.Error(), "2 free IP addresses left, fewer than the 3 tasks")
+	}
+}
+
+func TestValidateNetworkResourcesSubnetNotFound(t *testing.T) {
+	ecsParams := networkResourcesECSParams([]string{"subnet-missing"}, nil)
+	mockEntity, _, mockEC2, teardown := setupNetworkEntity(t, config.LaunchTypeFargate, ecsParams, "")
+	defer teardown()
+
+	mockEC2.EXPECT().DescribeSubnets([]string{"subnet-missing"}).Return(nil, awserr.New("InvalidSubnetID.NotFound", "The subnet ID 'subnet-missing' does not exist", nil))
+
+	err := ValidateNetworkResources(mockEntity, 1)
+	if assert.Error(t, err, "Expected an error for a subnet which does not exist") {
+		assert.Contains(t, err.Error(), "The subnet ID 'subnet-missing' does not exist")
+	}
+}
+
+func TestValidateNetworkResourcesUnauthorized(t *testing.T) {
+	ecsParams := networkResourcesECSParams([]string{"subnet-1"}, []string{"sg-1"})
+	mockEntity, _, mockEC2, teardown := setupNetworkEntity(t, config.LaunchTypeFargate, ecsParams, "")
+	defer teardown()
+
+	mockEC2.EXPECT().DescribeSubnets([]string{"subnet-1"}).Return([]*ec2.Subnet{subnet("subnet-1", "vpc-feedface", 10)}, nil)
+	mockEC2.EXPECT().DescribeSecurityGroups([]string{"sg-1"}).Return(nil, awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", errors.New("denied")))
+
+	assert.NoError(t, ValidateNetworkResources(mockEntity, 1), "Expected the validation to be skipped")
+}
+
+func TestValidateNetworkResourcesBridgeNetworkMode(t *testing.T) {
+	ecsParams := &composeutils.ECSParams{}
+	ecsParams.TaskDefinition.NetworkMode = "bridge"
+	mockEntity, _, _, teardown := setupNetworkEntity(t, config.LaunchTypeEC2, ecsParams, "")
+	defer teardown()
+
+	assert.NoError(t, ValidateNetworkResources(mockEntity, 1))
+}
@@ -0,0 +1,78 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/applicationautoscaling"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/interrupt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// make the scale-in suspension easily mockable in tests
+var setServiceScaleInSuspended applicationautoscaling.SetServiceScaleInSuspendedFunc = applicationautoscaling.SetServiceScaleInSuspended
+
+// suspendScaleIn suspends the dynamic scale-in of the Application Auto Scaling target of the service
+// if --suspend-autoscaling is specified, so that it does not race the rollout, and returns the
+// function resuming it. Scale-in which was already suspended before the deploy is left suspended.
+// An interrupted deploy exits without returning to the caller, so scale-in is also resumed by the
+// cleanup of the deploy operation.
+func (s *Service) suspendScaleIn(ecsService *ecs.Service, missingServiceErr bool, operation *interrupt.Operation) (func(), error) {
+	if !s.Context().CLIContext.Bool(flags.SuspendAutoScalingFlag) {
+		return func() {}, nil
+	}
+	// a service being created has no scalable target yet
+	if missingServiceErr || aws.StringValue(ecsService.Status) != ecsActiveResourceCode {
+		return func() {}, nil
+	}
+
+	serviceName := entity.GetServiceName(s)
+	commandConfig := s.Context().CommandConfig
+	logFields := log.Fields{"serviceName": serviceName}
+	target, err := getServiceScalableTarget(serviceName, commandConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error describing the Application Auto Scaling target of the service '%s'", serviceName)
+	}
+	if target == nil {
+		log.WithFields(logFields).Info("ECS Service is not managed by Application Auto Scaling; there is no scale-in to suspend")
+		return func() {}, nil
+	}
+	if target.SuspendedState != nil && aws.BoolValue(target.SuspendedState.DynamicScalingInSuspended) {
+		log.WithFields(logFields).Info("Scale-in of the ECS Service is already suspended; leaving it suspended after the deploy")
+		return func() {}, nil
+	}
+
+	if err := setServiceScaleInSuspended(serviceName, target, true, commandConfig); err != nil {
+		return nil, errors.Wrapf(err, "Error suspending the scale-in of the service '%s'", serviceName)
+	}
+	log.WithFields(logFields).Info("Suspended Application Auto Scaling scale-in for the deploy")
+	resume := func(commandConfig *config.CommandConfig) {
+		if err := setServiceScaleInSuspended(serviceName, target, false, commandConfig); err != nil {
+			log.WithFields(log.Fields{
+				"serviceName": serviceName,
+				"error":       err,
+			}).Warn("Unable to resume Application Auto Scaling scale-in; resume it with 'aws application-autoscaling register-scalable-target --suspended-state DynamicScalingInSuspended=false'")
+			return
+		}
+		log.WithFields(logFields).Info("Resumed Application Auto Scaling scale-in")
+	}
+	// the requests of the command are stopped after an interrupt
+	operation.AddCleanup(func() { resume(commandConfig.Ungated()) })
+	return func() { resume(commandConfig) }, nil
+}
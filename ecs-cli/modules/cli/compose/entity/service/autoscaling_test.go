@@ -0,0 +1,133 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/applicationautoscaling"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/interrupt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	sdkautoscaling "github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func newAutoScalingTestService(suspend bool) *Service {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.SuspendAutoScalingFlag, suspend, "")
+	ecsContext := &context.ECSContext{
+		CommandConfig: &config.CommandConfig{Cluster: "default"},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+	}
+	ecsContext.ProjectName = "hello"
+	return &Service{ecsContext: ecsContext}
+}
+
+func mockScalableTarget(target *sdkautoscaling.ScalableTarget) *[]bool {
+	var states []bool
+	getServiceScalableTarget = func(serviceName string, config *config.CommandConfig) (*sdkautoscaling.ScalableTarget, error) {
+		return target, nil
+	}
+	setServiceScaleInSuspended = func(serviceName string, target *sdkautoscaling.ScalableTarget, suspended bool, config *config.CommandConfig) error {
+		states = append(states, suspended)
+		return nil
+	}
+	return &states
+}
+
+func restoreScalableTarget() {
+	getServiceScalableTarget = applicationautoscaling.GetServiceScalableTarget
+	setServiceScaleInSuspended = applicationautoscaling.SetServiceScaleInSuspended
+}
+
+func TestSuspendScaleIn(t *testing.T) {
+	defer restoreScalableTarget()
+	states := mockScalableTarget(&sdkautoscaling.ScalableTarget{ResourceId: aws.String("service/default/hello")})
+	activeService := &ecs.Service{Status: aws.String(ecsActiveResourceCode)}
+
+	resume, err := newAutoScalingTestService(true).suspendScaleIn(activeService, false, &interrupt.Operation{})
+	require.NoError(t, err, "Unexpected error suspending scale-in")
+	assert.Equal(t, []bool{true}, *states, "Expected scale-in to be suspended during the deploy")
+
+	resume()
+	assert.Equal(t, []bool{true, false}, *states, "Expected scale-in to be resumed after the deploy")
+}
+
+func TestSuspendScaleInResumedOnInterrupt(t *testing.T) {
+	defer restoreScalableTarget()
+	var configs []*config.CommandConfig
+	mockScalableTarget(&sdkautoscaling.ScalableTarget{ResourceId: aws.String("service/default/hello")})
+	setServiceScaleInSuspended = func(serviceName string, target *sdkautoscaling.ScalableTarget, suspended bool, config *config.CommandConfig) error {
+		configs = append(configs, config)
+		return nil
+	}
+	service := newAutoScalingTestService(true)
+	service.Context().CommandConfig.Session = session.Must(session.NewSession(&aws.Config{Region: aws.String("us-west-2")}))
+	operation := &interrupt.Operation{}
+
+	_, err := service.suspendScaleIn(&ecs.Service{Status: aws.String(ecsActiveResourceCode)}, false, operation)
+	require.NoError(t, err, "Unexpected error suspending scale-in")
+	operation.Cleanup()
+	require.Len(t, configs, 2, "Expected scale-in to be resumed by the cleanup of the interrupted deploy")
+	assert.NotEqual(t, service.Context().CommandConfig.Session, configs[1].Session, "Expected scale-in to be resumed with a session not stopped by the interrupt")
+}
+
+func TestSuspendScaleInSkipped(t *testing.T) {
+	activeService := &ecs.Service{Status: aws.String(ecsActiveResourceCode)}
+	alreadySuspended := &sdkautoscaling.ScalableTarget{
+		SuspendedState: &sdkautoscaling.SuspendedState{DynamicScalingInSuspended: aws.Bool(true)},
+	}
+	testCases := map[string]struct {
+		suspend        bool
+		ecsService     *ecs.Service
+		missingService bool
+		target         *sdkautoscaling.ScalableTarget
+	}{
+		"flag not set":             {suspend: false, ecsService: activeService, target: &sdkautoscaling.ScalableTarget{}},
+		"new service":              {suspend: true, missingService: true, target: &sdkautoscaling.ScalableTarget{}},
+		"no scalable target":       {suspend: true, ecsService: activeService},
+		"scale-in already stopped": {suspend: true, ecsService: activeService, target: alreadySuspended},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer restoreScalableTarget()
+			states := mockScalableTarget(tc.target)
+
+			resume, err := newAutoScalingTestService(tc.suspend).suspendScaleIn(tc.ecsService, tc.missingService, &interrupt.Operation{})
+			require.NoError(t, err, "Unexpected error suspending scale-in")
+			resume()
+			assert.Empty(t, *states, "Expected the suspended state of the target to be left unchanged")
+		})
+	}
+}
+
+func TestSuspendScaleInError(t *testing.T) {
+	defer restoreScalableTarget()
+	mockScalableTarget(&sdkautoscaling.ScalableTarget{})
+	setServiceScaleInSuspended = func(serviceName string, target *sdkautoscaling.ScalableTarget, suspended bool, config *config.CommandConfig) error {
+		return errors.New("AccessDeniedException")
+	}
+
+	_, err := newAutoScalingTestService(true).suspendScaleIn(&ecs.Service{Status: aws.String(ecsActiveResourceCode)}, false, &interrupt.Operation{})
+	assert.Error(t, err, "Expected error when scale-in cannot be suspended")
+}
@@ -0,0 +1,206 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/waiters"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// debugTaskSeconds is how long the containers of a debug task sleep before the task stops on its own
+	debugTaskSeconds = 3600
+
+	// debugFamilySuffix is appended to the family of the task definition that failed to deploy, so that
+	// the debug revisions don't become the latest revision of the service's family
+	debugFamilySuffix = "-debug"
+
+	debugShell = "/bin/sh"
+)
+
+// make the interactive shell easily mockable in tests
+var (
+	canOpenDebugShell = func() bool {
+		if _, err := exec.LookPath("aws"); err != nil {
+			return false
+		}
+		stdin, err := os.Stdin.Stat()
+		return err == nil && stdin.Mode()&os.ModeCharDevice != 0
+	}
+	openDebugShell = func(command []string) error {
+		cmd := exec.Command(command[0], command[1:]...)
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = progress.Output()
+		cmd.Stderr = os.Stderr
+		return cmd.Run()
+	}
+)
+
+// debugFailedDeploy runs one task of the task definition that failed to deploy, with the entry points
+// of its containers replaced by sleep and ECS Exec enabled, and opens a shell in the container that
+// failed. Without a terminal or the AWS CLI, it prints the command opening the shell instead.
+func (s *Service) debugFailedDeploy() error {
+	container := s.failedContainer()
+
+	taskDef, err := s.Context().ECSClient.RegisterTaskDefinitionIfNeeded(debugTaskDefinitionInput(s.taskDef), s.TaskDefinitionCache())
+	if err != nil {
+		return err
+	}
+	input, err := s.buildRunTaskInput(taskDef.TaskDefinitionArn)
+	if err != nil {
+		return err
+	}
+	input.EnableExecuteCommand = aws.Bool(true)
+	output, err := s.Context().ECSClient.RunTask(input)
+	if err != nil {
+		return err
+	}
+	if len(output.Failures) > 0 {
+		return fmt.Errorf("Unable to run the debug task: %s", aws.StringValue(output.Failures[0].Reason))
+	}
+	if len(output.Tasks) == 0 {
+		return fmt.Errorf("Unable to run the debug task")
+	}
+	taskArn := aws.StringValue(output.Tasks[0].TaskArn)
+	log.WithFields(log.Fields{
+		"task":           taskArn,
+		"taskDefinition": entity.GetIdFromArn(taskDef.TaskDefinitionArn),
+	}).Info("Started a debug task")
+
+	if err = s.waitForDebugTask(taskArn); err != nil {
+		return err
+	}
+
+	command := []string{
+		"aws", "ecs", "execute-command",
+		"--region", s.Context().CommandConfig.Region(),
+		"--cluster", s.Context().CommandConfig.Cluster,
+		"--task", taskArn,
+		"--container", container,
+		"--interactive",
+		"--command", debugShell,
+	}
+	if !canOpenDebugShell() {
+		log.Infof("Open a shell in the debug task with: %s", strings.Join(command, " "))
+		log.Infof("The debug task stops after %d seconds", debugTaskSeconds)
+		return nil
+	}
+
+	log.WithField("container", container).Info("Opening a shell in the debug task; the task is stopped when the shell exits")
+	shellErr := openDebugShell(command)
+	if err = s.Context().ECSClient.StopTask(taskArn); err != nil {
+		return err
+	}
+	return shellErr
+}
+
+// waitForDebugTask waits for the debug task to be running, so that commands can be executed in it
+func (s *Service) waitForDebugTask(taskArn string) error {
+	action := func(retryCount int) (bool, error) {
+		tasks, err := s.Context().ECSClient.DescribeTasks([]*string{aws.String(taskArn)})
+		if err != nil {
+			return false, err
+		}
+		if len(tasks) == 0 {
+			return false, nil
+		}
+		switch aws.StringValue(tasks[0].LastStatus) {
+		case ecs.DesiredStatusRunning:
+			return true, nil
+		case ecs.DesiredStatusStopped:
+			return false, fmt.Errorf("The debug task stopped: %s", aws.StringValue(tasks[0].StoppedReason))
+		}
+		return false, nil
+	}
+	return waiters.TaskWaitUntilTimeout(action, s, "Timeout waiting for the debug task to run")
+}
+
+// failedContainer returns the container that exited with an error in the latest stopped task of the
+// task definition that failed to deploy, or else its first essential container
+func (s *Service) failedContainer() string {
+	tasks, _, err := s.Context().ECSClient.ListTasksPage(&ecs.ListTasksInput{
+		ServiceName:   aws.String(entity.GetServiceName(s)),
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+	})
+	if err != nil {
+		log.Warnf("Could not list the stopped tasks of the service: %v", err)
+	}
+	var latest *ecs.Task
+	for _, task := range tasks {
+		if aws.StringValue(task.TaskDefinitionArn) != aws.StringValue(s.taskDef.TaskDefinitionArn) {
+			continue
+		}
+		if latest == nil || aws.TimeValue(task.StoppedAt).After(aws.TimeValue(latest.StoppedAt)) {
+			latest = task
+		}
+	}
+	if latest != nil {
+		for _, container := range latest.Containers {
+			if container.ExitCode == nil || aws.Int64Value(container.ExitCode) != 0 {
+				return aws.StringValue(container.Name)
+			}
+		}
+	}
+
+	for _, container := range s.taskDef.ContainerDefinitions {
+		if container.Essential == nil || aws.BoolValue(container.Essential) {
+			return aws.StringValue(container.Name)
+		}
+	}
+	if len(s.taskDef.ContainerDefinitions) == 0 {
+		return ""
+	}
+	return aws.StringValue(s.taskDef.ContainerDefinitions[0].Name)
+}
+
+// debugTaskDefinitionInput returns a copy of the task definition whose containers sleep instead of
+// running their entry point. Health checks and container dependencies are dropped, since the sleeping
+// containers could never satisfy them.
+func debugTaskDefinitionInput(taskDef *ecs.TaskDefinition) *ecs.RegisterTaskDefinitionInput {
+	var containers []*ecs.ContainerDefinition
+	for _, container := range taskDef.ContainerDefinitions {
+		debugContainer := *container
+		debugContainer.EntryPoint = aws.StringSlice([]string{"sleep"})
+		debugContainer.Command = aws.StringSlice([]string{strconv.Itoa(debugTaskSeconds)})
+		debugContainer.HealthCheck = nil
+		debugContainer.DependsOn = nil
+		containers = append(containers, &debugContainer)
+	}
+	return &ecs.RegisterTaskDefinitionInput{
+		Family:                  aws.String(aws.StringValue(taskDef.Family) + debugFamilySuffix),
+		ContainerDefinitions:    containers,
+		Cpu:                     taskDef.Cpu,
+		Memory:                  taskDef.Memory,
+		ExecutionRoleArn:        taskDef.ExecutionRoleArn,
+		TaskRoleArn:             taskDef.TaskRoleArn,
+		NetworkMode:             taskDef.NetworkMode,
+		RequiresCompatibilities: taskDef.RequiresCompatibilities,
+		Volumes:                 taskDef.Volumes,
+		PlacementConstraints:    taskDef.PlacementConstraints,
+		PidMode:                 taskDef.PidMode,
+		IpcMode:                 taskDef.IpcMode,
+		ProxyConfiguration:      taskDef.ProxyConfiguration,
+		InferenceAccelerators:   taskDef.InferenceAccelerators,
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDebugTestService(mockEcs *mock_ecs.MockECSClient) *Service {
+	service := newHookTestService(mockEcs, composeutils.DeployHooks{})
+	service.SetTaskDefinition(&ecs.TaskDefinition{
+		Family:            aws.String("hello"),
+		TaskDefinitionArn: aws.String(arnPrefix + "hello:3"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{Name: aws.String("web")},
+			{Name: aws.String("worker")},
+		},
+	})
+	return service
+}
+
+func TestDebugFailedDeploy(t *testing.T) {
+	testCases := map[string]struct {
+		canOpenShell bool
+		shellErr     error
+	}{
+		"opens a shell": {
+			canOpenShell: true,
+		},
+		"shell fails": {
+			canOpenShell: true,
+			shellErr:     errors.New("exit status 1"),
+		},
+		"prints the command": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func(original func() bool) { canOpenDebugShell = original }(canOpenDebugShell)
+			defer func(original func([]string) error) { openDebugShell = original }(openDebugShell)
+			canOpenDebugShell = func() bool { return tc.canOpenShell }
+			var shellCommand []string
+			openDebugShell = func(command []string) error {
+				shellCommand = command
+				return tc.shellErr
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+			taskArn := "arn:aws:ecs:us-west-2:123456789012:task/default/debug"
+			debugTaskDefArn := aws.String(arnPrefix + "hello-debug:1")
+			gomock.InOrder(
+				mockEcs.EXPECT().ListTasksPage(gomock.Any()).Do(func(x interface{}) {
+					input := x.(*ecs.ListTasksInput)
+					assert.Equal(t, "hello", aws.StringValue(input.ServiceName), "Expected the tasks of the service")
+					assert.Equal(t, ecs.DesiredStatusStopped, aws.StringValue(input.DesiredStatus), "Expected the stopped tasks")
+				}).Return([]*ecs.Task{
+					{
+						TaskDefinitionArn: aws.String(arnPrefix + "hello:3"),
+						StoppedAt:         aws.Time(time.Unix(200, 0)),
+						Containers: []*ecs.Container{
+							{Name: aws.String("web"), ExitCode: aws.Int64(0)},
+							{Name: aws.String("worker"), ExitCode: aws.Int64(1)},
+						},
+					},
+					{
+						TaskDefinitionArn: aws.String(arnPrefix + "hello:2"),
+						StoppedAt:         aws.Time(time.Unix(300, 0)),
+						Containers:        []*ecs.Container{{Name: aws.String("web"), ExitCode: aws.Int64(137)}},
+					},
+				}, nil, nil),
+				mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+					input := x.(*ecs.RegisterTaskDefinitionInput)
+					assert.Equal(t, "hello-debug", aws.StringValue(input.Family), "Expected a debug family")
+				}).Return(&ecs.TaskDefinition{TaskDefinitionArn: debugTaskDefArn}, nil),
+				mockEcs.EXPECT().RunTask(gomock.Any()).Do(func(x interface{}) {
+					input := x.(*ecs.RunTaskInput)
+					assert.Equal(t, aws.StringValue(debugTaskDefArn), aws.StringValue(input.TaskDefinition), "Expected the debug task definition")
+					assert.Equal(t, int64(1), aws.Int64Value(input.Count), "Expected a single task")
+				}).Return(&ecs.RunTaskOutput{Tasks: []*ecs.Task{{TaskArn: aws.String(taskArn)}}}, nil),
+				mockEcs.EXPECT().DescribeTasks([]*string{aws.String(taskArn)}).Return([]*ecs.Task{{
+					TaskArn:    aws.String(taskArn),
+					LastStatus: aws.String(ecs.DesiredStatusRunning),
+				}}, nil),
+			)
+			if tc.canOpenShell {
+				mockEcs.EXPECT().StopTask(taskArn).Return(nil)
+			}
+
+			err := newDebugTestService(mockEcs).debugFailedDeploy()
+			if tc.shellErr != nil {
+				assert.Equal(t, tc.shellErr, err, "Expected the error of the shell")
+			} else {
+				assert.NoError(t, err, "Unexpected error debugging the failed deploy")
+			}
+			if tc.canOpenShell {
+				assert.Equal(t, []string{
+					"aws", "ecs", "execute-command",
+					"--region", "us-west-2",
+					"--cluster", "default",
+					"--task", taskArn,
+					"--container", "worker",
+					"--interactive",
+					"--command", "/bin/sh",
+				}, shellCommand, "Expected a shell in the container that failed")
+			} else {
+				assert.Nil(t, shellCommand, "Expected no shell without a terminal")
+			}
+		})
+	}
+}
+
+func TestDebugFailedDeployWithStoppedDebugTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	taskArn := aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/debug")
+	mockEcs.EXPECT().ListTasksPage(gomock.Any()).Return(nil, nil, errors.New("access denied"))
+	mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Return(&ecs.TaskDefinition{TaskDefinitionArn: aws.String(arnPrefix + "hello-debug:1")}, nil)
+	mockEcs.EXPECT().RunTask(gomock.Any()).Return(&ecs.RunTaskOutput{Tasks: []*ecs.Task{{TaskArn: taskArn}}}, nil)
+	mockEcs.EXPECT().DescribeTasks([]*string{taskArn}).Return([]*ecs.Task{{
+		TaskArn:       taskArn,
+		LastStatus:    aws.String(ecs.DesiredStatusStopped),
+		StoppedReason: aws.String("CannotPullContainerError"),
+	}}, nil)
+
+	err := newDebugTestService(mockEcs).debugFailedDeploy()
+	assert.EqualError(t, err, "The debug task stopped: CannotPullContainerError")
+}
+
+func TestDebugTaskDefinitionInput(t *testing.T) {
+	taskDef := &ecs.TaskDefinition{
+		Family:      aws.String("hello"),
+		NetworkMode: aws.String(ecs.NetworkModeAwsvpc),
+		TaskRoleArn: aws.String("arn:aws:iam::123456789012:role/hello"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:        aws.String("web"),
+				Image:       aws.String("nginx"),
+				EntryPoint:  aws.StringSlice([]string{"/docker-entrypoint.sh"}),
+				Command:     aws.StringSlice([]string{"nginx"}),
+				HealthCheck: &ecs.HealthCheck{Command: aws.StringSlice([]string{"CMD", "true"})},
+				DependsOn:   []*ecs.ContainerDependency{{ContainerName: aws.String("init"), Condition: aws.String("SUCCESS")}},
+			},
+		},
+	}
+
+	input := debugTaskDefinitionInput(taskDef)
+	assert.Equal(t, "hello-debug", aws.StringValue(input.Family), "Expected a debug family")
+	assert.Equal(t, taskDef.NetworkMode, input.NetworkMode, "Expected the network mode to be kept")
+	assert.Equal(t, taskDef.TaskRoleArn, input.TaskRoleArn, "Expected the task role to be kept")
+
+	container := input.ContainerDefinitions[0]
+	assert.Equal(t, "nginx", aws.StringValue(container.Image), "Expected the image to be kept")
+	assert.Equal(t, []string{"sleep"}, aws.StringValueSlice(container.EntryPoint), "Expected the entry point to sleep")
+	assert.Equal(t, []string{"3600"}, aws.StringValueSlice(container.Command), "Expected the sleep duration as command")
+	assert.Nil(t, container.HealthCheck, "Expected the health check to be dropped")
+	assert.Nil(t, container.DependsOn, "Expected the container dependencies to be dropped")
+	assert.Equal(t, []string{"nginx"}, aws.StringValueSlice(taskDef.ContainerDefinitions[0].Command), "Expected the task definition to be left unchanged")
+}
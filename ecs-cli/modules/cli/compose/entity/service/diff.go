@@ -0,0 +1,281 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/docker/docker/pkg/term"
+)
+
+const (
+	diffTaskSection      = "task"
+	diffContainerSection = "container "
+	diffEnvironmentField = "environment "
+
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorReset = "\x1b[0m"
+)
+
+// diffOutput is where the diff is printed, in color if it is a terminal; can be replaced in tests
+var diffOutput io.Writer = os.Stdout
+var isColorOutput = func() bool {
+	_, isTerminal := term.GetFdInfo(os.Stdout)
+	return isTerminal
+}
+
+// Diff prints the changes between the task definition of the primary deployment of the service and
+// the given revision, or the task definition converted from the local compose files if revision is
+// empty. The revision is either a revision number of the family of the project, a family:revision
+// or a task definition ARN. Only the fields that usually change between deployments are compared:
+// the task size and roles, and the image, size, command, ports, environment variables and secrets
+// of each container.
+func (s *Service) Diff(revision string) error {
+	ecsService, err := s.describeService()
+	if err != nil {
+		return err
+	}
+	runningTaskDefinition, err := s.Context().ECSClient.DescribeTaskDefinition(primaryTaskDefinition(ecsService))
+	if err != nil {
+		return err
+	}
+
+	otherTaskDefinition := s.TaskDefinition()
+	otherLabel := "local compose files"
+	if revision != "" {
+		if _, err := strconv.Atoi(revision); err == nil {
+			revision = entity.GetTaskDefinitionFamily(s) + ":" + revision
+		}
+		if otherTaskDefinition, err = s.Context().ECSClient.DescribeTaskDefinition(revision); err != nil {
+			return err
+		}
+		otherLabel = entity.GetIdFromArn(otherTaskDefinition.TaskDefinitionArn)
+	}
+
+	printTaskDefinitionDiff(diffOutput, isColorOutput(),
+		entity.GetIdFromArn(runningTaskDefinition.TaskDefinitionArn)+" (running)", diffFields(runningTaskDefinition),
+		otherLabel, diffFields(otherTaskDefinition))
+	return nil
+}
+
+// primaryTaskDefinition returns the task definition of the primary deployment of the service,
+// which is the one its new tasks are started with
+func primaryTaskDefinition(ecsService *ecs.Service) string {
+	for _, deployment := range ecsService.Deployments {
+		if aws.StringValue(deployment.Status) == ecsPrimaryDeployment {
+			return aws.StringValue(deployment.TaskDefinition)
+		}
+	}
+	return aws.StringValue(ecsService.TaskDefinition)
+}
+
+// diffFields returns the compared fields of the task definition by section: the task, and each of
+// its containers. Empty fields are omitted, so that unset and zero values are equal.
+// Sensitive values are only redacted when printed, so that their changes are still shown.
+func diffFields(taskDefinition *ecs.TaskDefinition) map[string]map[string]string {
+	sections := map[string]map[string]string{
+		diffTaskSection: nonEmptyFields(map[string]string{
+			"cpu":              aws.StringValue(taskDefinition.Cpu),
+			"memory":           aws.StringValue(taskDefinition.Memory),
+			"taskRoleArn":      aws.StringValue(taskDefinition.TaskRoleArn),
+			"executionRoleArn": aws.StringValue(taskDefinition.ExecutionRoleArn),
+		}),
+	}
+	for _, container := range taskDefinition.ContainerDefinitions {
+		fields := map[string]string{
+			"image":             aws.StringValue(container.Image),
+			"cpu":               formatInt64(container.Cpu),
+			"memory":            formatInt64(container.Memory),
+			"memoryReservation": formatInt64(container.MemoryReservation),
+			"command":           strings.Join(aws.StringValueSlice(container.Command), " "),
+			"entryPoint":        strings.Join(aws.StringValueSlice(container.EntryPoint), " "),
+			"ports":             formatPortMappings(container.PortMappings),
+		}
+		for _, env := range container.Environment {
+			fields[diffEnvironmentField+aws.StringValue(env.Name)] = aws.StringValue(env.Value)
+		}
+		for _, secret := range container.Secrets {
+			fields["secret "+aws.StringValue(secret.Name)] = aws.StringValue(secret.ValueFrom)
+		}
+		sections[diffContainerSection+aws.StringValue(container.Name)] = nonEmptyFields(fields)
+	}
+	return sections
+}
+
+// TaskDefinitionChanges returns the fields compared by Diff which differ between the two task
+// definitions, e.g. "container web image", with the sections only in one of them listed whole.
+func TaskDefinitionChanges(oldTaskDefinition, newTaskDefinition *ecs.TaskDefinition) []string {
+	oldSections := diffFields(oldTaskDefinition)
+	newSections := diffFields(newTaskDefinition)
+	var changes []string
+	for _, section := range sortedSections(oldSections, newSections) {
+		oldFields, inOld := oldSections[section]
+		newFields, inNew := newSections[section]
+		switch {
+		case !inNew:
+			changes = append(changes, section+" removed")
+		case !inOld:
+			changes = append(changes, section+" added")
+		default:
+			for _, field := range sortedKeys(oldFields, newFields) {
+				if oldFields[field] != newFields[field] {
+					changes = append(changes, section+" "+field)
+				}
+			}
+		}
+	}
+	return changes
+}
+
+// printTaskDefinitionDiff prints the fields that differ in each section, with the sections that are
+// only in one of the task definitions printed in full
+func printTaskDefinitionDiff(w io.Writer, color bool, oldLabel string, oldSections map[string]map[string]string, newLabel string, newSections map[string]map[string]string) {
+	fmt.Fprintln(w, colored(color, colorRed, "--- "+oldLabel))
+	fmt.Fprintln(w, colored(color, colorGreen, "+++ "+newLabel))
+
+	changed := false
+	for _, section := range sortedSections(oldSections, newSections) {
+		oldFields, inOld := oldSections[section]
+		newFields, inNew := newSections[section]
+		switch {
+		case !inNew:
+			fmt.Fprintln(w, colored(color, colorRed, "- "+section))
+			for _, field := range sortedKeys(oldFields) {
+				fmt.Fprintln(w, colored(color, colorRed, "-   "+diffLine(field, oldFields[field])))
+			}
+			changed = true
+		case !inOld:
+			fmt.Fprintln(w, colored(color, colorGreen, "+ "+section))
+			for _, field := range sortedKeys(newFields) {
+				fmt.Fprintln(w, colored(color, colorGreen, "+   "+diffLine(field, newFields[field])))
+			}
+			changed = true
+		default:
+			var lines []string
+			for _, field := range sortedKeys(oldFields, newFields) {
+				oldValue, inOld := oldFields[field]
+				newValue, inNew := newFields[field]
+				if inOld && inNew && oldValue == newValue {
+					continue
+				}
+				if inOld {
+					lines = append(lines, colored(color, colorRed, "-   "+diffLine(field, oldValue)))
+				}
+				if inNew {
+					lines = append(lines, colored(color, colorGreen, "+   "+diffLine(field, newValue)))
+				}
+			}
+			if len(lines) == 0 {
+				continue
+			}
+			fmt.Fprintln(w, "  "+section)
+			for _, line := range lines {
+				fmt.Fprintln(w, line)
+			}
+			changed = true
+		}
+	}
+	if !changed {
+		fmt.Fprintln(w, "No differences")
+	}
+}
+
+// diffLine returns the field and its value, redacted if it is a sensitive environment variable
+func diffLine(field, value string) string {
+	if strings.HasPrefix(field, diffEnvironmentField) {
+		value = entity.RedactedValue(strings.TrimPrefix(field, diffEnvironmentField), value)
+	}
+	return field + ": " + value
+}
+
+// sortedSections returns the sections of both task definitions, with the task first and then the
+// containers by name
+func sortedSections(oldSections, newSections map[string]map[string]string) []string {
+	sections := sortedKeys(stringKeys(oldSections), stringKeys(newSections))
+	sort.SliceStable(sections, func(i, j int) bool {
+		return sections[i] == diffTaskSection && sections[j] != diffTaskSection
+	})
+	return sections
+}
+
+func stringKeys(sections map[string]map[string]string) map[string]string {
+	keys := make(map[string]string, len(sections))
+	for key := range sections {
+		keys[key] = ""
+	}
+	return keys
+}
+
+// sortedKeys returns the sorted union of the keys of the maps
+func sortedKeys(maps ...map[string]string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, m := range maps {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func nonEmptyFields(fields map[string]string) map[string]string {
+	for key, value := range fields {
+		if value == "" {
+			delete(fields, key)
+		}
+	}
+	return fields
+}
+
+// formatPortMappings returns the container ports and their protocols, which defaults to tcp. The
+// host ports are left out, since ECS sets them to the container ports in the awsvpc network mode.
+func formatPortMappings(portMappings []*ecs.PortMapping) string {
+	var ports []string
+	for _, portMapping := range portMappings {
+		protocol := aws.StringValue(portMapping.Protocol)
+		if protocol == "" {
+			protocol = ecs.TransportProtocolTcp
+		}
+		ports = append(ports, fmt.Sprintf("%d/%s", aws.Int64Value(portMapping.ContainerPort), protocol))
+	}
+	sort.Strings(ports)
+	return strings.Join(ports, " ")
+}
+
+func formatInt64(value *int64) string {
+	if aws.Int64Value(value) == 0 {
+		return ""
+	}
+	return strconv.FormatInt(aws.Int64Value(value), 10)
+}
+
+func colored(color bool, ansiColor, line string) string {
+	if !color {
+		return line
+	}
+	return ansiColor + line + colorReset
+}
@@ -0,0 +1,203 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"testing"
+
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceDiff(t *testing.T) {
+	runningTaskDefinition := &ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String(arnPrefix + "test-service:4"),
+		Memory:            aws.String("512"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:   aws.String("web"),
+				Image:  aws.String("nginx:1.24"),
+				Cpu:    aws.Int64(0),
+				Memory: aws.Int64(256),
+				Environment: []*ecs.KeyValuePair{
+					{Name: aws.String("LOG_LEVEL"), Value: aws.String("info")},
+					{Name: aws.String("API_TOKEN"), Value: aws.String("old-token")},
+				},
+				Secrets: []*ecs.Secret{
+					{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/db-v1")},
+				},
+			},
+			{
+				Name:  aws.String("cron"),
+				Image: aws.String("cron:1"),
+			},
+		},
+	}
+	localTaskDefinition := &ecs.TaskDefinition{
+		Memory: aws.String("1024"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:   aws.String("web"),
+				Image:  aws.String("nginx:1.25"),
+				Memory: aws.Int64(256),
+				Environment: []*ecs.KeyValuePair{
+					{Name: aws.String("API_TOKEN"), Value: aws.String("new-token")},
+					{Name: aws.String("LOG_LEVEL"), Value: aws.String("debug")},
+				},
+				Secrets: []*ecs.Secret{
+					{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/db-v2")},
+				},
+			},
+			{
+				Name:  aws.String("worker"),
+				Image: aws.String("worker:2"),
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	serviceName := "test-service"
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(serviceName).Return(getDescribeServiceTestResponse(&ecs.Service{
+			ServiceName:    aws.String(serviceName),
+			TaskDefinition: aws.String(arnPrefix + "test-service:3"),
+			Deployments: []*ecs.Deployment{
+				{Status: aws.String("ACTIVE"), TaskDefinition: aws.String(arnPrefix + "test-service:3")},
+				{Status: aws.String("PRIMARY"), TaskDefinition: aws.String(arnPrefix + "test-service:4")},
+			},
+		}), nil),
+		mockEcs.EXPECT().DescribeTaskDefinition(arnPrefix+"test-service:4").Return(runningTaskDefinition, nil),
+	)
+
+	output, restore := captureDiffOutput()
+	defer restore()
+	service := newRestartTestService(mockEcs, serviceName)
+	service.SetTaskDefinition(localTaskDefinition)
+	err := service.Diff("")
+	assert.NoError(t, err, "Unexpected error on service diff")
+	assert.Equal(t, `--- test-service:4 (running)
++++ local compose files
+  task
+-   memory: 512
++   memory: 1024
+- container cron
+-   image: cron:1
+  container web
+-   environment API_TOKEN: REDACTED
++   environment API_TOKEN: REDACTED
+-   environment LOG_LEVEL: info
++   environment LOG_LEVEL: debug
+-   image: nginx:1.24
++   image: nginx:1.25
+-   secret DB_PASSWORD: arn:aws:ssm:us-west-2:123456789012:parameter/db-v1
++   secret DB_PASSWORD: arn:aws:ssm:us-west-2:123456789012:parameter/db-v2
++ container worker
++   image: worker:2
+`, output.String())
+}
+
+func TestServiceDiffWithRevision(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String(arnPrefix + "test-service:4"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{Name: aws.String("web"), Image: aws.String("nginx:1.25")},
+		},
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	serviceName := "test-service"
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(serviceName).Return(getDescribeServiceTestResponse(&ecs.Service{
+			ServiceName:    aws.String(serviceName),
+			TaskDefinition: aws.String(arnPrefix + "test-service:4"),
+		}), nil),
+		mockEcs.EXPECT().DescribeTaskDefinition(arnPrefix+"test-service:4").Return(taskDefinition, nil),
+		mockEcs.EXPECT().DescribeTaskDefinition("test-service:2").Return(taskDefinition, nil),
+	)
+
+	output, restore := captureDiffOutput()
+	defer restore()
+	service := newRestartTestService(mockEcs, serviceName)
+	err := service.Diff("2")
+	assert.NoError(t, err, "Unexpected error on service diff")
+	assert.Equal(t, "--- test-service:4 (running)\n+++ test-service:4\nNo differences\n", output.String())
+}
+
+func TestTaskDefinitionChanges(t *testing.T) {
+	runningTaskDefinition := &ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String(arnPrefix + "test-service:4"),
+		TaskRoleArn:       aws.String("arn:aws:iam::123456789012:role/web"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:         aws.String("web"),
+				Image:        aws.String("nginx:1.24"),
+				Command:      aws.StringSlice([]string{"nginx", "-g", "daemon off;"}),
+				PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(80), HostPort: aws.Int64(80), Protocol: aws.String("tcp")}},
+			},
+			{
+				Name:  aws.String("cron"),
+				Image: aws.String("cron:1"),
+			},
+		},
+	}
+	localTaskDefinition := &ecs.TaskDefinition{
+		TaskRoleArn: aws.String("arn:aws:iam::123456789012:role/web"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:         aws.String("web"),
+				Image:        aws.String("nginx:1.24"),
+				Command:      aws.StringSlice([]string{"nginx", "-g", "daemon off;"}),
+				PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(80)}, {ContainerPort: aws.Int64(443)}},
+			},
+			{
+				Name:  aws.String("cron"),
+				Image: aws.String("cron:1"),
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"container web ports"}, TaskDefinitionChanges(runningTaskDefinition, localTaskDefinition))
+	localTaskDefinition.ContainerDefinitions[0].PortMappings = localTaskDefinition.ContainerDefinitions[0].PortMappings[:1]
+	assert.Empty(t, TaskDefinitionChanges(runningTaskDefinition, localTaskDefinition))
+	localTaskDefinition.ContainerDefinitions = localTaskDefinition.ContainerDefinitions[:1]
+	assert.Equal(t, []string{"container cron removed"}, TaskDefinitionChanges(runningTaskDefinition, localTaskDefinition))
+}
+
+func TestPrintTaskDefinitionDiffInColor(t *testing.T) {
+	var output bytes.Buffer
+	printTaskDefinitionDiff(&output, true,
+		"web:1", map[string]map[string]string{diffTaskSection: {"cpu": "256"}},
+		"web:2", map[string]map[string]string{diffTaskSection: {"cpu": "512"}})
+	assert.Equal(t, "\x1b[31m--- web:1\x1b[0m\n\x1b[32m+++ web:2\x1b[0m\n  task\n\x1b[31m-   cpu: 256\x1b[0m\n\x1b[32m+   cpu: 512\x1b[0m\n", output.String())
+}
+
+// captureDiffOutput prints the diff without colors to a buffer, until the returned function is called
+func captureDiffOutput() (*bytes.Buffer, func()) {
+	var output bytes.Buffer
+	originalOutput, originalIsColorOutput := diffOutput, isColorOutput
+	diffOutput = &output
+	isColorOutput = func() bool { return false }
+	return &output, func() {
+		diffOutput, isColorOutput = originalOutput, originalIsColorOutput
+	}
+}
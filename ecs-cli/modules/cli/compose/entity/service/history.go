@@ -0,0 +1,166 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/git"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/docker/libcompose/project"
+)
+
+const (
+	historyRevisionKey     = "Revision"
+	historyRegisteredKey   = "Registered"
+	historyRegisteredByKey = "RegisteredBy"
+	historyCommitKey       = "Commit"
+	historyBranchKey       = "Branch"
+	historyImagesKey       = "Images"
+	historyStatusKey       = "Status"
+
+	// deployment statuses of the revisions
+	historyStatusCurrent    = "current"
+	historyStatusDeploying  = "deploying"
+	historyStatusDraining   = "draining"
+	historyStatusReplaced   = "replaced"
+	historyStatusRolledBack = "rolled back"
+)
+
+// HistoryColumns is the ordered list of info columns for the history command
+var HistoryColumns = []string{historyRevisionKey, historyRegisteredKey, historyRegisteredByKey, historyCommitKey, historyBranchKey, historyImagesKey, historyStatusKey}
+
+// History lists the last revisions of the task definition of the service, newest first, with the
+// time and the IAM principal of their registration, the git metadata they were tagged with, their
+// images, and their status in the deployments of the service. ECS only keeps the deployments in
+// progress, so the status of older revisions is inferred from the revision of the primary deployment:
+// older revisions were replaced by it, and newer ones were rolled back or never started.
+func (s *Service) History(limit int) (project.InfoSet, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("--%s must be greater than 0", flags.HistoryLimitFlag)
+	}
+	ecsService, err := s.describeService()
+	if err != nil {
+		return nil, err
+	}
+	ecsClient := s.Context().ECSClient
+	taskDefinitionArns, err := ecsClient.ListTaskDefinitionRevisions(entity.GetTaskDefinitionFamily(s), ecs.TaskDefinitionStatusActive)
+	if err != nil {
+		return nil, err
+	}
+	if len(taskDefinitionArns) > limit {
+		taskDefinitionArns = taskDefinitionArns[:limit]
+	}
+
+	primaryRevision := int64(0)
+	for _, deployment := range ecsService.Deployments {
+		if aws.StringValue(deployment.Status) == ecsPrimaryDeployment {
+			primaryRevision = taskDefinitionRevision(aws.StringValue(deployment.TaskDefinition))
+		}
+	}
+
+	result := project.InfoSet{}
+	for _, taskDefinitionArn := range taskDefinitionArns {
+		registration, err := ecsClient.DescribeTaskDefinitionRegistration(taskDefinitionArn)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, project.Info{
+			historyRevisionKey:     strconv.FormatInt(registration.Revision, 10),
+			historyRegisteredKey:   formatRegisteredAt(registration.RegisteredAt),
+			historyRegisteredByKey: principalName(registration.RegisteredBy),
+			historyCommitKey:       tagValue(registration.Tags, git.CommitTagKey),
+			historyBranchKey:       tagValue(registration.Tags, git.BranchTagKey),
+			historyImagesKey:       containerImages(registration.ContainerDefinitions),
+			historyStatusKey:       revisionStatus(registration, ecsService, primaryRevision),
+		})
+	}
+	return result, nil
+}
+
+// revisionStatus returns the status of a task definition revision in the deployments of the service
+func revisionStatus(registration *ecsclient.TaskDefinitionRegistration, ecsService *ecs.Service, primaryRevision int64) string {
+	for _, deployment := range ecsService.Deployments {
+		if aws.StringValue(deployment.TaskDefinition) != registration.TaskDefinitionArn {
+			continue
+		}
+		if aws.StringValue(deployment.Status) != ecsPrimaryDeployment {
+			return fmt.Sprintf("%s (%d running)", historyStatusDraining, aws.Int64Value(deployment.RunningCount))
+		}
+		if len(ecsService.Deployments) == 1 && aws.Int64Value(deployment.RunningCount) == aws.Int64Value(deployment.DesiredCount) {
+			return historyStatusCurrent
+		}
+		return fmt.Sprintf("%s (%d/%d running)", historyStatusDeploying, aws.Int64Value(deployment.RunningCount), aws.Int64Value(deployment.DesiredCount))
+	}
+	if registration.Revision > primaryRevision {
+		return historyStatusRolledBack
+	}
+	return historyStatusReplaced
+}
+
+// taskDefinitionRevision returns the revision of a task definition ARN, e.g. 3 for
+// arn:aws:ecs:us-west-2:123456789012:task-definition/web:3
+func taskDefinitionRevision(taskDefinitionArn string) int64 {
+	revision, err := strconv.ParseInt(taskDefinitionArn[strings.LastIndex(taskDefinitionArn, ":")+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+func formatRegisteredAt(registeredAt time.Time) string {
+	if registeredAt.IsZero() {
+		return ""
+	}
+	return registeredAt.UTC().Format(time.RFC3339)
+}
+
+// principalName returns the resource of an IAM principal ARN, e.g. assumed-role/deploy/alice for
+// arn:aws:sts::123456789012:assumed-role/deploy/alice
+func principalName(principalArn string) string {
+	parts := strings.SplitN(principalArn, ":", 6)
+	if len(parts) < 6 {
+		return principalArn
+	}
+	return parts[5]
+}
+
+func tagValue(tags []*ecs.Tag, key string) string {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == key {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
+
+// containerImages returns the images of the containers, prefixed with the container names if there
+// are several containers
+func containerImages(containerDefinitions []*ecs.ContainerDefinition) string {
+	if len(containerDefinitions) == 1 {
+		return aws.StringValue(containerDefinitions[0].Image)
+	}
+	var images []string
+	for _, container := range containerDefinitions {
+		images = append(images, aws.StringValue(container.Name)+"="+aws.StringValue(container.Image))
+	}
+	return strings.Join(images, ", ")
+}
@@ -0,0 +1,135 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/git"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/docker/libcompose/project"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	serviceName := "test-service"
+	registeredAt := time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+	mockEcs.EXPECT().DescribeService(serviceName).Return(getDescribeServiceTestResponse(&ecs.Service{
+		ServiceName: aws.String(serviceName),
+		Status:      aws.String("ACTIVE"),
+		Deployments: []*ecs.Deployment{
+			{
+				Status:         aws.String("PRIMARY"),
+				TaskDefinition: aws.String(arnPrefix + "test-service:4"),
+				DesiredCount:   aws.Int64(3),
+				RunningCount:   aws.Int64(1),
+			},
+			{
+				Status:         aws.String("ACTIVE"),
+				TaskDefinition: aws.String(arnPrefix + "test-service:3"),
+				DesiredCount:   aws.Int64(3),
+				RunningCount:   aws.Int64(2),
+			},
+		},
+	}), nil)
+	mockEcs.EXPECT().ListTaskDefinitionRevisions(serviceName, ecs.TaskDefinitionStatusActive).Return([]string{
+		arnPrefix + "test-service:5",
+		arnPrefix + "test-service:4",
+		arnPrefix + "test-service:3",
+		arnPrefix + "test-service:2",
+		arnPrefix + "test-service:1",
+	}, nil)
+	for revision := int64(5); revision >= 2; revision-- {
+		taskDefinitionArn := arnPrefix + "test-service:" + strconv.FormatInt(revision, 10)
+		mockEcs.EXPECT().DescribeTaskDefinitionRegistration(taskDefinitionArn).Return(&ecsclient.TaskDefinitionRegistration{
+			TaskDefinitionArn: taskDefinitionArn,
+			Revision:          revision,
+			ContainerDefinitions: []*ecs.ContainerDefinition{
+				{Name: aws.String("web"), Image: aws.String("nginx:1." + strconv.FormatInt(revision, 10))},
+			},
+			RegisteredAt: registeredAt.Add(time.Duration(revision) * time.Hour),
+			RegisteredBy: "arn:aws:sts::123456789012:assumed-role/deploy/alice",
+			Tags: []*ecs.Tag{
+				{Key: aws.String(git.CommitTagKey), Value: aws.String("c0ffee" + strconv.FormatInt(revision, 10))},
+				{Key: aws.String(git.BranchTagKey), Value: aws.String("main")},
+			},
+		}, nil)
+	}
+
+	service := newRestartTestService(mockEcs, serviceName)
+	history, err := service.History(4)
+	assert.NoError(t, err, "Unexpected error listing the service history")
+	assert.Len(t, history, 4, "Expected the history to be limited")
+
+	assert.Equal(t, project.Info{
+		historyRevisionKey:     "5",
+		historyRegisteredKey:   "2026-10-01T17:00:00Z",
+		historyRegisteredByKey: "assumed-role/deploy/alice",
+		historyCommitKey:       "c0ffee5",
+		historyBranchKey:       "main",
+		historyImagesKey:       "nginx:1.5",
+		historyStatusKey:       "rolled back",
+	}, history[0])
+	assert.Equal(t, "deploying (1/3 running)", history[1][historyStatusKey])
+	assert.Equal(t, "draining (2 running)", history[2][historyStatusKey])
+	assert.Equal(t, "replaced", history[3][historyStatusKey])
+}
+
+func TestServiceHistoryInvalidLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	service := newRestartTestService(mockEcs, "test-service")
+	_, err := service.History(0)
+	assert.Error(t, err, "Expected error with a limit of 0")
+}
+
+func TestRevisionStatusCurrent(t *testing.T) {
+	ecsService := &ecs.Service{
+		Deployments: []*ecs.Deployment{
+			{
+				Status:         aws.String("PRIMARY"),
+				TaskDefinition: aws.String(arnPrefix + "test-service:4"),
+				DesiredCount:   aws.Int64(2),
+				RunningCount:   aws.Int64(2),
+			},
+		},
+	}
+	registration := &ecsclient.TaskDefinitionRegistration{
+		TaskDefinitionArn: arnPrefix + "test-service:4",
+		Revision:          4,
+	}
+	assert.Equal(t, "current", revisionStatus(registration, ecsService, 4))
+}
+
+func TestContainerImages(t *testing.T) {
+	assert.Equal(t, "nginx:1.25", containerImages([]*ecs.ContainerDefinition{
+		{Name: aws.String("web"), Image: aws.String("nginx:1.25")},
+	}))
+	assert.Equal(t, "web=nginx:1.25, log_router=fluent-bit:2", containerImages([]*ecs.ContainerDefinition{
+		{Name: aws.String("web"), Image: aws.String("nginx:1.25")},
+		{Name: aws.String("log_router"), Image: aws.String("fluent-bit:2")},
+	}))
+}
@@ -0,0 +1,284 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/lambda"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/waiters"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	hookStagePreDeploy  = "pre_deploy"
+	hookStagePostDeploy = "post_deploy"
+)
+
+// make the Lambda invocation easily mockable in tests
+var invokeLambda lambda.InvokeFunc = lambda.Invoke
+
+// make local hook commands easily mockable in tests
+var runHookCommand = func(command []string, env []string) error {
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = progress.Output()
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// hookPayload is the event passed to Lambda hooks
+type hookPayload struct {
+	Stage          string `json:"stage"`
+	Cluster        string `json:"cluster"`
+	Region         string `json:"region"`
+	Service        string `json:"service"`
+	TaskDefinition string `json:"taskDefinition"`
+}
+
+// deployHooks returns the hooks configured in the ECS params file
+func (s *Service) deployHooks() composeutils.DeployHooks {
+	if s.ecsContext.ECSParams == nil {
+		return composeutils.DeployHooks{}
+	}
+	return s.ecsContext.ECSParams.RunParams.DeployHooks
+}
+
+// validateDeployHooks checks every hook before anything is deployed, so that a mistake in a
+// post-deploy hook isn't only reported once the service has been updated
+func (s *Service) validateDeployHooks() error {
+	hooks := s.deployHooks()
+	if err := s.validateStageHooks(hookStagePreDeploy, hooks.PreDeploy); err != nil {
+		return err
+	}
+	return s.validateStageHooks(hookStagePostDeploy, hooks.PostDeploy)
+}
+
+func (s *Service) validateStageHooks(stage string, hooks []composeutils.DeployHook) error {
+	for i, hook := range hooks {
+		if err := s.validateDeployHook(hook); err != nil {
+			return fmt.Errorf("Invalid %s hook '%s': %v", stage, hookName(stage, i, hook), err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) validateDeployHook(hook composeutils.DeployHook) error {
+	kinds := 0
+	if len(hook.Command) > 0 {
+		kinds++
+	}
+	if hook.Lambda != "" {
+		kinds++
+	}
+	if hook.Task != nil {
+		kinds++
+		if hook.Task.Container == "" || len(hook.Task.Command) == 0 {
+			return fmt.Errorf("a task hook requires a container and a command")
+		}
+		if s.taskDef != nil && !hasContainer(s.taskDef, hook.Task.Container) {
+			return fmt.Errorf("container '%s' is not defined in the compose file", hook.Task.Container)
+		}
+	}
+	if kinds != 1 {
+		return fmt.Errorf("exactly one of command, lambda and task must be specified")
+	}
+	return nil
+}
+
+func hasContainer(taskDef *ecs.TaskDefinition, name string) bool {
+	for _, container := range taskDef.ContainerDefinitions {
+		if aws.StringValue(container.Name) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hookName(stage string, index int, hook composeutils.DeployHook) string {
+	if hook.Name != "" {
+		return hook.Name
+	}
+	return fmt.Sprintf("%s[%d]", stage, index)
+}
+
+// runDeployHooks runs the hooks of the stage in order, and stops at the first one that fails
+func (s *Service) runDeployHooks(stage string, hooks []composeutils.DeployHook) error {
+	for i, hook := range hooks {
+		name := hookName(stage, i, hook)
+		log.WithFields(log.Fields{
+			"stage": stage,
+			"hook":  name,
+		}).Info("Running deploy hook")
+
+		var err error
+		switch {
+		case len(hook.Command) > 0:
+			err = runHookCommand(hook.Command, s.hookEnvironment(stage))
+		case hook.Lambda != "":
+			err = s.runLambdaHook(stage, hook.Lambda)
+		default:
+			err = s.runTaskHook(hook.Task)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "The %s hook '%s' failed", stage, name)
+		}
+	}
+	return nil
+}
+
+// hookEnvironment returns the environment variables describing the deploy to local hook commands
+func (s *Service) hookEnvironment(stage string) []string {
+	payload := s.hookPayload(stage)
+	return []string{
+		"ECS_CLI_HOOK_STAGE=" + payload.Stage,
+		"ECS_CLI_CLUSTER=" + payload.Cluster,
+		"ECS_CLI_REGION=" + payload.Region,
+		"ECS_CLI_SERVICE=" + payload.Service,
+		"ECS_CLI_TASK_DEFINITION=" + payload.TaskDefinition,
+	}
+}
+
+func (s *Service) hookPayload(stage string) *hookPayload {
+	commandConfig := s.Context().CommandConfig
+	return &hookPayload{
+		Stage:          stage,
+		Cluster:        commandConfig.Cluster,
+		Region:         commandConfig.Region(),
+		Service:        entity.GetServiceName(s),
+		TaskDefinition: entity.GetIdFromArn(s.taskDef.TaskDefinitionArn),
+	}
+}
+
+func (s *Service) runLambdaHook(stage, functionName string) error {
+	payload, err := json.Marshal(s.hookPayload(stage))
+	if err != nil {
+		return err
+	}
+	_, err = invokeLambda(functionName, payload, s.Context().CommandConfig)
+	return err
+}
+
+// runTaskHook runs the task definition being deployed once with the command of the container
+// overridden, and waits for the task to stop. The hook fails unless the container exits with 0.
+func (s *Service) runTaskHook(hook *composeutils.TaskHook) error {
+	input, err := s.buildHookRunTaskInput(hook)
+	if err != nil {
+		return err
+	}
+	output, err := s.Context().ECSClient.RunTask(input)
+	if err != nil {
+		return err
+	}
+	if len(output.Failures) > 0 {
+		return fmt.Errorf("Unable to run the task: %s", aws.StringValue(output.Failures[0].Reason))
+	}
+	if len(output.Tasks) == 0 {
+		return fmt.Errorf("Unable to run the task")
+	}
+	taskArn := output.Tasks[0].TaskArn
+
+	var stoppedTask *ecs.Task
+	action := func(retryCount int) (bool, error) {
+		tasks, err := s.Context().ECSClient.DescribeTasks([]*string{taskArn})
+		if err != nil {
+			return false, err
+		}
+		if len(tasks) > 0 && aws.StringValue(tasks[0].LastStatus) == ecs.DesiredStatusStopped {
+			stoppedTask = tasks[0]
+			return true, nil
+		}
+		return false, nil
+	}
+	if err = waiters.TaskWaitUntilTimeout(action, s, "Timeout waiting for the hook task to stop"); err != nil {
+		return err
+	}
+
+	for _, container := range stoppedTask.Containers {
+		if aws.StringValue(container.Name) != hook.Container {
+			continue
+		}
+		if container.ExitCode == nil {
+			return fmt.Errorf("Container %s did not run: %s", hook.Container, aws.StringValue(stoppedTask.StoppedReason))
+		}
+		if exitCode := aws.Int64Value(container.ExitCode); exitCode != 0 {
+			return fmt.Errorf("Container %s exited with code %d", hook.Container, exitCode)
+		}
+		return nil
+	}
+	return fmt.Errorf("Container %s not found in task %s", hook.Container, aws.StringValue(taskArn))
+}
+
+// buildHookRunTaskInput runs the task with the command of the hook container overridden
+func (s *Service) buildHookRunTaskInput(hook *composeutils.TaskHook) (*ecs.RunTaskInput, error) {
+	input, err := s.buildRunTaskInput(s.taskDef.TaskDefinitionArn)
+	if err != nil {
+		return nil, err
+	}
+	input.Overrides = &ecs.TaskOverride{
+		ContainerOverrides: []*ecs.ContainerOverride{
+			{
+				Name:    aws.String(hook.Container),
+				Command: aws.StringSlice(hook.Command),
+			},
+		},
+	}
+	return input, nil
+}
+
+// buildRunTaskInput runs one task with the network configuration and placement of the service
+func (s *Service) buildRunTaskInput(taskDefinitionArn *string) (*ecs.RunTaskInput, error) {
+	if err := entity.ResolveNetworkConfiguration(s); err != nil {
+		return nil, err
+	}
+	if err := entity.ValidateNetworkResources(s, 1); err != nil {
+		return nil, err
+	}
+	ecsParams := s.ecsContext.ECSParams
+	networkConfig, err := composeutils.ConvertToECSNetworkConfiguration(ecsParams)
+	if err != nil {
+		return nil, err
+	}
+	placementConstraints, err := composeutils.ConvertToECSPlacementConstraints(ecsParams)
+	if err != nil {
+		return nil, err
+	}
+	placementStrategy, err := composeutils.ConvertToECSPlacementStrategy(ecsParams)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &ecs.RunTaskInput{
+		Cluster:              aws.String(s.Context().CommandConfig.Cluster),
+		TaskDefinition:       taskDefinitionArn,
+		Group:                aws.String(entity.GetTaskGroup(s)),
+		Count:                aws.Int64(1),
+		NetworkConfiguration: networkConfig,
+		PlacementConstraints: placementConstraints,
+		PlacementStrategy:    placementStrategy,
+	}
+	if launchType := s.Context().CommandConfig.LaunchType; launchType != "" {
+		input.LaunchType = aws.String(launchType)
+	}
+	return input, nil
+}
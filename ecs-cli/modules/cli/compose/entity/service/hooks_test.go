@@ -0,0 +1,197 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func newHookTestService(ecsClient *mock_ecs.MockECSClient, hooks composeutils.DeployHooks) *Service {
+	ecsContext := &context.ECSContext{
+		ECSClient: ecsClient,
+		CommandConfig: &config.CommandConfig{
+			Cluster: "default",
+			Session: session.Must(session.NewSession(&aws.Config{Region: aws.String("us-west-2")})),
+		},
+		CLIContext: cli.NewContext(nil, flag.NewFlagSet("ecs-cli", 0), nil),
+		ECSParams: &composeutils.ECSParams{
+			RunParams: composeutils.RunParams{DeployHooks: hooks},
+		},
+	}
+	ecsContext.ProjectName = "hello"
+	service := &Service{ecsContext: ecsContext}
+	service.SetTaskDefinition(&ecs.TaskDefinition{
+		TaskDefinitionArn:    aws.String(arnPrefix + "hello:3"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{{Name: aws.String("web")}},
+	})
+	return service
+}
+
+func TestValidateDeployHooks(t *testing.T) {
+	testCases := map[string]struct {
+		hook        composeutils.DeployHook
+		expectedErr string
+	}{
+		"command": {
+			hook: composeutils.DeployHook{Command: []string{"./migrate.sh"}},
+		},
+		"lambda": {
+			hook: composeutils.DeployHook{Lambda: "warm-cache"},
+		},
+		"task": {
+			hook: composeutils.DeployHook{Task: &composeutils.TaskHook{Container: "web", Command: []string{"rake", "db:migrate"}}},
+		},
+		"nothing": {
+			hook:        composeutils.DeployHook{Name: "empty"},
+			expectedErr: "Invalid post_deploy hook 'empty': exactly one of command, lambda and task must be specified",
+		},
+		"command and lambda": {
+			hook:        composeutils.DeployHook{Command: []string{"true"}, Lambda: "warm-cache"},
+			expectedErr: "Invalid post_deploy hook 'post_deploy[0]': exactly one of command, lambda and task must be specified",
+		},
+		"task without command": {
+			hook:        composeutils.DeployHook{Task: &composeutils.TaskHook{Container: "web"}},
+			expectedErr: "a task hook requires a container and a command",
+		},
+		"task with unknown container": {
+			hook:        composeutils.DeployHook{Task: &composeutils.TaskHook{Container: "worker", Command: []string{"true"}}},
+			expectedErr: "container 'worker' is not defined in the compose file",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			service := newHookTestService(nil, composeutils.DeployHooks{
+				PostDeploy: []composeutils.DeployHook{tc.hook},
+			})
+			err := service.validateDeployHooks()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRunDeployHooksStopsAtFirstFailure(t *testing.T) {
+	defer func(original func([]string, []string) error) { runHookCommand = original }(runHookCommand)
+	defer func(original func(string, []byte, *config.CommandConfig) ([]byte, error)) { invokeLambda = original }(invokeLambda)
+
+	var commands [][]string
+	var env []string
+	runHookCommand = func(command []string, environment []string) error {
+		commands = append(commands, command)
+		env = environment
+		if command[0] == "./fail.sh" {
+			return errors.New("exit status 1")
+		}
+		return nil
+	}
+	var payload hookPayload
+	invokeLambda = func(functionName string, data []byte, config *config.CommandConfig) ([]byte, error) {
+		assert.Equal(t, "warm-cache", functionName)
+		assert.NoError(t, json.Unmarshal(data, &payload))
+		return nil, nil
+	}
+
+	hooks := []composeutils.DeployHook{
+		{Name: "migrate", Command: []string{"./migrate.sh"}},
+		{Lambda: "warm-cache"},
+		{Name: "check", Command: []string{"./fail.sh"}},
+		{Command: []string{"./never.sh"}},
+	}
+	service := newHookTestService(nil, composeutils.DeployHooks{PreDeploy: hooks})
+
+	err := service.runDeployHooks(hookStagePreDeploy, hooks)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "The pre_deploy hook 'check' failed")
+	}
+	assert.Equal(t, [][]string{{"./migrate.sh"}, {"./fail.sh"}}, commands, "Expected hooks to stop at the first failure")
+	assert.Contains(t, env, "ECS_CLI_SERVICE=hello")
+	assert.Contains(t, env, "ECS_CLI_TASK_DEFINITION=hello:3")
+	assert.Equal(t, hookPayload{
+		Stage:          hookStagePreDeploy,
+		Cluster:        "default",
+		Region:         "us-west-2",
+		Service:        "hello",
+		TaskDefinition: "hello:3",
+	}, payload)
+}
+
+func TestRunTaskHook(t *testing.T) {
+	testCases := map[string]struct {
+		container   *ecs.Container
+		expectedErr string
+	}{
+		"success": {
+			container: &ecs.Container{Name: aws.String("web"), ExitCode: aws.Int64(0)},
+		},
+		"non-zero exit code": {
+			container:   &ecs.Container{Name: aws.String("web"), ExitCode: aws.Int64(2)},
+			expectedErr: "Container web exited with code 2",
+		},
+		"container did not run": {
+			container:   &ecs.Container{Name: aws.String("web")},
+			expectedErr: "Container web did not run: CannotPullContainerError",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+			taskArn := aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/abc")
+			gomock.InOrder(
+				mockEcs.EXPECT().RunTask(gomock.Any()).Do(func(x interface{}) {
+					input := x.(*ecs.RunTaskInput)
+					assert.Equal(t, arnPrefix+"hello:3", aws.StringValue(input.TaskDefinition), "Expected the task definition being deployed")
+					override := input.Overrides.ContainerOverrides[0]
+					assert.Equal(t, "web", aws.StringValue(override.Name))
+					assert.Equal(t, []string{"rake", "db:migrate"}, aws.StringValueSlice(override.Command))
+				}).Return(&ecs.RunTaskOutput{Tasks: []*ecs.Task{{TaskArn: taskArn}}}, nil),
+				mockEcs.EXPECT().DescribeTasks([]*string{taskArn}).Return([]*ecs.Task{{
+					TaskArn:       taskArn,
+					LastStatus:    aws.String(ecs.DesiredStatusStopped),
+					StoppedReason: aws.String("CannotPullContainerError"),
+					Containers:    []*ecs.Container{tc.container},
+				}}, nil),
+			)
+
+			service := newHookTestService(mockEcs, composeutils.DeployHooks{})
+			err := service.runTaskHook(&composeutils.TaskHook{Container: "web", Command: []string{"rake", "db:migrate"}})
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Equal(t, tc.expectedErr, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/interrupt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// newUngatedECSClient returns an ECS client whose requests are not stopped when the command is
+// interrupted; can be replaced in tests
+var newUngatedECSClient = func(s *Service) ecsclient.ECSClient {
+	return ecsclient.NewECSClient(s.Context().CommandConfig.Ungated())
+}
+
+// deployOperation returns the deploy of the service, to handle the interrupts of 'service up'.
+// The update of an active service can be rolled back to its previous task definition and desired
+// count; a new service is only created after its task definition, so there is nothing to restore.
+func (s *Service) deployOperation(ecsService *ecs.Service, missingServiceErr bool) *interrupt.Operation {
+	serviceName := entity.GetServiceName(s)
+	operation := &interrupt.Operation{
+		Description: fmt.Sprintf("Deploy of the service '%s'", serviceName),
+		Status: func() (string, error) {
+			return deployStatus(newUngatedECSClient(s), serviceName)
+		},
+	}
+	if missingServiceErr || aws.StringValue(ecsService.Status) != ecsActiveResourceCode {
+		return operation
+	}
+
+	input := &ecs.UpdateServiceInput{
+		Cluster:        aws.String(s.Context().CommandConfig.Cluster),
+		Service:        aws.String(serviceName),
+		TaskDefinition: ecsService.TaskDefinition,
+	}
+	if aws.StringValue(ecsService.SchedulingStrategy) != ecs.SchedulingStrategyDaemon {
+		input.DesiredCount = ecsService.DesiredCount
+	}
+	operation.Rollback = func() error {
+		return newUngatedECSClient(s).UpdateService(input)
+	}
+	operation.RollbackDescription = fmt.Sprintf("redeploy %s with %d tasks", entity.GetIdFromArn(ecsService.TaskDefinition), aws.Int64Value(ecsService.DesiredCount))
+	if input.DesiredCount == nil {
+		operation.RollbackDescription = "redeploy " + entity.GetIdFromArn(ecsService.TaskDefinition)
+	}
+	return operation
+}
+
+// deployStatus returns the task definition and the task counts of the primary deployment of the service
+func deployStatus(ecsClient ecsclient.ECSClient, serviceName string) (string, error) {
+	output, err := ecsClient.DescribeService(serviceName)
+	if err != nil {
+		return "", err
+	}
+	if len(output.Services) == 0 {
+		return fmt.Sprintf("The service '%s' does not exist.", serviceName), nil
+	}
+	ecsService := output.Services[0]
+	for _, deployment := range ecsService.Deployments {
+		if aws.StringValue(deployment.Status) == ecsPrimaryDeployment {
+			return fmt.Sprintf("The service '%s' is deploying %s: %d/%d tasks running. Follow it with 'ecs-cli compose service ps'.",
+				serviceName, entity.GetIdFromArn(deployment.TaskDefinition), aws.Int64Value(deployment.RunningCount), aws.Int64Value(deployment.DesiredCount)), nil
+		}
+	}
+	return fmt.Sprintf("The service '%s' is %s.", serviceName, aws.StringValue(ecsService.Status)), nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployOperationRollback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	ungatedEcs := mock_ecs.NewMockECSClient(ctrl)
+	defer mockUngatedECSClient(ungatedEcs)()
+
+	ungatedEcs.EXPECT().UpdateService(gomock.Any()).Do(func(input *ecs.UpdateServiceInput) {
+		assert.Equal(t, "test-service", aws.StringValue(input.Service))
+		assert.Equal(t, arnPrefix+"test-service:3", aws.StringValue(input.TaskDefinition))
+		assert.Equal(t, int64(2), aws.Int64Value(input.DesiredCount))
+	}).Return(nil)
+
+	service := newRestartTestService(mockEcs, "test-service").(*Service)
+	operation := service.deployOperation(&ecs.Service{
+		Status:         aws.String(ecsActiveResourceCode),
+		TaskDefinition: aws.String(arnPrefix + "test-service:3"),
+		DesiredCount:   aws.Int64(2),
+	}, false)
+	assert.Equal(t, "Deploy of the service 'test-service'", operation.Description)
+	assert.Equal(t, "redeploy test-service:3 with 2 tasks", operation.RollbackDescription)
+	require.NotNil(t, operation.Rollback, "Expected the update of an active service to be rolled back")
+	assert.NoError(t, operation.Rollback())
+}
+
+func TestDeployOperationNewService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	ungatedEcs := mock_ecs.NewMockECSClient(ctrl)
+	defer mockUngatedECSClient(ungatedEcs)()
+
+	ungatedEcs.EXPECT().DescribeService("test-service").Return(getDescribeServiceTestResponse(&ecs.Service{
+		ServiceName: aws.String("test-service"),
+		Status:      aws.String(ecsActiveResourceCode),
+		Deployments: []*ecs.Deployment{
+			{
+				Status:         aws.String(ecsPrimaryDeployment),
+				TaskDefinition: aws.String(arnPrefix + "test-service:1"),
+				DesiredCount:   aws.Int64(2),
+				RunningCount:   aws.Int64(1),
+			},
+		},
+	}), nil)
+
+	service := newRestartTestService(mockEcs, "test-service").(*Service)
+	operation := service.deployOperation(nil, true)
+	assert.Nil(t, operation.Rollback, "Expected a new service not to be rolled back")
+	status, err := operation.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, "The service 'test-service' is deploying test-service:1: 1/2 tasks running. Follow it with 'ecs-cli compose service ps'.", status)
+}
+
+// mockUngatedECSClient replaces the ECS client of the interrupted operations, until the returned function is called
+func mockUngatedECSClient(client ecsclient.ECSClient) func() {
+	original := newUngatedECSClient
+	newUngatedECSClient = func(*Service) ecsclient.ECSClient { return client }
+	return func() { newUngatedECSClient = original }
+}
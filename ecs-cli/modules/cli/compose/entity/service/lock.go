@@ -0,0 +1,138 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/dynamodb"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// deployLockLease is how long the lock of a deploy is held without being renewed, so that the
+	// lock of a run that was killed expires
+	deployLockLease = 2 * time.Minute
+	// deployLockRetryDelay is the time to wait between attempts to acquire a lock held by another run
+	deployLockRetryDelay = 10 * time.Second
+)
+
+// make the deploy lock functions easily mockable in tests
+var acquireLock dynamodb.AcquireLockFunc = dynamodb.AcquireLock
+var releaseLock dynamodb.ReleaseLockFunc = dynamodb.ReleaseLock
+
+// deployLockRenewInterval is how often the lock is renewed while the deploy runs; can be replaced in tests
+var deployLockRenewInterval = deployLockLease / 4
+
+// lockDeploy acquires the deploy lock of the service if --lock is specified, waiting up to
+// --lock-timeout seconds for another run to release it, and returns the function releasing it.
+// The lock is renewed in the background until it is released. If it expires meanwhile, because
+// it could not be renewed for a whole lease or was acquired by another run, another deploy may
+// have run at the same time: the function releasing it then returns an error.
+func (s *Service) lockDeploy() (func() error, error) {
+	cliContext := s.Context().CLIContext
+	if !cliContext.Bool(flags.LockFlag) {
+		return func() error { return nil }, nil
+	}
+	commandConfig := s.Context().CommandConfig
+	table := commandConfig.DeployLockTable
+	if table == "" {
+		return nil, fmt.Errorf("--%s requires a DynamoDB table to hold the lock. Specify it with --%s, or configure it for the cluster with 'ecs-cli configure --%s'", flags.LockFlag, flags.DeployLockTableFlag, flags.DeployLockTableFlag)
+	}
+	timeout := time.Duration(cliContext.Int(flags.LockTimeoutFlag)) * time.Second
+	if timeout < 0 {
+		return nil, fmt.Errorf("--%s must not be negative", flags.LockTimeoutFlag)
+	}
+
+	serviceName := entity.GetServiceName(s)
+	lockID := fmt.Sprintf("%s/%s/%s", commandConfig.Region(), commandConfig.Cluster, serviceName)
+	owner := lockOwner()
+	logFields := log.Fields{"service": serviceName, "table": table}
+	for waited := time.Duration(0); ; waited += deployLockRetryDelay {
+		acquired, err := acquireLock(table, lockID, owner, deployLockLease, commandConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error acquiring the deploy lock of the service '%s'", serviceName)
+		}
+		if acquired {
+			break
+		}
+		if waited >= timeout {
+			return nil, fmt.Errorf("Timed out after %s waiting for another deploy of the service '%s' to release its lock", timeout, serviceName)
+		}
+		log.WithFields(logFields).Info("Waiting for another deploy of the service to release its lock")
+		sleep(deployLockRetryDelay)
+	}
+	log.WithFields(logFields).Info("Acquired deploy lock")
+
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	// lost is only read once the renewal stopped
+	var lost error
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(deployLockRenewInterval)
+		defer ticker.Stop()
+		renewedAt := time.Now()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				acquired, err := acquireLock(table, lockID, owner, deployLockLease, commandConfig)
+				switch {
+				case err != nil && time.Since(renewedAt) >= deployLockLease:
+					lost = fmt.Errorf("The deploy lock of the service '%s' expired since it could not be renewed (%v); another deploy may have run at the same time", serviceName, err)
+				case err != nil:
+					log.WithFields(logFields).Warnf("Could not renew the deploy lock: %v", err)
+					continue
+				case !acquired:
+					lost = fmt.Errorf("The deploy lock of the service '%s' expired and was acquired by another run, which may have deployed at the same time", serviceName)
+				default:
+					renewedAt = time.Now()
+					continue
+				}
+				log.WithFields(logFields).Error(lost)
+				return
+			}
+		}
+	}()
+
+	return func() error {
+		close(stop)
+		<-stopped
+		if lost != nil {
+			return lost
+		}
+		if err := releaseLock(table, lockID, owner, commandConfig); err != nil {
+			log.WithFields(logFields).Warnf("Could not release the deploy lock, it expires in %s: %v", deployLockLease, err)
+			return nil
+		}
+		log.WithFields(logFields).Info("Released deploy lock")
+		return nil
+	}, nil
+}
+
+// lockOwner identifies the run holding a deploy lock
+func lockOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s:%d:%d", hostname, os.Getpid(), time.Now().UnixNano())
+}
@@ -0,0 +1,148 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/dynamodb"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func newLockTestService(lock bool, table string, timeout int) *Service {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.LockFlag, lock, "")
+	flagSet.Int(flags.LockTimeoutFlag, timeout, "")
+	ecsContext := &context.ECSContext{
+		CommandConfig: &config.CommandConfig{
+			Cluster:         "default",
+			Session:         session.Must(session.NewSession(&aws.Config{Region: aws.String("us-west-2")})),
+			DeployLockTable: table,
+		},
+		CLIContext: cli.NewContext(nil, flagSet, nil),
+	}
+	ecsContext.ProjectName = "hello"
+	return &Service{ecsContext: ecsContext}
+}
+
+func mockDeployLock(t *testing.T, attempts []bool) (*[]string, *[]string) {
+	var acquired []string
+	var released []string
+	acquireLock = func(table, lockID, owner string, lease time.Duration, config *config.CommandConfig) (bool, error) {
+		assert.Equal(t, "locks", table, "Expected lock table to match")
+		assert.Equal(t, deployLockLease, lease, "Expected lease to match")
+		require.NotEmpty(t, attempts, "Unexpected attempt to acquire lock")
+		ok := attempts[0]
+		attempts = attempts[1:]
+		if ok {
+			acquired = append(acquired, lockID)
+		}
+		return ok, nil
+	}
+	releaseLock = func(table, lockID, owner string, config *config.CommandConfig) error {
+		released = append(released, lockID)
+		return nil
+	}
+	sleep = func(d time.Duration) {
+		assert.Equal(t, deployLockRetryDelay, d, "Expected delay between attempts to match")
+	}
+	return &acquired, &released
+}
+
+func restoreDeployLock() {
+	acquireLock = dynamodb.AcquireLock
+	releaseLock = dynamodb.ReleaseLock
+	sleep = time.Sleep
+	deployLockRenewInterval = deployLockLease / 4
+}
+
+func TestLockDeploy(t *testing.T) {
+	defer restoreDeployLock()
+	acquired, released := mockDeployLock(t, []bool{true})
+
+	unlock, err := newLockTestService(true, "locks", 600).lockDeploy()
+	require.NoError(t, err, "Unexpected error acquiring deploy lock")
+	assert.Equal(t, []string{"us-west-2/default/hello"}, *acquired, "Expected lock of the service to be acquired")
+	assert.Empty(t, *released, "Expected lock to be held until released")
+
+	assert.NoError(t, unlock(), "Unexpected error releasing deploy lock")
+	assert.Equal(t, []string{"us-west-2/default/hello"}, *released, "Expected lock to be released")
+}
+
+func TestLockDeployLost(t *testing.T) {
+	defer restoreDeployLock()
+	deployLockRenewInterval = time.Millisecond
+	// the renewal finds the lock acquired by another run
+	_, released := mockDeployLock(t, []bool{true, true, false})
+
+	unlock, err := newLockTestService(true, "locks", 600).lockDeploy()
+	require.NoError(t, err, "Unexpected error acquiring deploy lock")
+	time.Sleep(100 * time.Millisecond)
+
+	assert.EqualError(t, unlock(), "The deploy lock of the service 'hello' expired and was acquired by another run, which may have deployed at the same time")
+	assert.Empty(t, *released, "Expected the lock of the other run not to be released")
+}
+
+func TestLockDeployWaitsForOtherRun(t *testing.T) {
+	defer restoreDeployLock()
+	acquired, _ := mockDeployLock(t, []bool{false, false, true})
+
+	unlock, err := newLockTestService(true, "locks", 30).lockDeploy()
+	require.NoError(t, err, "Unexpected error acquiring deploy lock")
+	assert.Len(t, *acquired, 1, "Expected lock to be acquired once released by the other run")
+	assert.NoError(t, unlock(), "Unexpected error releasing deploy lock")
+}
+
+func TestLockDeployTimeout(t *testing.T) {
+	defer restoreDeployLock()
+	mockDeployLock(t, []bool{false, false})
+
+	_, err := newLockTestService(true, "locks", 10).lockDeploy()
+	assert.EqualError(t, err, "Timed out after 10s waiting for another deploy of the service 'hello' to release its lock")
+}
+
+func TestLockDeployErrors(t *testing.T) {
+	defer restoreDeployLock()
+	acquireLock = func(table, lockID, owner string, lease time.Duration, config *config.CommandConfig) (bool, error) {
+		return false, errors.New("ResourceNotFoundException: Requested resource not found")
+	}
+
+	_, err := newLockTestService(true, "", 600).lockDeploy()
+	assert.Error(t, err, "Expected error without a lock table")
+
+	_, err = newLockTestService(true, "locks", -1).lockDeploy()
+	assert.Error(t, err, "Expected error with a negative timeout")
+
+	_, err = newLockTestService(true, "locks", 600).lockDeploy()
+	assert.Error(t, err, "Expected error when the lock cannot be acquired")
+}
+
+func TestLockDeployNotRequested(t *testing.T) {
+	defer restoreDeployLock()
+	mockDeployLock(t, nil)
+
+	unlock, err := newLockTestService(false, "locks", 600).lockDeploy()
+	require.NoError(t, err, "Unexpected error without --lock")
+	assert.NoError(t, unlock(), "Unexpected error without --lock")
+}
@@ -0,0 +1,340 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// availabilityZoneAttribute is the attribute of container instances holding their availability zone
+	availabilityZoneAttribute = "ecs.availability-zone"
+	// rebalancePollInterval is the time to wait between two checks that a stopped task has stopped
+	rebalancePollInterval = 5 * time.Second
+	// rebalanceStopTimeout bounds the wait for a task to stop, e.g. while it is deregistered from its
+	// load balancer
+	rebalanceStopTimeout = 10 * time.Minute
+)
+
+// rebalanceOutput is where the spread of the tasks is printed; can be replaced in tests
+var rebalanceOutput io.Writer = os.Stdout
+
+// taskSpread is the spread of the running tasks of a service across the availability zones and the
+// container instances it can place them in
+type taskSpread struct {
+	tasks []*ecs.Task
+	// zones and instances are sorted, and include the ones without any task
+	zones         []string
+	instances     []string
+	perZone       map[string][]*ecs.Task
+	perInstance   map[string][]*ecs.Task
+	instanceZones map[string]string
+	instanceIDs   map[string]string
+}
+
+// Rebalance prints how the running tasks of the service are spread across availability zones and
+// container instances. With fix, it stops the tasks over-concentrated in an availability zone or on
+// a container instance one at a time, waiting for the scheduler to replace each of them, so that
+// the replacements are placed elsewhere.
+func (s *Service) Rebalance(fix bool) error {
+	spread, err := s.taskSpread()
+	if err != nil {
+		return err
+	}
+	printTaskSpread(rebalanceOutput, spread)
+
+	excess := spread.excess()
+	if excess == 0 {
+		log.Info("The tasks of the service are evenly spread")
+		return nil
+	}
+	if !fix {
+		log.Infof("%d tasks of the service are over-concentrated; run with --fix to replace them one at a time", excess)
+		return nil
+	}
+
+	// every replacement placed elsewhere reduces the excess, so this bounds the number of stops
+	serviceName := entity.GetServiceName(s)
+	for stopped := 0; stopped < excess; stopped++ {
+		task := spread.overConcentratedTask()
+		if task == nil {
+			break
+		}
+		taskArn := aws.StringValue(task.TaskArn)
+		log.WithFields(log.Fields{
+			"task":             entity.GetIdFromArn(task.TaskArn),
+			"availabilityZone": aws.StringValue(task.AvailabilityZone),
+		}).Info("Stopping over-concentrated task")
+		if err := s.Context().ECSClient.StopTask(taskArn); err != nil {
+			return err
+		}
+		if err := s.waitForTaskStopped(taskArn); err != nil {
+			return err
+		}
+		if err := waitForServiceTasks(s, serviceName); err != nil {
+			return err
+		}
+
+		previousExcess := spread.excess()
+		if spread, err = s.taskSpread(); err != nil {
+			return err
+		}
+		if spread.excess() >= previousExcess {
+			log.Warn("The replacement task was placed in the same availability zone or on the same container instance; check the placement strategy and constraints of the service")
+			break
+		}
+	}
+	printTaskSpread(rebalanceOutput, spread)
+	return nil
+}
+
+// taskSpread describes the running tasks of the service, and the availability zones and container
+// instances they can run in: the active container instances of the cluster and their zones, or the
+// zones of the subnets of a service using task networking on Fargate, and the zones the tasks
+// already run in. The instances of a service using task networking are limited to its subnets.
+func (s *Service) taskSpread() (*taskSpread, error) {
+	ecsService, err := s.describeService()
+	if err != nil {
+		return nil, err
+	}
+	if aws.StringValue(ecsService.SchedulingStrategy) == ecs.SchedulingStrategyDaemon {
+		return nil, fmt.Errorf("The service '%s' runs one task on each container instance and cannot be rebalanced", aws.StringValue(ecsService.ServiceName))
+	}
+	serviceName := aws.StringValue(ecsService.ServiceName)
+	ecsClient := s.Context().ECSClient
+
+	spread := &taskSpread{
+		perZone:       make(map[string][]*ecs.Task),
+		perInstance:   make(map[string][]*ecs.Task),
+		instanceZones: make(map[string]string),
+		instanceIDs:   make(map[string]string),
+	}
+	err = ecsClient.GetTasksPages(&ecs.ListTasksInput{
+		ServiceName:   aws.String(serviceName),
+		DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+	}, func(tasks []*ecs.Task) error {
+		for _, task := range tasks {
+			if aws.StringValue(task.LastStatus) == ecs.DesiredStatusRunning {
+				spread.tasks = append(spread.tasks, task)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(spread.tasks, func(i, j int) bool {
+		return aws.StringValue(spread.tasks[i].TaskArn) < aws.StringValue(spread.tasks[j].TaskArn)
+	})
+
+	subnetZones := make(map[string]bool)
+	if ecsService.NetworkConfiguration != nil && ecsService.NetworkConfiguration.AwsvpcConfiguration != nil {
+		subnets := aws.StringValueSlice(ecsService.NetworkConfiguration.AwsvpcConfiguration.Subnets)
+		zones, err := s.Context().EC2Client.GetSubnetAvailabilityZones(subnets)
+		if err != nil {
+			return nil, errors.Wrap(err, "Error describing the subnets of the service")
+		}
+		for _, zone := range zones {
+			subnetZones[zone] = true
+		}
+	}
+
+	zones := make(map[string]bool)
+	usesInstances := aws.StringValue(ecsService.LaunchType) == ecs.LaunchTypeEc2
+	for _, task := range spread.tasks {
+		if aws.StringValue(task.ContainerInstanceArn) != "" {
+			usesInstances = true
+		}
+	}
+	if usesInstances {
+		containerInstanceArns, err := ecsClient.ListContainerInstances()
+		if err != nil {
+			return nil, err
+		}
+		containerInstances, err := ecsClient.DescribeContainerInstances(containerInstanceArns)
+		if err != nil {
+			return nil, err
+		}
+		for _, containerInstance := range containerInstances {
+			if aws.StringValue(containerInstance.Status) != ecs.ContainerInstanceStatusActive {
+				continue
+			}
+			zone := instanceAvailabilityZone(containerInstance)
+			if len(subnetZones) > 0 && !subnetZones[zone] {
+				continue
+			}
+			arn := aws.StringValue(containerInstance.ContainerInstanceArn)
+			zones[zone] = true
+			spread.instances = append(spread.instances, arn)
+			spread.instanceZones[arn] = zone
+			spread.instanceIDs[arn] = aws.StringValue(containerInstance.Ec2InstanceId)
+		}
+	} else {
+		zones = subnetZones
+	}
+
+	for _, task := range spread.tasks {
+		zone := aws.StringValue(task.AvailabilityZone)
+		zones[zone] = true
+		spread.perZone[zone] = append(spread.perZone[zone], task)
+		if arn := aws.StringValue(task.ContainerInstanceArn); arn != "" {
+			if _, ok := spread.instanceZones[arn]; !ok {
+				// e.g. a draining instance still running the task
+				spread.instances = append(spread.instances, arn)
+				spread.instanceZones[arn] = zone
+			}
+			spread.perInstance[arn] = append(spread.perInstance[arn], task)
+		}
+	}
+	for zone := range zones {
+		spread.zones = append(spread.zones, zone)
+	}
+	sort.Strings(spread.zones)
+	sort.Strings(spread.instances)
+	return spread, nil
+}
+
+// waitForTaskStopped waits for the stopped task to reach the STOPPED status, so that the service
+// is known to be replacing it
+func (s *Service) waitForTaskStopped(taskArn string) error {
+	for waited := time.Duration(0); waited < rebalanceStopTimeout; waited += rebalancePollInterval {
+		tasks, err := s.Context().ECSClient.DescribeTasks([]*string{aws.String(taskArn)})
+		if err != nil {
+			return err
+		}
+		if len(tasks) == 0 || aws.StringValue(tasks[0].LastStatus) == ecs.DesiredStatusStopped {
+			return nil
+		}
+		sleep(rebalancePollInterval)
+	}
+	return fmt.Errorf("Timed out after %s waiting for task %s to stop", rebalanceStopTimeout, entity.GetIdFromArn(aws.String(taskArn)))
+}
+
+// instanceAvailabilityZone returns the availability zone of the container instance
+func instanceAvailabilityZone(containerInstance *ecs.ContainerInstance) string {
+	for _, attribute := range containerInstance.Attributes {
+		if aws.StringValue(attribute.Name) == availabilityZoneAttribute {
+			return aws.StringValue(attribute.Value)
+		}
+	}
+	return ""
+}
+
+// zoneLimit is the most tasks an availability zone runs when the tasks are evenly spread
+func (spread *taskSpread) zoneLimit() int {
+	return ceilDiv(len(spread.tasks), len(spread.zones))
+}
+
+// instanceLimit is the most tasks a container instance runs when the tasks are evenly spread
+func (spread *taskSpread) instanceLimit() int {
+	return ceilDiv(len(spread.tasks), len(spread.instances))
+}
+
+// excess returns the number of tasks over the limits of their availability zone or container instance
+func (spread *taskSpread) excess() int {
+	excess := 0
+	for _, zone := range spread.zones {
+		if count := len(spread.perZone[zone]); count > spread.zoneLimit() {
+			excess += count - spread.zoneLimit()
+		}
+	}
+	for _, instance := range spread.instances {
+		if count := len(spread.perInstance[instance]); count > spread.instanceLimit() {
+			excess += count - spread.instanceLimit()
+		}
+	}
+	return excess
+}
+
+// overConcentratedTask returns the task to stop next: a task of the most loaded availability zone
+// over its limit, on its most loaded container instance, or else a task of the most loaded container
+// instance over its limit. It returns nil if the tasks are evenly spread.
+func (spread *taskSpread) overConcentratedTask() *ecs.Task {
+	if zone := mostLoaded(spread.zones, spread.perZone, spread.zoneLimit()); zone != "" {
+		tasks := spread.perZone[zone]
+		var zoneInstances []string
+		for _, instance := range spread.instances {
+			if spread.instanceZones[instance] == zone {
+				zoneInstances = append(zoneInstances, instance)
+			}
+		}
+		if instance := mostLoaded(zoneInstances, spread.perInstance, 0); instance != "" {
+			tasks = spread.perInstance[instance]
+		}
+		return tasks[0]
+	}
+	if instance := mostLoaded(spread.instances, spread.perInstance, spread.instanceLimit()); instance != "" {
+		return spread.perInstance[instance][0]
+	}
+	return nil
+}
+
+// mostLoaded returns the key with the most tasks, if it has more tasks than the limit, or an empty
+// string. Ties go to the first key.
+func mostLoaded(keys []string, tasks map[string][]*ecs.Task, limit int) string {
+	mostLoaded := ""
+	for _, key := range keys {
+		if count := len(tasks[key]); count > limit && (mostLoaded == "" || count > len(tasks[mostLoaded])) {
+			mostLoaded = key
+		}
+	}
+	return mostLoaded
+}
+
+func ceilDiv(a, b int) int {
+	if b == 0 {
+		return a
+	}
+	return (a + b - 1) / b
+}
+
+func printTaskSpread(out io.Writer, spread *taskSpread) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "AVAILABILITY ZONE\tTASKS\t")
+	for _, zone := range spread.zones {
+		fmt.Fprintf(w, "%s\t%d\t%s\n", zone, len(spread.perZone[zone]), overLimit(len(spread.perZone[zone]), spread.zoneLimit()))
+	}
+	if len(spread.instances) > 0 {
+		fmt.Fprintln(w, "\t\t")
+		fmt.Fprintln(w, "CONTAINER INSTANCE\tAVAILABILITY ZONE\tTASKS\t")
+		for _, instance := range spread.instances {
+			name := spread.instanceIDs[instance]
+			if name == "" {
+				name = entity.GetIdFromArn(aws.String(instance))
+			}
+			count := len(spread.perInstance[instance])
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", name, spread.instanceZones[instance], count, overLimit(count, spread.instanceLimit()))
+		}
+	}
+	w.Flush()
+}
+
+// overLimit describes how many tasks are over the limit, if any
+func overLimit(count, limit int) string {
+	if count <= limit {
+		return ""
+	}
+	return fmt.Sprintf("%d over", count-limit)
+}
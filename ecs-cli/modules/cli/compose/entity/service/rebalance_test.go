@@ -0,0 +1,253 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const containerInstanceArnPrefix = "arn:aws:ecs:us-west-2:123456789012:container-instance/default/"
+
+func rebalanceTask(id, zone, instance string) *ecs.Task {
+	return &ecs.Task{
+		TaskArn:              aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/" + id),
+		LastStatus:           aws.String(ecs.DesiredStatusRunning),
+		AvailabilityZone:     aws.String(zone),
+		ContainerInstanceArn: aws.String(containerInstanceArnPrefix + instance),
+	}
+}
+
+func rebalanceInstance(id, zone string) *ecs.ContainerInstance {
+	return &ecs.ContainerInstance{
+		ContainerInstanceArn: aws.String(containerInstanceArnPrefix + id),
+		Ec2InstanceId:        aws.String("i-" + id),
+		Status:               aws.String(ecs.ContainerInstanceStatusActive),
+		Attributes: []*ecs.Attribute{
+			{Name: aws.String(availabilityZoneAttribute), Value: aws.String(zone)},
+		},
+	}
+}
+
+// expectTaskSpread expects the calls describing the spread of the given tasks across two instances
+// in two availability zones
+func expectTaskSpread(mockEcs *mock_ecs.MockECSClient, tasks ...*ecs.Task) []*gomock.Call {
+	instances := []*ecs.ContainerInstance{rebalanceInstance("a1", "us-west-2a"), rebalanceInstance("b1", "us-west-2b")}
+	return []*gomock.Call{
+		mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(&ecs.Service{
+			ServiceName: aws.String("hello"),
+			LaunchType:  aws.String(ecs.LaunchTypeEc2),
+		}), nil),
+		mockEcs.EXPECT().GetTasksPages(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+			y.(ecsclient.ProcessTasksAction)(tasks)
+		}).Return(nil),
+		mockEcs.EXPECT().ListContainerInstances().Return([]*string{instances[0].ContainerInstanceArn, instances[1].ContainerInstanceArn}, nil),
+		mockEcs.EXPECT().DescribeContainerInstances(gomock.Any()).Return(instances, nil),
+	}
+}
+
+func TestRebalanceReportsSpread(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	var out bytes.Buffer
+	rebalanceOutput = &out
+	defer func() { rebalanceOutput = os.Stdout }()
+
+	gomock.InOrder(expectTaskSpread(mockEcs,
+		rebalanceTask("1", "us-west-2a", "a1"),
+		rebalanceTask("2", "us-west-2a", "a1"),
+		rebalanceTask("3", "us-west-2a", "a1"),
+		rebalanceTask("4", "us-west-2b", "b1"),
+	)...)
+
+	err := newHookTestService(mockEcs, composeutils.DeployHooks{}).Rebalance(false)
+	assert.NoError(t, err, "Unexpected error reporting the spread")
+	assert.Contains(t, out.String(), "us-west-2a", "Expected the availability zones")
+	assert.Contains(t, out.String(), "i-a1", "Expected the EC2 instance IDs")
+	assert.Contains(t, out.String(), "1 over", "Expected the over-concentrated zone and instance")
+}
+
+func TestRebalanceFix(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	var out bytes.Buffer
+	rebalanceOutput = &out
+	defer func() { rebalanceOutput = os.Stdout }()
+
+	stoppedTaskArn := aws.StringValue(rebalanceTask("1", "us-west-2a", "a1").TaskArn)
+	calls := expectTaskSpread(mockEcs,
+		rebalanceTask("1", "us-west-2a", "a1"),
+		rebalanceTask("2", "us-west-2a", "a1"),
+		rebalanceTask("3", "us-west-2a", "a1"),
+		rebalanceTask("4", "us-west-2b", "b1"),
+	)
+	calls = append(calls,
+		mockEcs.EXPECT().StopTask(stoppedTaskArn).Return(nil),
+		mockEcs.EXPECT().DescribeTasks(gomock.Any()).Return([]*ecs.Task{
+			{TaskArn: aws.String(stoppedTaskArn), LastStatus: aws.String(ecs.DesiredStatusStopped)},
+		}, nil),
+	)
+	calls = append(calls, expectTaskSpread(mockEcs,
+		rebalanceTask("2", "us-west-2a", "a1"),
+		rebalanceTask("3", "us-west-2a", "a1"),
+		rebalanceTask("4", "us-west-2b", "b1"),
+		rebalanceTask("5", "us-west-2b", "b1"),
+	)...)
+	gomock.InOrder(calls...)
+
+	err := newHookTestService(mockEcs, composeutils.DeployHooks{}).Rebalance(true)
+	assert.NoError(t, err, "Unexpected error rebalancing the service")
+}
+
+func TestRebalanceFixStopsWhenReplacementIsPlacedInSameZone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	rebalanceOutput = &bytes.Buffer{}
+	defer func() { rebalanceOutput = os.Stdout }()
+
+	tasks := []*ecs.Task{
+		rebalanceTask("1", "us-west-2a", "a1"),
+		rebalanceTask("2", "us-west-2a", "a1"),
+		rebalanceTask("3", "us-west-2a", "a1"),
+		rebalanceTask("4", "us-west-2a", "a1"),
+	}
+	calls := expectTaskSpread(mockEcs, tasks...)
+	calls = append(calls,
+		mockEcs.EXPECT().StopTask(aws.StringValue(tasks[0].TaskArn)).Return(nil),
+		mockEcs.EXPECT().DescribeTasks(gomock.Any()).Return(nil, nil),
+	)
+	calls = append(calls, expectTaskSpread(mockEcs,
+		rebalanceTask("2", "us-west-2a", "a1"),
+		rebalanceTask("3", "us-west-2a", "a1"),
+		rebalanceTask("4", "us-west-2a", "a1"),
+		rebalanceTask("5", "us-west-2a", "a1"),
+	)...)
+	gomock.InOrder(calls...)
+
+	err := newHookTestService(mockEcs, composeutils.DeployHooks{}).Rebalance(true)
+	assert.NoError(t, err, "Expected rebalancing to give up without an error")
+}
+
+func TestRebalanceDaemonService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(&ecs.Service{
+		ServiceName:        aws.String("hello"),
+		SchedulingStrategy: aws.String(ecs.SchedulingStrategyDaemon),
+	}), nil)
+
+	err := newHookTestService(mockEcs, composeutils.DeployHooks{}).Rebalance(false)
+	assert.Error(t, err, "Expected an error rebalancing a daemon service")
+}
+
+func TestTaskSpreadExcess(t *testing.T) {
+	testCases := map[string]struct {
+		zones          []string
+		instances      []string
+		tasks          []*ecs.Task
+		expectedExcess int
+		expectedTask   string
+	}{
+		"evenly spread": {
+			zones:     []string{"us-west-2a", "us-west-2b"},
+			instances: []string{"a1", "b1"},
+			tasks: []*ecs.Task{
+				rebalanceTask("1", "us-west-2a", "a1"),
+				rebalanceTask("2", "us-west-2b", "b1"),
+				rebalanceTask("3", "us-west-2b", "b1"),
+			},
+		},
+		"over-concentrated zone": {
+			zones:     []string{"us-west-2a", "us-west-2b", "us-west-2c"},
+			instances: []string{"a1", "a2", "b1", "c1"},
+			tasks: []*ecs.Task{
+				rebalanceTask("1", "us-west-2a", "a1"),
+				rebalanceTask("2", "us-west-2a", "a2"),
+				rebalanceTask("3", "us-west-2a", "a2"),
+				rebalanceTask("4", "us-west-2b", "b1"),
+			},
+			expectedExcess: 2,
+			expectedTask:   "2",
+		},
+		"over-concentrated instance": {
+			zones:     []string{"us-west-2a"},
+			instances: []string{"a1", "a2"},
+			tasks: []*ecs.Task{
+				rebalanceTask("1", "us-west-2a", "a1"),
+				rebalanceTask("2", "us-west-2a", "a1"),
+			},
+			expectedExcess: 1,
+			expectedTask:   "1",
+		},
+		"Fargate": {
+			zones: []string{"us-west-2a", "us-west-2b"},
+			tasks: []*ecs.Task{
+				{TaskArn: aws.String("1"), AvailabilityZone: aws.String("us-west-2a")},
+				{TaskArn: aws.String("2"), AvailabilityZone: aws.String("us-west-2a")},
+			},
+			expectedExcess: 1,
+			expectedTask:   "1",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			spread := &taskSpread{
+				tasks:         tc.tasks,
+				zones:         tc.zones,
+				perZone:       make(map[string][]*ecs.Task),
+				perInstance:   make(map[string][]*ecs.Task),
+				instanceZones: make(map[string]string),
+			}
+			for _, instance := range tc.instances {
+				arn := containerInstanceArnPrefix + instance
+				spread.instances = append(spread.instances, arn)
+				spread.instanceZones[arn] = "us-west-2" + instance[:1]
+			}
+			for _, task := range tc.tasks {
+				zone := aws.StringValue(task.AvailabilityZone)
+				spread.perZone[zone] = append(spread.perZone[zone], task)
+				if task.ContainerInstanceArn != nil {
+					arn := aws.StringValue(task.ContainerInstanceArn)
+					spread.perInstance[arn] = append(spread.perInstance[arn], task)
+				}
+			}
+
+			assert.Equal(t, tc.expectedExcess, spread.excess(), "Unexpected number of over-concentrated tasks")
+			task := spread.overConcentratedTask()
+			if tc.expectedTask == "" {
+				assert.Nil(t, task, "Expected no task to stop")
+				return
+			}
+			if assert.NotNil(t, task, "Expected a task to stop") {
+				assert.True(t, strings.HasSuffix(aws.StringValue(task.TaskArn), tc.expectedTask), "Unexpected task to stop")
+			}
+		})
+	}
+}
@@ -15,14 +15,19 @@ package service
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	composecontainer "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/container"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/types"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/servicediscovery"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/applicationautoscaling"
 	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/elbv2"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/route53"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/tagging"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
@@ -30,6 +35,8 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/cache"
 	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/interrupt"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/notify"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	taggingSDK "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
@@ -43,18 +50,29 @@ import (
 type Service struct {
 	taskDef           *ecs.TaskDefinition
 	cache             cache.Cache
+	stateCache        cache.Cache
 	ecsContext        *context.ECSContext
 	deploymentConfig  *ecs.DeploymentConfiguration
 	loadBalancers     []*ecs.LoadBalancer
 	role              string
 	healthCheckGP     *int64
+	deregDelay        *int64
 	serviceRegistries []*ecs.ServiceRegistry
 	tags              []*ecs.Tag
+	desiredCount      *desiredCount // set by Up and Start, nil when no desired count is configured
+	deployStartedAt   time.Time     // set by Up, to notify the duration of the deploy
+}
+
+// desiredCount is the desired count configured for the compose services of the task definition
+type desiredCount struct {
+	count    int64
+	fromFlag bool // set with --scale rather than in the ecs-params file
 }
 
 const (
 	ecsActiveResourceCode  = "ACTIVE"
 	ecsMissingResourceCode = "MISSING"
+	ecsPrimaryDeployment   = "PRIMARY"
 )
 
 // make servicediscovery.Create easily mockable in tests
@@ -69,10 +87,26 @@ var servicediscoveryDelete servicediscovery.DeleteFunc = servicediscovery.Delete
 // make servicediscovery.Delete easily mockable in tests
 var waitUntilSDSDeletable route53.WaitUntilSDSDeletableFunc = route53.WaitUntilSDSDeletable
 
-// NewService creates an instance of a Service and also sets up a cache for task definition
+// make the load balancer and DNS record functions easily mockable in tests
+var getLoadBalancerForTargetGroup elbv2.GetLoadBalancerForTargetGroupFunc = elbv2.GetLoadBalancerForTargetGroup
+var getTargetHealth elbv2.GetTargetHealthFunc = elbv2.GetTargetHealth
+var getServiceURL elbv2.GetServiceURLFunc = elbv2.GetServiceURL
+var setDeregistrationDelay elbv2.SetDeregistrationDelayFunc = elbv2.SetDeregistrationDelay
+var upsertAliasRecord route53.UpsertAliasRecordFunc = route53.UpsertAliasRecord
+var deleteAliasRecord route53.DeleteAliasRecordFunc = route53.DeleteAliasRecord
+
+// make the scalable target lookup easily mockable in tests
+var getServiceScalableTarget applicationautoscaling.GetServiceScalableTargetFunc = applicationautoscaling.GetServiceScalableTarget
+
+// make the drain delay between scale-down batches easily mockable in tests
+var sleep = time.Sleep
+
+// NewService creates an instance of a Service and also sets up caches for task definitions
+// and for the resources created for the project
 func NewService(ecsContext *context.ECSContext) entity.ProjectEntity {
 	return &Service{
 		cache:      entity.SetupTaskDefinitionCache(),
+		stateCache: newProjectStateCache(),
 		ecsContext: ecsContext,
 	}
 }
@@ -110,8 +144,21 @@ func (s *Service) LoadContext() error {
 	if err != nil {
 		return err
 	}
+	if healthCheckGP == nil && s.Context().ECSParams != nil {
+		healthCheckGP = s.Context().ECSParams.RunParams.HealthCheckGracePeriod
+	}
 	s.healthCheckGP = healthCheckGP
 
+	// Target Group Deregistration Delay
+	deregDelay, err := getInt64FromCLIContext(s.Context(), flags.DeregistrationDelayFlag)
+	if err != nil {
+		return err
+	}
+	if deregDelay == nil && s.Context().ECSParams != nil {
+		deregDelay = s.Context().ECSParams.RunParams.DeregistrationDelay
+	}
+	s.deregDelay = deregDelay
+
 	// Validates LoadBalancerName and TargetGroupArn cannot exist at the same time.
 	// Other validation is taken care of by the API call. This currently
 	// includes errors on absence of container name and port if target
@@ -186,6 +233,12 @@ func (s *Service) TaskDefinition() *ecs.TaskDefinition {
 	return s.taskDef
 }
 
+// LoadBalancers returns the load balancers the service is created with, read from the cli context
+// by LoadContext
+func (s *Service) LoadBalancers() []*ecs.LoadBalancer {
+	return s.loadBalancers
+}
+
 // TaskDefinitionCache returns the cache that should be used when checking for
 // previous task definition
 func (s *Service) TaskDefinitionCache() cache.Cache {
@@ -211,12 +264,16 @@ func (s *Service) Create() error {
 	if err != nil {
 		return err
 	}
+	s.recordResources()
+	if err = s.updateDeregistrationDelay(s.loadBalancers); err != nil {
+		return err
+	}
 	return s.createService(0)
 }
 
 // Start starts the containers if they weren't already running. Internally, start calls
 // ECS.DescribeService to find out if the service is Active and if the count is 0,
-// it updates the service with desired count as 1 else its a no-op
+// it updates the service with the desired count configured in the ecs-params file, or 1, else its a no-op
 // TODO: Instead of always setting count=1, if the containers were Stopped before,
 //       Start should fetch the previously set desired-count from the cache and start x count of containers
 func (s *Service) Start() error {
@@ -232,7 +289,26 @@ func (s *Service) Start() error {
 // Otherwise, if the compose or ecs-params files have changed, it will update
 // the existing service with the new task definition by calling UpdateService
 // with the new task definition and service parameters.
-func (s *Service) Up() error {
+func (s *Service) Up() (err error) {
+	if err := s.validateDNSRecordFlags(); err != nil {
+		return err
+	}
+
+	// the service is described once the lock is held, so that the deploy starts from the state
+	// left by the previous one
+	if !entity.IsDryRun(s) {
+		unlock, lockErr := s.lockDeploy()
+		if lockErr != nil {
+			return lockErr
+		}
+		// a deploy which lost its lock fails, since another one may have run at the same time
+		defer func() {
+			if unlockErr := unlock(); unlockErr != nil && err == nil {
+				err = unlockErr
+			}
+		}()
+	}
+
 	// describe service to get the task definition and count running
 	ecsService, err := s.describeService()
 	var missingServiceErr bool
@@ -244,6 +320,45 @@ func (s *Service) Up() error {
 		}
 	}
 
+	if err = s.validateDeployHooks(); err != nil {
+		return err
+	}
+
+	if s.desiredCount, err = s.loadDesiredCount(); err != nil {
+		return err
+	}
+
+	if entity.IsDryRun(s) {
+		return s.dryRunUp(ecsService, missingServiceErr)
+	}
+
+	operation := s.deployOperation(ecsService, missingServiceErr)
+	defer interrupt.Handle(operation)()
+	s.deployStartedAt = time.Now()
+	s.notifyDeploy(notify.StatusStarted, nil)
+	resumeScaleIn, err := s.suspendScaleIn(ecsService, missingServiceErr, operation)
+	if err == nil {
+		err = s.deploy(ecsService, missingServiceErr)
+		if err == nil {
+			err = s.runDeployHooks(hookStagePostDeploy, s.deployHooks().PostDeploy)
+		}
+		resumeScaleIn()
+	}
+	if err != nil {
+		s.notifyDeploy(notify.StatusFailed, err)
+		if s.Context().CLIContext.Bool(flags.DebugOnFailureFlag) && s.taskDef != nil && s.taskDef.TaskDefinitionArn != nil {
+			if debugErr := s.debugFailedDeploy(); debugErr != nil {
+				log.Warnf("Could not debug the failed deploy: %v", debugErr)
+			}
+		}
+		return err
+	}
+	s.notifyDeploy(notify.StatusSucceeded, nil)
+	return nil
+}
+
+// deploy creates the ECS service, or updates it if it is active, with the latest task definition
+func (s *Service) deploy(ecsService *ecs.Service, missingServiceErr bool) error {
 	// get the current snapshot of compose yml
 	// and update this instance with the latest task definition
 	newTaskDefinition, err := entity.GetOrCreateTaskDefinition(s)
@@ -255,26 +370,49 @@ func (s *Service) Up() error {
 	if err != nil {
 		return err
 	}
+	s.recordResources()
+
+	// pre-deploy hooks run against the new task definition, e.g. to migrate the database it expects
+	if err = s.runDeployHooks(hookStagePreDeploy, s.deployHooks().PreDeploy); err != nil {
+		return err
+	}
 
 	// if ECS service was not created before, or is inactive, create and start the ECS Service
 	if missingServiceErr || aws.StringValue(ecsService.Status) != ecsActiveResourceCode {
+		if err = s.updateDeregistrationDelay(s.loadBalancers); err != nil {
+			return err
+		}
 		// uses the latest task definition to create the service
-		return s.createService(1)
+		if err = s.createService(int(s.countForCreate())); err != nil {
+			return err
+		}
+		return s.upsertDNSRecord(nil)
 	}
 
 	// Update Existing Service
+	if err = s.updateDeregistrationDelay(ecsService.LoadBalancers); err != nil {
+		return err
+	}
 	if err = s.updateService(ecsService, newTaskDefinition); err != nil {
 		return err
 	}
 
+	if err = s.upsertDNSRecord(ecsService); err != nil {
+		return err
+	}
+
 	// Update Service Discovery
 	if s.Context().CLIContext.Bool(flags.UpdateServiceDiscoveryFlag) {
 		return servicediscoveryUpdate(aws.StringValue(newTaskDefinition.NetworkMode), entity.GetServiceName(s), s.Context())
 	}
 
 	// add new tags, if provided
-	if tagVal := s.Context().CLIContext.String(flags.ResourceTagsFlag); tagVal != "" {
-		err = s.updateTags(ecsService, tagVal)
+	tags, err := s.serviceTags()
+	if err != nil {
+		return err
+	}
+	if len(tags) > 0 {
+		err = s.updateTags(ecsService, tags)
 		if err != nil {
 			return err
 		}
@@ -284,17 +422,51 @@ func (s *Service) Up() error {
 	return nil
 }
 
+// notifyDeploy sends a notification of the status of the deploy of the service, if notifications are configured
+func (s *Service) notifyDeploy(status string, err error) {
+	commandConfig := s.Context().CommandConfig
+	if commandConfig == nil || commandConfig.Notifier == nil {
+		return
+	}
+	event := &notify.Event{
+		Type:    notify.EventDeploy,
+		Status:  status,
+		Cluster: commandConfig.Cluster,
+		Region:  commandConfig.Region(),
+		Service: entity.GetServiceName(s),
+	}
+	if s.taskDef != nil {
+		event.TaskDefinition = entity.GetIdFromArn(s.taskDef.TaskDefinitionArn)
+	}
+	if status != notify.StatusStarted && !s.deployStartedAt.IsZero() {
+		event.Duration = time.Since(s.deployStartedAt).Seconds()
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	commandConfig.Notifier.Notify(event)
+}
+
+// serviceTags returns the tags to add to an existing service: the tags specified with --resource-tags,
+// and the git tags of the deployment so that the service reflects what it is running.
+func (s *Service) serviceTags() (map[string]*string, error) {
+	tags, err := s.GetTags()
+	if err != nil {
+		return nil, err
+	}
+	tagsMap := make(map[string]*string)
+	for _, tag := range tags {
+		tagsMap[aws.StringValue(tag.Key)] = tag.Value
+	}
+	return tagsMap, nil
+}
+
 // TODO: Refactor this if we use the stand alone tagging client in more places in the future
 var newTaggingClient = tagging.NewTaggingClient
 
-func (s *Service) updateTags(ecsService *ecs.Service, tagVal string) error {
+func (s *Service) updateTags(ecsService *ecs.Service, tags map[string]*string) error {
 	taggingClient := newTaggingClient(s.Context().CommandConfig)
 
-	tags, err := utils.GetTagsMap(tagVal)
-	if err != nil {
-		return err
-	}
-
 	input := &taggingSDK.TagResourcesInput{
 		ResourceARNList: []*string{
 			ecsService.ServiceArn,
@@ -317,6 +489,9 @@ func (s *Service) buildUpdateServiceInput(count *int64, serviceName, taskDefinit
 	cluster := s.Context().CommandConfig.Cluster
 	deploymentConfig := s.DeploymentConfig()
 	forceDeployment := s.Context().CLIContext.Bool(flags.ForceDeploymentFlag)
+	if err := entity.ResolveNetworkConfiguration(s); err != nil {
+		return nil, err
+	}
 	networkConfig, err := composeutils.ConvertToECSNetworkConfiguration(s.ecsContext.ECSParams)
 	if err != nil {
 		return nil, err
@@ -362,21 +537,12 @@ func (s *Service) updateService(ecsService *ecs.Service, newTaskDefinition *ecs.
 		}).Warn("You cannot update the load balancer configuration on an existing service.")
 	}
 
-	oldCount := aws.Int64Value(ecsService.DesiredCount)
-	newCount := int64(1)
-	count := &newCount
-	if oldCount != 0 {
-		count = &oldCount // get the current non-zero count
-	}
+	count := s.countForUpdate(ecsService)
 
 	// if both the task definitions are the same, call update with the new count
 	oldTaskDefinitionId := entity.GetIdFromArn(ecsService.TaskDefinition)
 	newTaskDefinitionId := entity.GetIdFromArn(newTaskDefinition.TaskDefinitionArn)
 
-	if aws.StringValue(ecsService.SchedulingStrategy) == ecs.SchedulingStrategyDaemon {
-		count = nil
-	}
-
 	if oldTaskDefinitionId == newTaskDefinitionId {
 		return s.updateServiceCount(count)
 	}
@@ -389,7 +555,7 @@ func (s *Service) updateService(ecsService *ecs.Service, newTaskDefinition *ecs.
 		return err
 	}
 
-	err = s.Context().ECSClient.UpdateService(updateServiceInput)
+	err = s.sendUpdateService(updateServiceInput)
 	if err != nil {
 		return err
 	}
@@ -401,17 +567,363 @@ func (s *Service) updateService(ecsService *ecs.Service, newTaskDefinition *ecs.
 	return waitForServiceTasks(s, ecsServiceName)
 }
 
+// countForUpdate returns the desired count to use when updating the existing service.
+// With --preserve-desired-count, the desired count is left unchanged (nil) so that up doesn't
+// undo manual scaling or fight Application Auto Scaling, unless the service was stopped.
+// A desired count given with --scale is always applied.
+func (s *Service) countForUpdate(ecsService *ecs.Service) *int64 {
+	if aws.StringValue(ecsService.SchedulingStrategy) == ecs.SchedulingStrategyDaemon {
+		return nil
+	}
+	if s.desiredCount != nil && s.desiredCount.fromFlag {
+		return aws.Int64(s.desiredCount.count)
+	}
+
+	oldCount := aws.Int64Value(ecsService.DesiredCount)
+	preserveCount := s.Context().CLIContext.BoolT(flags.PreserveDesiredCountFlag)
+	if oldCount != 0 {
+		if preserveCount {
+			return nil
+		}
+		if s.desiredCount != nil {
+			return aws.Int64(s.desiredCount.count)
+		}
+		return &oldCount // get the current non-zero count
+	}
+	if preserveCount && s.isAutoScaled(aws.StringValue(ecsService.ServiceName)) {
+		return nil
+	}
+	return aws.Int64(s.countForCreate())
+}
+
+// countForCreate returns the desired count to use when creating or starting the service
+func (s *Service) countForCreate() int64 {
+	if s.desiredCount != nil {
+		return s.desiredCount.count
+	}
+	return 1
+}
+
+// loadDesiredCount returns the desired count configured for the compose services of the task
+// definition with the --scale flag, or else with desired_count in the ecs-params file. Since the
+// compose services run in the tasks of a single ECS service, they must agree on the count.
+func (s *Service) loadDesiredCount() (*desiredCount, error) {
+	allServices, perService, err := parseScale(s.Context().CLIContext.String(flags.ScaleFlag))
+	if err != nil {
+		return nil, err
+	}
+	if allServices != nil {
+		return &desiredCount{count: *allServices, fromFlag: true}, nil
+	}
+
+	var containerDefs composeutils.ContainerDefs
+	if s.Context().ECSParams != nil {
+		containerDefs = s.Context().ECSParams.TaskDefinition.ContainerDefinitions
+	}
+	var containers []*ecs.ContainerDefinition
+	if s.TaskDefinition() != nil {
+		containers = s.TaskDefinition().ContainerDefinitions
+	}
+
+	deployed := make(map[string]bool)
+	counts := make(map[int64][]string) // names of the compose services per desired count
+	var result *desiredCount
+	for _, container := range containers {
+		name := aws.StringValue(container.Name)
+		deployed[name] = true
+		var count *desiredCount
+		if value, ok := perService[name]; ok {
+			count = &desiredCount{count: value, fromFlag: true}
+		} else if containerDef, ok := containerDefs[name]; ok && containerDef.DesiredCount != nil {
+			if *containerDef.DesiredCount < 0 {
+				return nil, fmt.Errorf("desired_count of service %s in the ecs-params file must not be negative", name)
+			}
+			count = &desiredCount{count: *containerDef.DesiredCount}
+		}
+		if count == nil {
+			continue
+		}
+		counts[count.count] = append(counts[count.count], name)
+		if result == nil || count.fromFlag {
+			result = count
+		}
+	}
+	for name := range perService {
+		if !deployed[name] {
+			log.WithField("service", name).Warnf("Ignoring the desired count given with --%s for a compose service that is not deployed", flags.ScaleFlag)
+		}
+	}
+
+	if len(counts) > 1 {
+		var conflicts []string
+		for count, names := range counts {
+			sort.Strings(names)
+			conflicts = append(conflicts, fmt.Sprintf("%d for %s", count, strings.Join(names, ", ")))
+		}
+		sort.Strings(conflicts)
+		return nil, fmt.Errorf("The compose services have different desired counts (%s), but they run in the tasks of a single ECS service; "+
+			"deploy them separately with --%s and a --%s for each", strings.Join(conflicts, "; "), flags.ServicesFlag, flags.ProjectNameFlag)
+	}
+	return result, nil
+}
+
+// parseScale parses the value of the --scale flag, either a count for all the compose services
+// or a comma-separated list of service=count pairs
+func parseScale(value string) (*int64, map[string]int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil, nil
+	}
+	if !strings.Contains(value, "=") {
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || count < 0 {
+			return nil, nil, fmt.Errorf("--%s must be a non-negative count or a list of service=count pairs, got '%s'", flags.ScaleFlag, value)
+		}
+		return &count, nil, nil
+	}
+
+	perService := make(map[string]int64)
+	for _, pair := range strings.Split(value, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		name := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || name == "" {
+			return nil, nil, fmt.Errorf("--%s must be a non-negative count or a list of service=count pairs, got '%s'", flags.ScaleFlag, pair)
+		}
+		count, err := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+		if err != nil || count < 0 {
+			return nil, nil, fmt.Errorf("The count of service %s given with --%s must be a non-negative integer, got '%s'", name, flags.ScaleFlag, parts[1])
+		}
+		perService[name] = count
+	}
+	return nil, perService, nil
+}
+
+// isAutoScaled returns whether a scalable target is registered for the desired count of the service
+func (s *Service) isAutoScaled(serviceName string) bool {
+	scalableTarget, err := getServiceScalableTarget(serviceName, s.Context().CommandConfig)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"serviceName": serviceName,
+			"error":       err,
+		}).Warn("Unable to describe the Application Auto Scaling target of the service")
+		return false
+	}
+	if scalableTarget == nil {
+		return false
+	}
+	log.WithFields(log.Fields{
+		"serviceName": serviceName,
+		"minCapacity": aws.Int64Value(scalableTarget.MinCapacity),
+		"maxCapacity": aws.Int64Value(scalableTarget.MaxCapacity),
+	}).Info("ECS Service is managed by Application Auto Scaling; leaving its desired count unchanged")
+	return true
+}
+
+// dryRunUp prints the requests that Up would send to ECS without executing them
+func (s *Service) dryRunUp(ecsService *ecs.Service, missingService bool) error {
+	taskDefFamily, err := entity.DryRunRegisterTaskDefinition(s)
+	if err != nil {
+		return err
+	}
+
+	hooks := s.deployHooks()
+	if len(hooks.PreDeploy) > 0 || len(hooks.PostDeploy) > 0 {
+		log.Warn("Dry run: deploy hooks are not run")
+	}
+
+	serviceConnect, err := entity.ServiceConnectConfiguration(s)
+	if err != nil {
+		return err
+	}
+
+	serviceName := entity.GetServiceName(s)
+	if missingService || aws.StringValue(ecsService.Status) != ecsActiveResourceCode {
+		if s.Context().CLIContext.Bool(flags.EnableServiceDiscoveryFlag) {
+			log.Warn("Dry run: Service Discovery resources will not be created, so the service registry is omitted from the CreateService request")
+		}
+		createServiceInput, err := s.buildCreateServiceInput(serviceName, taskDefFamily, int(s.countForCreate()))
+		if err != nil {
+			return err
+		}
+		createServiceInput.ServiceConnectConfiguration = serviceConnect
+		return entity.PrintDryRunRequest("CreateService", createServiceInput)
+	}
+
+	updateServiceInput, err := s.buildUpdateServiceInput(s.countForUpdate(ecsService), aws.StringValue(ecsService.ServiceName), taskDefFamily)
+	if err != nil {
+		return err
+	}
+	updateServiceInput.ServiceConnectConfiguration = serviceConnect
+	return entity.PrintDryRunRequest("UpdateService", updateServiceInput)
+}
+
 // Info returns a formatted list of containers (running and stopped) started by this service
 func (s *Service) Info(filterProjectTasks bool, desiredStatus string) (project.InfoSet, error) {
 	// filterProjectTasks is not honored for services, because ECS Services have their
 	// own custom Group field, overriding that with startedBy=project will result in no tasks
 	// We should instead filter by ServiceName=service
-	return entity.Info(s, false, desiredStatus)
+	containers, err := entity.Containers(s, false, desiredStatus)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.addLoadBalancerInfo(containers); err != nil {
+		log.Warnf("Failed to get the target health of the containers of the service: %s", err)
+	}
+	return composecontainer.ConvertContainersToInfoSet(containers), nil
 }
 
-// Scale the service desired count to be the specified count
+// addLoadBalancerInfo sets the target health and the load balancer URL of the containers
+// registered to the target groups of the service
+func (s *Service) addLoadBalancerInfo(containers []composecontainer.Container) error {
+	if len(containers) == 0 {
+		return nil
+	}
+	ecsService, err := s.describeService()
+	if err != nil {
+		return err
+	}
+	for _, loadBalancer := range ecsService.LoadBalancers {
+		targetGroupArn := aws.StringValue(loadBalancer.TargetGroupArn)
+		if targetGroupArn == "" {
+			// Classic Load Balancers do not have target groups
+			continue
+		}
+		targetHealth, err := getTargetHealth(targetGroupArn, s.Context().CommandConfig)
+		if err != nil {
+			return err
+		}
+		url, err := getServiceURL(targetGroupArn, s.Context().CommandConfig)
+		if err != nil {
+			return err
+		}
+
+		containerPort := aws.Int64Value(loadBalancer.ContainerPort)
+		for i := range containers {
+			container := &containers[i]
+			if container.ContainerName() != aws.StringValue(loadBalancer.ContainerName) {
+				continue
+			}
+			container.URL = url
+			container.TargetHealth = targetHealth[containerTargetKey(container, containerPort)]
+		}
+	}
+	return nil
+}
+
+// containerTargetKey returns the key of the container in a target group: its IP address
+// and container port with task networking, otherwise its EC2 instance and host port
+func containerTargetKey(container *composecontainer.Container, containerPort int64) string {
+	if ip := container.PrivateIPAddress(); ip != "" {
+		return elbv2.TargetKey(ip, containerPort)
+	}
+	return elbv2.TargetKey(container.EC2InstanceID, container.HostPort(containerPort))
+}
+
+// Scale the service desired count to be the specified count.
+// If --batch-size is specified, the desired count is changed in batches and the
+// service must reach a steady state after each batch before the next one starts.
 func (s *Service) Scale(count int) error {
-	return s.updateServiceCount(aws.Int64(int64(count)))
+	batchSize, drainDelay, err := s.scaleBatchOptions()
+	if err != nil {
+		return err
+	}
+	if batchSize == 0 {
+		return s.updateServiceCount(aws.Int64(int64(count)))
+	}
+
+	ecsService, err := s.describeService()
+	if err != nil {
+		return err
+	}
+	currentCount := aws.Int64Value(ecsService.DesiredCount)
+	targetCount := int64(count)
+	for currentCount != targetCount {
+		nextCount := nextBatchCount(currentCount, targetCount, batchSize)
+		log.WithFields(log.Fields{
+			"desiredCount": nextCount,
+			"targetCount":  targetCount,
+		}).Info("Scaling service")
+		if err = s.updateServiceCount(aws.Int64(nextCount)); err != nil {
+			return err
+		}
+		if nextCount < currentCount && nextCount != targetCount && drainDelay > 0 {
+			log.WithFields(log.Fields{
+				"drainDelay": drainDelay,
+			}).Info("Waiting for stopped tasks to drain")
+			sleep(drainDelay)
+		}
+		currentCount = nextCount
+	}
+	return nil
+}
+
+// scaleBatchOptions returns the batch size and the drain delay between scale-down batches
+func (s *Service) scaleBatchOptions() (int64, time.Duration, error) {
+	cliContext := s.Context().CLIContext
+	batchSize := cliContext.Int(flags.BatchSizeFlag)
+	drainDelay := cliContext.Int(flags.DrainDelayFlag)
+	wait := cliContext.Bool(flags.WaitFlag)
+
+	if batchSize < 0 {
+		return 0, 0, fmt.Errorf("--%s must be greater than 0", flags.BatchSizeFlag)
+	}
+	if drainDelay < 0 {
+		return 0, 0, fmt.Errorf("--%s must not be negative", flags.DrainDelayFlag)
+	}
+	if batchSize > 0 && !wait {
+		return 0, 0, fmt.Errorf("--%s requires --%s", flags.BatchSizeFlag, flags.WaitFlag)
+	}
+	if drainDelay > 0 && batchSize == 0 {
+		return 0, 0, fmt.Errorf("--%s requires --%s", flags.DrainDelayFlag, flags.BatchSizeFlag)
+	}
+	if wait && cliContext.Float64(flags.ComposeServiceTimeOutFlag) == 0 {
+		return 0, 0, fmt.Errorf("--%s cannot be used with a --%s of 0", flags.WaitFlag, flags.ComposeServiceTimeOutFlag)
+	}
+	return int64(batchSize), time.Duration(drainDelay) * time.Second, nil
+}
+
+// nextBatchCount returns the desired count after the next batch, moving from the
+// current count towards the target count by at most batchSize tasks
+func nextBatchCount(currentCount, targetCount, batchSize int64) int64 {
+	if targetCount > currentCount {
+		if targetCount-currentCount > batchSize {
+			return currentCount + batchSize
+		}
+		return targetCount
+	}
+	if currentCount-targetCount > batchSize {
+		return currentCount - batchSize
+	}
+	return targetCount
+}
+
+// Restart forces a new deployment of the service with its current task definition, so that the
+// new tasks pull their images again and read the current values of their secrets
+func (s *Service) Restart() error {
+	ecsService, err := s.describeService()
+	if err != nil {
+		return err
+	}
+	ecsServiceName := aws.StringValue(ecsService.ServiceName)
+	if aws.StringValue(ecsService.Status) != ecsActiveResourceCode {
+		return fmt.Errorf("Service %s is not active; use compose service up to create it", ecsServiceName)
+	}
+	if aws.StringValue(ecsService.SchedulingStrategy) != ecs.SchedulingStrategyDaemon && aws.Int64Value(ecsService.DesiredCount) == 0 {
+		return fmt.Errorf("Service %s has no tasks to restart; use compose service start to start it", ecsServiceName)
+	}
+
+	input, err := s.buildUpdateServiceInput(nil, ecsServiceName, "")
+	if err != nil {
+		return err
+	}
+	input.ForceNewDeployment = aws.Bool(true)
+
+	if err = s.Context().ECSClient.UpdateService(input); err != nil {
+		return err
+	}
+	s.logUpdateService(input, "Forced a new deployment of the ECS service. Old containers will be stopped automatically, and replaced with new ones")
+
+	return waitForServiceTasks(s, ecsServiceName)
 }
 
 // Stop stops all the containers in the service by calling ECS.UpdateService(count=0)
@@ -420,46 +932,140 @@ func (s *Service) Stop() error {
 	return s.updateServiceCount(aws.Int64(0))
 }
 
-// Down stops any running containers(tasks) by calling Stop() and deletes an active ECS Service
-// NoOp if the service is inactive
+// Down stops any running containers(tasks) by calling Stop() and deletes an active ECS Service.
+// It then deletes the other resources recorded for the project, such as task definitions and
+// services created under a previous service name
 func (s *Service) Down() error {
+	if err := s.validateDNSRecordFlags(); err != nil {
+		return err
+	}
+
 	// describe the service
 	ecsService, err := s.describeService()
 	if err != nil {
 		return err
 	}
 
+	serviceDiscoveryDeleted, err := s.deleteService(ecsService)
+	if err != nil {
+		return err
+	}
+	s.deleteRecordedResources(aws.StringValue(ecsService.ServiceName), serviceDiscoveryDeleted)
+
+	return s.deleteDNSRecord()
+}
+
+// deleteService deletes an active ECS Service and the Service Discovery resources it uses,
+// and returns whether those were deleted. NoOp if the service is inactive
+func (s *Service) deleteService(ecsService *ecs.Service) (bool, error) {
 	ecsServiceName := aws.StringValue(ecsService.ServiceName)
 	// if already deleted, NoOp
 	if aws.StringValue(ecsService.Status) != ecsActiveResourceCode {
 		log.WithFields(log.Fields{
 			"serviceName": ecsServiceName,
 		}).Info("ECS Service is already deleted")
-		return nil
+		return false, nil
 	}
 
 	// DeleteService will ignore desiredCount being non-zero by making use
 	// of the force flag
-	if err = s.Context().ECSClient.DeleteService(ecsServiceName); err != nil {
-		return err
+	if err := s.Context().ECSClient.DeleteService(ecsServiceName); err != nil {
+		return false, err
 	}
-	if err = waitForServiceTasks(s, ecsServiceName); err != nil {
-		return err
+	if err := waitForServiceTasks(s, ecsServiceName); err != nil {
+		return false, err
 	}
 
 	// delete Service Discovery resources if they exist
 	if len(ecsService.ServiceRegistries) > 0 {
 		log.Info("Trying to delete any Service Discovery Resources that were created by the ECS CLI...")
 		registryArn := aws.StringValue(ecsService.ServiceRegistries[0].RegistryArn)
-		if err = s.deleteServiceDiscoveryResources(registryArn, ecsServiceName); err != nil {
+		if err := s.deleteServiceDiscoveryResources(registryArn, ecsServiceName); err != nil {
 			// SD deletion errors are logged but aren't fatal.
 			log.Errorf("Problem deleting Service Discovery resources: %v", err)
 		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// updateDeregistrationDelay sets the deregistration delay, if specified, of the target groups of the service
+func (s *Service) updateDeregistrationDelay(loadBalancers []*ecs.LoadBalancer) error {
+	if s.deregDelay == nil {
+		return nil
+	}
+	updated := false
+	for _, loadBalancer := range loadBalancers {
+		targetGroupArn := aws.StringValue(loadBalancer.TargetGroupArn)
+		if targetGroupArn == "" {
+			continue
+		}
+		if err := setDeregistrationDelay(targetGroupArn, aws.Int64Value(s.deregDelay), s.Context().CommandConfig); err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{
+			"targetGroup":          targetGroupArn,
+			"deregistration-delay": aws.Int64Value(s.deregDelay),
+		}).Info("Updated the deregistration delay of the target group")
+		updated = true
 	}
+	if !updated {
+		log.Warn("The deregistration delay only applies to target groups of Application or Network Load Balancers, which this service does not use; ignoring it")
+	}
+	return nil
+}
 
+// validateDNSRecordFlags returns an error if only one of --dns-name and --hosted-zone-id is specified
+func (s *Service) validateDNSRecordFlags() error {
+	cliContext := s.Context().CLIContext
+	if (cliContext.String(flags.DNSNameFlag) == "") != (cliContext.String(flags.HostedZoneIDFlag) == "") {
+		return fmt.Errorf("--%s and --%s must be specified together", flags.DNSNameFlag, flags.HostedZoneIDFlag)
+	}
 	return nil
 }
 
+// upsertDNSRecord points the --dns-name alias record at the load balancer of the service's
+// first target group. The load balancers of the existing service are used if none were specified.
+func (s *Service) upsertDNSRecord(ecsService *ecs.Service) error {
+	cliContext := s.Context().CLIContext
+	dnsName := cliContext.String(flags.DNSNameFlag)
+	if dnsName == "" {
+		return nil
+	}
+
+	loadBalancers := s.loadBalancers
+	if loadBalancers == nil && ecsService != nil {
+		loadBalancers = ecsService.LoadBalancers
+	}
+	targetGroupArn := ""
+	for _, loadBalancer := range loadBalancers {
+		if arn := aws.StringValue(loadBalancer.TargetGroupArn); arn != "" {
+			targetGroupArn = arn
+			break
+		}
+	}
+	if targetGroupArn == "" {
+		return fmt.Errorf("--%s requires the service to use a target group of an Application or Network Load Balancer", flags.DNSNameFlag)
+	}
+
+	loadBalancer, err := getLoadBalancerForTargetGroup(targetGroupArn, s.Context().CommandConfig)
+	if err != nil {
+		return err
+	}
+	return upsertAliasRecord(cliContext.String(flags.HostedZoneIDFlag), dnsName, aws.StringValue(loadBalancer.DNSName), aws.StringValue(loadBalancer.CanonicalHostedZoneId), s.Context().CommandConfig)
+}
+
+// deleteDNSRecord deletes the --dns-name alias record, if specified
+func (s *Service) deleteDNSRecord() error {
+	cliContext := s.Context().CLIContext
+	dnsName := cliContext.String(flags.DNSNameFlag)
+	if dnsName == "" {
+		return nil
+	}
+	return deleteAliasRecord(cliContext.String(flags.HostedZoneIDFlag), dnsName, s.Context().CommandConfig)
+}
+
 func (s *Service) deleteServiceDiscoveryResources(registryArn, ecsServiceName string) error {
 	sdsID := getSDSIDFromArn(registryArn)
 	if err := waitUntilSDSDeletable(sdsID, s.Context().CommandConfig); err != nil {
@@ -489,6 +1095,10 @@ func (s *Service) GetTags() ([]*ecs.Tag, error) {
 				return nil, err
 			}
 		}
+		tags, err := entity.AppendGitTags(s, tags)
+		if err != nil {
+			return nil, err
+		}
 		s.tags = tags
 
 	}
@@ -503,6 +1113,9 @@ func (s *Service) buildCreateServiceInput(serviceName, taskDefName string, desir
 	ecsParams := s.ecsContext.ECSParams
 	schedulingStrategy := strings.ToUpper(s.Context().CLIContext.String(flags.SchedulingStrategyFlag))
 
+	if err := entity.ResolveNetworkConfiguration(s); err != nil {
+		return nil, err
+	}
 	networkConfig, err := composeutils.ConvertToECSNetworkConfiguration(ecsParams)
 	if err != nil {
 		return nil, err
@@ -672,7 +1285,7 @@ func (s *Service) createService(desiredCount int) error {
 	defer s.logCreateService(serviceName, taskDefName)
 
 	// Call ECS Client
-	err = s.Context().ECSClient.CreateService(createServiceInput)
+	err = s.sendCreateService(createServiceInput)
 	if err != nil {
 		return err
 	}
@@ -685,6 +1298,29 @@ func (s *Service) createService(desiredCount int) error {
 	return waitForServiceTasks(s, serviceName)
 }
 
+// sendCreateService calls the underlying ECS.CreateService, with the Service Connect
+// configuration of the ECS Params file if any
+func (s *Service) sendCreateService(input *ecs.CreateServiceInput) error {
+	serviceConnect, err := entity.ServiceConnectConfiguration(s)
+	if err != nil {
+		return err
+	}
+	input.ServiceConnectConfiguration = serviceConnect
+	return s.Context().ECSClient.CreateService(input)
+}
+
+// sendUpdateService calls the underlying ECS.UpdateService, with the Service Connect
+// configuration of the ECS Params file if any. Removing the configuration from the file
+// leaves the one of the service unchanged.
+func (s *Service) sendUpdateService(input *ecs.UpdateServiceInput) error {
+	serviceConnect, err := entity.ServiceConnectConfiguration(s)
+	if err != nil {
+		return err
+	}
+	input.ServiceConnectConfiguration = serviceConnect
+	return s.Context().ECSClient.UpdateService(input)
+}
+
 // describeService calls underlying ECS.DescribeService and expects the service to be present,
 // returns error otherwise
 func (s *Service) describeService() (*ecs.Service, error) {
@@ -702,7 +1338,8 @@ func (s *Service) describeService() (*ecs.Service, error) {
 	return output.Services[0], nil
 }
 
-// startService checks if the service has a zero desired count and updates the count to 1 (of each container)
+// startService checks if the service has a zero desired count and updates the count to the configured
+// desired count, or 1 (of each container)
 func (s *Service) startService() error {
 	ecsService, err := s.describeService()
 	if err != nil {
@@ -742,7 +1379,10 @@ func (s *Service) startService() error {
 
 		return waitForServiceTasks(s, serviceName)
 	}
-	return s.updateServiceCount(aws.Int64(1))
+	if s.desiredCount, err = s.loadDesiredCount(); err != nil {
+		return err
+	}
+	return s.updateServiceCount(aws.Int64(s.countForCreate()))
 }
 
 // updateServiceCount calls the underlying ECS.UpdateService with the specified count
@@ -755,7 +1395,7 @@ func (s *Service) updateServiceCount(count *int64) error {
 		return err
 	}
 
-	if err = s.Context().ECSClient.UpdateService(updateServiceInput); err != nil {
+	if err = s.sendUpdateService(updateServiceInput); err != nil {
 		return err
 	}
 
@@ -766,8 +1406,10 @@ func (s *Service) updateServiceCount(count *int64) error {
 
 func (s *Service) logUpdateService(input *ecs.UpdateServiceInput, message string) {
 	fields := log.Fields{
-		"service":      aws.StringValue(input.Service),
-		"desiredCount": aws.Int64Value(input.DesiredCount),
+		"service": aws.StringValue(input.Service),
+	}
+	if input.DesiredCount != nil {
+		fields["desiredCount"] = aws.Int64Value(input.DesiredCount)
 	}
 	if s.deploymentConfig != nil && s.deploymentConfig.MaximumPercent != nil {
 		fields["deployment-max-percent"] = aws.Int64Value(s.deploymentConfig.MaximumPercent)
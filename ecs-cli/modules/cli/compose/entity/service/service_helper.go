@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/waiters"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -143,6 +144,7 @@ func waitForServiceTasks(service *Service, ecsServiceName string) error {
 			lastRunningCount = runningCount
 			lastRunningCountChangedAt = time.Now()
 			log.WithFields(logFields).Info("Service status")
+			progress.EmitCount(progress.PhaseService, ecsServiceName, progress.StatusInProgress, runningCount, desiredCount)
 		}
 
 		// log new service events
@@ -153,10 +155,12 @@ func waitForServiceTasks(service *Service, ecsServiceName string) error {
 		// The deployment was successful
 		if len(ecsService.Deployments) == 1 && desiredCount == runningCount {
 			log.WithFields(logFields).Info("ECS Service has reached a stable state")
+			progress.EmitCount(progress.PhaseService, ecsServiceName, progress.StatusComplete, runningCount, desiredCount)
 			return true, nil
 		}
 
 		if time.Since(lastRunningCountChangedAt).Minutes() > timeOut {
+			progress.EmitCount(progress.PhaseService, ecsServiceName, progress.StatusFailed, runningCount, desiredCount)
 			return false, fmt.Errorf("Deployment has not completed: Running count has not changed for %.2f minutes", timeOut)
 		}
 
@@ -19,17 +19,24 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	composecontainer "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/container"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/applicationautoscaling"
+	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
 	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/elbv2"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/tagging"
 	mock_tagging "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/tagging/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	utils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
 	"github.com/aws/aws-sdk-go/aws"
+	sdkautoscaling "github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkelbv2 "github.com/aws/aws-sdk-go/service/elbv2"
 	taggingSDK "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -125,6 +132,67 @@ func TestCreateWithNetworkConfig(t *testing.T) {
 	)
 }
 
+func TestCreateWithServiceConnect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskDefID := "taskDefinitionId"
+	taskDefArn, taskDefinition, _ := getTestTaskDef(taskDefID)
+	taskDefinition.NetworkMode = aws.String(ecs.NetworkModeBridge)
+	taskDefinition.ContainerDefinitions = []*ecs.ContainerDefinition{{
+		Name:         aws.String("web"),
+		PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(8080)}},
+	}}
+	registerTaskDefResponse := taskDefinition
+	registerTaskDefResponse.TaskDefinitionArn = aws.String(taskDefArn)
+	ecsParams := &utils.ECSParams{}
+	ecsParams.RunParams.ServiceConnect = &utils.ServiceConnect{
+		Services: []utils.ServiceConnectService{{
+			ContainerName: "web",
+			ContainerPort: 8080,
+			ClientAliases: []utils.ServiceConnectClientAlias{{Port: 80}},
+		}},
+	}
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+			input := x.(*ecs.RegisterTaskDefinitionInput)
+			assert.Equal(t, "web-8080", aws.StringValue(input.ContainerDefinitions[0].PortMappings[0].Name), "Expected the port mapping of the service to be named")
+		}).Return(&registerTaskDefResponse, nil),
+		mockEcs.EXPECT().ListAccountSettings(gomock.Any()).Return(&ecs.ListAccountSettingsOutput{
+			Settings: []*ecs.Setting{{Value: aws.String(ecsSettingDisabled)}},
+		}, nil),
+		mockEcs.EXPECT().CreateService(gomock.Any()).Do(func(x interface{}) {
+			input := x.(*ecs.CreateServiceInput)
+			assert.Equal(t, &ecs.ServiceConnectConfiguration{
+				Enabled: aws.Bool(true),
+				Services: []*ecs.ServiceConnectService{{
+					PortName:      aws.String("web-8080"),
+					DiscoveryName: aws.String("web"),
+					ClientAliases: []*ecs.ServiceConnectClientAlias{{Port: aws.Int64(80)}},
+				}},
+			}, input.ServiceConnectConfiguration)
+		}).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	context := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     ecsParams,
+	}
+
+	service := NewService(context)
+	err := service.LoadContext()
+	assert.NoError(t, err, "Unexpected error while loading context in create service test")
+
+	service.SetTaskDefinition(&taskDefinition)
+	err = service.Create()
+	assert.NoError(t, err, "Unexpected error while create")
+}
+
 func ecsParamsWithFargateNetworkConfig() *utils.ECSParams {
 	return &utils.ECSParams{
 		TaskDefinition: utils.EcsTaskDef{
@@ -427,6 +495,66 @@ func TestCreateWithHealthCheckGracePeriodAndALB(t *testing.T) {
 	)
 }
 
+func TestCreateWithHealthCheckGracePeriodAndDeregistrationDelayFromECSParams(t *testing.T) {
+	targetGroupArn := "targetGroupArn"
+	healthCheckGP := int64(120)
+	deregistrationDelay := int64(30)
+
+	var observedTargetGroups []string
+	setDeregistrationDelay = func(targetGroupArn string, seconds int64, config *config.CommandConfig) error {
+		assert.Equal(t, deregistrationDelay, seconds, "Deregistration delay should match")
+		observedTargetGroups = append(observedTargetGroups, targetGroupArn)
+		return nil
+	}
+	defer func() { setDeregistrationDelay = elbv2.SetDeregistrationDelay }()
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.TargetGroupArnFlag, targetGroupArn, "")
+	flagSet.String(flags.ContainerNameFlag, "containerName", "")
+	flagSet.String(flags.ContainerPortFlag, "80", "")
+
+	ecsParams := &utils.ECSParams{
+		RunParams: utils.RunParams{
+			HealthCheckGracePeriod: aws.Int64(healthCheckGP),
+			DeregistrationDelay:    aws.Int64(deregistrationDelay),
+		},
+	}
+
+	createServiceTest(
+		t,
+		flagSet,
+		&config.CommandConfig{},
+		ecsParams,
+		func(input *ecs.CreateServiceInput) {
+			assert.Equal(t, healthCheckGP, aws.Int64Value(input.HealthCheckGracePeriodSeconds), "HealthCheckGracePeriod should match")
+		},
+		ecsSettingDisabled,
+	)
+	assert.Equal(t, []string{targetGroupArn}, observedTargetGroups, "Expected deregistration delay to be set on the target group")
+}
+
+func TestLoadContextHealthCheckGracePeriodAndDeregistrationDelayFlagsOverrideECSParams(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.HealthCheckGracePeriodFlag, "60", "")
+	flagSet.String(flags.DeregistrationDelayFlag, "10", "")
+	service := &Service{
+		ecsContext: &context.ECSContext{
+			CLIContext: cli.NewContext(nil, flagSet, nil),
+			ECSParams: &utils.ECSParams{
+				RunParams: utils.RunParams{
+					HealthCheckGracePeriod: aws.Int64(120),
+					DeregistrationDelay:    aws.Int64(30),
+				},
+			},
+		},
+	}
+
+	err := service.LoadContext()
+	assert.NoError(t, err, "Unexpected error while loading context")
+	assert.Equal(t, int64(60), aws.Int64Value(service.healthCheckGP), "HealthCheckGracePeriod should match the flag")
+	assert.Equal(t, int64(10), aws.Int64Value(service.deregDelay), "Deregistration delay should match the flag")
+}
+
 func TestCreateWithTwoTargetGroupsFlag(t *testing.T) {
 	role := "role"
 	targetGroups := &cli.StringSlice{}
@@ -978,6 +1106,31 @@ func TestCreateWithResourceTags(t *testing.T) {
 	)
 }
 
+func TestCreateWithGitTags(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.ResourceTagsFlag, "git-branch=release", "")
+	flagSet.String(flags.GitCommitFlag, "4f2a7c1e9b", "")
+	flagSet.String(flags.GitBranchFlag, "main", "")
+	flagSet.String(flags.GitRepositoryFlag, "https://token@github.com/org/repo.git", "")
+
+	expectedTags := []*ecs.Tag{
+		{Key: aws.String("git-branch"), Value: aws.String("release")},
+		{Key: aws.String("git-commit"), Value: aws.String("4f2a7c1e9b")},
+		{Key: aws.String("git-repository"), Value: aws.String("https://github.com/org/repo.git")},
+	}
+
+	createServiceTest(
+		t,
+		flagSet,
+		&config.CommandConfig{},
+		&utils.ECSParams{},
+		func(input *ecs.CreateServiceInput) {
+			assert.ElementsMatch(t, expectedTags, input.Tags, "Expected resource tags to override git tags")
+		},
+		ecsSettingDisabled,
+	)
+}
+
 func TestCreateWithECSManagedTags(t *testing.T) {
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 
@@ -1046,9 +1199,9 @@ func TestCreateWithLongARNsDisabled(t *testing.T) {
 	)
 }
 
-//////////////////////////////////////
+// ////////////////////////////////////
 // Helpers for CreateService tests //
-/////////////////////////////////////
+// ///////////////////////////////////
 type validateCreateServiceInputField func(*ecs.CreateServiceInput)
 
 func createServiceTest(t *testing.T,
@@ -1095,9 +1248,14 @@ func createServiceTest(t *testing.T,
 		}).Return(nil),
 	)
 
+	// Mock EC2 calls, the subnets of the Fargate tasks are public
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+	mockEC2.EXPECT().GetPublicSubnets(gomock.Any()).DoAndReturn(allPublicSubnets).AnyTimes()
+
 	cliContext := cli.NewContext(nil, flagSet, nil)
 	context := &context.ECSContext{
 		ECSClient:     mockEcs,
+		EC2Client:     mockEC2,
 		CommandConfig: commandConfig,
 		CLIContext:    cliContext,
 		ECSParams:     ecsParams,
@@ -1214,9 +1372,9 @@ func createNewServiceWithDelay(t *testing.T,
 	assert.Equal(t, taskDefArn, aws.StringValue(service.TaskDefinition().TaskDefinitionArn), "TaskDefArn should match")
 }
 
-////////////////////////
+// //////////////////////
 // LoadContext tests //
-///////////////////////
+// /////////////////////
 func TestLoadContext(t *testing.T) {
 	deploymentMaxPercent := 150
 
@@ -1282,6 +1440,67 @@ func TestServiceInfo(t *testing.T) {
 	}, t, true, "")
 }
 
+func TestServiceAddLoadBalancerInfo(t *testing.T) {
+	defer func() {
+		getTargetHealth = elbv2.GetTargetHealth
+		getServiceURL = elbv2.GetServiceURL
+	}()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	targetGroupArn := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/web/6d0ecf831eec9f09"
+	getTargetHealth = func(arn string, config *config.CommandConfig) (map[string]string, error) {
+		assert.Equal(t, targetGroupArn, arn, "Expected target group ARN to match")
+		return map[string]string{
+			"10.0.0.1:80":   "healthy",
+			"i-1234:32768":  "unhealthy",
+			"10.0.0.9:80":   "draining",
+			"i-other:32769": "healthy",
+		}, nil
+	}
+	getServiceURL = func(arn string, config *config.CommandConfig) (string, error) {
+		return "http://web-1234567890.us-west-2.elb.amazonaws.com", nil
+	}
+
+	mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(&ecs.Service{
+		LoadBalancers: []*ecs.LoadBalancer{
+			{
+				TargetGroupArn: aws.String(targetGroupArn),
+				ContainerName:  aws.String("web"),
+				ContainerPort:  aws.Int64(80),
+			},
+		},
+	}), nil)
+
+	task := &ecs.Task{TaskArn: aws.String("arn/task")}
+	awsvpcContainer := composecontainer.NewContainer(task, "", &ecs.Container{
+		Name: aws.String("web"),
+		NetworkInterfaces: []*ecs.NetworkInterface{
+			{PrivateIpv4Address: aws.String("10.0.0.1")},
+		},
+	}, nil)
+	bridgeContainer := composecontainer.NewContainer(task, "", &ecs.Container{Name: aws.String("web")}, []*ecs.NetworkBinding{
+		{ContainerPort: aws.Int64(80), HostPort: aws.Int64(32768)},
+	})
+	bridgeContainer.EC2InstanceID = "i-1234"
+	sidecarContainer := composecontainer.NewContainer(task, "", &ecs.Container{Name: aws.String("sidecar")}, nil)
+	containers := []composecontainer.Container{awsvpcContainer, bridgeContainer, sidecarContainer}
+
+	service := NewService(&context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+	}).(*Service)
+	err := service.addLoadBalancerInfo(containers)
+	assert.NoError(t, err, "Unexpected error adding load balancer info")
+
+	assert.Equal(t, "healthy", containers[0].TargetHealth)
+	assert.Equal(t, "http://web-1234567890.us-west-2.elb.amazonaws.com", containers[0].URL)
+	assert.Equal(t, "unhealthy", containers[1].TargetHealth)
+	assert.Equal(t, "", containers[2].TargetHealth, "Expected containers outside of the target group to have no target health")
+	assert.Equal(t, "", containers[2].URL, "Expected containers outside of the target group to have no URL")
+}
+
 ////////////////
 // Run tests //
 ///////////////
@@ -1292,6 +1511,226 @@ func TestServiceRun(t *testing.T) {
 	assert.Error(t, err, "Expected unsupported error")
 }
 
+//////////////////
+// Scale tests //
+/////////////////
+
+func TestServiceScaleUpInBatches(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+	flagSet.Int(flags.BatchSizeFlag, 2, "")
+	flagSet.Bool(flags.WaitFlag, true, "")
+	flagSet.Float64(flags.ComposeServiceTimeOutFlag, DefaultUpdateServiceTimeout, "")
+
+	sleep = func(d time.Duration) {
+		assert.Fail(t, "Unexpected drain delay on scale up")
+	}
+
+	scaleServiceTest(t, flagSet, 1, 5, []int64{3, 5})
+}
+
+func TestServiceScaleDownInBatchesWithDrainDelay(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+	flagSet.Int(flags.BatchSizeFlag, 2, "")
+	flagSet.Bool(flags.WaitFlag, true, "")
+	flagSet.Int(flags.DrainDelayFlag, 30, "")
+	flagSet.Float64(flags.ComposeServiceTimeOutFlag, DefaultUpdateServiceTimeout, "")
+
+	var delays []time.Duration
+	sleep = func(d time.Duration) {
+		delays = append(delays, d)
+	}
+
+	scaleServiceTest(t, flagSet, 6, 1, []int64{4, 2, 1})
+	assert.Equal(t, []time.Duration{30 * time.Second, 30 * time.Second}, delays, "Expected drain delay between scale-down batches only")
+}
+
+func TestServiceScaleInBatchesInvalidFlags(t *testing.T) {
+	var testCases = []struct {
+		testName  string
+		batchSize int
+		wait      bool
+		drain     int
+		timeout   float64
+	}{
+		{
+			testName:  "Batch size without wait",
+			batchSize: 2,
+			timeout:   DefaultUpdateServiceTimeout,
+		},
+		{
+			testName: "Drain delay without batch size",
+			wait:     true,
+			drain:    30,
+			timeout:  DefaultUpdateServiceTimeout,
+		},
+		{
+			testName:  "Wait with zero timeout",
+			batchSize: 2,
+			wait:      true,
+		},
+		{
+			testName:  "Negative batch size",
+			batchSize: -1,
+			wait:      true,
+			timeout:   DefaultUpdateServiceTimeout,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			flagSet := flag.NewFlagSet("ecs-cli-scale", 0)
+			flagSet.Int(flags.BatchSizeFlag, testCase.batchSize, "")
+			flagSet.Bool(flags.WaitFlag, testCase.wait, "")
+			flagSet.Int(flags.DrainDelayFlag, testCase.drain, "")
+			flagSet.Float64(flags.ComposeServiceTimeOutFlag, testCase.timeout, "")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+			service := NewService(&context.ECSContext{
+				ECSClient:     mockEcs,
+				CommandConfig: &config.CommandConfig{},
+				CLIContext:    cli.NewContext(nil, flagSet, nil),
+				ECSParams:     &utils.ECSParams{},
+			})
+			err := service.LoadContext()
+			assert.NoError(t, err, "Unexpected error while loading context")
+
+			err = service.Scale(5)
+			assert.Error(t, err, "Expected error on service scale")
+		})
+	}
+}
+
+// scaleServiceTest expects the desired count of the service to be updated to each of
+// the expected batch counts in order, with the service reaching a steady state in between
+func scaleServiceTest(t *testing.T, flagSet *flag.FlagSet, currentCount, targetCount int, expectedCounts []int64) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	serviceName := "test-service"
+	calls := []*gomock.Call{
+		mockEcs.EXPECT().DescribeService(serviceName).Return(getDescribeServiceTestResponse(&ecs.Service{
+			ServiceName:  aws.String(serviceName),
+			Status:       aws.String("ACTIVE"),
+			DesiredCount: aws.Int64(int64(currentCount)),
+		}), nil),
+	}
+	for _, count := range expectedCounts {
+		expectedCount := count
+		calls = append(calls,
+			mockEcs.EXPECT().UpdateService(gomock.Any()).Do(func(input interface{}) {
+				req := input.(*ecs.UpdateServiceInput)
+				assert.Equal(t, expectedCount, aws.Int64Value(req.DesiredCount), "Expected desired count to match batch")
+			}).Return(nil),
+			mockEcs.EXPECT().DescribeService(serviceName).Return(getDescribeServiceTestResponse(&ecs.Service{
+				ServiceName:  aws.String(serviceName),
+				Status:       aws.String("ACTIVE"),
+				DesiredCount: aws.Int64(expectedCount),
+				RunningCount: aws.Int64(expectedCount),
+				Deployments:  []*ecs.Deployment{&ecs.Deployment{}},
+			}), nil),
+		)
+	}
+	gomock.InOrder(calls...)
+
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+	}
+	ecsContext.ProjectName = serviceName
+	service := NewService(ecsContext)
+	err := service.LoadContext()
+	assert.NoError(t, err, "Unexpected error while loading context")
+
+	err = service.Scale(targetCount)
+	assert.NoError(t, err, "Unexpected error on service scale")
+}
+
+////////////////////////////
+// Restart Service tests //
+///////////////////////////
+
+func TestServiceRestart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	serviceName := "test-service"
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(serviceName).Return(getDescribeServiceTestResponse(&ecs.Service{
+			ServiceName:    aws.String(serviceName),
+			Status:         aws.String("ACTIVE"),
+			DesiredCount:   aws.Int64(3),
+			TaskDefinition: aws.String(arnPrefix + "test-service:4"),
+		}), nil),
+		mockEcs.EXPECT().UpdateService(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.UpdateServiceInput)
+			assert.True(t, aws.BoolValue(req.ForceNewDeployment), "Expected a new deployment to be forced")
+			assert.Nil(t, req.DesiredCount, "Expected desired count to be unchanged")
+			assert.Nil(t, req.TaskDefinition, "Expected task definition to be unchanged")
+		}).Return(nil),
+		mockEcs.EXPECT().DescribeService(serviceName).Return(getDescribeServiceTestResponse(&ecs.Service{
+			ServiceName:  aws.String(serviceName),
+			Status:       aws.String("ACTIVE"),
+			DesiredCount: aws.Int64(3),
+			RunningCount: aws.Int64(3),
+			Deployments:  []*ecs.Deployment{&ecs.Deployment{}},
+		}), nil),
+	)
+
+	service := newRestartTestService(mockEcs, serviceName)
+	err := service.Restart()
+	assert.NoError(t, err, "Unexpected error on service restart")
+}
+
+func TestServiceRestartErrors(t *testing.T) {
+	testCases := map[string]*ecs.Service{
+		"inactive service": {
+			Status:       aws.String("INACTIVE"),
+			DesiredCount: aws.Int64(1),
+		},
+		"stopped service": {
+			Status:       aws.String("ACTIVE"),
+			DesiredCount: aws.Int64(0),
+		},
+	}
+
+	for name, ecsService := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+			serviceName := "test-service"
+			ecsService.ServiceName = aws.String(serviceName)
+			mockEcs.EXPECT().DescribeService(serviceName).Return(getDescribeServiceTestResponse(ecsService), nil)
+
+			service := newRestartTestService(mockEcs, serviceName)
+			err := service.Restart()
+			assert.Error(t, err, "Expected error on service restart")
+		})
+	}
+}
+
+func newRestartTestService(mockEcs *mock_ecs.MockECSClient, serviceName string) entity.ProjectEntity {
+	flagSet := flag.NewFlagSet("ecs-cli-restart", 0)
+	flagSet.Float64(flags.ComposeServiceTimeOutFlag, DefaultUpdateServiceTimeout, "")
+
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+	}
+	ecsContext.ProjectName = serviceName
+	return NewService(ecsContext)
+}
+
 ///////////////////////
 // Up Service tests //
 //////////////////////
@@ -1376,6 +1815,40 @@ func TestUpdateExistingServiceWithTags(t *testing.T) {
 	updateServiceTest(t, flagSet, &config.CommandConfig{}, &utils.ECSParams{}, expectedInput, existingService, true)
 }
 
+func TestUpdateExistingServiceWithGitTags(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.GitCommitFlag, "4f2a7c1e9b", "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	taggingMock := mock_tagging.NewMockClient(ctrl)
+
+	newTaggingClient = func(commandConfig *config.CommandConfig) tagging.Client {
+		return taggingMock
+	}
+
+	serviceARN := "arn:aws:ecs:region:account-id:service/cluster-name/service-name"
+	serviceName := "test-service"
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String("arn/test-task-def"),
+		Status:         aws.String("ACTIVE"),
+		DesiredCount:   aws.Int64(0),
+		ServiceName:    aws.String(serviceName),
+		ServiceArn:     aws.String(serviceARN),
+	}
+
+	taggingMock.EXPECT().TagResources(gomock.Any()).Do(func(x interface{}) {
+		input := x.(*taggingSDK.TagResourcesInput)
+		assert.Equal(t, map[string]*string{"git-commit": aws.String("4f2a7c1e9b")}, input.Tags, "Expected the deployed commit to be tagged")
+		assert.Equal(t, serviceARN, aws.StringValue(input.ResourceARNList[0]))
+	}).Return(&taggingSDK.TagResourcesOutput{}, nil)
+
+	expectedInput := getDefaultUpdateInput()
+	expectedInput.serviceName = serviceName
+
+	updateServiceTest(t, flagSet, &config.CommandConfig{}, &utils.ECSParams{}, expectedInput, existingService, true)
+}
+
 func TestUpdateExistingServiceWithTagsError(t *testing.T) {
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 	flagSet.String(flags.ResourceTagsFlag, "everybodysworkingforthe=weekend", "")
@@ -1550,6 +2023,232 @@ func TestUpdateExistingServiceWithDesiredCountOverOne(t *testing.T) {
 	updateServiceTest(t, flagSet, &config.CommandConfig{}, &utils.ECSParams{}, expectedInput, existingService, true)
 }
 
+func TestUpdateExistingServiceWithPreserveDesiredCount(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.PreserveDesiredCountFlag, true, "")
+
+	serviceName := "test-service"
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String("arn/test-task-def"),
+		Status:         aws.String("ACTIVE"),
+		DesiredCount:   aws.Int64(3),
+		ServiceName:    aws.String(serviceName),
+	}
+
+	// the desired count is left to ECS, so changes made during the deploy aren't undone
+	expectedInput := getDefaultUpdateInput()
+	expectedInput.serviceName = serviceName
+	expectedInput.count = nil
+
+	updateServiceTest(t, flagSet, &config.CommandConfig{}, &utils.ECSParams{}, expectedInput, existingService, true)
+}
+
+func TestUpdateStoppedServiceWithPreserveDesiredCount(t *testing.T) {
+	testCases := map[string]struct {
+		scalableTarget *sdkautoscaling.ScalableTarget
+		expectedCount  *int64
+	}{
+		"without scalable target": {
+			expectedCount: aws.Int64(1),
+		},
+		"with scalable target": {
+			scalableTarget: &sdkautoscaling.ScalableTarget{MinCapacity: aws.Int64(0), MaxCapacity: aws.Int64(4)},
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			getServiceScalableTarget = func(serviceName string, config *config.CommandConfig) (*sdkautoscaling.ScalableTarget, error) {
+				assert.Equal(t, "test-service", serviceName, "Expected service name to match")
+				return test.scalableTarget, nil
+			}
+			defer func() { getServiceScalableTarget = applicationautoscaling.GetServiceScalableTarget }()
+
+			flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+			flagSet.Bool(flags.PreserveDesiredCountFlag, true, "")
+
+			existingService := &ecs.Service{
+				TaskDefinition: aws.String("arn/test-task-def"),
+				Status:         aws.String("ACTIVE"),
+				DesiredCount:   aws.Int64(0),
+				ServiceName:    aws.String("test-service"),
+			}
+
+			expectedInput := getDefaultUpdateInput()
+			expectedInput.serviceName = "test-service"
+			expectedInput.count = test.expectedCount
+
+			updateServiceTest(t, flagSet, &config.CommandConfig{}, &utils.ECSParams{}, expectedInput, existingService, true)
+		})
+	}
+}
+
+func TestUpdateExistingServiceWithScaleFlag(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.PreserveDesiredCountFlag, true, "")
+	flagSet.String(flags.ScaleFlag, "4", "")
+
+	serviceName := "test-service"
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String("arn/test-task-def"),
+		Status:         aws.String("ACTIVE"),
+		DesiredCount:   aws.Int64(2),
+		ServiceName:    aws.String(serviceName),
+	}
+
+	// an explicit --scale wins over --preserve-desired-count
+	expectedInput := getDefaultUpdateInput()
+	expectedInput.serviceName = serviceName
+	expectedInput.count = aws.Int64(4)
+
+	updateServiceTest(t, flagSet, &config.CommandConfig{}, &utils.ECSParams{}, expectedInput, existingService, true)
+}
+
+func TestLoadDesiredCount(t *testing.T) {
+	testCases := map[string]struct {
+		scale         string
+		desiredCounts map[string]int64
+		expected      *desiredCount
+		expectErr     bool
+	}{
+		"nothing configured": {},
+		"count for all services": {
+			scale:         "3",
+			desiredCounts: map[string]int64{"web": 5},
+			expected:      &desiredCount{count: 3, fromFlag: true},
+		},
+		"counts per service": {
+			scale:    "web=2, worker=2",
+			expected: &desiredCount{count: 2, fromFlag: true},
+		},
+		"ecs-params desired counts": {
+			desiredCounts: map[string]int64{"web": 4, "worker": 4},
+			expected:      &desiredCount{count: 4},
+		},
+		"flag overrides ecs-params": {
+			scale:         "web=6",
+			desiredCounts: map[string]int64{"web": 4, "worker": 6},
+			expected:      &desiredCount{count: 6, fromFlag: true},
+		},
+		"zero desired count": {
+			desiredCounts: map[string]int64{"worker": 0},
+			expected:      &desiredCount{count: 0},
+		},
+		"undeployed service is ignored": {
+			scale:    "web=2,db=1",
+			expected: &desiredCount{count: 2, fromFlag: true},
+		},
+		"different counts": {
+			scale:     "web=4,worker=2",
+			expectErr: true,
+		},
+		"different ecs-params desired counts": {
+			desiredCounts: map[string]int64{"web": 4, "worker": 2},
+			expectErr:     true,
+		},
+		"negative count": {
+			scale:     "-1",
+			expectErr: true,
+		},
+		"invalid count": {
+			scale:     "web=many",
+			expectErr: true,
+		},
+		"missing service name": {
+			scale:     "=2",
+			expectErr: true,
+		},
+		"negative ecs-params desired count": {
+			desiredCounts: map[string]int64{"web": -1},
+			expectErr:     true,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+			flagSet.String(flags.ScaleFlag, test.scale, "")
+
+			containerDefs := utils.ContainerDefs{}
+			for containerName, count := range test.desiredCounts {
+				containerDefs[containerName] = utils.ContainerDef{DesiredCount: aws.Int64(count)}
+			}
+			ecsContext := &context.ECSContext{
+				CLIContext: cli.NewContext(nil, flagSet, nil),
+				ECSParams: &utils.ECSParams{
+					TaskDefinition: utils.EcsTaskDef{ContainerDefinitions: containerDefs},
+				},
+			}
+			service := NewService(ecsContext).(*Service)
+			service.SetTaskDefinition(&ecs.TaskDefinition{
+				ContainerDefinitions: []*ecs.ContainerDefinition{
+					{Name: aws.String("web")},
+					{Name: aws.String("worker")},
+				},
+			})
+
+			count, err := service.loadDesiredCount()
+			if test.expectErr {
+				assert.Error(t, err, "Expected error loading the desired count")
+				return
+			}
+			assert.NoError(t, err, "Unexpected error loading the desired count")
+			assert.Equal(t, test.expected, count, "Expected desired count to match")
+		})
+	}
+}
+
+func TestCountForUpdateWithDesiredCount(t *testing.T) {
+	testCases := map[string]struct {
+		preserve     bool
+		desiredCount *desiredCount
+		currentCount int64
+		expected     *int64
+	}{
+		"ecs-params count replaces the current count": {
+			desiredCount: &desiredCount{count: 3},
+			currentCount: 5,
+			expected:     aws.Int64(3),
+		},
+		"preserved current count": {
+			preserve:     true,
+			desiredCount: &desiredCount{count: 3},
+			currentCount: 5,
+		},
+		"ecs-params count starts a stopped service": {
+			preserve:     true,
+			desiredCount: &desiredCount{count: 3},
+			expected:     aws.Int64(3),
+		},
+		"flag count replaces the preserved count": {
+			preserve:     true,
+			desiredCount: &desiredCount{count: 0, fromFlag: true},
+			currentCount: 5,
+			expected:     aws.Int64(0),
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			getServiceScalableTarget = func(serviceName string, config *config.CommandConfig) (*sdkautoscaling.ScalableTarget, error) {
+				return nil, nil
+			}
+			defer func() { getServiceScalableTarget = applicationautoscaling.GetServiceScalableTarget }()
+
+			flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+			flagSet.Bool(flags.PreserveDesiredCountFlag, test.preserve, "")
+			service := NewService(&context.ECSContext{CLIContext: cli.NewContext(nil, flagSet, nil)}).(*Service)
+			service.desiredCount = test.desiredCount
+
+			count := service.countForUpdate(&ecs.Service{
+				ServiceName:  aws.String("test-service"),
+				DesiredCount: aws.Int64(test.currentCount),
+			})
+			assert.Equal(t, test.expected, count, "Expected desired count to match")
+		})
+	}
+}
+
 func TestUpdateExistingServiceWithDaemonSchedulingStrategy(t *testing.T) {
 	// define test values
 	schedulingStrategy := ecs.SchedulingStrategyDaemon
@@ -1615,6 +2314,141 @@ func TestUpdateExistingServiceWithServiceDiscoveryFlag(t *testing.T) {
 	updateServiceTest(t, flagSet, &config.CommandConfig{}, &utils.ECSParams{}, expectedInput, existingService, true)
 }
 
+func TestUpdateExistingServiceWithDNSName(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.DNSNameFlag, "app.example.com", "")
+	flagSet.String(flags.HostedZoneIDFlag, "Z123", "")
+
+	targetGroupArn := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/web/6d0ecf831eec9f09"
+	getLoadBalancerForTargetGroup = func(arn string, config *config.CommandConfig) (*sdkelbv2.LoadBalancer, error) {
+		assert.Equal(t, targetGroupArn, arn, "Expected target group ARN to match")
+		return &sdkelbv2.LoadBalancer{
+			DNSName:               aws.String("web-1234567890.us-west-2.elb.amazonaws.com"),
+			CanonicalHostedZoneId: aws.String("Z1H1FL5HABSF5"),
+		}, nil
+	}
+	var upserted bool
+	upsertAliasRecord = func(hostedZoneID, name, targetDNSName, targetHostedZoneID string, config *config.CommandConfig) error {
+		upserted = true
+		assert.Equal(t, "Z123", hostedZoneID, "Expected hosted zone ID to match")
+		assert.Equal(t, "app.example.com", name, "Expected record name to match")
+		assert.Equal(t, "web-1234567890.us-west-2.elb.amazonaws.com", targetDNSName, "Expected alias target to be the load balancer")
+		assert.Equal(t, "Z1H1FL5HABSF5", targetHostedZoneID, "Expected alias target hosted zone to match")
+		return nil
+	}
+
+	// define existing service
+	serviceName := "test-service"
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String("arn/test-task-def"),
+		Status:         aws.String("ACTIVE"),
+		ServiceName:    aws.String(serviceName),
+		LoadBalancers: []*ecs.LoadBalancer{
+			&ecs.LoadBalancer{
+				TargetGroupArn: aws.String(targetGroupArn),
+				ContainerName:  aws.String("web"),
+				ContainerPort:  aws.Int64(80),
+			},
+		},
+	}
+
+	expectedInput := getDefaultUpdateInput()
+	expectedInput.serviceName = serviceName
+	// call tests
+	updateServiceTest(t, flagSet, &config.CommandConfig{}, &utils.ECSParams{}, expectedInput, existingService, true)
+	assert.True(t, upserted, "Expected alias record to be upserted")
+}
+
+func TestUpdateExistingServiceWithDNSNameWithoutTargetGroup(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.DNSNameFlag, "app.example.com", "")
+	flagSet.String(flags.HostedZoneIDFlag, "Z123", "")
+
+	upsertAliasRecord = func(hostedZoneID, name, targetDNSName, targetHostedZoneID string, config *config.CommandConfig) error {
+		assert.Fail(t, "Unexpected alias record upsert")
+		return nil
+	}
+
+	// define existing service
+	serviceName := "test-service"
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String("arn/test-task-def"),
+		Status:         aws.String("ACTIVE"),
+		ServiceName:    aws.String(serviceName),
+	}
+
+	expectedInput := getDefaultUpdateInput()
+	expectedInput.serviceName = serviceName
+	// call tests
+	updateServiceTest(t, flagSet, &config.CommandConfig{}, &utils.ECSParams{}, expectedInput, existingService, false)
+}
+
+func TestServiceUpWithDNSNameWithoutHostedZoneID(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.DNSNameFlag, "app.example.com", "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+	}
+	service := NewService(ecsContext)
+	err := service.LoadContext()
+	assert.NoError(t, err, "Unexpected error while loading context")
+
+	err = service.Up()
+	assert.Error(t, err, "Expected error when --dns-name is specified without --hosted-zone-id")
+}
+
+func TestServiceDownWithDNSName(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-rm", 0)
+	flagSet.String(flags.DNSNameFlag, "app.example.com", "")
+	flagSet.String(flags.HostedZoneIDFlag, "Z123", "")
+
+	var deleted bool
+	deleteAliasRecord = func(hostedZoneID, name string, config *config.CommandConfig) error {
+		deleted = true
+		assert.Equal(t, "Z123", hostedZoneID, "Expected hosted zone ID to match")
+		assert.Equal(t, "app.example.com", name, "Expected record name to match")
+		return nil
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	serviceName := "test-service"
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String("arn/test-task-def"),
+		Status:         aws.String("ACTIVE"),
+		ServiceName:    aws.String(serviceName),
+	}
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(serviceName).Return(getDescribeServiceTestResponse(existingService), nil),
+		mockEcs.EXPECT().DeleteService(serviceName).Return(nil),
+	)
+
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+	}
+	ecsContext.ProjectName = serviceName
+	service := NewService(ecsContext)
+	err := service.LoadContext()
+	assert.NoError(t, err, "Unexpected error while loading context")
+
+	err = service.Down()
+	assert.NoError(t, err, "Unexpected error on service down")
+	assert.True(t, deleted, "Expected alias record to be deleted")
+}
+
 ///////////////////////////////////////
 //  Update Service Helper functions  //
 ///////////////////////////////////////
@@ -1819,3 +2653,86 @@ func verifyTaskDefinitionInput(t *testing.T,
 	regInput *ecs.RegisterTaskDefinitionInput) {
 	assert.Equal(t, aws.StringValue(taskDef.Family), aws.StringValue(regInput.Family), "Task Definition family should match")
 }
+
+func TestServiceUpDryRunForNewService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskDefID := "taskDefinitionId"
+	_, taskDefinition, _ := getTestTaskDef(taskDefID)
+
+	// Only read calls are expected; RegisterTaskDefinition and CreateService must not be called
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(gomock.Any()).Return(nil, fmt.Errorf("Service %s", ecsMissingResourceCode)),
+		mockEcs.EXPECT().ListAccountSettings(gomock.Any()).Return(&ecs.ListAccountSettingsOutput{
+			Settings: []*ecs.Setting{
+				&ecs.Setting{
+					Value: aws.String(ecsSettingDisabled),
+					Name:  aws.String(ecs.SettingNameTaskLongArnFormat),
+				},
+			},
+		}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.DryRunFlag, true, "")
+	context := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+	}
+
+	service := NewService(context)
+	err := service.LoadContext()
+	assert.NoError(t, err, "Unexpected error while loading context in dry run test")
+
+	service.SetTaskDefinition(&taskDefinition)
+	err = service.Up()
+	assert.NoError(t, err, "Unexpected error during dry run")
+}
+
+func TestServiceUpDryRunForExistingService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskDefID := "taskDefinitionId"
+	_, taskDefinition, _ := getTestTaskDef(taskDefID)
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String("arn/test-task-def"),
+		Status:         aws.String(ecsActiveResourceCode),
+		DesiredCount:   aws.Int64(2),
+		ServiceName:    aws.String("test-service"),
+	}
+
+	// Only read calls are expected; RegisterTaskDefinition and UpdateService must not be called
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(existingService), nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Bool(flags.DryRunFlag, true, "")
+	context := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+	}
+
+	service := NewService(context)
+	err := service.LoadContext()
+	assert.NoError(t, err, "Unexpected error while loading context in dry run test")
+
+	service.SetTaskDefinition(&taskDefinition)
+	err = service.Up()
+	assert.NoError(t, err, "Unexpected error during dry run")
+}
+
+// allPublicSubnets mocks GetPublicSubnets for subnets which all route to an internet gateway
+func allPublicSubnets(subnetIDs []string) (map[string]bool, error) {
+	publicSubnets := make(map[string]bool)
+	for _, subnetID := range subnetIDs {
+		publicSubnets[subnetID] = true
+	}
+	return publicSubnets, nil
+}
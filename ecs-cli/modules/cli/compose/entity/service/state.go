@@ -0,0 +1,227 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/cache"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	log "github.com/sirupsen/logrus"
+)
+
+// projectState records the resources created for a compose project, so that
+// 'compose service rm' can clean them up even after the compose file or the
+// service name prefix has changed
+type projectState struct {
+	ServiceNames                 []string
+	TaskDefinitionArns           []string
+	ServiceDiscoveryServiceNames []string
+	LogGroups                    []projectLogGroup
+}
+
+// projectLogGroup is a CloudWatch log group created with --create-log-groups
+type projectLogGroup struct {
+	Name   string
+	Region string
+}
+
+// make the project state cache and log client factory easily mockable in tests
+var newProjectStateCache = setupProjectStateCache
+var newLogClientFactory = cloudwatchlogs.NewLogClientFactory
+
+// cluster ARNs contain characters that can't be used in a cache key
+var stateKeyReplacer = strings.NewReplacer("/", "_", ":", "_")
+
+// setupProjectStateCache finds a file system cache to store the resources created for each project
+func setupProjectStateCache() cache.Cache {
+	stateCache, err := cache.NewFSCache(composeutils.ProjectStateCache)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warn("Unable to create cache for project state; 'compose service rm' will only delete the current service")
+		stateCache = cache.NewNoopCache()
+	}
+	return stateCache
+}
+
+// stateKey returns the key of the project state, which is unique per cluster and project
+func (s *Service) stateKey() string {
+	return stateKeyReplacer.Replace(s.Context().CommandConfig.Cluster + "_" + entity.GetProjectName(s))
+}
+
+// loadState returns the recorded state of the project, or an empty one if nothing was recorded
+func (s *Service) loadState() *projectState {
+	state := &projectState{}
+	if err := s.stateCache.Get(s.stateKey(), state); err != nil {
+		log.WithFields(log.Fields{
+			"key":   s.stateKey(),
+			"error": err,
+		}).Debug("No project state found")
+		return &projectState{}
+	}
+	return state
+}
+
+// saveState records the state of the project. Failures are only logged, since the resources
+// themselves were created successfully.
+func (s *Service) saveState(state *projectState) {
+	if err := s.stateCache.Put(s.stateKey(), state); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warn("Could not record project state; 'compose service rm' may not delete every resource created for the project")
+	}
+}
+
+// recordResources adds the service, its task definition and the Service Discovery
+// resources and log groups created for it to the project state
+func (s *Service) recordResources() {
+	state := s.loadState()
+	serviceName := entity.GetServiceName(s)
+	cliContext := s.Context().CLIContext
+
+	state.ServiceNames = appendUnique(state.ServiceNames, serviceName)
+	state.TaskDefinitionArns = appendUnique(state.TaskDefinitionArns, aws.StringValue(s.TaskDefinition().TaskDefinitionArn))
+	if cliContext.Bool(flags.EnableServiceDiscoveryFlag) {
+		state.ServiceDiscoveryServiceNames = appendUnique(state.ServiceDiscoveryServiceNames, serviceName)
+	}
+	if cliContext.Bool(flags.CreateLogsFlag) {
+		for _, logGroup := range taskDefinitionLogGroups(s) {
+			state.LogGroups = appendUniqueLogGroup(state.LogGroups, logGroup)
+		}
+	}
+	s.saveState(state)
+}
+
+// deleteRecordedResources deletes the resources in the project state that were not already deleted
+// with the current service. Resources that could not be deleted are kept in the state, so that
+// running 'compose service rm' again retries them.
+func (s *Service) deleteRecordedResources(deletedServiceName string, serviceDiscoveryDeleted bool) {
+	state := s.loadState()
+	remaining := &projectState{}
+
+	for _, serviceName := range state.ServiceNames {
+		if serviceName == deletedServiceName {
+			continue
+		}
+		if err := s.deleteRecordedService(serviceName); err != nil {
+			log.WithFields(log.Fields{
+				"serviceName": serviceName,
+				"error":       err,
+			}).Warn("Unable to delete ECS Service recorded for the project")
+			remaining.ServiceNames = append(remaining.ServiceNames, serviceName)
+		}
+	}
+
+	for _, serviceName := range state.ServiceDiscoveryServiceNames {
+		if serviceName == deletedServiceName && serviceDiscoveryDeleted {
+			continue
+		}
+		if err := servicediscoveryDelete(serviceName, s.Context()); err != nil {
+			log.WithFields(log.Fields{
+				"serviceName": serviceName,
+				"error":       err,
+			}).Warn("Unable to delete Service Discovery resources recorded for the project")
+			remaining.ServiceDiscoveryServiceNames = append(remaining.ServiceDiscoveryServiceNames, serviceName)
+		}
+	}
+
+	for _, taskDefinitionArn := range state.TaskDefinitionArns {
+		if err := s.Context().ECSClient.DeregisterTaskDefinition(taskDefinitionArn); err != nil {
+			remaining.TaskDefinitionArns = append(remaining.TaskDefinitionArns, taskDefinitionArn)
+			continue
+		}
+		log.WithFields(log.Fields{
+			"taskDefinition": entity.GetIdFromArn(aws.String(taskDefinitionArn)),
+		}).Info("Deregistered task definition")
+	}
+
+	if s.Context().CLIContext.Bool(flags.DeleteLogsFlag) {
+		logClientFactory := newLogClientFactory(s.Context().CommandConfig)
+		for _, logGroup := range state.LogGroups {
+			if err := logClientFactory.Get(logGroup.Region).DeleteLogGroup(aws.String(logGroup.Name)); err != nil {
+				log.WithFields(log.Fields{
+					"logGroup": logGroup.Name,
+					"error":    err,
+				}).Warn("Unable to delete log group")
+				remaining.LogGroups = append(remaining.LogGroups, logGroup)
+				continue
+			}
+			log.Infof("Deleted Log Group %s in %s", logGroup.Name, logGroup.Region)
+		}
+	} else {
+		remaining.LogGroups = state.LogGroups
+	}
+
+	s.saveState(remaining)
+}
+
+// deleteRecordedService deletes an ECS Service that was previously created for the project,
+// e.g. under a different service name prefix. NoOp if the service no longer exists.
+func (s *Service) deleteRecordedService(serviceName string) error {
+	output, err := s.Context().ECSClient.DescribeService(serviceName)
+	if err != nil {
+		return err
+	}
+	if len(output.Services) == 0 {
+		return nil
+	}
+	_, err = s.deleteService(output.Services[0])
+	return err
+}
+
+// taskDefinitionLogGroups returns the awslogs log groups used by the containers of the task definition
+func taskDefinitionLogGroups(s *Service) []projectLogGroup {
+	var logGroups []projectLogGroup
+	for _, container := range s.TaskDefinition().ContainerDefinitions {
+		logConfig := container.LogConfiguration
+		if logConfig == nil || aws.StringValue(logConfig.LogDriver) != "awslogs" {
+			continue
+		}
+		name := aws.StringValue(logConfig.Options["awslogs-group"])
+		if name == "" {
+			continue
+		}
+		logGroups = append(logGroups, projectLogGroup{
+			Name:   name,
+			Region: aws.StringValue(logConfig.Options["awslogs-region"]),
+		})
+	}
+	return logGroups
+}
+
+func appendUnique(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+func appendUniqueLogGroup(logGroups []projectLogGroup, logGroup projectLogGroup) []projectLogGroup {
+	for _, existing := range logGroups {
+		if existing == logGroup {
+			return logGroups
+		}
+	}
+	return append(logGroups, logGroup)
+}
@@ -0,0 +1,178 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/servicediscovery"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
+	mock_cloudwatchlogs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs/mock"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/cache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func TestMain(m *testing.M) {
+	// keep the tests from recording project state in the user's cache directory
+	newProjectStateCache = cache.NewNoopCache
+	os.Exit(m.Run())
+}
+
+// memoryCache is an in-memory cache of project states
+type memoryCache map[string]projectState
+
+func (c memoryCache) Put(key string, val interface{}) error {
+	c[key] = *val.(*projectState)
+	return nil
+}
+
+func (c memoryCache) Get(key string, i interface{}) error {
+	state, ok := c[key]
+	if !ok {
+		return errors.New("not found")
+	}
+	*i.(*projectState) = state
+	return nil
+}
+
+func newStateTestService(ecsClient *mock_ecs.MockECSClient, flagSet *flag.FlagSet, stateCache cache.Cache) *Service {
+	ecsContext := &context.ECSContext{
+		ECSClient:     ecsClient,
+		CommandConfig: &config.CommandConfig{Cluster: "arn:aws:ecs:us-west-2:123456789012:cluster/default"},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+	}
+	ecsContext.ProjectName = "hello"
+	return &Service{
+		stateCache: stateCache,
+		ecsContext: ecsContext,
+	}
+}
+
+func TestServiceRecordResources(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.EnableServiceDiscoveryFlag, true, "")
+	flagSet.Bool(flags.CreateLogsFlag, true, "")
+
+	stateCache := memoryCache{}
+	service := newStateTestService(nil, flagSet, stateCache)
+	logConfig := &ecs.LogConfiguration{
+		LogDriver: aws.String("awslogs"),
+		Options: map[string]*string{
+			"awslogs-group":  aws.String("hello-logs"),
+			"awslogs-region": aws.String("us-west-2"),
+		},
+	}
+	service.SetTaskDefinition(&ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String(arnPrefix + "hello:1"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{Name: aws.String("web"), LogConfiguration: logConfig},
+			{Name: aws.String("worker"), LogConfiguration: logConfig},
+			{Name: aws.String("sidecar")},
+		},
+	})
+	service.recordResources()
+
+	service.SetTaskDefinition(&ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String(arnPrefix + "hello:2"),
+	})
+	service.recordResources()
+
+	state, ok := stateCache["arn_aws_ecs_us-west-2_123456789012_cluster_default_hello"]
+	assert.True(t, ok, "Expected state to be recorded under the cluster and project name")
+	assert.Equal(t, []string{"hello"}, state.ServiceNames, "Expected service names to match")
+	assert.Equal(t, []string{arnPrefix + "hello:1", arnPrefix + "hello:2"}, state.TaskDefinitionArns, "Expected task definitions to match")
+	assert.Equal(t, []string{"hello"}, state.ServiceDiscoveryServiceNames, "Expected Service Discovery service names to match")
+	assert.Equal(t, []projectLogGroup{{Name: "hello-logs", Region: "us-west-2"}}, state.LogGroups, "Expected log groups to match")
+}
+
+func TestServiceDeleteRecordedResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	oldService := &ecs.Service{
+		ServiceName: aws.String("old-hello"),
+		Status:      aws.String("INACTIVE"),
+	}
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService("old-hello").Return(getDescribeServiceTestResponse(oldService), nil),
+		mockEcs.EXPECT().DeregisterTaskDefinition(arnPrefix+"hello:1").Return(nil),
+		mockEcs.EXPECT().DeregisterTaskDefinition(arnPrefix+"hello:2").Return(errors.New("something went wrong")),
+	)
+
+	var sdDeleted []string
+	servicediscoveryDelete = func(serviceName string, c *context.ECSContext) error {
+		sdDeleted = append(sdDeleted, serviceName)
+		return nil
+	}
+	defer func() { servicediscoveryDelete = servicediscovery.Delete }()
+
+	logGroups := []projectLogGroup{{Name: "hello-logs", Region: "us-west-2"}}
+	stateCache := memoryCache{}
+	service := newStateTestService(mockEcs, flag.NewFlagSet("ecs-cli", 0), stateCache)
+	service.saveState(&projectState{
+		ServiceNames:                 []string{"old-hello", "hello"},
+		TaskDefinitionArns:           []string{arnPrefix + "hello:1", arnPrefix + "hello:2"},
+		ServiceDiscoveryServiceNames: []string{"old-hello", "hello"},
+		LogGroups:                    logGroups,
+	})
+
+	service.deleteRecordedResources("hello", true)
+
+	assert.Equal(t, []string{"old-hello"}, sdDeleted, "Expected only the Service Discovery resources of the old service to be deleted")
+	state := service.loadState()
+	assert.Empty(t, state.ServiceNames, "Expected no services to remain")
+	assert.Empty(t, state.ServiceDiscoveryServiceNames, "Expected no Service Discovery services to remain")
+	assert.Equal(t, []string{arnPrefix + "hello:2"}, state.TaskDefinitionArns, "Expected the task definition that failed to deregister to remain")
+	assert.Equal(t, logGroups, state.LogGroups, "Expected log groups to remain without --delete-log-groups")
+}
+
+func TestServiceDeleteRecordedLogGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogFactory := mock_cloudwatchlogs.NewMockLogClientFactory(ctrl)
+	mockLogClient := mock_cloudwatchlogs.NewMockClient(ctrl)
+
+	mockLogFactory.EXPECT().Get("us-west-2").Return(mockLogClient)
+	mockLogClient.EXPECT().DeleteLogGroup(aws.String("hello-logs")).Return(nil)
+
+	newLogClientFactory = func(*config.CommandConfig) cloudwatchlogs.LogClientFactory {
+		return mockLogFactory
+	}
+	defer func() { newLogClientFactory = cloudwatchlogs.NewLogClientFactory }()
+
+	flagSet := flag.NewFlagSet("ecs-cli-rm", 0)
+	flagSet.Bool(flags.DeleteLogsFlag, true, "")
+
+	stateCache := memoryCache{}
+	service := newStateTestService(nil, flagSet, stateCache)
+	service.saveState(&projectState{
+		LogGroups: []projectLogGroup{{Name: "hello-logs", Region: "us-west-2"}},
+	})
+
+	service.deleteRecordedResources("hello", false)
+
+	assert.Empty(t, service.loadState().LogGroups, "Expected log groups to be deleted")
+}
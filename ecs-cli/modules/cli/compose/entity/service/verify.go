@@ -0,0 +1,209 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	composecontainer "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/container"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// verifyRetryInterval is the time to wait between two rounds of checks
+	verifyRetryInterval = 10 * time.Second
+	// verifyRequestTimeout bounds each request, so that an unresponsive task does not use up the timeout
+	verifyRequestTimeout = 10 * time.Second
+)
+
+// verifyHTTPClient sends the requests of the checks; can be replaced in tests
+var verifyHTTPClient = &http.Client{Timeout: verifyRequestTimeout}
+
+// Verify checks that the service serves its primary deployment: once the deployments of the older
+// revisions are drained, it sends a GET request for urlPath to the load balancer of the service, or
+// to each running task of the primary deployment if the service has no load balancer, until they all
+// respond with expectStatus. It returns an error if they do not before the timeout expires.
+func (s *Service) Verify(urlPath string, expectStatus int, timeout time.Duration) error {
+	if timeout <= 0 {
+		return fmt.Errorf("Timeout must be greater than 0")
+	}
+	if !strings.HasPrefix(urlPath, "/") {
+		urlPath = "/" + urlPath
+	}
+
+	attempts := int(timeout/verifyRetryInterval) + 1
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			sleep(verifyRetryInterval)
+		}
+		var endpoints []string
+		if endpoints, err = s.verifyEndpoints(); err != nil {
+			log.Info(err)
+			continue
+		}
+		if err = checkEndpoints(endpoints, urlPath, expectStatus); err != nil {
+			log.Info(err)
+			continue
+		}
+		log.WithFields(log.Fields{
+			"endpoints": strings.Join(endpoints, ", "),
+			"status":    expectStatus,
+		}).Info("Service verified")
+		return nil
+	}
+	return fmt.Errorf("The service did not pass the checks within %s: %v", timeout, err)
+}
+
+// verifyEndpoints returns the base URLs to check: the URL of the load balancer of each target group
+// of the service, or else the address of each running task of the primary deployment. It returns an
+// error while older deployments are still active, as they could answer the requests instead.
+func (s *Service) verifyEndpoints() ([]string, error) {
+	ecsService, err := s.describeService()
+	if err != nil {
+		return nil, err
+	}
+	if len(ecsService.Deployments) > 1 {
+		return nil, fmt.Errorf("Waiting for the %d deployments of the service to complete", len(ecsService.Deployments))
+	}
+
+	var endpoints []string
+	for _, loadBalancer := range ecsService.LoadBalancers {
+		targetGroupArn := aws.StringValue(loadBalancer.TargetGroupArn)
+		if targetGroupArn == "" {
+			// Classic Load Balancers do not have target groups
+			continue
+		}
+		url, err := getServiceURL(targetGroupArn, s.Context().CommandConfig)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = appendUnique(endpoints, withScheme(url))
+	}
+	if len(endpoints) > 0 {
+		return endpoints, nil
+	}
+	return s.taskEndpoints(primaryTaskDefinition(ecsService))
+}
+
+// taskEndpoints returns the addresses of the running tasks of the task definition, on the host port
+// bound to the first TCP port of their first essential container with one. Non-essential containers
+// are skipped, as they are usually sidecars such as the tracing and metrics collectors.
+func (s *Service) taskEndpoints(taskDefinitionArn string) ([]string, error) {
+	taskDefinition, err := s.Context().ECSClient.DescribeTaskDefinition(taskDefinitionArn)
+	if err != nil {
+		return nil, err
+	}
+	containerName, containerPort := verifiedPort(taskDefinition)
+	if containerName == "" {
+		return nil, fmt.Errorf("No essential container of %s maps a TCP port to check", entity.GetIdFromArn(taskDefinition.TaskDefinitionArn))
+	}
+
+	containers, err := entity.Containers(s, false, ecs.DesiredStatusRunning)
+	if err != nil {
+		return nil, err
+	}
+	var endpoints []string
+	revision := entity.GetIdFromArn(taskDefinition.TaskDefinitionArn)
+	for i := range containers {
+		container := &containers[i]
+		if container.TaskDefinition() != revision || container.ContainerName() != containerName {
+			continue
+		}
+		if endpoint := containerEndpoint(container, containerPort); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("No running task of %s has an address to check yet", revision)
+	}
+	return endpoints, nil
+}
+
+// verifiedPort returns the first essential container of the task definition mapping a TCP port, and
+// that port
+func verifiedPort(taskDefinition *ecs.TaskDefinition) (string, int64) {
+	for _, containerDef := range taskDefinition.ContainerDefinitions {
+		if containerDef.Essential != nil && !aws.BoolValue(containerDef.Essential) {
+			continue
+		}
+		for _, portMapping := range containerDef.PortMappings {
+			protocol := aws.StringValue(portMapping.Protocol)
+			if protocol == "" || protocol == ecs.TransportProtocolTcp {
+				return aws.StringValue(containerDef.Name), aws.Int64Value(portMapping.ContainerPort)
+			}
+		}
+	}
+	return "", 0
+}
+
+// containerEndpoint returns the URL of the container port, or an empty string if the address of the
+// task or the host port is not known yet
+func containerEndpoint(container *composecontainer.Container, containerPort int64) string {
+	ip := container.EC2IPAddress
+	if ip == "" || strings.HasPrefix(ip, "(") {
+		// the IP address of a pending task with task networking is "(PENDING)"
+		return ""
+	}
+	hostPort := container.HostPort(containerPort)
+	if hostPort == 0 {
+		// with task networking, the host port of the mapping may be omitted
+		if container.PrivateIPAddress() == "" {
+			return ""
+		}
+		hostPort = containerPort
+	}
+	return fmt.Sprintf("http://%s:%d", ip, hostPort)
+}
+
+// checkEndpoints sends a GET request for the path to each endpoint, and returns an error describing
+// the endpoints that did not respond with the expected status
+func checkEndpoints(endpoints []string, urlPath string, expectStatus int) error {
+	var failures []string
+	for _, endpoint := range endpoints {
+		url := endpoint + urlPath
+		resp, err := verifyHTTPClient.Get(url)
+		if err != nil {
+			failures = append(failures, err.Error())
+			continue
+		}
+		// drain the body so that the connection can be reused by the next round
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != expectStatus {
+			failures = append(failures, fmt.Sprintf("GET %s returned %d, expected %d", url, resp.StatusCode, expectStatus))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("%d of %d checks failed: %s", len(failures), len(endpoints), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// withScheme returns the URL of the load balancer with the http scheme if it has none, as for the
+// listeners of Network Load Balancers
+func withScheme(url string) string {
+	if strings.Contains(url, "://") {
+		return url
+	}
+	return "http://" + url
+}
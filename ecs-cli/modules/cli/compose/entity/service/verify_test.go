@@ -0,0 +1,222 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/elbv2"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const verifyTargetGroupArn = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/web/6d0ecf831eec9f09"
+
+// newVerifyTestServer returns a server responding to /healthz with the given statuses in turn,
+// and then with the last one
+func newVerifyTestServer(t *testing.T, statuses ...int) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/healthz", r.URL.Path, "Expected the path of --url-path")
+		status := statuses[len(statuses)-1]
+		if requests < len(statuses) {
+			status = statuses[requests]
+		}
+		requests++
+		w.WriteHeader(status)
+	}))
+	return server, &requests
+}
+
+func loadBalancedService(deployments int) *ecs.DescribeServicesOutput {
+	ecsService := &ecs.Service{
+		LoadBalancers: []*ecs.LoadBalancer{
+			{TargetGroupArn: aws.String(verifyTargetGroupArn), ContainerName: aws.String("web"), ContainerPort: aws.Int64(80)},
+		},
+	}
+	for i := 0; i < deployments; i++ {
+		ecsService.Deployments = append(ecsService.Deployments, &ecs.Deployment{Status: aws.String(ecsPrimaryDeployment)})
+	}
+	return getDescribeServiceTestResponse(ecsService)
+}
+
+func TestVerifyLoadBalancer(t *testing.T) {
+	testCases := map[string]struct {
+		deployments []int
+		statuses    []int
+		timeout     time.Duration
+		requests    int
+		sleeps      int
+		expectedErr string
+	}{
+		"serving": {
+			deployments: []int{1},
+			statuses:    []int{http.StatusOK},
+			timeout:     time.Minute,
+			requests:    1,
+		},
+		"waits for the status": {
+			deployments: []int{1, 1, 1},
+			statuses:    []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			timeout:     time.Minute,
+			requests:    3,
+			sleeps:      2,
+		},
+		"waits for the older deployments to drain": {
+			deployments: []int{2, 1},
+			statuses:    []int{http.StatusOK},
+			timeout:     time.Minute,
+			requests:    1,
+			sleeps:      1,
+		},
+		"times out": {
+			deployments: []int{1, 1, 1},
+			statuses:    []int{http.StatusInternalServerError},
+			timeout:     25 * time.Second,
+			requests:    3,
+			sleeps:      2,
+			expectedErr: "returned 500, expected 200",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				getServiceURL = elbv2.GetServiceURL
+				sleep = time.Sleep
+			}()
+			server, requests := newVerifyTestServer(t, tc.statuses...)
+			defer server.Close()
+			getServiceURL = func(arn string, config *config.CommandConfig) (string, error) {
+				assert.Equal(t, verifyTargetGroupArn, arn, "Expected the target group of the service")
+				return server.URL, nil
+			}
+			sleeps := 0
+			sleep = func(d time.Duration) {
+				assert.Equal(t, verifyRetryInterval, d)
+				sleeps++
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEcs := mock_ecs.NewMockECSClient(ctrl)
+			var calls []*gomock.Call
+			for _, deployments := range tc.deployments {
+				calls = append(calls, mockEcs.EXPECT().DescribeService(gomock.Any()).Return(loadBalancedService(deployments), nil))
+			}
+			gomock.InOrder(calls...)
+
+			service := newHookTestService(mockEcs, composeutils.DeployHooks{})
+			err := service.Verify("healthz", http.StatusOK, tc.timeout)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			}
+			assert.Equal(t, tc.requests, *requests, "Unexpected number of requests")
+			assert.Equal(t, tc.sleeps, sleeps, "Unexpected number of retries")
+		})
+	}
+}
+
+func TestVerifyTasks(t *testing.T) {
+	defer func() { sleep = time.Sleep }()
+	sleep = func(d time.Duration) {
+		assert.Fail(t, "Unexpected retry")
+	}
+	server, requests := newVerifyTestServer(t, http.StatusOK)
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+	port, err := strconv.ParseInt(serverURL.Port(), 10, 64)
+	assert.NoError(t, err)
+
+	taskDefArn := arnPrefix + "hello:4"
+	taskDefinition := &ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String(taskDefArn),
+		NetworkMode:       aws.String(ecs.NetworkModeAwsvpc),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:         aws.String("aws-otel-collector"),
+				Essential:    aws.Bool(false),
+				PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(4317)}},
+			},
+			{
+				Name:         aws.String("web"),
+				PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(port), HostPort: aws.Int64(port)}},
+			},
+		},
+	}
+	task := func(taskDefinitionArn string) *ecs.Task {
+		return &ecs.Task{
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/1"),
+			TaskDefinitionArn: aws.String(taskDefinitionArn),
+			LastStatus:        aws.String(ecs.DesiredStatusRunning),
+			Containers: []*ecs.Container{
+				{Name: aws.String("web"), NetworkInterfaces: []*ecs.NetworkInterface{{PrivateIpv4Address: aws.String("127.0.0.1")}}},
+			},
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(&ecs.Service{
+			TaskDefinition: aws.String(taskDefArn),
+			Deployments:    []*ecs.Deployment{{Status: aws.String(ecsPrimaryDeployment), TaskDefinition: aws.String(taskDefArn)}},
+		}), nil),
+		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDefinition, nil),
+		mockEcs.EXPECT().GetTasksPages(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+			req := x.(*ecs.ListTasksInput)
+			assert.Equal(t, ecs.DesiredStatusRunning, aws.StringValue(req.DesiredStatus), "Expected the running tasks")
+			y.(ecsclient.ProcessTasksAction)([]*ecs.Task{task(taskDefArn)})
+		}).Return(nil),
+		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDefinition, nil),
+	)
+
+	service := newHookTestService(mockEcs, composeutils.DeployHooks{})
+	err = service.Verify("/healthz", http.StatusOK, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *requests, "Expected the task to be requested")
+}
+
+func TestVerifyInvalidTimeout(t *testing.T) {
+	service := newHookTestService(nil, composeutils.DeployHooks{})
+	err := service.Verify("/", http.StatusOK, 0)
+	assert.Error(t, err, "Expected error with a timeout of 0")
+}
+
+func TestVerifiedPort(t *testing.T) {
+	name, port := verifiedPort(&ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{Name: aws.String("sidecar"), Essential: aws.Bool(false), PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(4317)}}},
+			{Name: aws.String("dns"), PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(53), Protocol: aws.String(ecs.TransportProtocolUdp)}}},
+			{Name: aws.String("web"), PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(8080)}, {ContainerPort: aws.Int64(9090)}}},
+		},
+	})
+	assert.Equal(t, "web", name)
+	assert.Equal(t, int64(8080), port)
+}
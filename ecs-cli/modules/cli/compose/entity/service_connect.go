@@ -0,0 +1,148 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// invalidServiceConnectNameChars are the characters replaced in the port and discovery names
+// derived from the container names, which may only contain lowercase letters, digits, hyphens
+// and underscores
+var invalidServiceConnectNameChars = regexp.MustCompile(`[^a-z0-9_-]`)
+
+// NameServiceConnectPortMappings names the port mappings of the request that the services of
+// the service_connect section of the ecs-params file refer to
+func NameServiceConnectPortMappings(entity ProjectEntity, request *ecs.RegisterTaskDefinitionInput) error {
+	serviceConnect, err := validServiceConnect(entity)
+	if err != nil || serviceConnect == nil {
+		return err
+	}
+	for _, service := range serviceConnect.Services {
+		for _, containerDef := range request.ContainerDefinitions {
+			if aws.StringValue(containerDef.Name) != service.ContainerName {
+				continue
+			}
+			for _, portMapping := range containerDef.PortMappings {
+				if aws.Int64Value(portMapping.ContainerPort) == service.ContainerPort {
+					portMapping.Name = aws.String(serviceConnectPortName(service))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ServiceConnectConfiguration returns the Service Connect configuration of the service from
+// the service_connect section of the ecs-params file, or nil if there is none
+func ServiceConnectConfiguration(entity ProjectEntity) (*ecs.ServiceConnectConfiguration, error) {
+	serviceConnect, err := validServiceConnect(entity)
+	if err != nil || serviceConnect == nil {
+		return nil, err
+	}
+	config := &ecs.ServiceConnectConfiguration{Enabled: aws.Bool(true)}
+	if serviceConnect.Namespace != "" {
+		config.Namespace = aws.String(serviceConnect.Namespace)
+	}
+	for _, service := range serviceConnect.Services {
+		connectService := &ecs.ServiceConnectService{
+			PortName:      aws.String(serviceConnectPortName(service)),
+			DiscoveryName: aws.String(serviceConnectDiscoveryName(service)),
+		}
+		for _, alias := range service.ClientAliases {
+			clientAlias := &ecs.ServiceConnectClientAlias{Port: aws.Int64(alias.Port)}
+			if alias.DNSName != "" {
+				clientAlias.DnsName = aws.String(alias.DNSName)
+			}
+			connectService.ClientAliases = append(connectService.ClientAliases, clientAlias)
+		}
+		config.Services = append(config.Services, connectService)
+	}
+	return config, nil
+}
+
+// validServiceConnect returns the service_connect section of the ecs-params file after checking
+// it against the task definition, or nil if there is none
+func validServiceConnect(entity ProjectEntity) (*composeutils.ServiceConnect, error) {
+	ecsParams := entity.Context().ECSParams
+	if ecsParams == nil || ecsParams.RunParams.ServiceConnect == nil {
+		return nil, nil
+	}
+	serviceConnect := ecsParams.RunParams.ServiceConnect
+	taskDefinition := entity.TaskDefinition()
+
+	networkMode := aws.StringValue(taskDefinition.NetworkMode)
+	if networkMode != "" && networkMode != ecs.NetworkModeAwsvpc && networkMode != ecs.NetworkModeBridge {
+		return nil, fmt.Errorf("Service Connect requires the awsvpc or bridge network mode, not %s", networkMode)
+	}
+
+	discoveryNames := make(map[string]bool)
+	for _, service := range serviceConnect.Services {
+		if service.ContainerName == "" || service.ContainerPort == 0 {
+			return nil, fmt.Errorf("The services of service_connect require a container_name and a container_port")
+		}
+		if !hasPortMapping(taskDefinition, service.ContainerName, service.ContainerPort) {
+			return nil, fmt.Errorf("Container %s of service_connect does not map port %d", service.ContainerName, service.ContainerPort)
+		}
+		discoveryName := serviceConnectDiscoveryName(service)
+		if discoveryNames[discoveryName] {
+			return nil, fmt.Errorf("Several services of service_connect use the discovery name %s. Set the discovery_name of each port of container %s", discoveryName, service.ContainerName)
+		}
+		discoveryNames[discoveryName] = true
+		for _, alias := range service.ClientAliases {
+			if alias.Port == 0 {
+				return nil, fmt.Errorf("The client aliases of the %s service of service_connect require a port", discoveryName)
+			}
+		}
+	}
+	return serviceConnect, nil
+}
+
+func hasPortMapping(taskDefinition *ecs.TaskDefinition, containerName string, containerPort int64) bool {
+	for _, containerDef := range taskDefinition.ContainerDefinitions {
+		if aws.StringValue(containerDef.Name) != containerName {
+			continue
+		}
+		for _, portMapping := range containerDef.PortMappings {
+			if aws.Int64Value(portMapping.ContainerPort) == containerPort {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// serviceConnectPortName returns the name of the port mapping of the service, e.g. web-8080
+func serviceConnectPortName(service composeutils.ServiceConnectService) string {
+	return fmt.Sprintf("%s-%d", serviceConnectName(service.ContainerName), service.ContainerPort)
+}
+
+// serviceConnectDiscoveryName returns the discovery name of the service, which defaults to the
+// name of its container
+func serviceConnectDiscoveryName(service composeutils.ServiceConnectService) string {
+	if service.DiscoveryName != "" {
+		return service.DiscoveryName
+	}
+	return serviceConnectName(service.ContainerName)
+}
+
+func serviceConnectName(containerName string) string {
+	return strings.TrimLeft(invalidServiceConnectNameChars.ReplaceAllString(strings.ToLower(containerName), "-"), "-")
+}
@@ -0,0 +1,194 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/mock"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupServiceConnectEntity(t *testing.T, networkMode string, serviceConnect *composeutils.ServiceConnect) (*mock_entity.MockProjectEntity, func()) {
+	ctrl := gomock.NewController(t)
+	mockEntity := mock_entity.NewMockProjectEntity(ctrl)
+
+	ecsParams := &composeutils.ECSParams{}
+	ecsParams.RunParams.ServiceConnect = serviceConnect
+	mockEntity.EXPECT().Context().Return(&context.ECSContext{ECSParams: ecsParams}).AnyTimes()
+	mockEntity.EXPECT().TaskDefinition().Return(&ecs.TaskDefinition{
+		NetworkMode: aws.String(networkMode),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name: aws.String("web"),
+				PortMappings: []*ecs.PortMapping{
+					{ContainerPort: aws.Int64(8080)},
+					{ContainerPort: aws.Int64(9090)},
+				},
+			},
+			{
+				Name:         aws.String("Admin_API"),
+				PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(3000)}},
+			},
+		},
+	}).AnyTimes()
+	return mockEntity, ctrl.Finish
+}
+
+// namedPortMappings names the port mappings of a request for the task definition of the entity,
+// and returns the names by container and port
+func namedPortMappings(mockEntity *mock_entity.MockProjectEntity) (map[string]string, error) {
+	request := &ecs.RegisterTaskDefinitionInput{ContainerDefinitions: mockEntity.TaskDefinition().ContainerDefinitions}
+	if err := NameServiceConnectPortMappings(mockEntity, request); err != nil {
+		return nil, err
+	}
+	names := make(map[string]string)
+	for _, containerDef := range request.ContainerDefinitions {
+		for _, portMapping := range containerDef.PortMappings {
+			if portMapping.Name != nil {
+				names[fmt.Sprintf("%s:%d", aws.StringValue(containerDef.Name), aws.Int64Value(portMapping.ContainerPort))] = aws.StringValue(portMapping.Name)
+			}
+		}
+	}
+	return names, nil
+}
+
+func TestServiceConnectConfiguration(t *testing.T) {
+	mockEntity, teardown := setupServiceConnectEntity(t, ecs.NetworkModeAwsvpc, &composeutils.ServiceConnect{
+		Namespace: "internal",
+		Services: []composeutils.ServiceConnectService{
+			{
+				ContainerName: "web",
+				ContainerPort: 8080,
+				ClientAliases: []composeutils.ServiceConnectClientAlias{{Port: 80, DNSName: "web.internal"}},
+			},
+			{
+				ContainerName: "web",
+				ContainerPort: 9090,
+				DiscoveryName: "web-metrics",
+			},
+			{
+				ContainerName: "Admin_API",
+				ContainerPort: 3000,
+				ClientAliases: []composeutils.ServiceConnectClientAlias{{Port: 3000}},
+			},
+		},
+	})
+	defer teardown()
+
+	config, err := ServiceConnectConfiguration(mockEntity)
+	assert.NoError(t, err)
+	assert.Equal(t, &ecs.ServiceConnectConfiguration{
+		Enabled:   aws.Bool(true),
+		Namespace: aws.String("internal"),
+		Services: []*ecs.ServiceConnectService{
+			{
+				PortName:      aws.String("web-8080"),
+				DiscoveryName: aws.String("web"),
+				ClientAliases: []*ecs.ServiceConnectClientAlias{{Port: aws.Int64(80), DnsName: aws.String("web.internal")}},
+			},
+			{
+				PortName:      aws.String("web-9090"),
+				DiscoveryName: aws.String("web-metrics"),
+			},
+			{
+				PortName:      aws.String("admin_api-3000"),
+				DiscoveryName: aws.String("admin_api"),
+				ClientAliases: []*ecs.ServiceConnectClientAlias{{Port: aws.Int64(3000)}},
+			},
+		},
+	}, config)
+
+	portNames, err := namedPortMappings(mockEntity)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"web:8080":       "web-8080",
+		"web:9090":       "web-9090",
+		"Admin_API:3000": "admin_api-3000",
+	}, portNames)
+}
+
+func TestServiceConnectConfigurationClientOnly(t *testing.T) {
+	mockEntity, teardown := setupServiceConnectEntity(t, ecs.NetworkModeBridge, &composeutils.ServiceConnect{})
+	defer teardown()
+
+	config, err := ServiceConnectConfiguration(mockEntity)
+	assert.NoError(t, err)
+	assert.Equal(t, &ecs.ServiceConnectConfiguration{Enabled: aws.Bool(true)}, config)
+
+	portNames, err := namedPortMappings(mockEntity)
+	assert.NoError(t, err)
+	assert.Empty(t, portNames)
+}
+
+func TestServiceConnectConfigurationWithoutServiceConnect(t *testing.T) {
+	mockEntity, teardown := setupServiceConnectEntity(t, ecs.NetworkModeAwsvpc, nil)
+	defer teardown()
+
+	config, err := ServiceConnectConfiguration(mockEntity)
+	assert.NoError(t, err)
+	assert.Nil(t, config)
+}
+
+func TestServiceConnectConfigurationErrors(t *testing.T) {
+	tests := map[string]struct {
+		networkMode string
+		services    []composeutils.ServiceConnectService
+	}{
+		"host network mode": {
+			networkMode: ecs.NetworkModeHost,
+		},
+		"missing container port": {
+			networkMode: ecs.NetworkModeAwsvpc,
+			services:    []composeutils.ServiceConnectService{{ContainerName: "web"}},
+		},
+		"unmapped port": {
+			networkMode: ecs.NetworkModeAwsvpc,
+			services:    []composeutils.ServiceConnectService{{ContainerName: "web", ContainerPort: 8081}},
+		},
+		"duplicate discovery name": {
+			networkMode: ecs.NetworkModeAwsvpc,
+			services: []composeutils.ServiceConnectService{
+				{ContainerName: "web", ContainerPort: 8080},
+				{ContainerName: "web", ContainerPort: 9090},
+			},
+		},
+		"client alias without port": {
+			networkMode: ecs.NetworkModeAwsvpc,
+			services: []composeutils.ServiceConnectService{{
+				ContainerName: "web",
+				ContainerPort: 8080,
+				ClientAliases: []composeutils.ServiceConnectClientAlias{{DNSName: "web.internal"}},
+			}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockEntity, teardown := setupServiceConnectEntity(t, test.networkMode, &composeutils.ServiceConnect{Services: test.services})
+			defer teardown()
+
+			_, err := ServiceConnectConfiguration(mockEntity)
+			assert.Error(t, err)
+			_, err = namedPortMappings(mockEntity)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"fmt"
+	"strings"
+
+	iamclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// newIAMClient can be replaced in tests
+var newIAMClient = iamclient.NewIAMClient
+
+// EnsureSidecarPermissions attaches the AWS managed policies needed by the tracing and metrics
+// sidecars of the ecs-params file to the task role, so that they can send the traces and metrics.
+func EnsureSidecarPermissions(entity ProjectEntity) error {
+	ecsParams := entity.Context().ECSParams
+	if ecsParams == nil {
+		return nil
+	}
+	policies := composeutils.SidecarPolicies(ecsParams.TaskDefinition)
+	if len(policies) == 0 {
+		return nil
+	}
+
+	taskRole := aws.StringValue(entity.TaskDefinition().TaskRoleArn)
+	if taskRole == "" {
+		return fmt.Errorf("The tracing and metrics sidecars need a task role to send their data. Set task_role_arn in the ECS params file or use --%s", flags.TaskRoleArnFlag)
+	}
+	roleName, partition := taskRole, endpoints.AwsPartitionID
+	if roleARN, err := arn.Parse(taskRole); err == nil {
+		// the name of a role is the last element of its path, e.g. role/service/my-role
+		roleName = roleARN.Resource[strings.LastIndex(roleARN.Resource, "/")+1:]
+		partition = roleARN.Partition
+	}
+
+	client := newIAMClient(entity.Context().CommandConfig)
+	for _, policy := range policies {
+		policyArn := fmt.Sprintf("arn:%s:iam::aws:policy/%s", partition, policy)
+		// attaching a policy which is already attached to the role is a no-op
+		if _, err := client.AttachRolePolicy(policyArn, roleName); err != nil {
+			return errors.Wrapf(err, "Error attaching the %s policy needed by the sidecars to the task role %s", policy, roleName)
+		}
+		log.WithFields(log.Fields{
+			"policy": policy,
+			"role":   roleName,
+		}).Debug("Attached sidecar policy to the task role")
+	}
+	return nil
+}
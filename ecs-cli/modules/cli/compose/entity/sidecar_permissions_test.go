@@ -0,0 +1,124 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/mock"
+	iamclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/iam/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupSidecarEntity(t *testing.T, tracing string, metrics *composeutils.Metrics, taskRoleArn string) (*mock_entity.MockProjectEntity, *mock_iam.MockClient, func()) {
+	ctrl := gomock.NewController(t)
+	mockEntity := mock_entity.NewMockProjectEntity(ctrl)
+	mockIAM := mock_iam.NewMockClient(ctrl)
+
+	ecsParams := &composeutils.ECSParams{}
+	ecsParams.TaskDefinition.Tracing = tracing
+	ecsParams.TaskDefinition.Metrics = metrics
+	mockEntity.EXPECT().Context().Return(&context.ECSContext{
+		CommandConfig: &config.CommandConfig{},
+		ECSParams:     ecsParams,
+	}).AnyTimes()
+	mockEntity.EXPECT().TaskDefinition().Return(&ecs.TaskDefinition{TaskRoleArn: aws.String(taskRoleArn)}).AnyTimes()
+
+	oldNewIAMClient := newIAMClient
+	newIAMClient = func(*config.CommandConfig) iamclient.Client {
+		return mockIAM
+	}
+	return mockEntity, mockIAM, func() {
+		newIAMClient = oldNewIAMClient
+		ctrl.Finish()
+	}
+}
+
+func TestEnsureSidecarPermissionsXRay(t *testing.T) {
+	mockEntity, mockIAM, teardown := setupSidecarEntity(t, composeutils.TracingXRay, nil, "arn:aws:iam::123456789012:role/service/web-task-role")
+	defer teardown()
+
+	mockIAM.EXPECT().AttachRolePolicy("arn:aws:iam::aws:policy/AWSXRayDaemonWriteAccess", "web-task-role").Return(&iam.AttachRolePolicyOutput{}, nil)
+
+	assert.NoError(t, EnsureSidecarPermissions(mockEntity))
+}
+
+func TestEnsureSidecarPermissionsOTELInOtherPartition(t *testing.T) {
+	mockEntity, mockIAM, teardown := setupSidecarEntity(t, composeutils.TracingOTEL, nil, "arn:aws-cn:iam::123456789012:role/web-task-role")
+	defer teardown()
+
+	gomock.InOrder(
+		mockIAM.EXPECT().AttachRolePolicy("arn:aws-cn:iam::aws:policy/AWSXRayDaemonWriteAccess", "web-task-role").Return(&iam.AttachRolePolicyOutput{}, nil),
+		mockIAM.EXPECT().AttachRolePolicy("arn:aws-cn:iam::aws:policy/CloudWatchAgentServerPolicy", "web-task-role").Return(&iam.AttachRolePolicyOutput{}, nil),
+	)
+
+	assert.NoError(t, EnsureSidecarPermissions(mockEntity))
+}
+
+func TestEnsureSidecarPermissionsRoleName(t *testing.T) {
+	mockEntity, mockIAM, teardown := setupSidecarEntity(t, composeutils.TracingXRay, nil, "web-task-role")
+	defer teardown()
+
+	mockIAM.EXPECT().AttachRolePolicy("arn:aws:iam::aws:policy/AWSXRayDaemonWriteAccess", "web-task-role").Return(&iam.AttachRolePolicyOutput{}, nil)
+
+	assert.NoError(t, EnsureSidecarPermissions(mockEntity))
+}
+
+func TestEnsureSidecarPermissionsWithoutTaskRole(t *testing.T) {
+	mockEntity, _, teardown := setupSidecarEntity(t, composeutils.TracingXRay, nil, "")
+	defer teardown()
+
+	err := EnsureSidecarPermissions(mockEntity)
+	assert.EqualError(t, err, "The tracing and metrics sidecars need a task role to send their data. Set task_role_arn in the ECS params file or use --task-role-arn")
+}
+
+func TestEnsureSidecarPermissionsAttachError(t *testing.T) {
+	mockEntity, mockIAM, teardown := setupSidecarEntity(t, composeutils.TracingXRay, nil, "arn:aws:iam::123456789012:role/web-task-role")
+	defer teardown()
+
+	mockIAM.EXPECT().AttachRolePolicy(gomock.Any(), gomock.Any()).Return(nil, errors.New("AccessDenied"))
+
+	err := EnsureSidecarPermissions(mockEntity)
+	assert.EqualError(t, err, "Error attaching the AWSXRayDaemonWriteAccess policy needed by the sidecars to the task role web-task-role: AccessDenied")
+}
+
+func TestEnsureSidecarPermissionsMetrics(t *testing.T) {
+	mockEntity, mockIAM, teardown := setupSidecarEntity(t, composeutils.TracingOTEL, &composeutils.Metrics{}, "arn:aws:iam::123456789012:role/web-task-role")
+	defer teardown()
+
+	// the policy needed by both sidecars is only attached once
+	gomock.InOrder(
+		mockIAM.EXPECT().AttachRolePolicy("arn:aws:iam::aws:policy/AWSXRayDaemonWriteAccess", "web-task-role").Return(&iam.AttachRolePolicyOutput{}, nil),
+		mockIAM.EXPECT().AttachRolePolicy("arn:aws:iam::aws:policy/CloudWatchAgentServerPolicy", "web-task-role").Return(&iam.AttachRolePolicyOutput{}, nil),
+	)
+
+	assert.NoError(t, EnsureSidecarPermissions(mockEntity))
+}
+
+func TestEnsureSidecarPermissionsWithoutSidecars(t *testing.T) {
+	mockEntity, _, teardown := setupSidecarEntity(t, "", nil, "arn:aws:iam::123456789012:role/web-task-role")
+	defer teardown()
+
+	// the IAM mock fails the test if it is called
+	assert.NoError(t, EnsureSidecarPermissions(mockEntity))
+}
@@ -15,6 +15,7 @@ package task
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
@@ -193,6 +194,31 @@ func (t *Task) Run(commandOverrides map[string][]string) error {
 	return t.waitForRunTasks(ecsTasks.Tasks)
 }
 
+// Restart is not supported for tasks; tasks are replaced by running them again
+func (t *Task) Restart() error {
+	return composeutils.ErrUnsupported
+}
+
+// History is not supported for tasks, which are not deployed like services
+func (t *Task) History(limit int) (project.InfoSet, error) {
+	return nil, composeutils.ErrUnsupported
+}
+
+// Diff is not supported for tasks, which have no running task definition to compare with
+func (t *Task) Diff(revision string) error {
+	return composeutils.ErrUnsupported
+}
+
+// Verify is not supported for tasks, which are not deployed like services
+func (t *Task) Verify(urlPath string, expectStatus int, timeout time.Duration) error {
+	return composeutils.ErrUnsupported
+}
+
+// Rebalance is not supported for tasks, which are not replaced by a scheduler once stopped
+func (t *Task) Rebalance(fix bool) error {
+	return composeutils.ErrUnsupported
+}
+
 // Stop gets all the running tasks and issues ECS StopTask command to them
 // and waits until they stop
 func (t *Task) Stop() error {
@@ -310,6 +336,12 @@ func (t *Task) buildRunTaskInput(taskDefinition string, count int, overrides map
 	launchType := t.Context().CommandConfig.LaunchType
 	group := entity.GetTaskGroup(t)
 
+	if err := entity.ResolveNetworkConfiguration(t); err != nil {
+		return nil, err
+	}
+	if err := entity.ValidateNetworkResources(t, count); err != nil {
+		return nil, err
+	}
 	ecsParams := t.ecsContext.ECSParams
 	networkConfig, err := composeutils.ConvertToECSNetworkConfiguration(ecsParams)
 
@@ -430,6 +462,9 @@ func (t *Task) up(forceUpdate bool) error {
 	if err != nil {
 		return err
 	}
+	if entity.IsDryRun(t) {
+		return t.dryRunUp(ecsTasks)
+	}
 	_, err = entity.GetOrCreateTaskDefinition(t)
 	if err != nil {
 		return err
@@ -491,6 +526,33 @@ func (t *Task) up(forceUpdate bool) error {
 	return nil
 }
 
+// dryRunUp prints the requests that up would send to ECS without executing them
+func (t *Task) dryRunUp(ecsTasks []*ecs.Task) error {
+	taskDefFamily, err := entity.DryRunRegisterTaskDefinition(t)
+	if err != nil {
+		return err
+	}
+
+	count := 1
+	if len(ecsTasks) > 0 {
+		// Without registering the task definition we cannot tell whether it changed,
+		// so show the requests used to replace the running tasks.
+		log.WithFields(log.Fields{
+			"CountOfTasks": len(ecsTasks),
+		}).Info("Dry run: running tasks would be stopped and replaced if the task definition changed or --force-update was specified")
+		count = len(ecsTasks)
+		if count > 10 {
+			count = 10 // can issue only up to 10 tasks in a RunTask Call
+		}
+	}
+
+	runTaskInput, err := t.buildRunTaskInput(taskDefFamily, count, nil)
+	if err != nil {
+		return err
+	}
+	return entity.PrintDryRunRequest("RunTask", runTaskInput)
+}
+
 func (t *Task) GetTags() ([]*ecs.Tag, error) {
 	if t.tags == nil {
 		tags := make([]*ecs.Tag, 0)
@@ -501,6 +563,10 @@ func (t *Task) GetTags() ([]*ecs.Tag, error) {
 				return nil, err
 			}
 		}
+		tags, err := entity.AppendGitTags(t, tags)
+		if err != nil {
+			return nil, err
+		}
 		t.tags = tags
 
 	}
@@ -19,11 +19,13 @@ import (
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
 	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	utils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -398,8 +400,12 @@ func TestBuildRunTaskInput_EFSFargate(t *testing.T) {
 	cliContext := cli.NewContext(nil, flagSet, nil)
 	ctrl := gomock.NewController(t)
 	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+	mockEC2.EXPECT().GetPublicSubnets([]string{"sg-bafff1ed", "sg-c0ffeefe"}).Return(map[string]bool{"sg-bafff1ed": true, "sg-c0ffeefe": true}, nil)
+	mockEC2.EXPECT().DescribeSubnets([]string{"sg-bafff1ed", "sg-c0ffeefe"}).Return(efsVolumeSubnets(), nil)
 	context := &context.ECSContext{
 		ECSClient:  mockEcs,
+		EC2Client:  mockEC2,
 		CLIContext: cliContext,
 		ECSParams:  ecsParamsWithEFSVolume(),
 		CommandConfig: &config.CommandConfig{
@@ -434,8 +440,11 @@ func TestBuildRunTaskInput_EFSEC2(t *testing.T) {
 	cliContext := cli.NewContext(nil, flagSet, nil)
 	ctrl := gomock.NewController(t)
 	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+	mockEC2.EXPECT().DescribeSubnets([]string{"sg-bafff1ed", "sg-c0ffeefe"}).Return(efsVolumeSubnets(), nil)
 	context := &context.ECSContext{
 		ECSClient:  mockEcs,
+		EC2Client:  mockEC2,
 		CLIContext: cliContext,
 		ECSParams:  ecsParamsWithEFSVolume(),
 		CommandConfig: &config.CommandConfig{
@@ -459,6 +468,15 @@ func TestBuildRunTaskInput_EFSEC2(t *testing.T) {
 		assert.Nil(t, req.Overrides)
 	}
 }
+
+// efsVolumeSubnets returns the subnets of the network configuration of ecsParamsWithEFSVolume
+func efsVolumeSubnets() []*ec2.Subnet {
+	return []*ec2.Subnet{
+		{SubnetId: aws.String("sg-bafff1ed"), VpcId: aws.String("vpc-feedface"), AvailableIpAddressCount: aws.Int64(10)},
+		{SubnetId: aws.String("sg-c0ffeefe"), VpcId: aws.String("vpc-feedface"), AvailableIpAddressCount: aws.Int64(10)},
+	}
+}
+
 func ecsParamsWithEFSVolume() *utils.ECSParams {
 	return &utils.ECSParams{
 		TaskDefinition: utils.EcsTaskDef{
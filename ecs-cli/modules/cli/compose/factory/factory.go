@@ -14,8 +14,11 @@
 package factory
 
 import (
+	"fmt"
+
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
 
@@ -26,6 +29,7 @@ import (
 // ProjectFactory is an interface that surfaces a function to create ECS Compose Project (intended to make mocking easy in tests)
 type ProjectFactory interface {
 	Create(cliContext *cli.Context, isService bool) (project.Project, error)
+	CreateNamed(cliContext *cli.Context, isService bool) (project.Project, error)
 }
 
 // projectFactory implements ProjectFactory interface
@@ -57,6 +61,29 @@ func (projectFactory projectFactory) Create(cliContext *cli.Context, isService b
 }
 
 // populateContext sets the required CLI arguments to the ECS context
+// CreateNamed creates the project named with --project-name without reading its compose files, for
+// the commands which only delete the resources of the project
+func (projectFactory projectFactory) CreateNamed(cliContext *cli.Context, isService bool) (project.Project, error) {
+	ecsContext := &context.ECSContext{}
+	if err := projectFactory.populateContext(ecsContext, cliContext); err != nil {
+		return nil, err
+	}
+	ecsContext.IsService = isService
+	ecsContext.ProjectName = cliContext.GlobalString(flags.ProjectNameFlag)
+	if ecsContext.ProjectName == "" {
+		return nil, fmt.Errorf("The project must be named with --%s when its compose files do not exist", flags.ProjectNameFlag)
+	}
+
+	project := project.NewProject(ecsContext)
+	if err := ecsContext.Open(); err != nil {
+		return nil, err
+	}
+	if err := project.Entity().LoadContext(); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
 func (projectFactory projectFactory) populateContext(ecsContext *context.ECSContext, cliContext *cli.Context) error {
 	/*
 		Populate the following libcompose fields on the ECS context:
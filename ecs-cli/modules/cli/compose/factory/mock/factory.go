@@ -62,3 +62,18 @@ func (mr *MockProjectFactoryMockRecorder) Create(arg0, arg1 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectFactory)(nil).Create), arg0, arg1)
 }
+
+// CreateNamed mocks base method
+func (m *MockProjectFactory) CreateNamed(arg0 *cli.Context, arg1 bool) (project.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNamed", arg0, arg1)
+	ret0, _ := ret[0].(project.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNamed indicates an expected call of CreateNamed
+func (mr *MockProjectFactoryMockRecorder) CreateNamed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNamed", reflect.TypeOf((*MockProjectFactory)(nil).CreateNamed), arg0, arg1)
+}
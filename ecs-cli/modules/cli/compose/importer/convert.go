@@ -0,0 +1,674 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package importer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+const (
+	// composeVersion is the Compose file version written by the import. Later minor versions
+	// are not parsed by the compose commands.
+	composeVersion = "3"
+	// ecsParamsVersion is the version of the ECS params file written by the import
+	ecsParamsVersion = 1
+	// serviceLinkedRolePath is part of the ARN of the service-linked role ECS uses for load
+	// balancers, which can't be passed with --role
+	serviceLinkedRolePath = "/aws-service-role/"
+)
+
+// composeFile is the subset of a Compose file version 3 the ECS CLI converts to a task definition,
+// using the short syntax of ports and volumes available in every version 3 file
+type composeFile struct {
+	Version  string                    `yaml:"version"`
+	Services map[string]composeService `yaml:"services"`
+	Volumes  map[string]composeVolume  `yaml:"volumes,omitempty"`
+}
+
+type composeService struct {
+	Image           string                   `yaml:"image"`
+	Entrypoint      []string                 `yaml:"entrypoint,omitempty"`
+	Command         []string                 `yaml:"command,omitempty"`
+	WorkingDir      string                   `yaml:"working_dir,omitempty"`
+	User            string                   `yaml:"user,omitempty"`
+	Hostname        string                   `yaml:"hostname,omitempty"`
+	Environment     map[string]string        `yaml:"environment,omitempty"`
+	Labels          map[string]string        `yaml:"labels,omitempty"`
+	Ports           []string                 `yaml:"ports,omitempty"`
+	Volumes         []string                 `yaml:"volumes,omitempty"`
+	Links           []string                 `yaml:"links,omitempty"`
+	DNS             []string                 `yaml:"dns,omitempty"`
+	DNSSearch       []string                 `yaml:"dns_search,omitempty"`
+	ExtraHosts      []string                 `yaml:"extra_hosts,omitempty"`
+	SecurityOpt     []string                 `yaml:"security_opt,omitempty"`
+	CapAdd          []string                 `yaml:"cap_add,omitempty"`
+	CapDrop         []string                 `yaml:"cap_drop,omitempty"`
+	Devices         []string                 `yaml:"devices,omitempty"`
+	Tmpfs           []string                 `yaml:"tmpfs,omitempty"`
+	Privileged      bool                     `yaml:"privileged,omitempty"`
+	ReadOnly        bool                     `yaml:"read_only,omitempty"`
+	Tty             bool                     `yaml:"tty,omitempty"`
+	StopGracePeriod string                   `yaml:"stop_grace_period,omitempty"`
+	Ulimits         map[string]composeUlimit `yaml:"ulimits,omitempty"`
+	Logging         *composeLogging          `yaml:"logging,omitempty"`
+}
+
+type composeUlimit struct {
+	Soft int64 `yaml:"soft"`
+	Hard int64 `yaml:"hard"`
+}
+
+type composeLogging struct {
+	Driver  string            `yaml:"driver"`
+	Options map[string]string `yaml:"options,omitempty"`
+}
+
+// composeVolume declares a named volume, configured in the ECS params file if needed
+type composeVolume struct{}
+
+// ecsParams is the part of an ECS params file holding the settings of the task definition and the
+// service which have no Compose equivalent
+type ecsParams struct {
+	Version        int                     `yaml:"version"`
+	TaskDefinition ecsParamsTaskDefinition `yaml:"task_definition"`
+	RunParams      *ecsParamsRunParams     `yaml:"run_params,omitempty"`
+}
+
+type ecsParamsTaskDefinition struct {
+	NetworkMode           string                              `yaml:"ecs_network_mode,omitempty"`
+	TaskRoleArn           string                              `yaml:"task_role_arn,omitempty"`
+	ExecutionRole         string                              `yaml:"task_execution_role,omitempty"`
+	PIDMode               string                              `yaml:"pid_mode,omitempty"`
+	IPCMode               string                              `yaml:"ipc_mode,omitempty"`
+	TaskSize              *ecsParamsTaskSize                  `yaml:"task_size,omitempty"`
+	ContainerDefinitions  map[string]ecsParamsContainer       `yaml:"services,omitempty"`
+	DockerVolumes         []ecsParamsDockerVolume             `yaml:"docker_volumes,omitempty"`
+	EFSVolumes            []ecsParamsEFSVolume                `yaml:"efs_volumes,omitempty"`
+	PlacementConstraints  []composeutils.Constraint           `yaml:"placement_constraints,omitempty"`
+	InferenceAccelerators []composeutils.InferenceAccelerator `yaml:"inference_accelerators,omitempty"`
+}
+
+type ecsParamsTaskSize struct {
+	Cpu    string `yaml:"cpu_limit,omitempty"`
+	Memory string `yaml:"mem_limit,omitempty"`
+}
+
+type ecsParamsContainer struct {
+	Essential             *bool                               `yaml:"essential,omitempty"`
+	InitProcessEnabled    bool                                `yaml:"init_process_enabled,omitempty"`
+	RepositoryCredentials *composeutils.RepositoryCredentials `yaml:"repository_credentials,omitempty"`
+	Cpu                   int64                               `yaml:"cpu_shares,omitempty"`
+	Memory                string                              `yaml:"mem_limit,omitempty"`
+	MemoryReservation     string                              `yaml:"mem_reservation,omitempty"`
+	HealthCheck           *ecsParamsHealthCheck               `yaml:"healthcheck,omitempty"`
+	Logging               *composeutils.Logging               `yaml:"logging,omitempty"`
+	FirelensConfiguration *composeutils.FirelensConfiguration `yaml:"firelens_configuration,omitempty"`
+	Secrets               []composeutils.Secret               `yaml:"secrets,omitempty"`
+	GPU                   string                              `yaml:"gpu,omitempty"`
+	ContainerDependencies []composeutils.ContainerDependency  `yaml:"depends_on,omitempty"`
+	VolumesFrom           []string                            `yaml:"volumes_from,omitempty"`
+	DesiredCount          *int64                              `yaml:"desired_count,omitempty"`
+	InferenceAccelerators []string                            `yaml:"inference_accelerators,omitempty"`
+}
+
+type ecsParamsHealthCheck struct {
+	Test        []string `yaml:"test"`
+	Interval    string   `yaml:"interval,omitempty"`
+	Timeout     string   `yaml:"timeout,omitempty"`
+	Retries     int64    `yaml:"retries,omitempty"`
+	StartPeriod string   `yaml:"start_period,omitempty"`
+}
+
+type ecsParamsDockerVolume struct {
+	Name          string            `yaml:"name"`
+	Scope         string            `yaml:"scope,omitempty"`
+	Autoprovision *bool             `yaml:"autoprovision,omitempty"`
+	Driver        string            `yaml:"driver,omitempty"`
+	DriverOptions map[string]string `yaml:"driver_opts,omitempty"`
+	Labels        map[string]string `yaml:"labels,omitempty"`
+}
+
+type ecsParamsEFSVolume struct {
+	Name                  string `yaml:"name"`
+	FileSystemID          string `yaml:"filesystem_id"`
+	RootDirectory         string `yaml:"root_directory,omitempty"`
+	TransitEncryption     string `yaml:"transit_encryption,omitempty"`
+	TransitEncryptionPort int64  `yaml:"transit_encryption_port,omitempty"`
+	AccessPointID         string `yaml:"access_point,omitempty"`
+	IAM                   string `yaml:"iam,omitempty"`
+}
+
+type ecsParamsRunParams struct {
+	NetworkConfiguration   *composeutils.NetworkConfiguration `yaml:"network_configuration,omitempty"`
+	TaskPlacement          *ecsParamsTaskPlacement            `yaml:"task_placement,omitempty"`
+	HealthCheckGracePeriod *int64                             `yaml:"health_check_grace_period,omitempty"`
+}
+
+type ecsParamsTaskPlacement struct {
+	Strategies  []composeutils.Strategy   `yaml:"strategy,omitempty"`
+	Constraints []composeutils.Constraint `yaml:"constraints,omitempty"`
+}
+
+// importedProject is the compose project equivalent to an ECS service
+type importedProject struct {
+	compose   composeFile
+	ecsParams ecsParams
+	// unsupported lists the settings of the service and its task definition which can't be
+	// represented in the compose project
+	unsupported []string
+	// serviceUpFlags are the flags of 'compose service up' which configure the service as it is
+	serviceUpFlags []string
+}
+
+func (p *importedProject) skip(format string, args ...interface{}) {
+	p.unsupported = append(p.unsupported, fmt.Sprintf(format, args...))
+}
+
+// convertService converts an ECS service and its task definition to a compose project. The
+// settings which can't be represented are recorded rather than failing the conversion.
+func convertService(service *ecs.Service, taskDef *ecs.TaskDefinition) (*importedProject, error) {
+	if len(taskDef.ContainerDefinitions) == 0 {
+		return nil, fmt.Errorf("Task definition '%s' has no containers", aws.StringValue(taskDef.TaskDefinitionArn))
+	}
+	project := &importedProject{
+		compose: composeFile{
+			Version:  composeVersion,
+			Services: make(map[string]composeService),
+		},
+		ecsParams: ecsParams{Version: ecsParamsVersion},
+	}
+	project.convertTaskDefinition(taskDef)
+
+	// desired_count only applies to replica services
+	var desiredCount *int64
+	if aws.StringValue(service.SchedulingStrategy) != ecs.SchedulingStrategyDaemon {
+		desiredCount = service.DesiredCount
+	}
+	volumes := volumesByName(taskDef.Volumes)
+	for _, containerDef := range taskDef.ContainerDefinitions {
+		name := aws.StringValue(containerDef.Name)
+		serviceConfig, err := project.convertContainer(containerDef, volumes)
+		if err != nil {
+			return nil, err
+		}
+		project.compose.Services[name] = serviceConfig
+
+		containerParams := project.convertContainerParams(containerDef)
+		containerParams.DesiredCount = desiredCount
+		if project.ecsParams.TaskDefinition.ContainerDefinitions == nil {
+			project.ecsParams.TaskDefinition.ContainerDefinitions = make(map[string]ecsParamsContainer)
+		}
+		project.ecsParams.TaskDefinition.ContainerDefinitions[name] = containerParams
+	}
+
+	project.convertServiceSettings(service)
+	return project, nil
+}
+
+// convertTaskDefinition sets the task level settings of the ECS params file
+func (p *importedProject) convertTaskDefinition(taskDef *ecs.TaskDefinition) {
+	params := &p.ecsParams.TaskDefinition
+	params.NetworkMode = aws.StringValue(taskDef.NetworkMode)
+	params.TaskRoleArn = aws.StringValue(taskDef.TaskRoleArn)
+	params.ExecutionRole = aws.StringValue(taskDef.ExecutionRoleArn)
+	params.PIDMode = aws.StringValue(taskDef.PidMode)
+	params.IPCMode = aws.StringValue(taskDef.IpcMode)
+	if taskDef.Cpu != nil || taskDef.Memory != nil {
+		params.TaskSize = &ecsParamsTaskSize{
+			Cpu:    aws.StringValue(taskDef.Cpu),
+			Memory: aws.StringValue(taskDef.Memory),
+		}
+	}
+	for _, constraint := range taskDef.PlacementConstraints {
+		params.PlacementConstraints = append(params.PlacementConstraints, composeutils.Constraint{
+			Expression: aws.StringValue(constraint.Expression),
+			Type:       aws.StringValue(constraint.Type),
+		})
+	}
+	for _, accelerator := range taskDef.InferenceAccelerators {
+		params.InferenceAccelerators = append(params.InferenceAccelerators, composeutils.InferenceAccelerator{
+			DeviceName: aws.StringValue(accelerator.DeviceName),
+			DeviceType: aws.StringValue(accelerator.DeviceType),
+		})
+	}
+
+	for _, volume := range taskDef.Volumes {
+		name := aws.StringValue(volume.Name)
+		if config := volume.DockerVolumeConfiguration; config != nil {
+			params.DockerVolumes = append(params.DockerVolumes, ecsParamsDockerVolume{
+				Name:          name,
+				Scope:         aws.StringValue(config.Scope),
+				Autoprovision: config.Autoprovision,
+				Driver:        aws.StringValue(config.Driver),
+				DriverOptions: aws.StringValueMap(config.DriverOpts),
+				Labels:        aws.StringValueMap(config.Labels),
+			})
+		}
+		if config := volume.EfsVolumeConfiguration; config != nil {
+			efsVolume := ecsParamsEFSVolume{
+				Name:                  name,
+				FileSystemID:          aws.StringValue(config.FileSystemId),
+				RootDirectory:         aws.StringValue(config.RootDirectory),
+				TransitEncryption:     aws.StringValue(config.TransitEncryption),
+				TransitEncryptionPort: aws.Int64Value(config.TransitEncryptionPort),
+			}
+			if config.AuthorizationConfig != nil {
+				efsVolume.AccessPointID = aws.StringValue(config.AuthorizationConfig.AccessPointId)
+				efsVolume.IAM = aws.StringValue(config.AuthorizationConfig.Iam)
+			}
+			params.EFSVolumes = append(params.EFSVolumes, efsVolume)
+		}
+	}
+
+	if taskDef.ProxyConfiguration != nil {
+		p.skip("task definition: proxy configuration (App Mesh)")
+	}
+}
+
+// convertContainer converts the settings of a container definition which have a Compose equivalent
+func (p *importedProject) convertContainer(containerDef *ecs.ContainerDefinition, volumes map[string]*ecs.Volume) (composeService, error) {
+	name := aws.StringValue(containerDef.Name)
+	service := composeService{
+		Image:       aws.StringValue(containerDef.Image),
+		Entrypoint:  escapeAll(aws.StringValueSlice(containerDef.EntryPoint)),
+		Command:     escapeAll(aws.StringValueSlice(containerDef.Command)),
+		WorkingDir:  aws.StringValue(containerDef.WorkingDirectory),
+		User:        aws.StringValue(containerDef.User),
+		Hostname:    aws.StringValue(containerDef.Hostname),
+		Links:       aws.StringValueSlice(containerDef.Links),
+		DNS:         aws.StringValueSlice(containerDef.DnsServers),
+		DNSSearch:   aws.StringValueSlice(containerDef.DnsSearchDomains),
+		SecurityOpt: aws.StringValueSlice(containerDef.DockerSecurityOptions),
+		Privileged:  aws.BoolValue(containerDef.Privileged),
+		ReadOnly:    aws.BoolValue(containerDef.ReadonlyRootFilesystem),
+		Tty:         aws.BoolValue(containerDef.PseudoTerminal),
+	}
+	if len(containerDef.Environment) > 0 {
+		service.Environment = make(map[string]string)
+		for _, env := range containerDef.Environment {
+			service.Environment[aws.StringValue(env.Name)] = escape(aws.StringValue(env.Value))
+		}
+	}
+	if len(containerDef.DockerLabels) > 0 {
+		service.Labels = make(map[string]string)
+		for key, value := range containerDef.DockerLabels {
+			service.Labels[key] = escape(aws.StringValue(value))
+		}
+	}
+	for _, portMapping := range containerDef.PortMappings {
+		service.Ports = append(service.Ports, convertPortMapping(portMapping))
+	}
+	for _, mountPoint := range containerDef.MountPoints {
+		volume, err := p.convertMountPoint(mountPoint, volumes)
+		if err != nil {
+			return service, err
+		}
+		service.Volumes = append(service.Volumes, volume)
+	}
+	for _, extraHost := range containerDef.ExtraHosts {
+		service.ExtraHosts = append(service.ExtraHosts, aws.StringValue(extraHost.Hostname)+":"+aws.StringValue(extraHost.IpAddress))
+	}
+	if len(containerDef.Ulimits) > 0 {
+		service.Ulimits = make(map[string]composeUlimit)
+		for _, ulimit := range containerDef.Ulimits {
+			service.Ulimits[aws.StringValue(ulimit.Name)] = composeUlimit{
+				Soft: aws.Int64Value(ulimit.SoftLimit),
+				Hard: aws.Int64Value(ulimit.HardLimit),
+			}
+		}
+	}
+	if containerDef.StopTimeout != nil {
+		service.StopGracePeriod = (time.Duration(aws.Int64Value(containerDef.StopTimeout)) * time.Second).String()
+	}
+	if logConfig := containerDef.LogConfiguration; logConfig != nil {
+		service.Logging = &composeLogging{
+			Driver:  aws.StringValue(logConfig.LogDriver),
+			Options: aws.StringValueMap(logConfig.Options),
+		}
+	}
+	if linuxParams := containerDef.LinuxParameters; linuxParams != nil {
+		if linuxParams.Capabilities != nil {
+			service.CapAdd = aws.StringValueSlice(linuxParams.Capabilities.Add)
+			service.CapDrop = aws.StringValueSlice(linuxParams.Capabilities.Drop)
+		}
+		for _, device := range linuxParams.Devices {
+			service.Devices = append(service.Devices, convertDevice(device))
+		}
+		for _, tmpfs := range linuxParams.Tmpfs {
+			service.Tmpfs = append(service.Tmpfs, convertTmpfs(tmpfs))
+		}
+		if linuxParams.SharedMemorySize != nil {
+			p.skip("container %s: shared memory size", name)
+		}
+		if linuxParams.MaxSwap != nil || linuxParams.Swappiness != nil {
+			p.skip("container %s: swap settings", name)
+		}
+	}
+
+	if aws.BoolValue(containerDef.DisableNetworking) {
+		p.skip("container %s: disabled networking", name)
+	}
+	if aws.BoolValue(containerDef.Interactive) {
+		p.skip("container %s: interactive (stdin_open)", name)
+	}
+	if containerDef.StartTimeout != nil {
+		p.skip("container %s: start timeout", name)
+	}
+	if len(containerDef.SystemControls) > 0 {
+		p.skip("container %s: system controls (sysctls)", name)
+	}
+	return service, nil
+}
+
+// convertContainerParams converts the settings of a container definition which are set in the
+// ECS params file
+func (p *importedProject) convertContainerParams(containerDef *ecs.ContainerDefinition) ecsParamsContainer {
+	name := aws.StringValue(containerDef.Name)
+	params := ecsParamsContainer{
+		Cpu:         aws.Int64Value(containerDef.Cpu),
+		Memory:      memoryInMiB(containerDef.Memory),
+		VolumesFrom: convertVolumesFrom(containerDef.VolumesFrom),
+		Secrets:     convertSecrets(containerDef.Secrets),
+	}
+	if memoryReservation := memoryInMiB(containerDef.MemoryReservation); memoryReservation != "" {
+		params.MemoryReservation = memoryReservation
+	}
+	// containers are essential unless the ECS params file says otherwise
+	if containerDef.Essential != nil && !aws.BoolValue(containerDef.Essential) {
+		params.Essential = aws.Bool(false)
+	}
+	if containerDef.LinuxParameters != nil {
+		params.InitProcessEnabled = aws.BoolValue(containerDef.LinuxParameters.InitProcessEnabled)
+	}
+	if containerDef.RepositoryCredentials != nil {
+		params.RepositoryCredentials = &composeutils.RepositoryCredentials{
+			CredentialsParameter: aws.StringValue(containerDef.RepositoryCredentials.CredentialsParameter),
+		}
+	}
+	if healthCheck := containerDef.HealthCheck; healthCheck != nil {
+		params.HealthCheck = &ecsParamsHealthCheck{
+			Test:        aws.StringValueSlice(healthCheck.Command),
+			Interval:    seconds(healthCheck.Interval),
+			Timeout:     seconds(healthCheck.Timeout),
+			Retries:     aws.Int64Value(healthCheck.Retries),
+			StartPeriod: seconds(healthCheck.StartPeriod),
+		}
+		// unlike the Compose file, the ECS params file has no escape for the variables it is
+		// expanded with when it is read
+		if strings.Contains(strings.Join(params.HealthCheck.Test, " "), "$") {
+			p.skip("container %s: health check command with shell variables", name)
+		}
+	}
+	if logConfig := containerDef.LogConfiguration; logConfig != nil && len(logConfig.SecretOptions) > 0 {
+		params.Logging = &composeutils.Logging{SecretOptions: convertSecrets(logConfig.SecretOptions)}
+	}
+	if firelens := containerDef.FirelensConfiguration; firelens != nil {
+		params.FirelensConfiguration = &composeutils.FirelensConfiguration{
+			Type:    aws.StringValue(firelens.Type),
+			Options: aws.StringValueMap(firelens.Options),
+		}
+	}
+	for _, dependency := range containerDef.DependsOn {
+		params.ContainerDependencies = append(params.ContainerDependencies, composeutils.ContainerDependency{
+			ContainerName: aws.StringValue(dependency.ContainerName),
+			Condition:     aws.StringValue(dependency.Condition),
+		})
+	}
+	for _, requirement := range containerDef.ResourceRequirements {
+		switch aws.StringValue(requirement.Type) {
+		case ecs.ResourceTypeGpu:
+			params.GPU = aws.StringValue(requirement.Value)
+		case ecs.ResourceTypeInferenceAccelerator:
+			params.InferenceAccelerators = append(params.InferenceAccelerators, aws.StringValue(requirement.Value))
+		default:
+			p.skip("container %s: resource requirement of type %s", name, aws.StringValue(requirement.Type))
+		}
+	}
+	return params
+}
+
+// convertServiceSettings sets the run params of the ECS params file and the flags of
+// 'compose service up' from the settings of the service
+func (p *importedProject) convertServiceSettings(service *ecs.Service) {
+	runParams := &ecsParamsRunParams{}
+	if networkConfig := service.NetworkConfiguration; networkConfig != nil && networkConfig.AwsvpcConfiguration != nil {
+		awsvpcConfig := networkConfig.AwsvpcConfiguration
+		runParams.NetworkConfiguration = &composeutils.NetworkConfiguration{
+			AwsVpcConfiguration: composeutils.AwsVpcConfiguration{
+				Subnets:        aws.StringValueSlice(awsvpcConfig.Subnets),
+				SecurityGroups: aws.StringValueSlice(awsvpcConfig.SecurityGroups),
+				AssignPublicIp: composeutils.AssignPublicIp(aws.StringValue(awsvpcConfig.AssignPublicIp)),
+			},
+		}
+	}
+	if len(service.PlacementStrategy) > 0 || len(service.PlacementConstraints) > 0 {
+		runParams.TaskPlacement = &ecsParamsTaskPlacement{}
+		for _, strategy := range service.PlacementStrategy {
+			runParams.TaskPlacement.Strategies = append(runParams.TaskPlacement.Strategies, composeutils.Strategy{
+				Field: aws.StringValue(strategy.Field),
+				Type:  aws.StringValue(strategy.Type),
+			})
+		}
+		for _, constraint := range service.PlacementConstraints {
+			runParams.TaskPlacement.Constraints = append(runParams.TaskPlacement.Constraints, composeutils.Constraint{
+				Expression: aws.StringValue(constraint.Expression),
+				Type:       aws.StringValue(constraint.Type),
+			})
+		}
+	}
+	if aws.Int64Value(service.HealthCheckGracePeriodSeconds) > 0 {
+		runParams.HealthCheckGracePeriod = service.HealthCheckGracePeriodSeconds
+	}
+	if *runParams != (ecsParamsRunParams{}) {
+		p.ecsParams.RunParams = runParams
+	}
+
+	if launchType := aws.StringValue(service.LaunchType); launchType != "" {
+		p.upFlag(flags.LaunchTypeFlag, launchType)
+	}
+	if aws.StringValue(service.SchedulingStrategy) == ecs.SchedulingStrategyDaemon {
+		p.upFlag(flags.SchedulingStrategyFlag, ecs.SchedulingStrategyDaemon)
+	}
+	if deploymentConfig := service.DeploymentConfiguration; deploymentConfig != nil {
+		if maxPercent := aws.Int64Value(deploymentConfig.MaximumPercent); deploymentConfig.MaximumPercent != nil && maxPercent != flags.DeploymentMaxPercentDefaultValue {
+			p.upFlag(flags.DeploymentMaxPercentFlag, strconv.FormatInt(maxPercent, 10))
+		}
+		if minHealthyPercent := aws.Int64Value(deploymentConfig.MinimumHealthyPercent); deploymentConfig.MinimumHealthyPercent != nil && minHealthyPercent != flags.DeploymentMinHealthyPercentDefaultValue {
+			p.upFlag(flags.DeploymentMinHealthyPercentFlag, strconv.FormatInt(minHealthyPercent, 10))
+		}
+	}
+	for _, loadBalancer := range service.LoadBalancers {
+		if loadBalancer.TargetGroupArn != nil {
+			p.upFlag(flags.TargetGroupsFlag, fmt.Sprintf("targetGroupArn=%s,containerName=%s,containerPort=%d",
+				aws.StringValue(loadBalancer.TargetGroupArn), aws.StringValue(loadBalancer.ContainerName), aws.Int64Value(loadBalancer.ContainerPort)))
+			continue
+		}
+		p.upFlag(flags.LoadBalancerNameFlag, aws.StringValue(loadBalancer.LoadBalancerName))
+		p.upFlag(flags.ContainerNameFlag, aws.StringValue(loadBalancer.ContainerName))
+		p.upFlag(flags.ContainerPortFlag, strconv.FormatInt(aws.Int64Value(loadBalancer.ContainerPort), 10))
+	}
+	if role := aws.StringValue(service.RoleArn); len(service.LoadBalancers) > 0 && role != "" && !strings.Contains(role, serviceLinkedRolePath) {
+		p.upFlag(flags.RoleFlag, role)
+	}
+	if service.EnableECSManagedTags != nil && !aws.BoolValue(service.EnableECSManagedTags) {
+		p.serviceUpFlags = append(p.serviceUpFlags, "--"+flags.DisableECSManagedTagsFlag)
+	}
+
+	if len(service.ServiceRegistries) > 0 {
+		p.skip("service: service discovery registries")
+	}
+	if len(service.CapacityProviderStrategy) > 0 {
+		p.skip("service: capacity provider strategy")
+	}
+	if controller := service.DeploymentController; controller != nil && aws.StringValue(controller.Type) != ecs.DeploymentControllerTypeEcs {
+		p.skip("service: %s deployment controller", aws.StringValue(controller.Type))
+	}
+	if propagateTags := aws.StringValue(service.PropagateTags); propagateTags != "" && propagateTags != "NONE" {
+		p.skip("service: propagation of the tags of the %s", strings.ToLower(propagateTags))
+	}
+	// compose uses platform version 1.4.0 for Fargate tasks with EFS volumes, and the latest one otherwise
+	platformVersion := aws.StringValue(service.PlatformVersion)
+	efsPlatformVersion := platformVersion == config.PlatformVersion140 && len(p.ecsParams.TaskDefinition.EFSVolumes) > 0
+	if platformVersion != "" && platformVersion != "LATEST" && !efsPlatformVersion {
+		p.skip("service: platform version %s", platformVersion)
+	}
+}
+
+func (p *importedProject) upFlag(name, value string) {
+	p.serviceUpFlags = append(p.serviceUpFlags, fmt.Sprintf("--%s %s", name, shellQuote(value)))
+}
+
+// convertMountPoint converts a mount point to a bind mount for volumes with a host path, and to a
+// named volume otherwise
+func (p *importedProject) convertMountPoint(mountPoint *ecs.MountPoint, volumes map[string]*ecs.Volume) (string, error) {
+	name := aws.StringValue(mountPoint.SourceVolume)
+	volume, ok := volumes[name]
+	if !ok {
+		return "", fmt.Errorf("Mount point of %s refers to the undefined volume '%s'", aws.StringValue(mountPoint.ContainerPath), name)
+	}
+	source := name
+	if volume.Host != nil && aws.StringValue(volume.Host.SourcePath) != "" {
+		source = aws.StringValue(volume.Host.SourcePath)
+	} else {
+		if p.compose.Volumes == nil {
+			p.compose.Volumes = make(map[string]composeVolume)
+		}
+		p.compose.Volumes[name] = composeVolume{}
+	}
+	mount := source + ":" + aws.StringValue(mountPoint.ContainerPath)
+	if aws.BoolValue(mountPoint.ReadOnly) {
+		mount += ":ro"
+	}
+	return mount, nil
+}
+
+func volumesByName(volumes []*ecs.Volume) map[string]*ecs.Volume {
+	byName := make(map[string]*ecs.Volume)
+	for _, volume := range volumes {
+		byName[aws.StringValue(volume.Name)] = volume
+	}
+	return byName
+}
+
+// convertPortMapping returns the [HOST:]CONTAINER[/PROTOCOL] short syntax of the port mapping
+func convertPortMapping(portMapping *ecs.PortMapping) string {
+	port := strconv.FormatInt(aws.Int64Value(portMapping.ContainerPort), 10)
+	if hostPort := aws.Int64Value(portMapping.HostPort); hostPort != 0 {
+		port = strconv.FormatInt(hostPort, 10) + ":" + port
+	}
+	if protocol := aws.StringValue(portMapping.Protocol); protocol != "" && protocol != ecs.TransportProtocolTcp {
+		port += "/" + protocol
+	}
+	return port
+}
+
+// convertDevice returns the HOST[:CONTAINER[:PERMISSIONS]] syntax of the device
+func convertDevice(device *ecs.Device) string {
+	composeDevice := aws.StringValue(device.HostPath)
+	if device.ContainerPath == nil && len(device.Permissions) == 0 {
+		return composeDevice
+	}
+	containerPath := aws.StringValue(device.ContainerPath)
+	if containerPath == "" {
+		containerPath = composeDevice
+	}
+	composeDevice += ":" + containerPath
+	if len(device.Permissions) > 0 {
+		permissions := ""
+		for _, permission := range aws.StringValueSlice(device.Permissions) {
+			// read, write and mknod
+			permissions += permission[:1]
+		}
+		composeDevice += ":" + permissions
+	}
+	return composeDevice
+}
+
+// convertTmpfs returns the PATH:size=SIZE[,OPTIONS] syntax of the tmpfs mount
+func convertTmpfs(tmpfs *ecs.Tmpfs) string {
+	options := append([]string{fmt.Sprintf("size=%dm", aws.Int64Value(tmpfs.Size))}, aws.StringValueSlice(tmpfs.MountOptions)...)
+	return aws.StringValue(tmpfs.ContainerPath) + ":" + strings.Join(options, ",")
+}
+
+// convertVolumesFrom returns the NAME[:ro] syntax of the volumes from other containers
+func convertVolumesFrom(volumesFrom []*ecs.VolumeFrom) []string {
+	var composeVolumesFrom []string
+	for _, volumeFrom := range volumesFrom {
+		composeVolumeFrom := aws.StringValue(volumeFrom.SourceContainer)
+		if aws.BoolValue(volumeFrom.ReadOnly) {
+			composeVolumeFrom += ":ro"
+		}
+		composeVolumesFrom = append(composeVolumesFrom, composeVolumeFrom)
+	}
+	return composeVolumesFrom
+}
+
+func convertSecrets(secrets []*ecs.Secret) []composeutils.Secret {
+	var paramsSecrets []composeutils.Secret
+	for _, secret := range secrets {
+		paramsSecrets = append(paramsSecrets, composeutils.Secret{
+			ValueFrom: aws.StringValue(secret.ValueFrom),
+			Name:      aws.StringValue(secret.Name),
+		})
+	}
+	return paramsSecrets
+}
+
+// memoryInMiB returns the memory of a container definition, in MiB, in the syntax of the ECS params file
+func memoryInMiB(memory *int64) string {
+	if aws.Int64Value(memory) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%dm", aws.Int64Value(memory))
+}
+
+func seconds(value *int64) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%ds", aws.Int64Value(value))
+}
+
+// escape escapes the dollar signs of a value of the Compose file, which would otherwise be
+// interpolated with the environment when the file is read
+func escape(value string) string {
+	return strings.Replace(value, "$", "$$", -1)
+}
+
+func escapeAll(values []string) []string {
+	var escaped []string
+	for _, value := range values {
+		escaped = append(escaped, escape(value))
+	}
+	return escaped
+}
+
+// shellQuote quotes a flag value for a shell, if needed
+func shellQuote(value string) string {
+	if value != "" && strings.IndexFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:=,@", r))
+	}) < 0 {
+		return value
+	}
+	return "'" + strings.Replace(value, "'", `'\''`, -1) + "'"
+}
@@ -0,0 +1,241 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package importer
+
+import (
+	"testing"
+
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/schema"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/docker/cli/cli/compose/loader"
+	"github.com/docker/cli/cli/compose/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+const taskDefArn = "arn:aws:ecs:us-west-2:123456789012:task-definition/web:7"
+
+func testTaskDefinition() *ecs.TaskDefinition {
+	return &ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String(taskDefArn),
+		NetworkMode:       aws.String(ecs.NetworkModeAwsvpc),
+		TaskRoleArn:       aws.String("arn:aws:iam::123456789012:role/web-task"),
+		ExecutionRoleArn:  aws.String("arn:aws:iam::123456789012:role/web-execution"),
+		Cpu:               aws.String("512"),
+		Memory:            aws.String("1024"),
+		Volumes: []*ecs.Volume{
+			{Name: aws.String("config"), Host: &ecs.HostVolumeProperties{SourcePath: aws.String("/etc/web")}},
+			{Name: aws.String("cache"), Host: &ecs.HostVolumeProperties{}},
+			{Name: aws.String("shared"), EfsVolumeConfiguration: &ecs.EFSVolumeConfiguration{
+				FileSystemId:      aws.String("fs-1234"),
+				TransitEncryption: aws.String("ENABLED"),
+				AuthorizationConfig: &ecs.EFSAuthorizationConfig{
+					AccessPointId: aws.String("fsap-1234"),
+				},
+			}},
+		},
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:       aws.String("web"),
+				Image:      aws.String("nginx:1.19"),
+				Command:    aws.StringSlice([]string{"sh", "-c", "echo $HOME"}),
+				Essential:  aws.Bool(true),
+				Cpu:        aws.Int64(256),
+				Memory:     aws.Int64(512),
+				Privileged: aws.Bool(false),
+				Environment: []*ecs.KeyValuePair{
+					{Name: aws.String("PORT"), Value: aws.String("80")},
+				},
+				PortMappings: []*ecs.PortMapping{
+					{ContainerPort: aws.Int64(80), HostPort: aws.Int64(80), Protocol: aws.String(ecs.TransportProtocolTcp)},
+					{ContainerPort: aws.Int64(8125), Protocol: aws.String(ecs.TransportProtocolUdp)},
+				},
+				MountPoints: []*ecs.MountPoint{
+					{SourceVolume: aws.String("config"), ContainerPath: aws.String("/etc/nginx"), ReadOnly: aws.Bool(true)},
+					{SourceVolume: aws.String("cache"), ContainerPath: aws.String("/var/cache")},
+					{SourceVolume: aws.String("shared"), ContainerPath: aws.String("/srv")},
+				},
+				Ulimits: []*ecs.Ulimit{
+					{Name: aws.String("nofile"), SoftLimit: aws.Int64(1024), HardLimit: aws.Int64(4096)},
+				},
+				StopTimeout: aws.Int64(30),
+				LogConfiguration: &ecs.LogConfiguration{
+					LogDriver: aws.String(ecs.LogDriverAwslogs),
+					Options:   aws.StringMap(map[string]string{"awslogs-group": "web"}),
+				},
+				LinuxParameters: &ecs.LinuxParameters{
+					InitProcessEnabled: aws.Bool(true),
+					Capabilities:       &ecs.KernelCapabilities{Add: aws.StringSlice([]string{"SYS_PTRACE"})},
+					Tmpfs: []*ecs.Tmpfs{
+						{ContainerPath: aws.String("/run"), Size: aws.Int64(64), MountOptions: aws.StringSlice([]string{"noexec"})},
+					},
+					SharedMemorySize: aws.Int64(128),
+				},
+				HealthCheck: &ecs.HealthCheck{
+					Command:     aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost/"}),
+					Interval:    aws.Int64(10),
+					Retries:     aws.Int64(3),
+					StartPeriod: aws.Int64(60),
+				},
+				Secrets: []*ecs.Secret{
+					{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/db")},
+				},
+				DependsOn: []*ecs.ContainerDependency{
+					{ContainerName: aws.String("log-router"), Condition: aws.String(ecs.ContainerConditionStart)},
+				},
+				Interactive: aws.Bool(true),
+			},
+			{
+				Name:                  aws.String("log-router"),
+				Image:                 aws.String("amazon/aws-for-fluent-bit:latest"),
+				Essential:             aws.Bool(false),
+				MemoryReservation:     aws.Int64(64),
+				FirelensConfiguration: &ecs.FirelensConfiguration{Type: aws.String("fluentbit")},
+			},
+		},
+	}
+}
+
+func testService() *ecs.Service {
+	return &ecs.Service{
+		ServiceName:        aws.String("web"),
+		TaskDefinition:     aws.String(taskDefArn),
+		DesiredCount:       aws.Int64(3),
+		LaunchType:         aws.String(ecs.LaunchTypeFargate),
+		SchedulingStrategy: aws.String(ecs.SchedulingStrategyReplica),
+		DeploymentConfiguration: &ecs.DeploymentConfiguration{
+			MaximumPercent:        aws.Int64(200),
+			MinimumHealthyPercent: aws.Int64(50),
+		},
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				Subnets:        aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+				SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+				AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
+			},
+		},
+		LoadBalancers: []*ecs.LoadBalancer{
+			{
+				TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/web/1234"),
+				ContainerName:  aws.String("web"),
+				ContainerPort:  aws.Int64(80),
+			},
+		},
+		RoleArn:                       aws.String("arn:aws:iam::123456789012:role/aws-service-role/ecs.amazonaws.com/AWSServiceRoleForECS"),
+		HealthCheckGracePeriodSeconds: aws.Int64(120),
+		ServiceRegistries:             []*ecs.ServiceRegistry{{RegistryArn: aws.String("arn:aws:servicediscovery:us-west-2:123456789012:service/srv-1234")}},
+		EnableECSManagedTags:          aws.Bool(false),
+		PlatformVersion:               aws.String("1.4.0"),
+	}
+}
+
+func TestConvertService(t *testing.T) {
+	project, err := convertService(testService(), testTaskDefinition())
+	require.NoError(t, err, "Unexpected error converting service")
+
+	web := project.compose.Services["web"]
+	assert.Equal(t, "nginx:1.19", web.Image)
+	assert.Equal(t, []string{"sh", "-c", "echo $$HOME"}, web.Command, "Expected dollar signs to be escaped")
+	assert.Equal(t, map[string]string{"PORT": "80"}, web.Environment)
+	assert.Equal(t, []string{"80:80", "8125/udp"}, web.Ports)
+	assert.Equal(t, []string{"/etc/web:/etc/nginx:ro", "cache:/var/cache", "shared:/srv"}, web.Volumes)
+	assert.Equal(t, []string{"/run:size=64m,noexec"}, web.Tmpfs)
+	assert.Equal(t, []string{"SYS_PTRACE"}, web.CapAdd)
+	assert.Equal(t, map[string]composeUlimit{"nofile": {Soft: 1024, Hard: 4096}}, web.Ulimits)
+	assert.Equal(t, "30s", web.StopGracePeriod)
+	assert.Equal(t, &composeLogging{Driver: "awslogs", Options: map[string]string{"awslogs-group": "web"}}, web.Logging)
+	assert.Equal(t, map[string]composeVolume{"cache": {}, "shared": {}}, project.compose.Volumes)
+
+	taskDefParams := project.ecsParams.TaskDefinition
+	assert.Equal(t, ecs.NetworkModeAwsvpc, taskDefParams.NetworkMode)
+	assert.Equal(t, &ecsParamsTaskSize{Cpu: "512", Memory: "1024"}, taskDefParams.TaskSize)
+	assert.Equal(t, []ecsParamsEFSVolume{{Name: "shared", FileSystemID: "fs-1234", TransitEncryption: "ENABLED", AccessPointID: "fsap-1234"}}, taskDefParams.EFSVolumes)
+
+	webParams := taskDefParams.ContainerDefinitions["web"]
+	assert.Nil(t, webParams.Essential, "Expected essential containers to be left to the default")
+	assert.True(t, webParams.InitProcessEnabled)
+	assert.Equal(t, int64(256), webParams.Cpu)
+	assert.Equal(t, "512m", webParams.Memory)
+	assert.Equal(t, &ecsParamsHealthCheck{Test: []string{"CMD-SHELL", "curl -f http://localhost/"}, Interval: "10s", Retries: 3, StartPeriod: "60s"}, webParams.HealthCheck)
+	assert.Equal(t, []composeutils.Secret{{Name: "DB_PASSWORD", ValueFrom: "arn:aws:ssm:us-west-2:123456789012:parameter/db"}}, webParams.Secrets)
+	assert.Equal(t, []composeutils.ContainerDependency{{ContainerName: "log-router", Condition: "START"}}, webParams.ContainerDependencies)
+	assert.Equal(t, aws.Int64(3), webParams.DesiredCount)
+
+	routerParams := taskDefParams.ContainerDefinitions["log-router"]
+	assert.Equal(t, aws.Bool(false), routerParams.Essential)
+	assert.Equal(t, "64m", routerParams.MemoryReservation)
+	assert.Equal(t, "fluentbit", routerParams.FirelensConfiguration.Type)
+
+	runParams := project.ecsParams.RunParams
+	require.NotNil(t, runParams, "Expected run params")
+	assert.Equal(t, []string{"subnet-1", "subnet-2"}, runParams.NetworkConfiguration.AwsVpcConfiguration.Subnets)
+	assert.Equal(t, aws.Int64(120), runParams.HealthCheckGracePeriod)
+
+	assert.Equal(t, []string{
+		"--launch-type FARGATE",
+		"--deployment-min-healthy-percent 50",
+		"--target-groups targetGroupArn=arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/web/1234,containerName=web,containerPort=80",
+		"--disable-ecs-managed-tags",
+	}, project.serviceUpFlags, "Expected the service-linked role and default deployment settings to be left out")
+	assert.Equal(t, []string{
+		"container web: shared memory size",
+		"container web: interactive (stdin_open)",
+		"service: service discovery registries",
+	}, project.unsupported, "Expected platform version 1.4.0 to be left to compose for EFS volumes")
+}
+
+func TestConvertServiceDaemon(t *testing.T) {
+	service := testService()
+	service.SchedulingStrategy = aws.String(ecs.SchedulingStrategyDaemon)
+	service.LaunchType = aws.String(ecs.LaunchTypeEc2)
+
+	project, err := convertService(service, testTaskDefinition())
+	require.NoError(t, err, "Unexpected error converting service")
+	assert.Nil(t, project.ecsParams.TaskDefinition.ContainerDefinitions["web"].DesiredCount, "Expected no desired count for a daemon service")
+	assert.Contains(t, project.serviceUpFlags, "--scheduling-strategy DAEMON")
+}
+
+func TestConvertServiceWithoutContainers(t *testing.T) {
+	_, err := convertService(testService(), &ecs.TaskDefinition{TaskDefinitionArn: aws.String(taskDefArn)})
+	assert.Error(t, err, "Expected error converting a task definition without containers")
+}
+
+// TestImportedProjectIsReadable checks that the compose commands can read the imported project
+func TestImportedProjectIsReadable(t *testing.T) {
+	project, err := convertService(testService(), testTaskDefinition())
+	require.NoError(t, err, "Unexpected error converting service")
+
+	composeData, err := marshalComposeFile(project, "web", taskDefArn)
+	require.NoError(t, err, "Unexpected error marshalling the Compose file")
+	composeDict, err := loader.ParseYAML(composeData)
+	require.NoError(t, err, "Unexpected error parsing the Compose file")
+	composeConfig, err := loader.Load(types.ConfigDetails{
+		WorkingDir:  ".",
+		ConfigFiles: []types.ConfigFile{{Filename: defaultComposeFileName, Config: composeDict}},
+		Environment: map[string]string{},
+	})
+	require.NoError(t, err, "Unexpected error loading the Compose file")
+	assert.Len(t, composeConfig.Services, 2)
+
+	ecsParamsData, err := yaml.Marshal(project.ecsParams)
+	require.NoError(t, err, "Unexpected error marshalling the ECS params file")
+	assert.NoError(t, schema.Validate(schema.ECSParams, ecsParamsData), "Expected a valid ECS params file")
+	readParams := &composeutils.ECSParams{}
+	require.NoError(t, yaml.Unmarshal(ecsParamsData, readParams), "Unexpected error reading the ECS params file")
+	assert.True(t, readParams.TaskDefinition.ContainerDefinitions["web"].Essential, "Expected containers to default to essential")
+	assert.Equal(t, int64(512), int64(readParams.TaskDefinition.ContainerDefinitions["web"].Memory)/(1024*1024))
+}
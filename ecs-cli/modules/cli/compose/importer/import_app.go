@@ -0,0 +1,160 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package importer creates a compose project from an ECS service created outside of the ECS CLI,
+// so that it can be managed with the compose commands.
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	defaultComposeFileName   = "docker-compose.yml"
+	defaultECSParamsFileName = "ecs-params.yml"
+)
+
+// Import writes the Compose file and the ECS params file equivalent to the task definition and the
+// settings of an ECS service, and prints the 'compose service up' command which deploys them.
+func Import(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'compose import': ", err)
+	}
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'compose import': ", err)
+	}
+	if err := importService(c, ecsclient.NewECSClient(commandConfig), commandConfig, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'compose import': ", err)
+	}
+}
+
+func importService(context *cli.Context, ecsClient ecsclient.ECSClient, commandConfig *config.CommandConfig, out io.Writer) error {
+	serviceName := context.String(flags.ImportServiceFlag)
+	if serviceName == "" {
+		return fmt.Errorf("A service must be specified with the --%s flag", flags.ImportServiceFlag)
+	}
+	composeFileName := defaultComposeFileName
+	if composeFiles := context.GlobalStringSlice(flags.ComposeFileNameFlag); len(composeFiles) > 1 {
+		return fmt.Errorf("A service is imported into a single Compose file, found %d", len(composeFiles))
+	} else if len(composeFiles) == 1 {
+		composeFileName = composeFiles[0]
+	}
+	ecsParamsFileName := context.GlobalString(flags.ECSParamsFileNameFlag)
+	if ecsParamsFileName == "" {
+		ecsParamsFileName = defaultECSParamsFileName
+	}
+	if !context.Bool(flags.ForceFlag) {
+		for _, fileName := range []string{composeFileName, ecsParamsFileName} {
+			if _, err := os.Stat(fileName); err == nil {
+				return fmt.Errorf("%s already exists. Use --%s to overwrite it", fileName, flags.ForceFlag)
+			}
+		}
+	}
+
+	output, err := ecsClient.DescribeService(serviceName)
+	if err != nil {
+		return err
+	}
+	if len(output.Services) == 0 || aws.StringValue(output.Services[0].Status) == "INACTIVE" {
+		return fmt.Errorf("Service '%s' not found in cluster '%s'", serviceName, commandConfig.Cluster)
+	}
+	service := output.Services[0]
+	taskDef, err := ecsClient.DescribeTaskDefinition(aws.StringValue(service.TaskDefinition))
+	if err != nil {
+		return err
+	}
+	project, err := convertService(service, taskDef)
+	if err != nil {
+		return err
+	}
+
+	composeData, err := marshalComposeFile(project, serviceName, aws.StringValue(taskDef.TaskDefinitionArn))
+	if err != nil {
+		return err
+	}
+	ecsParamsData, err := yaml.Marshal(project.ecsParams)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(composeFileName, composeData, 0644); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(ecsParamsFileName, ecsParamsData, 0644); err != nil {
+		return err
+	}
+	for _, setting := range project.unsupported {
+		logrus.WithFields(logrus.Fields{"setting": setting}).Warn("Setting can not be represented in the compose project and was not imported")
+	}
+	logrus.Infof("Imported service '%s' into %s and %s", serviceName, composeFileName, ecsParamsFileName)
+
+	printServiceUpCommand(out, project, serviceName, composeFileName, ecsParamsFileName, commandConfig.ComposeServiceNamePrefix)
+	return nil
+}
+
+// marshalComposeFile serializes the Compose file of the project, with a header recording the
+// service it was imported from and the settings which were not imported
+func marshalComposeFile(project *importedProject, serviceName, taskDefArn string) ([]byte, error) {
+	data, err := yaml.Marshal(project.compose)
+	if err != nil {
+		return nil, err
+	}
+	var header bytes.Buffer
+	fmt.Fprintf(&header, "# Imported from ECS service %s, task definition %s, by 'ecs-cli compose import'.\n", serviceName, taskDefArn)
+	if len(project.unsupported) > 0 {
+		fmt.Fprintln(&header, "# The following settings can not be represented in a compose project and were not imported:")
+		for _, setting := range project.unsupported {
+			fmt.Fprintf(&header, "#   - %s\n", setting)
+		}
+	}
+	return append(header.Bytes(), data...), nil
+}
+
+// printServiceUpCommand prints the 'compose service up' command which updates the service from
+// the compose project. The project name is the name of the service without the compose service
+// name prefix of the configuration.
+func printServiceUpCommand(out io.Writer, project *importedProject, serviceName, composeFileName, ecsParamsFileName, servicePrefix string) {
+	projectName := serviceName
+	if servicePrefix != "" {
+		if !strings.HasPrefix(serviceName, servicePrefix) {
+			logrus.Warnf("The name of service '%s' does not start with the compose service name prefix '%s' of the configuration, so 'compose service up' would create a new service rather than update it", serviceName, servicePrefix)
+		} else {
+			projectName = strings.TrimPrefix(serviceName, servicePrefix)
+		}
+	}
+
+	args := []string{"ecs-cli", "compose", "--" + flags.ProjectNameFlag, shellQuote(projectName)}
+	if composeFileName != defaultComposeFileName {
+		args = append(args, "--"+flags.ComposeFileNameFlag, shellQuote(composeFileName))
+	}
+	if ecsParamsFileName != defaultECSParamsFileName {
+		args = append(args, "--"+flags.ECSParamsFileNameFlag, shellQuote(ecsParamsFileName))
+	}
+	args = append(args, "service", "up")
+	args = append(args, project.serviceUpFlags...)
+	fmt.Fprintf(out, "\nTo deploy the service from the compose project, run:\n\n%s\n", strings.Join(args, " "))
+}
@@ -0,0 +1,121 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package importer
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func newContext(serviceName, composeFileName, ecsParamsFileName string, force bool) *cli.Context {
+	globalSet := flag.NewFlagSet("ecs-cli-compose", 0)
+	composeFiles := &cli.StringSlice{}
+	composeFiles.Set(composeFileName)
+	globalSet.Var(composeFiles, flags.ComposeFileNameFlag, "")
+	globalSet.String(flags.ECSParamsFileNameFlag, ecsParamsFileName, "")
+	globalContext := cli.NewContext(nil, globalSet, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-compose-import", 0)
+	flagSet.String(flags.ImportServiceFlag, serviceName, "")
+	flagSet.Bool(flags.ForceFlag, force, "")
+	return cli.NewContext(nil, flagSet, globalContext)
+}
+
+func tempFiles(t *testing.T) (string, string, func()) {
+	dir, err := ioutil.TempDir("", "ecs-cli-compose-import")
+	require.NoError(t, err, "Unexpected error creating temporary directory")
+	return filepath.Join(dir, "docker-compose.yml"), filepath.Join(dir, "ecs-params.yml"), func() { os.RemoveAll(dir) }
+}
+
+func TestImportService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	composeFileName, ecsParamsFileName, cleanup := tempFiles(t)
+	defer cleanup()
+
+	gomock.InOrder(
+		mockECS.EXPECT().DescribeService("web").Return(&ecs.DescribeServicesOutput{Services: []*ecs.Service{testService()}}, nil),
+		mockECS.EXPECT().DescribeTaskDefinition(taskDefArn).Return(testTaskDefinition(), nil),
+	)
+
+	out := &bytes.Buffer{}
+	err := importService(newContext("web", composeFileName, ecsParamsFileName, false), mockECS, &config.CommandConfig{Cluster: "default"}, out)
+	require.NoError(t, err, "Unexpected error importing service")
+
+	composeData, err := ioutil.ReadFile(composeFileName)
+	require.NoError(t, err, "Expected the Compose file to be written")
+	assert.Contains(t, string(composeData), "# Imported from ECS service web, task definition "+taskDefArn)
+	assert.Contains(t, string(composeData), "#   - container web: shared memory size\n")
+	assert.Contains(t, string(composeData), "image: nginx:1.19")
+	ecsParamsData, err := ioutil.ReadFile(ecsParamsFileName)
+	require.NoError(t, err, "Expected the ECS params file to be written")
+	assert.Contains(t, string(ecsParamsData), "task_role_arn: arn:aws:iam::123456789012:role/web-task")
+
+	assert.Contains(t, out.String(), "ecs-cli compose --project-name web --file "+composeFileName+" --ecs-params "+ecsParamsFileName+" service up --launch-type FARGATE")
+}
+
+func TestImportServiceStripsServicePrefix(t *testing.T) {
+	project := &importedProject{}
+	out := &bytes.Buffer{}
+	printServiceUpCommand(out, project, "ecscompose-service-web", defaultComposeFileName, defaultECSParamsFileName, "ecscompose-service-")
+	assert.Contains(t, out.String(), "ecs-cli compose --project-name web service up\n")
+}
+
+func TestImportServiceExistingFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	composeFileName, ecsParamsFileName, cleanup := tempFiles(t)
+	defer cleanup()
+	require.NoError(t, ioutil.WriteFile(ecsParamsFileName, []byte("version: 1\n"), 0644))
+
+	err := importService(newContext("web", composeFileName, ecsParamsFileName, false), mockECS, &config.CommandConfig{Cluster: "default"}, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error overwriting an existing ECS params file without --force")
+}
+
+func TestImportServiceNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	composeFileName, ecsParamsFileName, cleanup := tempFiles(t)
+	defer cleanup()
+
+	mockECS.EXPECT().DescribeService("web").Return(&ecs.DescribeServicesOutput{}, nil)
+
+	err := importService(newContext("web", composeFileName, ecsParamsFileName, true), mockECS, &config.CommandConfig{Cluster: "default"}, &bytes.Buffer{})
+	assert.EqualError(t, err, "Service 'web' not found in cluster 'default'")
+}
+
+func TestImportServiceWithoutService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	err := importService(newContext("", defaultComposeFileName, defaultECSParamsFileName, false), mockECS, &config.CommandConfig{}, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error without --service")
+}
@@ -19,6 +19,7 @@ package mock_project
 
 import (
 	reflect "reflect"
+	time "time"
 
 	adapter "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
 	context "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
@@ -92,6 +93,20 @@ func (mr *MockProjectMockRecorder) Create() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProject)(nil).Create))
 }
 
+// Diff mocks base method
+func (m *MockProject) Diff(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diff", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Diff indicates an expected call of Diff
+func (mr *MockProjectMockRecorder) Diff(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*MockProject)(nil).Diff), arg0)
+}
+
 // Down mocks base method
 func (m *MockProject) Down() error {
 	m.ctrl.T.Helper()
@@ -120,6 +135,21 @@ func (mr *MockProjectMockRecorder) Entity() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Entity", reflect.TypeOf((*MockProject)(nil).Entity))
 }
 
+// History mocks base method
+func (m *MockProject) History(arg0 int) (project.InfoSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", arg0)
+	ret0, _ := ret[0].(project.InfoSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History
+func (mr *MockProjectMockRecorder) History(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockProject)(nil).History), arg0)
+}
+
 // Info mocks base method
 func (m *MockProject) Info(arg0 string) (project.InfoSet, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +193,34 @@ func (mr *MockProjectMockRecorder) Parse() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockProject)(nil).Parse))
 }
 
+// Rebalance mocks base method
+func (m *MockProject) Rebalance(arg0 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebalance", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rebalance indicates an expected call of Rebalance
+func (mr *MockProjectMockRecorder) Rebalance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebalance", reflect.TypeOf((*MockProject)(nil).Rebalance), arg0)
+}
+
+// Restart mocks base method
+func (m *MockProject) Restart() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restart")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restart indicates an expected call of Restart
+func (mr *MockProjectMockRecorder) Restart() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockProject)(nil).Restart))
+}
+
 // Run mocks base method
 func (m *MockProject) Run(arg0 map[string][]string) error {
 	m.ctrl.T.Helper()
@@ -233,6 +291,20 @@ func (mr *MockProjectMockRecorder) Up() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Up", reflect.TypeOf((*MockProject)(nil).Up))
 }
 
+// Verify mocks base method
+func (m *MockProject) Verify(arg0 string, arg1 int, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify
+func (mr *MockProjectMockRecorder) Verify(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockProject)(nil).Verify), arg0, arg1, arg2)
+}
+
 // VolumeConfigs mocks base method
 func (m *MockProject) VolumeConfigs() *adapter.Volumes {
 	m.ctrl.T.Helper()
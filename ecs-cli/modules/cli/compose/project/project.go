@@ -15,6 +15,7 @@ package project
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
@@ -47,6 +48,11 @@ type Project interface {
 	Info(string) (project.InfoSet, error)
 	Run(commandOverrides map[string][]string) error
 	Scale(count int) error
+	Restart() error
+	History(limit int) (project.InfoSet, error)
+	Diff(revision string) error
+	Verify(urlPath string, expectStatus int, timeout time.Duration) error
+	Rebalance(fix bool) error
 	Stop() error
 	Down() error
 }
@@ -57,6 +63,7 @@ type ecsProject struct {
 	volumes          *adapter.Volumes
 	ecsContext       *context.ECSContext
 	ecsRegistryCreds *regcredio.ECSRegistryCredsOutput
+	serviceProfiles  map[string][]string // profiles of the services assigned to profiles in the compose files
 
 	// TODO: track a map of entities [taskDefinition -> Entity]
 	// 1 task definition for every disjoint set of containers in the compose file
@@ -121,6 +128,10 @@ func (p *ecsProject) Parse() error {
 		return err
 	}
 
+	if err := p.buildImages(); err != nil {
+		return err
+	}
+
 	// Populates ecs-params onto project ecsContext
 	if err := p.parseECSParams(); err != nil {
 		return err
@@ -160,6 +171,10 @@ func (p *ecsProject) parseCompose() error {
 		return fmt.Errorf("Unsupported Docker Compose version found: %s", version)
 	}
 
+	if err := p.selectServices(); err != nil {
+		return err
+	}
+
 	// libcompose sanitizes the project name and removes any non alpha-numeric character.
 	// The following undoes that and sets the project name as user defined it.
 	return p.ecsContext.SetProjectName()
@@ -250,6 +265,26 @@ func (p *ecsProject) Scale(count int) error {
 	return p.entity.Scale(count)
 }
 
+func (p *ecsProject) Restart() error {
+	return p.entity.Restart()
+}
+
+func (p *ecsProject) History(limit int) (project.InfoSet, error) {
+	return p.entity.History(limit)
+}
+
+func (p *ecsProject) Diff(revision string) error {
+	return p.entity.Diff(revision)
+}
+
+func (p *ecsProject) Verify(urlPath string, expectStatus int, timeout time.Duration) error {
+	return p.entity.Verify(urlPath, expectStatus, timeout)
+}
+
+func (p *ecsProject) Rebalance(fix bool) error {
+	return p.entity.Rebalance(fix)
+}
+
 func (p *ecsProject) Stop() error {
 	return p.entity.Stop()
 }
@@ -0,0 +1,346 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	stsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts"
+	dockerclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
+	"github.com/docker/libcompose/config"
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// imageIDTagLength is the number of hex digits of the image ID used to tag built images that are
+	// not tagged in the compose files, so that an unchanged image keeps its tag and task definition
+	imageIDTagLength = 12
+
+	// buildxBuilder is the buildx builder created to build with buildx, since the default builder of
+	// the docker driver can neither build for several platforms nor export a cache to a registry
+	buildxBuilder = "ecs-cli"
+
+	// buildCacheTag is the tag of the build cache in the repository of each image
+	buildCacheTag = "buildcache"
+
+	// buildxDefaultTag is the tag pushed with buildx for images that are not tagged in the compose
+	// files; they are deployed by digest
+	buildxDefaultTag = "latest"
+)
+
+// make the docker daemon and the account lookup easily mockable in tests
+var (
+	newDockerClient = dockerclient.NewClient
+	newSTSClient    = stsclient.NewClient
+	runDockerBuild  = dockerBuild
+	runDocker       = dockerCLI
+)
+
+// buildImages builds the images of the services with a build section when the --build flag is
+// given, pushes them to Amazon ECR and sets the pushed images on the container configs
+func (p *ecsProject) buildImages() error {
+	var builds []*adapter.ContainerConfig
+	for i := range p.containerConfigs {
+		if p.containerConfigs[i].Build != nil {
+			builds = append(builds, &p.containerConfigs[i])
+		}
+	}
+
+	cliContext := p.ecsContext.CLIContext
+	platforms := buildPlatforms(cliContext.String(flags.BuildPlatformFlag))
+	useBuildx := len(platforms) > 0 || cliContext.Bool(flags.BuildCacheFlag)
+	if useBuildx && !cliContext.Bool(flags.BuildFlag) {
+		buildxFlag := flags.BuildCacheFlag
+		if len(platforms) > 0 {
+			buildxFlag = flags.BuildPlatformFlag
+		}
+		return fmt.Errorf("--%s requires --%s", buildxFlag, flags.BuildFlag)
+	}
+	if len(builds) == 0 {
+		return nil
+	}
+
+	if !cliContext.Bool(flags.BuildFlag) {
+		for _, containerConfig := range builds {
+			if containerConfig.Image == "" {
+				logrus.Warnf("Service %s has a build section but no image; build and push its image with --%s", containerConfig.Name, flags.BuildFlag)
+			}
+		}
+		return nil
+	}
+	if cliContext.Bool(flags.DryRunFlag) {
+		for _, containerConfig := range builds {
+			logrus.WithField("service", containerConfig.Name).Info("Skipping the image build in a dry run")
+		}
+		return nil
+	}
+	if p.ecsContext.ECRClient == nil {
+		return fmt.Errorf("Unable to push the built images without an ECR client")
+	}
+
+	accountID, err := newSTSClient(p.ecsContext.CommandConfig).GetAWSAccountID()
+	if err != nil {
+		return err
+	}
+	ecrAuth, err := p.ecsContext.ECRClient.GetAuthorizationTokenByID(accountID)
+	if err != nil {
+		return err
+	}
+
+	if useBuildx {
+		return p.buildxImages(builds, ecrAuth, platforms, cliContext.Bool(flags.BuildCacheFlag))
+	}
+
+	dockerClient, err := newDockerClient()
+	if err != nil {
+		return err
+	}
+	dockerAuth := docker.AuthConfiguration{
+		Username:      ecrAuth.Username,
+		Password:      ecrAuth.Password,
+		ServerAddress: ecrAuth.ProxyEndpoint,
+	}
+
+	for _, containerConfig := range builds {
+		logrus.WithField("service", containerConfig.Name).Info("Building image")
+		imageID, err := runDockerBuild(dockerBuildArgs(containerConfig.Build))
+		if err != nil {
+			return errors.Wrapf(err, "unable to build the image of service %s", containerConfig.Name)
+		}
+
+		repository, tag := p.buildRepository(containerConfig)
+		if tag == "" {
+			tag = imageIDTag(imageID)
+		}
+		repositoryURI := ecrAuth.Registry + "/" + repository
+		if err = dockerClient.TagImage(imageID, repositoryURI, tag); err != nil {
+			return err
+		}
+		if err = p.createRepositoryIfNeeded(repository); err != nil {
+			return err
+		}
+		if err = dockerClient.PushImage(repositoryURI, tag, ecrAuth.Registry, dockerAuth); err != nil {
+			return err
+		}
+		containerConfig.Image = repositoryURI + ":" + tag
+	}
+	return nil
+}
+
+// buildxImages builds and pushes the images with docker buildx, which pushes them itself, with the
+// credentials of the docker CLI. Images that are not tagged in the compose files are deployed by the
+// digest buildx pushed, which covers every platform of a multi-platform image.
+func (p *ecsProject) buildxImages(builds []*adapter.ContainerConfig, ecrAuth *ecrclient.Auth, platforms []string, cache bool) error {
+	if err := runDocker(ioutil.Discard, strings.NewReader(ecrAuth.Password), "login", "--username", ecrAuth.Username, "--password-stdin", ecrAuth.ProxyEndpoint); err != nil {
+		return errors.Wrap(err, "unable to log in to ECR")
+	}
+	if err := runDocker(ioutil.Discard, nil, "buildx", "inspect", buildxBuilder); err != nil {
+		logrus.WithField("builder", buildxBuilder).Info("Creating buildx builder")
+		if err = runDocker(os.Stderr, nil, "buildx", "create", "--name", buildxBuilder, "--driver", "docker-container"); err != nil {
+			return errors.Wrap(err, "unable to create the buildx builder")
+		}
+	}
+
+	for _, containerConfig := range builds {
+		repository, tag := p.buildRepository(containerConfig)
+		pushedTag := tag
+		if pushedTag == "" {
+			pushedTag = buildxDefaultTag
+		}
+		repositoryURI := ecrAuth.Registry + "/" + repository
+		if err := p.createRepositoryIfNeeded(repository); err != nil {
+			return err
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"service":   containerConfig.Name,
+			"platforms": strings.Join(platforms, ","),
+		}).Info("Building image with buildx")
+		digest, err := dockerBuildx(buildxArgs(containerConfig.Build, repositoryURI, pushedTag, platforms, cache))
+		if err != nil {
+			return errors.Wrapf(err, "unable to build the image of service %s", containerConfig.Name)
+		}
+
+		if tag != "" || digest == "" {
+			containerConfig.Image = repositoryURI + ":" + pushedTag
+		} else {
+			containerConfig.Image = repositoryURI + "@" + digest
+		}
+		logrus.WithField("image", containerConfig.Image).Info("Image pushed")
+	}
+	return nil
+}
+
+// createRepositoryIfNeeded creates the ECR repository of a built image if it does not exist
+func (p *ecsProject) createRepositoryIfNeeded(repository string) error {
+	if p.ecsContext.ECRClient.RepositoryExists(repository) {
+		return nil
+	}
+	_, err := p.ecsContext.ECRClient.CreateRepository(repository)
+	return err
+}
+
+// buildRepository returns the ECR repository and tag of the image built for a service: those of
+// its image, without the registry, or else a repository named after the project and the service
+func (p *ecsProject) buildRepository(containerConfig *adapter.ContainerConfig) (string, string) {
+	if containerConfig.Image == "" {
+		return strings.ToLower(p.ecsContext.ProjectName + "-" + containerConfig.Name), ""
+	}
+	image := containerConfig.Image
+	if parts := strings.SplitN(image, "/", 2); len(parts) == 2 && strings.ContainsAny(parts[0], ".:") {
+		image = parts[1]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i+1:]
+	}
+	return image, ""
+}
+
+// imageIDTag returns the tag derived from the ID of a built image
+func imageIDTag(imageID string) string {
+	tag := strings.TrimPrefix(imageID, "sha256:")
+	if len(tag) > imageIDTagLength {
+		tag = tag[:imageIDTagLength]
+	}
+	return tag
+}
+
+// dockerBuildArgs returns the arguments of docker build for the build section of a service
+func dockerBuildArgs(build *adapter.BuildConfig) []string {
+	var args []string
+	if build.Dockerfile != "" {
+		dockerfile := build.Dockerfile
+		// compose resolves the Dockerfile relative to the context, docker build to the current directory
+		if !filepath.IsAbs(dockerfile) && !config.IsValidRemote(build.Context) {
+			dockerfile = filepath.Join(build.Context, dockerfile)
+		}
+		args = append(args, "--file", dockerfile)
+	}
+	var names []string
+	for name := range build.Args {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if value := build.Args[name]; value != nil {
+			args = append(args, "--build-arg", name+"="+*value)
+		} else {
+			// docker build takes the value from the environment
+			args = append(args, "--build-arg", name)
+		}
+	}
+	if build.Target != "" {
+		args = append(args, "--target", build.Target)
+	}
+	return append(args, build.Context)
+}
+
+// buildxArgs returns the arguments of docker buildx build pushing the image of a service to the
+// repository, with the build cache in the same repository
+func buildxArgs(build *adapter.BuildConfig, repositoryURI, tag string, platforms []string, cache bool) []string {
+	args := []string{"--builder", buildxBuilder, "--push", "--tag", repositoryURI + ":" + tag}
+	if len(platforms) > 0 {
+		args = append(args, "--platform", strings.Join(platforms, ","))
+	}
+	if cache {
+		cacheRef := "type=registry,ref=" + repositoryURI + ":" + buildCacheTag
+		// ECR only accepts caches exported as image manifests
+		args = append(args, "--cache-from", cacheRef, "--cache-to", cacheRef+",mode=max,image-manifest=true,oci-mediatypes=true")
+	}
+	return append(args, dockerBuildArgs(build)...)
+}
+
+// buildPlatforms returns the platforms of the comma-separated list given with --platform
+func buildPlatforms(value string) []string {
+	var platforms []string
+	for _, platform := range strings.Split(value, ",") {
+		if platform = strings.TrimSpace(platform); platform != "" {
+			platforms = append(platforms, platform)
+		}
+	}
+	return platforms
+}
+
+// dockerBuildx runs docker buildx build with the arguments and returns the digest of the pushed image
+func dockerBuildx(args []string) (string, error) {
+	metadataFile, err := ioutil.TempFile("", "ecs-cli-buildx")
+	if err != nil {
+		return "", err
+	}
+	metadataFile.Close()
+	defer os.Remove(metadataFile.Name())
+
+	args = append([]string{"buildx", "build", "--metadata-file", metadataFile.Name()}, args...)
+	if err = runDocker(progress.Output(), nil, args...); err != nil {
+		return "", err
+	}
+	content, err := ioutil.ReadFile(metadataFile.Name())
+	if err != nil {
+		return "", err
+	}
+	metadata := struct {
+		Digest string `json:"containerimage.digest"`
+	}{}
+	if len(content) > 0 {
+		if err = json.Unmarshal(content, &metadata); err != nil {
+			return "", errors.Wrap(err, "unable to read the buildx metadata")
+		}
+	}
+	return metadata.Digest, nil
+}
+
+// dockerCLI runs the docker CLI with the arguments, writing its output to output
+func dockerCLI(output io.Writer, stdin io.Reader, args ...string) error {
+	cmd := exec.Command("docker", args...)
+	cmd.Stdin = stdin
+	cmd.Stdout = output
+	cmd.Stderr = output
+	return cmd.Run()
+}
+
+// dockerBuild runs docker build with the arguments and returns the ID of the built image
+func dockerBuild(args []string) (string, error) {
+	iidFile, err := ioutil.TempFile("", "ecs-cli-build")
+	if err != nil {
+		return "", err
+	}
+	iidFile.Close()
+	defer os.Remove(iidFile.Name())
+
+	cmd := exec.Command("docker", append([]string{"build", "--iidfile", iidFile.Name()}, args...)...)
+	cmd.Stdout = progress.Output()
+	cmd.Stderr = os.Stderr
+	if err = cmd.Run(); err != nil {
+		return "", err
+	}
+	imageID, err := ioutil.ReadFile(iidFile.Name())
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(imageID)), nil
+}
@@ -0,0 +1,383 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	mock_ecr "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock"
+	stsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts"
+	mock_sts "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts/mock"
+	dockerclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker"
+	mock_docker "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+const (
+	buildImageID  = "sha256:0123456789abcdef0123456789abcdef"
+	buildRegistry = "123456789012.dkr.ecr.us-west-2.amazonaws.com"
+)
+
+func TestParseComposeWithBuild(t *testing.T) {
+	testCases := map[string]string{
+		"v3": `version: '3'
+services:
+  web:
+    build:
+      context: ./web
+      dockerfile: Dockerfile.prod
+      args:
+        VERSION: "1.0"
+        TOKEN:
+  api:
+    image: api`,
+		"v2": `version: '2'
+services:
+  web:
+    build:
+      context: ./web
+      dockerfile: Dockerfile.prod
+      args:
+        VERSION: "1.0"
+  api:
+    image: api`,
+	}
+
+	for name, composeFile := range testCases {
+		t.Run(name, func(t *testing.T) {
+			project := setupTestProjectWithServices(t, composeFile, "", nil)
+			defer os.Remove(project.ecsContext.ComposeFiles[0])
+
+			err := project.parseCompose()
+			require.NoError(t, err, "Unexpected error parsing the compose file")
+
+			api, err := getContainerConfigByName("api", &project.containerConfigs)
+			require.NoError(t, err, "Unexpected error retrieving api config")
+			assert.Nil(t, api.Build, "Expected no build section for api")
+
+			web, err := getContainerConfigByName("web", &project.containerConfigs)
+			require.NoError(t, err, "Unexpected error retrieving web config")
+			require.NotNil(t, web.Build, "Expected a build section for web")
+			wrkDir := filepath.Dir(project.ecsContext.ComposeFiles[0])
+			assert.Equal(t, filepath.Join(wrkDir, "web"), web.Build.Context, "Expected the context relative to the compose file")
+			assert.Equal(t, "Dockerfile.prod", web.Build.Dockerfile, "Expected Dockerfile to match")
+			assert.Equal(t, "1.0", aws.StringValue(web.Build.Args["VERSION"]), "Expected build arg to match")
+			if name == "v3" {
+				assert.Contains(t, web.Build.Args, "TOKEN", "Expected build arg without value")
+			}
+		})
+	}
+}
+
+func TestBuildImages(t *testing.T) {
+	testCases := map[string]struct {
+		image         string
+		repository    string
+		tag           string
+		expectedImage string
+	}{
+		"without image": {
+			repository:    "myproject-web",
+			tag:           "0123456789ab",
+			expectedImage: buildRegistry + "/myproject-web:0123456789ab",
+		},
+		"with tagged image": {
+			image:         "team/web:1.2",
+			repository:    "team/web",
+			tag:           "1.2",
+			expectedImage: buildRegistry + "/team/web:1.2",
+		},
+		"with ECR image": {
+			image:         buildRegistry + "/web",
+			repository:    "web",
+			tag:           "0123456789ab",
+			expectedImage: buildRegistry + "/web:0123456789ab",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDocker, mockECR, teardown := setupBuildMocks(ctrl)
+			defer teardown()
+
+			var buildArgs []string
+			runDockerBuild = func(args []string) (string, error) {
+				buildArgs = args
+				return buildImageID, nil
+			}
+
+			repositoryURI := buildRegistry + "/" + tc.repository
+			gomock.InOrder(
+				mockECR.EXPECT().GetAuthorizationTokenByID("123456789012").Return(&ecrclient.Auth{
+					Registry:      buildRegistry,
+					ProxyEndpoint: "https://" + buildRegistry,
+					Username:      "AWS",
+					Password:      "secret",
+				}, nil),
+				mockDocker.EXPECT().TagImage(buildImageID, repositoryURI, tc.tag).Return(nil),
+				mockECR.EXPECT().RepositoryExists(tc.repository).Return(false),
+				mockECR.EXPECT().CreateRepository(tc.repository).Return(tc.repository, nil),
+				mockDocker.EXPECT().PushImage(repositoryURI, tc.tag, buildRegistry, docker.AuthConfiguration{
+					Username:      "AWS",
+					Password:      "secret",
+					ServerAddress: "https://" + buildRegistry,
+				}).Return(nil),
+			)
+
+			project := setupBuildProject(mockECR, true, false, tc.image)
+			err := project.buildImages()
+			assert.NoError(t, err, "Unexpected error building the images")
+			assert.Equal(t, []string{"/src/web"}, buildArgs, "Expected docker build of the context")
+			assert.Equal(t, tc.expectedImage, project.containerConfigs[0].Image, "Expected the pushed image")
+			assert.Equal(t, "api", project.containerConfigs[1].Image, "Expected the image of api to be unchanged")
+		})
+	}
+}
+
+func TestBuildImagesWithFailedBuild(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	_, mockECR, teardown := setupBuildMocks(ctrl)
+	defer teardown()
+
+	runDockerBuild = func(args []string) (string, error) {
+		return "", errors.New("exit status 1")
+	}
+	mockECR.EXPECT().GetAuthorizationTokenByID(gomock.Any()).Return(&ecrclient.Auth{Registry: buildRegistry}, nil)
+
+	project := setupBuildProject(mockECR, true, false, "")
+	err := project.buildImages()
+	assert.EqualError(t, err, "unable to build the image of service web: exit status 1")
+}
+
+func TestBuildImagesSkipped(t *testing.T) {
+	testCases := map[string]struct {
+		build  bool
+		dryRun bool
+	}{
+		"without build flag": {},
+		"in a dry run": {
+			build:  true,
+			dryRun: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			_, mockECR, teardown := setupBuildMocks(ctrl)
+			defer teardown()
+
+			runDockerBuild = func(args []string) (string, error) {
+				t.Fatal("Unexpected docker build")
+				return "", nil
+			}
+
+			project := setupBuildProject(mockECR, tc.build, tc.dryRun, "web")
+			err := project.buildImages()
+			assert.NoError(t, err, "Unexpected error skipping the build")
+			assert.Equal(t, "web", project.containerConfigs[0].Image, "Expected the image to be unchanged")
+		})
+	}
+}
+
+func TestBuildImagesWithBuildx(t *testing.T) {
+	testCases := map[string]struct {
+		image         string
+		builderExists bool
+		expectedImage string
+		expectedTag   string
+	}{
+		"without image": {
+			expectedImage: buildRegistry + "/myproject-web@sha256:feedface",
+			expectedTag:   buildRegistry + "/myproject-web:latest",
+		},
+		"with tagged image and existing builder": {
+			image:         "web:1.2",
+			builderExists: true,
+			expectedImage: buildRegistry + "/web:1.2",
+			expectedTag:   buildRegistry + "/web:1.2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			_, mockECR, teardown := setupBuildMocks(ctrl)
+			defer teardown()
+
+			var commands [][]string
+			runDocker = func(output io.Writer, stdin io.Reader, args ...string) error {
+				commands = append(commands, args)
+				switch args[0] + " " + args[1] {
+				case "login --username":
+					password, err := ioutil.ReadAll(stdin)
+					require.NoError(t, err, "Unexpected error reading the password")
+					assert.Equal(t, "secret", string(password), "Expected the ECR password on stdin")
+				case "buildx inspect":
+					if !tc.builderExists {
+						return errors.New("no builder")
+					}
+				case "buildx build":
+					return ioutil.WriteFile(args[3], []byte(`{"containerimage.digest": "sha256:feedface"}`), 0600)
+				}
+				return nil
+			}
+			mockECR.EXPECT().GetAuthorizationTokenByID(gomock.Any()).Return(&ecrclient.Auth{
+				Registry:      buildRegistry,
+				ProxyEndpoint: "https://" + buildRegistry,
+				Username:      "AWS",
+				Password:      "secret",
+			}, nil)
+			mockECR.EXPECT().RepositoryExists(gomock.Any()).Return(true)
+
+			project := setupBuildProject(mockECR, true, false, tc.image)
+			require.NoError(t, project.ecsContext.CLIContext.Set(flags.BuildPlatformFlag, "linux/amd64, linux/arm64"))
+			require.NoError(t, project.ecsContext.CLIContext.Set(flags.BuildCacheFlag, "true"))
+			err := project.buildImages()
+			assert.NoError(t, err, "Unexpected error building the images")
+			assert.Equal(t, tc.expectedImage, project.containerConfigs[0].Image, "Expected the pushed image")
+
+			expectedCommands := 3
+			if !tc.builderExists {
+				expectedCommands = 4
+			}
+			require.Len(t, commands, expectedCommands, "Expected login, builder and build commands")
+			assert.Equal(t, []string{"login", "--username", "AWS", "--password-stdin", "https://" + buildRegistry}, commands[0])
+			if !tc.builderExists {
+				assert.Equal(t, []string{"buildx", "create", "--name", "ecs-cli", "--driver", "docker-container"}, commands[2])
+			}
+			build := commands[len(commands)-1]
+			cacheRef := "type=registry,ref=" + strings.SplitN(tc.expectedTag, ":", 2)[0] + ":buildcache"
+			assert.Equal(t, []string{
+				"--builder", "ecs-cli",
+				"--push",
+				"--tag", tc.expectedTag,
+				"--platform", "linux/amd64,linux/arm64",
+				"--cache-from", cacheRef,
+				"--cache-to", cacheRef + ",mode=max,image-manifest=true,oci-mediatypes=true",
+				"/src/web",
+			}, build[4:], "Expected a multi-platform build with a registry cache")
+		})
+	}
+}
+
+func TestBuildImagesWithPlatformWithoutBuild(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	_, mockECR, teardown := setupBuildMocks(ctrl)
+	defer teardown()
+
+	project := setupBuildProject(mockECR, false, false, "web")
+	require.NoError(t, project.ecsContext.CLIContext.Set(flags.BuildPlatformFlag, "linux/arm64"))
+	err := project.buildImages()
+	assert.EqualError(t, err, "--platform requires --build")
+}
+
+func TestDockerBuildArgs(t *testing.T) {
+	args := dockerBuildArgs(&adapter.BuildConfig{
+		Context:    "/src/web",
+		Dockerfile: "docker/Dockerfile",
+		Args: map[string]*string{
+			"VERSION": aws.String("1.0"),
+			"TOKEN":   nil,
+		},
+		Target: "release",
+	})
+	assert.Equal(t, []string{
+		"--file", "/src/web/docker/Dockerfile",
+		"--build-arg", "TOKEN",
+		"--build-arg", "VERSION=1.0",
+		"--target", "release",
+		"/src/web",
+	}, args)
+
+	args = dockerBuildArgs(&adapter.BuildConfig{
+		Context:    "https://github.com/docker/rootfs.git",
+		Dockerfile: "Dockerfile.prod",
+	})
+	assert.Equal(t, []string{"--file", "Dockerfile.prod", "https://github.com/docker/rootfs.git"}, args,
+		"Expected the Dockerfile of a remote context to be left unchanged")
+}
+
+// setupBuildMocks replaces the docker and STS client constructors with mocks, and returns a function
+// restoring them together with the docker commands
+func setupBuildMocks(ctrl *gomock.Controller) (*mock_docker.MockClient, *mock_ecr.MockClient, func()) {
+	mockDocker := mock_docker.NewMockClient(ctrl)
+	mockECR := mock_ecr.NewMockClient(ctrl)
+	mockSTS := mock_sts.NewMockClient(ctrl)
+	mockSTS.EXPECT().GetAWSAccountID().Return("123456789012", nil).AnyTimes()
+
+	oldDockerClient, oldSTSClient, oldDockerBuild, oldDocker := newDockerClient, newSTSClient, runDockerBuild, runDocker
+	newDockerClient = func() (dockerclient.Client, error) {
+		return mockDocker, nil
+	}
+	newSTSClient = func(commandConfig *config.CommandConfig) stsclient.Client {
+		return mockSTS
+	}
+	teardown := func() {
+		newDockerClient, newSTSClient, runDockerBuild, runDocker = oldDockerClient, oldSTSClient, oldDockerBuild, oldDocker
+	}
+	return mockDocker, mockECR, teardown
+}
+
+func setupBuildProject(ecrClient ecrclient.Client, build, dryRun bool, image string) *ecsProject {
+	flagSet := flag.NewFlagSet("up", 0)
+	flagSet.Bool(flags.BuildFlag, build, "")
+	flagSet.Bool(flags.DryRunFlag, dryRun, "")
+	flagSet.String(flags.BuildPlatformFlag, "", "")
+	flagSet.Bool(flags.BuildCacheFlag, false, "")
+
+	ecsContext := &context.ECSContext{
+		CLIContext: cli.NewContext(nil, flagSet, nil),
+		ECRClient:  ecrClient,
+	}
+	ecsContext.ProjectName = "MyProject"
+
+	return &ecsProject{
+		ecsContext: ecsContext,
+		containerConfigs: []adapter.ContainerConfig{
+			{
+				Name:  "web",
+				Image: image,
+				Build: &adapter.BuildConfig{Context: "/src/web"},
+			},
+			{
+				Name:  "api",
+				Image: "api",
+			},
+		},
+	}
+}
@@ -8,13 +8,27 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/docker/libcompose/config"
 	"github.com/docker/libcompose/project"
+	"github.com/docker/libcompose/yaml"
 	"github.com/sirupsen/logrus"
 )
 
 func (p *ecsProject) parseV1V2() (*[]adapter.ContainerConfig, error) {
 	logrus.Debug("Parsing v1/2 project...")
 
-	libcomposeProject := project.NewProject(&p.ecsContext.Context, nil, nil)
+	p.serviceProfiles = make(map[string][]string)
+	parseOptions := &config.ParseOptions{
+		Interpolate: true,
+		Validate:    true,
+		Preprocess: func(services config.RawServiceMap) (config.RawServiceMap, error) {
+			for serviceName, service := range services {
+				if err := popProfiles(serviceName, service, p.serviceProfiles); err != nil {
+					return nil, err
+				}
+			}
+			return services, nil
+		},
+	}
+	libcomposeProject := project.NewProject(&p.ecsContext.Context, nil, parseOptions)
 	// libcompose.Project#Parse populates project information based on its
 	// context. It sets up the name, the composefile and the composebytes
 	// (the composefile content). This is where libcompose ServiceConfigs
@@ -144,7 +158,21 @@ func convertV1V2ToContainerConfig(context *project.Context, serviceName string,
 		VolumesFrom:           volumesFrom,
 		User:                  service.User,
 		WorkingDirectory:      service.WorkingDir,
+		Build:                 convertV1V2ToBuildConfig(service.Build),
 	}
 
 	return outputConfig, nil
 }
+
+// convertV1V2ToBuildConfig returns the build section of a service, whose context libcompose
+// resolves relative to the compose file
+func convertV1V2ToBuildConfig(build yaml.Build) *adapter.BuildConfig {
+	if build.Context == "" {
+		return nil
+	}
+	return &adapter.BuildConfig{
+		Context:    build.Context,
+		Dockerfile: build.Dockerfile,
+		Args:       build.Args,
+	}
+}
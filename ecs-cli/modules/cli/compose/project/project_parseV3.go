@@ -17,6 +17,7 @@ import (
 	"github.com/docker/cli/cli/compose/loader"
 	"github.com/docker/cli/cli/compose/types"
 	"github.com/docker/cli/opts"
+	"github.com/docker/libcompose/config"
 	"github.com/docker/libcompose/yaml"
 )
 
@@ -25,7 +26,8 @@ const dotEnvFileName = ".env"
 func (p *ecsProject) parseV3() (*[]adapter.ContainerConfig, error) {
 	log.Debug("Parsing v3 project...")
 
-	v3Config, err := getV3Config(p.ecsContext.ComposeFiles)
+	p.serviceProfiles = make(map[string][]string)
+	v3Config, err := getV3Config(p.ecsContext.ComposeFiles, p.serviceProfiles)
 	if err != nil {
 		return nil, err
 	}
@@ -36,6 +38,11 @@ func (p *ecsProject) parseV3() (*[]adapter.ContainerConfig, error) {
 	}
 	p.volumes = servVols
 
+	wrkDir, err := getWorkingDir(p.ecsContext.ComposeFiles[0])
+	if err != nil {
+		return nil, err
+	}
+
 	// convert ServiceConfigs to ContainerConfigs
 	conConfigs := []adapter.ContainerConfig{}
 	for _, service := range v3Config.Services {
@@ -43,14 +50,16 @@ func (p *ecsProject) parseV3() (*[]adapter.ContainerConfig, error) {
 		if err != nil {
 			return nil, err
 		}
+		cCon.Build = convertV3ToBuildConfig(service.Build, wrkDir)
 		conConfigs = append(conConfigs, *cCon)
 	}
 
 	return &conConfigs, nil
 }
 
-// parses compose files into a docker/cli Config, which contains v3 ServiceConfigs
-func getV3Config(composeFiles []string) (*types.Config, error) {
+// parses compose files into a docker/cli Config, which contains v3 ServiceConfigs, and
+// records the profiles of the services in serviceProfiles
+func getV3Config(composeFiles []string, serviceProfiles map[string][]string) (*types.Config, error) {
 	configFiles := []types.ConfigFile{}
 	for _, file := range composeFiles {
 
@@ -62,6 +71,15 @@ func getV3Config(composeFiles []string) (*types.Config, error) {
 		if err != nil {
 			return nil, err
 		}
+		if services, ok := parsedFile["services"].(map[string]interface{}); ok {
+			for serviceName, service := range services {
+				if service, ok := service.(map[string]interface{}); ok {
+					if err := popProfiles(serviceName, service, serviceProfiles); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
 		configFile := types.ConfigFile{
 			Filename: file,
 			Config:   parsedFile,
@@ -119,6 +137,10 @@ func convertToContainerConfig(serviceConfig types.ServiceConfig, serviceVols *ad
 		WorkingDirectory:      serviceConfig.WorkingDir,
 	}
 
+	if err := convertToContainerResources(serviceConfig.Deploy.Resources, c); err != nil {
+		return nil, errors.Wrapf(err, "invalid deploy resources for service %s", serviceConfig.Name)
+	}
+
 	devices, err := adapter.ConvertToDevices(serviceConfig.Devices)
 	if err != nil {
 		return nil, err
@@ -220,6 +242,24 @@ func convertToContainerConfig(serviceConfig types.ServiceConfig, serviceVols *ad
 	return c, nil
 }
 
+// convertV3ToBuildConfig returns the build section of a service, with its context resolved
+// relative to the directory of the first compose file
+func convertV3ToBuildConfig(build types.BuildConfig, wrkDir string) *adapter.BuildConfig {
+	if build.Context == "" {
+		return nil
+	}
+	context := build.Context
+	if !filepath.IsAbs(context) && !config.IsValidRemote(context) {
+		context = filepath.Join(wrkDir, context)
+	}
+	return &adapter.BuildConfig{
+		Context:    context,
+		Dockerfile: build.Dockerfile,
+		Args:       build.Args,
+		Target:     build.Target,
+	}
+}
+
 func getWorkingDir(fileName string) (string, error) {
 	pwd, err := filepath.Abs(fileName)
 	if err != nil {
@@ -259,13 +299,33 @@ func convertToECSUlimits(ulimits map[string]*types.UlimitsConfig) []*ecs.Ulimit
 	return ecsUlimits
 }
 
+// convertToContainerResources sets the container resources from the deploy resources of a service.
+// Memory limits become the hard memory limit and memory reservations the soft limit. Since ECS
+// container cpu is a reservation rather than a limit, cpus reservations take precedence over limits.
+func convertToContainerResources(resources types.Resources, c *adapter.ContainerConfig) error {
+	cpus := ""
+	if resources.Limits != nil {
+		c.Memory = adapter.ConvertToMemoryInMB(int64(resources.Limits.MemoryBytes))
+		cpus = resources.Limits.NanoCPUs
+	}
+	if resources.Reservations != nil {
+		c.MemoryReservation = adapter.ConvertToMemoryInMB(int64(resources.Reservations.MemoryBytes))
+		if resources.Reservations.NanoCPUs != "" {
+			cpus = resources.Reservations.NanoCPUs
+		}
+	}
+	cpu, err := adapter.ConvertToCPUUnits(cpus)
+	if err != nil {
+		return err
+	}
+	c.CPU = cpu
+	return nil
+}
+
 func logWarningForDeployFields(d types.DeployConfig, serviceName string) {
-	if d.Resources.Limits != nil || d.Resources.Reservations != nil {
-		log.WithFields(log.Fields{
-			"option name":  "deploy",
-			"service name": serviceName,
-		}).Warn("Skipping unsupported YAML option for service... service-level resources should be configured in the ecs-param.yml file.")
-	} else if !reflect.DeepEqual(d, types.DeployConfig{}) {
+	// resources are converted to container resources
+	d.Resources = types.Resources{}
+	if !reflect.DeepEqual(d, types.DeployConfig{}) {
 		log.WithFields(log.Fields{
 			"option name":  "deploy",
 			"service name": serviceName,
@@ -435,6 +435,90 @@ volumes:
 	verifyContainerConfig(t, mysqlCon, *mysql)
 }
 
+func TestParseV3WithVolumeDriverAndReadOnlyBindMount(t *testing.T) {
+	webCon := adapter.ContainerConfig{
+		Name:  "web",
+		Image: "httpd",
+		MountPoints: []*ecs.MountPoint{
+			{
+				ContainerPath: aws.String("/usr/local/apache2/htdocs"),
+				ReadOnly:      aws.Bool(true),
+				SourceVolume:  aws.String("volume-2"),
+			},
+			{
+				ContainerPath: aws.String("/data"),
+				ReadOnly:      aws.Bool(false),
+				SourceVolume:  aws.String("nfs"),
+			},
+			{
+				ContainerPath: aws.String("/cache"),
+				ReadOnly:      aws.Bool(true),
+				SourceVolume:  aws.String("cache"),
+			},
+		},
+	}
+
+	// set up file
+	composeFileString := `version: '3.2'
+services:
+  web:
+    image: httpd
+    volumes:
+      - type: bind
+        source: /opt/site
+        target: /usr/local/apache2/htdocs
+        read_only: true
+      - nfs:/data
+      - cache:/cache:ro
+volumes:
+  nfs:
+    driver: local
+    driver_opts:
+      type: nfs
+      o: addr=10.0.0.1,rw
+      device: ":/exports"
+  cache:
+    external: true`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(composeFileString))
+	assert.NoError(t, err, "Unexpected error writing file")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	// add files to projects
+	project := setupTestProject(t)
+	project.ecsContext.ComposeFiles = append(project.ecsContext.ComposeFiles, tmpfile.Name())
+
+	actualConfigs, err := project.parseV3()
+	assert.NoError(t, err, "Unexpected error parsing file")
+
+	web, err := getContainerConfigByName(webCon.Name, actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving web config")
+	verifyContainerConfig(t, webCon, *web)
+
+	expectedNFSConfig := &ecs.DockerVolumeConfiguration{
+		Driver: aws.String("local"),
+		DriverOpts: aws.StringMap(map[string]string{
+			"type":   "nfs",
+			"o":      "addr=10.0.0.1,rw",
+			"device": ":/exports",
+		}),
+	}
+	expectedCacheConfig := &ecs.DockerVolumeConfiguration{
+		Scope:         aws.String("shared"),
+		Autoprovision: aws.Bool(false),
+	}
+	assert.Equal(t, expectedNFSConfig, project.volumes.VolumeConfigs["nfs"], "Expected nfs volume configuration to match")
+	assert.Equal(t, expectedCacheConfig, project.volumes.VolumeConfigs["cache"], "Expected external volume configuration to match")
+	assert.Equal(t, map[string]string{"/opt/site": "volume-2"}, project.volumes.VolumeWithHost, "Expected bind mount host path to match")
+}
+
 func TestParseV3_ErrorWithExternalVolume(t *testing.T) {
 	// set up file with invalid Volume config ("external")
 	composeFileString := `version: '3'
@@ -773,6 +857,73 @@ services:
 	verifyContainerConfig(t, wordpressCon, *wp)
 }
 
+func TestParseV3WithDeployResources(t *testing.T) {
+	// set up expected ContainerConfig values
+	webCon := adapter.ContainerConfig{
+		Name:              "web",
+		Image:             "httpd",
+		CPU:               512,
+		Memory:            1024,
+		MemoryReservation: 256,
+	}
+	workerCon := adapter.ContainerConfig{
+		Name:   "worker",
+		Image:  "busybox",
+		CPU:    256,
+		Memory: 512,
+	}
+
+	// set up file
+	composeFileString := `version: '3'
+services:
+  web:
+    image: httpd
+    deploy:
+      resources:
+        limits:
+          cpus: '1'
+          memory: 1G
+        reservations:
+          cpus: '0.5'
+          memory: 256M
+  worker:
+    image: busybox
+    deploy:
+      resources:
+        limits:
+          cpus: '0.25'
+          memory: 512M`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(composeFileString))
+	assert.NoError(t, err, "Unexpected error writing file")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	// add files to projects
+	project := setupTestProject(t)
+	project.ecsContext.ComposeFiles = append(project.ecsContext.ComposeFiles, tmpfile.Name())
+
+	// assert # and content of container configs matches expected
+	actualConfigs, err := project.parseV3()
+	assert.NoError(t, err, "Unexpected error parsing file")
+
+	assert.Equal(t, 2, len(*actualConfigs))
+
+	web, err := getContainerConfigByName(webCon.Name, actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving web config")
+	verifyContainerConfig(t, webCon, *web)
+
+	worker, err := getContainerConfigByName(workerCon.Name, actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving worker config")
+	verifyContainerConfig(t, workerCon, *worker)
+}
+
 // TODO: add check for fields not used by V3, use to also check V1V2 ContainerConfigs?
 func verifyContainerConfig(t *testing.T, expected, actual adapter.ContainerConfig) {
 	assert.ElementsMatch(t, expected.CapAdd, actual.CapAdd, "Expected CapAdd to match")
@@ -801,6 +952,9 @@ func verifyContainerConfig(t *testing.T, expected, actual adapter.ContainerConfi
 	assert.ElementsMatch(t, expected.Ulimits, actual.Ulimits, "Expected Ulimits to match")
 	assert.Equal(t, expected.User, actual.User, "Expected User to match")
 	assert.Equal(t, expected.WorkingDirectory, actual.WorkingDirectory, "Expected WorkingDirectory to match")
+	assert.Equal(t, expected.CPU, actual.CPU, "Expected CPU to match")
+	assert.Equal(t, expected.Memory, actual.Memory, "Expected Memory to match")
+	assert.Equal(t, expected.MemoryReservation, actual.MemoryReservation, "Expected MemoryReservation to match")
 	if expected.HealthCheck != nil && actual.HealthCheck != nil {
 		assert.ElementsMatch(t, aws.StringValueSlice(expected.HealthCheck.Command), aws.StringValueSlice(actual.HealthCheck.Command), "Expected healthcheck command to match")
 		assert.Equal(t, expected.HealthCheck.Interval, actual.HealthCheck.Interval, "Expected healthcheck interval to match")
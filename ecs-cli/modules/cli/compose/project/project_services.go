@@ -0,0 +1,164 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/sirupsen/logrus"
+)
+
+// profilesKey assigns a service to profiles, see https://docs.docker.com/compose/profiles/.
+// Neither libcompose nor the v3 loader know the key, so it is removed from the services
+// before they validate them.
+const profilesKey = "profiles"
+
+// popProfiles removes the profiles key from the raw configuration of a service and records its
+// profiles. A compose file that does not set the key keeps the profiles of the previous files.
+func popProfiles(serviceName string, service map[string]interface{}, serviceProfiles map[string][]string) error {
+	value, ok := service[profilesKey]
+	if !ok {
+		return nil
+	}
+	delete(service, profilesKey)
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("The profiles of service %s must be a list", serviceName)
+	}
+	profiles := []string{}
+	for _, item := range items {
+		profile, ok := item.(string)
+		if !ok || profile == "" {
+			return fmt.Errorf("The profiles of service %s must be non-empty strings", serviceName)
+		}
+		profiles = append(profiles, profile)
+	}
+	serviceProfiles[serviceName] = profiles
+	return nil
+}
+
+// selectServices keeps the container configs of the services given with the --services flag, or
+// else of the services enabled by the active profiles, together with the services they link to or
+// mount volumes from.
+func (p *ecsProject) selectServices() error {
+	requested := p.requestedServices()
+	if len(requested) == 0 && len(p.serviceProfiles) == 0 {
+		return nil
+	}
+
+	configs := make(map[string]*adapter.ContainerConfig)
+	for i := range p.containerConfigs {
+		configs[p.containerConfigs[i].Name] = &p.containerConfigs[i]
+	}
+
+	initial := requested
+	if len(requested) > 0 {
+		for _, serviceName := range requested {
+			if _, ok := configs[serviceName]; !ok {
+				return fmt.Errorf("Service %s given with --%s is not defined in the compose files", serviceName, flags.ServicesFlag)
+			}
+		}
+	} else {
+		activeProfiles := p.activeProfiles()
+		for _, containerConfig := range p.containerConfigs {
+			if isEnabled(p.serviceProfiles[containerConfig.Name], activeProfiles) {
+				initial = append(initial, containerConfig.Name)
+			}
+		}
+	}
+
+	selected := make(map[string]bool)
+	var visit func(serviceName, dependent string)
+	visit = func(serviceName, dependent string) {
+		containerConfig, ok := configs[serviceName]
+		if !ok || selected[serviceName] {
+			return
+		}
+		selected[serviceName] = true
+		if dependent != "" {
+			logrus.Infof("Including service %s, which service %s depends on", serviceName, dependent)
+		}
+		for _, link := range containerConfig.Links {
+			visit(strings.SplitN(link, ":", 2)[0], serviceName)
+		}
+		for _, volumeFrom := range containerConfig.VolumesFrom {
+			visit(aws.StringValue(volumeFrom.SourceContainer), serviceName)
+		}
+	}
+	for _, serviceName := range initial {
+		visit(serviceName, "")
+	}
+
+	containerConfigs := []adapter.ContainerConfig{}
+	var names []string
+	for _, containerConfig := range p.containerConfigs {
+		if selected[containerConfig.Name] {
+			containerConfigs = append(containerConfigs, containerConfig)
+			names = append(names, containerConfig.Name)
+		}
+	}
+	if len(containerConfigs) == 0 {
+		return fmt.Errorf("No service is enabled; activate a profile with --%s or select services with --%s", flags.ComposeProfileFlag, flags.ServicesFlag)
+	}
+	if len(containerConfigs) < len(p.containerConfigs) {
+		logrus.Infof("Using services %s of the compose files", strings.Join(names, ", "))
+	}
+	p.containerConfigs = containerConfigs
+	return nil
+}
+
+// requestedServices returns the services given with the --services flag
+func (p *ecsProject) requestedServices() []string {
+	var services []string
+	for _, service := range strings.Split(p.ecsContext.CLIContext.String(flags.ServicesFlag), ",") {
+		if service = strings.TrimSpace(service); service != "" {
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
+// activeProfiles returns the profiles given with the --profile flag or the COMPOSE_PROFILES
+// environment variable, which may list several profiles separated by commas
+func (p *ecsProject) activeProfiles() map[string]bool {
+	profiles := make(map[string]bool)
+	for _, value := range p.ecsContext.CLIContext.GlobalStringSlice(flags.ComposeProfileFlag) {
+		for _, profile := range strings.Split(value, ",") {
+			if profile = strings.TrimSpace(profile); profile != "" {
+				profiles[profile] = true
+			}
+		}
+	}
+	return profiles
+}
+
+// isEnabled returns whether a service assigned to the profiles is enabled: services without
+// profiles are always enabled, the others when one of their profiles is active or when every
+// profile is activated with "*"
+func isEnabled(profiles []string, activeProfiles map[string]bool) bool {
+	if len(profiles) == 0 || activeProfiles["*"] {
+		return true
+	}
+	for _, profile := range profiles {
+		if activeProfiles[profile] {
+			return true
+		}
+	}
+	return false
+}
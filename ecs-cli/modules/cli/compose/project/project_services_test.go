@@ -0,0 +1,184 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+const profilesComposeV3 = `version: '3'
+services:
+  web:
+    image: nginx
+    links:
+      - api:backend
+  api:
+    image: api
+  worker:
+    image: worker
+    profiles: ["jobs"]
+  debug:
+    image: busybox
+    profiles: ["debug", "jobs"]`
+
+const profilesComposeV2 = `version: '2'
+services:
+  web:
+    image: nginx
+  worker:
+    image: worker
+    volumes_from:
+      - cache
+  cache:
+    image: redis
+    profiles:
+      - cache`
+
+func TestParseComposeWithProfiles(t *testing.T) {
+	testCases := map[string]struct {
+		composeFile string
+		services    string
+		profiles    []string
+		expected    []string
+	}{
+		"v3 without active profile": {
+			composeFile: profilesComposeV3,
+			expected:    []string{"api", "web"},
+		},
+		"v3 with active profile": {
+			composeFile: profilesComposeV3,
+			profiles:    []string{"jobs"},
+			expected:    []string{"api", "debug", "web", "worker"},
+		},
+		"v3 with comma-separated profiles": {
+			composeFile: profilesComposeV3,
+			profiles:    []string{"debug,other"},
+			expected:    []string{"api", "debug", "web"},
+		},
+		"v3 with all profiles": {
+			composeFile: profilesComposeV3,
+			profiles:    []string{"*"},
+			expected:    []string{"api", "debug", "web", "worker"},
+		},
+		"v3 with selected services": {
+			composeFile: profilesComposeV3,
+			services:    "web, worker",
+			expected:    []string{"api", "web", "worker"},
+		},
+		"v2 without active profile": {
+			composeFile: profilesComposeV2,
+			expected:    []string{"cache", "web", "worker"},
+		},
+		"v2 with selected service": {
+			composeFile: profilesComposeV2,
+			services:    "web",
+			expected:    []string{"web"},
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			project := setupTestProjectWithServices(t, test.composeFile, test.services, test.profiles)
+			defer os.Remove(project.ecsContext.ComposeFiles[0])
+
+			err := project.parseCompose()
+			require.NoError(t, err, "Unexpected error parsing the compose file")
+			assert.ElementsMatch(t, test.expected, containerNames(project), "Expected selected services to match")
+		})
+	}
+}
+
+func TestParseComposeWithUnknownService(t *testing.T) {
+	project := setupTestProjectWithServices(t, profilesComposeV3, "web,missing", nil)
+	defer os.Remove(project.ecsContext.ComposeFiles[0])
+
+	err := project.parseCompose()
+	assert.Error(t, err, "Expected error when selecting a service that is not defined")
+}
+
+func TestParseComposeWithoutEnabledService(t *testing.T) {
+	composeFile := `version: '3'
+services:
+  worker:
+    image: worker
+    profiles: ["jobs"]`
+	project := setupTestProjectWithServices(t, composeFile, "", nil)
+	defer os.Remove(project.ecsContext.ComposeFiles[0])
+
+	err := project.parseCompose()
+	assert.Error(t, err, "Expected error when no service is enabled")
+}
+
+func TestParseComposeWithInvalidProfiles(t *testing.T) {
+	composeFile := `version: '3'
+services:
+  worker:
+    image: worker
+    profiles: jobs`
+	project := setupTestProjectWithServices(t, composeFile, "", nil)
+	defer os.Remove(project.ecsContext.ComposeFiles[0])
+
+	err := project.parseCompose()
+	assert.Error(t, err, "Expected error when the profiles are not a list")
+}
+
+func setupTestProjectWithServices(t *testing.T, composeFile, services string, profiles []string) *ecsProject {
+	tmpfile, err := ioutil.TempFile("", "test")
+	require.NoError(t, err, "Unexpected error in creating test file")
+	_, err = tmpfile.Write([]byte(composeFile))
+	require.NoError(t, err, "Unexpected error in writing to test file")
+	require.NoError(t, tmpfile.Close(), "Unexpected error closing file")
+
+	envLookup, err := mockGetDefaultEnvironment()
+	require.NoError(t, err, "Unexpected error setting up environment lookup")
+	resourceLookup, err := composeutils.GetDefaultResourceLookup()
+	require.NoError(t, err, "Unexpected error setting up resource lookup")
+
+	profileValue := cli.StringSlice(profiles)
+	composeFlagSet := flag.NewFlagSet("compose", 0)
+	composeFlagSet.String(flags.ProjectNameFlag, testProjectName, "")
+	composeFlagSet.Var(&profileValue, flags.ComposeProfileFlag, "")
+	upFlagSet := flag.NewFlagSet("up", 0)
+	upFlagSet.String(flags.ServicesFlag, services, "")
+
+	parentContext := cli.NewContext(nil, composeFlagSet, nil)
+	ecsContext := &context.ECSContext{
+		CLIContext: cli.NewContext(nil, upFlagSet, parentContext),
+	}
+	ecsContext.EnvironmentLookup = envLookup
+	ecsContext.ResourceLookup = resourceLookup
+	ecsContext.ComposeFiles = []string{tmpfile.Name()}
+
+	return &ecsProject{
+		ecsContext: ecsContext,
+	}
+}
+
+func containerNames(project *ecsProject) []string {
+	var names []string
+	for _, containerConfig := range project.ContainerConfigs() {
+		names = append(names, containerConfig.Name)
+	}
+	return names
+}
@@ -319,7 +319,7 @@ func setupTestProjectWithECSRegistryCreds(t *testing.T, ecsParamsFileName, credF
 	flagSet.String(flags.RegistryCredsFileNameFlag, credFileName, "")
 
 	parentContext := cli.NewContext(nil, flagSet, nil)
-	cliContext := cli.NewContext(nil, nil, parentContext)
+	cliContext := cli.NewContext(nil, flag.NewFlagSet("up", 0), parentContext)
 
 	ecsContext := &context.ECSContext{
 		CLIContext: cliContext,
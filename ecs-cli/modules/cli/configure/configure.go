@@ -137,6 +137,13 @@ func Cluster(context *cli.Context) error {
 		CFNStackName:             cfnStackName,
 		ComposeServiceNamePrefix: composeServiceNamePrefix,
 		DefaultLaunchType:        launchType,
+		AuditLogFile:             context.String(flags.AuditLogFileFlag),
+		AuditLogGroup:            context.String(flags.AuditLogGroupFlag),
+		NotificationWebhookURL:   context.String(flags.NotificationWebhookURLFlag),
+		NotificationTopicArn:     context.String(flags.NotificationTopicArnFlag),
+		DeployMetricsNamespace:   context.String(flags.DeployMetricsNamespaceFlag),
+		DeployLockTable:          context.String(flags.DeployLockTableFlag),
+		InventoryTable:           context.String(flags.InventoryTableFlag),
 	}
 
 	rdwr, err := config.NewReadWriter()
@@ -14,9 +14,11 @@
 package configure
 
 import (
+	"bytes"
 	"flag"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
@@ -536,3 +538,57 @@ func TestMigrateWarningConfigNotModified(t *testing.T) {
 	assert.Equal(t, cfnStackNamePrefix, cliConfig.CFNStackNamePrefix)
 	assert.Equal(t, cfnStackNamePrefix, cliConfig.CFNStackName)
 }
+
+func TestExportImport(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "test")
+	if err != nil {
+		t.Fatal("Error while creating the dummy ecs config directory")
+	}
+	os.Setenv("HOME", tempDirName)
+	os.Setenv(flags.ConfigPassphraseEnvVar, "passphrase")
+	defer os.Unsetenv("HOME")
+	defer os.Unsetenv(flags.ConfigPassphraseEnvVar)
+	defer os.RemoveAll(tempDirName)
+
+	err = Cluster(createClusterConfig(profileName, clusterName, config.LaunchTypeFargate))
+	assert.NoError(t, err, "Unexpected error configuring cluster")
+	err = Profile(createProfileConfig(profileName, awsAccessKey, awsSecretKey))
+	assert.NoError(t, err, "Unexpected error configuring profile")
+
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.EncryptProfilesFlag, true, "")
+	out := &bytes.Buffer{}
+	exportOutput = out
+	defer func() { exportOutput = os.Stdout }()
+
+	err = Export(cli.NewContext(nil, flagSet, nil))
+	assert.NoError(t, err, "Unexpected error exporting configuration")
+	assert.Contains(t, out.String(), clusterName)
+	assert.Contains(t, out.String(), "encrypted_ecs_profiles")
+	assert.NotContains(t, out.String(), awsSecretKey, "Expected credentials to be encrypted")
+
+	// import on a new machine
+	newHome, err := ioutil.TempDir("", "test")
+	if err != nil {
+		t.Fatal("Error while creating the dummy ecs config directory")
+	}
+	defer os.RemoveAll(newHome)
+	os.Setenv("HOME", newHome)
+
+	envFile := filepath.Join(newHome, "env.yml")
+	err = ioutil.WriteFile(envFile, out.Bytes(), 0600)
+	assert.NoError(t, err, "Unexpected error writing exported configuration")
+
+	flagSet = flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Parse([]string{envFile})
+	err = Import(cli.NewContext(nil, flagSet, nil))
+	assert.NoError(t, err, "Unexpected error importing configuration")
+
+	parser, err := config.NewReadWriter()
+	assert.NoError(t, err, "Error reading config")
+	readConfig, err := parser.Get("", "")
+	assert.NoError(t, err, "Error reading config")
+	assert.Equal(t, clusterName, readConfig.Cluster, "Cluster name mismatch in config.")
+	assert.Equal(t, config.LaunchTypeFargate, readConfig.DefaultLaunchType, "Launch Type mismatch in config.")
+	assert.Equal(t, awsSecretKey, readConfig.AWSSecretKey, "Secret Key mismatch in config.")
+}
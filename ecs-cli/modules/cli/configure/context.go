@@ -0,0 +1,120 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package configure
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// contextOutput is where the contexts are listed; it can be replaced in tests
+var contextOutput io.Writer = os.Stdout
+
+// Context is the callback for the ctx command. With --show-context, it prints the name of the active
+// context, the default cluster configuration, for use in a shell prompt, and nothing if there is none.
+func Context(context *cli.Context) error {
+	if !context.Bool(flags.ShowContextFlag) {
+		return cli.ShowSubcommandHelp(context)
+	}
+	clusterConfig, err := readClusterConfigs()
+	if err != nil {
+		// a prompt must not print errors when no cluster is configured
+		logrus.Debugf("Error reading the cluster configurations: %s", err)
+		return nil
+	}
+	if clusterConfig.Default != "" {
+		fmt.Fprintln(contextOutput, clusterConfig.Default)
+	}
+	return nil
+}
+
+// ListContexts is the callback for the ctx list subcommand. It lists the cluster configurations,
+// marking the active one, the default cluster configuration.
+func ListContexts(context *cli.Context) error {
+	clusterConfig, err := readClusterConfigs()
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range clusterConfig.Clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := tabwriter.NewWriter(contextOutput, 8, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "CURRENT\tNAME\tCLUSTER\tREGION\tLAUNCH TYPE")
+	for _, name := range names {
+		cluster := clusterConfig.Clusters[name]
+		current := ""
+		if name == clusterConfig.Default {
+			current = "*"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", current, name, cluster.Cluster, cluster.Region, cluster.DefaultLaunchType)
+	}
+	return w.Flush()
+}
+
+// UseContext is the callback for the ctx use subcommand. It makes the given cluster configuration the
+// default one, like 'ecs-cli configure default'.
+func UseContext(context *cli.Context) error {
+	name := context.Args().First()
+	if name == "" {
+		return fmt.Errorf("Specify the name of the cluster configuration to use; run 'ecs-cli ctx list' to list them")
+	}
+	clusterConfig, err := readClusterConfigs()
+	if err != nil {
+		return err
+	}
+	cluster, ok := clusterConfig.Clusters[name]
+	if !ok {
+		return fmt.Errorf("Cluster configuration %s not found; run 'ecs-cli ctx list' to list them", name)
+	}
+
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		return errors.Wrap(err, "Error setting default config")
+	}
+	if err = rdwr.SetDefaultCluster(name); err != nil {
+		return errors.Wrap(err, "Error setting default config")
+	}
+	logrus.Infof("Switched to cluster configuration %s (cluster %s in %s).", name, cluster.Cluster, cluster.Region)
+	return nil
+}
+
+// readClusterConfigs reads the cluster configurations stored by 'ecs-cli configure'
+func readClusterConfigs() (*config.ClusterConfig, error) {
+	dest, err := config.NewDefaultDestination()
+	if err != nil {
+		return nil, errors.Wrap(err, "Error reading cluster configurations")
+	}
+	path := config.ConfigFilePath(dest)
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("No cluster configuration found in %s; run 'ecs-cli configure' to create one", dest.Path)
+	}
+	clusterConfig, err := config.ReadClusterFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "Run 'ecs-cli configure migrate' to convert an old configuration file")
+	}
+	return clusterConfig, nil
+}
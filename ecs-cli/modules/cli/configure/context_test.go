@@ -0,0 +1,112 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package configure
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func showContextFlagSet() *flag.FlagSet {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.ShowContextFlag, true, "")
+	return flagSet
+}
+
+func TestListAndUseContexts(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "test")
+	require.NoError(t, err, "Error while creating the dummy ecs config directory")
+	os.Setenv("HOME", tempDirName)
+	defer os.Unsetenv("HOME")
+	defer os.RemoveAll(tempDirName)
+
+	err = Cluster(createClusterConfig(profileName, clusterName, config.LaunchTypeEC2))
+	require.NoError(t, err, "Unexpected error configuring cluster")
+	err = Cluster(createClusterConfig(profileName2, secondCluster, config.LaunchTypeFargate))
+	require.NoError(t, err, "Unexpected error configuring cluster")
+
+	out := &bytes.Buffer{}
+	contextOutput = out
+	defer func() { contextOutput = os.Stdout }()
+
+	err = ListContexts(cli.NewContext(nil, flag.NewFlagSet("ecs-cli", 0), nil))
+	require.NoError(t, err, "Unexpected error listing contexts")
+	expected := "CURRENT   NAME             CLUSTER            REGION      LAUNCH TYPE\n" +
+		"          alternate        alternateCluster   us-west-1   FARGATE\n" +
+		"*         defaultProfile   defaultCluster     us-west-1   EC2\n"
+	assert.Equal(t, expected, out.String())
+
+	useFlagSet := flag.NewFlagSet("ecs-cli", 0)
+	useFlagSet.Parse([]string{profileName2})
+	err = UseContext(cli.NewContext(nil, useFlagSet, nil))
+	require.NoError(t, err, "Unexpected error switching context")
+
+	parser, err := config.NewReadWriter()
+	require.NoError(t, err, "Error reading config")
+	readConfig, err := parser.Get("", "")
+	require.NoError(t, err, "Error reading config")
+	assert.Equal(t, secondCluster, readConfig.Cluster, "Expected the default cluster configuration to be switched")
+
+	out.Reset()
+	err = Context(cli.NewContext(nil, showContextFlagSet(), nil))
+	assert.NoError(t, err, "Unexpected error showing the context")
+	assert.Equal(t, profileName2+"\n", out.String())
+}
+
+func TestUseUnknownContext(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "test")
+	require.NoError(t, err, "Error while creating the dummy ecs config directory")
+	os.Setenv("HOME", tempDirName)
+	defer os.Unsetenv("HOME")
+	defer os.RemoveAll(tempDirName)
+
+	err = Cluster(createClusterConfig(profileName, clusterName, config.LaunchTypeEC2))
+	require.NoError(t, err, "Unexpected error configuring cluster")
+
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Parse([]string{"unknown"})
+	err = UseContext(cli.NewContext(nil, flagSet, nil))
+	assert.Error(t, err, "Expected error for a cluster configuration which does not exist")
+
+	err = UseContext(cli.NewContext(nil, flag.NewFlagSet("ecs-cli", 0), nil))
+	assert.Error(t, err, "Expected error without a cluster configuration name")
+}
+
+func TestShowContextWithoutConfiguration(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "test")
+	require.NoError(t, err, "Error while creating the dummy ecs config directory")
+	os.Setenv("HOME", tempDirName)
+	defer os.Unsetenv("HOME")
+	defer os.RemoveAll(tempDirName)
+
+	out := &bytes.Buffer{}
+	contextOutput = out
+	defer func() { contextOutput = os.Stdout }()
+
+	err = Context(cli.NewContext(nil, showContextFlagSet(), nil))
+	assert.NoError(t, err, "Expected no error for a prompt without cluster configurations")
+	assert.Empty(t, out.String())
+
+	err = ListContexts(cli.NewContext(nil, flag.NewFlagSet("ecs-cli", 0), nil))
+	assert.Error(t, err, "Expected error listing contexts without cluster configurations")
+}
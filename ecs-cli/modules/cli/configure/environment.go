@@ -0,0 +1,100 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package configure
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// exportOutput is where the exported configuration is written; it can be replaced in tests
+var exportOutput io.Writer = os.Stdout
+
+// Export is the callback for Configure Export subcommand. It writes the cluster configurations,
+// and optionally the profiles, as YAML that can be imported with 'ecs-cli configure import'.
+func Export(context *cli.Context) error {
+	encrypt := context.Bool(flags.EncryptProfilesFlag)
+	includeProfiles := context.Bool(flags.IncludeProfilesFlag) || encrypt
+
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		return errors.Wrap(err, "Error exporting configuration")
+	}
+	env, err := rdwr.Export(includeProfiles)
+	if err != nil {
+		return errors.Wrap(err, "Error exporting configuration")
+	}
+	if encrypt && len(env.Profiles) > 0 {
+		if err = env.EncryptProfiles(os.Getenv(flags.ConfigPassphraseEnvVar)); err != nil {
+			return errors.Wrapf(err, "Error encrypting profiles; set the passphrase with the %s environment variable", flags.ConfigPassphraseEnvVar)
+		}
+	} else if len(env.Profiles) > 0 {
+		logrus.Warnf("The exported configuration contains AWS credentials in plain text; use --%s to encrypt them", flags.EncryptProfilesFlag)
+	}
+
+	data, err := yaml.Marshal(env)
+	if err != nil {
+		return errors.Wrap(err, "Error exporting configuration")
+	}
+	_, err = exportOutput.Write(data)
+	return err
+}
+
+// Import is the callback for Configure Import subcommand. It saves the cluster configurations and
+// profiles of a file written by 'ecs-cli configure export', replacing configurations with the same names.
+func Import(context *cli.Context) error {
+	path := context.Args().First()
+	if path == "" {
+		return fmt.Errorf("Specify the file to import, or - to read it from standard input")
+	}
+
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return errors.Wrap(err, "Error reading configuration to import")
+	}
+
+	env := &config.Environment{}
+	if err = yaml.UnmarshalStrict(data, env); err != nil {
+		return errors.Wrapf(err, "Error parsing configuration to import from %s", path)
+	}
+	if err = env.DecryptProfiles(os.Getenv(flags.ConfigPassphraseEnvVar)); err != nil {
+		return errors.Wrapf(err, "Error decrypting profiles; set the passphrase with the %s environment variable", flags.ConfigPassphraseEnvVar)
+	}
+
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		return errors.Wrap(err, "Error importing configuration")
+	}
+	if err = rdwr.Import(env); err != nil {
+		return errors.Wrap(err, "Error importing configuration")
+	}
+
+	logrus.Infof("Imported %d ECS CLI cluster configuration(s) and %d profile(s).", len(env.Clusters), len(env.Profiles))
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package costs reports the costs of the resources of a cluster from Cost Explorer.
+package costs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/costexplorer"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcostexplorer "github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// now can be replaced in tests
+var now = time.Now
+
+// cost lookup can be easily mocked in tests
+var getCostAndUsage costexplorer.GetCostAndUsageFunc = costexplorer.GetCostAndUsage
+
+// Cost allocation tags of the resources of a cluster: CloudFormation tags the resources of the stack
+// created by the up command, and ECS tags the tasks of the cluster when ECS managed tags are enabled
+const (
+	stackNameCostTag   = "aws:cloudformation:stack-name"
+	clusterNameCostTag = "aws:ecs:clusterName"
+)
+
+const (
+	costMetric          = "UnblendedCost"
+	costDateFormat      = "2006-01-02"
+	costCategoryEC2     = "EC2"
+	costCategoryEBS     = "EBS"
+	costCategoryNAT     = "NAT Gateway"
+	costCategoryFargate = "Fargate"
+	costCategoryOther   = "Other"
+)
+
+// costCategories are the categories of the cost report, in the order they are printed
+var costCategories = []string{costCategoryEC2, costCategoryEBS, costCategoryNAT, costCategoryFargate, costCategoryOther}
+
+// Costs prints the costs of the resources of the cluster.
+func Costs(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'costs': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'costs': ", err)
+	}
+
+	costs, err := clusterCosts(c, commandConfig)
+	if err != nil {
+		logrus.Fatal("Error executing 'costs': ", err)
+	}
+	printCosts(os.Stdout, costs)
+}
+
+type clusterCostReport struct {
+	Start string
+	End   string
+	Unit  string
+	// Estimated is true if the costs of the period are not final yet
+	Estimated  bool
+	ByCategory map[string]float64
+}
+
+// Total returns the cost of every category
+func (r *clusterCostReport) Total() float64 {
+	var total float64
+	for _, cost := range r.ByCategory {
+		total += cost
+	}
+	return total
+}
+
+// clusterCosts looks up the costs of the resources of the cluster over the --last period in Cost
+// Explorer, broken down by category. Only the costs of resources with an activated cost allocation
+// tag are included.
+func clusterCosts(context *cli.Context, commandConfig *config.CommandConfig) (*clusterCostReport, error) {
+	if commandConfig.Cluster == "" {
+		return nil, cluster.ClusterNotSetError()
+	}
+	days, err := parseCostPeriod(context.String(flags.CostPeriodFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	// the end date is excluded, so end tomorrow to include the costs of today
+	end := now().UTC().AddDate(0, 0, 1)
+	report := &clusterCostReport{
+		Start:      end.AddDate(0, 0, -days).Format(costDateFormat),
+		End:        end.Format(costDateFormat),
+		ByCategory: make(map[string]float64),
+	}
+	results, err := getCostAndUsage(&sdkcostexplorer.GetCostAndUsageInput{
+		TimePeriod:  &sdkcostexplorer.DateInterval{Start: aws.String(report.Start), End: aws.String(report.End)},
+		Granularity: aws.String("MONTHLY"),
+		Metrics:     aws.StringSlice([]string{costMetric}),
+		Filter: &sdkcostexplorer.Expression{
+			Or: []*sdkcostexplorer.Expression{
+				{Tags: &sdkcostexplorer.TagValues{Key: aws.String(stackNameCostTag), Values: aws.StringSlice([]string{commandConfig.CFNStackName})}},
+				{Tags: &sdkcostexplorer.TagValues{Key: aws.String(clusterNameCostTag), Values: aws.StringSlice([]string{commandConfig.Cluster})}},
+			},
+		},
+		GroupBy: []*sdkcostexplorer.GroupDefinition{{Type: aws.String("DIMENSION"), Key: aws.String("USAGE_TYPE")}},
+	}, commandConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to look up costs in Cost Explorer")
+	}
+
+	for _, result := range results {
+		report.Estimated = report.Estimated || aws.BoolValue(result.Estimated)
+		for _, group := range result.Groups {
+			metric, ok := group.Metrics[costMetric]
+			if !ok || len(group.Keys) == 0 {
+				continue
+			}
+			amount, err := strconv.ParseFloat(aws.StringValue(metric.Amount), 64)
+			if err != nil {
+				return nil, fmt.Errorf("Unexpected cost amount %s for %s", aws.StringValue(metric.Amount), aws.StringValue(group.Keys[0]))
+			}
+			report.ByCategory[costCategory(aws.StringValue(group.Keys[0]))] += amount
+			report.Unit = aws.StringValue(metric.Unit)
+		}
+	}
+	if report.Total() == 0 {
+		logrus.Warnf("No costs found for cluster '%s'. Costs are only reported for the '%s' and '%s' tags once they are activated as cost allocation tags in the Billing console, and Cost Explorer can take up to 24 hours to include new costs", commandConfig.Cluster, stackNameCostTag, clusterNameCostTag)
+	}
+	return report, nil
+}
+
+// parseCostPeriod parses a number of days, e.g. 30d
+func parseCostPeriod(period string) (int, error) {
+	days, err := strconv.Atoi(strings.TrimSuffix(period, "d"))
+	if err != nil || !strings.HasSuffix(period, "d") || days <= 0 {
+		return 0, fmt.Errorf("--%s must be a number of days, e.g. 30d", flags.CostPeriodFlag)
+	}
+	return days, nil
+}
+
+// costCategory returns the category of the cost of a usage type, e.g. USW2-BoxUsage:t2.micro
+func costCategory(usageType string) string {
+	switch {
+	case strings.Contains(usageType, "Fargate"):
+		return costCategoryFargate
+	case strings.Contains(usageType, "NatGateway"):
+		return costCategoryNAT
+	case strings.Contains(usageType, "EBS:"):
+		return costCategoryEBS
+	case strings.Contains(usageType, "BoxUsage"), strings.Contains(usageType, "SpotUsage"),
+		strings.Contains(usageType, "DedicatedUsage"), strings.Contains(usageType, "HostUsage"):
+		return costCategoryEC2
+	}
+	return costCategoryOther
+}
+
+func printCosts(out io.Writer, report *clusterCostReport) {
+	fmt.Fprintf(out, "Costs from %s to %s", report.Start, report.End)
+	if report.Estimated {
+		fmt.Fprint(out, " (estimated)")
+	}
+	fmt.Fprintln(out)
+
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "CATEGORY\tCOST")
+	for _, category := range costCategories {
+		fmt.Fprintf(w, "%s\t%.2f %s\n", category, report.ByCategory[category], report.Unit)
+	}
+	fmt.Fprintf(w, "Total\t%.2f %s\n", report.Total(), report.Unit)
+	w.Flush()
+}
@@ -0,0 +1,135 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package costs
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/costexplorer"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcostexplorer "github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const clusterName = "defaultCluster"
+
+type mockReadWriter struct {
+	clusterName string
+}
+
+func (rdwr *mockReadWriter) Get(cluster string, profile string) (*config.LocalConfig, error) {
+	cliConfig := config.NewLocalConfig(rdwr.clusterName)
+	cliConfig.CFNStackName = rdwr.clusterName
+	return cliConfig, nil
+}
+
+func (rdwr *mockReadWriter) SaveProfile(configName string, profile *config.Profile) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SaveCluster(configName string, cluster *config.Cluster) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultProfile(configName string) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultCluster(configName string) error {
+	return nil
+}
+
+func newMockReadWriter() *mockReadWriter {
+	return &mockReadWriter{
+		clusterName: clusterName,
+	}
+}
+
+func setupTest() {
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "secret")
+	os.Setenv("AWS_REGION", "us-west-1")
+}
+
+func costGroup(usageType, amount string) *sdkcostexplorer.Group {
+	return &sdkcostexplorer.Group{
+		Keys:    aws.StringSlice([]string{usageType}),
+		Metrics: map[string]*sdkcostexplorer.MetricValue{"UnblendedCost": {Amount: aws.String(amount), Unit: aws.String("USD")}},
+	}
+}
+
+func TestClusterCosts(t *testing.T) {
+	defer os.Clearenv()
+	setupTest()
+	now = func() time.Time { return time.Date(2020, 3, 15, 18, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	var input *sdkcostexplorer.GetCostAndUsageInput
+	getCostAndUsage = func(in *sdkcostexplorer.GetCostAndUsageInput, config *config.CommandConfig) ([]*sdkcostexplorer.ResultByTime, error) {
+		input = in
+		return []*sdkcostexplorer.ResultByTime{
+			{Groups: []*sdkcostexplorer.Group{costGroup("USW2-BoxUsage:t2.micro", "10.50"), costGroup("USW2-EBS:VolumeUsage.gp2", "2.25")}},
+			{Estimated: aws.Bool(true), Groups: []*sdkcostexplorer.Group{costGroup("USW2-BoxUsage:t2.micro", "4.50"), costGroup("USW2-Fargate-vCPU-Hours:perCPU", "3"), costGroup("USW2-NatGateway-Hours", "1"), costGroup("USW2-DataTransfer-Regional-Bytes", "0.25")}},
+		}, nil
+	}
+	defer func() { getCostAndUsage = costexplorer.GetCostAndUsage }()
+
+	flagSet := flag.NewFlagSet("ecs-cli-costs", 0)
+	flagSet.String(flags.CostPeriodFlag, "30d", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	report, err := clusterCosts(context, commandConfig)
+	assert.NoError(t, err, "Unexpected error looking up costs")
+	assert.Equal(t, "2020-02-15", aws.StringValue(input.TimePeriod.Start), "Expected costs of the last 30 days")
+	assert.Equal(t, "2020-03-16", aws.StringValue(input.TimePeriod.End), "Expected costs of today to be included")
+	if assert.Len(t, input.Filter.Or, 2) {
+		assert.Equal(t, commandConfig.CFNStackName, aws.StringValue(input.Filter.Or[0].Tags.Values[0]), "Expected stack name tag filter")
+		assert.Equal(t, clusterName, aws.StringValue(input.Filter.Or[1].Tags.Values[0]), "Expected cluster name tag filter")
+	}
+	assert.Equal(t, map[string]float64{"EC2": 15, "EBS": 2.25, "Fargate": 3, "NAT Gateway": 1, "Other": 0.25}, report.ByCategory, "Expected costs by category")
+	assert.True(t, report.Estimated, "Expected report to be estimated")
+
+	out := &bytes.Buffer{}
+	printCosts(out, report)
+	assert.Contains(t, out.String(), "(estimated)")
+	assert.Contains(t, out.String(), "21.50 USD")
+}
+
+func TestClusterCostsInvalidPeriod(t *testing.T) {
+	defer os.Clearenv()
+	setupTest()
+	for _, period := range []string{"30", "0d", "a month"} {
+		flagSet := flag.NewFlagSet("ecs-cli-costs", 0)
+		flagSet.String(flags.CostPeriodFlag, period, "")
+
+		context := cli.NewContext(nil, flagSet, nil)
+		rdwr := newMockReadWriter()
+		commandConfig, err := config.NewCommandConfig(context, rdwr)
+		assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+		_, err = clusterCosts(context, commandConfig)
+		assert.Error(t, err, "Expected error for period %s", period)
+	}
+}
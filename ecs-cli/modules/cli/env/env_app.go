@@ -0,0 +1,150 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package env exports the attributes of a cluster that deploy pipelines consume as variables.
+package env
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/elbv2"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// load balancer lookup can be easily mocked in tests
+var getLoadBalancerForTargetGroup elbv2.GetLoadBalancerForTargetGroupFunc = elbv2.GetLoadBalancerForTargetGroup
+
+// Formats of the variables printed by the env command
+const (
+	FormatDotenv        = "dotenv"
+	FormatGitHubActions = "github-actions"
+)
+
+// Env prints the attributes of the cluster as variables.
+func Env(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'env': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'env': ", err)
+	}
+
+	awsClients := cluster.NewAWSClients(commandConfig)
+	if err := clusterEnv(c, awsClients, commandConfig, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'env': ", err)
+	}
+}
+
+// clusterVariable is an attribute of the cluster exported by the env command, under an environment
+// variable name and a GitHub Actions step output name
+type clusterVariable struct {
+	envName    string
+	outputName string
+	value      string
+}
+
+// clusterEnv prints the attributes of the cluster consumed by deploy pipelines: its name and region,
+// the network of its CloudFormation stack, and the DNS names of the load balancers of its services.
+// Attributes without a value, e.g. the network of an empty cluster, are omitted.
+func clusterEnv(context *cli.Context, awsClients *cluster.AWSClients, commandConfig *config.CommandConfig, out io.Writer) error {
+	format := context.String(flags.EnvFormatFlag)
+	if format != FormatDotenv && format != FormatGitHubActions {
+		return fmt.Errorf("--%s must be %s or %s", flags.EnvFormatFlag, FormatDotenv, FormatGitHubActions)
+	}
+
+	ecsClient := awsClients.ECSClient
+	if err := cluster.ValidateCluster(commandConfig.Cluster, ecsClient); err != nil {
+		return err
+	}
+
+	outputs, err := awsClients.CFNClient.GetStackOutputs(commandConfig.CFNStackName)
+	if err != nil {
+		logrus.Warnf("CloudFormation stack not found for cluster '%s', its network is not exported", commandConfig.Cluster)
+		outputs = map[string]string{}
+	}
+	loadBalancerDNSNames, err := serviceLoadBalancerDNSNames(ecsClient, commandConfig)
+	if err != nil {
+		return err
+	}
+
+	variables := []clusterVariable{
+		{envName: "ECS_CLUSTER", outputName: "cluster", value: commandConfig.Cluster},
+		{envName: "AWS_REGION", outputName: "region", value: commandConfig.Region()},
+		{envName: "ECS_VPC_ID", outputName: "vpc_id", value: outputs[cloudformation.OutputKeyVpcId]},
+		{envName: "ECS_SUBNET_IDS", outputName: "subnet_ids", value: outputs[cloudformation.OutputKeySubnetIds]},
+		{envName: "ECS_SECURITY_GROUP_ID", outputName: "security_group_id", value: outputs[cloudformation.OutputKeySecurityGroupId]},
+		{envName: "ECS_LOAD_BALANCER_DNS", outputName: "load_balancer_dns", value: strings.Join(loadBalancerDNSNames, ",")},
+	}
+	for _, variable := range variables {
+		if variable.value == "" {
+			continue
+		}
+		name := variable.envName
+		if format == FormatGitHubActions {
+			name = variable.outputName
+		}
+		fmt.Fprintf(out, "%s=%s\n", name, variable.value)
+	}
+	return nil
+}
+
+// serviceLoadBalancerDNSNames returns the sorted DNS names of the Application and Network Load
+// Balancers forwarding traffic to the services of the cluster
+func serviceLoadBalancerDNSNames(ecsClient ecsclient.ECSClient, commandConfig *config.CommandConfig) ([]string, error) {
+	serviceArns, err := ecsClient.ListServices()
+	if err != nil {
+		return nil, err
+	}
+	services, err := ecsClient.DescribeServices(serviceArns)
+	if err != nil {
+		return nil, err
+	}
+
+	seenTargetGroups := make(map[string]bool)
+	seenDNSNames := make(map[string]bool)
+	var dnsNames []string
+	for _, service := range services {
+		for _, loadBalancer := range service.LoadBalancers {
+			targetGroupArn := aws.StringValue(loadBalancer.TargetGroupArn)
+			if targetGroupArn == "" || seenTargetGroups[targetGroupArn] {
+				continue
+			}
+			seenTargetGroups[targetGroupArn] = true
+			lb, err := getLoadBalancerForTargetGroup(targetGroupArn, commandConfig)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Unable to look up the load balancer of service '%s'", aws.StringValue(service.ServiceName))
+			}
+			if dnsName := aws.StringValue(lb.DNSName); dnsName != "" && !seenDNSNames[dnsName] {
+				seenDNSNames[dnsName] = true
+				dnsNames = append(dnsNames, dnsName)
+			}
+		}
+	}
+	sort.Strings(dnsNames)
+	return dnsNames, nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package env
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	mock_amimetadata "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	mock_cloudformation "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/elbv2"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkelbv2 "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	clusterName = "defaultCluster"
+	stackName   = "defaultCluster"
+)
+
+func setupTest(t *testing.T) (*mock_ecs.MockECSClient, *mock_cloudformation.MockCloudformationClient, *mock_amimetadata.MockClient, *mock_ec2.MockEC2Client) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockSSM := mock_amimetadata.NewMockClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "secret")
+	os.Setenv("AWS_REGION", "us-west-1")
+
+	return mockECS, mockCloudformation, mockSSM, mockEC2
+}
+
+func newTestCommandConfig() *config.CommandConfig {
+	return &config.CommandConfig{
+		Cluster:      clusterName,
+		CFNStackName: stackName,
+		Session:      session.Must(session.NewSession(&aws.Config{Region: aws.String("us-west-1")})),
+	}
+}
+
+func TestClusterEnv(t *testing.T) {
+	testCases := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format: FormatDotenv,
+			expected: `ECS_CLUSTER=defaultCluster
+AWS_REGION=us-west-1
+ECS_VPC_ID=vpc-1
+ECS_SUBNET_IDS=subnet-1,subnet-2
+ECS_SECURITY_GROUP_ID=sg-1
+ECS_LOAD_BALANCER_DNS=app-123.us-west-1.elb.amazonaws.com
+`,
+		},
+		{
+			format: FormatGitHubActions,
+			expected: `cluster=defaultCluster
+region=us-west-1
+vpc_id=vpc-1
+subnet_ids=subnet-1,subnet-2
+security_group_id=sg-1
+load_balancer_dns=app-123.us-west-1.elb.amazonaws.com
+`,
+		},
+	}
+
+	// the web and api services share a load balancer
+	getLoadBalancerForTargetGroup = func(targetGroupArn string, config *config.CommandConfig) (*sdkelbv2.LoadBalancer, error) {
+		return &sdkelbv2.LoadBalancer{DNSName: aws.String("app-123.us-west-1.elb.amazonaws.com")}, nil
+	}
+	defer func() { getLoadBalancerForTargetGroup = elbv2.GetLoadBalancerForTargetGroup }()
+
+	for _, test := range testCases {
+		t.Run(test.format, func(t *testing.T) {
+			defer os.Clearenv()
+			mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+			awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+			serviceArns := aws.StringSlice([]string{"arn1", "arn2", "arn3"})
+			services := []*ecs.Service{
+				{ServiceName: aws.String("web"), LoadBalancers: []*ecs.LoadBalancer{{TargetGroupArn: aws.String("tg-web")}}},
+				{ServiceName: aws.String("api"), LoadBalancers: []*ecs.LoadBalancer{{TargetGroupArn: aws.String("tg-api")}}},
+				{ServiceName: aws.String("worker")},
+			}
+			gomock.InOrder(
+				mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+				mockECS.EXPECT().ListServices().Return(serviceArns, nil),
+				mockECS.EXPECT().DescribeServices(serviceArns).Return(services, nil),
+			)
+			mockCloudformation.EXPECT().GetStackOutputs(stackName).Return(map[string]string{
+				cloudformation.OutputKeyVpcId:           "vpc-1",
+				cloudformation.OutputKeySubnetIds:       "subnet-1,subnet-2",
+				cloudformation.OutputKeySecurityGroupId: "sg-1",
+				cloudformation.OutputKeyAsgName:         "asg-1",
+			}, nil)
+
+			flagSet := flag.NewFlagSet("ecs-cli-env", 0)
+			flagSet.String(flags.EnvFormatFlag, test.format, "")
+
+			context := cli.NewContext(nil, flagSet, nil)
+			commandConfig := newTestCommandConfig()
+
+			out := &bytes.Buffer{}
+			err := clusterEnv(context, awsClients, commandConfig, out)
+			assert.NoError(t, err, "Unexpected error exporting the cluster")
+			assert.Equal(t, test.expected, out.String(), "Expected variables to match")
+		})
+	}
+}
+
+func TestClusterEnvWithoutStack(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockECS.EXPECT().ListServices().Return(nil, nil),
+		mockECS.EXPECT().DescribeServices(nil).Return(nil, nil),
+	)
+	mockCloudformation.EXPECT().GetStackOutputs(stackName).Return(nil, errors.New("Stack with id defaultCluster does not exist"))
+
+	flagSet := flag.NewFlagSet("ecs-cli-env", 0)
+	flagSet.String(flags.EnvFormatFlag, FormatDotenv, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig := newTestCommandConfig()
+
+	out := &bytes.Buffer{}
+	err := clusterEnv(context, awsClients, commandConfig, out)
+	assert.NoError(t, err, "Unexpected error exporting a cluster without stack")
+	assert.Equal(t, "ECS_CLUSTER=defaultCluster\nAWS_REGION=us-west-1\n", out.String(), "Expected only the cluster and region")
+}
+
+func TestClusterEnvInvalidFormat(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	flagSet := flag.NewFlagSet("ecs-cli-env", 0)
+	flagSet.String(flags.EnvFormatFlag, "yaml", "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig := newTestCommandConfig()
+
+	err := clusterEnv(context, awsClients, commandConfig, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error for an unsupported format")
+}
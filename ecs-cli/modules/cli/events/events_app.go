@@ -0,0 +1,201 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package events queries the stopped tasks of a cluster, from the ECS events captured for it.
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	cfnclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	cwlogsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+const (
+	// stoppedTaskFilterPattern matches the task state change events of stopped tasks, the only ones
+	// captured by the rule of the cluster stack
+	stoppedTaskFilterPattern = `{ $.detail.lastStatus = "STOPPED" }`
+	// serviceGroupPrefix prefixes the name of the service in the group of the tasks it started
+	serviceGroupPrefix = "service:"
+)
+
+// make the clock easily mockable in tests
+var now = time.Now
+
+// stoppedTask is the detail of the ECS Task State Change event of a stopped task
+type stoppedTask struct {
+	TaskArn           string             `json:"taskArn"`
+	Group             string             `json:"group"`
+	TaskDefinitionArn string             `json:"taskDefinitionArn"`
+	StopCode          string             `json:"stopCode"`
+	StoppedReason     string             `json:"stoppedReason"`
+	StoppedAt         time.Time          `json:"stoppedAt"`
+	Containers        []stoppedContainer `json:"containers"`
+}
+
+type stoppedContainer struct {
+	Name     string `json:"name"`
+	ExitCode *int64 `json:"exitCode"`
+	Reason   string `json:"reason"`
+}
+
+// taskStateChange is the ECS Task State Change event delivered by EventBridge
+type taskStateChange struct {
+	Detail stoppedTask `json:"detail"`
+}
+
+// History prints the stopped tasks of the cluster captured in the log group created by
+// 'ecs-cli up --capture-task-events', with the reasons they stopped
+func History(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'events history': ", err)
+	}
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'events history': ", err)
+	}
+	cfnClient := cfnclient.NewCloudformationClient(commandConfig)
+	cwLogsClient := cwlogsclient.NewCloudWatchLogsClient(commandConfig, commandConfig.Region())
+	if err := history(c, cfnClient, cwLogsClient, commandConfig, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'events history': ", err)
+	}
+}
+
+func history(context *cli.Context, cfnClient cfnclient.CloudformationClient, cwLogsClient cwlogsclient.Client, commandConfig *config.CommandConfig, out io.Writer) error {
+	since := context.Int(flags.SinceFlag)
+	if since <= 0 {
+		return fmt.Errorf("--%s must be greater than zero", flags.SinceFlag)
+	}
+	logGroup, err := taskEventsLogGroup(cfnClient, commandConfig)
+	if err != nil {
+		return err
+	}
+	if logGroup == "" {
+		return fmt.Errorf("The events of the tasks of cluster '%s' are not captured. Recreate the cluster with 'ecs-cli up --%s'", commandConfig.Cluster, flags.CaptureTaskEventsFlag)
+	}
+	tasks, err := capturedStoppedTasks(cwLogsClient, logGroup, now().Add(-time.Duration(since)*time.Minute))
+	if err != nil {
+		return err
+	}
+
+	service := context.String(flags.EventsServiceFlag)
+	taskID := context.String(flags.TaskIDFlag)
+	var matches []*stoppedTask
+	for _, task := range tasks {
+		if service != "" && task.Group != serviceGroupPrefix+service {
+			continue
+		}
+		if taskID != "" && resourceID(task.TaskArn) != taskID {
+			continue
+		}
+		matches = append(matches, task)
+	}
+	printHistory(out, matches)
+	return nil
+}
+
+// taskEventsLogGroup returns the log group capturing the events of the stopped tasks of the cluster,
+// or an empty string if the cluster was created without --capture-task-events
+func taskEventsLogGroup(cfnClient cfnclient.CloudformationClient, commandConfig *config.CommandConfig) (string, error) {
+	outputs, err := cfnClient.GetStackOutputs(commandConfig.CFNStackName)
+	if err != nil {
+		return "", fmt.Errorf("CloudFormation stack not found for cluster '%s'", commandConfig.Cluster)
+	}
+	return outputs[cfnclient.OutputKeyTaskEventsLogGroup], nil
+}
+
+// capturedStoppedTasks returns the tasks captured in the log group which stopped after the start
+// time, the most recently stopped first
+func capturedStoppedTasks(cwLogsClient cwlogsclient.Client, logGroup string, startTime time.Time) ([]*stoppedTask, error) {
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName:  aws.String(logGroup),
+		FilterPattern: aws.String(stoppedTaskFilterPattern),
+		StartTime:     aws.Int64(startTime.UnixNano() / int64(time.Millisecond)),
+	}
+
+	var tasks []*stoppedTask
+	err := cwLogsClient.FilterAllLogEvents(input, func(logEvents []*cloudwatchlogs.FilteredLogEvent) {
+		for _, logEvent := range logEvents {
+			event := &taskStateChange{}
+			if err := json.Unmarshal([]byte(aws.StringValue(logEvent.Message)), event); err != nil || event.Detail.TaskArn == "" {
+				logrus.Debugf("Skipping unexpected task event: %s", aws.StringValue(logEvent.Message))
+				continue
+			}
+			task := event.Detail
+			if task.StoppedAt.IsZero() {
+				task.StoppedAt = time.Unix(0, aws.Int64Value(logEvent.Timestamp)*int64(time.Millisecond)).UTC()
+			}
+			tasks = append(tasks, &task)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortByStopTime(tasks)
+	return tasks, nil
+}
+
+// sortByStopTime sorts the tasks so that the most recently stopped come first
+func sortByStopTime(tasks []*stoppedTask) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].StoppedAt.After(tasks[j].StoppedAt)
+	})
+}
+
+func printHistory(out io.Writer, tasks []*stoppedTask) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "STOPPED AT\tTASK\tGROUP\tTASK DEFINITION\tSTOP CODE\tEXIT CODES\tREASON")
+	for _, task := range tasks {
+		var exitCodes []string
+		var reasons []string
+		if task.StoppedReason != "" {
+			reasons = append(reasons, task.StoppedReason)
+		}
+		for _, container := range task.Containers {
+			if container.ExitCode != nil {
+				exitCodes = append(exitCodes, fmt.Sprintf("%s=%d", container.Name, *container.ExitCode))
+			}
+			if container.Reason != "" {
+				reasons = append(reasons, fmt.Sprintf("%s: %s", container.Name, container.Reason))
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", task.StoppedAt.Format(time.RFC3339), resourceID(task.TaskArn), task.Group,
+			resourceID(task.TaskDefinitionArn), task.StopCode, strings.Join(exitCodes, ","), strings.Join(reasons, "; "))
+	}
+	w.Flush()
+}
+
+// resourceID returns the last element of the resource of the ARN, e.g. the ID of a task or the
+// family and revision of a task definition
+func resourceID(resourceArn string) string {
+	parsed, err := arn.Parse(resourceArn)
+	if err != nil {
+		return resourceArn
+	}
+	return parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:]
+}
@@ -0,0 +1,155 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package events
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	clusterName = "default"
+	stackName   = "amazon-ecs-cli-setup-default"
+	logGroup    = "/aws/events/ecs/default/stopped-tasks"
+)
+
+func newContext(since int, service, taskID string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-events", 0)
+	flagSet.Int(flags.SinceFlag, since, "")
+	flagSet.String(flags.EventsServiceFlag, service, "")
+	flagSet.String(flags.TaskIDFlag, taskID, "")
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func newCommandConfig() *config.CommandConfig {
+	return &config.CommandConfig{Cluster: clusterName, CFNStackName: stackName}
+}
+
+func taskEvent(message string) *cloudwatchlogs.FilteredLogEvent {
+	return &cloudwatchlogs.FilteredLogEvent{Message: aws.String(message), Timestamp: aws.Int64(1500000000000)}
+}
+
+var taskEvents = []*cloudwatchlogs.FilteredLogEvent{
+	taskEvent(`{"detail-type":"ECS Task State Change","detail":{"taskArn":"arn:aws:ecs:us-west-2:123456789012:task/default/oom","group":"service:web","taskDefinitionArn":"arn:aws:ecs:us-west-2:123456789012:task-definition/web:7","lastStatus":"STOPPED","stopCode":"EssentialContainerExited","stoppedReason":"Essential container in task exited","stoppedAt":"2017-07-14T02:00:00Z","containers":[{"name":"app","exitCode":137,"reason":"OutOfMemoryError: Container killed due to memory usage"},{"name":"proxy","exitCode":0}]}}`),
+	taskEvent(`{"detail-type":"ECS Task State Change","detail":{"taskArn":"arn:aws:ecs:us-west-2:123456789012:task/default/spot","group":"family:batch","taskDefinitionArn":"arn:aws:ecs:us-west-2:123456789012:task-definition/batch:2","lastStatus":"STOPPED","stopCode":"TerminationNotice","stoppedReason":"Your Spot Task was interrupted.","stoppedAt":"2017-07-14T02:30:00Z","containers":[{"name":"job"}]}}`),
+	taskEvent(`{"detail-type":"ECS Task State Change","detail":{"taskArn":"arn:aws:ecs:us-west-2:123456789012:task/default/scaled","group":"service:web","taskDefinitionArn":"arn:aws:ecs:us-west-2:123456789012:task-definition/web:7","lastStatus":"STOPPED","stopCode":"ServiceSchedulerInitiated","stoppedReason":"Scaling activity initiated by (deployment ecs-svc/123)","containers":[{"name":"app","exitCode":0}]}}`),
+	taskEvent(`not json`),
+}
+
+func setupTest(t *testing.T) (*mock_cloudformation.MockCloudformationClient, *mock_cloudwatchlogs.MockClient, *gomock.Controller) {
+	now = func() time.Time { return time.Unix(1500003600, 0) }
+	ctrl := gomock.NewController(t)
+	return mock_cloudformation.NewMockCloudformationClient(ctrl), mock_cloudwatchlogs.NewMockClient(ctrl), ctrl
+}
+
+func TestHistory(t *testing.T) {
+	mockCFN, mockLogs, ctrl := setupTest(t)
+	defer ctrl.Finish()
+	defer func() { now = time.Now }()
+
+	mockCFN.EXPECT().GetStackOutputs(stackName).Return(map[string]string{cloudformation.OutputKeyTaskEventsLogGroup: logGroup}, nil)
+	mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Do(func(input *cloudwatchlogs.FilterLogEventsInput, action func([]*cloudwatchlogs.FilteredLogEvent)) {
+		assert.Equal(t, logGroup, aws.StringValue(input.LogGroupName), "Expected log group to match")
+		assert.Equal(t, stoppedTaskFilterPattern, aws.StringValue(input.FilterPattern), "Expected filter pattern to match")
+		assert.Equal(t, int64(1500000000000), aws.Int64Value(input.StartTime), "Expected start time to be an hour ago")
+		action(taskEvents)
+	}).Return(nil)
+
+	out := &bytes.Buffer{}
+	err := history(newContext(60, "", ""), mockCFN, mockLogs, newCommandConfig(), out)
+	assert.NoError(t, err, "Unexpected error listing the stopped tasks")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 4, "Expected a header and one line per stopped task")
+	assert.Contains(t, lines[0], "STOP CODE", "Expected header")
+	assert.Contains(t, lines[1], "scaled", "Expected the most recently stopped task first")
+	assert.Contains(t, lines[1], "2017-07-14T02:40:00Z", "Expected the time of the event when the stop time is missing")
+	assert.Contains(t, lines[2], "spot", "Expected task ID")
+	assert.Contains(t, lines[2], "Your Spot Task was interrupted.", "Expected stopped reason")
+	assert.Contains(t, lines[3], "oom", "Expected the least recently stopped task last")
+	assert.Contains(t, lines[3], "web:7", "Expected task definition")
+	assert.Contains(t, lines[3], "app=137,proxy=0", "Expected exit codes of the containers")
+	assert.Contains(t, lines[3], "app: OutOfMemoryError", "Expected reason of the container")
+}
+
+func TestHistoryFilters(t *testing.T) {
+	testCases := map[string]struct {
+		service, taskID string
+		expectedTasks   []string
+	}{
+		"service": {service: "web", expectedTasks: []string{"scaled", "oom"}},
+		"task":    {taskID: "spot", expectedTasks: []string{"spot"}},
+		"none":    {service: "api"},
+	}
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockCFN, mockLogs, ctrl := setupTest(t)
+			defer ctrl.Finish()
+			defer func() { now = time.Now }()
+
+			mockCFN.EXPECT().GetStackOutputs(stackName).Return(map[string]string{cloudformation.OutputKeyTaskEventsLogGroup: logGroup}, nil)
+			mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Do(func(input *cloudwatchlogs.FilterLogEventsInput, action func([]*cloudwatchlogs.FilteredLogEvent)) {
+				action(taskEvents)
+			}).Return(nil)
+
+			out := &bytes.Buffer{}
+			err := history(newContext(60, test.service, test.taskID), mockCFN, mockLogs, newCommandConfig(), out)
+			assert.NoError(t, err, "Unexpected error listing the stopped tasks")
+
+			lines := strings.Split(strings.TrimSpace(out.String()), "\n")[1:]
+			assert.Len(t, lines, len(test.expectedTasks), "Expected one line per matching task")
+			for i, task := range test.expectedTasks {
+				assert.Contains(t, lines[i], task, "Expected matching task")
+			}
+		})
+	}
+}
+
+func TestHistoryWithoutCapturedEvents(t *testing.T) {
+	mockCFN, mockLogs, ctrl := setupTest(t)
+	defer ctrl.Finish()
+	defer func() { now = time.Now }()
+
+	mockCFN.EXPECT().GetStackOutputs(stackName).Return(map[string]string{cloudformation.OutputKeyAsgName: "asg"}, nil)
+
+	err := history(newContext(60, "", ""), mockCFN, mockLogs, newCommandConfig(), &bytes.Buffer{})
+	assert.Error(t, err, "Expected error when the events are not captured")
+	assert.Contains(t, err.Error(), flags.CaptureTaskEventsFlag, "Expected error to suggest the flag")
+}
+
+func TestHistoryWithoutStack(t *testing.T) {
+	mockCFN, mockLogs, ctrl := setupTest(t)
+	defer ctrl.Finish()
+	defer func() { now = time.Now }()
+
+	mockCFN.EXPECT().GetStackOutputs(stackName).Return(nil, errors.New("stack does not exist"))
+
+	err := history(newContext(60, "", ""), mockCFN, mockLogs, newCommandConfig(), &bytes.Buffer{})
+	assert.Error(t, err, "Expected error when the cluster has no stack")
+}
@@ -0,0 +1,305 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package events
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	cfnclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	cwlogsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+const (
+	// ECS keeps the stopped tasks for about an hour
+	ecsStoppedTaskRetention = time.Hour
+
+	outOfMemoryError = "OutOfMemoryError"
+	// stopCodeEssentialContainerExited is the stop code of the tasks whose essential container exited
+	stopCodeEssentialContainerExited = "EssentialContainerExited"
+)
+
+const (
+	outOfMemoryFix = "Raise the memory of the containers, with mem_limit or mem_reservation in the compose file or ecs-params.yml, " +
+		"or the memory of the task with task_size in ecs-params.yml."
+	crashLoopFix = "Read the logs of the last task with 'ecs-cli logs --task-id <task>' to find why its essential container exits, " +
+		"and check the command and the health check of the container."
+	imagePullFix = "Check that the image and its tag exist, that the task execution role can pull from ECR (AmazonECSTaskExecutionRolePolicy) " +
+		"or read the registry credentials, and that the tasks reach the registry through a public IP, a NAT gateway or VPC endpoints."
+)
+
+// imagePullError matches the reasons of the tasks which could not pull an image or the registry credentials
+var imagePullError = regexp.MustCompile(`CannotPullContainerError|pull image|pull registry auth`)
+
+// finding is a problem shared by the tasks of a group which stopped
+type finding struct {
+	group          string
+	taskDefinition string
+	container      string
+	tasks          int
+	lastTask       string
+	lastStopped    time.Time
+	reason         string
+	exitCodes      []string
+}
+
+// healthReport groups the problems found among the stopped tasks of a cluster
+type healthReport struct {
+	outOfMemory  []*finding
+	crashLoops   []*finding
+	imagePulls   []*finding
+	stoppedTasks int
+	since        time.Time
+}
+
+// Health prints the out of memory errors, the crash loops of the services and the image pull
+// failures found among the tasks of the cluster which stopped recently, with suggested fixes
+func Health(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'health': ", err)
+	}
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'health': ", err)
+	}
+	cfnClient := cfnclient.NewCloudformationClient(commandConfig)
+	cwLogsClient := cwlogsclient.NewCloudWatchLogsClient(commandConfig, commandConfig.Region())
+	ecsClient := ecsclient.NewECSClient(commandConfig)
+	if err := health(c, cfnClient, cwLogsClient, ecsClient, commandConfig, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'health': ", err)
+	}
+}
+
+func health(context *cli.Context, cfnClient cfnclient.CloudformationClient, cwLogsClient cwlogsclient.Client, ecsClient ecsclient.ECSClient, commandConfig *config.CommandConfig, out io.Writer) error {
+	since := context.Int(flags.SinceFlag)
+	if since <= 0 {
+		return fmt.Errorf("--%s must be greater than zero", flags.SinceFlag)
+	}
+	threshold := context.Int(flags.RestartThresholdFlag)
+	if threshold < 1 {
+		return fmt.Errorf("--%s must be greater than zero", flags.RestartThresholdFlag)
+	}
+	startTime := now().Add(-time.Duration(since) * time.Minute)
+
+	// clusters without a stack, such as empty clusters, can only be checked with the tasks kept by ECS
+	logGroup, err := taskEventsLogGroup(cfnClient, commandConfig)
+	if err != nil {
+		logrus.Debug(err)
+	}
+	var tasks []*stoppedTask
+	if logGroup != "" {
+		tasks, err = capturedStoppedTasks(cwLogsClient, logGroup, startTime)
+	} else {
+		if time.Duration(since)*time.Minute > ecsStoppedTaskRetention {
+			logrus.Warnf("The events of the tasks of cluster '%s' are not captured, so only the tasks which stopped in the last hour are checked. Create the cluster with 'ecs-cli up --%s' to check a longer period.", commandConfig.Cluster, flags.CaptureTaskEventsFlag)
+		}
+		tasks, err = ecsStoppedTasks(ecsClient, startTime)
+	}
+	if err != nil {
+		return err
+	}
+
+	report := analyzeStoppedTasks(tasks, threshold)
+	report.since = startTime
+	printHealthReport(out, report)
+	return nil
+}
+
+// ecsStoppedTasks returns the tasks kept by ECS which stopped after the start time, the most
+// recently stopped first
+func ecsStoppedTasks(ecsClient ecsclient.ECSClient, startTime time.Time) ([]*stoppedTask, error) {
+	var tasks []*stoppedTask
+	err := ecsClient.GetTasksPages(&ecs.ListTasksInput{DesiredStatus: aws.String(ecs.DesiredStatusStopped)}, func(ecsTasks []*ecs.Task) error {
+		for _, ecsTask := range ecsTasks {
+			// a task which is still stopping has no stop time yet
+			if ecsTask.StoppedAt == nil || ecsTask.StoppedAt.Before(startTime) {
+				continue
+			}
+			task := &stoppedTask{
+				TaskArn:           aws.StringValue(ecsTask.TaskArn),
+				Group:             aws.StringValue(ecsTask.Group),
+				TaskDefinitionArn: aws.StringValue(ecsTask.TaskDefinitionArn),
+				StopCode:          aws.StringValue(ecsTask.StopCode),
+				StoppedReason:     aws.StringValue(ecsTask.StoppedReason),
+				StoppedAt:         aws.TimeValue(ecsTask.StoppedAt),
+			}
+			for _, container := range ecsTask.Containers {
+				task.Containers = append(task.Containers, stoppedContainer{
+					Name:     aws.StringValue(container.Name),
+					ExitCode: container.ExitCode,
+					Reason:   aws.StringValue(container.Reason),
+				})
+			}
+			tasks = append(tasks, task)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortByStopTime(tasks)
+	return tasks, nil
+}
+
+// analyzeStoppedTasks groups the stopped tasks, sorted from the most recently stopped, by problem.
+// The tasks of a service whose essential container exited at least threshold times are a crash loop.
+func analyzeStoppedTasks(tasks []*stoppedTask, threshold int) *healthReport {
+	report := &healthReport{stoppedTasks: len(tasks)}
+	outOfMemory := make(map[string]*finding)
+	crashLoops := make(map[string]*finding)
+	imagePulls := make(map[string]*finding)
+
+	// add counts the task in the finding with the key, created from the task, the first one being the most recent
+	add := func(findings map[string]*finding, key string, task *stoppedTask) *finding {
+		f, ok := findings[key]
+		if !ok {
+			f = &finding{
+				group:          task.Group,
+				taskDefinition: resourceID(task.TaskDefinitionArn),
+				lastTask:       resourceID(task.TaskArn),
+				lastStopped:    task.StoppedAt,
+			}
+			findings[key] = f
+		}
+		f.tasks++
+		return f
+	}
+
+	for _, task := range tasks {
+		key := task.Group + "|" + task.TaskDefinitionArn
+		for _, container := range task.Containers {
+			if strings.Contains(container.Reason, outOfMemoryError) {
+				f := add(outOfMemory, key+"|"+container.Name, task)
+				f.container = container.Name
+			}
+		}
+
+		if reason := imagePullReason(task); reason != "" {
+			f := add(imagePulls, key, task)
+			if f.reason == "" {
+				f.reason = reason
+			}
+		}
+
+		if strings.HasPrefix(task.Group, serviceGroupPrefix) && task.StopCode == stopCodeEssentialContainerExited {
+			f := add(crashLoops, key, task)
+			for _, container := range task.Containers {
+				if code := aws.Int64Value(container.ExitCode); container.ExitCode != nil && code != 0 {
+					exitCode := fmt.Sprintf("%s=%d", container.Name, code)
+					if !utils.InSlice(exitCode, f.exitCodes) {
+						f.exitCodes = append(f.exitCodes, exitCode)
+					}
+				}
+			}
+		}
+	}
+
+	report.outOfMemory = sortedFindings(outOfMemory, 1)
+	report.crashLoops = sortedFindings(crashLoops, threshold)
+	report.imagePulls = sortedFindings(imagePulls, 1)
+	return report
+}
+
+// imagePullReason returns the reason of the task or of one of its containers if it mentions a
+// failure to pull an image, or an empty string
+func imagePullReason(task *stoppedTask) string {
+	if imagePullError.MatchString(task.StoppedReason) {
+		return task.StoppedReason
+	}
+	for _, container := range task.Containers {
+		if imagePullError.MatchString(container.Reason) {
+			return fmt.Sprintf("%s: %s", container.Name, container.Reason)
+		}
+	}
+	return ""
+}
+
+// sortedFindings returns the findings shared by at least min tasks, the most frequent first
+func sortedFindings(findings map[string]*finding, min int) []*finding {
+	var sorted []*finding
+	for _, f := range findings {
+		if f.tasks >= min {
+			sorted = append(sorted, f)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].tasks != sorted[j].tasks {
+			return sorted[i].tasks > sorted[j].tasks
+		}
+		if sorted[i].group != sorted[j].group {
+			return sorted[i].group < sorted[j].group
+		}
+		if sorted[i].taskDefinition != sorted[j].taskDefinition {
+			return sorted[i].taskDefinition < sorted[j].taskDefinition
+		}
+		return sorted[i].container < sorted[j].container
+	})
+	return sorted
+}
+
+func printHealthReport(out io.Writer, report *healthReport) {
+	fmt.Fprintf(out, "Checked %d tasks which stopped since %s\n", report.stoppedTasks, report.since.UTC().Format(time.RFC3339))
+	if len(report.outOfMemory) == 0 && len(report.crashLoops) == 0 && len(report.imagePulls) == 0 {
+		fmt.Fprintln(out, "No out of memory errors, crash loops or image pull failures found")
+		return
+	}
+
+	if len(report.outOfMemory) > 0 {
+		fmt.Fprintln(out, "\nOUT OF MEMORY")
+		w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "GROUP\tTASK DEFINITION\tCONTAINER\tSTOPPED TASKS\tLAST STOPPED")
+		for _, f := range report.outOfMemory {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", f.group, f.taskDefinition, f.container, f.tasks, f.lastStopped.UTC().Format(time.RFC3339))
+		}
+		w.Flush()
+		fmt.Fprintf(out, "Suggested fix: %s\n", outOfMemoryFix)
+	}
+
+	if len(report.crashLoops) > 0 {
+		fmt.Fprintln(out, "\nCRASH LOOPS")
+		w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "GROUP\tTASK DEFINITION\tSTOPPED TASKS\tEXIT CODES\tLAST TASK")
+		for _, f := range report.crashLoops {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", f.group, f.taskDefinition, f.tasks, strings.Join(f.exitCodes, ","), f.lastTask)
+		}
+		w.Flush()
+		fmt.Fprintf(out, "Suggested fix: %s\n", crashLoopFix)
+	}
+
+	if len(report.imagePulls) > 0 {
+		fmt.Fprintln(out, "\nIMAGE PULL FAILURES")
+		w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "GROUP\tTASK DEFINITION\tSTOPPED TASKS\tREASON")
+		for _, f := range report.imagePulls {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", f.group, f.taskDefinition, f.tasks, f.reason)
+		}
+		w.Flush()
+		fmt.Fprintf(out, "Suggested fix: %s\n", imagePullFix)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package events
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func newHealthContext(since, threshold int) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-health", 0)
+	flagSet.Int(flags.SinceFlag, since, "")
+	flagSet.Int(flags.RestartThresholdFlag, threshold, "")
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func stoppedTaskEvent(task, group, taskDef, stopCode, reason, stoppedAt, containers string) *cloudwatchlogs.FilteredLogEvent {
+	return taskEvent(`{"detail":{"taskArn":"arn:aws:ecs:us-west-2:123456789012:task/default/` + task + `","group":"` + group +
+		`","taskDefinitionArn":"arn:aws:ecs:us-west-2:123456789012:task-definition/` + taskDef + `","lastStatus":"STOPPED","stopCode":"` + stopCode +
+		`","stoppedReason":"` + reason + `","stoppedAt":"` + stoppedAt + `","containers":[` + containers + `]}}`)
+}
+
+func TestHealth(t *testing.T) {
+	mockCFN, mockLogs, ctrl := setupTest(t)
+	defer ctrl.Finish()
+	defer func() { now = time.Now }()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	oomContainers := `{"name":"app","exitCode":137,"reason":"OutOfMemoryError: Container killed due to memory usage"},{"name":"proxy","exitCode":0}`
+	events := []*cloudwatchlogs.FilteredLogEvent{
+		stoppedTaskEvent("oom1", "service:web", "web:7", "EssentialContainerExited", "Essential container in task exited", "2017-07-14T02:30:00Z", oomContainers),
+		stoppedTaskEvent("oom2", "service:web", "web:7", "EssentialContainerExited", "Essential container in task exited", "2017-07-14T02:20:00Z", oomContainers),
+		stoppedTaskEvent("crash1", "service:api", "api:3", "EssentialContainerExited", "Essential container in task exited", "2017-07-14T02:35:00Z", `{"name":"api","exitCode":1}`),
+		stoppedTaskEvent("crash2", "service:api", "api:3", "EssentialContainerExited", "Essential container in task exited", "2017-07-14T02:25:00Z", `{"name":"api","exitCode":2}`),
+		stoppedTaskEvent("crash3", "service:api", "api:3", "EssentialContainerExited", "Essential container in task exited", "2017-07-14T02:15:00Z", `{"name":"api","exitCode":1}`),
+		stoppedTaskEvent("pull", "family:batch", "batch:2", "TaskFailedToStart", "CannotPullContainerError: pull image manifest has been retried 5 time(s): not found", "2017-07-14T02:10:00Z", `{"name":"job"}`),
+		stoppedTaskEvent("scaled", "service:worker", "worker:1", "ServiceSchedulerInitiated", "Scaling activity initiated by (deployment ecs-svc/123)", "2017-07-14T02:05:00Z", `{"name":"worker","exitCode":0}`),
+	}
+	mockCFN.EXPECT().GetStackOutputs(stackName).Return(map[string]string{cloudformation.OutputKeyTaskEventsLogGroup: logGroup}, nil)
+	mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Do(func(input *cloudwatchlogs.FilterLogEventsInput, action func([]*cloudwatchlogs.FilteredLogEvent)) {
+		assert.Equal(t, logGroup, aws.StringValue(input.LogGroupName), "Expected log group to match")
+		assert.Equal(t, int64(1499996400000), aws.Int64Value(input.StartTime), "Expected start time to be two hours ago")
+		action(events)
+	}).Return(nil)
+
+	out := &bytes.Buffer{}
+	err := health(newHealthContext(120, 3), mockCFN, mockLogs, mockECS, newCommandConfig(), out)
+	assert.NoError(t, err, "Unexpected error checking the health of the cluster")
+
+	report := out.String()
+	assert.Contains(t, report, "Checked 7 tasks which stopped since 2017-07-14T01:40:00Z", "Expected summary")
+	sections := strings.Split(report, "\n\n")
+	assert.Len(t, sections, 4, "Expected a summary and three sections")
+
+	assert.Contains(t, sections[1], "OUT OF MEMORY", "Expected out of memory section")
+	assert.Regexp(t, `service:web\s+web:7\s+app\s+2\s+2017-07-14T02:30:00Z`, sections[1], "Expected the out of memory errors of the container")
+	assert.NotContains(t, sections[1], "proxy", "Expected only the container which ran out of memory")
+	assert.Contains(t, sections[1], outOfMemoryFix, "Expected suggested fix")
+
+	assert.Contains(t, sections[2], "CRASH LOOPS", "Expected crash loops section")
+	assert.Regexp(t, `service:api\s+api:3\s+3\s+api=1,api=2\s+crash1`, sections[2], "Expected the crash loop of the service")
+	assert.NotContains(t, sections[2], "service:web", "Expected services stopped fewer times than the threshold not to be reported")
+	assert.Contains(t, sections[2], crashLoopFix, "Expected suggested fix")
+
+	assert.Contains(t, sections[3], "IMAGE PULL FAILURES", "Expected image pull failures section")
+	assert.Regexp(t, `family:batch\s+batch:2\s+1\s+CannotPullContainerError`, sections[3], "Expected the image pull failure")
+	assert.Contains(t, sections[3], imagePullFix, "Expected suggested fix")
+	assert.NotContains(t, report, "worker", "Expected tasks stopped by the scheduler not to be reported")
+}
+
+func TestHealthWithoutCapturedEvents(t *testing.T) {
+	mockCFN, mockLogs, ctrl := setupTest(t)
+	defer ctrl.Finish()
+	defer func() { now = time.Now }()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	recent := time.Unix(1500003000, 0)
+	old := time.Unix(1499999000, 0)
+	mockCFN.EXPECT().GetStackOutputs(stackName).Return(nil, errors.New("stack does not exist"))
+	mockECS.EXPECT().GetTasksPages(gomock.Any(), gomock.Any()).Do(func(input *sdkecs.ListTasksInput, fn ecs.ProcessTasksAction) {
+		assert.Equal(t, sdkecs.DesiredStatusStopped, aws.StringValue(input.DesiredStatus), "Expected stopped tasks to be listed")
+		fn([]*sdkecs.Task{
+			{
+				TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/recent"),
+				Group:             aws.String("service:web"),
+				TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/web:7"),
+				StopCode:          aws.String("EssentialContainerExited"),
+				StoppedAt:         aws.Time(recent),
+				Containers: []*sdkecs.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int64(137), Reason: aws.String("OutOfMemoryError: Container killed due to memory usage")},
+				},
+			},
+			{
+				TaskArn:   aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/old"),
+				Group:     aws.String("service:web"),
+				StopCode:  aws.String("EssentialContainerExited"),
+				StoppedAt: aws.Time(old),
+				Containers: []*sdkecs.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int64(137), Reason: aws.String("OutOfMemoryError: Container killed due to memory usage")},
+				},
+			},
+			{
+				TaskArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/stopping"),
+				Group:   aws.String("service:web"),
+			},
+		})
+	}).Return(nil)
+
+	out := &bytes.Buffer{}
+	err := health(newHealthContext(60, 3), mockCFN, mockLogs, mockECS, newCommandConfig(), out)
+	assert.NoError(t, err, "Unexpected error checking the health of the cluster")
+
+	report := out.String()
+	assert.Contains(t, report, "Checked 1 tasks", "Expected only the task stopped in the period to be checked")
+	assert.Regexp(t, `service:web\s+web:7\s+app\s+1`, report, "Expected the out of memory error")
+	assert.NotContains(t, report, "CRASH LOOPS", "Expected no crash loop below the threshold")
+}
+
+func TestHealthWithoutProblems(t *testing.T) {
+	mockCFN, mockLogs, ctrl := setupTest(t)
+	defer ctrl.Finish()
+	defer func() { now = time.Now }()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	mockCFN.EXPECT().GetStackOutputs(stackName).Return(map[string]string{cloudformation.OutputKeyTaskEventsLogGroup: logGroup}, nil)
+	mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Return(nil)
+
+	out := &bytes.Buffer{}
+	err := health(newHealthContext(60, 3), mockCFN, mockLogs, mockECS, newCommandConfig(), out)
+	assert.NoError(t, err, "Unexpected error checking the health of the cluster")
+	assert.Contains(t, out.String(), "No out of memory errors, crash loops or image pull failures found", "Expected healthy report")
+}
+
+func TestHealthInvalidFlags(t *testing.T) {
+	testCases := map[string]*cli.Context{
+		"since":     newHealthContext(0, 3),
+		"threshold": newHealthContext(60, 0),
+	}
+	for name, context := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockCFN, mockLogs, ctrl := setupTest(t)
+			defer ctrl.Finish()
+			defer func() { now = time.Now }()
+
+			err := health(context, mockCFN, mockLogs, mock_ecs.NewMockECSClient(ctrl), newCommandConfig(), &bytes.Buffer{})
+			assert.Error(t, err, "Expected error for invalid flag")
+		})
+	}
+}
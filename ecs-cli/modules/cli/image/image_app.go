@@ -28,6 +28,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/pagination"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -47,6 +48,9 @@ const (
 	NumOfFlags  = 0
 	PageSize    = 100
 
+	// describeImagesMaxResults is the maximum number of images returned by a single DescribeImages call
+	describeImagesMaxResults = 1000
+
 	// const formats
 	PushImageFormat = "ECR_REPOSITORY[:TAG]"
 	PullImageFormat = "ECR_REPOSITORY[:TAG|@DIGEST]"
@@ -157,6 +161,10 @@ func pushImage(c *cli.Context, region string, dockerClient dockerclient.Client,
 		return err
 	}
 
+	if err = checkAttestationTools(c); err != nil {
+		return err
+	}
+
 	// For tagging (need the full ARN) and ECR auth, we need the registry ID
 	// We can get this either from the registry URI or from STS
 	if registryURI == "" {
@@ -213,8 +221,11 @@ func pushImage(c *cli.Context, region string, dockerClient dockerclient.Client,
 		ServerAddress: ecrAuth.ProxyEndpoint,
 	}
 
-	err = dockerClient.PushImage(repositoryURI, tag, ecrAuth.Registry, dockerAuth)
-	return err
+	if err = dockerClient.PushImage(repositoryURI, tag, ecrAuth.Registry, dockerAuth); err != nil {
+		return err
+	}
+
+	return attestImage(c, ecrClient, ecrAuth, registryID, repository, tag)
 }
 
 func pullImage(c *cli.Context, rdwr config.ReadWriter, dockerClient dockerclient.Client, ecrClient ecrclient.Client, stsClient stsclient.Client) error {
@@ -265,11 +276,16 @@ func getImages(c *cli.Context, rdwr config.ReadWriter, ecrClient ecrclient.Clien
 	registryID := c.String(flags.RegistryIdFlag)
 	args := c.Args() // repository names
 
+	paginator, err := pagination.NewPaginator(c)
+	if err != nil {
+		return err
+	}
+
 	totalCount := 0
 
 	w := tabwriter.NewWriter(os.Stdout, MinWidth, TabWidth, Padding, PaddingChar, NumOfFlags)
 
-	err := ecrClient.GetImages(aws.StringSlice(args), getTagStatus(c), registryID, func(imageDetails []*ecr.ImageDetail) error {
+	printImages := func(imageDetails []*ecr.ImageDetail) error {
 		// Prints all images in table
 		for _, image := range imageDetails {
 			info := imageInfo{
@@ -290,11 +306,62 @@ func getImages(c *cli.Context, rdwr config.ReadWriter, ecrClient ecrclient.Clien
 			}
 		}
 		return nil
-	})
+	}
+
+	if paginator.Enabled() {
+		err = getImagesPages(c, ecrClient, paginator, func(imageDetails []*ecr.ImageDetail) error {
+			if err := printImages(imageDetails); err != nil {
+				return err
+			}
+			// print each page as it arrives
+			return w.Flush()
+		})
+	} else {
+		err = ecrClient.GetImages(aws.StringSlice(args), getTagStatus(c), registryID, printImages)
+	}
 	w.Flush()
 	return err
 }
 
+// getImagesPages describes the images of the repositories page by page, until the number of
+// images listed reaches --max-items
+func getImagesPages(c *cli.Context, ecrClient ecrclient.Client, paginator *pagination.Paginator, processFn ecrclient.ProcessImageDetails) error {
+	registryID := c.String(flags.RegistryIdFlag)
+	repositoryNames := []string(c.Args())
+	if len(repositoryNames) == 0 {
+		names, err := ecrClient.ListRepositoryNames(registryID)
+		if err != nil {
+			return err
+		}
+		repositoryNames = aws.StringValueSlice(names)
+	}
+	repositoryNames, nextToken, err := paginator.Resume(repositoryNames)
+	if err != nil {
+		return err
+	}
+
+	for i, repositoryName := range repositoryNames {
+		for {
+			images, pageToken, err := ecrClient.DescribeImagesPage(repositoryName, getTagStatus(c), registryID, nextToken, paginator.PageSize(describeImagesMaxResults))
+			if err != nil {
+				return err
+			}
+			if err := processFn(images); err != nil {
+				return err
+			}
+			if paginator.Add(len(images)) {
+				paginator.Stop("images", repositoryName, pageToken, repositoryNames[i+1:])
+				return nil
+			}
+			nextToken = pageToken
+			if nextToken == nil {
+				break
+			}
+		}
+	}
+	return nil
+}
+
 func listImagesContent(w *tabwriter.Writer, info imageInfo, count int) {
 	if count%PageSize == 0 {
 		w.Flush()
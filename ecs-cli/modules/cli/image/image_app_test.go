@@ -371,6 +371,33 @@ func TestImageListFail(t *testing.T) {
 	assert.Error(t, err, "Expected error listing images")
 }
 
+func TestImageListWithMaxItems(t *testing.T) {
+	mockECR, _, _, _ := setupTestController(t)
+	setupEnvironmentVar()
+
+	pushedAt := time.Unix(1489687380, 0)
+	image := func(repositoryName, digest string) *ecrApi.ImageDetail {
+		return &ecrApi.ImageDetail{
+			ImageDigest:      aws.String(digest),
+			RepositoryName:   aws.String(repositoryName),
+			ImagePushedAt:    &pushedAt,
+			ImageSizeInBytes: aws.Int64(1024),
+		}
+	}
+	gomock.InOrder(
+		mockECR.EXPECT().ListRepositoryNames("").Return(aws.StringSlice([]string{"api", "web", "worker"}), nil),
+		mockECR.EXPECT().DescribeImagesPage("api", "", "", nil, aws.Int64(3)).Return([]*ecrApi.ImageDetail{image("api", "sha:1")}, nil, nil),
+		mockECR.EXPECT().DescribeImagesPage("web", "", "", nil, aws.Int64(2)).Return([]*ecrApi.ImageDetail{image("web", "sha:2")}, aws.String("next"), nil),
+		mockECR.EXPECT().DescribeImagesPage("web", "", "", aws.String("next"), aws.Int64(1)).Return([]*ecrApi.ImageDetail{image("web", "sha:3")}, nil, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-images", 0)
+	flagSet.Int(flags.MaxItemsFlag, 3, "")
+	context := cli.NewContext(nil, flagSet, nil)
+	err := getImages(context, newMockReadWriter(), mockECR)
+	assert.NoError(t, err, "Error listing images")
+}
+
 func TestSplitImageName(t *testing.T) {
 	tests := []struct {
 		name       string
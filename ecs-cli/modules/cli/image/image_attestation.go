@@ -0,0 +1,135 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+const (
+	// kmsKeyPrefix turns the ID, alias or ARN of a KMS key into a cosign key reference
+	kmsKeyPrefix = "awskms:///"
+
+	defaultImageTag = "latest"
+)
+
+// make the external tools easily mockable in tests
+var (
+	lookPath = exec.LookPath
+	runTool  = func(name string, stdin io.Reader, args ...string) error {
+		cmd := exec.Command(name, args...)
+		cmd.Stdin = stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		return cmd.Run()
+	}
+)
+
+// checkAttestationTools returns an error if a tool needed for the --sbom and --sign-key flags is not
+// installed, so that push fails before pushing anything
+func checkAttestationTools(c *cli.Context) error {
+	var tools []string
+	if c.Bool(flags.SBOMFlag) {
+		tools = append(tools, "syft")
+	}
+	if c.Bool(flags.SBOMFlag) || c.String(flags.SignKeyFlag) != "" {
+		tools = append(tools, "cosign", "docker")
+	}
+	for _, tool := range tools {
+		if _, err := lookPath(tool); err != nil {
+			return fmt.Errorf("--%s and --%s require %s to be installed: %v", flags.SBOMFlag, flags.SignKeyFlag, tool, err)
+		}
+	}
+	return nil
+}
+
+// attestImage generates the SBOM of the pushed image and signs it, as requested with --sbom and
+// --sign-key. The signature and the SBOM are stored by cosign in the repository of the image, next
+// to it, and refer to the image by digest so that they cannot be moved to another image with its tag.
+func attestImage(c *cli.Context, ecrClient ecrclient.Client, ecrAuth *ecrclient.Auth, registryID, repository, tag string) error {
+	generateSBOM := c.Bool(flags.SBOMFlag)
+	signKey := cosignKey(c.String(flags.SignKeyFlag))
+	if !generateSBOM && signKey == "" {
+		return nil
+	}
+
+	if tag == "" {
+		tag = defaultImageTag
+	}
+	digest, err := ecrClient.GetImageDigest(registryID, repository, tag)
+	if err != nil {
+		return err
+	}
+	imageRef := ecrAuth.Registry + "/" + repository + "@" + digest
+
+	// syft and cosign use the credentials of the docker CLI
+	if err = runTool("docker", strings.NewReader(ecrAuth.Password), "login", "--username", ecrAuth.Username, "--password-stdin", ecrAuth.ProxyEndpoint); err != nil {
+		return errors.Wrap(err, "unable to log in to ECR")
+	}
+
+	if signKey != "" {
+		logrus.WithField("image", imageRef).Info("Signing image")
+		if err = runTool("cosign", nil, "sign", "--yes", "--key", signKey, imageRef); err != nil {
+			return errors.Wrap(err, "unable to sign image")
+		}
+	}
+	if !generateSBOM {
+		return nil
+	}
+
+	sbomFile, err := ioutil.TempFile("", "ecs-cli-sbom")
+	if err != nil {
+		return err
+	}
+	sbomFile.Close()
+	defer os.Remove(sbomFile.Name())
+
+	logrus.WithField("image", imageRef).Info("Generating SBOM")
+	if err = runTool("syft", nil, imageRef, "--output", "spdx-json="+sbomFile.Name()); err != nil {
+		return errors.Wrap(err, "unable to generate SBOM")
+	}
+	if signKey != "" {
+		err = runTool("cosign", nil, "attest", "--yes", "--key", signKey, "--type", "spdxjson", "--predicate", sbomFile.Name(), imageRef)
+	} else {
+		err = runTool("cosign", nil, "attach", "sbom", "--sbom", sbomFile.Name(), "--type", "spdx", "--input-format", "json", imageRef)
+	}
+	if err != nil {
+		return errors.Wrap(err, "unable to store SBOM")
+	}
+	logrus.WithField("image", imageRef).Info("SBOM stored")
+	return nil
+}
+
+// cosignKey returns the cosign key reference of the --sign-key flag, which is either the ID, alias or
+// ARN of a KMS key, or a reference cosign accepts as is, such as awskms:///alias/name or a key file
+func cosignKey(signKey string) string {
+	if signKey == "" || strings.Contains(signKey, "://") {
+		return signKey
+	}
+	if _, err := os.Stat(signKey); err == nil {
+		return signKey
+	}
+	return kmsKeyPrefix + signKey
+}
@@ -0,0 +1,175 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/fsouza/go-dockerclient"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+const imageDigest = "sha256:feedface"
+
+// sbomFilePattern matches the temporary file the SBOM is written to
+var sbomFilePattern = regexp.MustCompile(`/\S*ecs-cli-sbom\d+`)
+
+func TestImagePushWithSBOMAndSignature(t *testing.T) {
+	testCases := map[string]struct {
+		sbom             bool
+		signKey          string
+		expectedCommands [][]string
+	}{
+		"signature": {
+			signKey: "alias/signing",
+			expectedCommands: [][]string{
+				{"docker", "login", "--username", "AWS", "--password-stdin", registry},
+				{"cosign", "sign", "--yes", "--key", "awskms:///alias/signing", repositoryURI + "@" + imageDigest},
+			},
+		},
+		"signed SBOM": {
+			sbom:    true,
+			signKey: "awskms:///arn:aws:kms:us-west-2:012345678912:key/1234",
+			expectedCommands: [][]string{
+				{"docker", "login", "--username", "AWS", "--password-stdin", registry},
+				{"cosign", "sign", "--yes", "--key", "awskms:///arn:aws:kms:us-west-2:012345678912:key/1234", repositoryURI + "@" + imageDigest},
+				{"syft", repositoryURI + "@" + imageDigest, "--output", "spdx-json=<sbom>"},
+				{"cosign", "attest", "--yes", "--key", "awskms:///arn:aws:kms:us-west-2:012345678912:key/1234", "--type", "spdxjson", "--predicate", "<sbom>", repositoryURI + "@" + imageDigest},
+			},
+		},
+		"unsigned SBOM": {
+			sbom: true,
+			expectedCommands: [][]string{
+				{"docker", "login", "--username", "AWS", "--password-stdin", registry},
+				{"syft", repositoryURI + "@" + imageDigest, "--output", "spdx-json=<sbom>"},
+				{"cosign", "attach", "sbom", "--sbom", "<sbom>", "--type", "spdx", "--input-format", "json", repositoryURI + "@" + imageDigest},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockECR, mockDocker, mockSTS, mockTagging := setupTestController(t)
+			defer func(original func(string) (string, error)) { lookPath = original }(lookPath)
+			defer func(original func(string, io.Reader, ...string) error) { runTool = original }(runTool)
+			lookPath = func(file string) (string, error) { return "/usr/local/bin/" + file, nil }
+
+			var commands [][]string
+			runTool = func(name string, stdin io.Reader, args ...string) error {
+				command := []string{name}
+				for _, arg := range args {
+					command = append(command, sbomFilePattern.ReplaceAllString(arg, "<sbom>"))
+				}
+				if name == "docker" {
+					password, err := ioutil.ReadAll(stdin)
+					require.NoError(t, err, "Unexpected error reading the password")
+					assert.Equal(t, "secret", string(password), "Expected the ECR password on stdin")
+				}
+				commands = append(commands, command)
+				return nil
+			}
+
+			gomock.InOrder(
+				mockSTS.EXPECT().GetAWSAccountID().Return(registryID, nil),
+				mockECR.EXPECT().GetAuthorizationTokenByID(gomock.Any()).Return(&ecr.Auth{
+					Registry:      registry,
+					ProxyEndpoint: registry,
+					Username:      "AWS",
+					Password:      "secret",
+				}, nil),
+				mockDocker.EXPECT().TagImage(image, repositoryURI, tag).Return(nil),
+				mockECR.EXPECT().RepositoryExists(repository).Return(true),
+				mockDocker.EXPECT().PushImage(repositoryURI, tag, registry, gomock.Any()).Return(nil),
+				mockECR.EXPECT().GetImageDigest(registryID, repository, tag).Return(imageDigest, nil),
+			)
+
+			context := setAttestationPushFlags(tc.sbom, tc.signKey)
+			err := pushImage(context, region, mockDocker, mockECR, mockSTS, mockTagging)
+			assert.NoError(t, err, "Unexpected error pushing image")
+			assert.Equal(t, tc.expectedCommands, commands, "Expected the attestation commands to match")
+		})
+	}
+}
+
+func TestImagePushWithSBOMWithoutSyft(t *testing.T) {
+	mockECR, mockDocker, mockSTS, mockTagging := setupTestController(t)
+	defer func(original func(string) (string, error)) { lookPath = original }(lookPath)
+	lookPath = func(file string) (string, error) {
+		if file == "syft" {
+			return "", errors.New("executable file not found in $PATH")
+		}
+		return "/usr/local/bin/" + file, nil
+	}
+
+	context := setAttestationPushFlags(true, "")
+	err := pushImage(context, region, mockDocker, mockECR, mockSTS, mockTagging)
+	assert.Error(t, err, "Expected error when syft is not installed")
+}
+
+func TestImagePushWithFailedSignature(t *testing.T) {
+	mockECR, mockDocker, mockSTS, mockTagging := setupTestController(t)
+	defer func(original func(string) (string, error)) { lookPath = original }(lookPath)
+	defer func(original func(string, io.Reader, ...string) error) { runTool = original }(runTool)
+	lookPath = func(file string) (string, error) { return "/usr/local/bin/" + file, nil }
+	runTool = func(name string, stdin io.Reader, args ...string) error {
+		if name == "cosign" {
+			return errors.New("exit status 1")
+		}
+		return nil
+	}
+
+	mockSTS.EXPECT().GetAWSAccountID().Return(registryID, nil)
+	mockECR.EXPECT().GetAuthorizationTokenByID(gomock.Any()).Return(&ecr.Auth{Registry: registry}, nil)
+	mockDocker.EXPECT().TagImage(image, repositoryURI, tag).Return(nil)
+	mockECR.EXPECT().RepositoryExists(repository).Return(true)
+	mockDocker.EXPECT().PushImage(repositoryURI, tag, registry, docker.AuthConfiguration{}).Return(nil)
+	mockECR.EXPECT().GetImageDigest(registryID, repository, tag).Return(imageDigest, nil)
+
+	context := setAttestationPushFlags(false, "alias/signing")
+	err := pushImage(context, region, mockDocker, mockECR, mockSTS, mockTagging)
+	assert.EqualError(t, err, "unable to sign image: exit status 1")
+}
+
+func TestCosignKey(t *testing.T) {
+	keyFile, err := ioutil.TempFile("", "cosign.key")
+	require.NoError(t, err, "Unexpected error creating key file")
+	keyFile.Close()
+	defer os.Remove(keyFile.Name())
+
+	assert.Equal(t, "", cosignKey(""))
+	assert.Equal(t, "awskms:///1234abcd-12ab-34cd-56ef-1234567890ab", cosignKey("1234abcd-12ab-34cd-56ef-1234567890ab"))
+	assert.Equal(t, "awskms:///alias/signing", cosignKey("alias/signing"))
+	assert.Equal(t, "awskms:///arn:aws:kms:us-west-2:012345678912:alias/signing", cosignKey("arn:aws:kms:us-west-2:012345678912:alias/signing"))
+	assert.Equal(t, "hashivault://signing", cosignKey("hashivault://signing"))
+	assert.Equal(t, keyFile.Name(), cosignKey(keyFile.Name()))
+}
+
+func setAttestationPushFlags(sbom bool, signKey string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-push", 0)
+	flagSet.Bool(flags.SBOMFlag, sbom, "")
+	flagSet.String(flags.SignKeyFlag, signKey, "")
+	flagSet.Parse([]string{image})
+	return cli.NewContext(nil, flagSet, nil)
+}
@@ -0,0 +1,350 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package instances replaces the container instances of a cluster one at a time, e.g. to change their
+// instance type.
+package instances
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/capacity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/dynamodb"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	sdkCFN "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// sleep and now can be replaced in tests
+var sleep = time.Sleep
+var now = time.Now
+
+const (
+	registerPollInterval = 15 * time.Second
+	registerTimeout      = 15 * time.Minute
+)
+
+// ReplaceInstance replaces the given container instances of the cluster, one at a time.
+func ReplaceInstance(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'replace-instance': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'replace-instance': ", err)
+	}
+
+	awsClients := cluster.NewAWSClients(commandConfig)
+	if err := replaceInstances(c, awsClients, commandConfig); err != nil {
+		logrus.Fatal("Error executing 'replace-instance': ", err)
+	}
+}
+
+// ResizeInstanceType changes the instance type of the container instances of the cluster.
+func ResizeInstanceType(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'resize-instance-type': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'resize-instance-type': ", err)
+	}
+
+	awsClients := cluster.NewAWSClients(commandConfig)
+	if err := resizeInstanceType(c, awsClients, commandConfig, bufio.NewReader(os.Stdin)); err != nil {
+		logrus.Fatal("Error executing 'resize-instance-type': ", err)
+	}
+	if !c.Bool(flags.DryRunFlag) {
+		cluster.RecordClusterStack(c, commandConfig, "resize-instance-type", commandConfig.CFNStackName, commandConfig.Cluster, dynamodb.StackStatusActive)
+	}
+}
+
+// replaceInstances replaces the given container instances one at a time: each one is drained, its EC2
+// instance is terminated, and the command waits for the Auto Scaling group to register a replacement.
+func replaceInstances(context *cli.Context, awsClients *cluster.AWSClients, commandConfig *config.CommandConfig) error {
+	identifiers := context.Args()
+	if len(identifiers) == 0 {
+		return fmt.Errorf("Please specify the IDs or full ARNs of the container instances to replace")
+	}
+
+	ecsClient := awsClients.ECSClient
+	if err := cluster.ValidateCluster(commandConfig.Cluster, ecsClient); err != nil {
+		return err
+	}
+
+	outputs, err := awsClients.CFNClient.GetStackOutputs(commandConfig.CFNStackName)
+	if err != nil {
+		return fmt.Errorf("CloudFormation stack not found for cluster '%s'", commandConfig.Cluster)
+	}
+	asgName := outputs[cloudformation.OutputKeyAsgName]
+	if asgName == "" {
+		return fmt.Errorf("Cluster '%s' has no container instances launched by the ECS CLI", commandConfig.Cluster)
+	}
+	asgInstanceIDs, err := awsClients.EC2Client.GetAutoScalingGroupInstanceIDs(asgName)
+	if err != nil {
+		return err
+	}
+
+	containerInstances, err := ecsClient.DescribeContainerInstances(aws.StringSlice(identifiers))
+	if err != nil {
+		return err
+	}
+	if len(containerInstances) != len(identifiers) {
+		return fmt.Errorf("Found %d of the %d container instances to replace in cluster '%s'", len(containerInstances), len(identifiers), commandConfig.Cluster)
+	}
+	// Only instances of the Auto Scaling group are replaced after being terminated, so check all of them first
+	for _, containerInstance := range containerInstances {
+		if !utils.InSlice(aws.StringValue(containerInstance.Ec2InstanceId), asgInstanceIDs) {
+			return fmt.Errorf("Container instance %s was not launched by the Auto Scaling group of cluster '%s' and would not be replaced", composeutils.GetIdFromArn(aws.StringValue(containerInstance.ContainerInstanceArn)), commandConfig.Cluster)
+		}
+	}
+
+	for _, containerInstance := range containerInstances {
+		if err := replaceInstance(awsClients, containerInstance, !context.Bool(flags.ForceFlag)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func replaceInstance(awsClients *cluster.AWSClients, containerInstance *ecs.ContainerInstance, waitForDrain bool) error {
+	ecsClient := awsClients.ECSClient
+	containerInstanceArn := containerInstance.ContainerInstanceArn
+	containerInstanceID := composeutils.GetIdFromArn(aws.StringValue(containerInstanceArn))
+	ec2InstanceID := aws.StringValue(containerInstance.Ec2InstanceId)
+
+	existingArns, err := ecsClient.ListContainerInstances()
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("Draining container instance %s...", containerInstanceID)
+	if err := ecsClient.DrainContainerInstances([]*string{containerInstanceArn}); err != nil {
+		return err
+	}
+	if waitForDrain {
+		if err := capacity.WaitForTasksToDrain(ecsClient, []*string{containerInstanceArn}); err != nil {
+			return err
+		}
+	}
+
+	// Terminating the instance through EC2 leaves the desired capacity of the Auto Scaling group
+	// unchanged, so the group launches a replacement
+	logrus.Infof("Terminating EC2 instance %s...", ec2InstanceID)
+	if err := awsClients.EC2Client.TerminateInstance(ec2InstanceID); err != nil {
+		return err
+	}
+
+	replacementArn, err := waitForReplacementInstance(ecsClient, existingArns)
+	if err != nil {
+		return err
+	}
+	logrus.Infof("Container instance %s was replaced by container instance %s", containerInstanceID, composeutils.GetIdFromArn(replacementArn))
+	return nil
+}
+
+// resizeInstanceType executes the 'resize-instance-type' command. It updates the instance type of the
+// stack of the cluster, with the recommended AMI for the new instance type since its architecture may
+// differ, through a change set that is previewed first. The Auto Scaling group only launches new
+// instances with the new instance type, so the existing container instances are then replaced one at
+// a time, after their tasks are drained.
+func resizeInstanceType(context *cli.Context, awsClients *cluster.AWSClients, commandConfig *config.CommandConfig, reader *bufio.Reader) error {
+	if !context.Bool(flags.CapabilityIAMFlag) {
+		return fmt.Errorf("Please acknowledge that this command may create IAM resources with the '--%s' flag", flags.CapabilityIAMFlag)
+	}
+	instanceType := context.String(flags.InstanceTypeFlag)
+	if instanceType == "" {
+		return fmt.Errorf("Missing required flag '--%s'", flags.InstanceTypeFlag)
+	}
+
+	ecsClient := awsClients.ECSClient
+	cfnClient := awsClients.CFNClient
+	stackName := commandConfig.CFNStackName
+	if err := cluster.ValidateCluster(commandConfig.Cluster, ecsClient); err != nil {
+		return err
+	}
+	existingParameters, err := cfnClient.GetStackParameters(stackName)
+	if err != nil {
+		return fmt.Errorf("CloudFormation stack not found for cluster '%s'", commandConfig.Cluster)
+	}
+	outputs, err := cfnClient.GetStackOutputs(stackName)
+	if err != nil {
+		return err
+	}
+	asgName := outputs[cloudformation.OutputKeyAsgName]
+	if asgName == "" {
+		return fmt.Errorf("Cluster '%s' has no container instances launched by the ECS CLI", commandConfig.Cluster)
+	}
+
+	// The existing values are needed to compare the instance type and to find the availability zones of the
+	// Auto Scaling group, while the update uses the previous values of the other parameters
+	existingValues := cloudformation.NewCfnStackParams(nil)
+	for _, param := range existingParameters {
+		if value := aws.StringValue(param.ParameterValue); value != "" {
+			existingValues.Add(aws.StringValue(param.ParameterKey), value)
+		}
+	}
+	if param, err := existingValues.GetParameter(cluster.ParameterKeyInstanceType); err == nil && aws.StringValue(param.ParameterValue) == instanceType {
+		return fmt.Errorf("Cluster '%s' already uses instance type %s", commandConfig.Cluster, instanceType)
+	}
+	availabilityZones, err := cluster.StackAvailabilityZones(existingValues, awsClients.EC2Client)
+	if err != nil {
+		return err
+	}
+	if err := cluster.ValidateInstanceTypeOfferings(instanceType, availabilityZones, awsClients.EC2Client, commandConfig.Region()); err != nil {
+		return err
+	}
+
+	cfnParams, err := cloudformation.NewCfnStackParamsForUpdate(cluster.RequiredParameters, existingParameters)
+	if err != nil {
+		return err
+	}
+	cfnParams.Add(cluster.ParameterKeyInstanceType, instanceType)
+	if imageID := context.String(flags.ImageIdFlag); imageID != "" {
+		cfnParams.Add(cluster.ParameterKeyAmiId, imageID)
+	} else if err := cluster.PopulateAMIID(cfnParams, awsClients.AMIMetadataClient); err != nil {
+		return err
+	}
+
+	// Replace the container instances that exist before the update, the ones launched by the Auto Scaling group
+	asgInstanceIDs, err := awsClients.EC2Client.GetAutoScalingGroupInstanceIDs(asgName)
+	if err != nil {
+		return err
+	}
+	containerInstanceArns, err := ecsClient.ListContainerInstances()
+	if err != nil {
+		return err
+	}
+	var containerInstances []*ecs.ContainerInstance
+	if len(containerInstanceArns) > 0 {
+		registered, err := ecsClient.DescribeContainerInstances(containerInstanceArns)
+		if err != nil {
+			return err
+		}
+		for _, containerInstance := range registered {
+			if utils.InSlice(aws.StringValue(containerInstance.Ec2InstanceId), asgInstanceIDs) {
+				containerInstances = append(containerInstances, containerInstance)
+			}
+		}
+	}
+
+	changeSetName := fmt.Sprintf("ecs-cli-resize-instance-type-%d", now().Unix())
+	logrus.Info("Computing the changes to your cluster resources...")
+	changes, err := cfnClient.CreateChangeSet(stackName, changeSetName, cfnParams)
+	if err != nil {
+		return err
+	}
+	printChangeSet(os.Stdout, changes)
+	fmt.Printf("%d container instances will be replaced with %s instances, one at a time.\n", len(containerInstances), instanceType)
+
+	if context.Bool(flags.DryRunFlag) {
+		return cfnClient.DeleteChangeSet(stackName, changeSetName)
+	}
+	if !context.Bool(flags.ForceFlag) {
+		if err := resizeInstanceTypePrompt(reader); err != nil {
+			if deleteErr := cfnClient.DeleteChangeSet(stackName, changeSetName); deleteErr != nil {
+				logrus.Warnf("Could not delete change set %s: %v", changeSetName, deleteErr)
+			}
+			return err
+		}
+	}
+
+	if err := cfnClient.ExecuteChangeSet(stackName, changeSetName); err != nil {
+		return err
+	}
+	logrus.Info("Waiting for your cluster resources to be updated...")
+	if err := cfnClient.WaitUntilUpdateComplete(stackName); err != nil {
+		return err
+	}
+
+	for i, containerInstance := range containerInstances {
+		logrus.Infof("Replacing container instance %d of %d...", i+1, len(containerInstances))
+		if err := replaceInstance(awsClients, containerInstance, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printChangeSet prints the resource changes of a change set
+func printChangeSet(out io.Writer, changes []*sdkCFN.Change) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "ACTION\tRESOURCE\tTYPE\tREPLACEMENT")
+	for _, change := range changes {
+		if change.ResourceChange == nil {
+			continue
+		}
+		replacement := aws.StringValue(change.ResourceChange.Replacement)
+		if replacement == "" {
+			replacement = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", aws.StringValue(change.ResourceChange.Action), aws.StringValue(change.ResourceChange.LogicalResourceId),
+			aws.StringValue(change.ResourceChange.ResourceType), replacement)
+	}
+	w.Flush()
+}
+
+// resizeInstanceTypePrompt prompts and checks for confirmation to apply the change set and replace the instances
+func resizeInstanceTypePrompt(reader *bufio.Reader) error {
+	fmt.Println("Are you sure you want to update your cluster and replace its container instances? [y/N]")
+	input, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("Error reading input: %s", err.Error())
+	}
+	formattedInput := strings.ToLower(strings.TrimSpace(input))
+	if formattedInput != "yes" && formattedInput != "y" {
+		return fmt.Errorf("Aborted the instance type change. To apply it, re-run this command and specify the '--%s' flag or confirm at the prompt.", flags.ForceFlag)
+	}
+	return nil
+}
+
+// waitForReplacementInstance waits until a container instance that is not one of the existing
+// container instances registers to the cluster, and returns its ARN.
+func waitForReplacementInstance(ecsClient ecsclient.ECSClient, existingArns []*string) (string, error) {
+	existing := aws.StringValueSlice(existingArns)
+	for elapsed := time.Duration(0); ; elapsed += registerPollInterval {
+		containerInstanceArns, err := ecsClient.ListContainerInstances()
+		if err != nil {
+			return "", err
+		}
+		for _, arn := range aws.StringValueSlice(containerInstanceArns) {
+			if !utils.InSlice(arn, existing) {
+				return arn, nil
+			}
+		}
+		if elapsed >= registerTimeout {
+			return "", fmt.Errorf("Timed out waiting for a replacement container instance to register to your cluster")
+		}
+		logrus.Info("Waiting for a replacement container instance to register to your cluster...")
+		sleep(registerPollInterval)
+	}
+}
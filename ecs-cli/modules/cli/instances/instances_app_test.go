@@ -0,0 +1,324 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package instances
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata"
+	mock_amimetadata "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	mock_cloudformation "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	sdkCFN "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+const (
+	clusterName = "defaultCluster"
+	stackName   = "defaultCluster"
+	amiID       = "ami-deadb33f"
+	armAMIID    = "ami-baadf00d"
+)
+
+func setupTest(t *testing.T) (*mock_ecs.MockECSClient, *mock_cloudformation.MockCloudformationClient, *mock_amimetadata.MockClient, *mock_ec2.MockEC2Client) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockSSM := mock_amimetadata.NewMockClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "secret")
+	os.Setenv("AWS_REGION", "us-west-1")
+
+	return mockECS, mockCloudformation, mockSSM, mockEC2
+}
+
+func TestClusterReplaceInstance(t *testing.T) {
+	defer func() { sleep = time.Sleep }()
+	var slept []time.Duration
+	sleep = func(d time.Duration) { slept = append(slept, d) }
+
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+	containerInstanceArn := aws.String("arn:aws:ecs:us-west-1:123456789012:container-instance/clusterName/old")
+	replacementArn := aws.String("arn:aws:ecs:us-west-1:123456789012:container-instance/clusterName/new")
+	otherArn := aws.String("arn:aws:ecs:us-west-1:123456789012:container-instance/clusterName/other")
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackOutputs(stackName).Return(map[string]string{
+			cloudformation.OutputKeyAsgName: "asg",
+		}, nil),
+		mockEC2.EXPECT().GetAutoScalingGroupInstanceIDs("asg").Return([]string{"i-old", "i-other"}, nil),
+		mockECS.EXPECT().DescribeContainerInstances(aws.StringSlice([]string{"old"})).Return([]*ecs.ContainerInstance{
+			{ContainerInstanceArn: containerInstanceArn, Ec2InstanceId: aws.String("i-old")},
+		}, nil),
+		mockECS.EXPECT().ListContainerInstances().Return([]*string{containerInstanceArn, otherArn}, nil),
+		mockECS.EXPECT().DrainContainerInstances([]*string{containerInstanceArn}).Return(nil),
+		mockECS.EXPECT().GetRunningTasksCount([]*string{containerInstanceArn}).Return(int64(0), nil),
+		mockEC2.EXPECT().TerminateInstance("i-old").Return(nil),
+		mockECS.EXPECT().ListContainerInstances().Return([]*string{containerInstanceArn, otherArn}, nil),
+		mockECS.EXPECT().ListContainerInstances().Return([]*string{otherArn, replacementArn}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-replace-instance", 0)
+	flagSet.Parse([]string{"old"})
+
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig := &config.CommandConfig{
+		Cluster:      clusterName,
+		CFNStackName: stackName,
+	}
+
+	err := replaceInstances(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error replacing container instance")
+	assert.Equal(t, []time.Duration{registerPollInterval}, slept, "Expected to wait once for the replacement to register")
+}
+
+func TestClusterReplaceInstanceNotInAutoScalingGroup(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackOutputs(stackName).Return(map[string]string{
+			cloudformation.OutputKeyAsgName: "asg",
+		}, nil),
+		mockEC2.EXPECT().GetAutoScalingGroupInstanceIDs("asg").Return([]string{"i-other"}, nil),
+		mockECS.EXPECT().DescribeContainerInstances(aws.StringSlice([]string{"manual"})).Return([]*ecs.ContainerInstance{
+			{ContainerInstanceArn: aws.String("arn:aws:ecs:us-west-1:123456789012:container-instance/clusterName/manual"), Ec2InstanceId: aws.String("i-manual")},
+		}, nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli-replace-instance", 0)
+	flagSet.Parse([]string{"manual"})
+
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig := &config.CommandConfig{
+		Cluster:      clusterName,
+		CFNStackName: stackName,
+	}
+
+	err := replaceInstances(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error replacing a container instance that is not part of the Auto Scaling group")
+}
+
+func TestClusterReplaceInstanceWithoutArgs(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	flagSet := flag.NewFlagSet("ecs-cli-replace-instance", 0)
+	context := cli.NewContext(nil, flagSet, nil)
+	commandConfig := &config.CommandConfig{
+		Cluster:      clusterName,
+		CFNStackName: stackName,
+	}
+
+	err := replaceInstances(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error when no container instance is specified")
+}
+
+func TestClusterResizeInstanceType(t *testing.T) {
+	defer func() { sleep = time.Sleep }()
+	sleep = func(time.Duration) {}
+
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+	oldArn := aws.String("arn:aws:ecs:us-west-1:123456789012:container-instance/clusterName/old")
+	manualArn := aws.String("arn:aws:ecs:us-west-1:123456789012:container-instance/clusterName/manual")
+	replacementArn := aws.String("arn:aws:ecs:us-west-1:123456789012:container-instance/clusterName/new")
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(resizeInstanceTypeStackParameters(), nil),
+		mockCloudformation.EXPECT().GetStackOutputs(stackName).Return(map[string]string{
+			cloudformation.OutputKeyAsgName: "asg",
+		}, nil),
+		mockEC2.EXPECT().DescribeInstanceTypeOfferingsByAZ([]string{"us-west-1a", "us-west-1b"}).Return(map[string][]string{
+			"us-west-1a": {"t2.micro", "m6g.large"},
+			"us-west-1b": {"t2.micro", "m6g.large"},
+		}, nil),
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("m6g.large").Return(amiMetadata(armAMIID), nil),
+		mockEC2.EXPECT().GetAutoScalingGroupInstanceIDs("asg").Return([]string{"i-old"}, nil),
+		mockECS.EXPECT().ListContainerInstances().Return([]*string{oldArn, manualArn}, nil),
+		mockECS.EXPECT().DescribeContainerInstances([]*string{oldArn, manualArn}).Return([]*ecs.ContainerInstance{
+			{ContainerInstanceArn: oldArn, Ec2InstanceId: aws.String("i-old")},
+			{ContainerInstanceArn: manualArn, Ec2InstanceId: aws.String("i-manual")},
+		}, nil),
+		mockCloudformation.EXPECT().CreateChangeSet(stackName, gomock.Any(), gomock.Any()).Do(func(_, _ string, params *cloudformation.CfnStackParams) {
+			instanceType, err := params.GetParameter(cluster.ParameterKeyInstanceType)
+			assert.NoError(t, err, "Expected the instance type to be updated")
+			assert.Equal(t, "m6g.large", aws.StringValue(instanceType.ParameterValue))
+			amiID, err := params.GetParameter(cluster.ParameterKeyAmiId)
+			assert.NoError(t, err, "Expected the AMI to be updated")
+			assert.Equal(t, armAMIID, aws.StringValue(amiID.ParameterValue))
+			keyPair, err := params.GetParameter(cluster.ParameterKeyKeyPairName)
+			assert.NoError(t, err, "Expected the other parameters to be kept")
+			assert.True(t, aws.BoolValue(keyPair.UsePreviousValue))
+		}).Return([]*sdkCFN.Change{}, nil),
+		mockCloudformation.EXPECT().ExecuteChangeSet(stackName, gomock.Any()).Return(nil),
+		mockCloudformation.EXPECT().WaitUntilUpdateComplete(stackName).Return(nil),
+		mockECS.EXPECT().ListContainerInstances().Return([]*string{oldArn, manualArn}, nil),
+		mockECS.EXPECT().DrainContainerInstances([]*string{oldArn}).Return(nil),
+		mockECS.EXPECT().GetRunningTasksCount([]*string{oldArn}).Return(int64(0), nil),
+		mockEC2.EXPECT().TerminateInstance("i-old").Return(nil),
+		mockECS.EXPECT().ListContainerInstances().Return([]*string{manualArn, replacementArn}, nil),
+	)
+
+	err := resizeInstanceType(newResizeInstanceTypeContext(t, "--force"), awsClients, newResizeInstanceTypeConfig(t), nil)
+	assert.NoError(t, err, "Unexpected error resizing the instance type")
+}
+
+func TestClusterResizeInstanceTypeDryRun(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(resizeInstanceTypeStackParameters(), nil),
+		mockCloudformation.EXPECT().GetStackOutputs(stackName).Return(map[string]string{
+			cloudformation.OutputKeyAsgName: "asg",
+		}, nil),
+		mockEC2.EXPECT().DescribeInstanceTypeOfferingsByAZ(gomock.Any()).Return(map[string][]string{
+			"us-west-1a": {"m5.large"},
+			"us-west-1b": {"m5.large"},
+		}, nil),
+		mockEC2.EXPECT().GetAutoScalingGroupInstanceIDs("asg").Return(nil, nil),
+		mockECS.EXPECT().ListContainerInstances().Return(nil, nil),
+		mockCloudformation.EXPECT().CreateChangeSet(stackName, gomock.Any(), gomock.Any()).Do(func(_, _ string, params *cloudformation.CfnStackParams) {
+			amiID, err := params.GetParameter(cluster.ParameterKeyAmiId)
+			assert.NoError(t, err, "Expected the AMI to be updated")
+			assert.Equal(t, "ami-custom", aws.StringValue(amiID.ParameterValue))
+		}).Return([]*sdkCFN.Change{}, nil),
+		mockCloudformation.EXPECT().DeleteChangeSet(stackName, gomock.Any()).Return(nil),
+	)
+
+	err := resizeInstanceType(newResizeInstanceTypeContext(t, "--instance-type", "m5.large", "--image-id", "ami-custom", "--dry-run"), awsClients, newResizeInstanceTypeConfig(t), nil)
+	assert.NoError(t, err, "Unexpected error previewing the instance type change")
+}
+
+func TestClusterResizeInstanceTypeAborted(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(resizeInstanceTypeStackParameters(), nil),
+		mockCloudformation.EXPECT().GetStackOutputs(stackName).Return(map[string]string{
+			cloudformation.OutputKeyAsgName: "asg",
+		}, nil),
+		mockEC2.EXPECT().DescribeInstanceTypeOfferingsByAZ(gomock.Any()).Return(map[string][]string{
+			"us-west-1a": {"m6g.large"},
+			"us-west-1b": {"m6g.large"},
+		}, nil),
+		mockSSM.EXPECT().GetRecommendedECSLinuxAMI("m6g.large").Return(amiMetadata(armAMIID), nil),
+		mockEC2.EXPECT().GetAutoScalingGroupInstanceIDs("asg").Return(nil, nil),
+		mockECS.EXPECT().ListContainerInstances().Return(nil, nil),
+		mockCloudformation.EXPECT().CreateChangeSet(stackName, gomock.Any(), gomock.Any()).Return([]*sdkCFN.Change{}, nil),
+		mockCloudformation.EXPECT().DeleteChangeSet(stackName, gomock.Any()).Return(nil),
+	)
+
+	err := resizeInstanceType(newResizeInstanceTypeContext(t), awsClients, newResizeInstanceTypeConfig(t), bufio.NewReader(strings.NewReader("n\n")))
+	assert.Error(t, err, "Expected error when the change is not confirmed")
+}
+
+func TestClusterResizeInstanceTypeSameInstanceType(t *testing.T) {
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockCloudformation.EXPECT().GetStackParameters(stackName).Return(resizeInstanceTypeStackParameters(), nil),
+		mockCloudformation.EXPECT().GetStackOutputs(stackName).Return(map[string]string{
+			cloudformation.OutputKeyAsgName: "asg",
+		}, nil),
+	)
+
+	err := resizeInstanceType(newResizeInstanceTypeContext(t, "--instance-type", "t2.micro"), awsClients, newResizeInstanceTypeConfig(t), nil)
+	assert.Error(t, err, "Expected error when the instance type does not change")
+}
+
+func TestPrintChangeSet(t *testing.T) {
+	out := new(bytes.Buffer)
+	printChangeSet(out, []*sdkCFN.Change{
+		{ResourceChange: &sdkCFN.ResourceChange{
+			Action:            aws.String(sdkCFN.ChangeActionModify),
+			LogicalResourceId: aws.String("EcsInstanceLt"),
+			ResourceType:      aws.String("AWS::EC2::LaunchTemplate"),
+			Replacement:       aws.String(sdkCFN.ReplacementFalse),
+		}},
+	})
+	assert.Equal(t, "ACTION              RESOURCE            TYPE                       REPLACEMENT\n"+
+		"Modify              EcsInstanceLt       AWS::EC2::LaunchTemplate   False\n", out.String())
+}
+
+func resizeInstanceTypeStackParameters() []*sdkCFN.Parameter {
+	return []*sdkCFN.Parameter{
+		{ParameterKey: aws.String(cluster.ParameterKeyInstanceType), ParameterValue: aws.String("t2.micro")},
+		{ParameterKey: aws.String(cluster.ParameterKeyAmiId), ParameterValue: aws.String(amiID)},
+		{ParameterKey: aws.String(cluster.ParameterKeyKeyPairName), ParameterValue: aws.String("default")},
+		{ParameterKey: aws.String(cluster.ParameterKeyVPCAzs), ParameterValue: aws.String("us-west-1a,us-west-1b")},
+	}
+}
+
+// newResizeInstanceTypeContext returns the context of the command, with --capability-iam and an instance type of
+// m6g.large unless the arguments specify another one
+func newResizeInstanceTypeContext(t *testing.T, args ...string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-resize-instance-type", 0)
+	flagSet.Bool(flags.CapabilityIAMFlag, true, "")
+	flagSet.String(flags.InstanceTypeFlag, "m6g.large", "")
+	flagSet.String(flags.ImageIdFlag, "", "")
+	flagSet.Bool(flags.DryRunFlag, false, "")
+	flagSet.Bool(flags.ForceFlag, false, "")
+	require.NoError(t, flagSet.Parse(args))
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func newResizeInstanceTypeConfig(t *testing.T) *config.CommandConfig {
+	testSession, err := session.NewSession(&aws.Config{Region: aws.String("us-west-1")})
+	require.NoError(t, err)
+	return &config.CommandConfig{
+		Cluster:      clusterName,
+		CFNStackName: stackName,
+		Session:      testSession,
+	}
+}
+
+func amiMetadata(imageID string) *amimetadata.AMIMetadata {
+	return &amimetadata.AMIMetadata{
+		ImageID:        imageID,
+		OsName:         "Amazon Linux",
+		AgentVersion:   "1.7.2",
+		RuntimeVersion: "Docker version 17.12.1-ce",
+	}
+}
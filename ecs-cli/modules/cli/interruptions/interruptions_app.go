@@ -0,0 +1,129 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package interruptions lists the Spot interruptions of the container instances of a cluster.
+package interruptions
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	ec2client "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// spotInterruptionStatusPrefixes and spotInterruptionStatusSuffixes match the Spot Instance request
+// status codes of instances that were, or are about to be, interrupted by EC2.
+var spotInterruptionStatusPrefixes = []string{"marked-for-"}
+var spotInterruptionStatusSuffixes = []string{"-by-price", "-no-capacity", "-capacity-oversubscribed", "-by-experiment"}
+
+// Interruptions lists the recent Spot interruptions of the container instances of the cluster.
+func Interruptions(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'interruptions': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'interruptions': ", err)
+	}
+
+	cfnClient := cloudformation.NewCloudformationClient(commandConfig)
+	ec2Client := ec2client.NewEC2Client(commandConfig)
+	interruptions, err := clusterInterruptions(cfnClient, ec2Client, commandConfig)
+	if err != nil {
+		logrus.Fatal("Error executing 'interruptions': ", err)
+	}
+	printInterruptions(os.Stdout, interruptions)
+}
+
+type spotInterruption struct {
+	Time       time.Time
+	InstanceID string
+	Status     string
+	Message    string
+}
+
+// clusterInterruptions returns the Spot interruptions of the instances launched by the Auto Scaling group
+// of the cluster, most recent first. EC2 keeps terminated instances and their Spot Instance requests for a
+// few hours only, so older interruptions are not returned.
+func clusterInterruptions(cfnClient cloudformation.CloudformationClient, ec2Client ec2client.EC2Client, commandConfig *config.CommandConfig) ([]*spotInterruption, error) {
+	outputs, err := cfnClient.GetStackOutputs(commandConfig.CFNStackName)
+	if err != nil {
+		return nil, fmt.Errorf("CloudFormation stack not found for cluster '%s'", commandConfig.Cluster)
+	}
+	asgName := outputs[cloudformation.OutputKeyAsgName]
+	if asgName == "" {
+		logrus.Infof("Cluster '%s' has no container instances launched by the ECS CLI", commandConfig.Cluster)
+		return nil, nil
+	}
+
+	instanceIDs, err := ec2Client.GetAutoScalingGroupInstanceIDs(asgName)
+	if err != nil {
+		return nil, err
+	}
+	requests, err := ec2Client.DescribeSpotInstanceRequests(instanceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var interruptions []*spotInterruption
+	for _, request := range requests {
+		if request.Status == nil || !isSpotInterruption(aws.StringValue(request.Status.Code)) {
+			continue
+		}
+		interruptions = append(interruptions, &spotInterruption{
+			Time:       aws.TimeValue(request.Status.UpdateTime),
+			InstanceID: aws.StringValue(request.InstanceId),
+			Status:     aws.StringValue(request.Status.Code),
+			Message:    aws.StringValue(request.Status.Message),
+		})
+	}
+	sort.SliceStable(interruptions, func(i, j int) bool {
+		return interruptions[i].Time.After(interruptions[j].Time)
+	})
+	return interruptions, nil
+}
+
+func isSpotInterruption(statusCode string) bool {
+	for _, prefix := range spotInterruptionStatusPrefixes {
+		if strings.HasPrefix(statusCode, prefix) {
+			return true
+		}
+	}
+	for _, suffix := range spotInterruptionStatusSuffixes {
+		if strings.HasSuffix(statusCode, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func printInterruptions(out io.Writer, interruptions []*spotInterruption) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "TIME\tINSTANCE\tSTATUS\tMESSAGE")
+	for _, interruption := range interruptions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", interruption.Time.UTC().Format(time.RFC3339), interruption.InstanceID, interruption.Status, interruption.Message)
+	}
+	w.Flush()
+}
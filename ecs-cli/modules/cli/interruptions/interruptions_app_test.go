@@ -0,0 +1,98 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package interruptions
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	mock_cloudformation "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterInterruptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+
+	commandConfig := &config.CommandConfig{
+		Cluster:      "dev",
+		CFNStackName: "amazon-ecs-cli-setup-dev",
+	}
+	earlier := time.Date(2020, 5, 4, 10, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().GetStackOutputs("amazon-ecs-cli-setup-dev").Return(map[string]string{
+			cloudformation.OutputKeyAsgName: "dev-asg",
+		}, nil),
+		mockEC2.EXPECT().GetAutoScalingGroupInstanceIDs("dev-asg").Return([]string{"i-1", "i-2", "i-3"}, nil),
+		mockEC2.EXPECT().DescribeSpotInstanceRequests([]string{"i-1", "i-2", "i-3"}).Return([]*ec2.SpotInstanceRequest{
+			{
+				InstanceId: aws.String("i-1"),
+				Status: &ec2.SpotInstanceStatus{
+					Code:       aws.String("instance-terminated-no-capacity"),
+					Message:    aws.String("Spot Instance terminated due to no available capacity."),
+					UpdateTime: aws.Time(earlier),
+				},
+			},
+			{
+				InstanceId: aws.String("i-2"),
+				Status:     &ec2.SpotInstanceStatus{Code: aws.String("fulfilled"), UpdateTime: aws.Time(later)},
+			},
+			{
+				InstanceId: aws.String("i-3"),
+				Status:     &ec2.SpotInstanceStatus{Code: aws.String("marked-for-termination"), UpdateTime: aws.Time(later)},
+			},
+		}, nil),
+	)
+
+	interruptions, err := clusterInterruptions(mockCloudformation, mockEC2, commandConfig)
+	assert.NoError(t, err, "Unexpected error listing cluster interruptions")
+	assert.Equal(t, []*spotInterruption{
+		{Time: later, InstanceID: "i-3", Status: "marked-for-termination"},
+		{Time: earlier, InstanceID: "i-1", Status: "instance-terminated-no-capacity", Message: "Spot Instance terminated due to no available capacity."},
+	}, interruptions)
+
+	out := &bytes.Buffer{}
+	printInterruptions(out, interruptions)
+	assert.Contains(t, out.String(), "2020-05-04T11:00:00Z")
+	assert.Contains(t, out.String(), "instance-terminated-no-capacity")
+}
+
+func TestClusterInterruptionsWithoutInstances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+
+	commandConfig := &config.CommandConfig{
+		Cluster:      "dev",
+		CFNStackName: "amazon-ecs-cli-setup-dev",
+	}
+
+	mockCloudformation.EXPECT().GetStackOutputs("amazon-ecs-cli-setup-dev").Return(map[string]string{}, nil)
+
+	interruptions, err := clusterInterruptions(mockCloudformation, mockEC2, commandConfig)
+	assert.NoError(t, err, "Unexpected error listing interruptions of a cluster without instances")
+	assert.Empty(t, interruptions)
+}
@@ -14,12 +14,84 @@
 package license
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"sort"
+	"text/tabwriter"
 
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/version"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
 
-// PrintLicense prints the license
+// PrintLicense prints the license, or the third-party attributions as JSON
 func PrintLicense(c *cli.Context) {
+	if c.Bool(flags.JSON) {
+		if err := printJSON(os.Stdout, Attributions()); err != nil {
+			logrus.Fatal("Error executing 'license': ", err)
+		}
+		return
+	}
 	os.Stdout.WriteString(License)
 }
+
+// PrintVersion prints the build metadata and the AWS API versions of the ECS CLI
+func PrintVersion(c *cli.Context) {
+	if err := printVersion(c, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'version': ", err)
+	}
+}
+
+func printVersion(c *cli.Context, out io.Writer) error {
+	report := NewReport()
+	if c.Bool(flags.CheckUpdateFlag) {
+		update, err := checkUpdate(report.Version)
+		if err != nil {
+			return err
+		}
+		report.Update = update
+	}
+	if c.Bool(flags.JSON) {
+		report.Attributions = Attributions()
+		return printJSON(out, report)
+	}
+	printReport(out, report)
+	return nil
+}
+
+func printJSON(out io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func printReport(out io.Writer, report *Report) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintf(w, "Version\t%s\n", version.String())
+	fmt.Fprintf(w, "Build date\t%s\n", report.BuildDate)
+	fmt.Fprintf(w, "Go version\t%s %s\n", report.GoVersion, report.Platform)
+	fmt.Fprintf(w, "AWS SDK version\t%s\n", report.SDKVersion)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "SERVICE\tAPI VERSION")
+	var services []string
+	for service := range report.APIVersions {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		fmt.Fprintf(w, "%s\t%s\n", service, report.APIVersions[service])
+	}
+	w.Flush()
+
+	if update := report.Update; update != nil {
+		fmt.Fprintln(out)
+		if update.UpdateAvailable {
+			fmt.Fprintf(out, "Version %s of the ECS CLI is available: %s\n", update.LatestVersion, update.ReleaseURL)
+		} else {
+			fmt.Fprintf(out, "The ECS CLI is up to date; the latest release is %s.\n", update.LatestVersion)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package license
+
+import (
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/version"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2instanceconnect"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// vendorPrefix precedes the import path of each third-party component in License
+const vendorPrefix = "./../../../vendor/"
+
+// Report is the build metadata, the AWS API versions and the third-party attributions of the ECS CLI
+type Report struct {
+	Version      string            `json:"Version"`
+	GitHash      string            `json:"GitHash"`
+	GitDirty     bool              `json:"GitDirty"`
+	BuildDate    string            `json:"BuildDate"`
+	GoVersion    string            `json:"GoVersion"`
+	Platform     string            `json:"Platform"`
+	SDKVersion   string            `json:"SdkVersion"`
+	APIVersions  map[string]string `json:"ApiVersions"`
+	Attributions []*Attribution    `json:"Attributions,omitempty"`
+	Update       *UpdateCheck      `json:"Update,omitempty"`
+}
+
+// Attribution is a third-party component distributed with the ECS CLI, and the license it is used under
+type Attribution struct {
+	Component string `json:"Component"`
+	License   string `json:"License"`
+}
+
+// NewReport returns the report of this build of the ECS CLI
+func NewReport() *Report {
+	return &Report{
+		Version:     version.Version,
+		GitHash:     version.GitShortHash,
+		GitDirty:    version.GitDirty,
+		BuildDate:   version.BuildDate,
+		GoVersion:   runtime.Version(),
+		Platform:    runtime.GOOS + "/" + runtime.GOARCH,
+		SDKVersion:  aws.SDKVersion,
+		APIVersions: apiVersions(),
+	}
+}
+
+// apiVersions returns the version of each AWS API the ECS CLI calls, by the signing name of the
+// service. The clients are only created to read their metadata, so the session needs neither a
+// real region nor credentials.
+func apiVersions() map[string]string {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-east-1"),
+		Credentials: credentials.AnonymousCredentials,
+	}))
+	versions := make(map[string]string)
+	for _, c := range []*client.Client{
+		applicationautoscaling.New(sess).Client,
+		cloudformation.New(sess).Client,
+		cloudwatch.New(sess).Client,
+		cloudwatchlogs.New(sess).Client,
+		costexplorer.New(sess).Client,
+		dynamodb.New(sess).Client,
+		ec2.New(sess).Client,
+		ec2instanceconnect.New(sess).Client,
+		ecr.New(sess).Client,
+		ecs.New(sess).Client,
+		elbv2.New(sess).Client,
+		iam.New(sess).Client,
+		kms.New(sess).Client,
+		lambda.New(sess).Client,
+		resourcegroupstaggingapi.New(sess).Client,
+		route53.New(sess).Client,
+		secretsmanager.New(sess).Client,
+		servicediscovery.New(sess).Client,
+		servicequotas.New(sess).Client,
+		sns.New(sess).Client,
+		ssm.New(sess).Client,
+		sts.New(sess).Client,
+	} {
+		versions[c.SigningName] = c.APIVersion
+	}
+	return versions
+}
+
+// Attributions returns the third-party components in the license text, sorted by import path
+func Attributions() []*Attribution {
+	var attributions []*Attribution
+	for _, section := range strings.Split(License, "\n***\n") {
+		if !strings.HasPrefix(section, vendorPrefix) {
+			continue
+		}
+		lines := strings.SplitN(section, "\n", 2)
+		text := ""
+		if len(lines) == 2 {
+			text = lines[1]
+		}
+		attributions = append(attributions, &Attribution{
+			Component: strings.TrimPrefix(lines[0], vendorPrefix),
+			License:   licenseName(text),
+		})
+	}
+	sort.Slice(attributions, func(i, j int) bool {
+		return attributions[i].Component < attributions[j].Component
+	})
+	return attributions
+}
+
+// licenseName identifies the common open source licenses from their text, or returns "Other"
+func licenseName(text string) string {
+	switch {
+	case strings.Contains(text, "Apache License"):
+		return "Apache-2.0"
+	case strings.Contains(text, "Mozilla Public License"):
+		return "MPL-2.0"
+	case strings.Contains(text, "Permission is hereby granted, free of charge"):
+		return "MIT"
+	case strings.Contains(text, "Permission to use, copy, modify, and/or distribute"),
+		strings.Contains(text, "Permission to use, copy, modify, and distribute"):
+		return "ISC"
+	case strings.Contains(text, "Redistribution and use in source and binary forms"):
+		if strings.Contains(text, "Neither the name") || strings.Contains(text, "names of its contributors") {
+			return "BSD-3-Clause"
+		}
+		return "BSD-2-Clause"
+	}
+	return "Other"
+}
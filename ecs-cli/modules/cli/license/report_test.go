@@ -0,0 +1,74 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package license
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func TestNewReport(t *testing.T) {
+	report := NewReport()
+	assert.Equal(t, "2014-11-13", report.APIVersions["ecs"])
+	assert.Equal(t, "2010-05-15", report.APIVersions["cloudformation"])
+	assert.Equal(t, "2019-06-24", report.APIVersions["servicequotas"])
+	assert.NotEmpty(t, report.SDKVersion)
+}
+
+func TestAttributions(t *testing.T) {
+	attributions := Attributions()
+	require.NotEmpty(t, attributions)
+	licenses := make(map[string]string)
+	for _, attribution := range attributions {
+		licenses[attribution.Component] = attribution.License
+	}
+	assert.Equal(t, "Apache-2.0", licenses["github.com/aws/aws-sdk-go"])
+	assert.Equal(t, "MIT", licenses["github.com/urfave/cli"])
+	assert.Equal(t, "BSD-2-Clause", licenses["github.com/pkg/errors"])
+	assert.Equal(t, "BSD-3-Clause", licenses["golang.org/x/crypto"])
+	assert.Equal(t, "github.com/Azure/go-ansiterm", attributions[0].Component, "Expected the components to be sorted")
+}
+
+func TestLicenseName(t *testing.T) {
+	testCases := map[string]string{
+		"Mozilla Public License, version 2.0":                           "MPL-2.0",
+		"Permission to use, copy, modify, and distribute this software": "ISC",
+		"Some custom terms": "Other",
+	}
+	for text, expected := range testCases {
+		assert.Equal(t, expected, licenseName(text), text)
+	}
+}
+
+func TestPrintVersionJSON(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-version", 0)
+	flagSet.Bool(flags.JSON, true, "")
+	context := cli.NewContext(nil, flagSet, nil)
+
+	out := new(bytes.Buffer)
+	require.NoError(t, printVersion(context, out))
+
+	report := &Report{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), report))
+	assert.Equal(t, NewReport().Version, report.Version)
+	assert.NotEmpty(t, report.Attributions, "Expected the attributions to be included")
+	assert.Nil(t, report.Update, "Expected no update check without --check-update")
+}
@@ -0,0 +1,102 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package license
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const updateCheckTimeout = 10 * time.Second
+
+// latestReleaseURL is the GitHub releases API endpoint of the latest ECS CLI release; can be replaced in tests
+var latestReleaseURL = "https://api.github.com/repos/memfault/memfault-ecs-cli/releases/latest"
+
+var updateCheckClient = &http.Client{Timeout: updateCheckTimeout}
+
+// UpdateCheck is the result of comparing the version of the ECS CLI with its latest release
+type UpdateCheck struct {
+	LatestVersion   string `json:"LatestVersion"`
+	UpdateAvailable bool   `json:"UpdateAvailable"`
+	ReleaseURL      string `json:"ReleaseUrl"`
+}
+
+// githubRelease is the subset of a release returned by the GitHub releases API that the check needs
+type githubRelease struct {
+	TagName string `json:"tag_name"`
+	HTMLURL string `json:"html_url"`
+}
+
+// checkUpdate compares the current version with the latest release published on GitHub
+func checkUpdate(currentVersion string) (*UpdateCheck, error) {
+	request, err := http.NewRequest(http.MethodGet, latestReleaseURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/vnd.github.v3+json")
+
+	response, err := updateCheckClient.Do(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error checking for updates")
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error checking for updates: GitHub returned %s", response.Status)
+	}
+
+	release := &githubRelease{}
+	if err := json.NewDecoder(response.Body).Decode(release); err != nil {
+		return nil, errors.Wrap(err, "Error reading the latest release")
+	}
+	latestVersion := strings.TrimPrefix(release.TagName, "v")
+	if latestVersion == "" {
+		return nil, fmt.Errorf("Error reading the latest release: no tag name")
+	}
+	return &UpdateCheck{
+		LatestVersion:   latestVersion,
+		UpdateAvailable: compareVersions(latestVersion, currentVersion) > 0,
+		ReleaseURL:      release.HTMLURL,
+	}, nil
+}
+
+// compareVersions compares dotted numeric versions, e.g. 1.21.0, and returns -1, 0 or 1. Parts which
+// are not numeric, e.g. pre-release suffixes, compare as 0.
+func compareVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		aValue, bValue := versionPart(aParts, i), versionPart(bParts, i)
+		if aValue != bValue {
+			if aValue < bValue {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionPart(parts []string, i int) int {
+	if i >= len(parts) {
+		return 0
+	}
+	value, _ := strconv.Atoi(parts[i])
+	return value
+}
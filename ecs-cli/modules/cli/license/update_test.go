@@ -0,0 +1,66 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package license
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/vnd.github.v3+json", r.Header.Get("Accept"))
+		w.Write([]byte(`{"tag_name": "v1.22.0", "html_url": "https://github.com/memfault/memfault-ecs-cli/releases/tag/v1.22.0"}`))
+	}))
+	defer server.Close()
+	defer mockLatestReleaseURL(server.URL)()
+
+	update, err := checkUpdate("1.21.0")
+	require.NoError(t, err)
+	assert.Equal(t, "1.22.0", update.LatestVersion)
+	assert.True(t, update.UpdateAvailable)
+	assert.Equal(t, "https://github.com/memfault/memfault-ecs-cli/releases/tag/v1.22.0", update.ReleaseURL)
+
+	update, err = checkUpdate("1.22.0")
+	require.NoError(t, err)
+	assert.False(t, update.UpdateAvailable)
+}
+
+func TestCheckUpdateError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	defer mockLatestReleaseURL(server.URL)()
+
+	_, err := checkUpdate("1.21.0")
+	assert.Error(t, err, "Expected error when GitHub rejects the request")
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, 1, compareVersions("1.10.0", "1.9.2"))
+	assert.Equal(t, -1, compareVersions("1.21", "1.21.1"))
+	assert.Equal(t, 0, compareVersions("1.21.0", "1.21"))
+}
+
+// mockLatestReleaseURL replaces the releases API endpoint, until the returned function is called
+func mockLatestReleaseURL(url string) func() {
+	original := latestReleaseURL
+	latestReleaseURL = url
+	return func() { latestReleaseURL = original }
+}
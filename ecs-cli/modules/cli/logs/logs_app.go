@@ -14,7 +14,13 @@
 package logs
 
 import (
+	"bufio"
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	cwlogsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
@@ -25,6 +31,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/docker/docker/pkg/term"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -33,8 +40,31 @@ import (
 const (
 	// followLogsWaitTime is the time in seconds to sleep between API calls to get logs
 	followLogsWaitTime = 10
+
+	colorReset = "\x1b[0m"
 )
 
+// ANSI colors used to tell apart the logs of each container
+var containerColors = []string{
+	"\x1b[36m", // cyan
+	"\x1b[33m", // yellow
+	"\x1b[32m", // green
+	"\x1b[35m", // magenta
+	"\x1b[34m", // blue
+	"\x1b[31m", // red
+}
+
+// stdin and stdout are only used interactively if they are terminals; overridden in tests
+var promptInput io.Reader = os.Stdin
+var isInteractive = func() bool {
+	_, isTerminal := term.GetFdInfo(os.Stdin)
+	return isTerminal
+}
+var isColorOutput = func() bool {
+	_, isTerminal := term.GetFdInfo(os.Stdout)
+	return isTerminal
+}
+
 type logConfiguration struct {
 	logGroup  *string
 	logRegion *string
@@ -93,9 +123,19 @@ func logsRequest(context *cli.Context, ecsClient ecsclient.ECSClient, config *co
 		return nil, "", errors.Wrap(err, fmt.Sprintf("Failed to Describe TaskDefinition; try using --%s to specify the Task Definition.", flags.TaskDefinitionFlag))
 	}
 
-	containerName := context.String(flags.ContainerNameFlag)
+	containerName, err := resolveContainerName(taskDef, context.String(flags.ContainerNameFlag))
+	if err != nil {
+		return nil, "", err
+	}
 	logConfig, err := getLogConfiguration(taskDef, taskID, containerName)
-
+	if err != nil && containerName == "" && len(taskDef.ContainerDefinitions) > 1 && isInteractive() {
+		// the logs of all containers can't be queried together, so ask which container to show
+		logrus.Warn(err)
+		if containerName, err = promptForContainer(bufio.NewReader(promptInput), containerNames(taskDef)); err != nil {
+			return nil, "", err
+		}
+		logConfig, err = getLogConfiguration(taskDef, taskID, containerName)
+	}
 	if err != nil {
 		return nil, "", errors.Wrap(err, "Failed to get log configuration")
 	}
@@ -112,6 +152,66 @@ func logsRequest(context *cli.Context, ecsClient ecsclient.ECSClient, config *co
 	return request, aws.StringValue(logConfig.logRegion), nil
 }
 
+// resolveContainerName returns the container of the task definition whose logs were requested, or
+// an empty string to show the logs of all containers. A name that doesn't match a container exactly
+// selects the container it is part of, if it is unique, or else prompts for one in a terminal.
+func resolveContainerName(taskDef *ecs.TaskDefinition, containerName string) (string, error) {
+	if containerName == "" {
+		return "", nil
+	}
+	names := containerNames(taskDef)
+	var candidates []string
+	for _, name := range names {
+		if name == containerName {
+			return name, nil
+		}
+		if strings.Contains(strings.ToLower(name), strings.ToLower(containerName)) {
+			candidates = append(candidates, name)
+		}
+	}
+	if len(candidates) == 1 {
+		logrus.Infof("Showing logs for container %s", candidates[0])
+		return candidates[0], nil
+	}
+	if isInteractive() {
+		if len(candidates) == 0 {
+			candidates = names
+		}
+		return promptForContainer(bufio.NewReader(promptInput), candidates)
+	}
+	return "", fmt.Errorf("Container %s does not uniquely match a container in %s; use --%s with one of: %s", containerName, aws.StringValue(taskDef.TaskDefinitionArn), flags.ContainerNameFlag, strings.Join(names, ", "))
+}
+
+func containerNames(taskDef *ecs.TaskDefinition) []string {
+	var names []string
+	for _, containerDef := range taskDef.ContainerDefinitions {
+		names = append(names, aws.StringValue(containerDef.Name))
+	}
+	return names
+}
+
+// promptForContainer asks the user to select one of the containers by number or name
+func promptForContainer(reader *bufio.Reader, names []string) (string, error) {
+	fmt.Println("Select a container:")
+	for i, name := range names {
+		fmt.Printf("  %d) %s\n", i+1, name)
+	}
+	input, err := reader.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("Error reading input: %s", err.Error())
+	}
+	selection := strings.TrimSpace(input)
+	if index, err := strconv.Atoi(selection); err == nil && index >= 1 && index <= len(names) {
+		return names[index-1], nil
+	}
+	for _, name := range names {
+		if name == selection {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("Invalid container selection %q; specify a container with --%s", selection, flags.ContainerNameFlag)
+}
+
 func getTaskDefArn(context *cli.Context, ecsClient ecsclient.ECSClient, config *config.CommandConfig) (string, error) {
 	var taskIDs []*string
 	taskID := context.String(flags.TaskIDFlag)
@@ -148,14 +248,16 @@ func logs(context *cli.Context, input *cloudwatchlogs.FilterLogEventsInput, cwLo
 }
 
 func printLogEvents(context *cli.Context, input *cloudwatchlogs.FilterLogEventsInput, cwLogsClient cwlogsclient.Client) (lastEvent *cloudwatchlogs.FilteredLogEvent, err error) {
+	prefixes := containerPrefixes(input.LogStreamNames, isColorOutput())
 	err = cwLogsClient.FilterAllLogEvents(input, func(events []*cloudwatchlogs.FilteredLogEvent) {
 		for _, event := range events {
 			lastEvent = event
+			message := prefixes[aws.StringValue(event.LogStreamName)] + aws.StringValue(event.Message)
 			if context.Bool(flags.TimeStampsFlag) {
 				timeStamp := time.Unix(0, aws.Int64Value(event.Timestamp)*int64(time.Millisecond))
-				fmt.Printf("%s\t%s\n", timeStamp.Format(time.RFC3339), aws.StringValue(event.Message))
+				fmt.Printf("%s\t%s\n", timeStamp.Format(time.RFC3339), message)
 			} else {
-				fmt.Println(aws.StringValue(event.Message))
+				fmt.Println(message)
 			}
 			fmt.Println()
 		}
@@ -163,6 +265,41 @@ func printLogEvents(context *cli.Context, input *cloudwatchlogs.FilterLogEventsI
 	return lastEvent, err
 }
 
+// containerPrefixes returns the prefix printed before each log event of a stream, which is the name of
+// the container, padded and optionally colored. No prefix is needed for the logs of a single container.
+func containerPrefixes(streams []*string, color bool) map[string]string {
+	prefixes := make(map[string]string)
+	if len(streams) < 2 {
+		return prefixes
+	}
+
+	streamNames := aws.StringValueSlice(streams)
+	sort.Strings(streamNames)
+	width := 0
+	for _, stream := range streamNames {
+		if name := streamContainerName(stream); len(name) > width {
+			width = len(name)
+		}
+	}
+	for i, stream := range streamNames {
+		prefix := fmt.Sprintf("%-*s |", width, streamContainerName(stream))
+		if color {
+			prefix = containerColors[i%len(containerColors)] + prefix + colorReset
+		}
+		prefixes[stream] = prefix + " "
+	}
+	return prefixes
+}
+
+// streamContainerName returns the container name of a log stream named prefix/container-name/task-id
+func streamContainerName(stream string) string {
+	parts := strings.Split(stream, "/")
+	if len(parts) < 3 {
+		return stream
+	}
+	return parts[len(parts)-2]
+}
+
 // validateLogFlags ensures that conflicting flags are not used
 func validateLogFlags(context *cli.Context) error {
 	if taskID := context.String(flags.TaskIDFlag); taskID == "" {
@@ -16,6 +16,8 @@ package logs
 import (
 	"flag"
 	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs/mock"
@@ -49,6 +51,13 @@ const (
 	clientErrorMesssage = "Some Error with CloudWatch Logs Client"
 )
 
+func TestMain(m *testing.M) {
+	// keep the tests from prompting when run in a terminal
+	isInteractive = func() bool { return false }
+	isColorOutput = func() bool { return false }
+	os.Exit(m.Run())
+}
+
 func dummyTaskDef(containers []*ecs.ContainerDefinition) *ecs.TaskDefinition {
 	taskDef := &ecs.TaskDefinition{}
 	taskDef.SetContainerDefinitions(containers)
@@ -261,8 +270,97 @@ func TestLogsRequestContainerFlag(t *testing.T) {
 	assert.Equal(t, 1, len(request.LogStreamNames))
 }
 
+func TestLogsRequestPartialContainerName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	container1 := dummyContainerDefFromLogOptions(logRegion1, logGroup1, logPrefix1)
+	container2 := dummyContainerDef(logRegion1, logGroup1, logPrefix1, "awslogs", containerName2, containerImage2)
+	taskDef := dummyTaskDef([]*ecs.ContainerDefinition{container1, container2})
+
+	mockECS.EXPECT().DescribeTaskDefinition(taskDefName).Return(taskDef, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.TaskIDFlag, taskID, "")
+	flagSet.String(flags.TaskDefinitionFlag, taskDefName, "")
+	flagSet.String(flags.ContainerNameFlag, "sql", "")
+	context := cli.NewContext(nil, flagSet, nil)
+
+	request, _, err := logsRequest(context, mockECS, &config.CommandConfig{})
+	assert.NoError(t, err, "Unexpected error getting logs")
+	assert.Equal(t, []string{logPrefix1 + "/" + containerName2 + "/" + taskID}, aws.StringValueSlice(request.LogStreamNames))
+}
+
+func TestLogsRequestMismatchLogGroupPromptsForContainer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	container1 := dummyContainerDefFromLogOptions(logRegion1, logGroup1, logPrefix1)
+	container2 := dummyContainerDef(logRegion2, logGroup2, logPrefix2, "awslogs", containerName2, containerImage2)
+	taskDef := dummyTaskDef([]*ecs.ContainerDefinition{container1, container2})
+
+	mockECS.EXPECT().DescribeTaskDefinition(taskDefName).Return(taskDef, nil)
+
+	isInteractive = func() bool { return true }
+	promptInput = strings.NewReader("2\n")
+	defer func() {
+		isInteractive = func() bool { return false }
+		promptInput = os.Stdin
+	}()
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.TaskIDFlag, taskID, "")
+	flagSet.String(flags.TaskDefinitionFlag, taskDefName, "")
+	context := cli.NewContext(nil, flagSet, nil)
+
+	request, logRegion, err := logsRequest(context, mockECS, &config.CommandConfig{})
+	assert.NoError(t, err, "Unexpected error getting logs")
+	assert.Equal(t, logRegion2, logRegion)
+	assert.Equal(t, logGroup2, aws.StringValue(request.LogGroupName))
+	assert.Equal(t, []string{logPrefix2 + "/" + containerName2 + "/" + taskID}, aws.StringValueSlice(request.LogStreamNames))
+}
+
+func TestContainerPrefixes(t *testing.T) {
+	streams := aws.StringSlice([]string{
+		logPrefix1 + "/" + containerName + "/" + taskID,
+		logPrefix1 + "/" + containerName2 + "/" + taskID,
+	})
+
+	prefixes := containerPrefixes(streams, false)
+	assert.Equal(t, "wordpress | ", prefixes[logPrefix1+"/"+containerName+"/"+taskID])
+	assert.Equal(t, "mysql     | ", prefixes[logPrefix1+"/"+containerName2+"/"+taskID])
+
+	prefixes = containerPrefixes(streams, true)
+	assert.Equal(t, containerColors[0]+"mysql     |"+colorReset+" ", prefixes[logPrefix1+"/"+containerName2+"/"+taskID])
+
+	assert.Empty(t, containerPrefixes(streams[:1], true), "Expected no prefix for the logs of a single container")
+}
+
 // Error Cases
 
+func TestLogsRequestContainerNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	container1 := dummyContainerDefFromLogOptions(logRegion1, logGroup1, logPrefix1)
+	container2 := dummyContainerDef(logRegion1, logGroup1, logPrefix1, "awslogs", containerName2, containerImage2)
+	taskDef := dummyTaskDef([]*ecs.ContainerDefinition{container1, container2})
+
+	mockECS.EXPECT().DescribeTaskDefinition(taskDefName).Return(taskDef, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.TaskIDFlag, taskID, "")
+	flagSet.String(flags.TaskDefinitionFlag, taskDefName, "")
+	flagSet.String(flags.ContainerNameFlag, "redis", "")
+	context := cli.NewContext(nil, flagSet, nil)
+
+	_, _, err := logsRequest(context, mockECS, &config.CommandConfig{})
+	assert.Error(t, err, "Expected error getting logs for a container that is not in the task definition")
+}
+
 func TestLogsRequestMismatchRegionError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
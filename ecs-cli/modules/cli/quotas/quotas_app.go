@@ -0,0 +1,161 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package quotas compares the usage of a cluster against the ECS and VPC service quotas of its region.
+package quotas
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/servicequotas"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// service quotas lookup can be easily mocked in tests
+var getServiceQuotas servicequotas.GetServiceQuotasFunc = servicequotas.GetServiceQuotas
+
+// Names of the Service Quotas quotas reported by the quotas command
+const (
+	quotaServicesPerCluster           = "Services per cluster"
+	quotaTasksPerService              = "Tasks per service"
+	quotaContainerInstancesPerCluster = "Container instances per cluster"
+	quotaNetworkInterfacesPerRegion   = "Network interfaces per Region"
+)
+
+// Quotas prints the usage of the cluster against its service quotas.
+func Quotas(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'quotas': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'quotas': ", err)
+	}
+
+	awsClients := cluster.NewAWSClients(commandConfig)
+	quotas, err := clusterQuotas(c, awsClients, commandConfig)
+	if err != nil {
+		logrus.Fatal("Error executing 'quotas': ", err)
+	}
+	printQuotas(os.Stdout, quotas)
+}
+
+type quotaUsage struct {
+	Name string
+	// Scope is the resource whose usage is reported, e.g. the service with the most tasks
+	Scope string
+	Usage int64
+	// Limit is 0 if the quota could not be found
+	Limit float64
+}
+
+// Percent returns the usage as a percentage of the quota
+func (q *quotaUsage) Percent() float64 {
+	if q.Limit == 0 {
+		return 0
+	}
+	return float64(q.Usage) / q.Limit * 100
+}
+
+// clusterQuotas compares the usage of the cluster against the ECS and VPC service quotas of the
+// region, and warns about the quotas whose usage is above the threshold.
+func clusterQuotas(context *cli.Context, awsClients *cluster.AWSClients, commandConfig *config.CommandConfig) ([]*quotaUsage, error) {
+	threshold := context.Int(flags.QuotaWarningThresholdFlag)
+	if threshold <= 0 || threshold > 100 {
+		return nil, fmt.Errorf("--%s must be a percentage between 1 and 100", flags.QuotaWarningThresholdFlag)
+	}
+
+	ecsClient := awsClients.ECSClient
+	if err := cluster.ValidateCluster(commandConfig.Cluster, ecsClient); err != nil {
+		return nil, err
+	}
+
+	serviceArns, err := ecsClient.ListServices()
+	if err != nil {
+		return nil, err
+	}
+	services, err := ecsClient.DescribeServices(serviceArns)
+	if err != nil {
+		return nil, err
+	}
+	busiestService, maxTasks := "-", int64(0)
+	for _, service := range services {
+		tasks := aws.Int64Value(service.DesiredCount)
+		if running := aws.Int64Value(service.RunningCount) + aws.Int64Value(service.PendingCount); running > tasks {
+			tasks = running
+		}
+		if tasks > maxTasks || busiestService == "-" {
+			busiestService, maxTasks = aws.StringValue(service.ServiceName), tasks
+		}
+	}
+
+	containerInstanceArns, err := ecsClient.ListContainerInstances()
+	if err != nil {
+		return nil, err
+	}
+	networkInterfaces, err := awsClients.EC2Client.CountNetworkInterfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	ecsQuotas, err := getServiceQuotas(servicequotas.ECSServiceCode, commandConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to look up ECS service quotas")
+	}
+	vpcQuotas, err := getServiceQuotas(servicequotas.VPCServiceCode, commandConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to look up VPC service quotas")
+	}
+
+	quotas := []*quotaUsage{
+		{Name: quotaServicesPerCluster, Scope: commandConfig.Cluster, Usage: int64(len(services)), Limit: ecsQuotas[quotaServicesPerCluster]},
+		{Name: quotaTasksPerService, Scope: busiestService, Usage: maxTasks, Limit: ecsQuotas[quotaTasksPerService]},
+		{Name: quotaContainerInstancesPerCluster, Scope: commandConfig.Cluster, Usage: int64(len(containerInstanceArns)), Limit: ecsQuotas[quotaContainerInstancesPerCluster]},
+		{Name: quotaNetworkInterfacesPerRegion, Scope: aws.StringValue(commandConfig.Session.Config.Region), Usage: networkInterfaces, Limit: vpcQuotas[quotaNetworkInterfacesPerRegion]},
+	}
+	for _, quota := range quotas {
+		if quota.Limit == 0 {
+			logrus.Warnf("Could not find the '%s' quota in Service Quotas", quota.Name)
+			continue
+		}
+		if quota.Percent() >= float64(threshold) {
+			logrus.Warnf("%s is at %.0f%% of the '%s' quota (%d of %.0f)", quota.Scope, quota.Percent(), quota.Name, quota.Usage, quota.Limit)
+		}
+	}
+	return quotas, nil
+}
+
+func printQuotas(out io.Writer, quotas []*quotaUsage) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "QUOTA\tSCOPE\tUSAGE\tLIMIT\tPERCENT")
+	for _, quota := range quotas {
+		limit, percent := "-", "-"
+		if quota.Limit != 0 {
+			limit = fmt.Sprintf("%.0f", quota.Limit)
+			percent = fmt.Sprintf("%.1f%%", quota.Percent())
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", quota.Name, quota.Scope, quota.Usage, limit, percent)
+	}
+	w.Flush()
+}
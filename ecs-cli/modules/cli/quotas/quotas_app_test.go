@@ -0,0 +1,153 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package quotas
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	mock_amimetadata "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata/mock"
+	mock_cloudformation "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/servicequotas"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const clusterName = "defaultCluster"
+
+type mockReadWriter struct {
+	clusterName string
+}
+
+func (rdwr *mockReadWriter) Get(cluster string, profile string) (*config.LocalConfig, error) {
+	cliConfig := config.NewLocalConfig(rdwr.clusterName)
+	cliConfig.CFNStackName = rdwr.clusterName
+	return cliConfig, nil
+}
+
+func (rdwr *mockReadWriter) SaveProfile(configName string, profile *config.Profile) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SaveCluster(configName string, cluster *config.Cluster) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultProfile(configName string) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultCluster(configName string) error {
+	return nil
+}
+
+func newMockReadWriter() *mockReadWriter {
+	return &mockReadWriter{
+		clusterName: clusterName,
+	}
+}
+
+func setupTest(t *testing.T) (*mock_ecs.MockECSClient, *mock_cloudformation.MockCloudformationClient, *mock_amimetadata.MockClient, *mock_ec2.MockEC2Client) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockSSM := mock_amimetadata.NewMockClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "secret")
+	os.Setenv("AWS_REGION", "us-west-1")
+
+	return mockECS, mockCloudformation, mockSSM, mockEC2
+}
+
+func TestClusterQuotas(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	serviceArns := aws.StringSlice([]string{"arn1", "arn2"})
+	services := []*ecs.Service{
+		{ServiceName: aws.String("web"), DesiredCount: aws.Int64(4), RunningCount: aws.Int64(4)},
+		{ServiceName: aws.String("worker"), DesiredCount: aws.Int64(2), RunningCount: aws.Int64(2), PendingCount: aws.Int64(3)},
+	}
+	gomock.InOrder(
+		mockECS.EXPECT().IsActiveCluster(gomock.Any()).Return(true, nil),
+		mockECS.EXPECT().ListServices().Return(serviceArns, nil),
+		mockECS.EXPECT().DescribeServices(serviceArns).Return(services, nil),
+		mockECS.EXPECT().ListContainerInstances().Return(aws.StringSlice([]string{"arn3"}), nil),
+	)
+	mockEC2.EXPECT().CountNetworkInterfaces().Return(int64(4500), nil)
+
+	getServiceQuotas = func(serviceCode string, config *config.CommandConfig) (map[string]float64, error) {
+		if serviceCode == "vpc" {
+			return map[string]float64{quotaNetworkInterfacesPerRegion: 5000}, nil
+		}
+		return map[string]float64{quotaServicesPerCluster: 2, quotaTasksPerService: 1000}, nil
+	}
+	defer func() { getServiceQuotas = servicequotas.GetServiceQuotas }()
+
+	flagSet := flag.NewFlagSet("ecs-cli-quotas", 0)
+	flagSet.Int(flags.QuotaWarningThresholdFlag, 80, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	quotas, err := clusterQuotas(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error comparing quotas")
+	if assert.Len(t, quotas, 4) {
+		assert.Equal(t, int64(2), quotas[0].Usage, "Expected services per cluster usage")
+		assert.Equal(t, float64(100), quotas[0].Percent(), "Expected services per cluster to be at the quota")
+		assert.Equal(t, "worker", quotas[1].Scope, "Expected the service with the most tasks")
+		assert.Equal(t, int64(5), quotas[1].Usage, "Expected running and pending tasks to be counted")
+		assert.Equal(t, int64(1), quotas[2].Usage, "Expected container instances per cluster usage")
+		assert.Zero(t, quotas[2].Limit, "Expected missing quota to have no limit")
+		assert.Equal(t, float64(90), quotas[3].Percent(), "Expected network interfaces per region percentage")
+	}
+
+	out := &bytes.Buffer{}
+	printQuotas(out, quotas)
+	assert.Contains(t, out.String(), "100.0%")
+	assert.Contains(t, out.String(), "90.0%")
+}
+
+func TestClusterQuotasInvalidThreshold(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	flagSet := flag.NewFlagSet("ecs-cli-quotas", 0)
+	flagSet.Int(flags.QuotaWarningThresholdFlag, 120, "")
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	_, err = clusterQuotas(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error for a threshold above 100")
+}
@@ -0,0 +1,193 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package regions reports the availability of the ECS launch options across regions.
+package regions
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata"
+	ec2client "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// Values of the columns of the availability matrix
+const (
+	available   = "yes"
+	unavailable = "no"
+	unknown     = "unknown"
+)
+
+const (
+	fargateCapacityProvider     = "FARGATE"
+	fargateSpotCapacityProvider = "FARGATE_SPOT"
+)
+
+// make the per-region clients easily mockable in tests
+var (
+	newECSClient      = ecsclient.NewECSClient
+	newEC2Client      = ec2client.NewEC2Client
+	newMetadataClient = amimetadata.NewMetadataClient
+)
+
+// regionAvailability holds the availability of the launch options in a region
+type regionAvailability struct {
+	Region       string
+	Fargate      string
+	FargateSpot  string
+	InstanceType string
+	AMI          string
+}
+
+// Regions prints the availability of Fargate, Fargate Spot, the instance type and its
+// ECS-optimized AMI in each region.
+func Regions(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'regions': ", err)
+	}
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'regions': ", err)
+	}
+	if err := regions(c, commandConfig, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'regions': ", err)
+	}
+}
+
+func regions(context *cli.Context, commandConfig *config.CommandConfig, out io.Writer) error {
+	instanceType := context.String(flags.InstanceTypeFlag)
+	if instanceType == "" {
+		return fmt.Errorf("--%s must not be empty", flags.InstanceTypeFlag)
+	}
+	regionNames, err := listRegions(context, commandConfig)
+	if err != nil {
+		return err
+	}
+
+	results := make([]*regionAvailability, len(regionNames))
+	var wg sync.WaitGroup
+	for i, region := range regionNames {
+		wg.Add(1)
+		go func(i int, region string) {
+			defer wg.Done()
+			results[i] = checkRegion(regionConfig(commandConfig, region), instanceType)
+		}(i, region)
+	}
+	wg.Wait()
+
+	printAvailability(out, instanceType, results)
+	return nil
+}
+
+// listRegions returns the regions given with the --regions flag, or all the regions enabled for the account
+func listRegions(context *cli.Context, commandConfig *config.CommandConfig) ([]string, error) {
+	value := context.String(flags.RegionsFlag)
+	if value == "" {
+		regionNames, err := newEC2Client(commandConfig).DescribeRegions()
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to list the regions of the account")
+		}
+		return regionNames, nil
+	}
+	var regionNames []string
+	for _, region := range strings.Split(value, ",") {
+		if region = strings.TrimSpace(region); region != "" {
+			regionNames = append(regionNames, region)
+		}
+	}
+	if len(regionNames) == 0 {
+		return nil, fmt.Errorf("--%s must list at least one region", flags.RegionsFlag)
+	}
+	return regionNames, nil
+}
+
+// regionConfig returns a copy of the command config whose session targets the region
+func regionConfig(commandConfig *config.CommandConfig, region string) *config.CommandConfig {
+	copied := *commandConfig
+	copied.Session = commandConfig.Session.Copy(&aws.Config{Region: aws.String(region)})
+	return &copied
+}
+
+// checkRegion reports the availability of the launch options in the region of the command config. A check
+// that fails is logged and reported as unknown, so that one region does not hide the others.
+func checkRegion(commandConfig *config.CommandConfig, instanceType string) *regionAvailability {
+	region := commandConfig.Region()
+	result := &regionAvailability{
+		Region:       region,
+		Fargate:      unknown,
+		FargateSpot:  unknown,
+		InstanceType: unknown,
+		AMI:          unknown,
+	}
+	logger := logrus.WithField("region", region)
+
+	providers, err := newECSClient(commandConfig).DescribeCapacityProviders([]string{fargateCapacityProvider, fargateSpotCapacityProvider})
+	if err != nil {
+		logger.Warnf("Could not check the availability of Fargate: %v", err)
+	} else {
+		result.Fargate, result.FargateSpot = unavailable, unavailable
+		for _, provider := range providers {
+			switch aws.StringValue(provider.Name) {
+			case fargateCapacityProvider:
+				result.Fargate = available
+			case fargateSpotCapacityProvider:
+				result.FargateSpot = available
+			}
+		}
+	}
+
+	offered, err := newEC2Client(commandConfig).IsInstanceTypeOffered(instanceType)
+	if err != nil {
+		logger.Warnf("Could not check the availability of instance type %s: %v", instanceType, err)
+	} else if offered {
+		result.InstanceType = available
+	} else {
+		result.InstanceType = unavailable
+	}
+
+	ami, err := newMetadataClient(commandConfig).GetRecommendedECSLinuxAMI(instanceType)
+	if err != nil {
+		if aerr, ok := errors.Cause(err).(awserr.Error); ok && aerr.Code() == ssm.ErrCodeParameterNotFound {
+			result.AMI = unavailable
+		} else {
+			logger.Warnf("Could not check the availability of the ECS-optimized AMI: %v", err)
+		}
+	} else {
+		result.AMI = ami.ImageID
+	}
+	return result
+}
+
+func printAvailability(out io.Writer, instanceType string, results []*regionAvailability) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintf(w, "REGION\tFARGATE\tFARGATE_SPOT\t%s\tAMI (%s)\n", instanceType, amimetadata.Flavor(instanceType))
+	for _, result := range results {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", result.Region, result.Fargate, result.FargateSpot, result.InstanceType, result.AMI)
+	}
+	w.Flush()
+}
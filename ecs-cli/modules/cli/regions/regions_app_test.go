@@ -0,0 +1,172 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package regions
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata"
+	mock_amimetadata "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata/mock"
+	ec2client "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2"
+	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+type regionMocks struct {
+	ecs         *mock_ecs.MockECSClient
+	ec2         *mock_ec2.MockEC2Client
+	amiMetadata *mock_amimetadata.MockClient
+}
+
+// setupRegionMocks replaces the client constructors with mocks per region, and returns a command config
+// targeting the first region and a function restoring the constructors.
+func setupRegionMocks(t *testing.T, regionNames ...string) (map[string]*regionMocks, *config.CommandConfig, func()) {
+	ctrl := gomock.NewController(t)
+	mocks := make(map[string]*regionMocks)
+	for _, region := range regionNames {
+		mocks[region] = &regionMocks{
+			ecs:         mock_ecs.NewMockECSClient(ctrl),
+			ec2:         mock_ec2.NewMockEC2Client(ctrl),
+			amiMetadata: mock_amimetadata.NewMockClient(ctrl),
+		}
+	}
+
+	oldECSClient, oldEC2Client, oldMetadataClient := newECSClient, newEC2Client, newMetadataClient
+	newECSClient = func(commandConfig *config.CommandConfig) ecsclient.ECSClient {
+		return mocks[commandConfig.Region()].ecs
+	}
+	newEC2Client = func(commandConfig *config.CommandConfig) ec2client.EC2Client {
+		return mocks[commandConfig.Region()].ec2
+	}
+	newMetadataClient = func(commandConfig *config.CommandConfig) amimetadata.Client {
+		return mocks[commandConfig.Region()].amiMetadata
+	}
+	teardown := func() {
+		ctrl.Finish()
+		newECSClient, newEC2Client, newMetadataClient = oldECSClient, oldEC2Client, oldMetadataClient
+	}
+
+	testSession, err := session.NewSession(&aws.Config{Region: aws.String(regionNames[0])})
+	require.NoError(t, err, "Unexpected error creating session")
+	return mocks, &config.CommandConfig{Session: testSession}, teardown
+}
+
+func newContext(regionsValue string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.String(flags.RegionsFlag, regionsValue, "")
+	flagSet.String(flags.InstanceTypeFlag, "m6g.large", "")
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestRegions(t *testing.T) {
+	mocks, commandConfig, teardown := setupRegionMocks(t, "us-east-1", "ap-east-1")
+	defer teardown()
+
+	us := mocks["us-east-1"]
+	us.ecs.EXPECT().DescribeCapacityProviders([]string{"FARGATE", "FARGATE_SPOT"}).Return([]*ecs.CapacityProvider{
+		{Name: aws.String("FARGATE")},
+		{Name: aws.String("FARGATE_SPOT")},
+	}, nil)
+	us.ec2.EXPECT().IsInstanceTypeOffered("m6g.large").Return(true, nil)
+	us.amiMetadata.EXPECT().GetRecommendedECSLinuxAMI("m6g.large").Return(&amimetadata.AMIMetadata{ImageID: "ami-123"}, nil)
+
+	ap := mocks["ap-east-1"]
+	ap.ecs.EXPECT().DescribeCapacityProviders(gomock.Any()).Return([]*ecs.CapacityProvider{
+		{Name: aws.String("FARGATE")},
+	}, nil)
+	ap.ec2.EXPECT().IsInstanceTypeOffered("m6g.large").Return(false, nil)
+	ap.amiMetadata.EXPECT().GetRecommendedECSLinuxAMI("m6g.large").Return(nil,
+		pkgerrors.Wrap(awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil), "Could not find Recommended Amazon Linux 2 AMI"))
+
+	out := &bytes.Buffer{}
+	err := regions(newContext("us-east-1, ap-east-1"), commandConfig, out)
+	assert.NoError(t, err, "Unexpected error checking the regions")
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3, "Expected a header and a line per region")
+	assert.Equal(t, []string{"REGION", "FARGATE", "FARGATE_SPOT", "m6g.large", "AMI", "(arm64)"}, fields(lines[0]))
+	assert.Equal(t, []string{"us-east-1", "yes", "yes", "yes", "ami-123"}, fields(lines[1]))
+	assert.Equal(t, []string{"ap-east-1", "yes", "no", "no", "no"}, fields(lines[2]))
+}
+
+func TestRegionsDefaultsToAccountRegions(t *testing.T) {
+	mocks, commandConfig, teardown := setupRegionMocks(t, "us-west-2", "eu-west-1")
+	defer teardown()
+
+	mocks["us-west-2"].ec2.EXPECT().DescribeRegions().Return([]string{"eu-west-1", "us-west-2"}, nil)
+	for _, m := range mocks {
+		m.ecs.EXPECT().DescribeCapacityProviders(gomock.Any()).Return(nil, nil)
+		m.ec2.EXPECT().IsInstanceTypeOffered(gomock.Any()).Return(true, nil)
+		m.amiMetadata.EXPECT().GetRecommendedECSLinuxAMI(gomock.Any()).Return(&amimetadata.AMIMetadata{ImageID: "ami-123"}, nil)
+	}
+
+	out := &bytes.Buffer{}
+	err := regions(newContext(""), commandConfig, out)
+	assert.NoError(t, err, "Unexpected error checking the regions")
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3, "Expected a header and a line per region")
+	assert.Equal(t, "eu-west-1", fields(lines[1])[0])
+	assert.Equal(t, "us-west-2", fields(lines[2])[0])
+}
+
+func TestRegionsWithFailedChecks(t *testing.T) {
+	mocks, commandConfig, teardown := setupRegionMocks(t, "us-east-1")
+	defer teardown()
+
+	m := mocks["us-east-1"]
+	m.ecs.EXPECT().DescribeCapacityProviders(gomock.Any()).Return(nil, errors.New("access denied"))
+	m.ec2.EXPECT().IsInstanceTypeOffered(gomock.Any()).Return(false, errors.New("access denied"))
+	m.amiMetadata.EXPECT().GetRecommendedECSLinuxAMI(gomock.Any()).Return(nil, errors.New("access denied"))
+
+	out := &bytes.Buffer{}
+	err := regions(newContext("us-east-1"), commandConfig, out)
+	assert.NoError(t, err, "Expected failed checks to be reported as unknown")
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2, "Expected a header and a line per region")
+	assert.Equal(t, []string{"us-east-1", "unknown", "unknown", "unknown", "unknown"}, fields(lines[1]))
+}
+
+func TestRegionsWithEmptyRegionList(t *testing.T) {
+	_, commandConfig, teardown := setupRegionMocks(t, "us-east-1")
+	defer teardown()
+
+	err := regions(newContext(" , "), commandConfig, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error when --regions lists no region")
+}
+
+func fields(line []byte) []string {
+	var result []string
+	for _, field := range bytes.Fields(line) {
+		result = append(result, string(field))
+	}
+	return result
+}
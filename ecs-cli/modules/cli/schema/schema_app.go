@@ -0,0 +1,62 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package schema prints the JSON Schemas of the files read by the ECS CLI.
+package schema
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/schema"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// Schema prints the JSON Schema named by the argument, or validates a file against it
+func Schema(c *cli.Context) {
+	if err := printSchema(c, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'schema': ", err)
+	}
+}
+
+func printSchema(c *cli.Context, out io.Writer) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("Specify the name of a schema: %s", strings.Join(schema.Names(), ", "))
+	}
+	name := c.Args().First()
+	document, err := schema.Get(name)
+	if err != nil {
+		return err
+	}
+
+	path := c.String(flags.ValidateFlag)
+	if path == "" {
+		fmt.Fprint(out, document)
+		return nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "Error reading %s", path)
+	}
+	if err := schema.Validate(name, data); err != nil {
+		return errors.Wrapf(err, "Invalid %s file: %s", name, path)
+	}
+	fmt.Fprintf(out, "%s is a valid %s file\n", path, name)
+	return nil
+}
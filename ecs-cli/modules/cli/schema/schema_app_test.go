@@ -0,0 +1,87 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package schema
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func TestPrintSchema(t *testing.T) {
+	out := &bytes.Buffer{}
+	err := printSchema(newContext(t, "", "credentials"), out)
+	require.NoError(t, err, "Unexpected error printing the schema")
+
+	expected, err := schema.Get(schema.Credentials)
+	require.NoError(t, err)
+	assert.Equal(t, expected, out.String())
+}
+
+func TestPrintSchemaWithoutName(t *testing.T) {
+	err := printSchema(newContext(t, ""), &bytes.Buffer{})
+	assert.EqualError(t, err, "Specify the name of a schema: cluster-config, credentials, ecs-params")
+}
+
+func TestPrintSchemaUnknownName(t *testing.T) {
+	err := printSchema(newContext(t, "", "compose"), &bytes.Buffer{})
+	assert.Error(t, err, "Expected an error for an unknown schema")
+}
+
+func TestPrintSchemaValidate(t *testing.T) {
+	path := writeTempFile(t, "version: 1\ntask_definition:\n  ecs_network_mode: awsvpc\n")
+	defer os.Remove(path)
+
+	out := &bytes.Buffer{}
+	err := printSchema(newContext(t, path, "ecs-params"), out)
+	require.NoError(t, err, "Unexpected error validating the file")
+	assert.Equal(t, path+" is a valid ecs-params file\n", out.String())
+}
+
+func TestPrintSchemaValidateViolations(t *testing.T) {
+	path := writeTempFile(t, "version: 1\ntask_definitions:\n  ecs_network_mode: awsvpc\n")
+	defer os.Remove(path)
+
+	out := &bytes.Buffer{}
+	err := printSchema(newContext(t, path, "ecs-params"), out)
+	if assert.Error(t, err, "Expected an error for an invalid file") {
+		assert.Contains(t, err.Error(), "Invalid ecs-params file: "+path)
+		assert.Contains(t, err.Error(), "task_definitions: Additional property task_definitions is not allowed")
+	}
+	assert.Empty(t, out.String())
+}
+
+func newContext(t *testing.T, validate string, args ...string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.String(flags.ValidateFlag, validate, "")
+	require.NoError(t, flagSet.Parse(args))
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func writeTempFile(t *testing.T, content string) string {
+	tmpfile, err := ioutil.TempFile("", "ecs-cli-schema")
+	require.NoError(t, err, "Could not create tempfile")
+	_, err = tmpfile.WriteString(content)
+	require.NoError(t, err, "Could not write tempfile")
+	require.NoError(t, tmpfile.Close())
+	return tmpfile.Name()
+}
@@ -0,0 +1,258 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package secrets redeploys the ECS services that consume a Secrets Manager secret.
+package secrets
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+const (
+	// pollInterval is the time between two checks of a redeployed service
+	pollInterval = 15 * time.Second
+
+	serviceStatusActive = "ACTIVE"
+)
+
+// make the pause between service checks easily mockable in tests
+var sleep = time.Sleep
+
+// Secrets Manager appends a hyphen and 6 random characters to the name of a secret in its ARN
+var secretSuffix = regexp.MustCompile(`-[a-zA-Z0-9]{6}$`)
+
+// RedeployServices forces a new deployment of every service in the cluster whose
+// task definition references the given secret.
+func RedeployServices(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'secrets redeploy': ", err)
+	}
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'secrets redeploy': ", err)
+	}
+	if err := redeployServices(c, ecsclient.NewECSClient(commandConfig)); err != nil {
+		logrus.Fatal("Error executing 'secrets redeploy': ", err)
+	}
+}
+
+func redeployServices(context *cli.Context, ecsClient ecsclient.ECSClient) error {
+	secretArn := context.String(flags.SecretArnFlag)
+	if secretArn == "" {
+		return fmt.Errorf("A secret must be specified with the --%s flag", flags.SecretArnFlag)
+	}
+	if !arn.IsARN(secretArn) {
+		return fmt.Errorf("--%s must be the full ARN of the secret, got '%s'", flags.SecretArnFlag, secretArn)
+	}
+	concurrency := context.Int(flags.ConcurrencyFlag)
+	if concurrency < 1 {
+		return fmt.Errorf("--%s must be greater than zero", flags.ConcurrencyFlag)
+	}
+	timeout := time.Duration(context.Float64(flags.ComposeServiceTimeOutFlag) * float64(time.Minute))
+
+	services, err := findServices(ecsClient, secretArn)
+	if err != nil {
+		return err
+	}
+	if len(services) == 0 {
+		logrus.Infof("No service in the cluster references secret '%s'", secretArn)
+		return nil
+	}
+	for _, service := range services {
+		logrus.WithFields(logrus.Fields{
+			"service":        aws.StringValue(service.ServiceName),
+			"taskDefinition": aws.StringValue(service.TaskDefinition),
+		}).Info("Service references the secret")
+	}
+	if context.Bool(flags.DryRunFlag) {
+		logrus.Infof("Dry run: %d service(s) would be redeployed", len(services))
+		return nil
+	}
+
+	failed := redeploy(ecsClient, services, concurrency, timeout)
+	if len(failed) > 0 {
+		return fmt.Errorf("Failed to redeploy %d of %d service(s): %s", len(failed), len(services), strings.Join(failed, ", "))
+	}
+	logrus.Infof("Redeployed %d service(s) referencing secret '%s'", len(services), secretArn)
+	return nil
+}
+
+// findServices returns the ACTIVE services of the cluster whose task definition references the secret
+func findServices(ecsClient ecsclient.ECSClient, secretArn string) ([]*ecs.Service, error) {
+	serviceArns, err := ecsClient.ListServices()
+	if err != nil {
+		return nil, err
+	}
+	if len(serviceArns) == 0 {
+		return nil, nil
+	}
+	services, err := ecsClient.DescribeServices(serviceArns)
+	if err != nil {
+		return nil, err
+	}
+
+	// services often share a task definition, so describe each of them once
+	references := make(map[string]bool)
+	var matches []*ecs.Service
+	for _, service := range services {
+		if aws.StringValue(service.Status) != serviceStatusActive {
+			continue
+		}
+		taskDefArn := aws.StringValue(service.TaskDefinition)
+		referenced, ok := references[taskDefArn]
+		if !ok {
+			taskDef, err := ecsClient.DescribeTaskDefinition(taskDefArn)
+			if err != nil {
+				return nil, err
+			}
+			referenced = referencesSecret(taskDef, secretArn)
+			references[taskDefArn] = referenced
+		}
+		if referenced {
+			matches = append(matches, service)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return aws.StringValue(matches[i].ServiceName) < aws.StringValue(matches[j].ServiceName)
+	})
+	return matches, nil
+}
+
+// referencesSecret returns true if a container of the task definition reads the secret,
+// either as an environment variable or as its private registry credentials
+func referencesSecret(taskDef *ecs.TaskDefinition, secretArn string) bool {
+	for _, container := range taskDef.ContainerDefinitions {
+		for _, secret := range container.Secrets {
+			if matchesSecret(aws.StringValue(secret.ValueFrom), secretArn) {
+				return true
+			}
+		}
+		if container.RepositoryCredentials != nil &&
+			matchesSecret(aws.StringValue(container.RepositoryCredentials.CredentialsParameter), secretArn) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesSecret compares the valueFrom of a container secret to the ARN of a secret. The
+// valueFrom may select a JSON key or version of the secret with a ':' separated suffix, and
+// may omit the random characters Secrets Manager appends to the name of the secret.
+func matchesSecret(valueFrom, secretArn string) bool {
+	if valueFrom == "" {
+		return false
+	}
+	candidates := []string{secretArn}
+	if partialArn := secretSuffix.ReplaceAllString(secretArn, ""); partialArn != secretArn {
+		candidates = append(candidates, partialArn)
+	}
+	for _, candidate := range candidates {
+		if valueFrom == candidate || strings.HasPrefix(valueFrom, candidate+":") {
+			return true
+		}
+	}
+	return false
+}
+
+// redeploy forces a new deployment of the services, at most concurrency at a time, and returns
+// the names of the services which failed to redeploy
+func redeploy(ecsClient ecsclient.ECSClient, services []*ecs.Service, concurrency int, timeout time.Duration) []string {
+	var (
+		wg     sync.WaitGroup
+		mutex  sync.Mutex
+		failed []string
+	)
+	slots := make(chan struct{}, concurrency)
+	for _, service := range services {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(service *ecs.Service) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			name := aws.StringValue(service.ServiceName)
+			if err := redeployService(ecsClient, service, timeout); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"service": name,
+					"error":   err,
+				}).Error("Error redeploying service")
+				mutex.Lock()
+				failed = append(failed, name)
+				mutex.Unlock()
+			}
+		}(service)
+	}
+	wg.Wait()
+	sort.Strings(failed)
+	return failed
+}
+
+// redeployService forces a new deployment of the service, so that its new tasks read the
+// current value of the secret, and waits for the deployment to complete
+func redeployService(ecsClient ecsclient.ECSClient, service *ecs.Service, timeout time.Duration) error {
+	name := aws.StringValue(service.ServiceName)
+	err := ecsClient.UpdateService(&ecs.UpdateServiceInput{
+		Cluster:            service.ClusterArn,
+		Service:            service.ServiceArn,
+		ForceNewDeployment: aws.Bool(true),
+	})
+	if err != nil {
+		return err
+	}
+	logrus.WithField("service", name).Info("Forced a new deployment of the service")
+	if timeout <= 0 {
+		return nil
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		sleep(pollInterval)
+		services, err := ecsClient.DescribeServices([]*string{service.ServiceArn})
+		if err != nil {
+			return err
+		}
+		if len(services) == 0 {
+			return fmt.Errorf("service %s no longer exists", name)
+		}
+		if deploymentComplete(services[0]) {
+			logrus.WithField("service", name).Info("Service deployment completed")
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("deployment of service %s did not complete within %s", name, timeout)
+		}
+	}
+}
+
+// deploymentComplete returns true once the new deployment of the service has replaced all the old tasks
+func deploymentComplete(service *ecs.Service) bool {
+	if len(service.Deployments) != 1 {
+		return false
+	}
+	deployment := service.Deployments[0]
+	return aws.Int64Value(deployment.RunningCount) == aws.Int64Value(deployment.DesiredCount)
+}
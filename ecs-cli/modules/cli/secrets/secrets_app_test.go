@@ -0,0 +1,220 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package secrets
+
+import (
+	"errors"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	secretArn  = "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-password-AbCdEf"
+	clusterArn = "arn:aws:ecs:us-west-2:123456789012:cluster/default"
+)
+
+func setupTest(t *testing.T) (*mock_ecs.MockECSClient, *gomock.Controller) {
+	sleep = func(time.Duration) {}
+	ctrl := gomock.NewController(t)
+	return mock_ecs.NewMockECSClient(ctrl), ctrl
+}
+
+func newContext(secretArn string, concurrency int, timeout float64, dryRun bool) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-secrets", 0)
+	flagSet.String(flags.SecretArnFlag, secretArn, "")
+	flagSet.Int(flags.ConcurrencyFlag, concurrency, "")
+	flagSet.Float64(flags.ComposeServiceTimeOutFlag, timeout, "")
+	flagSet.Bool(flags.DryRunFlag, dryRun, "")
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func newService(name, taskDef string) *ecs.Service {
+	return &ecs.Service{
+		ServiceName:    aws.String(name),
+		ServiceArn:     aws.String("arn:aws:ecs:us-west-2:123456789012:service/" + name),
+		ClusterArn:     aws.String(clusterArn),
+		Status:         aws.String(serviceStatusActive),
+		TaskDefinition: aws.String(taskDef),
+	}
+}
+
+func newTaskDef(valueFroms ...string) *ecs.TaskDefinition {
+	var secrets []*ecs.Secret
+	for _, valueFrom := range valueFroms {
+		secrets = append(secrets, &ecs.Secret{Name: aws.String("SECRET"), ValueFrom: aws.String(valueFrom)})
+	}
+	return &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{{Name: aws.String("web"), Secrets: secrets}},
+	}
+}
+
+func forceDeployment(service *ecs.Service) *ecs.UpdateServiceInput {
+	return &ecs.UpdateServiceInput{
+		Cluster:            aws.String(clusterArn),
+		Service:            service.ServiceArn,
+		ForceNewDeployment: aws.Bool(true),
+	}
+}
+
+func TestRedeployServices(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	api := newService("api", "api:3")
+	worker := newService("worker", "api:3")
+	frontend := newService("frontend", "frontend:7")
+	draining := newService("old-api", "api:2")
+	draining.Status = aws.String("DRAINING")
+
+	stable := *api
+	stable.Deployments = []*ecs.Deployment{{RunningCount: aws.Int64(2), DesiredCount: aws.Int64(2)}}
+	inProgress := *api
+	inProgress.Deployments = []*ecs.Deployment{
+		{RunningCount: aws.Int64(1), DesiredCount: aws.Int64(2)},
+		{RunningCount: aws.Int64(1), DesiredCount: aws.Int64(0)},
+	}
+
+	arns := []*string{api.ServiceArn, worker.ServiceArn, frontend.ServiceArn, draining.ServiceArn}
+	mockECS.EXPECT().ListServices().Return(arns, nil)
+	mockECS.EXPECT().DescribeServices(arns).Return([]*ecs.Service{worker, api, frontend, draining}, nil)
+	mockECS.EXPECT().DescribeTaskDefinition("api:3").Return(newTaskDef(secretArn+":password::"), nil)
+	mockECS.EXPECT().DescribeTaskDefinition("frontend:7").Return(newTaskDef("arn:aws:secretsmanager:us-west-2:123456789012:secret:other-GhIjKl"), nil)
+
+	mockECS.EXPECT().UpdateService(forceDeployment(api)).Return(nil)
+	gomock.InOrder(
+		mockECS.EXPECT().DescribeServices([]*string{api.ServiceArn}).Return([]*ecs.Service{&inProgress}, nil),
+		mockECS.EXPECT().DescribeServices([]*string{api.ServiceArn}).Return([]*ecs.Service{&stable}, nil),
+	)
+	mockECS.EXPECT().UpdateService(forceDeployment(worker)).Return(nil)
+	mockECS.EXPECT().DescribeServices([]*string{worker.ServiceArn}).Return([]*ecs.Service{&stable}, nil)
+
+	err := redeployServices(newContext(secretArn, 1, 5, false), mockECS)
+	assert.NoError(t, err, "Unexpected error redeploying services")
+}
+
+func TestRedeployServicesWithoutWaiting(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	api := newService("api", "api:3")
+	mockECS.EXPECT().ListServices().Return([]*string{api.ServiceArn}, nil)
+	mockECS.EXPECT().DescribeServices([]*string{api.ServiceArn}).Return([]*ecs.Service{api}, nil)
+	mockECS.EXPECT().DescribeTaskDefinition("api:3").Return(&ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{{
+			Name: aws.String("web"),
+			RepositoryCredentials: &ecs.RepositoryCredentials{
+				CredentialsParameter: aws.String(secretArn),
+			},
+		}},
+	}, nil)
+	mockECS.EXPECT().UpdateService(forceDeployment(api)).Return(nil)
+
+	err := redeployServices(newContext(secretArn, 2, 0, false), mockECS)
+	assert.NoError(t, err, "Unexpected error redeploying services")
+}
+
+func TestRedeployServicesDryRun(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	api := newService("api", "api:3")
+	mockECS.EXPECT().ListServices().Return([]*string{api.ServiceArn}, nil)
+	mockECS.EXPECT().DescribeServices([]*string{api.ServiceArn}).Return([]*ecs.Service{api}, nil)
+	mockECS.EXPECT().DescribeTaskDefinition("api:3").Return(newTaskDef(secretArn), nil)
+
+	err := redeployServices(newContext(secretArn, 2, 5, true), mockECS)
+	assert.NoError(t, err, "Unexpected error listing services")
+}
+
+func TestRedeployServicesReportsFailures(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	api := newService("api", "api:3")
+	worker := newService("worker", "api:3")
+	arns := []*string{api.ServiceArn, worker.ServiceArn}
+	mockECS.EXPECT().ListServices().Return(arns, nil)
+	mockECS.EXPECT().DescribeServices(arns).Return([]*ecs.Service{api, worker}, nil)
+	mockECS.EXPECT().DescribeTaskDefinition("api:3").Return(newTaskDef(secretArn), nil)
+	mockECS.EXPECT().UpdateService(forceDeployment(api)).Return(errors.New("throttled"))
+	mockECS.EXPECT().UpdateService(forceDeployment(worker)).Return(nil)
+
+	err := redeployServices(newContext(secretArn, 2, 0, false), mockECS)
+	assert.EqualError(t, err, "Failed to redeploy 1 of 2 service(s): api")
+}
+
+func TestRedeployServicesInvalidFlags(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	err := redeployServices(newContext("", 2, 5, false), mockECS)
+	assert.Error(t, err, "Expected error when the secret is missing")
+
+	err = redeployServices(newContext("db-password", 2, 5, false), mockECS)
+	assert.Error(t, err, "Expected error when the secret is not an ARN")
+
+	err = redeployServices(newContext(secretArn, 0, 5, false), mockECS)
+	assert.Error(t, err, "Expected error when --concurrency is not positive")
+}
+
+func TestMatchesSecret(t *testing.T) {
+	testCases := map[string]struct {
+		valueFrom string
+		expected  bool
+	}{
+		"full ARN": {
+			valueFrom: secretArn,
+			expected:  true,
+		},
+		"JSON key of the secret": {
+			valueFrom: secretArn + ":password::",
+			expected:  true,
+		},
+		"partial ARN": {
+			valueFrom: "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-password",
+			expected:  true,
+		},
+		"JSON key of the partial ARN": {
+			valueFrom: "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-password:username:AWSCURRENT:",
+			expected:  true,
+		},
+		"secret with a longer name": {
+			valueFrom: "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-password-replica-MnOpQr",
+			expected:  false,
+		},
+		"SSM parameter": {
+			valueFrom: "arn:aws:ssm:us-west-2:123456789012:parameter/db-password",
+			expected:  false,
+		},
+		"empty": {
+			valueFrom: "",
+			expected:  false,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, matchesSecret(test.valueFrom, secretArn))
+		})
+	}
+}
@@ -182,13 +182,13 @@ func TestCreateServiceDiscoveryForceRecreate(t *testing.T) {
 		// validate that existing SDS stack is deleted
 		mockCloudformation.EXPECT().DeleteStack(testNamespaceStackName).Return(nil),
 		mockCloudformation.EXPECT().WaitUntilDeleteComplete(testNamespaceStackName).Return(nil),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), testNamespaceStackName, false, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), testNamespaceStackName, nil, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
 			stackName := w.(string)
-			capabilityIAM := x.(bool)
+			capabilities := x.([]string)
 			cfnParams := y.(*cloudformation.CfnStackParams)
 			validateCFNParam(testNamespaceName, parameterKeyNamespaceName, cfnParams, t)
 			validateCFNParam(testVPCID, parameterKeyVPCID, cfnParams, t)
-			assert.False(t, capabilityIAM, "Expected capability capabilityIAM to be false")
+			assert.Empty(t, capabilities, "Expected no capabilities to be acknowledged")
 			assert.Equal(t, testNamespaceStackName, stackName, "Expected stack name to match")
 		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(testNamespaceStackName).Return(nil),
@@ -197,14 +197,14 @@ func TestCreateServiceDiscoveryForceRecreate(t *testing.T) {
 		// Validate that existing Namespace stack is deleted
 		mockCloudformation.EXPECT().DeleteStack(testSDSStackName).Return(nil),
 		mockCloudformation.EXPECT().WaitUntilDeleteComplete(testSDSStackName).Return(nil),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), testSDSStackName, false, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), testSDSStackName, nil, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
 			stackName := w.(string)
-			capabilityIAM := x.(bool)
+			capabilities := x.([]string)
 			cfnParams := y.(*cloudformation.CfnStackParams)
 			validateCFNParam(testNamespaceID, parameterKeyNamespaceID, cfnParams, t)
 			validateCFNParam(testServiceName, parameterKeySDSName, cfnParams, t)
 			validateCFNParam(servicediscovery.RecordTypeA, parameterKeyDNSType, cfnParams, t)
-			assert.False(t, capabilityIAM, "Expected capability capabilityIAM to be false")
+			assert.Empty(t, capabilities, "Expected no capabilities to be acknowledged")
 			assert.Equal(t, testSDSStackName, stackName, "Expected stack name to match")
 		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(testSDSStackName).Return(nil),
@@ -798,12 +798,12 @@ func testCreateServiceDiscovery(t *testing.T, networkMode string, ecsParamsSD *u
 	if createNamespace {
 		expectedCFNCalls = append(expectedCFNCalls, []*gomock.Call{
 			mockCloudformation.EXPECT().ValidateStackExists(testNamespaceStackName).Return(fmt.Errorf("Stack Not Found")),
-			mockCloudformation.EXPECT().CreateStack(gomock.Any(), testNamespaceStackName, false, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+			mockCloudformation.EXPECT().CreateStack(gomock.Any(), testNamespaceStackName, nil, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
 				stackName := w.(string)
-				capabilityIAM := x.(bool)
+				capabilities := x.([]string)
 				cfnParams := y.(*cloudformation.CfnStackParams)
 				validateNamespace(t, cfnParams)
-				assert.False(t, capabilityIAM, "Expected capability capabilityIAM to be false")
+				assert.Empty(t, capabilities, "Expected no capabilities to be acknowledged")
 				assert.Equal(t, testNamespaceStackName, stackName, "Expected stack name to match")
 			}).Return("", nil),
 			mockCloudformation.EXPECT().WaitUntilCreateComplete(testNamespaceStackName).Return(nil),
@@ -812,12 +812,12 @@ func testCreateServiceDiscovery(t *testing.T, networkMode string, ecsParamsSD *u
 	}
 	expectedCFNCalls = append(expectedCFNCalls, []*gomock.Call{
 		mockCloudformation.EXPECT().ValidateStackExists(testSDSStackName).Return(fmt.Errorf("Stack Not Found")),
-		mockCloudformation.EXPECT().CreateStack(gomock.Any(), testSDSStackName, false, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
+		mockCloudformation.EXPECT().CreateStack(gomock.Any(), testSDSStackName, nil, gomock.Any(), gomock.Any()).Do(func(v, w, x, y, z interface{}) {
 			stackName := w.(string)
-			capabilityIAM := x.(bool)
+			capabilities := x.([]string)
 			cfnParams := y.(*cloudformation.CfnStackParams)
 			validateSDS(t, cfnParams)
-			assert.False(t, capabilityIAM, "Expected capability capabilityIAM to be false")
+			assert.Empty(t, capabilities, "Expected no capabilities to be acknowledged")
 			assert.Equal(t, testSDSStackName, stackName, "Expected stack name to match")
 		}).Return("", nil),
 		mockCloudformation.EXPECT().WaitUntilCreateComplete(testSDSStackName).Return(nil),
@@ -217,6 +217,15 @@ func getOutputIDFromStack(cfnClient cloudformation.CloudformationClient, stackNa
 	return nil, fmt.Errorf("Failed to find output %s in stack %s", outputKey, stackName)
 }
 
+// ClusterStackNamePrefixes returns the prefixes of the names of the Service Discovery
+// stacks created by the ECS CLI for the services in the cluster
+func ClusterStackNamePrefixes(cluster string) []string {
+	return []string{
+		cfnStackName(privateDNSNamespaceStackNameFormat, cluster, ""),
+		cfnStackName(serviceDiscoveryServiceStackNameFormat, cluster, ""),
+	}
+}
+
 func cfnStackName(stackNameFmt, cluster, service string) string {
 	maxLength := (cfnStackNameMaxLength - len(stackNameFmt)) / 2
 	name := fmt.Sprintf(stackNameFmt, truncate(cluster, maxLength), truncate(service, maxLength))
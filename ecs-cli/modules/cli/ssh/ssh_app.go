@@ -0,0 +1,146 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package ssh connects to the container instances of a cluster with ssh.
+package ssh
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/instanceconnect"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// EC2 Instance Connect and the ssh client can be easily mocked in tests
+var sendSSHPublicKey instanceconnect.SendSSHPublicKeyFunc = instanceconnect.SendSSHPublicKey
+var runSSH = func(args []string) error {
+	cmd := exec.Command("ssh", args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// SSH connects to a container instance of the cluster with ssh.
+func SSH(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'ssh': ", err)
+	}
+
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'ssh': ", err)
+	}
+
+	awsClients := cluster.NewAWSClients(commandConfig)
+	if err := sshContainerInstance(c, awsClients, commandConfig); err != nil {
+		logrus.Fatal("Error executing 'ssh': ", err)
+	}
+}
+
+// sshContainerInstance executes the 'ssh' command. It runs ssh to the public IP address of the EC2
+// instance of the container instance, or its private IP address if it has none, with the remaining
+// arguments as the remote command. With EC2 Instance Connect, a one-time key is generated and pushed
+// to the instance instead of using a key pair.
+func sshContainerInstance(context *cli.Context, awsClients *cluster.AWSClients, commandConfig *config.CommandConfig) error {
+	args := context.Args()
+	if len(args) == 0 {
+		return fmt.Errorf("Please specify the ID or full ARN of the container instance to connect to")
+	}
+	useInstanceConnect := context.Bool(flags.UseInstanceConnectFlag)
+	identityFile := context.String(flags.IdentityFileFlag)
+	if useInstanceConnect && identityFile != "" {
+		return fmt.Errorf("You can only specify '--%s' or '--%s'", flags.IdentityFileFlag, flags.UseInstanceConnectFlag)
+	}
+	if useInstanceConnect {
+		if err := readonly.Check(context, "ssh --"+flags.UseInstanceConnectFlag, "ec2-instance-connect:SendSSHPublicKey"); err != nil {
+			return err
+		}
+	}
+	if commandConfig.Cluster == "" {
+		return cluster.ClusterNotSetError()
+	}
+
+	containerInstances, err := awsClients.ECSClient.DescribeContainerInstances(aws.StringSlice(args[:1]))
+	if err != nil {
+		return err
+	}
+	if len(containerInstances) == 0 {
+		return fmt.Errorf("Container instance %s not found in cluster '%s'", args[0], commandConfig.Cluster)
+	}
+	instanceID := aws.StringValue(containerInstances[0].Ec2InstanceId)
+	instances, err := awsClients.EC2Client.DescribeInstances(aws.StringSlice([]string{instanceID}))
+	if err != nil {
+		return err
+	}
+	instance, ok := instances[instanceID]
+	if !ok {
+		return fmt.Errorf("EC2 instance %s of container instance %s not found", instanceID, args[0])
+	}
+	address := aws.StringValue(instance.PublicIpAddress)
+	if address == "" {
+		address = aws.StringValue(instance.PrivateIpAddress)
+	}
+	if address == "" {
+		return fmt.Errorf("EC2 instance %s has no IP address", instanceID)
+	}
+
+	user := context.String(flags.SSHUserFlag)
+	var sshArgs []string
+	if useInstanceConnect {
+		privateKey, publicKey, err := instanceconnect.GenerateSSHKey()
+		if err != nil {
+			return errors.Wrap(err, "Error generating SSH key")
+		}
+		dir, err := ioutil.TempDir("", "ecs-cli-ssh")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+		identityFile = filepath.Join(dir, "id_rsa")
+		if err := ioutil.WriteFile(identityFile, privateKey, 0600); err != nil {
+			return err
+		}
+		var availabilityZone string
+		if instance.Placement != nil {
+			availabilityZone = aws.StringValue(instance.Placement.AvailabilityZone)
+		}
+		if err := sendSSHPublicKey(instanceID, availabilityZone, user, publicKey, commandConfig); err != nil {
+			return errors.Wrapf(err, "Error sending SSH public key to instance %s with EC2 Instance Connect", instanceID)
+		}
+		// Only the one-time key is offered, since it is the one the instance accepts
+		sshArgs = append(sshArgs, "-o", "IdentitiesOnly=yes")
+	} else if instance.KeyName == nil && identityFile == "" {
+		logrus.Warnf("Instance %s was launched without a key pair. Specify '--%s' to connect with EC2 Instance Connect", instanceID, flags.UseInstanceConnectFlag)
+	}
+	if identityFile != "" {
+		sshArgs = append(sshArgs, "-i", identityFile)
+	}
+	sshArgs = append(sshArgs, user+"@"+address)
+	sshArgs = append(sshArgs, args[1:]...)
+
+	logrus.Infof("Connecting to instance %s at %s", instanceID, address)
+	return runSSH(sshArgs)
+}
@@ -0,0 +1,216 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ssh
+
+import (
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	mock_amimetadata "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/amimetadata/mock"
+	mock_cloudformation "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock"
+	mock_ec2 "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
+	mock_ecs "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+const clusterName = "defaultCluster"
+
+type mockReadWriter struct {
+	clusterName string
+}
+
+func (rdwr *mockReadWriter) Get(cluster string, profile string) (*config.LocalConfig, error) {
+	cliConfig := config.NewLocalConfig(rdwr.clusterName)
+	cliConfig.CFNStackName = rdwr.clusterName
+	return cliConfig, nil
+}
+
+func (rdwr *mockReadWriter) SaveProfile(configName string, profile *config.Profile) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SaveCluster(configName string, cluster *config.Cluster) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultProfile(configName string) error {
+	return nil
+}
+
+func (rdwr *mockReadWriter) SetDefaultCluster(configName string) error {
+	return nil
+}
+
+func newMockReadWriter() *mockReadWriter {
+	return &mockReadWriter{
+		clusterName: clusterName,
+	}
+}
+
+func setupTest(t *testing.T) (*mock_ecs.MockECSClient, *mock_cloudformation.MockCloudformationClient, *mock_amimetadata.MockClient, *mock_ec2.MockEC2Client) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockCloudformation := mock_cloudformation.NewMockCloudformationClient(ctrl)
+	mockSSM := mock_amimetadata.NewMockClient(ctrl)
+	mockEC2 := mock_ec2.NewMockEC2Client(ctrl)
+
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "secret")
+	os.Setenv("AWS_REGION", "us-west-1")
+
+	return mockECS, mockCloudformation, mockSSM, mockEC2
+}
+
+func TestClusterSSHWithInstanceConnect(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	instanceID := "i-0123456789abcdef0"
+	mockECS.EXPECT().DescribeContainerInstances(aws.StringSlice([]string{"c0ffee"})).Return([]*ecs.ContainerInstance{
+		{Ec2InstanceId: aws.String(instanceID)},
+	}, nil)
+	mockEC2.EXPECT().DescribeInstances(aws.StringSlice([]string{instanceID})).Return(map[string]*ec2.Instance{
+		instanceID: {
+			InstanceId:       aws.String(instanceID),
+			PublicIpAddress:  aws.String("203.0.113.10"),
+			PrivateIpAddress: aws.String("10.0.0.10"),
+			Placement:        &ec2.Placement{AvailabilityZone: aws.String("us-west-1a")},
+		},
+	}, nil)
+
+	var sentKey string
+	oldSendSSHPublicKey, oldRunSSH := sendSSHPublicKey, runSSH
+	defer func() { sendSSHPublicKey, runSSH = oldSendSSHPublicKey, oldRunSSH }()
+	sendSSHPublicKey = func(id, availabilityZone, osUser, publicKey string, config *config.CommandConfig) error {
+		assert.Equal(t, instanceID, id)
+		assert.Equal(t, "us-west-1a", availabilityZone)
+		assert.Equal(t, "ec2-user", osUser)
+		sentKey = publicKey
+		return nil
+	}
+	var sshArgs []string
+	var identityFile string
+	runSSH = func(args []string) error {
+		sshArgs = args
+		identityFile = args[3]
+		_, err := os.Stat(identityFile)
+		assert.NoError(t, err, "Expected the one-time private key to exist while ssh runs")
+		return nil
+	}
+
+	flagSet := flag.NewFlagSet("ecs-cli-ssh", 0)
+	flagSet.String(flags.SSHUserFlag, "ec2-user", "")
+	flagSet.Bool(flags.UseInstanceConnectFlag, true, "")
+	flagSet.Parse([]string{"c0ffee", "docker", "ps"})
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = sshContainerInstance(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error connecting to container instance")
+	assert.True(t, strings.HasPrefix(sentKey, "ssh-rsa "), "Expected an SSH public key to be sent")
+	assert.Equal(t, []string{"-o", "IdentitiesOnly=yes", "-i", identityFile, "ec2-user@203.0.113.10", "docker", "ps"}, sshArgs)
+	_, err = os.Stat(identityFile)
+	assert.True(t, os.IsNotExist(err), "Expected the one-time private key to be deleted")
+}
+
+func TestClusterSSHWithIdentityFile(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	instanceID := "i-0123456789abcdef0"
+	mockECS.EXPECT().DescribeContainerInstances(aws.StringSlice([]string{"c0ffee"})).Return([]*ecs.ContainerInstance{
+		{Ec2InstanceId: aws.String(instanceID)},
+	}, nil)
+	mockEC2.EXPECT().DescribeInstances(aws.StringSlice([]string{instanceID})).Return(map[string]*ec2.Instance{
+		instanceID: {
+			InstanceId:       aws.String(instanceID),
+			PrivateIpAddress: aws.String("10.0.0.10"),
+			KeyName:          aws.String("default"),
+		},
+	}, nil)
+
+	oldRunSSH := runSSH
+	defer func() { runSSH = oldRunSSH }()
+	var sshArgs []string
+	runSSH = func(args []string) error {
+		sshArgs = args
+		return nil
+	}
+
+	flagSet := flag.NewFlagSet("ecs-cli-ssh", 0)
+	flagSet.String(flags.SSHUserFlag, "ec2-user", "")
+	flagSet.String(flags.IdentityFileFlag, "default.pem", "")
+	flagSet.Parse([]string{"c0ffee"})
+
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = sshContainerInstance(context, awsClients, commandConfig)
+	assert.NoError(t, err, "Unexpected error connecting to container instance")
+	assert.Equal(t, []string{"-i", "default.pem", "ec2-user@10.0.0.10"}, sshArgs, "Expected the private IP address without a public one")
+}
+
+func TestClusterSSHWithoutContainerInstance(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	flagSet := flag.NewFlagSet("ecs-cli-ssh", 0)
+	context := cli.NewContext(nil, flagSet, nil)
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = sshContainerInstance(context, awsClients, commandConfig)
+	assert.Error(t, err, "Expected error without a container instance")
+}
+
+func TestClusterSSHWithInstanceConnectReadOnly(t *testing.T) {
+	defer os.Clearenv()
+	mockECS, mockCloudformation, mockSSM, mockEC2 := setupTest(t)
+	awsClients := &cluster.AWSClients{ECSClient: mockECS, CFNClient: mockCloudformation, AMIMetadataClient: mockSSM, EC2Client: mockEC2}
+
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalSet.Bool(flags.ReadOnlyFlag, true, "")
+	flagSet := flag.NewFlagSet("ecs-cli-ssh", 0)
+	flagSet.Bool(flags.UseInstanceConnectFlag, true, "")
+	flagSet.Parse([]string{"c0ffee"})
+	context := cli.NewContext(nil, flagSet, cli.NewContext(nil, globalSet, nil))
+	rdwr := newMockReadWriter()
+	commandConfig, err := config.NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error creating CommandConfig")
+
+	err = sshContainerInstance(context, awsClients, commandConfig)
+	require.Error(t, err, "Expected EC2 Instance Connect to be refused in read-only mode")
+	assert.Contains(t, err.Error(), "ec2-instance-connect:SendSSHPublicKey")
+}
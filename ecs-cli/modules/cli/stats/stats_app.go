@@ -0,0 +1,168 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package stats displays the resource utilization of the tasks in a cluster.
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	cwlogsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+const (
+	// Container Insights publishes the performance of each task once a minute
+	refreshInterval = time.Minute
+	// statsWindow is how far back to look for the latest performance event of each task
+	statsWindow = 5 * time.Minute
+
+	performanceLogGroupFormat = "/aws/ecs/containerinsights/%s/performance"
+	taskEventsFilterPattern   = `{ $.Type = "Task" }`
+
+	clearScreen = "\x1b[H\x1b[2J"
+)
+
+// make the clock and the pause between refreshes easily mockable in tests
+var now = time.Now
+var sleep = time.Sleep
+
+// taskStats is the task performance event published by Container Insights. CPU is in CPU units
+// and memory in MiB.
+type taskStats struct {
+	TaskID                 string  `json:"TaskId"`
+	TaskDefinitionFamily   string  `json:"TaskDefinitionFamily"`
+	TaskDefinitionRevision string  `json:"TaskDefinitionRevision"`
+	LaunchType             string  `json:"LaunchType"`
+	CPUUtilized            float64 `json:"CpuUtilized"`
+	CPUReserved            float64 `json:"CpuReserved"`
+	MemoryUtilized         float64 `json:"MemoryUtilized"`
+	MemoryReserved         float64 `json:"MemoryReserved"`
+	Timestamp              int64   `json:"Timestamp"`
+}
+
+// CPUPercent returns the CPU utilization of the task as a percentage of its reservation
+func (s *taskStats) CPUPercent() float64 {
+	return percent(s.CPUUtilized, s.CPUReserved)
+}
+
+// MemoryPercent returns the memory utilization of the task as a percentage of its reservation
+func (s *taskStats) MemoryPercent() float64 {
+	return percent(s.MemoryUtilized, s.MemoryReserved)
+}
+
+func percent(used, reserved float64) float64 {
+	if reserved == 0 {
+		return 0
+	}
+	return used / reserved * 100
+}
+
+// Stats prints the CPU and memory utilization of the running tasks of the cluster, and refreshes
+// it until interrupted unless --no-stream is set.
+func Stats(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'stats': ", err)
+	}
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'stats': ", err)
+	}
+	cwLogsClient := cwlogsclient.NewCloudWatchLogsClient(commandConfig, aws.StringValue(commandConfig.Session.Config.Region))
+	if err := stats(c, cwLogsClient, commandConfig, os.Stdout); err != nil {
+		logrus.Fatal("Error executing 'stats': ", err)
+	}
+}
+
+func stats(context *cli.Context, cwLogsClient cwlogsclient.Client, commandConfig *config.CommandConfig, out io.Writer) error {
+	stream := !context.Bool(flags.NoStreamFlag)
+	for {
+		tasks, err := latestTaskStats(cwLogsClient, commandConfig.Cluster)
+		if err != nil {
+			return err
+		}
+		if stream {
+			fmt.Fprint(out, clearScreen)
+		}
+		printStats(out, tasks)
+		if !stream {
+			return nil
+		}
+		sleep(refreshInterval)
+	}
+}
+
+// latestTaskStats returns the latest performance event of each task that reported one recently,
+// sorted by CPU utilization so that the busiest tasks come first
+func latestTaskStats(cwLogsClient cwlogsclient.Client, cluster string) ([]*taskStats, error) {
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName:  aws.String(fmt.Sprintf(performanceLogGroupFormat, cluster)),
+		FilterPattern: aws.String(taskEventsFilterPattern),
+		StartTime:     aws.Int64(now().Add(-statsWindow).UnixNano() / int64(time.Millisecond)),
+	}
+
+	latest := make(map[string]*taskStats)
+	err := cwLogsClient.FilterAllLogEvents(input, func(events []*cloudwatchlogs.FilteredLogEvent) {
+		for _, event := range events {
+			task := &taskStats{}
+			if err := json.Unmarshal([]byte(aws.StringValue(event.Message)), task); err != nil || task.TaskID == "" {
+				logrus.Debugf("Skipping unexpected performance event: %s", aws.StringValue(event.Message))
+				continue
+			}
+			if previous, ok := latest[task.TaskID]; !ok || task.Timestamp > previous.Timestamp {
+				latest[task.TaskID] = task
+			}
+		}
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException {
+			return nil, fmt.Errorf("No Container Insights metrics found for cluster %s; enable Container Insights with 'aws ecs update-cluster-settings --cluster %s --settings name=containerInsights,value=enabled'", cluster, cluster)
+		}
+		return nil, err
+	}
+
+	var tasks []*taskStats
+	for _, task := range latest {
+		tasks = append(tasks, task)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		if tasks[i].CPUPercent() != tasks[j].CPUPercent() {
+			return tasks[i].CPUPercent() > tasks[j].CPUPercent()
+		}
+		return tasks[i].TaskID < tasks[j].TaskID
+	})
+	return tasks, nil
+}
+
+func printStats(out io.Writer, tasks []*taskStats) {
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "TASK\tTASK DEFINITION\tLAUNCH TYPE\tCPU %\tCPU USAGE / RESERVED\tMEM %\tMEM USAGE / RESERVED")
+	for _, task := range tasks {
+		fmt.Fprintf(w, "%s\t%s:%s\t%s\t%.1f%%\t%.0f / %.0f\t%.1f%%\t%.0fMiB / %.0fMiB\n", task.TaskID, task.TaskDefinitionFamily, task.TaskDefinitionRevision,
+			task.LaunchType, task.CPUPercent(), task.CPUUtilized, task.CPUReserved, task.MemoryPercent(), task.MemoryUtilized, task.MemoryReserved)
+	}
+	w.Flush()
+}
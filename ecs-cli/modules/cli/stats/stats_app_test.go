@@ -0,0 +1,117 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package stats
+
+import (
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const clusterName = "default"
+
+func performanceEvent(message string) *cloudwatchlogs.FilteredLogEvent {
+	return &cloudwatchlogs.FilteredLogEvent{Message: aws.String(message)}
+}
+
+func noStreamContext() *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.NoStreamFlag, true, "")
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogs := mock_cloudwatchlogs.NewMockClient(ctrl)
+
+	currentTime := time.Unix(1500000000, 0)
+	now = func() time.Time { return currentTime }
+	defer func() { now = time.Now }()
+
+	events := []*cloudwatchlogs.FilteredLogEvent{
+		performanceEvent(`{"Type":"Task","TaskId":"idle","TaskDefinitionFamily":"web","TaskDefinitionRevision":"3","LaunchType":"FARGATE","CpuUtilized":25.6,"CpuReserved":256,"MemoryUtilized":128,"MemoryReserved":512,"Timestamp":1499999940000}`),
+		performanceEvent(`{"Type":"Task","TaskId":"busy","TaskDefinitionFamily":"worker","TaskDefinitionRevision":"1","LaunchType":"EC2","CpuUtilized":200,"CpuReserved":1024,"MemoryUtilized":100,"MemoryReserved":1024,"Timestamp":1499999880000}`),
+		performanceEvent(`{"Type":"Task","TaskId":"busy","TaskDefinitionFamily":"worker","TaskDefinitionRevision":"1","LaunchType":"EC2","CpuUtilized":921.6,"CpuReserved":1024,"MemoryUtilized":512,"MemoryReserved":1024,"Timestamp":1499999940000}`),
+		performanceEvent(`not json`),
+	}
+	mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Do(func(input *cloudwatchlogs.FilterLogEventsInput, action func([]*cloudwatchlogs.FilteredLogEvent)) {
+		assert.Equal(t, "/aws/ecs/containerinsights/default/performance", aws.StringValue(input.LogGroupName), "Expected log group to match")
+		assert.Equal(t, taskEventsFilterPattern, aws.StringValue(input.FilterPattern), "Expected filter pattern to match")
+		assert.Equal(t, int64(1499999700000), aws.Int64Value(input.StartTime), "Expected start time to be 5 minutes ago")
+		action(events)
+	}).Return(nil)
+
+	out := &bytes.Buffer{}
+	err := stats(noStreamContext(), mockLogs, &config.CommandConfig{Cluster: clusterName}, out)
+	assert.NoError(t, err, "Unexpected error getting stats")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 3, "Expected a header and one line per task")
+	assert.Contains(t, lines[0], "CPU %", "Expected header")
+	assert.Contains(t, lines[1], "busy", "Expected the busiest task first")
+	assert.Contains(t, lines[1], "worker:1", "Expected task definition")
+	assert.Contains(t, lines[1], "90.0%", "Expected CPU utilization of the latest event")
+	assert.Contains(t, lines[1], "512MiB / 1024MiB", "Expected memory usage of the latest event")
+	assert.Contains(t, lines[2], "idle", "Expected the idle task last")
+	assert.Contains(t, lines[2], "10.0%", "Expected CPU utilization")
+	assert.Contains(t, lines[2], "25.0%", "Expected memory utilization")
+	assert.NotContains(t, out.String(), clearScreen, "Expected screen not to be cleared with --no-stream")
+}
+
+func TestStatsStreamRefreshes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogs := mock_cloudwatchlogs.NewMockClient(ctrl)
+
+	gomock.InOrder(
+		mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Return(nil),
+		mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Return(awserr.New("ThrottlingException", "slow down", nil)),
+	)
+
+	var slept []time.Duration
+	sleep = func(d time.Duration) { slept = append(slept, d) }
+	defer func() { sleep = time.Sleep }()
+
+	out := &bytes.Buffer{}
+	context := cli.NewContext(nil, flag.NewFlagSet("ecs-cli", 0), nil)
+	err := stats(context, mockLogs, &config.CommandConfig{Cluster: clusterName}, out)
+	assert.Error(t, err, "Expected error from the second refresh")
+	assert.Equal(t, []time.Duration{refreshInterval}, slept, "Expected one pause between refreshes")
+	assert.True(t, strings.HasPrefix(out.String(), clearScreen), "Expected screen to be cleared before each refresh")
+}
+
+func TestStatsContainerInsightsNotEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogs := mock_cloudwatchlogs.NewMockClient(ctrl)
+
+	mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Return(awserr.New(cloudwatchlogs.ErrCodeResourceNotFoundException, "The specified log group does not exist.", nil))
+
+	err := stats(noStreamContext(), mockLogs, &config.CommandConfig{Cluster: clusterName}, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error when Container Insights is not enabled")
+	assert.Contains(t, err.Error(), "containerInsights", "Expected error to explain how to enable Container Insights")
+}
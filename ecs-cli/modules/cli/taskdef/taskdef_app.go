@@ -0,0 +1,106 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package taskdef manages the revisions of ECS task definitions.
+package taskdef
+
+import (
+	"fmt"
+	"time"
+
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// deregisterInterval paces the DeregisterTaskDefinition calls, so that pruning
+// thousands of revisions doesn't exhaust the API rate limit of the account
+const deregisterInterval = 200 * time.Millisecond
+
+// make the pause between DeregisterTaskDefinition calls easily mockable in tests
+var sleep = time.Sleep
+
+// PruneTaskDefinitions deregisters all but the newest ACTIVE revisions of a task definition family,
+// and optionally deletes its INACTIVE revisions.
+func PruneTaskDefinitions(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		logrus.Fatal("Error executing 'taskdef prune': ", err)
+	}
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		logrus.Fatal("Error executing 'taskdef prune': ", err)
+	}
+	if err := pruneTaskDefinitions(c, ecsclient.NewECSClient(commandConfig)); err != nil {
+		logrus.Fatal("Error executing 'taskdef prune': ", err)
+	}
+}
+
+func pruneTaskDefinitions(context *cli.Context, ecsClient ecsclient.ECSClient) error {
+	family := context.String(flags.FamilyFlag)
+	if family == "" {
+		return fmt.Errorf("A task definition family must be specified with the --%s flag", flags.FamilyFlag)
+	}
+	keep := context.Int(flags.KeepFlag)
+	if keep < 0 {
+		return fmt.Errorf("--%s must be zero or greater", flags.KeepFlag)
+	}
+	inactiveOnly := context.Bool(flags.InactiveOnlyFlag)
+	deleteRevisions := context.Bool(flags.DeleteFlag)
+	if inactiveOnly && !deleteRevisions {
+		return fmt.Errorf("--%s requires --%s, since INACTIVE revisions are already deregistered", flags.InactiveOnlyFlag, flags.DeleteFlag)
+	}
+
+	var activeRevisions, inactiveRevisions []string
+	var err error
+	if !inactiveOnly {
+		if activeRevisions, err = ecsClient.ListTaskDefinitionRevisions(family, ecs.TaskDefinitionStatusActive); err != nil {
+			return err
+		}
+	}
+	if deleteRevisions {
+		if inactiveRevisions, err = ecsClient.ListTaskDefinitionRevisions(family, ecs.TaskDefinitionStatusInactive); err != nil {
+			return err
+		}
+	}
+
+	if len(activeRevisions) > keep {
+		// revisions are listed newest first
+		staleRevisions := activeRevisions[keep:]
+		for i, arn := range staleRevisions {
+			if i > 0 {
+				sleep(deregisterInterval)
+			}
+			if err := ecsClient.DeregisterTaskDefinition(arn); err != nil {
+				return err
+			}
+		}
+		logrus.Infof("Deregistered %d revision(s) of task definition family '%s'", len(staleRevisions), family)
+		inactiveRevisions = append(inactiveRevisions, staleRevisions...)
+	}
+
+	if len(inactiveRevisions) == 0 {
+		logrus.Infof("Task definition family '%s' has nothing to prune", family)
+		return nil
+	}
+	if deleteRevisions {
+		if err := ecsClient.DeleteTaskDefinitions(inactiveRevisions); err != nil {
+			return err
+		}
+		logrus.Infof("Deleted %d INACTIVE revision(s) of task definition family '%s'", len(inactiveRevisions), family)
+	}
+	return nil
+}
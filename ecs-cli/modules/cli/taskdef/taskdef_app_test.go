@@ -0,0 +1,126 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package taskdef
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const family = "web"
+
+func setupTest(t *testing.T) (*mock_ecs.MockECSClient, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	return mock_ecs.NewMockECSClient(ctrl), ctrl
+}
+
+func newContext(family string, keep int, deleteRevisions, inactiveOnly bool) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-taskdef", 0)
+	flagSet.String(flags.FamilyFlag, family, "")
+	flagSet.Int(flags.KeepFlag, keep, "")
+	flagSet.Bool(flags.DeleteFlag, deleteRevisions, "")
+	flagSet.Bool(flags.InactiveOnlyFlag, inactiveOnly, "")
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+// revisions returns the ARNs of the given revisions of the family
+func revisions(numbers ...int) []string {
+	var arns []string
+	for _, number := range numbers {
+		arns = append(arns, fmt.Sprintf("arn:aws:ecs:us-west-2:123456789012:task-definition/%s:%d", family, number))
+	}
+	return arns
+}
+
+func TestPruneTaskDefinitions(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	var sleeps []time.Duration
+	sleep = func(d time.Duration) {
+		sleeps = append(sleeps, d)
+	}
+	defer func() { sleep = time.Sleep }()
+
+	gomock.InOrder(
+		mockECS.EXPECT().ListTaskDefinitionRevisions(family, ecs.TaskDefinitionStatusActive).Return(revisions(5, 4, 3, 2), nil),
+		mockECS.EXPECT().DeregisterTaskDefinition(revisions(3)[0]).Return(nil),
+		mockECS.EXPECT().DeregisterTaskDefinition(revisions(2)[0]).Return(nil),
+	)
+
+	err := pruneTaskDefinitions(newContext(family, 2, false, false), mockECS)
+	assert.NoError(t, err, "Unexpected error pruning task definitions")
+	assert.Equal(t, []time.Duration{deregisterInterval}, sleeps, "Expected a pause between DeregisterTaskDefinition calls")
+}
+
+func TestPruneTaskDefinitionsWithDelete(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockECS.EXPECT().ListTaskDefinitionRevisions(family, ecs.TaskDefinitionStatusActive).Return(revisions(5, 4, 3), nil),
+		mockECS.EXPECT().ListTaskDefinitionRevisions(family, ecs.TaskDefinitionStatusInactive).Return(revisions(2, 1), nil),
+		mockECS.EXPECT().DeregisterTaskDefinition(revisions(3)[0]).Return(nil),
+		mockECS.EXPECT().DeleteTaskDefinitions(revisions(2, 1, 3)).Return(nil),
+	)
+
+	err := pruneTaskDefinitions(newContext(family, 2, true, false), mockECS)
+	assert.NoError(t, err, "Unexpected error pruning task definitions")
+}
+
+func TestPruneTaskDefinitionsInactiveOnly(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockECS.EXPECT().ListTaskDefinitionRevisions(family, ecs.TaskDefinitionStatusInactive).Return(revisions(2, 1), nil),
+		mockECS.EXPECT().DeleteTaskDefinitions(revisions(2, 1)).Return(nil),
+	)
+
+	err := pruneTaskDefinitions(newContext(family, 20, true, true), mockECS)
+	assert.NoError(t, err, "Unexpected error pruning task definitions")
+}
+
+func TestPruneTaskDefinitionsNothingToPrune(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	mockECS.EXPECT().ListTaskDefinitionRevisions(family, ecs.TaskDefinitionStatusActive).Return(revisions(2, 1), nil)
+
+	err := pruneTaskDefinitions(newContext(family, 20, false, false), mockECS)
+	assert.NoError(t, err, "Unexpected error pruning task definitions")
+}
+
+func TestPruneTaskDefinitionsInvalidFlags(t *testing.T) {
+	mockECS, ctrl := setupTest(t)
+	defer ctrl.Finish()
+
+	err := pruneTaskDefinitions(newContext("", 20, false, false), mockECS)
+	assert.Error(t, err, "Expected error when the family is missing")
+
+	err = pruneTaskDefinitions(newContext(family, -1, false, false), mockECS)
+	assert.Error(t, err, "Expected error when --keep is negative")
+
+	err = pruneTaskDefinitions(newContext(family, 20, false, true), mockECS)
+	assert.Error(t, err, "Expected error when --inactive-only is used without --delete")
+}
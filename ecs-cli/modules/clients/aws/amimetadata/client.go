@@ -34,6 +34,15 @@ const (
 	amazonLinux2X86RecommendedParameterName    = "/aws/service/ecs/optimized-ami/amazon-linux-2/recommended"
 	amazonLinux2ARM64RecommendedParameterName  = "/aws/service/ecs/optimized-ami/amazon-linux-2/arm64/recommended"
 	amazonLinux2X86GPURecommendedParameterName = "/aws/service/ecs/optimized-ami/amazon-linux-2/gpu/recommended"
+	amazonLinux2NeuronRecommendedParameterName = "/aws/service/ecs/optimized-ami/amazon-linux-2/inf/recommended"
+)
+
+// Flavors of the ECS optimized AMI, see Flavor.
+const (
+	FlavorX86    = "x86_64"
+	FlavorARM64  = "arm64"
+	FlavorGPU    = "gpu"
+	FlavorNeuron = "neuron"
 )
 
 // AMIMetadata is returned through ssm:GetParameters and can be used to retrieve the ImageId
@@ -79,9 +88,27 @@ func (c *metadataClient) GetRecommendedECSLinuxAMI(instanceType string) (*AMIMet
 		logrus.Infof("Using GPU ecs-optimized AMI because instance type was %s", instanceType)
 		return c.parameterValueFor(amazonLinux2X86GPURecommendedParameterName)
 	}
+	if IsNeuronInstance(instanceType) {
+		logrus.Infof("Using Neuron ecs-optimized AMI because instance type was %s", instanceType)
+		return c.parameterValueFor(amazonLinux2NeuronRecommendedParameterName)
+	}
 	return c.parameterValueFor(amazonLinux2X86RecommendedParameterName)
 }
 
+// Flavor returns the flavor of the ECS optimized AMI recommended for the instance type.
+func Flavor(instanceType string) string {
+	if isARM64Instance(instanceType) {
+		return FlavorARM64
+	}
+	if isGPUInstance(instanceType) {
+		return FlavorGPU
+	}
+	if IsNeuronInstance(instanceType) {
+		return FlavorNeuron
+	}
+	return FlavorX86
+}
+
 func (c *metadataClient) parameterValueFor(ssmParamName string) (*AMIMetadata, error) {
 	response, err := c.client.GetParameter(&ssm.GetParameterInput{
 		Name: aws.String(ssmParamName),
@@ -134,3 +161,21 @@ func isGPUInstance(instanceType string) bool {
 	}
 	return false
 }
+
+// IsNeuronInstance returns true for the Inferentia and Trainium instance types, whose accelerators
+// are used through the AWS Neuron SDK.
+// See: https://docs.aws.amazon.com/AmazonECS/latest/developerguide/ecs-inference.html
+func IsNeuronInstance(instanceType string) bool {
+	var neuronInstanceClasses = []string{
+		"inf1.",
+		"inf2.",
+		"trn1.",
+		"trn1n.",
+	}
+	for _, instanceClass := range neuronInstanceClasses {
+		if strings.HasPrefix(instanceType, instanceClass) {
+			return true
+		}
+	}
+	return false
+}
@@ -41,6 +41,17 @@ func TestMetadataClient_GetRecommendedECSLinuxAMI(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			// validate that we use the Neuron optimized AMI for Inferentia and Trainium instances
+			[]string{"inf1.xlarge", "inf2.8xlarge", "trn1.32xlarge", "trn1n.32xlarge"},
+			func(ssmClient *mock_ssmiface.MockSSMAPI) *mock_ssmiface.MockSSMAPI {
+				ssmClient.EXPECT().GetParameter(gomock.Any()).Do(func(input *ssm.GetParameterInput) {
+					assert.Equal(t, amazonLinux2NeuronRecommendedParameterName, *input.Name)
+				}).Return(emptySSMParameterOutput(), nil)
+				return ssmClient
+			},
+			nil,
+		},
 		{
 			// validate that we use the generic AMI for other instances
 			[]string{"t2.micro", "m5ad.large", "c4.large", "i3.2xlarge"},
@@ -99,6 +110,13 @@ func TestMetadataClient_GetRecommendedECSLinuxAMI(t *testing.T) {
 	}
 }
 
+func TestFlavor(t *testing.T) {
+	assert.Equal(t, FlavorARM64, Flavor("m6g.medium"))
+	assert.Equal(t, FlavorGPU, Flavor("g4dn.xlarge"))
+	assert.Equal(t, FlavorNeuron, Flavor("inf2.xlarge"))
+	assert.Equal(t, FlavorX86, Flavor("t2.micro"))
+}
+
 func newMockSSMAPI(t *testing.T) *mock_ssmiface.MockSSMAPI {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,95 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package applicationautoscaling contains functions for looking up the
+// Application Auto Scaling configuration of ECS Services
+package applicationautoscaling
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
+)
+
+const (
+	ecsServiceNamespace        = "ecs"
+	ecsServiceDesiredCount     = "ecs:service:DesiredCount"
+	ecsServiceResourceIDFormat = "service/%s/%s"
+)
+
+// factory function to create clients
+func newApplicationAutoScalingClient(config *config.CommandConfig) applicationautoscalingiface.ApplicationAutoScalingAPI {
+	client := applicationautoscaling.New(config.Session)
+	client.Handlers.Build.PushBackNamed(clients.CustomUserAgentHandler())
+	return client
+}
+
+// GetServiceScalableTargetFunc is the interface/signature for GetServiceScalableTarget
+// This helps when writing code in other packages that need to mock this function
+type GetServiceScalableTargetFunc func(serviceName string, config *config.CommandConfig) (*applicationautoscaling.ScalableTarget, error)
+
+// GetServiceScalableTarget returns the scalable target registered for the desired count of the
+// ECS Service in the configured cluster, or nil if the service isn't managed by Application Auto Scaling
+func GetServiceScalableTarget(serviceName string, config *config.CommandConfig) (*applicationautoscaling.ScalableTarget, error) {
+	return getServiceScalableTarget(config.Cluster, serviceName, newApplicationAutoScalingClient(config))
+}
+
+func getServiceScalableTarget(cluster, serviceName string, client applicationautoscalingiface.ApplicationAutoScalingAPI) (*applicationautoscaling.ScalableTarget, error) {
+	output, err := client.DescribeScalableTargets(&applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+		ScalableDimension: aws.String(ecsServiceDesiredCount),
+		ResourceIds:       aws.StringSlice([]string{fmt.Sprintf(ecsServiceResourceIDFormat, cluster, serviceName)}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.ScalableTargets) == 0 {
+		return nil, nil
+	}
+	return output.ScalableTargets[0], nil
+}
+
+// SetServiceScaleInSuspendedFunc is the interface/signature for SetServiceScaleInSuspended
+// This helps when writing code in other packages that need to mock this function
+type SetServiceScaleInSuspendedFunc func(serviceName string, target *applicationautoscaling.ScalableTarget, suspended bool, config *config.CommandConfig) error
+
+// SetServiceScaleInSuspended suspends or resumes the dynamic scale-in of the scalable target of the
+// ECS Service, leaving its capacities and its other scaling activities unchanged
+func SetServiceScaleInSuspended(serviceName string, target *applicationautoscaling.ScalableTarget, suspended bool, config *config.CommandConfig) error {
+	return setServiceScaleInSuspended(config.Cluster, serviceName, target, suspended, newApplicationAutoScalingClient(config))
+}
+
+func setServiceScaleInSuspended(cluster, serviceName string, target *applicationautoscaling.ScalableTarget, suspended bool, client applicationautoscalingiface.ApplicationAutoScalingAPI) error {
+	// the state is given in full, so that the other activities keep their current state
+	state := &applicationautoscaling.SuspendedState{
+		DynamicScalingOutSuspended: aws.Bool(false),
+		ScheduledScalingSuspended:  aws.Bool(false),
+	}
+	if target.SuspendedState != nil {
+		state.DynamicScalingOutSuspended = aws.Bool(aws.BoolValue(target.SuspendedState.DynamicScalingOutSuspended))
+		state.ScheduledScalingSuspended = aws.Bool(aws.BoolValue(target.SuspendedState.ScheduledScalingSuspended))
+	}
+	state.DynamicScalingInSuspended = aws.Bool(suspended)
+
+	_, err := client.RegisterScalableTarget(&applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+		ScalableDimension: aws.String(ecsServiceDesiredCount),
+		ResourceId:        aws.String(fmt.Sprintf(ecsServiceResourceIDFormat, cluster, serviceName)),
+		SuspendedState:    state,
+	})
+	return err
+}
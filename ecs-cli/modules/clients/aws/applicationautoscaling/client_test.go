@@ -0,0 +1,87 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package applicationautoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/applicationautoscaling/mock/sdk"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetServiceScalableTarget(t *testing.T) {
+	target := &applicationautoscaling.ScalableTarget{
+		ResourceId:  aws.String("service/default/web"),
+		MinCapacity: aws.Int64(2),
+		MaxCapacity: aws.Int64(10),
+	}
+	mockAutoScaling, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockAutoScaling.EXPECT().DescribeScalableTargets(gomock.Any()).Do(func(input *applicationautoscaling.DescribeScalableTargetsInput) {
+		assert.Equal(t, "ecs", aws.StringValue(input.ServiceNamespace), "Expected service namespace to match")
+		assert.Equal(t, "ecs:service:DesiredCount", aws.StringValue(input.ScalableDimension), "Expected scalable dimension to match")
+		assert.Equal(t, []string{"service/default/web"}, aws.StringValueSlice(input.ResourceIds), "Expected resource ID to match")
+	}).Return(&applicationautoscaling.DescribeScalableTargetsOutput{
+		ScalableTargets: []*applicationautoscaling.ScalableTarget{target},
+	}, nil)
+
+	actual, err := getServiceScalableTarget("default", "web", mockAutoScaling)
+	assert.NoError(t, err, "Unexpected error getting scalable target")
+	assert.Equal(t, target, actual, "Expected scalable target to match")
+}
+
+func TestGetServiceScalableTargetWithoutTarget(t *testing.T) {
+	mockAutoScaling, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockAutoScaling.EXPECT().DescribeScalableTargets(gomock.Any()).Return(&applicationautoscaling.DescribeScalableTargetsOutput{}, nil)
+
+	actual, err := getServiceScalableTarget("default", "worker", mockAutoScaling)
+	assert.NoError(t, err, "Unexpected error getting scalable target")
+	assert.Nil(t, actual, "Expected no scalable target for a service without one")
+}
+
+func TestSetServiceScaleInSuspended(t *testing.T) {
+	target := &applicationautoscaling.ScalableTarget{
+		ResourceId: aws.String("service/default/web"),
+		SuspendedState: &applicationautoscaling.SuspendedState{
+			DynamicScalingInSuspended: aws.Bool(false),
+			ScheduledScalingSuspended: aws.Bool(true),
+		},
+	}
+	mockAutoScaling, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockAutoScaling.EXPECT().RegisterScalableTarget(gomock.Any()).Do(func(input *applicationautoscaling.RegisterScalableTargetInput) {
+		assert.Equal(t, "service/default/web", aws.StringValue(input.ResourceId), "Expected resource ID to match")
+		assert.Equal(t, "ecs:service:DesiredCount", aws.StringValue(input.ScalableDimension), "Expected scalable dimension to match")
+		require.NotNil(t, input.SuspendedState, "Expected suspended state to be set")
+		assert.True(t, aws.BoolValue(input.SuspendedState.DynamicScalingInSuspended), "Expected scale-in to be suspended")
+		assert.False(t, aws.BoolValue(input.SuspendedState.DynamicScalingOutSuspended), "Expected scale-out to be left running")
+		assert.True(t, aws.BoolValue(input.SuspendedState.ScheduledScalingSuspended), "Expected scheduled scaling to stay suspended")
+	}).Return(&applicationautoscaling.RegisterScalableTargetOutput{}, nil)
+
+	err := setServiceScaleInSuspended("default", "web", target, true, mockAutoScaling)
+	assert.NoError(t, err, "Unexpected error suspending scale-in")
+}
+
+func setupTestController(t *testing.T) (*mock_applicationautoscalingiface.MockApplicationAutoScalingAPI, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	return mock_applicationautoscalingiface.NewMockApplicationAutoScalingAPI(ctrl), ctrl
+}
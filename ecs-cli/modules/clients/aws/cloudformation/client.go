@@ -16,11 +16,12 @@ package cloudformation
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/progress"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -29,26 +30,23 @@ import (
 )
 
 const (
-	// maxRetriesCreate is the maximum number of DescribeStackEvents API will be invoked by the WaitUntilCreateComplete method
-	// to determine if the stack was created successfully before giving up. This value reflects the values set in the
-	// cloudformation waiters json file in the aws-go-sdk.
-	maxRetriesCreate = 50
+	// maxRetriesCreate is the default maximum number of DescribeStackEvents API will be invoked by the WaitUntilCreateComplete
+	// method to determine if the stack was created successfully before giving up. With exponential polling capped at
+	// maxDelayWait, this allows stacks with many resources (NAT gateways, VPC endpoints, large ASGs) about 2 hours to complete.
+	maxRetriesCreate = 240
 
-	// maxRetriesDelete is the maximum number of DescribeStackEvents API will be invoked by the WaitUntilDeleteComplete method
-	// to determine if the stack was deleted successfully before giving up. This value reflects the values set in the
-	// cloudformation waiters json file in the aws-go-sdk.
-	maxRetriesDelete = 25
+	// maxRetriesDelete is the default maximum number of DescribeStackEvents API will be invoked by the WaitUntilDeleteComplete
+	// method to determine if the stack was deleted successfully before giving up.
+	maxRetriesDelete = 240
 
-	// maxRetriesUpdate is the maximum number of DescribeStackEvents API will be invoked by the WaitUntilUpdateComplete method
-	// to determine if the stack was updated successfully before giving up. This value reflects the values set in the
-	// cloudformation waiters json file in the aws-go-sdk.
-	maxRetriesUpdate = 5
-
-	// delayWait is the delay between successive DescribeStackEvents API calls while determining if the stack was created. This value
-	// reflects the values set in the cloudformation waiters json file in the aws-go-sdk.
-	delayWait = 30 * time.Second
+	// maxRetriesUpdate is the default maximum number of DescribeStackEvents API will be invoked by the WaitUntilUpdateComplete
+	// method to determine if the stack was updated successfully before giving up.
+	maxRetriesUpdate = 120
 
 	validationErrorCode = "ValidationError"
+
+	// notImportedErrorMessage is part of the error returned by ListImports for exports that no stack imports
+	notImportedErrorMessage = "is not imported by any stack"
 )
 
 // createStackFailures maps all known cloudformation stack creation failure statuses to boolean values. It is
@@ -88,16 +86,28 @@ func init() {
 
 // CloudformationClient defines methods to interact the with the CloudFormationAPI interface.
 type CloudformationClient interface {
-	CreateStack(string, string, bool, *CfnStackParams, []*cloudformation.Tag) (string, error)
+	CreateStack(string, string, []string, *CfnStackParams, []*cloudformation.Tag) (string, error)
 	WaitUntilCreateComplete(string) error
 	DeleteStack(string) error
 	DescribeStacks(string) (*cloudformation.DescribeStacksOutput, error)
 	WaitUntilDeleteComplete(string) error
 	UpdateStack(string, *CfnStackParams) (string, error)
+	UpdateStackWithTemplate(string, string, *CfnStackParams) (string, error)
+	CancelUpdateStack(string) error
+	CreateChangeSet(string, string, *CfnStackParams) ([]*cloudformation.Change, error)
+	ExecuteChangeSet(string, string) error
+	DeleteChangeSet(string, string) error
 	WaitUntilUpdateComplete(string) error
 	ValidateStackExists(string) error
 	DescribeNetworkResources(string) error
 	GetStackParameters(string) ([]*cloudformation.Parameter, error)
+	GetStackResourceIds(string) (map[string]string, error)
+	GetStackResources(string) ([]*cloudformation.StackResource, error)
+	GetStackOutputs(string) (map[string]string, error)
+	GetStackExportNames(string) (map[string]string, error)
+	DescribeStacksWithNamePrefix(...string) ([]*cloudformation.Stack, error)
+	ListImports(string) ([]string, error)
+	ValidateTemplate(string, []string) error
 }
 
 // cloudformationClient implements CloudFormationClient.
@@ -105,6 +115,7 @@ type cloudformationClient struct {
 	client  cloudformationiface.CloudFormationAPI
 	config  *config.CommandConfig
 	sleeper utils.Sleeper
+	waiter  *stackWaiter
 }
 
 // NewCloudformationClient creates an instance of cloudFormationClient object.
@@ -120,18 +131,20 @@ func newClient(config *config.CommandConfig, client cloudformationiface.CloudFor
 		config:  config,
 		client:  client,
 		sleeper: &utils.TimeSleeper{},
+		waiter:  newStackWaiter(config.CFNWaitMaxAttempts),
 	}
 }
 
 // CreateStack creates the cloudformation stack by invoking the sdk's CreateStack API and returns the stack id.
-func (c *cloudformationClient) CreateStack(template, stackName string, capabilityIAM bool, params *CfnStackParams, tags []*cloudformation.Tag) (string, error) {
+// The capabilities acknowledge the IAM resources and macros of the template.
+func (c *cloudformationClient) CreateStack(template, stackName string, capabilities []string, params *CfnStackParams, tags []*cloudformation.Tag) (string, error) {
 	input := &cloudformation.CreateStackInput{
 		TemplateBody: aws.String(template),
 		StackName:    aws.String(stackName),
 		Parameters:   params.Get(),
 	}
-	if capabilityIAM {
-		input.Capabilities = aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam})
+	if len(capabilities) > 0 {
+		input.Capabilities = aws.StringSlice(capabilities)
 	}
 	if len(tags) > 0 {
 		input.Tags = tags
@@ -139,6 +152,9 @@ func (c *cloudformationClient) CreateStack(template, stackName string, capabilit
 	output, err := c.client.CreateStack(input)
 
 	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == cloudformation.ErrCodeInsufficientCapabilitiesException {
+			return "", fmt.Errorf("%s. Specify the missing capabilities with the '--%s' flag", aerr.Message(), flags.CapabilitiesFlag)
+		}
 		return "", err
 	}
 
@@ -146,6 +162,36 @@ func (c *cloudformationClient) CreateStack(template, stackName string, capabilit
 	return aws.StringValue(output.StackId), nil
 }
 
+// ValidateTemplate validates the template body with CloudFormation, so that an invalid template,
+// or one requiring capabilities that were not acknowledged, is reported before any resources are created.
+func (c *cloudformationClient) ValidateTemplate(template string, capabilities []string) error {
+	output, err := c.client.ValidateTemplate(&cloudformation.ValidateTemplateInput{
+		TemplateBody: aws.String(template),
+	})
+	if err != nil {
+		return fmt.Errorf("CloudFormation template is invalid: %v", err)
+	}
+
+	acknowledged := make(map[string]bool)
+	for _, capability := range capabilities {
+		acknowledged[capability] = true
+	}
+	// CAPABILITY_NAMED_IAM also acknowledges IAM resources without custom names
+	if acknowledged[cloudformation.CapabilityCapabilityNamedIam] {
+		acknowledged[cloudformation.CapabilityCapabilityIam] = true
+	}
+	var missing []string
+	for _, capability := range aws.StringValueSlice(output.Capabilities) {
+		if !acknowledged[capability] {
+			missing = append(missing, capability)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s. Specify the missing capabilities with the '--%s' flag: %s", aws.StringValue(output.CapabilitiesReason), flags.CapabilitiesFlag, strings.Join(missing, ","))
+	}
+	return nil
+}
+
 // DeleteStack deletes the cloudformation stack.
 func (c *cloudformationClient) DeleteStack(stackName string) error {
 	_, err := c.client.DeleteStack(&cloudformation.DeleteStackInput{
@@ -155,6 +201,76 @@ func (c *cloudformationClient) DeleteStack(stackName string) error {
 	return err
 }
 
+// CancelUpdateStack cancels the update of the cloudformation stack in progress, which rolls it back
+// to its previous template and parameters.
+func (c *cloudformationClient) CancelUpdateStack(stackName string) error {
+	_, err := c.client.CancelUpdateStack(&cloudformation.CancelUpdateStackInput{
+		StackName: aws.String(stackName),
+	})
+
+	return err
+}
+
+// CreateChangeSet creates a change set that updates the parameters of the stack, keeping its template,
+// and returns its changes once CloudFormation has computed them.
+func (c *cloudformationClient) CreateChangeSet(stackName, changeSetName string, params *CfnStackParams) ([]*cloudformation.Change, error) {
+	if _, err := c.client.CreateChangeSet(&cloudformation.CreateChangeSetInput{
+		Capabilities:        aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam}),
+		ChangeSetName:       aws.String(changeSetName),
+		ChangeSetType:       aws.String(cloudformation.ChangeSetTypeUpdate),
+		StackName:           aws.String(stackName),
+		Parameters:          params.Get(),
+		UsePreviousTemplate: aws.Bool(true),
+	}); err != nil {
+		return nil, err
+	}
+
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackName),
+	}
+	if err := c.client.WaitUntilChangeSetCreateComplete(input); err != nil {
+		// the waiter does not report why the change set failed, e.g. that it contains no changes
+		if output, describeErr := c.client.DescribeChangeSet(input); describeErr == nil && aws.StringValue(output.Status) == cloudformation.ChangeSetStatusFailed {
+			return nil, fmt.Errorf("Change set %s failed: %s", changeSetName, aws.StringValue(output.StatusReason))
+		}
+		return nil, err
+	}
+
+	var changes []*cloudformation.Change
+	for {
+		output, err := c.client.DescribeChangeSet(input)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, output.Changes...)
+		if output.NextToken == nil {
+			return changes, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// ExecuteChangeSet starts the update of the stack with the change set.
+func (c *cloudformationClient) ExecuteChangeSet(stackName, changeSetName string) error {
+	_, err := c.client.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackName),
+	})
+
+	return err
+}
+
+// DeleteChangeSet deletes a change set that was not executed.
+func (c *cloudformationClient) DeleteChangeSet(stackName, changeSetName string) error {
+	_, err := c.client.DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackName),
+	})
+
+	return err
+}
+
 // DescribeStacks describes a CFN stack
 func (c *cloudformationClient) DescribeStacks(stackName string) (*cloudformation.DescribeStacksOutput, error) {
 	return c.client.DescribeStacks(&cloudformation.DescribeStacksInput{
@@ -179,6 +295,23 @@ func (c *cloudformationClient) UpdateStack(stackName string, params *CfnStackPar
 	return aws.StringValue(output.StackId), nil
 }
 
+// UpdateStackWithTemplate updates the stack and replaces its template with the specified one.
+func (c *cloudformationClient) UpdateStackWithTemplate(stackName, template string, params *CfnStackParams) (string, error) {
+	output, err := c.client.UpdateStack(&cloudformation.UpdateStackInput{
+		Capabilities: aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam}),
+		StackName:    aws.String(stackName),
+		Parameters:   params.Get(),
+		TemplateBody: aws.String(template),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	log.WithFields(log.Fields{"stackId": output.StackId}).Debug("Cloudformation update stack call succeeded")
+	return aws.StringValue(output.StackId), nil
+}
+
 // ValidateStackExists validates if a stack exists with the specified name.
 func (c *cloudformationClient) ValidateStackExists(stackName string) error {
 	_, err := c.describeStackStatus(stackName)
@@ -202,6 +335,104 @@ func (c *cloudformationClient) GetStackParameters(stackName string) ([]*cloudfor
 	return output.Stacks[0].Parameters, nil
 }
 
+// GetStackOutputs returns the values of the outputs of the stack, keyed by output key.
+func (c *cloudformationClient) GetStackOutputs(stackName string) (map[string]string, error) {
+	output, err := c.DescribeStacks(stackName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.Stacks) == 0 {
+		return nil, fmt.Errorf("Could not describe stack '%s'", stackName)
+	}
+
+	outputs := make(map[string]string)
+	for _, stackOutput := range output.Stacks[0].Outputs {
+		outputs[aws.StringValue(stackOutput.OutputKey)] = aws.StringValue(stackOutput.OutputValue)
+	}
+	return outputs, nil
+}
+
+// GetStackExportNames returns the names of the exports of the stack, keyed by output key.
+func (c *cloudformationClient) GetStackExportNames(stackName string) (map[string]string, error) {
+	output, err := c.DescribeStacks(stackName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.Stacks) == 0 {
+		return nil, fmt.Errorf("Could not describe stack '%s'", stackName)
+	}
+
+	exportNames := make(map[string]string)
+	for _, stackOutput := range output.Stacks[0].Outputs {
+		if exportName := aws.StringValue(stackOutput.ExportName); exportName != "" {
+			exportNames[aws.StringValue(stackOutput.OutputKey)] = exportName
+		}
+	}
+	return exportNames, nil
+}
+
+// DescribeStacksWithNamePrefix returns the stacks whose names start with any of the prefixes.
+func (c *cloudformationClient) DescribeStacksWithNamePrefix(prefixes ...string) ([]*cloudformation.Stack, error) {
+	var stacks []*cloudformation.Stack
+	err := c.client.DescribeStacksPages(&cloudformation.DescribeStacksInput{}, func(output *cloudformation.DescribeStacksOutput, lastPage bool) bool {
+		for _, stack := range output.Stacks {
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(aws.StringValue(stack.StackName), prefix) {
+					stacks = append(stacks, stack)
+					break
+				}
+			}
+		}
+		return true
+	})
+	return stacks, err
+}
+
+// ListImports returns the names of the stacks that import the export. It returns
+// an empty list if no stack imports it.
+func (c *cloudformationClient) ListImports(exportName string) ([]string, error) {
+	var stackNames []string
+	err := c.client.ListImportsPages(&cloudformation.ListImportsInput{
+		ExportName: aws.String(exportName),
+	}, func(output *cloudformation.ListImportsOutput, lastPage bool) bool {
+		stackNames = append(stackNames, aws.StringValueSlice(output.Imports)...)
+		return true
+	})
+	if awsError, ok := err.(awserr.Error); ok && awsError.Code() == validationErrorCode && strings.Contains(awsError.Message(), notImportedErrorMessage) {
+		return nil, nil
+	}
+	return stackNames, err
+}
+
+// GetStackResourceIds returns the physical IDs of the resources in the stack, keyed by logical ID.
+func (c *cloudformationClient) GetStackResourceIds(stackName string) (map[string]string, error) {
+	output, err := c.client.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resourceIds := make(map[string]string)
+	for _, resource := range output.StackResources {
+		resourceIds[aws.StringValue(resource.LogicalResourceId)] = aws.StringValue(resource.PhysicalResourceId)
+	}
+	return resourceIds, nil
+}
+
+// GetStackResources returns the resources of the stack, with their status.
+func (c *cloudformationClient) GetStackResources(stackName string) ([]*cloudformation.StackResource, error) {
+	output, err := c.client.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.StackResources, nil
+}
+
 // WaitUntilCreateComplete waits until the stack creation completes.
 func (c *cloudformationClient) WaitUntilCreateComplete(stackName string) error {
 	return c.waitUntilComplete(stackName, failureInCreateEvent, cloudformation.StackStatusCreateComplete, createStackFailures, maxRetriesCreate)
@@ -216,6 +447,7 @@ func (c *cloudformationClient) WaitUntilDeleteComplete(stackName string) error {
 		// then continue, else return the error
 		// TODO: ListStacks and check StackSummaries[n].StackStatus == "DELETE_COMPLETE"
 		if ok && awsError.Code() == validationErrorCode && strings.Contains(awsError.Message(), "does not exist") {
+			progress.Emit(progress.PhaseStack, stackName, cloudformation.StackStatusDeleteComplete, "")
 			return nil
 		}
 		return err
@@ -232,15 +464,23 @@ func (c *cloudformationClient) WaitUntilUpdateComplete(stackName string) error {
 // stack event's status indicates failure in creating/updating/deleting a resource.
 type failureInStackEvent func(*cloudformation.StackEvent) bool
 
-// waitUntilComplete waits until the function callback indicates completeness or until maxRetries are exhausted.
-func (c *cloudformationClient) waitUntilComplete(stackName string, hasFailed failureInStackEvent, successState string, failureStates map[string]bool, maxRetries int) error {
+// waitUntilComplete waits until the function callback indicates completeness or until the attempts are exhausted.
+// defaultMaxRetries is used unless the maximum number of attempts was configured.
+func (c *cloudformationClient) waitUntilComplete(stackName string, hasFailed failureInStackEvent, successState string, failureStates map[string]bool, defaultMaxRetries int) error {
+	maxRetries := c.waiter.attempts(defaultMaxRetries)
+	var lastEventID string
 	for retryCount := 0; retryCount < maxRetries; retryCount++ {
 		event, err := c.latestStackEvent(stackName)
 		if err != nil {
 			return err
 		}
+		if eventID := aws.StringValue(event.EventId); eventID != lastEventID {
+			lastEventID = eventID
+			progress.Emit(progress.PhaseStackResource, aws.StringValue(event.LogicalResourceId), aws.StringValue(event.ResourceStatus), aws.StringValue(event.ResourceStatusReason))
+		}
 		if failed := hasFailed(event); failed {
 			reason := aws.StringValue(event.ResourceStatusReason)
+			progress.Emit(progress.PhaseStack, stackName, progress.StatusFailed, reason)
 			return fmt.Errorf("Cloudformation failure waiting for '%s'. Reason: '%s'", successState, reason)
 		}
 
@@ -249,6 +489,7 @@ func (c *cloudformationClient) waitUntilComplete(stackName string, hasFailed fai
 		if err != nil {
 			return err
 		}
+		progress.Emit(progress.PhaseStack, stackName, status, "")
 
 		if successState == status {
 			return nil
@@ -271,10 +512,10 @@ func (c *cloudformationClient) waitUntilComplete(stackName string, hasFailed fai
 		} else {
 			log.WithFields(log.Fields{"stackStatus": status}).Debug("Cloudformation stack status")
 		}
-		c.sleeper.Sleep(delayWait)
+		c.sleeper.Sleep(c.waiter.delay(retryCount))
 	}
 
-	return fmt.Errorf("Timeout waiting for stack operation to complete")
+	return fmt.Errorf("Timeout waiting for stack operation to complete after %d attempts. The maximum number of attempts can be increased with --%s", maxRetries, flags.CFNWaitMaxAttemptsFlag)
 }
 
 // latestStackEvent describes stack events and gets the latest event.
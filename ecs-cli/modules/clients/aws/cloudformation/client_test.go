@@ -15,12 +15,14 @@ package cloudformation
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation/mock/sdk"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/golang/mock/gomock"
@@ -88,6 +90,19 @@ func TestWaitUntilCreateCompleteFails(t *testing.T) {
 	}
 }
 
+func TestWaitUntilCreateCompleteWithMaxAttempts(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+	cfnClient.(*cloudformationClient).waiter = newStackWaiter(2)
+
+	eventCreateInProgress := createStackEvent(cloudformation.ResourceStatusCreateInProgress)
+	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(eventCreateInProgress, nil).Times(2)
+	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusCreateInProgress), nil).Times(2)
+
+	err := cfnClient.WaitUntilCreateComplete("")
+	assert.Error(t, err, "Expected timeout waiting for create completion")
+}
+
 func TestWaitUntilDeleteCompletes(t *testing.T) {
 	mockCfn, cfnClient, ctrl := setupTestController(t)
 	defer ctrl.Finish()
@@ -315,6 +330,211 @@ func TestDescribeNetworkResources(t *testing.T) {
 	}
 }
 
+func TestGetStackOutputs(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	output := &cloudformation.DescribeStacksOutput{
+		Stacks: []*cloudformation.Stack{
+			{
+				Outputs: []*cloudformation.Output{
+					{OutputKey: aws.String(OutputKeyVpcId), OutputValue: aws.String("vpc-feedface")},
+					{OutputKey: aws.String(OutputKeySubnetIds), OutputValue: aws.String("subnet-baff1ed,subnet-baff2ed")},
+				},
+			},
+		},
+	}
+	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Do(func(input interface{}) {
+		assert.Equal(t, "myStack", aws.StringValue(input.(*cloudformation.DescribeStacksInput).StackName))
+	}).Return(output, nil)
+
+	outputs, err := cfnClient.GetStackOutputs("myStack")
+	assert.NoError(t, err, "Unexpected error getting stack outputs")
+	assert.Equal(t, map[string]string{
+		OutputKeyVpcId:     "vpc-feedface",
+		OutputKeySubnetIds: "subnet-baff1ed,subnet-baff2ed",
+	}, outputs)
+}
+
+func TestGetStackOutputsWithNoStack(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{}, nil)
+
+	_, err := cfnClient.GetStackOutputs("myStack")
+	assert.Error(t, err, "Expected error getting outputs of missing stack")
+}
+
+func TestGetStackExportNames(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	output := &cloudformation.DescribeStacksOutput{
+		Stacks: []*cloudformation.Stack{
+			{
+				Outputs: []*cloudformation.Output{
+					{OutputKey: aws.String(OutputKeyVpcId), OutputValue: aws.String("vpc-feedface"), ExportName: aws.String("myStack-VpcId")},
+					{OutputKey: aws.String(OutputKeyAsgName), OutputValue: aws.String("myStack-EcsInstanceAsg")},
+				},
+			},
+		},
+	}
+	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(output, nil)
+
+	exportNames, err := cfnClient.GetStackExportNames("myStack")
+	assert.NoError(t, err, "Unexpected error getting stack export names")
+	assert.Equal(t, map[string]string{
+		OutputKeyVpcId: "myStack-VpcId",
+	}, exportNames)
+}
+
+func TestDescribeStacksWithNamePrefix(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	pages := []*cloudformation.DescribeStacksOutput{
+		{
+			Stacks: []*cloudformation.Stack{
+				{StackName: aws.String("amazon-ecs-cli-setup-myCluster")},
+				{StackName: aws.String("someOtherStack")},
+			},
+		},
+		{
+			Stacks: []*cloudformation.Stack{
+				{StackName: aws.String("amazon-ecs-cli-setup-private-dns-namespace-myCluster-web")},
+			},
+		},
+	}
+	mockCfn.EXPECT().DescribeStacksPages(gomock.Any(), gomock.Any()).Do(func(input, fn interface{}) {
+		for i, page := range pages {
+			fn.(func(*cloudformation.DescribeStacksOutput, bool) bool)(page, i == len(pages)-1)
+		}
+	}).Return(nil)
+
+	stacks, err := cfnClient.DescribeStacksWithNamePrefix("amazon-ecs-cli-setup-myCluster", "amazon-ecs-cli-setup-private-dns-namespace-myCluster-")
+	assert.NoError(t, err, "Unexpected error describing stacks")
+	assert.Equal(t, []*cloudformation.Stack{pages[0].Stacks[0], pages[1].Stacks[0]}, stacks)
+}
+
+func TestListImports(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockCfn.EXPECT().ListImportsPages(gomock.Any(), gomock.Any()).Do(func(input, fn interface{}) {
+		assert.Equal(t, "myStack-VpcId", aws.StringValue(input.(*cloudformation.ListImportsInput).ExportName))
+		fn.(func(*cloudformation.ListImportsOutput, bool) bool)(&cloudformation.ListImportsOutput{
+			Imports: aws.StringSlice([]string{"otherStack"}),
+		}, true)
+	}).Return(nil)
+
+	stackNames, err := cfnClient.ListImports("myStack-VpcId")
+	assert.NoError(t, err, "Unexpected error listing imports")
+	assert.Equal(t, []string{"otherStack"}, stackNames)
+}
+
+func TestListImportsNotImported(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockCfn.EXPECT().ListImportsPages(gomock.Any(), gomock.Any()).Return(awserr.New(validationErrorCode, "Export 'myStack-VpcId' is not imported by any stack.", nil))
+
+	stackNames, err := cfnClient.ListImports("myStack-VpcId")
+	assert.NoError(t, err, "Unexpected error listing imports of an export that is not imported")
+	assert.Empty(t, stackNames)
+}
+
+func TestValidateTemplate(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockCfn.EXPECT().ValidateTemplate(gomock.Any()).Do(func(input interface{}) {
+		assert.Equal(t, "{}", aws.StringValue(input.(*cloudformation.ValidateTemplateInput).TemplateBody))
+	}).Return(&cloudformation.ValidateTemplateOutput{}, nil)
+
+	err := cfnClient.ValidateTemplate("{}", nil)
+	assert.NoError(t, err, "Unexpected error validating template")
+}
+
+func TestValidateTemplateInvalid(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockCfn.EXPECT().ValidateTemplate(gomock.Any()).Return(nil, awserr.New(validationErrorCode, "Template format error: Unresolved resource dependencies [Vpc] in the Resources block of the template", nil))
+
+	err := cfnClient.ValidateTemplate("{}", nil)
+	assert.Error(t, err, "Expected error validating template")
+	assert.Contains(t, err.Error(), "Unresolved resource dependencies [Vpc]")
+}
+
+func TestValidateTemplateMissingCapabilities(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	reason := "The following resource(s) require capabilities: [AWS::IAM::Role]"
+	mockCfn.EXPECT().ValidateTemplate(gomock.Any()).Return(&cloudformation.ValidateTemplateOutput{
+		Capabilities:       aws.StringSlice([]string{cloudformation.CapabilityCapabilityNamedIam}),
+		CapabilitiesReason: aws.String(reason),
+	}, nil).Times(2)
+
+	err := cfnClient.ValidateTemplate("{}", []string{cloudformation.CapabilityCapabilityIam})
+	assert.Error(t, err, "Expected error validating template requiring a missing capability")
+	assert.Contains(t, err.Error(), reason, "Expected the CloudFormation reason to be surfaced")
+	assert.Contains(t, err.Error(), cloudformation.CapabilityCapabilityNamedIam, "Expected the missing capability to be listed")
+
+	err = cfnClient.ValidateTemplate("{}", []string{cloudformation.CapabilityCapabilityIam, cloudformation.CapabilityCapabilityNamedIam})
+	assert.NoError(t, err, "Unexpected error validating template with its required capabilities")
+}
+
+func TestValidateTemplateNamedIAMAcknowledgesIAM(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockCfn.EXPECT().ValidateTemplate(gomock.Any()).Return(&cloudformation.ValidateTemplateOutput{
+		Capabilities: aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam}),
+	}, nil)
+
+	err := cfnClient.ValidateTemplate("{}", []string{cloudformation.CapabilityCapabilityNamedIam})
+	assert.NoError(t, err, "Unexpected error validating template")
+}
+
+func TestCreateStackInsufficientCapabilities(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	message := "Requires capabilities : [CAPABILITY_AUTO_EXPAND]"
+	mockCfn.EXPECT().CreateStack(gomock.Any()).Do(func(input interface{}) {
+		capabilities := aws.StringValueSlice(input.(*cloudformation.CreateStackInput).Capabilities)
+		assert.Equal(t, []string{cloudformation.CapabilityCapabilityIam}, capabilities, "Expected capabilities to match")
+	}).Return(nil, awserr.New(cloudformation.ErrCodeInsufficientCapabilitiesException, message, nil))
+
+	_, err := cfnClient.CreateStack("{}", "myStack", []string{cloudformation.CapabilityCapabilityIam}, NewCfnStackParams(nil), nil)
+	assert.Error(t, err, "Expected error creating stack")
+	assert.True(t, strings.HasPrefix(err.Error(), message), "Expected the CloudFormation message to be surfaced verbatim")
+}
+
+func TestGetStackResourceIds(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	output := &cloudformation.DescribeStackResourcesOutput{
+		StackResources: []*cloudformation.StackResource{
+			{LogicalResourceId: aws.String(VPCLogicalResourceId), PhysicalResourceId: aws.String("vpc-feedface")},
+			{LogicalResourceId: aws.String(Subnet1LogicalResourceId), PhysicalResourceId: aws.String("subnet-baff1ed")},
+		},
+	}
+	mockCfn.EXPECT().DescribeStackResources(gomock.Any()).Do(func(input interface{}) {
+		assert.Equal(t, "myStack", aws.StringValue(input.(*cloudformation.DescribeStackResourcesInput).StackName))
+	}).Return(output, nil)
+
+	resourceIds, err := cfnClient.GetStackResourceIds("myStack")
+	assert.NoError(t, err, "Unexpected error getting stack resource IDs")
+	assert.Equal(t, map[string]string{
+		VPCLogicalResourceId:     "vpc-feedface",
+		Subnet1LogicalResourceId: "subnet-baff1ed",
+	}, resourceIds)
+}
+
 func setupTestController(t *testing.T) (*mock_cloudformationiface.MockCloudFormationAPI, CloudformationClient, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
 	// defer ctrl.Finish()
@@ -328,3 +548,50 @@ func setupTestController(t *testing.T) (*mock_cloudformationiface.MockCloudForma
 
 	return mockCfn, client, ctrl
 }
+
+func TestCreateChangeSet(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	params := NewCfnStackParams(nil)
+	params.Add("EcsInstanceType", "m5.large")
+	gomock.InOrder(
+		mockCfn.EXPECT().CreateChangeSet(gomock.Any()).Do(func(input *cloudformation.CreateChangeSetInput) {
+			assert.Equal(t, "resize", aws.StringValue(input.ChangeSetName))
+			assert.Equal(t, cloudformation.ChangeSetTypeUpdate, aws.StringValue(input.ChangeSetType))
+			assert.True(t, aws.BoolValue(input.UsePreviousTemplate), "Expected the template of the stack to be kept")
+			assert.Equal(t, "m5.large", aws.StringValue(input.Parameters[0].ParameterValue))
+		}).Return(&cloudformation.CreateChangeSetOutput{}, nil),
+		mockCfn.EXPECT().WaitUntilChangeSetCreateComplete(gomock.Any()).Return(nil),
+		mockCfn.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+			Changes:   []*cloudformation.Change{{Type: aws.String("Resource")}},
+			NextToken: aws.String("next"),
+		}, nil),
+		mockCfn.EXPECT().DescribeChangeSet(gomock.Any()).Do(func(input *cloudformation.DescribeChangeSetInput) {
+			assert.Equal(t, "next", aws.StringValue(input.NextToken))
+		}).Return(&cloudformation.DescribeChangeSetOutput{
+			Changes: []*cloudformation.Change{{Type: aws.String("Resource")}},
+		}, nil),
+	)
+
+	changes, err := cfnClient.CreateChangeSet("stack", "resize", params)
+	assert.NoError(t, err, "Unexpected error creating change set")
+	assert.Len(t, changes, 2, "Expected the changes of all pages")
+}
+
+func TestCreateChangeSetFailed(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockCfn.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{}, nil),
+		mockCfn.EXPECT().WaitUntilChangeSetCreateComplete(gomock.Any()).Return(errors.New("ResourceNotReady: failed waiting for successful resource state")),
+		mockCfn.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+			Status:       aws.String(cloudformation.ChangeSetStatusFailed),
+			StatusReason: aws.String("The submitted information didn't contain changes."),
+		}, nil),
+	)
+
+	_, err := cfnClient.CreateChangeSet("stack", "resize", NewCfnStackParams(nil))
+	assert.EqualError(t, err, "Change set resize failed: The submitted information didn't contain changes.")
+}
@@ -14,26 +14,87 @@
 package cloudformation
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 )
 
-func GetClusterTemplate(tags []*ecs.Tag, stackName string) (string, error) {
-	tagJSON, err := json.Marshal(tags)
-	if err != nil {
-		return "", err
+// NewClusterTemplate builds the template of the cluster stack, with the tags applied
+// to its resources and the network outputs exported for use by other stacks. Each
+// feature of the cluster adds its parameters, conditions, resources and outputs.
+func NewClusterTemplate(tags []*ecs.Tag, stackName string) (*Template, error) {
+	template := &Template{
+		AWSTemplateFormatVersion: "2010-09-09",
+		Description:              "AWS CloudFormation template to create resources required to run tasks on an ECS cluster.",
+		Mappings:                 make(map[string]interface{}),
+		Parameters:               make(map[string]*Parameter),
+		Conditions:               make(map[string]interface{}),
+		Resources:                make(map[string]*Resource),
+		Outputs:                  make(map[string]*Output),
+	}
+	template.Parameters["EcsCluster"] = &Parameter{
+		Type:        "String",
+		Description: "ECS Cluster Name",
+		Default:     aws.String("default"),
+	}
+
+	addClusterNetwork(template)
+	instances := addClusterInstances(template)
+	addCapacityReservation(template, instances)
+	addInstancePlacement(template, instances)
+	addCapacityProvider(template, instances)
+	addTaskEventCapture(template)
+
+	if tags == nil {
+		tags = []*ecs.Tag{}
+	}
+	for _, logicalID := range taggedLogicalResourceIds {
+		if err := template.SetProperty(logicalID, "Tags", tags); err != nil {
+			return nil, err
+		}
+	}
+	if err := template.SetProperty(AsgLogicalResourceId, "Tags", getASGTags(tags, stackName)); err != nil {
+		return nil, err
 	}
 
-	asgTags := getASGTags(tags, stackName)
-	asgTagJSON, err := json.Marshal(asgTags)
-	if err != nil {
-		return "", err
+	for _, outputKey := range ExportedOutputKeys {
+		output, ok := template.Outputs[outputKey]
+		if !ok {
+			return nil, fmt.Errorf("Output %s not found in CloudFormation template", outputKey)
+		}
+		output.Export = &Export{
+			Name: Sub(fmt.Sprintf(exportNameFormat, "${AWS::StackName}", outputKey)),
+		}
 	}
+	return template, nil
+}
 
-	return fmt.Sprintf(clusterTemplate, string(tagJSON), string(asgTagJSON)), nil
+// ExportName returns the name of the export of the output of the cluster stack.
+func ExportName(stackName, outputKey string) string {
+	return fmt.Sprintf(exportNameFormat, stackName, outputKey)
+}
+
+// ScheduledAction is a recurring change of the desired capacity of the Auto Scaling group of the cluster
+type ScheduledAction struct {
+	// Recurrence is the schedule of the action in Unix cron format, in UTC
+	Recurrence      string
+	DesiredCapacity int64
+}
+
+// AddScheduledActions adds an AWS::AutoScaling::ScheduledAction resource to the template for each action.
+func AddScheduledActions(template *Template, actions []*ScheduledAction) {
+	for i, action := range actions {
+		template.Resources[fmt.Sprintf(scheduledActionLogicalResourceIdFormat, i+1)] = &Resource{
+			Type:      "AWS::AutoScaling::ScheduledAction",
+			Condition: "LaunchInstances",
+			Properties: map[string]interface{}{
+				"AutoScalingGroupName": map[string]interface{}{"Ref": AsgLogicalResourceId},
+				"DesiredCapacity":      action.DesiredCapacity,
+				"Recurrence":           action.Recurrence,
+			},
+		}
+	}
 }
 
 // Autoscaling CFN tags have an additional field that determines if they are
@@ -75,557 +136,694 @@ type autoscalingTag struct {
 }
 
 // TODO: Improvements:
-// 1. Auto detect default vpc
-// 2. Auto detect existing key pairs
-// 3. Create key pair when none exist
-// 4. Remove the hardcoded 2 subnets creation
+// 1. Auto detect existing key pairs
+// 2. Create key pair when none exist
+// 3. Remove the hardcoded 2 subnets creation
+// 4. Private subnet topology with NAT gateways. Once it exists, allow allocating Elastic IPs
+//    (or passing existing allocation IDs) for the NAT gateways so that outbound traffic has
+//    stable IPs, and surface them in the stack outputs.
 
 // These are used to display CFN resources in the CreateCluster callback.
-// TODO: Find better way to use constants in template string itself.
 const (
 	Subnet1LogicalResourceId       = "PubSubnetAz1"
 	Subnet2LogicalResourceId       = "PubSubnetAz2"
 	VPCLogicalResourceId           = "Vpc"
 	SecurityGroupLogicalResourceId = "EcsSecurityGroup"
+	AsgLogicalResourceId           = "EcsInstanceAsg"
 	DefaultECSInstanceType         = "t2.micro"
 )
 
-var clusterTemplate = `
-{
-  "AWSTemplateFormatVersion": "2010-09-09",
-  "Description": "AWS CloudFormation template to create resources required to run tasks on an ECS cluster.",
-  "Mappings": {
-    "VpcCidrs": {
-      "vpc": {"cidr" : "10.0.0.0/16"},
-      "pubsubnet1": {"cidr" : "10.0.0.0/24"},
-      "pubsubnet2": {"cidr" :"10.0.1.0/24"}
-    }
-  },
-  "Parameters": {
-    "EcsAmiId": {
-      "Type": "String",
-      "Description": "ECS EC2 AMI id",
-      "Default": ""
-    },
-    "EcsInstanceType": {
-      "Type": "String",
-      "Description": "ECS EC2 instance type",
-      "Default": ""
-    },
-    "SpotPrice": {
-      "Type": "Number",
-      "Description": "If greater than 0, then a EC2 Spot instance will be requested",
-      "Default": "0"
-    },
-    "KeyName": {
-      "Type": "String",
-      "Description": "Optional - Name of an existing EC2 KeyPair to enable SSH access to the ECS instances",
-      "Default": ""
-    },
-    "VpcId": {
-      "Type": "String",
-      "Description": "Optional - VPC Id of existing VPC. Leave blank to have a new VPC created",
-      "Default": "",
-      "AllowedPattern": "^(?:vpc-[0-9a-f]{8}|vpc-[0-9a-f]{17}|)$",
-      "ConstraintDescription": "VPC Id must begin with 'vpc-' followed by either an 8 or 17 character identifier, or leave blank to have a new VPC created"
-    },
-    "SubnetIds": {
-      "Type": "CommaDelimitedList",
-      "Description": "Optional - Comma separated list of two (2) existing VPC Subnet Ids where ECS instances will run.  Required if setting VpcId.",
-      "Default": ""
-    },
-    "AsgMaxSize": {
-      "Type": "Number",
-      "Description": "Maximum size and initial Desired Capacity of ECS Auto Scaling Group",
-      "Default": "1"
-    },
-    "SecurityGroupIds": {
-      "Type": "CommaDelimitedList",
-      "Description": "Optional - Existing security group to associate the container instances. Creates one by default.",
-      "Default": ""
-    },
-    "SourceCidr": {
-      "Type": "String",
-      "Description": "Optional - CIDR/IP range for EcsPort - defaults to 0.0.0.0/0",
-      "Default": "0.0.0.0/0"
-    },
-    "EcsPort" : {
-      "Type" : "String",
-      "Description" : "Optional - Security Group port to open on ECS instances - defaults to port 80",
-      "Default" : "80"
-    },
-    "VpcAvailabilityZones": {
-      "Type": "CommaDelimitedList",
-      "Description": "Optional - Comma-delimited list of VPC availability zones in which to create subnets.  Required if setting VpcId.",
-      "Default": ""
-    },
-    "AssociatePublicIpAddress": {
-      "Type": "String",
-      "Description": "Optional - Automatically assign public IP addresses to new instances in this VPC.",
-      "Default": "true"
-    },
-    "EcsCluster" : {
-      "Type" : "String",
-      "Description" : "ECS Cluster Name",
-      "Default" : "default"
-    },
-    "InstanceRole" : {
-      "Type" : "String",
-      "Description" : "Optional - Instance IAM Role.",
-      "Default" : ""
-    },
-    "IsFargate": {
-      "Type": "String",
-      "Description": "Optional - Whether to create resources only for running Fargate tasks.",
-      "Default": "false"
-    },
-    "IsIMDSv2": {
-      "Type": "String",
-      "Description": "Optional - Disable IMDSv1.",
-      "Default": "false",
-    },
-    "UserData" : {
-      "Type" : "String",
-      "Description" : "User data for EC2 instances. Required for EC2 launch type, ignored with Fargate",
-      "Default" : ""
-    }
-  },
-  "Conditions": {
-    "IsCNRegion": {
-      "Fn::Or" : [
-        {"Fn::Equals": [ { "Ref": "AWS::Region" }, "cn-north-1" ]},
-        {"Fn::Equals": [ { "Ref": "AWS::Region" }, "cn-northwest-1" ]}
-      ]
-    },
-    "LaunchInstances": {
-      "Fn::Equals": [ { "Ref": "IsFargate" }, "false" ]
-    },
-    "EnableIMDSv2": {
-      "Fn::Equals": [ { "Ref": "IsIMDSv2" }, "true" ]
-    },
-    "CreateVpcResources": {
-      "Fn::Equals": [
-        {
-          "Ref": "VpcId"
-        },
-        ""
-      ]
-    },
-    "CreateSecurityGroup": {
-      "Fn::And":[
-        {
-          "Condition": "LaunchInstances"
-        },
-        {
-          "Fn::Equals": [
-            {
-              "Fn::Join": [
-                "",
-                {
-                  "Ref": "SecurityGroupIds"
-                }
-              ]
-            },
-            ""
-          ]
-        }
-      ]
-    },
-    "CreateEC2LCWithKeyPair": {
-      "Fn::And":[
-        {
-          "Condition": "LaunchInstances"
-        },
-        {
-          "Fn::Not": [
-            {
-              "Fn::Equals": [
-                {
-                  "Ref": "KeyName"
-                },
-                ""
-              ]
-            }
-          ]
-        }
-      ]
-    },
-    "UseSpecifiedVpcAvailabilityZones": {
-      "Fn::Not": [
-        {
-          "Fn::Equals": [
-            {
-              "Fn::Join": [
-                "",
-                {
-                  "Ref": "VpcAvailabilityZones"
-                }
-              ]
-            },
-            ""
-          ]
-        }
-      ]
-    },
-    "CreateEcsInstanceRole": {
-      "Fn::And":[
-        {
-          "Condition": "LaunchInstances"
-        },
-        {
-          "Fn::Equals": [
-            {
-              "Ref": "InstanceRole"
-            },
-            ""
-          ]
-        }
-      ]
-    },
-    "UseSpotInstances": {
-      "Fn::Not": [
-      {
-        "Fn::Equals": [
-        {
-          "Ref": "SpotPrice"
-        },
-        0
-        ]
-      }
-      ]
-    }
-  },
-  "Resources": {
-    "Vpc": {
-      "Condition": "CreateVpcResources",
-      "Type": "AWS::EC2::VPC",
-      "Properties": {
-        "EnableDnsSupport" : true,
-        "EnableDnsHostnames" : true,
-        "CidrBlock": {
-          "Fn::FindInMap": ["VpcCidrs", "vpc", "cidr"]
-        },
-        "Tags": %[1]s
-      }
-    },
-    "PubSubnetAz1": {
-      "Condition": "CreateVpcResources",
-      "Type": "AWS::EC2::Subnet",
-      "Properties": {
-        "VpcId": {
-          "Ref": "Vpc"
-        },
-        "CidrBlock": {
-          "Fn::FindInMap": ["VpcCidrs", "pubsubnet1", "cidr"]
-        },
-        "Tags": %[1]s,
-        "AvailabilityZone": {
-          "Fn::If": [
-            "UseSpecifiedVpcAvailabilityZones",
-            {
-              "Fn::Select": [
-                "0",
-                {
-                  "Ref": "VpcAvailabilityZones"
-                }
-              ]
-            },
-            {
-              "Fn::Select": [
-                "0",
-                {
-                  "Fn::GetAZs": {
-                    "Ref": "AWS::Region"
-                  }
-                }
-              ]
-            }
-          ]
-        }
-      }
-    },
-    "PubSubnetAz2": {
-      "Condition": "CreateVpcResources",
-      "Type": "AWS::EC2::Subnet",
-      "Properties": {
-        "VpcId": {
-          "Ref": "Vpc"
-        },
-        "CidrBlock": {
-          "Fn::FindInMap": ["VpcCidrs", "pubsubnet2", "cidr"]
-        },
-        "Tags": %[1]s,
-        "AvailabilityZone": {
-          "Fn::If": [
-            "UseSpecifiedVpcAvailabilityZones",
-            {
-              "Fn::Select": [
-                "1",
-                {
-                  "Ref": "VpcAvailabilityZones"
-                }
-              ]
-            },
-            {
-              "Fn::Select": [
-                "1",
-                {
-                  "Fn::GetAZs": {
-                    "Ref": "AWS::Region"
-                  }
-                }
-              ]
-            }
-          ]
-        }
-      }
-    },
-    "InternetGateway": {
-      "Condition": "CreateVpcResources",
-      "Type": "AWS::EC2::InternetGateway",
-      "Properties": {
-        "Tags": %[1]s
-      }
-    },
-    "AttachGateway": {
-      "Condition": "CreateVpcResources",
-      "Type": "AWS::EC2::VPCGatewayAttachment",
-      "Properties": {
-        "VpcId": {
-          "Ref": "Vpc"
-        },
-        "InternetGatewayId": {
-          "Ref": "InternetGateway"
-        }
-      }
-    },
-    "RouteViaIgw": {
-      "Condition": "CreateVpcResources",
-      "Type": "AWS::EC2::RouteTable",
-      "Properties": {
-        "VpcId": {
-          "Ref": "Vpc"
-        },
-        "Tags": %[1]s
-      }
-    },
-    "PublicRouteViaIgw": {
-      "Condition": "CreateVpcResources",
-      "DependsOn": "AttachGateway",
-      "Type": "AWS::EC2::Route",
-      "Properties": {
-        "RouteTableId": {
-          "Ref": "RouteViaIgw"
-        },
-        "DestinationCidrBlock": "0.0.0.0/0",
-        "GatewayId": {
-          "Ref": "InternetGateway"
-        }
-      }
-    },
-    "PubSubnet1RouteTableAssociation": {
-      "Condition": "CreateVpcResources",
-      "Type": "AWS::EC2::SubnetRouteTableAssociation",
-      "Properties": {
-        "SubnetId": {
-          "Ref": "PubSubnetAz1"
-        },
-        "RouteTableId": {
-          "Ref": "RouteViaIgw"
-        }
-      }
-    },
-    "PubSubnet2RouteTableAssociation": {
-      "Condition": "CreateVpcResources",
-      "Type": "AWS::EC2::SubnetRouteTableAssociation",
-      "Properties": {
-        "SubnetId": {
-          "Ref": "PubSubnetAz2"
-        },
-        "RouteTableId": {
-          "Ref": "RouteViaIgw"
-        }
-      }
-    },
-    "EcsSecurityGroup": {
-      "Condition": "CreateSecurityGroup",
-      "Type": "AWS::EC2::SecurityGroup",
-      "Properties": {
-        "GroupDescription": "ECS Allowed Ports",
-        "Tags": %[1]s,
-        "VpcId": {
-          "Fn::If": [
-            "CreateVpcResources",
-            {
-              "Ref": "Vpc"
-            },
-            {
-              "Ref": "VpcId"
-            }
-          ]
-        },
-        "SecurityGroupIngress" : [ {
-            "IpProtocol" : "tcp",
-            "FromPort" : { "Ref" : "EcsPort" },
-            "ToPort" : { "Ref" : "EcsPort" },
-            "CidrIp" : { "Ref" : "SourceCidr" }
-        } ]
-      }
-    },
-    "EcsInstanceRole": {
-      "Condition": "CreateEcsInstanceRole",
-      "Type": "AWS::IAM::Role",
-      "Properties": {
-        "AssumeRolePolicyDocument": {
-          "Version": "2012-10-17",
-          "Statement": [
-            {
-              "Effect": "Allow",
-              "Principal": {
-                "Service": [
-                  "Fn::If": [
-                    "IsCNRegion",
-                    "ec2.amazonaws.com.cn",
-                    "ec2.amazonaws.com"
-                  ]
-                ]
-              },
-              "Action": [
-                "sts:AssumeRole"
-              ]
-            }
-          ]
-        },
-        "Path": "/",
-        "ManagedPolicyArns": [
-          "arn:aws:iam::aws:policy/service-role/AmazonEC2ContainerServiceforEC2Role"
-        ]
-      }
-    },
-    "EcsInstanceProfile": {
-      "Condition": "LaunchInstances",
-      "Type": "AWS::IAM::InstanceProfile",
-      "Properties": {
-        "Path": "/",
-        "Roles": [
-          "Fn::If": [
-            "CreateEcsInstanceRole",
-            {
-              "Ref": "EcsInstanceRole"
-            },
-            {
-              "Ref": "InstanceRole"
-            }
-          ]
-        ]
-      }
-    },
-    "EcsInstanceLc": {
-      "Condition": "LaunchInstances",
-      "Type": "AWS::AutoScaling::LaunchConfiguration",
-      "Properties": {
-        "ImageId": { "Ref" : "EcsAmiId" },
-        "InstanceType": {
-          "Ref": "EcsInstanceType"
-        },
-        "SpotPrice": {
-          "Fn::If": [
-            "UseSpotInstances",
-            {
-              "Ref": "SpotPrice"
-            },
-            {
-              "Ref": "AWS::NoValue"
-            }
-          ]
-        },
-        "AssociatePublicIpAddress": {
-          "Ref": "AssociatePublicIpAddress"
-        },
-        "IamInstanceProfile": {
-          "Ref": "EcsInstanceProfile"
-        },
-        "KeyName": {
-          "Fn::If": [
-            "CreateEC2LCWithKeyPair",
-            {
-              "Ref": "KeyName"
-            },
-            {
-              "Ref": "AWS::NoValue"
-            }
-          ]
-        },
-        "MetadataOptions": {
-          "Fn::If": [
-            "EnableIMDSv2",
-            {
-              "HttpEndpoint": "enabled",
-              "HttpTokens": "required"
-            },
-            {
-              "Ref": "AWS::NoValue"
-            }
-          ]
-        },
-        "SecurityGroups": {
-          "Fn::If": [
-            "CreateSecurityGroup",
-            [ {
-              "Ref": "EcsSecurityGroup"
-            } ],
-            {
-              "Ref": "SecurityGroupIds"
-            }
-          ]
-        },
-        "UserData": {
-          "Fn::Base64": {
-            "Ref": "UserData"
-          }
-        }
-      }
-    },
-    "EcsInstanceAsg": {
-      "Condition": "LaunchInstances",
-      "Type": "AWS::AutoScaling::AutoScalingGroup",
-      "Properties": {
-        "VPCZoneIdentifier": {
-          "Fn::If": [
-            "CreateVpcResources",
-            [
-              {
-                "Fn::Join": [
-                  ",",
-                  [
-                    {
-                      "Ref": "PubSubnetAz1"
-                    },
-                    {
-                      "Ref": "PubSubnetAz2"
-                    }
-                  ]
-                ]
-              }
-            ],
-            {
-              "Ref": "SubnetIds"
-            }
-          ]
-        },
-        "LaunchConfigurationName": {
-          "Ref": "EcsInstanceLc"
-        },
-        "MinSize": "0",
-        "MaxSize": {
-          "Ref": "AsgMaxSize"
-        },
-        "DesiredCapacity": {
-          "Ref": "AsgMaxSize"
-        },
-        "Tags": %[2]s
-      }
-    }
-  }
+// taggedLogicalResourceIds are the resources of the cluster stack that get the
+// resource tags of the cluster. The Auto Scaling group gets them separately.
+var taggedLogicalResourceIds = []string{
+	VPCLogicalResourceId,
+	Subnet1LogicalResourceId,
+	Subnet2LogicalResourceId,
+	"InternetGateway",
+	"RouteViaIgw",
+	SecurityGroupLogicalResourceId,
+}
+
+// exportNameFormat is the format of the names of the exports of the cluster stack,
+// from the stack name and the output key.
+const exportNameFormat = "%s-%s"
+
+// scheduledActionLogicalResourceIdFormat is the format of the logical IDs of the scheduled
+// actions of the Auto Scaling group, numbered from 1 in the order they were specified.
+const scheduledActionLogicalResourceIdFormat = "EcsInstanceAsgScheduledAction%d"
+
+// LaunchConfigurationLogicalResourceId is the logical ID of the launch configuration of the
+// instances of stacks created before the launch template replaced it.
+const LaunchConfigurationLogicalResourceId = "EcsInstanceLc"
+
+// Keys of the outputs of the cluster stack.
+const (
+	OutputKeyVpcId                = "VpcId"
+	OutputKeySubnetIds            = "SubnetIds"
+	OutputKeySecurityGroupId      = "SecurityGroupId"
+	OutputKeyAsgName              = "AsgName"
+	OutputKeyCapacityProviderName = "CapacityProviderName"
+	OutputKeyInstanceRoleArn      = "InstanceRoleArn"
+	OutputKeyTaskEventsLogGroup   = "TaskEventsLogGroup"
+)
+
+// StackOutputKeys lists the keys of the outputs of the cluster stack in display order.
+var StackOutputKeys = []string{
+	OutputKeyVpcId,
+	OutputKeySubnetIds,
+	OutputKeySecurityGroupId,
+	OutputKeyAsgName,
+	OutputKeyCapacityProviderName,
+	OutputKeyInstanceRoleArn,
+	OutputKeyTaskEventsLogGroup,
+}
+
+// ExportedOutputKeys lists the keys of the outputs of the cluster stack that other stacks can import.
+var ExportedOutputKeys = []string{
+	OutputKeyVpcId,
+	OutputKeySubnetIds,
+	OutputKeySecurityGroupId,
+}
+
+// clusterInstances are the resources of the container instances that the optional
+// features of the instances add their properties to.
+type clusterInstances struct {
+	launchTemplateData map[string]interface{}
+	autoScalingGroup   *Resource
+}
+
+// addClusterNetwork adds the VPC of the cluster, which is created unless an existing VPC is specified.
+func addClusterNetwork(template *Template) {
+	template.Parameters["VpcId"] = &Parameter{
+		Type:                  "String",
+		Description:           "Optional - VPC Id of existing VPC. Leave blank to have a new VPC created",
+		Default:               aws.String(""),
+		AllowedPattern:        "^(?:vpc-[0-9a-f]{8}|vpc-[0-9a-f]{17}|)$",
+		ConstraintDescription: "VPC Id must begin with 'vpc-' followed by either an 8 or 17 character identifier, or leave blank to have a new VPC created",
+	}
+	template.Parameters["SubnetIds"] = &Parameter{
+		Type:        "CommaDelimitedList",
+		Description: "Optional - Comma separated list of two (2) existing VPC Subnet Ids where ECS instances will run.  Required if setting VpcId.",
+		Default:     aws.String(""),
+	}
+	template.Parameters["VpcAvailabilityZones"] = &Parameter{
+		Type:        "CommaDelimitedList",
+		Description: "Optional - Comma-delimited list of VPC availability zones in which to create subnets.  Required if setting VpcId.",
+		Default:     aws.String(""),
+	}
+	template.Parameters["SharedVpcExportName"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - Name of the export of the VPC ID of another cluster stack whose VPC this cluster uses. Importing it prevents that stack from being deleted while this one exists.",
+		Default:     aws.String(""),
+	}
+	template.Conditions["CreateVpcResources"] = Equals(Ref("VpcId"), "")
+	template.Conditions["UseSpecifiedVpcAvailabilityZones"] = Not(Equals(Join("", Ref("VpcAvailabilityZones")), ""))
+	template.Conditions["ImportSharedVpc"] = Not(Equals(Ref("SharedVpcExportName"), ""))
+	template.Mappings["VpcCidrs"] = map[string]interface{}{
+		"vpc": map[string]interface{}{
+			"cidr": "10.0.0.0/16",
+		},
+		"pubsubnet1": map[string]interface{}{
+			"cidr": "10.0.0.0/24",
+		},
+		"pubsubnet2": map[string]interface{}{
+			"cidr": "10.0.1.0/24",
+		},
+	}
+	template.Resources[VPCLogicalResourceId] = &Resource{
+		Type:      "AWS::EC2::VPC",
+		Condition: "CreateVpcResources",
+		Properties: map[string]interface{}{
+			"EnableDnsSupport":   true,
+			"EnableDnsHostnames": true,
+			"CidrBlock":          FindInMap("VpcCidrs", "vpc", "cidr"),
+		},
+	}
+	template.Resources[Subnet1LogicalResourceId] = &Resource{
+		Type:      "AWS::EC2::Subnet",
+		Condition: "CreateVpcResources",
+		Properties: map[string]interface{}{
+			"VpcId":            Ref(VPCLogicalResourceId),
+			"CidrBlock":        FindInMap("VpcCidrs", "pubsubnet1", "cidr"),
+			"AvailabilityZone": If("UseSpecifiedVpcAvailabilityZones", Select("0", Ref("VpcAvailabilityZones")), Select("0", GetAZs(Ref("AWS::Region")))),
+		},
+	}
+	template.Resources[Subnet2LogicalResourceId] = &Resource{
+		Type:      "AWS::EC2::Subnet",
+		Condition: "CreateVpcResources",
+		Properties: map[string]interface{}{
+			"VpcId":            Ref(VPCLogicalResourceId),
+			"CidrBlock":        FindInMap("VpcCidrs", "pubsubnet2", "cidr"),
+			"AvailabilityZone": If("UseSpecifiedVpcAvailabilityZones", Select("1", Ref("VpcAvailabilityZones")), Select("1", GetAZs(Ref("AWS::Region")))),
+		},
+	}
+	template.Resources["InternetGateway"] = &Resource{
+		Type:      "AWS::EC2::InternetGateway",
+		Condition: "CreateVpcResources",
+	}
+	template.Resources["AttachGateway"] = &Resource{
+		Type:      "AWS::EC2::VPCGatewayAttachment",
+		Condition: "CreateVpcResources",
+		Properties: map[string]interface{}{
+			"VpcId":             Ref(VPCLogicalResourceId),
+			"InternetGatewayId": Ref("InternetGateway"),
+		},
+	}
+	template.Resources["RouteViaIgw"] = &Resource{
+		Type:      "AWS::EC2::RouteTable",
+		Condition: "CreateVpcResources",
+		Properties: map[string]interface{}{
+			"VpcId": Ref(VPCLogicalResourceId),
+		},
+	}
+	template.Resources["PublicRouteViaIgw"] = &Resource{
+		Type:      "AWS::EC2::Route",
+		Condition: "CreateVpcResources",
+		DependsOn: "AttachGateway",
+		Properties: map[string]interface{}{
+			"RouteTableId":         Ref("RouteViaIgw"),
+			"DestinationCidrBlock": "0.0.0.0/0",
+			"GatewayId":            Ref("InternetGateway"),
+		},
+	}
+	template.Resources["PubSubnet1RouteTableAssociation"] = &Resource{
+		Type:      "AWS::EC2::SubnetRouteTableAssociation",
+		Condition: "CreateVpcResources",
+		Properties: map[string]interface{}{
+			"SubnetId":     Ref(Subnet1LogicalResourceId),
+			"RouteTableId": Ref("RouteViaIgw"),
+		},
+	}
+	template.Resources["PubSubnet2RouteTableAssociation"] = &Resource{
+		Type:      "AWS::EC2::SubnetRouteTableAssociation",
+		Condition: "CreateVpcResources",
+		Properties: map[string]interface{}{
+			"SubnetId":     Ref(Subnet2LogicalResourceId),
+			"RouteTableId": Ref("RouteViaIgw"),
+		},
+	}
+	template.Outputs[OutputKeyVpcId] = &Output{
+		Description: "The ID of the VPC in which the container instances run",
+		Value:       If("CreateVpcResources", Ref(VPCLogicalResourceId), Ref("VpcId")),
+	}
+	template.Outputs[OutputKeySubnetIds] = &Output{
+		Description: "Comma separated list of the IDs of the subnets in which the container instances run",
+		Value: If("CreateVpcResources", Join(",", []interface{}{
+			Ref(Subnet1LogicalResourceId),
+			Ref(Subnet2LogicalResourceId),
+		}), Join(",", Ref("SubnetIds"))),
+	}
+	template.Outputs["SharedVpcId"] = &Output{
+		Condition:   "ImportSharedVpc",
+		Description: "The ID of the VPC imported from the cluster stack whose VPC this cluster uses",
+		Value:       ImportValue(Ref("SharedVpcExportName")),
+	}
+}
+
+// addClusterInstances adds the container instances of the cluster and their Auto Scaling
+// group, which are not created for clusters that only run Fargate tasks.
+func addClusterInstances(template *Template) *clusterInstances {
+	template.Parameters["EcsAmiId"] = &Parameter{
+		Type:        "String",
+		Description: "ECS EC2 AMI id",
+		Default:     aws.String(""),
+	}
+	template.Parameters["EcsInstanceType"] = &Parameter{
+		Type:        "String",
+		Description: "ECS EC2 instance type",
+		Default:     aws.String(""),
+	}
+	template.Parameters["SpotPrice"] = &Parameter{
+		Type:        "Number",
+		Description: "If greater than 0, then a EC2 Spot instance will be requested",
+		Default:     aws.String("0"),
+	}
+	template.Parameters["KeyName"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - Name of an existing EC2 KeyPair to enable SSH access to the ECS instances",
+		Default:     aws.String(""),
+	}
+	template.Parameters["AsgMaxSize"] = &Parameter{
+		Type:        "Number",
+		Description: "Maximum size and initial Desired Capacity of ECS Auto Scaling Group",
+		Default:     aws.String("1"),
+	}
+	template.Parameters["AsgDesiredCapacity"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - Desired Capacity of ECS Auto Scaling Group, if different from its maximum size",
+		Default:     aws.String(""),
+	}
+	template.Parameters["SecurityGroupIds"] = &Parameter{
+		Type:        "CommaDelimitedList",
+		Description: "Optional - Existing security group to associate the container instances. Creates one by default.",
+		Default:     aws.String(""),
+	}
+	template.Parameters["SourceCidr"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - CIDR/IP range for EcsPort - defaults to 0.0.0.0/0",
+		Default:     aws.String("0.0.0.0/0"),
+	}
+	template.Parameters["EcsPort"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - Security Group port to open on ECS instances - defaults to port 80",
+		Default:     aws.String("80"),
+	}
+	template.Parameters["AssociatePublicIpAddress"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - Automatically assign public IP addresses to new instances in this VPC.",
+		Default:     aws.String("true"),
+	}
+	template.Parameters["InstanceRole"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - Instance IAM Role.",
+		Default:     aws.String(""),
+	}
+	template.Parameters["IsFargate"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - Whether to create resources only for running Fargate tasks.",
+		Default:     aws.String("false"),
+	}
+	template.Parameters["IsIMDSv2"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - Disable IMDSv1.",
+		Default:     aws.String("false"),
+	}
+	template.Parameters["MetadataHopLimit"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - Maximum number of network hops for instance metadata PUT responses. Containers using bridge networking need at least 2 to use IMDSv2.",
+		Default:     aws.String(""),
+	}
+	template.Parameters["InstanceMetadataTags"] = &Parameter{
+		Type:          "String",
+		Description:   "Optional - Whether to make the instance tags available in the instance metadata.",
+		Default:       aws.String("false"),
+		AllowedValues: []string{"true", "false"},
+	}
+	template.Parameters["LaunchTemplateVersion"] = &Parameter{
+		Type:                  "String",
+		Description:           "Optional - Version of the launch template used by the Auto Scaling group. Leave blank to use its latest version.",
+		Default:               aws.String(""),
+		AllowedPattern:        "^[0-9]*$",
+		ConstraintDescription: "Launch template version must be a version number, or leave blank to use the latest version",
+	}
+	template.Parameters["UserData"] = &Parameter{
+		Type:        "String",
+		Description: "User data for EC2 instances. Required for EC2 launch type, ignored with Fargate",
+		Default:     aws.String(""),
+	}
+	template.Parameters["DetailedMonitoring"] = &Parameter{
+		Type:          "String",
+		Description:   "Optional - Whether to enable detailed (1-minute) CloudWatch monitoring of the ECS instances.",
+		Default:       aws.String("false"),
+		AllowedValues: []string{"true", "false"},
+	}
+	template.Conditions["IsCNRegion"] = Or(Equals(Ref("AWS::Region"), "cn-north-1"), Equals(Ref("AWS::Region"), "cn-northwest-1"))
+	template.Conditions["LaunchInstances"] = Equals(Ref("IsFargate"), "false")
+	template.Conditions["EnableIMDSv2"] = Equals(Ref("IsIMDSv2"), "true")
+	template.Conditions["SetAsgDesiredCapacity"] = Not(Equals(Ref("AsgDesiredCapacity"), ""))
+	template.Conditions["UseMetadataHopLimit"] = Not(Equals(Ref("MetadataHopLimit"), ""))
+	template.Conditions["PinLaunchTemplateVersion"] = Not(Equals(Ref("LaunchTemplateVersion"), ""))
+	template.Conditions["EnableInstanceMetadataTags"] = Equals(Ref("InstanceMetadataTags"), "true")
+	template.Conditions["CustomizeMetadataOptions"] = Or(Condition("EnableIMDSv2"), Condition("UseMetadataHopLimit"), Condition("EnableInstanceMetadataTags"))
+	template.Conditions["CreateSecurityGroup"] = And(Condition("LaunchInstances"), Equals(Join("", Ref("SecurityGroupIds")), ""))
+	template.Conditions["CreateEC2LCWithKeyPair"] = And(Condition("LaunchInstances"), Not(Equals(Ref("KeyName"), "")))
+	template.Conditions["CreateEcsInstanceRole"] = And(Condition("LaunchInstances"), Equals(Ref("InstanceRole"), ""))
+	template.Conditions["UseSpotInstances"] = Not(Equals(Ref("SpotPrice"), 0))
+	template.Conditions["UseFirstSubnetOnly"] = Or(Equals(Ref("PlacementGroupStrategy"), "cluster"), Condition("CreateCapacityReservation"))
+	template.Resources[SecurityGroupLogicalResourceId] = &Resource{
+		Type:      "AWS::EC2::SecurityGroup",
+		Condition: "CreateSecurityGroup",
+		Properties: map[string]interface{}{
+			"GroupDescription": "ECS Allowed Ports",
+			"VpcId":            If("CreateVpcResources", Ref(VPCLogicalResourceId), Ref("VpcId")),
+			"SecurityGroupIngress": []interface{}{
+				map[string]interface{}{
+					"IpProtocol": "tcp",
+					"FromPort":   Ref("EcsPort"),
+					"ToPort":     Ref("EcsPort"),
+					"CidrIp":     Ref("SourceCidr"),
+				},
+			},
+		},
+	}
+	template.Resources["EcsInstanceRole"] = &Resource{
+		Type:      "AWS::IAM::Role",
+		Condition: "CreateEcsInstanceRole",
+		Properties: map[string]interface{}{
+			"AssumeRolePolicyDocument": map[string]interface{}{
+				"Version": "2012-10-17",
+				"Statement": []interface{}{
+					map[string]interface{}{
+						"Effect": "Allow",
+						"Principal": map[string]interface{}{
+							"Service": []interface{}{
+								If("IsCNRegion", "ec2.amazonaws.com.cn", "ec2.amazonaws.com"),
+							},
+						},
+						"Action": []interface{}{
+							"sts:AssumeRole",
+						},
+					},
+				},
+			},
+			"Path": "/",
+			"ManagedPolicyArns": []interface{}{
+				"arn:aws:iam::aws:policy/service-role/AmazonEC2ContainerServiceforEC2Role",
+			},
+		},
+	}
+	template.Resources["EcsInstanceProfile"] = &Resource{
+		Type:      "AWS::IAM::InstanceProfile",
+		Condition: "LaunchInstances",
+		Properties: map[string]interface{}{
+			"Path": "/",
+			"Roles": []interface{}{
+				If("CreateEcsInstanceRole", Ref("EcsInstanceRole"), Ref("InstanceRole")),
+			},
+		},
+	}
+	// Stacks created by older versions of the ECS CLI launch the instances from an
+	// AWS::AutoScaling::LaunchConfiguration instead, until 'ecs-cli scale' updates them to
+	// this template.
+	launchTemplateData := map[string]interface{}{
+		"ImageId":      Ref("EcsAmiId"),
+		"InstanceType": Ref("EcsInstanceType"),
+		"InstanceMarketOptions": If("UseSpotInstances", map[string]interface{}{
+			"MarketType": "spot",
+			"SpotOptions": map[string]interface{}{
+				"MaxPrice": Ref("SpotPrice"),
+			},
+		}, NoValue()),
+		"IamInstanceProfile": map[string]interface{}{
+			"Arn": GetAtt("EcsInstanceProfile", "Arn"),
+		},
+		"KeyName": If("CreateEC2LCWithKeyPair", Ref("KeyName"), NoValue()),
+		"MetadataOptions": If("CustomizeMetadataOptions", map[string]interface{}{
+			"HttpEndpoint":            "enabled",
+			"HttpTokens":              If("EnableIMDSv2", "required", "optional"),
+			"HttpPutResponseHopLimit": If("UseMetadataHopLimit", Ref("MetadataHopLimit"), NoValue()),
+			"InstanceMetadataTags":    If("EnableInstanceMetadataTags", "enabled", NoValue()),
+		}, NoValue()),
+		"Monitoring": map[string]interface{}{
+			"Enabled": Ref("DetailedMonitoring"),
+		},
+		"NetworkInterfaces": []interface{}{
+			map[string]interface{}{
+				"DeviceIndex":              0,
+				"AssociatePublicIpAddress": Ref("AssociatePublicIpAddress"),
+				"Groups": If("CreateSecurityGroup", []interface{}{
+					Ref(SecurityGroupLogicalResourceId),
+				}, Ref("SecurityGroupIds")),
+			},
+		},
+		"UserData": Base64(Ref("UserData")),
+	}
+	template.Resources["EcsInstanceLt"] = &Resource{
+		Type:      "AWS::EC2::LaunchTemplate",
+		Condition: "LaunchInstances",
+		Properties: map[string]interface{}{
+			"LaunchTemplateData": launchTemplateData,
+		},
+	}
+	autoScalingGroup := &Resource{
+		Type:      "AWS::AutoScaling::AutoScalingGroup",
+		Condition: "LaunchInstances",
+		Properties: map[string]interface{}{
+			"VPCZoneIdentifier": If("UseFirstSubnetOnly", If("CreateVpcResources", []interface{}{
+				Ref(Subnet1LogicalResourceId),
+			}, []interface{}{
+				Select("0", Ref("SubnetIds")),
+			}), If("CreateVpcResources", []interface{}{
+				Join(",", []interface{}{
+					Ref(Subnet1LogicalResourceId),
+					Ref(Subnet2LogicalResourceId),
+				}),
+			}, Ref("SubnetIds"))),
+			"LaunchTemplate": map[string]interface{}{
+				"LaunchTemplateId": Ref("EcsInstanceLt"),
+				"Version":          If("PinLaunchTemplateVersion", Ref("LaunchTemplateVersion"), GetAtt("EcsInstanceLt", "LatestVersionNumber")),
+			},
+			"MetricsCollection": []interface{}{
+				map[string]interface{}{
+					"Granularity": "1Minute",
+				},
+			},
+			"MinSize":         "0",
+			"MaxSize":         Ref("AsgMaxSize"),
+			"DesiredCapacity": If("SetAsgDesiredCapacity", Ref("AsgDesiredCapacity"), Ref("AsgMaxSize")),
+		},
+	}
+	template.Resources[AsgLogicalResourceId] = autoScalingGroup
+	template.Outputs[OutputKeySecurityGroupId] = &Output{
+		Condition:   "LaunchInstances",
+		Description: "Comma separated list of the IDs of the security groups associated with the container instances",
+		Value:       If("CreateSecurityGroup", Ref(SecurityGroupLogicalResourceId), Join(",", Ref("SecurityGroupIds"))),
+	}
+	template.Outputs[OutputKeyAsgName] = &Output{
+		Condition:   "LaunchInstances",
+		Description: "The name of the Auto Scaling group of the container instances",
+		Value:       Ref(AsgLogicalResourceId),
+	}
+	template.Outputs[OutputKeyInstanceRoleArn] = &Output{
+		Condition:   "LaunchInstances",
+		Description: "The ARN of the IAM role of the container instances",
+		Value:       If("CreateEcsInstanceRole", GetAtt("EcsInstanceRole", "Arn"), Sub("arn:${AWS::Partition}:iam::${AWS::AccountId}:role/${InstanceRole}")),
+	}
+	return &clusterInstances{
+		launchTemplateData: launchTemplateData,
+		autoScalingGroup:   autoScalingGroup,
+	}
+}
+
+// addCapacityReservation launches the container instances into an existing or a new capacity reservation.
+func addCapacityReservation(template *Template, instances *clusterInstances) {
+	template.Parameters["CapacityReservationId"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - ID of an existing EC2 capacity reservation to launch the ECS instances into.",
+		Default:     aws.String(""),
+	}
+	template.Parameters["CreateCapacityReservation"] = &Parameter{
+		Type:          "String",
+		Description:   "Optional - Whether to create an EC2 capacity reservation for AsgMaxSize ECS instances and launch them into it. The instances are launched in the first subnet.",
+		Default:       aws.String("false"),
+		AllowedValues: []string{"true", "false"},
+	}
+	template.Parameters["CapacityReservationAz"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - Availability zone of the first subnet, for the created capacity reservation. Required if setting SubnetIds.",
+		Default:     aws.String(""),
+	}
+	template.Conditions["TargetCapacityReservation"] = Not(Equals(Ref("CapacityReservationId"), ""))
+	template.Conditions["CreateCapacityReservation"] = And(Condition("LaunchInstances"), Equals(Ref("CreateCapacityReservation"), "true"))
+	template.Conditions["UseSpecifiedCapacityReservationAz"] = Not(Equals(Ref("CapacityReservationAz"), ""))
+	template.Resources["EcsCapacityReservation"] = &Resource{
+		Type:      "AWS::EC2::CapacityReservation",
+		Condition: "CreateCapacityReservation",
+		Properties: map[string]interface{}{
+			"AvailabilityZone":      If("UseSpecifiedCapacityReservationAz", Ref("CapacityReservationAz"), GetAtt(Subnet1LogicalResourceId, "AvailabilityZone")),
+			"InstanceType":          Ref("EcsInstanceType"),
+			"InstancePlatform":      "Linux/UNIX",
+			"Tenancy":               If("UseDedicatedTenancy", "dedicated", NoValue()),
+			"InstanceCount":         Ref("AsgMaxSize"),
+			"InstanceMatchCriteria": "open",
+			"EndDateType":           "unlimited",
+		},
+	}
+	instances.launchTemplateData["CapacityReservationSpecification"] = If("TargetCapacityReservation", map[string]interface{}{
+		"CapacityReservationTarget": map[string]interface{}{
+			"CapacityReservationId": Ref("CapacityReservationId"),
+		},
+	}, If("CreateCapacityReservation", map[string]interface{}{
+		"CapacityReservationTarget": map[string]interface{}{
+			"CapacityReservationId": Ref("EcsCapacityReservation"),
+		},
+	}, NoValue()))
+}
+
+// addInstancePlacement adds the placement group, tenancy and license configuration of the container instances.
+func addInstancePlacement(template *Template, instances *clusterInstances) {
+	template.Parameters["PlacementGroupStrategy"] = &Parameter{
+		Type:          "String",
+		Description:   "Optional - Strategy of a placement group to create and launch the ECS instances into. A cluster placement group launches all instances in the first subnet.",
+		Default:       aws.String(""),
+		AllowedValues: []string{"", "cluster", "spread"},
+	}
+	template.Parameters["Tenancy"] = &Parameter{
+		Type:          "String",
+		Description:   "Optional - Tenancy of the ECS instances. Leave blank to run on shared hardware.",
+		Default:       aws.String(""),
+		AllowedValues: []string{"", "dedicated", "host"},
+	}
+	template.Parameters["HostResourceGroupArn"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - ARN of the host resource group in which to launch the ECS instances. Requires host tenancy.",
+		Default:     aws.String(""),
+	}
+	template.Parameters["LicenseConfigurationArn"] = &Parameter{
+		Type:        "String",
+		Description: "Optional - ARN of the License Manager license configuration to associate with the ECS instances.",
+		Default:     aws.String(""),
+	}
+	template.Conditions["CreatePlacementGroup"] = And(Condition("LaunchInstances"), Not(Equals(Ref("PlacementGroupStrategy"), "")))
+	template.Conditions["UseSpecifiedTenancy"] = Not(Equals(Ref("Tenancy"), ""))
+	template.Conditions["UseDedicatedTenancy"] = Equals(Ref("Tenancy"), "dedicated")
+	template.Conditions["UseHostResourceGroup"] = Not(Equals(Ref("HostResourceGroupArn"), ""))
+	template.Conditions["UseLicenseConfiguration"] = Not(Equals(Ref("LicenseConfigurationArn"), ""))
+	template.Resources["EcsPlacementGroup"] = &Resource{
+		Type:      "AWS::EC2::PlacementGroup",
+		Condition: "CreatePlacementGroup",
+		Properties: map[string]interface{}{
+			"Strategy": Ref("PlacementGroupStrategy"),
+		},
+	}
+	instances.launchTemplateData["Placement"] = If("UseSpecifiedTenancy", map[string]interface{}{
+		"Tenancy":              Ref("Tenancy"),
+		"HostResourceGroupArn": If("UseHostResourceGroup", Ref("HostResourceGroupArn"), NoValue()),
+	}, NoValue())
+	instances.launchTemplateData["LicenseSpecifications"] = If("UseLicenseConfiguration", []interface{}{
+		map[string]interface{}{
+			"LicenseConfigurationArn": Ref("LicenseConfigurationArn"),
+		},
+	}, NoValue())
+	instances.autoScalingGroup.Properties["PlacementGroup"] = If("CreatePlacementGroup", Ref("EcsPlacementGroup"), NoValue())
+}
+
+// addCapacityProvider adds a capacity provider for the Auto Scaling group and makes it
+// the default capacity provider strategy of the cluster.
+func addCapacityProvider(template *Template, instances *clusterInstances) {
+	template.Parameters["CapacityProvider"] = &Parameter{
+		Type:          "String",
+		Description:   "Optional - Whether to create an ECS capacity provider for the Auto Scaling group and make it the default capacity provider of the cluster.",
+		Default:       aws.String("false"),
+		AllowedValues: []string{"true", "false"},
+	}
+	template.Parameters["ManagedScaling"] = &Parameter{
+		Type:          "String",
+		Description:   "Optional - Whether ECS manages the desired capacity of the Auto Scaling group of the capacity provider.",
+		Default:       aws.String("DISABLED"),
+		AllowedValues: []string{"ENABLED", "DISABLED"},
+	}
+	template.Parameters["ManagedTerminationProtection"] = &Parameter{
+		Type:          "String",
+		Description:   "Optional - Whether ECS prevents the container instances running tasks from being terminated by scale-in. Requires managed scaling.",
+		Default:       aws.String("DISABLED"),
+		AllowedValues: []string{"ENABLED", "DISABLED"},
+	}
+	template.Parameters["ManagedDraining"] = &Parameter{
+		Type:          "String",
+		Description:   "Optional - Whether ECS drains the container instances of the capacity provider before the Auto Scaling group terminates them. Leave blank to use the ECS default.",
+		Default:       aws.String(""),
+		AllowedValues: []string{"", "ENABLED", "DISABLED"},
+	}
+	template.Parameters["InstanceWarmupPeriod"] = &Parameter{
+		Type:                  "String",
+		Description:           "Optional - Number of seconds a new container instance of the capacity provider takes before it contributes to the metrics of managed scaling. Leave blank to use the ECS default.",
+		Default:               aws.String(""),
+		AllowedPattern:        "^[0-9]*$",
+		ConstraintDescription: "Instance warmup period must be a number of seconds, or leave blank to use the ECS default",
+	}
+	template.Conditions["CreateCapacityProvider"] = And(Condition("LaunchInstances"), Equals(Ref("CapacityProvider"), "true"))
+	template.Conditions["ProtectInstancesFromScaleIn"] = And(Condition("CreateCapacityProvider"), Equals(Ref("ManagedTerminationProtection"), "ENABLED"))
+	template.Conditions["SetManagedDraining"] = Not(Equals(Ref("ManagedDraining"), ""))
+	template.Conditions["SetInstanceWarmupPeriod"] = Not(Equals(Ref("InstanceWarmupPeriod"), ""))
+	template.Resources["EcsCapacityProvider"] = &Resource{
+		Type:      "AWS::ECS::CapacityProvider",
+		Condition: "CreateCapacityProvider",
+		Properties: map[string]interface{}{
+			"AutoScalingGroupProvider": map[string]interface{}{
+				"AutoScalingGroupArn": Ref(AsgLogicalResourceId),
+				"ManagedScaling": map[string]interface{}{
+					"Status":               Ref("ManagedScaling"),
+					"TargetCapacity":       100,
+					"InstanceWarmupPeriod": If("SetInstanceWarmupPeriod", Ref("InstanceWarmupPeriod"), NoValue()),
+				},
+				"ManagedTerminationProtection": Ref("ManagedTerminationProtection"),
+				"ManagedDraining":              If("SetManagedDraining", Ref("ManagedDraining"), NoValue()),
+			},
+		},
+	}
+	template.Resources["EcsCapacityProviderAssociation"] = &Resource{
+		Type:      "AWS::ECS::ClusterCapacityProviderAssociations",
+		Condition: "CreateCapacityProvider",
+		Properties: map[string]interface{}{
+			"Cluster": Ref("EcsCluster"),
+			"CapacityProviders": []interface{}{
+				Ref("EcsCapacityProvider"),
+			},
+			"DefaultCapacityProviderStrategy": []interface{}{
+				map[string]interface{}{
+					"CapacityProvider": Ref("EcsCapacityProvider"),
+					"Weight":           1,
+				},
+			},
+		},
+	}
+	instances.autoScalingGroup.Properties["NewInstancesProtectedFromScaleIn"] = If("ProtectInstancesFromScaleIn", true, NoValue())
+	template.Outputs[OutputKeyCapacityProviderName] = &Output{
+		Condition:   "CreateCapacityProvider",
+		Description: "The name of the capacity provider of the Auto Scaling group",
+		Value:       Ref("EcsCapacityProvider"),
+	}
+}
+
+// addTaskEventCapture captures the events of the stopped tasks of the cluster into a log group.
+func addTaskEventCapture(template *Template) {
+	template.Parameters["CaptureTaskEvents"] = &Parameter{
+		Type:          "String",
+		Description:   "Optional - Whether to capture the events of the tasks of the cluster which stopped into a CloudWatch Logs log group.",
+		Default:       aws.String("false"),
+		AllowedValues: []string{"true", "false"},
+	}
+	template.Conditions["EnableTaskEventCapture"] = Equals(Ref("CaptureTaskEvents"), "true")
+	template.Resources["TaskEventsLogGroup"] = &Resource{
+		Type:      "AWS::Logs::LogGroup",
+		Condition: "EnableTaskEventCapture",
+		Properties: map[string]interface{}{
+			"LogGroupName":    Sub("/aws/events/ecs/${EcsCluster}/stopped-tasks"),
+			"RetentionInDays": 90,
+		},
+	}
+	template.Resources["TaskEventsLogGroupPolicy"] = &Resource{
+		Type:      "AWS::Logs::ResourcePolicy",
+		Condition: "EnableTaskEventCapture",
+		Properties: map[string]interface{}{
+			"PolicyName":     Sub("${AWS::StackName}-task-events"),
+			"PolicyDocument": Sub(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["events.amazonaws.com","delivery.logs.amazonaws.com"]},"Action":["logs:CreateLogStream","logs:PutLogEvents"],"Resource":"${TaskEventsLogGroup.Arn}"}]}`),
+		},
+	}
+	template.Resources["TaskEventsRule"] = &Resource{
+		Type:      "AWS::Events::Rule",
+		Condition: "EnableTaskEventCapture",
+		DependsOn: "TaskEventsLogGroupPolicy",
+		Properties: map[string]interface{}{
+			"Description": Sub("Captures the events of the stopped tasks of the ${EcsCluster} ECS cluster"),
+			"EventPattern": map[string]interface{}{
+				"source": []interface{}{
+					"aws.ecs",
+				},
+				"detail-type": []interface{}{
+					"ECS Task State Change",
+				},
+				"detail": map[string]interface{}{
+					"clusterArn": []interface{}{
+						Sub("arn:${AWS::Partition}:ecs:${AWS::Region}:${AWS::AccountId}:cluster/${EcsCluster}"),
+					},
+					"lastStatus": []interface{}{
+						"STOPPED",
+					},
+				},
+			},
+			"Targets": []interface{}{
+				map[string]interface{}{
+					"Id":  "TaskEventsLogGroup",
+					"Arn": GetAtt("TaskEventsLogGroup", "Arn"),
+				},
+			},
+		},
+	}
+	template.Outputs[OutputKeyTaskEventsLogGroup] = &Output{
+		Condition:   "EnableTaskEventCapture",
+		Description: "The name of the CloudWatch Logs log group capturing the events of the stopped tasks",
+		Value:       Ref("TaskEventsLogGroup"),
+	}
 }
-`
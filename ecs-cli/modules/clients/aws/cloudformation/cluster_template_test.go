@@ -0,0 +1,171 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterTemplateReferences(t *testing.T) {
+	template, err := NewClusterTemplate(nil, "amazon-ecs-cli-setup-dev")
+	require.NoError(t, err, "Unexpected error building cluster template")
+
+	for _, logicalID := range append(taggedLogicalResourceIds, AsgLogicalResourceId) {
+		assert.Contains(t, template.Resources, logicalID, "Expected tagged resource to exist")
+	}
+	for _, outputKey := range StackOutputKeys {
+		assert.Contains(t, template.Outputs, outputKey, "Expected output to exist")
+	}
+	for name, resource := range template.Resources {
+		if resource.Condition != "" {
+			assert.Contains(t, template.Conditions, resource.Condition, "Expected condition of resource %s to exist", name)
+		}
+	}
+
+	// Round trip through JSON, so that the intrinsic functions built in code are walked as parsed
+	body, err := template.String()
+	require.NoError(t, err, "Unexpected error rendering cluster template")
+	var document map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(body), &document), "Expected cluster template to be valid JSON")
+	walkIntrinsics(document, func(function string, args interface{}) {
+		switch function {
+		case "Ref":
+			name := args.(string)
+			if strings.HasPrefix(name, "AWS::") {
+				return
+			}
+			_, isParameter := template.Parameters[name]
+			_, isResource := template.Resources[name]
+			assert.True(t, isParameter || isResource, "Expected %s to be a parameter or a resource", name)
+		case "Fn::GetAtt":
+			assert.Contains(t, template.Resources, args.([]interface{})[0], "Expected resource of Fn::GetAtt to exist")
+		case "Fn::If":
+			assert.Contains(t, template.Conditions, args.([]interface{})[0], "Expected condition of Fn::If to exist")
+		case "Condition":
+			if name, ok := args.(string); ok {
+				assert.Contains(t, template.Conditions, name, "Expected condition to exist")
+			}
+		}
+	})
+}
+
+// walkIntrinsics calls fn with the name and the arguments of each single-key object of the document.
+func walkIntrinsics(value interface{}, fn func(function string, args interface{})) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if len(v) == 1 {
+				fn(key, child)
+			}
+			walkIntrinsics(child, fn)
+		}
+	case []interface{}:
+		for _, child := range v {
+			walkIntrinsics(child, fn)
+		}
+	}
+}
+
+func TestNewClusterTemplate(t *testing.T) {
+	tags := []*ecs.Tag{
+		{Key: aws.String("team"), Value: aws.String("platform")},
+	}
+
+	template, err := NewClusterTemplate(tags, "amazon-ecs-cli-setup-dev")
+	require.NoError(t, err, "Unexpected error building cluster template")
+
+	for _, logicalID := range taggedLogicalResourceIds {
+		assert.Equal(t, tags, template.Resources[logicalID].Properties["Tags"], "Expected tags of resource %s to match", logicalID)
+	}
+	assert.Equal(t, []autoscalingTag{
+		{Key: "team", Value: "platform", PropagateAtLaunch: true},
+		{Key: "Name", Value: "ECS Instance - amazon-ecs-cli-setup-dev", PropagateAtLaunch: true},
+	}, template.Resources[AsgLogicalResourceId].Properties["Tags"], "Expected Auto Scaling group tags to match")
+
+	for _, outputKey := range ExportedOutputKeys {
+		require.NotNil(t, template.Outputs[outputKey].Export, "Expected output %s to be exported", outputKey)
+		assert.Equal(t, Sub("${AWS::StackName}-"+outputKey), template.Outputs[outputKey].Export.Name, "Expected export name to match")
+	}
+	assert.Nil(t, template.Outputs[OutputKeyAsgName].Export, "Expected AsgName output not to be exported")
+
+	body, err := template.String()
+	require.NoError(t, err, "Unexpected error rendering cluster template")
+	_, err = ParseTemplate(body)
+	assert.NoError(t, err, "Expected rendered cluster template to be valid JSON")
+}
+
+func TestAddScheduledActions(t *testing.T) {
+	template, err := NewClusterTemplate(nil, "amazon-ecs-cli-setup-dev")
+	require.NoError(t, err, "Unexpected error building cluster template")
+
+	AddScheduledActions(template, []*ScheduledAction{
+		{Recurrence: "0 8 * * MON-FRI", DesiredCapacity: 5},
+		{Recurrence: "0 20 * * *", DesiredCapacity: 0},
+	})
+
+	assert.Equal(t, &Resource{
+		Type:      "AWS::AutoScaling::ScheduledAction",
+		Condition: "LaunchInstances",
+		Properties: map[string]interface{}{
+			"AutoScalingGroupName": map[string]interface{}{"Ref": AsgLogicalResourceId},
+			"DesiredCapacity":      int64(5),
+			"Recurrence":           "0 8 * * MON-FRI",
+		},
+	}, template.Resources["EcsInstanceAsgScheduledAction1"])
+	assert.Equal(t, int64(0), template.Resources["EcsInstanceAsgScheduledAction2"].Properties["DesiredCapacity"])
+}
+
+func TestTemplateFormat(t *testing.T) {
+	template, err := ParseTemplate(`{"Parameters": {"IsIMDSv2": {"Type": "String", "Default": "false"}}, "Resources": {"Vpc": {"Type": "AWS::EC2::VPC", "Properties": {"Tags": [{"Key": "Name", "Value": {"Fn::Sub": "${AWS::StackName}-vpc"}}]}}}}`)
+	require.NoError(t, err, "Unexpected error parsing template")
+
+	body, err := template.Format(TemplateFormatYAML)
+	assert.NoError(t, err, "Unexpected error rendering template as YAML")
+	assert.Equal(t, `Parameters:
+  IsIMDSv2:
+    Type: String
+    Default: "false"
+Resources:
+  Vpc:
+    Type: AWS::EC2::VPC
+    Properties:
+      Tags:
+      - Key: Name
+        Value:
+          Fn::Sub: ${AWS::StackName}-vpc
+`, body)
+
+	body, err = template.Format(TemplateFormatJSON)
+	assert.NoError(t, err, "Unexpected error rendering template as JSON")
+	assert.True(t, strings.HasPrefix(body, "{\n  \"Parameters\""), "Expected template to be rendered as JSON")
+
+	_, err = template.Format("xml")
+	assert.Error(t, err, "Expected error rendering template in an unsupported format")
+}
+
+func TestParseTemplateWithTrailingComma(t *testing.T) {
+	_, err := ParseTemplate(`{"Resources": {"Vpc": {"Type": "AWS::EC2::VPC",}}}`)
+	assert.Error(t, err, "Expected error parsing template with a trailing comma")
+}
+
+func TestExportName(t *testing.T) {
+	assert.Equal(t, "amazon-ecs-cli-setup-dev-VpcId", ExportName("amazon-ecs-cli-setup-dev", OutputKeyVpcId))
+}
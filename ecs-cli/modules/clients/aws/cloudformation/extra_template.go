@@ -0,0 +1,291 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// shortFormFunctionPattern matches the YAML short form of intrinsic functions (e.g. !Ref), which
+// the YAML parser would silently drop
+var shortFormFunctionPattern = regexp.MustCompile(`(^|[\s\[{,:-])!(Ref|GetAtt|Sub|Join|Select|Split|If|Equals|And|Or|Not|FindInMap|GetAZs|ImportValue|Base64|Cidr|Condition)\b`)
+
+// subVariablePattern matches the variables of an Fn::Sub string; ${!Literal} is not a variable
+var subVariablePattern = regexp.MustCompile(`\$\{([^!}][^}]*)\}`)
+
+// ParseExtraTemplate parses a CloudFormation template in JSON or YAML format, whose resources are
+// added to the cluster stack. Intrinsic functions must be written in their full form in YAML.
+func ParseExtraTemplate(body []byte) (*Template, error) {
+	body = bytes.TrimSpace(body)
+	if !bytes.HasPrefix(body, []byte("{")) {
+		if match := shortFormFunctionPattern.FindSubmatch(body); match != nil {
+			return nil, fmt.Errorf("Error parsing extra template: the short form of intrinsic functions (!%s) is not supported; use the full form instead (e.g. 'Ref:' or 'Fn::GetAtt:')", match[2])
+		}
+		var document interface{}
+		if err := yaml.Unmarshal(body, &document); err != nil {
+			return nil, fmt.Errorf("Error parsing extra template: %v", err)
+		}
+		converted, err := json.Marshal(stringKeys(document))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing extra template: %v", err)
+		}
+		body = converted
+	}
+
+	template := &Template{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(template); err != nil {
+		return nil, fmt.Errorf("Error parsing extra template: %v", err)
+	}
+	if len(template.Resources) == 0 {
+		return nil, fmt.Errorf("The extra template has no resources")
+	}
+	return template, nil
+}
+
+// stringKeys converts the maps decoded from YAML, which have interface{} keys, to maps with string
+// keys so that they can be encoded to JSON.
+func stringKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[fmt.Sprintf("%v", key)] = stringKeys(item)
+		}
+		return converted
+	case []interface{}:
+		for i, item := range v {
+			v[i] = stringKeys(item)
+		}
+	}
+	return value
+}
+
+// AddExtraTemplate adds the resources, conditions, mappings and outputs of the extra template to the
+// cluster template, so that they are created and deleted with the cluster stack. The parameters of the
+// extra template reference the outputs of the cluster stack by key (e.g. VpcId) and are replaced by
+// their values. Resources and outputs that use an output which is only created under a condition
+// (e.g. the Auto Scaling group, which is not created for the FARGATE launch type) get that condition.
+func AddExtraTemplate(template, extra *Template) error {
+	values := make(map[string]interface{})
+	conditions := make(map[string]string)
+	for name, param := range extra.Parameters {
+		output, ok := template.Outputs[name]
+		if !ok || !isStackOutputKey(name) {
+			return fmt.Errorf("Parameter %s of the extra template is not an output of the cluster stack; valid parameters are %s", name, strings.Join(StackOutputKeys, ", "))
+		}
+		value := output.Value
+		if param.Type == "CommaDelimitedList" || strings.HasPrefix(param.Type, "List<") {
+			value = map[string]interface{}{"Fn::Split": []interface{}{",", value}}
+		}
+		values[name] = value
+		if output.Condition != "" {
+			conditions[name] = output.Condition
+		}
+	}
+
+	if err := checkLogicalIDConflicts("Resource", resourceIDs(template.Resources), resourceIDs(extra.Resources)); err != nil {
+		return err
+	}
+	if err := checkLogicalIDConflicts("Condition", mapKeys(template.Conditions), mapKeys(extra.Conditions)); err != nil {
+		return err
+	}
+	if err := checkLogicalIDConflicts("Mapping", mapKeys(template.Mappings), mapKeys(extra.Mappings)); err != nil {
+		return err
+	}
+	if err := checkLogicalIDConflicts("Output", outputIDs(template.Outputs), outputIDs(extra.Outputs)); err != nil {
+		return err
+	}
+
+	for logicalID, resource := range extra.Resources {
+		used := make(map[string]bool)
+		properties := substituteParameters(resource.Properties, values, used)
+		resource.Properties, _ = properties.(map[string]interface{})
+		condition, err := conditionOf("Resource "+logicalID, resource.Condition, used, conditions)
+		if err != nil {
+			return err
+		}
+		resource.Condition = condition
+		template.Resources[logicalID] = resource
+	}
+	for key, output := range extra.Outputs {
+		used := make(map[string]bool)
+		output.Value = substituteParameters(output.Value, values, used)
+		condition, err := conditionOf("Output "+key, output.Condition, used, conditions)
+		if err != nil {
+			return err
+		}
+		output.Condition = condition
+		if template.Outputs == nil {
+			template.Outputs = make(map[string]*Output)
+		}
+		template.Outputs[key] = output
+	}
+	for name, condition := range extra.Conditions {
+		if template.Conditions == nil {
+			template.Conditions = make(map[string]interface{})
+		}
+		template.Conditions[name] = condition
+	}
+	for name, mapping := range extra.Mappings {
+		if template.Mappings == nil {
+			template.Mappings = make(map[string]interface{})
+		}
+		template.Mappings[name] = mapping
+	}
+	return nil
+}
+
+// substituteParameters replaces the references to the parameters in the value, and records the
+// parameters it used.
+func substituteParameters(value interface{}, values map[string]interface{}, used map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["Ref"].(string); ok && len(v) == 1 {
+			if replacement, ok := values[ref]; ok {
+				used[ref] = true
+				return replacement
+			}
+			return v
+		}
+		if sub, ok := v["Fn::Sub"]; ok && len(v) == 1 {
+			return map[string]interface{}{"Fn::Sub": substituteSubParameters(sub, values, used)}
+		}
+		for key, item := range v {
+			v[key] = substituteParameters(item, values, used)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = substituteParameters(item, values, used)
+		}
+	}
+	return value
+}
+
+// substituteSubParameters passes the values of the parameters used by an Fn::Sub string in its
+// variable map, converting it to the [string, map] form if needed.
+func substituteSubParameters(sub interface{}, values map[string]interface{}, used map[string]bool) interface{} {
+	var str string
+	variables := make(map[string]interface{})
+	switch s := sub.(type) {
+	case string:
+		str = s
+	case []interface{}:
+		if len(s) != 2 {
+			return sub
+		}
+		var ok bool
+		if str, ok = s[0].(string); !ok {
+			return sub
+		}
+		existing, ok := s[1].(map[string]interface{})
+		if !ok {
+			return sub
+		}
+		for name, value := range existing {
+			variables[name] = substituteParameters(value, values, used)
+		}
+	default:
+		return sub
+	}
+
+	substituted := false
+	for _, match := range subVariablePattern.FindAllStringSubmatch(str, -1) {
+		name := match[1]
+		if _, defined := variables[name]; defined {
+			continue
+		}
+		if value, ok := values[name]; ok {
+			variables[name] = value
+			used[name] = true
+			substituted = true
+		}
+	}
+	if !substituted {
+		if _, ok := sub.(string); ok {
+			return sub
+		}
+	}
+	return []interface{}{str, variables}
+}
+
+// conditionOf returns the condition of an element of the extra template that uses the given parameters.
+func conditionOf(element, condition string, used map[string]bool, conditions map[string]string) (string, error) {
+	var names []string
+	for name := range used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		outputCondition, ok := conditions[name]
+		if !ok {
+			continue
+		}
+		if condition == "" {
+			condition = outputCondition
+		} else if condition != outputCondition {
+			return "", fmt.Errorf("%s of the extra template has condition %s, but uses the %s output of the cluster stack, which only exists under condition %s", element, condition, name, outputCondition)
+		}
+	}
+	return condition, nil
+}
+
+func checkLogicalIDConflicts(kind string, existing, added []string) error {
+	for _, id := range added {
+		if utils.InSlice(id, existing) {
+			return fmt.Errorf("%s %s of the extra template conflicts with the cluster template; rename it", kind, id)
+		}
+	}
+	return nil
+}
+
+func isStackOutputKey(key string) bool {
+	return utils.InSlice(key, StackOutputKeys)
+}
+
+func resourceIDs(resources map[string]*Resource) []string {
+	var ids []string
+	for id := range resources {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func outputIDs(outputs map[string]*Output) []string {
+	var ids []string
+	for id := range outputs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func mapKeys(m map[string]interface{}) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,135 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const extraTemplateYAML = `
+Parameters:
+  VpcId:
+    Type: AWS::EC2::VPC::Id
+  SubnetIds:
+    Type: List<AWS::EC2::Subnet::Id>
+  AsgName:
+    Type: String
+Resources:
+  RedisSecurityGroup:
+    Type: AWS::EC2::SecurityGroup
+    Properties:
+      GroupDescription:
+        Fn::Sub: Redis for ${AWS::StackName} in ${VpcId}
+      VpcId:
+        Ref: VpcId
+  RedisSubnetGroup:
+    Type: AWS::ElastiCache::SubnetGroup
+    DependsOn:
+      - RedisSecurityGroup
+    Properties:
+      Description: Redis subnets
+      SubnetIds:
+        Ref: SubnetIds
+  AsgNotifications:
+    Type: AWS::SNS::Topic
+    Properties:
+      DisplayName:
+        Ref: AsgName
+Outputs:
+  RedisSecurityGroupId:
+    Value:
+      Ref: RedisSecurityGroup
+`
+
+func TestAddExtraTemplate(t *testing.T) {
+	template, err := NewClusterTemplate(nil, "amazon-ecs-cli-setup-cluster")
+	require.NoError(t, err, "Unexpected error building the cluster template")
+	extra, err := ParseExtraTemplate([]byte(extraTemplateYAML))
+	require.NoError(t, err, "Unexpected error parsing the extra template")
+
+	err = AddExtraTemplate(template, extra)
+	require.NoError(t, err, "Unexpected error adding the extra template")
+
+	securityGroup, err := template.Resource("RedisSecurityGroup")
+	require.NoError(t, err, "Expected the extra resource to be added")
+	assert.Equal(t, template.Outputs[OutputKeyVpcId].Value, securityGroup.Properties["VpcId"], "Expected the parameter to be replaced by the output value")
+	assert.Equal(t, map[string]interface{}{
+		"Fn::Sub": []interface{}{
+			"Redis for ${AWS::StackName} in ${VpcId}",
+			map[string]interface{}{"VpcId": template.Outputs[OutputKeyVpcId].Value},
+		},
+	}, securityGroup.Properties["GroupDescription"], "Expected the parameter to be passed to Fn::Sub")
+	assert.Empty(t, securityGroup.Condition, "Expected no condition for a resource using an unconditional output")
+
+	subnetGroup, err := template.Resource("RedisSubnetGroup")
+	require.NoError(t, err, "Expected the extra resource to be added")
+	assert.Equal(t, map[string]interface{}{
+		"Fn::Split": []interface{}{",", template.Outputs[OutputKeySubnetIds].Value},
+	}, subnetGroup.Properties["SubnetIds"], "Expected a list parameter to be split")
+	assert.Equal(t, []interface{}{"RedisSecurityGroup"}, subnetGroup.DependsOn)
+
+	topic, err := template.Resource("AsgNotifications")
+	require.NoError(t, err, "Expected the extra resource to be added")
+	assert.Equal(t, template.Outputs[OutputKeyAsgName].Condition, topic.Condition, "Expected the condition of the output to be applied")
+
+	assert.Contains(t, template.Outputs, "RedisSecurityGroupId")
+	_, err = template.String()
+	assert.NoError(t, err, "Unexpected error rendering the merged template")
+}
+
+func TestParseExtraTemplateJSON(t *testing.T) {
+	extra, err := ParseExtraTemplate([]byte(`{"Resources": {"Bastion": {"Type": "AWS::EC2::Instance", "DeletionPolicy": "Retain"}}}`))
+	require.NoError(t, err, "Unexpected error parsing the extra template")
+	assert.Equal(t, "Retain", extra.Resources["Bastion"].DeletionPolicy)
+}
+
+func TestParseExtraTemplateErrors(t *testing.T) {
+	testCases := map[string]string{
+		"short form function": "Resources:\n  Topic:\n    Type: AWS::SNS::Topic\n    Properties:\n      DisplayName: !Ref AWS::StackName\n",
+		"transform":           "Transform: AWS::Serverless-2016-10-31\nResources:\n  Topic:\n    Type: AWS::SNS::Topic\n",
+		"no resources":        "Outputs: {}\n",
+		"invalid YAML":        "Resources: [\n",
+	}
+	for name, body := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseExtraTemplate([]byte(body))
+			assert.Error(t, err, "Expected error parsing the extra template")
+		})
+	}
+}
+
+func TestAddExtraTemplateErrors(t *testing.T) {
+	testCases := map[string]string{
+		"unknown parameter":  `{"Parameters": {"KeyName": {"Type": "String"}}, "Resources": {"Topic": {"Type": "AWS::SNS::Topic"}}}`,
+		"resource conflict":  `{"Resources": {"Vpc": {"Type": "AWS::EC2::VPC"}}}`,
+		"output conflict":    `{"Resources": {"Topic": {"Type": "AWS::SNS::Topic"}}, "Outputs": {"VpcId": {"Value": "vpc"}}}`,
+		"condition mismatch": `{"Parameters": {"AsgName": {"Type": "String"}}, "Conditions": {"Enabled": {"Fn::Equals": ["a", "a"]}}, "Resources": {"Topic": {"Type": "AWS::SNS::Topic", "Condition": "Enabled", "Properties": {"DisplayName": {"Ref": "AsgName"}}}}}`,
+		"condition conflict": `{"Conditions": {"LaunchInstances": {"Fn::Equals": ["a", "a"]}}, "Resources": {"Topic": {"Type": "AWS::SNS::Topic"}}}`,
+		"unexported output":  `{"Parameters": {"SharedVpcId": {"Type": "String"}}, "Resources": {"Topic": {"Type": "AWS::SNS::Topic"}}}`,
+	}
+	for name, body := range testCases {
+		t.Run(name, func(t *testing.T) {
+			template, err := NewClusterTemplate(nil, "amazon-ecs-cli-setup-cluster")
+			require.NoError(t, err, "Unexpected error building the cluster template")
+			extra, err := ParseExtraTemplate([]byte(body))
+			require.NoError(t, err, "Unexpected error parsing the extra template")
+
+			err = AddExtraTemplate(template, extra)
+			assert.Error(t, err, "Expected error adding the extra template")
+		})
+	}
+}
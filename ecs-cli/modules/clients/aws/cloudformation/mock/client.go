@@ -48,8 +48,37 @@ func (m *MockCloudformationClient) EXPECT() *MockCloudformationClientMockRecorde
 	return m.recorder
 }
 
+// CancelUpdateStack mocks base method
+func (m *MockCloudformationClient) CancelUpdateStack(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUpdateStack", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelUpdateStack indicates an expected call of CancelUpdateStack
+func (mr *MockCloudformationClientMockRecorder) CancelUpdateStack(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUpdateStack", reflect.TypeOf((*MockCloudformationClient)(nil).CancelUpdateStack), arg0)
+}
+
+// CreateChangeSet mocks base method
+func (m *MockCloudformationClient) CreateChangeSet(arg0 string, arg1 string, arg2 *cloudformation.CfnStackParams) ([]*cloudformation0.Change, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChangeSet", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*cloudformation0.Change)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChangeSet indicates an expected call of CreateChangeSet
+func (mr *MockCloudformationClientMockRecorder) CreateChangeSet(arg0 interface{}, arg1 interface{}, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeSet", reflect.TypeOf((*MockCloudformationClient)(nil).CreateChangeSet), arg0, arg1, arg2)
+}
+
 // CreateStack mocks base method
-func (m *MockCloudformationClient) CreateStack(arg0, arg1 string, arg2 bool, arg3 *cloudformation.CfnStackParams, arg4 []*cloudformation0.Tag) (string, error) {
+func (m *MockCloudformationClient) CreateStack(arg0, arg1 string, arg2 []string, arg3 *cloudformation.CfnStackParams, arg4 []*cloudformation0.Tag) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateStack", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(string)
@@ -63,6 +92,20 @@ func (mr *MockCloudformationClientMockRecorder) CreateStack(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStack", reflect.TypeOf((*MockCloudformationClient)(nil).CreateStack), arg0, arg1, arg2, arg3, arg4)
 }
 
+// DeleteChangeSet mocks base method
+func (m *MockCloudformationClient) DeleteChangeSet(arg0 string, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MockCloudformationClientMockRecorder) DeleteChangeSet(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockCloudformationClient)(nil).DeleteChangeSet), arg0, arg1)
+}
+
 // DeleteStack mocks base method
 func (m *MockCloudformationClient) DeleteStack(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -106,6 +149,70 @@ func (mr *MockCloudformationClientMockRecorder) DescribeStacks(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStacks", reflect.TypeOf((*MockCloudformationClient)(nil).DescribeStacks), arg0)
 }
 
+// DescribeStacksWithNamePrefix mocks base method
+func (m *MockCloudformationClient) DescribeStacksWithNamePrefix(arg0 ...string) ([]*cloudformation0.Stack, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeStacksWithNamePrefix", varargs...)
+	ret0, _ := ret[0].([]*cloudformation0.Stack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStacksWithNamePrefix indicates an expected call of DescribeStacksWithNamePrefix
+func (mr *MockCloudformationClientMockRecorder) DescribeStacksWithNamePrefix(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{}, arg0...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStacksWithNamePrefix", reflect.TypeOf((*MockCloudformationClient)(nil).DescribeStacksWithNamePrefix), varargs...)
+}
+
+// ExecuteChangeSet mocks base method
+func (m *MockCloudformationClient) ExecuteChangeSet(arg0 string, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MockCloudformationClientMockRecorder) ExecuteChangeSet(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockCloudformationClient)(nil).ExecuteChangeSet), arg0, arg1)
+}
+
+// GetStackExportNames mocks base method
+func (m *MockCloudformationClient) GetStackExportNames(arg0 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStackExportNames", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStackExportNames indicates an expected call of GetStackExportNames
+func (mr *MockCloudformationClientMockRecorder) GetStackExportNames(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStackExportNames", reflect.TypeOf((*MockCloudformationClient)(nil).GetStackExportNames), arg0)
+}
+
+// GetStackOutputs mocks base method
+func (m *MockCloudformationClient) GetStackOutputs(arg0 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStackOutputs", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStackOutputs indicates an expected call of GetStackOutputs
+func (mr *MockCloudformationClientMockRecorder) GetStackOutputs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStackOutputs", reflect.TypeOf((*MockCloudformationClient)(nil).GetStackOutputs), arg0)
+}
+
 // GetStackParameters mocks base method
 func (m *MockCloudformationClient) GetStackParameters(arg0 string) ([]*cloudformation0.Parameter, error) {
 	m.ctrl.T.Helper()
@@ -121,6 +228,51 @@ func (mr *MockCloudformationClientMockRecorder) GetStackParameters(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStackParameters", reflect.TypeOf((*MockCloudformationClient)(nil).GetStackParameters), arg0)
 }
 
+// GetStackResourceIds mocks base method
+func (m *MockCloudformationClient) GetStackResourceIds(arg0 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStackResourceIds", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStackResourceIds indicates an expected call of GetStackResourceIds
+func (mr *MockCloudformationClientMockRecorder) GetStackResourceIds(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStackResourceIds", reflect.TypeOf((*MockCloudformationClient)(nil).GetStackResourceIds), arg0)
+}
+
+// GetStackResources mocks base method
+func (m *MockCloudformationClient) GetStackResources(arg0 string) ([]*cloudformation0.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStackResources", arg0)
+	ret0, _ := ret[0].([]*cloudformation0.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStackResources indicates an expected call of GetStackResources
+func (mr *MockCloudformationClientMockRecorder) GetStackResources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStackResources", reflect.TypeOf((*MockCloudformationClient)(nil).GetStackResources), arg0)
+}
+
+// ListImports mocks base method
+func (m *MockCloudformationClient) ListImports(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImports", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImports indicates an expected call of ListImports
+func (mr *MockCloudformationClientMockRecorder) ListImports(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImports", reflect.TypeOf((*MockCloudformationClient)(nil).ListImports), arg0)
+}
+
 // UpdateStack mocks base method
 func (m *MockCloudformationClient) UpdateStack(arg0 string, arg1 *cloudformation.CfnStackParams) (string, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +288,21 @@ func (mr *MockCloudformationClientMockRecorder) UpdateStack(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStack", reflect.TypeOf((*MockCloudformationClient)(nil).UpdateStack), arg0, arg1)
 }
 
+// UpdateStackWithTemplate mocks base method
+func (m *MockCloudformationClient) UpdateStackWithTemplate(arg0, arg1 string, arg2 *cloudformation.CfnStackParams) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStackWithTemplate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStackWithTemplate indicates an expected call of UpdateStackWithTemplate
+func (mr *MockCloudformationClientMockRecorder) UpdateStackWithTemplate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStackWithTemplate", reflect.TypeOf((*MockCloudformationClient)(nil).UpdateStackWithTemplate), arg0, arg1, arg2)
+}
+
 // ValidateStackExists mocks base method
 func (m *MockCloudformationClient) ValidateStackExists(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -150,6 +317,20 @@ func (mr *MockCloudformationClientMockRecorder) ValidateStackExists(arg0 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateStackExists", reflect.TypeOf((*MockCloudformationClient)(nil).ValidateStackExists), arg0)
 }
 
+// ValidateTemplate mocks base method
+func (m *MockCloudformationClient) ValidateTemplate(arg0 string, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateTemplate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateTemplate indicates an expected call of ValidateTemplate
+func (mr *MockCloudformationClientMockRecorder) ValidateTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateTemplate", reflect.TypeOf((*MockCloudformationClient)(nil).ValidateTemplate), arg0, arg1)
+}
+
 // WaitUntilCreateComplete mocks base method
 func (m *MockCloudformationClient) WaitUntilCreateComplete(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,225 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	// TemplateFormatJSON renders the template in JSON format
+	TemplateFormatJSON = "json"
+	// TemplateFormatYAML renders the template in YAML format
+	TemplateFormatYAML = "yaml"
+)
+
+// Template is a CloudFormation template. Intrinsic functions and resource properties
+// are kept as generic JSON values, so that any resource can be represented.
+type Template struct {
+	AWSTemplateFormatVersion string                 `json:"AWSTemplateFormatVersion,omitempty"`
+	Description              string                 `json:"Description,omitempty"`
+	Mappings                 map[string]interface{} `json:"Mappings,omitempty"`
+	Parameters               map[string]*Parameter  `json:"Parameters,omitempty"`
+	Conditions               map[string]interface{} `json:"Conditions,omitempty"`
+	Resources                map[string]*Resource   `json:"Resources"`
+	Outputs                  map[string]*Output     `json:"Outputs,omitempty"`
+}
+
+// Parameter is a parameter of a CloudFormation template
+type Parameter struct {
+	Type                  string   `json:"Type"`
+	Description           string   `json:"Description,omitempty"`
+	Default               *string  `json:"Default,omitempty"`
+	AllowedValues         []string `json:"AllowedValues,omitempty"`
+	AllowedPattern        string   `json:"AllowedPattern,omitempty"`
+	ConstraintDescription string   `json:"ConstraintDescription,omitempty"`
+}
+
+// Resource is a resource of a CloudFormation template
+type Resource struct {
+	Type      string `json:"Type"`
+	Condition string `json:"Condition,omitempty"`
+	// DependsOn is either a logical ID or a list of logical IDs
+	DependsOn           interface{}            `json:"DependsOn,omitempty"`
+	DeletionPolicy      string                 `json:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy string                 `json:"UpdateReplacePolicy,omitempty"`
+	Metadata            map[string]interface{} `json:"Metadata,omitempty"`
+	Properties          map[string]interface{} `json:"Properties,omitempty"`
+}
+
+// Output is an output of a CloudFormation template
+type Output struct {
+	Condition   string      `json:"Condition,omitempty"`
+	Description string      `json:"Description,omitempty"`
+	Value       interface{} `json:"Value"`
+	Export      *Export     `json:"Export,omitempty"`
+}
+
+// Export makes an output available to other stacks through Fn::ImportValue
+type Export struct {
+	Name interface{} `json:"Name"`
+}
+
+// ParseTemplate parses a CloudFormation template in JSON format.
+func ParseTemplate(body string) (*Template, error) {
+	template := &Template{}
+	if err := json.Unmarshal([]byte(body), template); err != nil {
+		return nil, fmt.Errorf("Error parsing CloudFormation template: %v", err)
+	}
+	return template, nil
+}
+
+// String returns the template in JSON format.
+func (t *Template) String() (string, error) {
+	body, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// YAML returns the template in YAML format. Intrinsic functions are kept in their
+// full form (e.g. Fn::Sub), since the short form tags cannot be produced from JSON.
+func (t *Template) YAML() (string, error) {
+	body, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	// JSON is valid YAML; unmarshalling into a MapSlice keeps the order of the keys
+	var document yaml.MapSlice
+	if err := yaml.Unmarshal(body, &document); err != nil {
+		return "", err
+	}
+	out, err := yaml.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// Format returns the template in the given format, either json or yaml.
+func (t *Template) Format(format string) (string, error) {
+	switch format {
+	case TemplateFormatJSON, "":
+		return t.String()
+	case TemplateFormatYAML:
+		return t.YAML()
+	}
+	return "", fmt.Errorf("Unsupported template format '%s'; must be one of: %s, %s", format, TemplateFormatJSON, TemplateFormatYAML)
+}
+
+// Resource returns the resource with the given logical ID.
+func (t *Template) Resource(logicalID string) (*Resource, error) {
+	resource, ok := t.Resources[logicalID]
+	if !ok {
+		return nil, fmt.Errorf("Resource %s not found in CloudFormation template", logicalID)
+	}
+	return resource, nil
+}
+
+// SetProperty sets a property of the resource with the given logical ID.
+func (t *Template) SetProperty(logicalID, property string, value interface{}) error {
+	resource, err := t.Resource(logicalID)
+	if err != nil {
+		return err
+	}
+	if resource.Properties == nil {
+		resource.Properties = make(map[string]interface{})
+	}
+	resource.Properties[property] = value
+	return nil
+}
+
+// Sub returns the Fn::Sub intrinsic function for a string.
+func Sub(value string) map[string]interface{} {
+	return map[string]interface{}{"Fn::Sub": value}
+}
+
+// Ref returns the Ref intrinsic function for a parameter or a resource.
+func Ref(logicalName string) map[string]interface{} {
+	return map[string]interface{}{"Ref": logicalName}
+}
+
+// NoValue returns a reference to the AWS::NoValue pseudo parameter, which removes a property.
+func NoValue() map[string]interface{} {
+	return Ref("AWS::NoValue")
+}
+
+// GetAtt returns the Fn::GetAtt intrinsic function for an attribute of a resource.
+func GetAtt(logicalID, attribute string) map[string]interface{} {
+	return map[string]interface{}{"Fn::GetAtt": []interface{}{logicalID, attribute}}
+}
+
+// If returns the Fn::If intrinsic function, which is valueIfTrue if the condition is true.
+func If(condition string, valueIfTrue, valueIfFalse interface{}) map[string]interface{} {
+	return map[string]interface{}{"Fn::If": []interface{}{condition, valueIfTrue, valueIfFalse}}
+}
+
+// Condition returns a reference to a condition, for use in the condition functions.
+func Condition(condition string) map[string]interface{} {
+	return map[string]interface{}{"Condition": condition}
+}
+
+// Equals returns the Fn::Equals condition function.
+func Equals(value1, value2 interface{}) map[string]interface{} {
+	return map[string]interface{}{"Fn::Equals": []interface{}{value1, value2}}
+}
+
+// Not returns the Fn::Not condition function.
+func Not(condition interface{}) map[string]interface{} {
+	return map[string]interface{}{"Fn::Not": []interface{}{condition}}
+}
+
+// And returns the Fn::And condition function.
+func And(conditions ...interface{}) map[string]interface{} {
+	return map[string]interface{}{"Fn::And": conditions}
+}
+
+// Or returns the Fn::Or condition function.
+func Or(conditions ...interface{}) map[string]interface{} {
+	return map[string]interface{}{"Fn::Or": conditions}
+}
+
+// Join returns the Fn::Join intrinsic function; values is either a list or a function returning one.
+func Join(delimiter string, values interface{}) map[string]interface{} {
+	return map[string]interface{}{"Fn::Join": []interface{}{delimiter, values}}
+}
+
+// Select returns the Fn::Select intrinsic function; list is either a list or a function returning one.
+func Select(index string, list interface{}) map[string]interface{} {
+	return map[string]interface{}{"Fn::Select": []interface{}{index, list}}
+}
+
+// FindInMap returns the Fn::FindInMap intrinsic function.
+func FindInMap(mapName, topLevelKey, secondLevelKey string) map[string]interface{} {
+	return map[string]interface{}{"Fn::FindInMap": []interface{}{mapName, topLevelKey, secondLevelKey}}
+}
+
+// GetAZs returns the Fn::GetAZs intrinsic function.
+func GetAZs(region interface{}) map[string]interface{} {
+	return map[string]interface{}{"Fn::GetAZs": region}
+}
+
+// Base64 returns the Fn::Base64 intrinsic function.
+func Base64(value interface{}) map[string]interface{} {
+	return map[string]interface{}{"Fn::Base64": value}
+}
+
+// ImportValue returns the Fn::ImportValue intrinsic function.
+func ImportValue(exportName interface{}) map[string]interface{} {
+	return map[string]interface{}{"Fn::ImportValue": exportName}
+}
@@ -0,0 +1,65 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import "time"
+
+const (
+	// minDelayWait is the delay before the second DescribeStackEvents API call while waiting for a stack operation.
+	// The delay doubles after every attempt, up to maxDelayWait.
+	minDelayWait = 5 * time.Second
+
+	// maxDelayWait is the maximum delay between successive DescribeStackEvents API calls while waiting for a stack
+	// operation. This value reflects the values set in the cloudformation waiters json file in the aws-go-sdk.
+	maxDelayWait = 30 * time.Second
+)
+
+// stackWaiter determines how often and how many times a stack is polled while
+// waiting for a create, update or delete to complete. Polling starts quickly,
+// since small stacks finish within seconds, and backs off exponentially.
+type stackWaiter struct {
+	maxAttempts int
+	minDelay    time.Duration
+	maxDelay    time.Duration
+}
+
+// newStackWaiter creates a stackWaiter. If maxAttempts is 0, each operation
+// uses its own default maximum.
+func newStackWaiter(maxAttempts int) *stackWaiter {
+	return &stackWaiter{
+		maxAttempts: maxAttempts,
+		minDelay:    minDelayWait,
+		maxDelay:    maxDelayWait,
+	}
+}
+
+// attempts returns the maximum number of attempts to use for an operation.
+func (w *stackWaiter) attempts(defaultAttempts int) int {
+	if w.maxAttempts > 0 {
+		return w.maxAttempts
+	}
+	return defaultAttempts
+}
+
+// delay returns the time to wait after the given (zero-indexed) attempt.
+func (w *stackWaiter) delay(attempt int) time.Duration {
+	delay := w.minDelay
+	for i := 0; i < attempt && delay < w.maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > w.maxDelay {
+		return w.maxDelay
+	}
+	return delay
+}
@@ -0,0 +1,36 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cloudformation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackWaiterDelay(t *testing.T) {
+	waiter := newStackWaiter(0)
+
+	assert.Equal(t, 5*time.Second, waiter.delay(0))
+	assert.Equal(t, 10*time.Second, waiter.delay(1))
+	assert.Equal(t, 20*time.Second, waiter.delay(2))
+	assert.Equal(t, 30*time.Second, waiter.delay(3), "Expected delay to be capped")
+	assert.Equal(t, 30*time.Second, waiter.delay(200), "Expected delay to be capped")
+}
+
+func TestStackWaiterAttempts(t *testing.T) {
+	assert.Equal(t, maxRetriesCreate, newStackWaiter(0).attempts(maxRetriesCreate), "Expected default attempts")
+	assert.Equal(t, 500, newStackWaiter(500).attempts(maxRetriesCreate), "Expected configured attempts")
+}
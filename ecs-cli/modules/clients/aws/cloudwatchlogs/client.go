@@ -25,6 +25,8 @@ import (
 type Client interface {
 	FilterAllLogEvents(*cloudwatchlogs.FilterLogEventsInput, func([]*cloudwatchlogs.FilteredLogEvent)) error
 	CreateLogGroup(*string) error
+	DeleteLogGroup(*string) error
+	LogGroupExists(*string) (bool, error)
 }
 
 // ec2Client implements EC2Client
@@ -61,6 +63,30 @@ func (c *cwLogsClient) CreateLogGroup(group *string) error {
 	return err
 }
 
+func (c *cwLogsClient) DeleteLogGroup(group *string) error {
+	_, err := c.client.DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{
+		LogGroupName: group,
+	})
+	return err
+}
+
+// LogGroupExists returns true if the log group exists
+func (c *cwLogsClient) LogGroupExists(group *string) (bool, error) {
+	exists := false
+	err := c.client.DescribeLogGroupsPages(&cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: group,
+	}, func(page *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+		for _, logGroup := range page.LogGroups {
+			if aws.StringValue(logGroup.LogGroupName) == aws.StringValue(group) {
+				exists = true
+				return false
+			}
+		}
+		return true
+	})
+	return exists, err
+}
+
 // LogClientFactory is a factory which creates log clients for a region
 type LogClientFactory interface {
 	Get(string) Client
@@ -16,9 +16,12 @@ package cloudwatchlogs
 import (
 	"testing"
 
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs/mock/sdk"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,3 +41,37 @@ func TestNewCloudWatchLogsClientLeavesRegionIntact(t *testing.T) {
 	assert.Equal(t, correctRegion, aws.StringValue(params.Session.Config.Region), "Expected configured region to remain unchanged after call to NewCloudWatchLogsClient()")
 
 }
+
+func TestDeleteLogGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSDK := mock_cloudwatchlogsiface.NewMockCloudWatchLogsAPI(ctrl)
+	client := &cwLogsClient{client: mockSDK}
+
+	mockSDK.EXPECT().DeleteLogGroup(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*cloudwatchlogs.DeleteLogGroupInput)
+		assert.Equal(t, "my-log-group", aws.StringValue(req.LogGroupName), "Expected log group name to match")
+	}).Return(&cloudwatchlogs.DeleteLogGroupOutput{}, nil)
+
+	err := client.DeleteLogGroup(aws.String("my-log-group"))
+	assert.NoError(t, err, "Unexpected error deleting log group")
+}
+
+func TestLogGroupExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSDK := mock_cloudwatchlogsiface.NewMockCloudWatchLogsAPI(ctrl)
+	client := &cwLogsClient{client: mockSDK}
+
+	mockSDK.EXPECT().DescribeLogGroupsPages(gomock.Any(), gomock.Any()).Do(func(input interface{}, fn func(*cloudwatchlogs.DescribeLogGroupsOutput, bool) bool) {
+		req := input.(*cloudwatchlogs.DescribeLogGroupsInput)
+		assert.Equal(t, "/ecs/web", aws.StringValue(req.LogGroupNamePrefix), "Expected the log group name as prefix")
+		fn(&cloudwatchlogs.DescribeLogGroupsOutput{
+			LogGroups: []*cloudwatchlogs.LogGroup{{LogGroupName: aws.String("/ecs/web-canary")}},
+		}, true)
+	}).Return(nil)
+
+	exists, err := client.LogGroupExists(aws.String("/ecs/web"))
+	assert.NoError(t, err, "Unexpected error describing log groups")
+	assert.False(t, exists, "Expected a log group with the name as prefix not to match")
+}
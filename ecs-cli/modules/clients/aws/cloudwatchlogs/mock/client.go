@@ -61,6 +61,20 @@ func (mr *MockClientMockRecorder) CreateLogGroup(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLogGroup", reflect.TypeOf((*MockClient)(nil).CreateLogGroup), arg0)
 }
 
+// DeleteLogGroup mocks base method
+func (m *MockClient) DeleteLogGroup(arg0 *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogGroup", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLogGroup indicates an expected call of DeleteLogGroup
+func (mr *MockClientMockRecorder) DeleteLogGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroup", reflect.TypeOf((*MockClient)(nil).DeleteLogGroup), arg0)
+}
+
 // FilterAllLogEvents mocks base method
 func (m *MockClient) FilterAllLogEvents(arg0 *cloudwatchlogs.FilterLogEventsInput, arg1 func([]*cloudwatchlogs.FilteredLogEvent)) error {
 	m.ctrl.T.Helper()
@@ -74,3 +88,18 @@ func (mr *MockClientMockRecorder) FilterAllLogEvents(arg0, arg1 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterAllLogEvents", reflect.TypeOf((*MockClient)(nil).FilterAllLogEvents), arg0, arg1)
 }
+
+// LogGroupExists mocks base method
+func (m *MockClient) LogGroupExists(arg0 *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogGroupExists", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogGroupExists indicates an expected call of LogGroupExists
+func (mr *MockClientMockRecorder) LogGroupExists(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogGroupExists", reflect.TypeOf((*MockClient)(nil).LogGroupExists), arg0)
+}
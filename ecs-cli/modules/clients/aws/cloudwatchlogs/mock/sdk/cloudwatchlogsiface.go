@@ -149,6 +149,56 @@ func (mr *MockCloudWatchLogsAPIMockRecorder) CancelExportTaskWithContext(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelExportTaskWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).CancelExportTaskWithContext), varargs...)
 }
 
+// CreateDelivery mocks base method
+func (m *MockCloudWatchLogsAPI) CreateDelivery(arg0 *cloudwatchlogs.CreateDeliveryInput) (*cloudwatchlogs.CreateDeliveryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.CreateDeliveryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery
+func (mr *MockCloudWatchLogsAPIMockRecorder) CreateDelivery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).CreateDelivery), arg0)
+}
+
+// CreateDeliveryRequest mocks base method
+func (m *MockCloudWatchLogsAPI) CreateDeliveryRequest(arg0 *cloudwatchlogs.CreateDeliveryInput) (*request.Request, *cloudwatchlogs.CreateDeliveryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeliveryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.CreateDeliveryOutput)
+	return ret0, ret1
+}
+
+// CreateDeliveryRequest indicates an expected call of CreateDeliveryRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) CreateDeliveryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeliveryRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).CreateDeliveryRequest), arg0)
+}
+
+// CreateDeliveryWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) CreateDeliveryWithContext(arg0 context.Context, arg1 *cloudwatchlogs.CreateDeliveryInput, arg2 ...request.Option) (*cloudwatchlogs.CreateDeliveryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDeliveryWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.CreateDeliveryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeliveryWithContext indicates an expected call of CreateDeliveryWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) CreateDeliveryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeliveryWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).CreateDeliveryWithContext), varargs...)
+}
+
 // CreateExportTask mocks base method
 func (m *MockCloudWatchLogsAPI) CreateExportTask(arg0 *cloudwatchlogs.CreateExportTaskInput) (*cloudwatchlogs.CreateExportTaskOutput, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +249,56 @@ func (mr *MockCloudWatchLogsAPIMockRecorder) CreateExportTaskWithContext(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExportTaskWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).CreateExportTaskWithContext), varargs...)
 }
 
+// CreateLogAnomalyDetector mocks base method
+func (m *MockCloudWatchLogsAPI) CreateLogAnomalyDetector(arg0 *cloudwatchlogs.CreateLogAnomalyDetectorInput) (*cloudwatchlogs.CreateLogAnomalyDetectorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLogAnomalyDetector", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.CreateLogAnomalyDetectorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLogAnomalyDetector indicates an expected call of CreateLogAnomalyDetector
+func (mr *MockCloudWatchLogsAPIMockRecorder) CreateLogAnomalyDetector(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLogAnomalyDetector", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).CreateLogAnomalyDetector), arg0)
+}
+
+// CreateLogAnomalyDetectorRequest mocks base method
+func (m *MockCloudWatchLogsAPI) CreateLogAnomalyDetectorRequest(arg0 *cloudwatchlogs.CreateLogAnomalyDetectorInput) (*request.Request, *cloudwatchlogs.CreateLogAnomalyDetectorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLogAnomalyDetectorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.CreateLogAnomalyDetectorOutput)
+	return ret0, ret1
+}
+
+// CreateLogAnomalyDetectorRequest indicates an expected call of CreateLogAnomalyDetectorRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) CreateLogAnomalyDetectorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLogAnomalyDetectorRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).CreateLogAnomalyDetectorRequest), arg0)
+}
+
+// CreateLogAnomalyDetectorWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) CreateLogAnomalyDetectorWithContext(arg0 context.Context, arg1 *cloudwatchlogs.CreateLogAnomalyDetectorInput, arg2 ...request.Option) (*cloudwatchlogs.CreateLogAnomalyDetectorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateLogAnomalyDetectorWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.CreateLogAnomalyDetectorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLogAnomalyDetectorWithContext indicates an expected call of CreateLogAnomalyDetectorWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) CreateLogAnomalyDetectorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLogAnomalyDetectorWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).CreateLogAnomalyDetectorWithContext), varargs...)
+}
+
 // CreateLogGroup mocks base method
 func (m *MockCloudWatchLogsAPI) CreateLogGroup(arg0 *cloudwatchlogs.CreateLogGroupInput) (*cloudwatchlogs.CreateLogGroupOutput, error) {
 	m.ctrl.T.Helper()
@@ -299,1335 +399,2850 @@ func (mr *MockCloudWatchLogsAPIMockRecorder) CreateLogStreamWithContext(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLogStreamWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).CreateLogStreamWithContext), varargs...)
 }
 
-// DeleteDestination mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteDestination(arg0 *cloudwatchlogs.DeleteDestinationInput) (*cloudwatchlogs.DeleteDestinationOutput, error) {
+// DeleteAccountPolicy mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteAccountPolicy(arg0 *cloudwatchlogs.DeleteAccountPolicyInput) (*cloudwatchlogs.DeleteAccountPolicyOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDestination", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDestinationOutput)
+	ret := m.ctrl.Call(m, "DeleteAccountPolicy", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteAccountPolicyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteDestination indicates an expected call of DeleteDestination
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDestination(arg0 interface{}) *gomock.Call {
+// DeleteAccountPolicy indicates an expected call of DeleteAccountPolicy
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteAccountPolicy(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDestination", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDestination), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountPolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteAccountPolicy), arg0)
 }
 
-// DeleteDestinationRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteDestinationRequest(arg0 *cloudwatchlogs.DeleteDestinationInput) (*request.Request, *cloudwatchlogs.DeleteDestinationOutput) {
+// DeleteAccountPolicyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteAccountPolicyRequest(arg0 *cloudwatchlogs.DeleteAccountPolicyInput) (*request.Request, *cloudwatchlogs.DeleteAccountPolicyOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDestinationRequest", arg0)
+	ret := m.ctrl.Call(m, "DeleteAccountPolicyRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DeleteDestinationOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteAccountPolicyOutput)
 	return ret0, ret1
 }
 
-// DeleteDestinationRequest indicates an expected call of DeleteDestinationRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDestinationRequest(arg0 interface{}) *gomock.Call {
+// DeleteAccountPolicyRequest indicates an expected call of DeleteAccountPolicyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteAccountPolicyRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDestinationRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDestinationRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountPolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteAccountPolicyRequest), arg0)
 }
 
-// DeleteDestinationWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteDestinationWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteDestinationInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteDestinationOutput, error) {
+// DeleteAccountPolicyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteAccountPolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteAccountPolicyInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteAccountPolicyOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteDestinationWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDestinationOutput)
+	ret := m.ctrl.Call(m, "DeleteAccountPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteAccountPolicyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteDestinationWithContext indicates an expected call of DeleteDestinationWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDestinationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// DeleteAccountPolicyWithContext indicates an expected call of DeleteAccountPolicyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteAccountPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDestinationWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDestinationWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountPolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteAccountPolicyWithContext), varargs...)
 }
 
-// DeleteLogGroup mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteLogGroup(arg0 *cloudwatchlogs.DeleteLogGroupInput) (*cloudwatchlogs.DeleteLogGroupOutput, error) {
+// DeleteDataProtectionPolicy mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDataProtectionPolicy(arg0 *cloudwatchlogs.DeleteDataProtectionPolicyInput) (*cloudwatchlogs.DeleteDataProtectionPolicyOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLogGroup", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteLogGroupOutput)
+	ret := m.ctrl.Call(m, "DeleteDataProtectionPolicy", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDataProtectionPolicyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteLogGroup indicates an expected call of DeleteLogGroup
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogGroup(arg0 interface{}) *gomock.Call {
+// DeleteDataProtectionPolicy indicates an expected call of DeleteDataProtectionPolicy
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDataProtectionPolicy(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroup", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogGroup), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataProtectionPolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDataProtectionPolicy), arg0)
 }
 
-// DeleteLogGroupRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteLogGroupRequest(arg0 *cloudwatchlogs.DeleteLogGroupInput) (*request.Request, *cloudwatchlogs.DeleteLogGroupOutput) {
+// DeleteDataProtectionPolicyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDataProtectionPolicyRequest(arg0 *cloudwatchlogs.DeleteDataProtectionPolicyInput) (*request.Request, *cloudwatchlogs.DeleteDataProtectionPolicyOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLogGroupRequest", arg0)
+	ret := m.ctrl.Call(m, "DeleteDataProtectionPolicyRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DeleteLogGroupOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteDataProtectionPolicyOutput)
 	return ret0, ret1
 }
 
-// DeleteLogGroupRequest indicates an expected call of DeleteLogGroupRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogGroupRequest(arg0 interface{}) *gomock.Call {
+// DeleteDataProtectionPolicyRequest indicates an expected call of DeleteDataProtectionPolicyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDataProtectionPolicyRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroupRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogGroupRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataProtectionPolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDataProtectionPolicyRequest), arg0)
 }
 
-// DeleteLogGroupWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteLogGroupWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteLogGroupInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteLogGroupOutput, error) {
+// DeleteDataProtectionPolicyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDataProtectionPolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteDataProtectionPolicyInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteDataProtectionPolicyOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteLogGroupWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteLogGroupOutput)
+	ret := m.ctrl.Call(m, "DeleteDataProtectionPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDataProtectionPolicyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteLogGroupWithContext indicates an expected call of DeleteLogGroupWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// DeleteDataProtectionPolicyWithContext indicates an expected call of DeleteDataProtectionPolicyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDataProtectionPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroupWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogGroupWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataProtectionPolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDataProtectionPolicyWithContext), varargs...)
 }
 
-// DeleteLogStream mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteLogStream(arg0 *cloudwatchlogs.DeleteLogStreamInput) (*cloudwatchlogs.DeleteLogStreamOutput, error) {
+// DeleteDelivery mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDelivery(arg0 *cloudwatchlogs.DeleteDeliveryInput) (*cloudwatchlogs.DeleteDeliveryOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLogStream", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteLogStreamOutput)
+	ret := m.ctrl.Call(m, "DeleteDelivery", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDeliveryOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteLogStream indicates an expected call of DeleteLogStream
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogStream(arg0 interface{}) *gomock.Call {
+// DeleteDelivery indicates an expected call of DeleteDelivery
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDelivery(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogStream", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogStream), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDelivery", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDelivery), arg0)
 }
 
-// DeleteLogStreamRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteLogStreamRequest(arg0 *cloudwatchlogs.DeleteLogStreamInput) (*request.Request, *cloudwatchlogs.DeleteLogStreamOutput) {
+// DeleteDeliveryDestination mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDeliveryDestination(arg0 *cloudwatchlogs.DeleteDeliveryDestinationInput) (*cloudwatchlogs.DeleteDeliveryDestinationOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLogStreamRequest", arg0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DeleteLogStreamOutput)
+	ret := m.ctrl.Call(m, "DeleteDeliveryDestination", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDeliveryDestinationOutput)
+	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteLogStreamRequest indicates an expected call of DeleteLogStreamRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogStreamRequest(arg0 interface{}) *gomock.Call {
+// DeleteDeliveryDestination indicates an expected call of DeleteDeliveryDestination
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDeliveryDestination(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogStreamRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogStreamRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryDestination", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDeliveryDestination), arg0)
 }
 
-// DeleteLogStreamWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteLogStreamWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteLogStreamInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteLogStreamOutput, error) {
+// DeleteDeliveryDestinationPolicy mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDeliveryDestinationPolicy(arg0 *cloudwatchlogs.DeleteDeliveryDestinationPolicyInput) (*cloudwatchlogs.DeleteDeliveryDestinationPolicyOutput, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "DeleteLogStreamWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteLogStreamOutput)
+	ret := m.ctrl.Call(m, "DeleteDeliveryDestinationPolicy", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDeliveryDestinationPolicyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteLogStreamWithContext indicates an expected call of DeleteLogStreamWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogStreamWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// DeleteDeliveryDestinationPolicy indicates an expected call of DeleteDeliveryDestinationPolicy
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDeliveryDestinationPolicy(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogStreamWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogStreamWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryDestinationPolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDeliveryDestinationPolicy), arg0)
 }
 
-// DeleteMetricFilter mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteMetricFilter(arg0 *cloudwatchlogs.DeleteMetricFilterInput) (*cloudwatchlogs.DeleteMetricFilterOutput, error) {
+// DeleteDeliveryDestinationPolicyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDeliveryDestinationPolicyRequest(arg0 *cloudwatchlogs.DeleteDeliveryDestinationPolicyInput) (*request.Request, *cloudwatchlogs.DeleteDeliveryDestinationPolicyOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMetricFilter", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteMetricFilterOutput)
+	ret := m.ctrl.Call(m, "DeleteDeliveryDestinationPolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteDeliveryDestinationPolicyOutput)
+	return ret0, ret1
+}
+
+// DeleteDeliveryDestinationPolicyRequest indicates an expected call of DeleteDeliveryDestinationPolicyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDeliveryDestinationPolicyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryDestinationPolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDeliveryDestinationPolicyRequest), arg0)
+}
+
+// DeleteDeliveryDestinationPolicyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDeliveryDestinationPolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteDeliveryDestinationPolicyInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteDeliveryDestinationPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDeliveryDestinationPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDeliveryDestinationPolicyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteMetricFilter indicates an expected call of DeleteMetricFilter
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteMetricFilter(arg0 interface{}) *gomock.Call {
+// DeleteDeliveryDestinationPolicyWithContext indicates an expected call of DeleteDeliveryDestinationPolicyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDeliveryDestinationPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetricFilter", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteMetricFilter), arg0)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryDestinationPolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDeliveryDestinationPolicyWithContext), varargs...)
 }
 
-// DeleteMetricFilterRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteMetricFilterRequest(arg0 *cloudwatchlogs.DeleteMetricFilterInput) (*request.Request, *cloudwatchlogs.DeleteMetricFilterOutput) {
+// DeleteDeliveryDestinationRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDeliveryDestinationRequest(arg0 *cloudwatchlogs.DeleteDeliveryDestinationInput) (*request.Request, *cloudwatchlogs.DeleteDeliveryDestinationOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMetricFilterRequest", arg0)
+	ret := m.ctrl.Call(m, "DeleteDeliveryDestinationRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DeleteMetricFilterOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteDeliveryDestinationOutput)
 	return ret0, ret1
 }
 
-// DeleteMetricFilterRequest indicates an expected call of DeleteMetricFilterRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteMetricFilterRequest(arg0 interface{}) *gomock.Call {
+// DeleteDeliveryDestinationRequest indicates an expected call of DeleteDeliveryDestinationRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDeliveryDestinationRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetricFilterRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteMetricFilterRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryDestinationRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDeliveryDestinationRequest), arg0)
 }
 
-// DeleteMetricFilterWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteMetricFilterWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteMetricFilterInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteMetricFilterOutput, error) {
+// DeleteDeliveryDestinationWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDeliveryDestinationWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteDeliveryDestinationInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteDeliveryDestinationOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteMetricFilterWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteMetricFilterOutput)
+	ret := m.ctrl.Call(m, "DeleteDeliveryDestinationWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDeliveryDestinationOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteMetricFilterWithContext indicates an expected call of DeleteMetricFilterWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteMetricFilterWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// DeleteDeliveryDestinationWithContext indicates an expected call of DeleteDeliveryDestinationWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDeliveryDestinationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetricFilterWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteMetricFilterWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryDestinationWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDeliveryDestinationWithContext), varargs...)
 }
 
-// DeleteResourcePolicy mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteResourcePolicy(arg0 *cloudwatchlogs.DeleteResourcePolicyInput) (*cloudwatchlogs.DeleteResourcePolicyOutput, error) {
+// DeleteDeliveryRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDeliveryRequest(arg0 *cloudwatchlogs.DeleteDeliveryInput) (*request.Request, *cloudwatchlogs.DeleteDeliveryOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteResourcePolicy", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteResourcePolicyOutput)
+	ret := m.ctrl.Call(m, "DeleteDeliveryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteDeliveryOutput)
+	return ret0, ret1
+}
+
+// DeleteDeliveryRequest indicates an expected call of DeleteDeliveryRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDeliveryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDeliveryRequest), arg0)
+}
+
+// DeleteDeliverySource mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDeliverySource(arg0 *cloudwatchlogs.DeleteDeliverySourceInput) (*cloudwatchlogs.DeleteDeliverySourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeliverySource", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDeliverySourceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteResourcePolicy indicates an expected call of DeleteResourcePolicy
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteResourcePolicy(arg0 interface{}) *gomock.Call {
+// DeleteDeliverySource indicates an expected call of DeleteDeliverySource
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDeliverySource(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourcePolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteResourcePolicy), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliverySource", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDeliverySource), arg0)
 }
 
-// DeleteResourcePolicyRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteResourcePolicyRequest(arg0 *cloudwatchlogs.DeleteResourcePolicyInput) (*request.Request, *cloudwatchlogs.DeleteResourcePolicyOutput) {
+// DeleteDeliverySourceRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDeliverySourceRequest(arg0 *cloudwatchlogs.DeleteDeliverySourceInput) (*request.Request, *cloudwatchlogs.DeleteDeliverySourceOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteResourcePolicyRequest", arg0)
+	ret := m.ctrl.Call(m, "DeleteDeliverySourceRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DeleteResourcePolicyOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteDeliverySourceOutput)
 	return ret0, ret1
 }
 
-// DeleteResourcePolicyRequest indicates an expected call of DeleteResourcePolicyRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteResourcePolicyRequest(arg0 interface{}) *gomock.Call {
+// DeleteDeliverySourceRequest indicates an expected call of DeleteDeliverySourceRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDeliverySourceRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourcePolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteResourcePolicyRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliverySourceRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDeliverySourceRequest), arg0)
 }
 
-// DeleteResourcePolicyWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteResourcePolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteResourcePolicyInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteResourcePolicyOutput, error) {
+// DeleteDeliverySourceWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDeliverySourceWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteDeliverySourceInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteDeliverySourceOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteResourcePolicyWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteResourcePolicyOutput)
+	ret := m.ctrl.Call(m, "DeleteDeliverySourceWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDeliverySourceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteResourcePolicyWithContext indicates an expected call of DeleteResourcePolicyWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteResourcePolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// DeleteDeliverySourceWithContext indicates an expected call of DeleteDeliverySourceWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDeliverySourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourcePolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteResourcePolicyWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliverySourceWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDeliverySourceWithContext), varargs...)
 }
 
-// DeleteRetentionPolicy mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteRetentionPolicy(arg0 *cloudwatchlogs.DeleteRetentionPolicyInput) (*cloudwatchlogs.DeleteRetentionPolicyOutput, error) {
+// DeleteDeliveryWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDeliveryWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteDeliveryInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteDeliveryOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRetentionPolicy", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteRetentionPolicyOutput)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDeliveryWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDeliveryOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteRetentionPolicy indicates an expected call of DeleteRetentionPolicy
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteRetentionPolicy(arg0 interface{}) *gomock.Call {
+// DeleteDeliveryWithContext indicates an expected call of DeleteDeliveryWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDeliveryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionPolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteRetentionPolicy), arg0)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDeliveryWithContext), varargs...)
 }
 
-// DeleteRetentionPolicyRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteRetentionPolicyRequest(arg0 *cloudwatchlogs.DeleteRetentionPolicyInput) (*request.Request, *cloudwatchlogs.DeleteRetentionPolicyOutput) {
+// DeleteDestination mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDestination(arg0 *cloudwatchlogs.DeleteDestinationInput) (*cloudwatchlogs.DeleteDestinationOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRetentionPolicyRequest", arg0)
+	ret := m.ctrl.Call(m, "DeleteDestination", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDestinationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDestination indicates an expected call of DeleteDestination
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDestination(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDestination", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDestination), arg0)
+}
+
+// DeleteDestinationRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDestinationRequest(arg0 *cloudwatchlogs.DeleteDestinationInput) (*request.Request, *cloudwatchlogs.DeleteDestinationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDestinationRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DeleteRetentionPolicyOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteDestinationOutput)
 	return ret0, ret1
 }
 
-// DeleteRetentionPolicyRequest indicates an expected call of DeleteRetentionPolicyRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteRetentionPolicyRequest(arg0 interface{}) *gomock.Call {
+// DeleteDestinationRequest indicates an expected call of DeleteDestinationRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDestinationRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionPolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteRetentionPolicyRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDestinationRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDestinationRequest), arg0)
 }
 
-// DeleteRetentionPolicyWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteRetentionPolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteRetentionPolicyInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteRetentionPolicyOutput, error) {
+// DeleteDestinationWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteDestinationWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteDestinationInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteDestinationOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteRetentionPolicyWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteRetentionPolicyOutput)
+	ret := m.ctrl.Call(m, "DeleteDestinationWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteDestinationOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteRetentionPolicyWithContext indicates an expected call of DeleteRetentionPolicyWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteRetentionPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// DeleteDestinationWithContext indicates an expected call of DeleteDestinationWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteDestinationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionPolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteRetentionPolicyWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDestinationWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteDestinationWithContext), varargs...)
 }
 
-// DeleteSubscriptionFilter mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteSubscriptionFilter(arg0 *cloudwatchlogs.DeleteSubscriptionFilterInput) (*cloudwatchlogs.DeleteSubscriptionFilterOutput, error) {
+// DeleteLogAnomalyDetector mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteLogAnomalyDetector(arg0 *cloudwatchlogs.DeleteLogAnomalyDetectorInput) (*cloudwatchlogs.DeleteLogAnomalyDetectorOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSubscriptionFilter", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteSubscriptionFilterOutput)
+	ret := m.ctrl.Call(m, "DeleteLogAnomalyDetector", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteLogAnomalyDetectorOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteSubscriptionFilter indicates an expected call of DeleteSubscriptionFilter
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteSubscriptionFilter(arg0 interface{}) *gomock.Call {
+// DeleteLogAnomalyDetector indicates an expected call of DeleteLogAnomalyDetector
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogAnomalyDetector(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionFilter", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteSubscriptionFilter), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogAnomalyDetector", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogAnomalyDetector), arg0)
 }
 
-// DeleteSubscriptionFilterRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteSubscriptionFilterRequest(arg0 *cloudwatchlogs.DeleteSubscriptionFilterInput) (*request.Request, *cloudwatchlogs.DeleteSubscriptionFilterOutput) {
+// DeleteLogAnomalyDetectorRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteLogAnomalyDetectorRequest(arg0 *cloudwatchlogs.DeleteLogAnomalyDetectorInput) (*request.Request, *cloudwatchlogs.DeleteLogAnomalyDetectorOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSubscriptionFilterRequest", arg0)
+	ret := m.ctrl.Call(m, "DeleteLogAnomalyDetectorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteLogAnomalyDetectorOutput)
+	return ret0, ret1
+}
+
+// DeleteLogAnomalyDetectorRequest indicates an expected call of DeleteLogAnomalyDetectorRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogAnomalyDetectorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogAnomalyDetectorRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogAnomalyDetectorRequest), arg0)
+}
+
+// DeleteLogAnomalyDetectorWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteLogAnomalyDetectorWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteLogAnomalyDetectorInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteLogAnomalyDetectorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLogAnomalyDetectorWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteLogAnomalyDetectorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLogAnomalyDetectorWithContext indicates an expected call of DeleteLogAnomalyDetectorWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogAnomalyDetectorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogAnomalyDetectorWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogAnomalyDetectorWithContext), varargs...)
+}
+
+// DeleteLogGroup mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteLogGroup(arg0 *cloudwatchlogs.DeleteLogGroupInput) (*cloudwatchlogs.DeleteLogGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogGroup", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteLogGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLogGroup indicates an expected call of DeleteLogGroup
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroup", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogGroup), arg0)
+}
+
+// DeleteLogGroupRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteLogGroupRequest(arg0 *cloudwatchlogs.DeleteLogGroupInput) (*request.Request, *cloudwatchlogs.DeleteLogGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteLogGroupOutput)
+	return ret0, ret1
+}
+
+// DeleteLogGroupRequest indicates an expected call of DeleteLogGroupRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroupRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogGroupRequest), arg0)
+}
+
+// DeleteLogGroupWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteLogGroupWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteLogGroupInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteLogGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLogGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteLogGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLogGroupWithContext indicates an expected call of DeleteLogGroupWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroupWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogGroupWithContext), varargs...)
+}
+
+// DeleteLogStream mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteLogStream(arg0 *cloudwatchlogs.DeleteLogStreamInput) (*cloudwatchlogs.DeleteLogStreamOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogStream", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteLogStreamOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLogStream indicates an expected call of DeleteLogStream
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogStream(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogStream", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogStream), arg0)
+}
+
+// DeleteLogStreamRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteLogStreamRequest(arg0 *cloudwatchlogs.DeleteLogStreamInput) (*request.Request, *cloudwatchlogs.DeleteLogStreamOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogStreamRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteLogStreamOutput)
+	return ret0, ret1
+}
+
+// DeleteLogStreamRequest indicates an expected call of DeleteLogStreamRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogStreamRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogStreamRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogStreamRequest), arg0)
+}
+
+// DeleteLogStreamWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteLogStreamWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteLogStreamInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteLogStreamOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLogStreamWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteLogStreamOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLogStreamWithContext indicates an expected call of DeleteLogStreamWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteLogStreamWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogStreamWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteLogStreamWithContext), varargs...)
+}
+
+// DeleteMetricFilter mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteMetricFilter(arg0 *cloudwatchlogs.DeleteMetricFilterInput) (*cloudwatchlogs.DeleteMetricFilterOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMetricFilter", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteMetricFilterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMetricFilter indicates an expected call of DeleteMetricFilter
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteMetricFilter(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetricFilter", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteMetricFilter), arg0)
+}
+
+// DeleteMetricFilterRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteMetricFilterRequest(arg0 *cloudwatchlogs.DeleteMetricFilterInput) (*request.Request, *cloudwatchlogs.DeleteMetricFilterOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMetricFilterRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteMetricFilterOutput)
+	return ret0, ret1
+}
+
+// DeleteMetricFilterRequest indicates an expected call of DeleteMetricFilterRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteMetricFilterRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetricFilterRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteMetricFilterRequest), arg0)
+}
+
+// DeleteMetricFilterWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteMetricFilterWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteMetricFilterInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteMetricFilterOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMetricFilterWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteMetricFilterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMetricFilterWithContext indicates an expected call of DeleteMetricFilterWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteMetricFilterWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetricFilterWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteMetricFilterWithContext), varargs...)
+}
+
+// DeleteQueryDefinition mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteQueryDefinition(arg0 *cloudwatchlogs.DeleteQueryDefinitionInput) (*cloudwatchlogs.DeleteQueryDefinitionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueryDefinition", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteQueryDefinitionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteQueryDefinition indicates an expected call of DeleteQueryDefinition
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteQueryDefinition(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueryDefinition", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteQueryDefinition), arg0)
+}
+
+// DeleteQueryDefinitionRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteQueryDefinitionRequest(arg0 *cloudwatchlogs.DeleteQueryDefinitionInput) (*request.Request, *cloudwatchlogs.DeleteQueryDefinitionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueryDefinitionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteQueryDefinitionOutput)
+	return ret0, ret1
+}
+
+// DeleteQueryDefinitionRequest indicates an expected call of DeleteQueryDefinitionRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteQueryDefinitionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueryDefinitionRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteQueryDefinitionRequest), arg0)
+}
+
+// DeleteQueryDefinitionWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteQueryDefinitionWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteQueryDefinitionInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteQueryDefinitionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteQueryDefinitionWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteQueryDefinitionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteQueryDefinitionWithContext indicates an expected call of DeleteQueryDefinitionWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteQueryDefinitionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueryDefinitionWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteQueryDefinitionWithContext), varargs...)
+}
+
+// DeleteResourcePolicy mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteResourcePolicy(arg0 *cloudwatchlogs.DeleteResourcePolicyInput) (*cloudwatchlogs.DeleteResourcePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourcePolicy", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteResourcePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourcePolicy indicates an expected call of DeleteResourcePolicy
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteResourcePolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourcePolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteResourcePolicy), arg0)
+}
+
+// DeleteResourcePolicyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteResourcePolicyRequest(arg0 *cloudwatchlogs.DeleteResourcePolicyInput) (*request.Request, *cloudwatchlogs.DeleteResourcePolicyOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourcePolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteResourcePolicyOutput)
+	return ret0, ret1
+}
+
+// DeleteResourcePolicyRequest indicates an expected call of DeleteResourcePolicyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteResourcePolicyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourcePolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteResourcePolicyRequest), arg0)
+}
+
+// DeleteResourcePolicyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteResourcePolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteResourcePolicyInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteResourcePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteResourcePolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteResourcePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourcePolicyWithContext indicates an expected call of DeleteResourcePolicyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteResourcePolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourcePolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteResourcePolicyWithContext), varargs...)
+}
+
+// DeleteRetentionPolicy mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteRetentionPolicy(arg0 *cloudwatchlogs.DeleteRetentionPolicyInput) (*cloudwatchlogs.DeleteRetentionPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionPolicy", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteRetentionPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionPolicy indicates an expected call of DeleteRetentionPolicy
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteRetentionPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionPolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteRetentionPolicy), arg0)
+}
+
+// DeleteRetentionPolicyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteRetentionPolicyRequest(arg0 *cloudwatchlogs.DeleteRetentionPolicyInput) (*request.Request, *cloudwatchlogs.DeleteRetentionPolicyOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionPolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DeleteRetentionPolicyOutput)
+	return ret0, ret1
+}
+
+// DeleteRetentionPolicyRequest indicates an expected call of DeleteRetentionPolicyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteRetentionPolicyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionPolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteRetentionPolicyRequest), arg0)
+}
+
+// DeleteRetentionPolicyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteRetentionPolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteRetentionPolicyInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteRetentionPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRetentionPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteRetentionPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionPolicyWithContext indicates an expected call of DeleteRetentionPolicyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteRetentionPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionPolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteRetentionPolicyWithContext), varargs...)
+}
+
+// DeleteSubscriptionFilter mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteSubscriptionFilter(arg0 *cloudwatchlogs.DeleteSubscriptionFilterInput) (*cloudwatchlogs.DeleteSubscriptionFilterOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscriptionFilter", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteSubscriptionFilterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSubscriptionFilter indicates an expected call of DeleteSubscriptionFilter
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteSubscriptionFilter(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionFilter", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteSubscriptionFilter), arg0)
+}
+
+// DeleteSubscriptionFilterRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteSubscriptionFilterRequest(arg0 *cloudwatchlogs.DeleteSubscriptionFilterInput) (*request.Request, *cloudwatchlogs.DeleteSubscriptionFilterOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscriptionFilterRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*cloudwatchlogs.DeleteSubscriptionFilterOutput)
 	return ret0, ret1
 }
 
-// DeleteSubscriptionFilterRequest indicates an expected call of DeleteSubscriptionFilterRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteSubscriptionFilterRequest(arg0 interface{}) *gomock.Call {
+// DeleteSubscriptionFilterRequest indicates an expected call of DeleteSubscriptionFilterRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteSubscriptionFilterRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionFilterRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteSubscriptionFilterRequest), arg0)
+}
+
+// DeleteSubscriptionFilterWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DeleteSubscriptionFilterWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteSubscriptionFilterInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteSubscriptionFilterOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSubscriptionFilterWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteSubscriptionFilterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSubscriptionFilterWithContext indicates an expected call of DeleteSubscriptionFilterWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteSubscriptionFilterWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionFilterWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteSubscriptionFilterWithContext), varargs...)
+}
+
+// DescribeAccountPolicies mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeAccountPolicies(arg0 *cloudwatchlogs.DescribeAccountPoliciesInput) (*cloudwatchlogs.DescribeAccountPoliciesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAccountPolicies", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeAccountPoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAccountPolicies indicates an expected call of DescribeAccountPolicies
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeAccountPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAccountPolicies", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeAccountPolicies), arg0)
+}
+
+// DescribeAccountPoliciesRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeAccountPoliciesRequest(arg0 *cloudwatchlogs.DescribeAccountPoliciesInput) (*request.Request, *cloudwatchlogs.DescribeAccountPoliciesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAccountPoliciesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeAccountPoliciesOutput)
+	return ret0, ret1
+}
+
+// DescribeAccountPoliciesRequest indicates an expected call of DescribeAccountPoliciesRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeAccountPoliciesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAccountPoliciesRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeAccountPoliciesRequest), arg0)
+}
+
+// DescribeAccountPoliciesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeAccountPoliciesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeAccountPoliciesInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeAccountPoliciesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAccountPoliciesWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeAccountPoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAccountPoliciesWithContext indicates an expected call of DescribeAccountPoliciesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeAccountPoliciesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAccountPoliciesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeAccountPoliciesWithContext), varargs...)
+}
+
+// DescribeDeliveries mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliveries(arg0 *cloudwatchlogs.DescribeDeliveriesInput) (*cloudwatchlogs.DescribeDeliveriesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliveries", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeDeliveriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeliveries indicates an expected call of DescribeDeliveries
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliveries(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveries", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliveries), arg0)
+}
+
+// DescribeDeliveriesPages mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliveriesPages(arg0 *cloudwatchlogs.DescribeDeliveriesInput, arg1 func(*cloudwatchlogs.DescribeDeliveriesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliveriesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeDeliveriesPages indicates an expected call of DescribeDeliveriesPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliveriesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveriesPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliveriesPages), arg0, arg1)
+}
+
+// DescribeDeliveriesPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliveriesPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeDeliveriesInput, arg2 func(*cloudwatchlogs.DescribeDeliveriesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDeliveriesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeDeliveriesPagesWithContext indicates an expected call of DescribeDeliveriesPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliveriesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveriesPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliveriesPagesWithContext), varargs...)
+}
+
+// DescribeDeliveriesRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliveriesRequest(arg0 *cloudwatchlogs.DescribeDeliveriesInput) (*request.Request, *cloudwatchlogs.DescribeDeliveriesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliveriesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeDeliveriesOutput)
+	return ret0, ret1
+}
+
+// DescribeDeliveriesRequest indicates an expected call of DescribeDeliveriesRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliveriesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveriesRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliveriesRequest), arg0)
+}
+
+// DescribeDeliveriesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliveriesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeDeliveriesInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeDeliveriesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDeliveriesWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeDeliveriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeliveriesWithContext indicates an expected call of DescribeDeliveriesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliveriesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveriesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliveriesWithContext), varargs...)
+}
+
+// DescribeDeliveryDestinations mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliveryDestinations(arg0 *cloudwatchlogs.DescribeDeliveryDestinationsInput) (*cloudwatchlogs.DescribeDeliveryDestinationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliveryDestinations", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeDeliveryDestinationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeliveryDestinations indicates an expected call of DescribeDeliveryDestinations
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliveryDestinations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryDestinations", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliveryDestinations), arg0)
+}
+
+// DescribeDeliveryDestinationsPages mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliveryDestinationsPages(arg0 *cloudwatchlogs.DescribeDeliveryDestinationsInput, arg1 func(*cloudwatchlogs.DescribeDeliveryDestinationsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliveryDestinationsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeDeliveryDestinationsPages indicates an expected call of DescribeDeliveryDestinationsPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliveryDestinationsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryDestinationsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliveryDestinationsPages), arg0, arg1)
+}
+
+// DescribeDeliveryDestinationsPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliveryDestinationsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeDeliveryDestinationsInput, arg2 func(*cloudwatchlogs.DescribeDeliveryDestinationsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDeliveryDestinationsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeDeliveryDestinationsPagesWithContext indicates an expected call of DescribeDeliveryDestinationsPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliveryDestinationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryDestinationsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliveryDestinationsPagesWithContext), varargs...)
+}
+
+// DescribeDeliveryDestinationsRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliveryDestinationsRequest(arg0 *cloudwatchlogs.DescribeDeliveryDestinationsInput) (*request.Request, *cloudwatchlogs.DescribeDeliveryDestinationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliveryDestinationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeDeliveryDestinationsOutput)
+	return ret0, ret1
+}
+
+// DescribeDeliveryDestinationsRequest indicates an expected call of DescribeDeliveryDestinationsRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliveryDestinationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryDestinationsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliveryDestinationsRequest), arg0)
+}
+
+// DescribeDeliveryDestinationsWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliveryDestinationsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeDeliveryDestinationsInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeDeliveryDestinationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDeliveryDestinationsWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeDeliveryDestinationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeliveryDestinationsWithContext indicates an expected call of DescribeDeliveryDestinationsWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliveryDestinationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryDestinationsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliveryDestinationsWithContext), varargs...)
+}
+
+// DescribeDeliverySources mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliverySources(arg0 *cloudwatchlogs.DescribeDeliverySourcesInput) (*cloudwatchlogs.DescribeDeliverySourcesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliverySources", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeDeliverySourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeliverySources indicates an expected call of DescribeDeliverySources
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliverySources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliverySources", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliverySources), arg0)
+}
+
+// DescribeDeliverySourcesPages mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliverySourcesPages(arg0 *cloudwatchlogs.DescribeDeliverySourcesInput, arg1 func(*cloudwatchlogs.DescribeDeliverySourcesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliverySourcesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeDeliverySourcesPages indicates an expected call of DescribeDeliverySourcesPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliverySourcesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliverySourcesPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliverySourcesPages), arg0, arg1)
+}
+
+// DescribeDeliverySourcesPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliverySourcesPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeDeliverySourcesInput, arg2 func(*cloudwatchlogs.DescribeDeliverySourcesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDeliverySourcesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeDeliverySourcesPagesWithContext indicates an expected call of DescribeDeliverySourcesPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliverySourcesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliverySourcesPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliverySourcesPagesWithContext), varargs...)
+}
+
+// DescribeDeliverySourcesRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliverySourcesRequest(arg0 *cloudwatchlogs.DescribeDeliverySourcesInput) (*request.Request, *cloudwatchlogs.DescribeDeliverySourcesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliverySourcesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeDeliverySourcesOutput)
+	return ret0, ret1
+}
+
+// DescribeDeliverySourcesRequest indicates an expected call of DescribeDeliverySourcesRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliverySourcesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliverySourcesRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliverySourcesRequest), arg0)
+}
+
+// DescribeDeliverySourcesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDeliverySourcesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeDeliverySourcesInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeDeliverySourcesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDeliverySourcesWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeDeliverySourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeliverySourcesWithContext indicates an expected call of DescribeDeliverySourcesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDeliverySourcesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliverySourcesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDeliverySourcesWithContext), varargs...)
+}
+
+// DescribeDestinations mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDestinations(arg0 *cloudwatchlogs.DescribeDestinationsInput) (*cloudwatchlogs.DescribeDestinationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDestinations", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeDestinationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDestinations indicates an expected call of DescribeDestinations
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDestinations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDestinations", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDestinations), arg0)
+}
+
+// DescribeDestinationsPages mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDestinationsPages(arg0 *cloudwatchlogs.DescribeDestinationsInput, arg1 func(*cloudwatchlogs.DescribeDestinationsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDestinationsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeDestinationsPages indicates an expected call of DescribeDestinationsPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDestinationsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDestinationsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDestinationsPages), arg0, arg1)
+}
+
+// DescribeDestinationsPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDestinationsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeDestinationsInput, arg2 func(*cloudwatchlogs.DescribeDestinationsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDestinationsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeDestinationsPagesWithContext indicates an expected call of DescribeDestinationsPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDestinationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDestinationsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDestinationsPagesWithContext), varargs...)
+}
+
+// DescribeDestinationsRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDestinationsRequest(arg0 *cloudwatchlogs.DescribeDestinationsInput) (*request.Request, *cloudwatchlogs.DescribeDestinationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDestinationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeDestinationsOutput)
+	return ret0, ret1
+}
+
+// DescribeDestinationsRequest indicates an expected call of DescribeDestinationsRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDestinationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDestinationsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDestinationsRequest), arg0)
+}
+
+// DescribeDestinationsWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeDestinationsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeDestinationsInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeDestinationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDestinationsWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeDestinationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDestinationsWithContext indicates an expected call of DescribeDestinationsWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDestinationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDestinationsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDestinationsWithContext), varargs...)
+}
+
+// DescribeExportTasks mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeExportTasks(arg0 *cloudwatchlogs.DescribeExportTasksInput) (*cloudwatchlogs.DescribeExportTasksOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeExportTasks", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeExportTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeExportTasks indicates an expected call of DescribeExportTasks
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeExportTasks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeExportTasks", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeExportTasks), arg0)
+}
+
+// DescribeExportTasksRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeExportTasksRequest(arg0 *cloudwatchlogs.DescribeExportTasksInput) (*request.Request, *cloudwatchlogs.DescribeExportTasksOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeExportTasksRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeExportTasksOutput)
+	return ret0, ret1
+}
+
+// DescribeExportTasksRequest indicates an expected call of DescribeExportTasksRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeExportTasksRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeExportTasksRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeExportTasksRequest), arg0)
+}
+
+// DescribeExportTasksWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeExportTasksWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeExportTasksInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeExportTasksOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeExportTasksWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeExportTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeExportTasksWithContext indicates an expected call of DescribeExportTasksWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeExportTasksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeExportTasksWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeExportTasksWithContext), varargs...)
+}
+
+// DescribeLogGroups mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeLogGroups(arg0 *cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLogGroups", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeLogGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLogGroups indicates an expected call of DescribeLogGroups
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogGroups", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogGroups), arg0)
+}
+
+// DescribeLogGroupsPages mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeLogGroupsPages(arg0 *cloudwatchlogs.DescribeLogGroupsInput, arg1 func(*cloudwatchlogs.DescribeLogGroupsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLogGroupsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeLogGroupsPages indicates an expected call of DescribeLogGroupsPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogGroupsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogGroupsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogGroupsPages), arg0, arg1)
+}
+
+// DescribeLogGroupsPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeLogGroupsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeLogGroupsInput, arg2 func(*cloudwatchlogs.DescribeLogGroupsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeLogGroupsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeLogGroupsPagesWithContext indicates an expected call of DescribeLogGroupsPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogGroupsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogGroupsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogGroupsPagesWithContext), varargs...)
+}
+
+// DescribeLogGroupsRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeLogGroupsRequest(arg0 *cloudwatchlogs.DescribeLogGroupsInput) (*request.Request, *cloudwatchlogs.DescribeLogGroupsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLogGroupsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeLogGroupsOutput)
+	return ret0, ret1
+}
+
+// DescribeLogGroupsRequest indicates an expected call of DescribeLogGroupsRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogGroupsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogGroupsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogGroupsRequest), arg0)
+}
+
+// DescribeLogGroupsWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeLogGroupsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeLogGroupsInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeLogGroupsWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeLogGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLogGroupsWithContext indicates an expected call of DescribeLogGroupsWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogGroupsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogGroupsWithContext), varargs...)
+}
+
+// DescribeLogStreams mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeLogStreams(arg0 *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLogStreams", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeLogStreamsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLogStreams indicates an expected call of DescribeLogStreams
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogStreams(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogStreams", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogStreams), arg0)
+}
+
+// DescribeLogStreamsPages mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeLogStreamsPages(arg0 *cloudwatchlogs.DescribeLogStreamsInput, arg1 func(*cloudwatchlogs.DescribeLogStreamsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLogStreamsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeLogStreamsPages indicates an expected call of DescribeLogStreamsPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogStreamsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogStreamsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogStreamsPages), arg0, arg1)
+}
+
+// DescribeLogStreamsPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeLogStreamsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeLogStreamsInput, arg2 func(*cloudwatchlogs.DescribeLogStreamsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeLogStreamsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeLogStreamsPagesWithContext indicates an expected call of DescribeLogStreamsPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogStreamsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogStreamsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogStreamsPagesWithContext), varargs...)
+}
+
+// DescribeLogStreamsRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeLogStreamsRequest(arg0 *cloudwatchlogs.DescribeLogStreamsInput) (*request.Request, *cloudwatchlogs.DescribeLogStreamsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLogStreamsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeLogStreamsOutput)
+	return ret0, ret1
+}
+
+// DescribeLogStreamsRequest indicates an expected call of DescribeLogStreamsRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogStreamsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogStreamsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogStreamsRequest), arg0)
+}
+
+// DescribeLogStreamsWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeLogStreamsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeLogStreamsInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeLogStreamsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeLogStreamsWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeLogStreamsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLogStreamsWithContext indicates an expected call of DescribeLogStreamsWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogStreamsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogStreamsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogStreamsWithContext), varargs...)
+}
+
+// DescribeMetricFilters mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeMetricFilters(arg0 *cloudwatchlogs.DescribeMetricFiltersInput) (*cloudwatchlogs.DescribeMetricFiltersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMetricFilters", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeMetricFiltersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMetricFilters indicates an expected call of DescribeMetricFilters
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeMetricFilters(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMetricFilters", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeMetricFilters), arg0)
+}
+
+// DescribeMetricFiltersPages mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeMetricFiltersPages(arg0 *cloudwatchlogs.DescribeMetricFiltersInput, arg1 func(*cloudwatchlogs.DescribeMetricFiltersOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMetricFiltersPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMetricFiltersPages indicates an expected call of DescribeMetricFiltersPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeMetricFiltersPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMetricFiltersPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeMetricFiltersPages), arg0, arg1)
+}
+
+// DescribeMetricFiltersPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeMetricFiltersPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeMetricFiltersInput, arg2 func(*cloudwatchlogs.DescribeMetricFiltersOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMetricFiltersPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMetricFiltersPagesWithContext indicates an expected call of DescribeMetricFiltersPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeMetricFiltersPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMetricFiltersPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeMetricFiltersPagesWithContext), varargs...)
+}
+
+// DescribeMetricFiltersRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeMetricFiltersRequest(arg0 *cloudwatchlogs.DescribeMetricFiltersInput) (*request.Request, *cloudwatchlogs.DescribeMetricFiltersOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMetricFiltersRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeMetricFiltersOutput)
+	return ret0, ret1
+}
+
+// DescribeMetricFiltersRequest indicates an expected call of DescribeMetricFiltersRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeMetricFiltersRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMetricFiltersRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeMetricFiltersRequest), arg0)
+}
+
+// DescribeMetricFiltersWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeMetricFiltersWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeMetricFiltersInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeMetricFiltersOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMetricFiltersWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeMetricFiltersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMetricFiltersWithContext indicates an expected call of DescribeMetricFiltersWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeMetricFiltersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMetricFiltersWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeMetricFiltersWithContext), varargs...)
+}
+
+// DescribeQueries mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeQueries(arg0 *cloudwatchlogs.DescribeQueriesInput) (*cloudwatchlogs.DescribeQueriesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeQueries", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeQueriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeQueries indicates an expected call of DescribeQueries
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeQueries(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueries", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeQueries), arg0)
+}
+
+// DescribeQueriesRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeQueriesRequest(arg0 *cloudwatchlogs.DescribeQueriesInput) (*request.Request, *cloudwatchlogs.DescribeQueriesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeQueriesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeQueriesOutput)
+	return ret0, ret1
+}
+
+// DescribeQueriesRequest indicates an expected call of DescribeQueriesRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeQueriesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueriesRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeQueriesRequest), arg0)
+}
+
+// DescribeQueriesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeQueriesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeQueriesInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeQueriesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeQueriesWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeQueriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeQueriesWithContext indicates an expected call of DescribeQueriesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeQueriesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueriesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeQueriesWithContext), varargs...)
+}
+
+// DescribeQueryDefinitions mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeQueryDefinitions(arg0 *cloudwatchlogs.DescribeQueryDefinitionsInput) (*cloudwatchlogs.DescribeQueryDefinitionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeQueryDefinitions", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeQueryDefinitionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeQueryDefinitions indicates an expected call of DescribeQueryDefinitions
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeQueryDefinitions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueryDefinitions", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeQueryDefinitions), arg0)
+}
+
+// DescribeQueryDefinitionsRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeQueryDefinitionsRequest(arg0 *cloudwatchlogs.DescribeQueryDefinitionsInput) (*request.Request, *cloudwatchlogs.DescribeQueryDefinitionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeQueryDefinitionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeQueryDefinitionsOutput)
+	return ret0, ret1
+}
+
+// DescribeQueryDefinitionsRequest indicates an expected call of DescribeQueryDefinitionsRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeQueryDefinitionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueryDefinitionsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeQueryDefinitionsRequest), arg0)
+}
+
+// DescribeQueryDefinitionsWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeQueryDefinitionsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeQueryDefinitionsInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeQueryDefinitionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeQueryDefinitionsWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeQueryDefinitionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeQueryDefinitionsWithContext indicates an expected call of DescribeQueryDefinitionsWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeQueryDefinitionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueryDefinitionsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeQueryDefinitionsWithContext), varargs...)
+}
+
+// DescribeResourcePolicies mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeResourcePolicies(arg0 *cloudwatchlogs.DescribeResourcePoliciesInput) (*cloudwatchlogs.DescribeResourcePoliciesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeResourcePolicies", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeResourcePoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeResourcePolicies indicates an expected call of DescribeResourcePolicies
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeResourcePolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeResourcePolicies", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeResourcePolicies), arg0)
+}
+
+// DescribeResourcePoliciesRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeResourcePoliciesRequest(arg0 *cloudwatchlogs.DescribeResourcePoliciesInput) (*request.Request, *cloudwatchlogs.DescribeResourcePoliciesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeResourcePoliciesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeResourcePoliciesOutput)
+	return ret0, ret1
+}
+
+// DescribeResourcePoliciesRequest indicates an expected call of DescribeResourcePoliciesRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeResourcePoliciesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeResourcePoliciesRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeResourcePoliciesRequest), arg0)
+}
+
+// DescribeResourcePoliciesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeResourcePoliciesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeResourcePoliciesInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeResourcePoliciesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeResourcePoliciesWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeResourcePoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeResourcePoliciesWithContext indicates an expected call of DescribeResourcePoliciesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeResourcePoliciesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeResourcePoliciesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeResourcePoliciesWithContext), varargs...)
+}
+
+// DescribeSubscriptionFilters mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeSubscriptionFilters(arg0 *cloudwatchlogs.DescribeSubscriptionFiltersInput) (*cloudwatchlogs.DescribeSubscriptionFiltersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSubscriptionFilters", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeSubscriptionFiltersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSubscriptionFilters indicates an expected call of DescribeSubscriptionFilters
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeSubscriptionFilters(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubscriptionFilters", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeSubscriptionFilters), arg0)
+}
+
+// DescribeSubscriptionFiltersPages mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeSubscriptionFiltersPages(arg0 *cloudwatchlogs.DescribeSubscriptionFiltersInput, arg1 func(*cloudwatchlogs.DescribeSubscriptionFiltersOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSubscriptionFiltersPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeSubscriptionFiltersPages indicates an expected call of DescribeSubscriptionFiltersPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeSubscriptionFiltersPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubscriptionFiltersPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeSubscriptionFiltersPages), arg0, arg1)
+}
+
+// DescribeSubscriptionFiltersPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeSubscriptionFiltersPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeSubscriptionFiltersInput, arg2 func(*cloudwatchlogs.DescribeSubscriptionFiltersOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSubscriptionFiltersPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeSubscriptionFiltersPagesWithContext indicates an expected call of DescribeSubscriptionFiltersPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeSubscriptionFiltersPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubscriptionFiltersPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeSubscriptionFiltersPagesWithContext), varargs...)
+}
+
+// DescribeSubscriptionFiltersRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeSubscriptionFiltersRequest(arg0 *cloudwatchlogs.DescribeSubscriptionFiltersInput) (*request.Request, *cloudwatchlogs.DescribeSubscriptionFiltersOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSubscriptionFiltersRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DescribeSubscriptionFiltersOutput)
+	return ret0, ret1
+}
+
+// DescribeSubscriptionFiltersRequest indicates an expected call of DescribeSubscriptionFiltersRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeSubscriptionFiltersRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionFilterRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteSubscriptionFilterRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubscriptionFiltersRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeSubscriptionFiltersRequest), arg0)
 }
 
-// DeleteSubscriptionFilterWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DeleteSubscriptionFilterWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DeleteSubscriptionFilterInput, arg2 ...request.Option) (*cloudwatchlogs.DeleteSubscriptionFilterOutput, error) {
+// DescribeSubscriptionFiltersWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DescribeSubscriptionFiltersWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeSubscriptionFiltersInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeSubscriptionFiltersOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteSubscriptionFilterWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DeleteSubscriptionFilterOutput)
+	ret := m.ctrl.Call(m, "DescribeSubscriptionFiltersWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeSubscriptionFiltersOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteSubscriptionFilterWithContext indicates an expected call of DeleteSubscriptionFilterWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DeleteSubscriptionFilterWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// DescribeSubscriptionFiltersWithContext indicates an expected call of DescribeSubscriptionFiltersWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeSubscriptionFiltersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionFilterWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DeleteSubscriptionFilterWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubscriptionFiltersWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeSubscriptionFiltersWithContext), varargs...)
 }
 
-// DescribeDestinations mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeDestinations(arg0 *cloudwatchlogs.DescribeDestinationsInput) (*cloudwatchlogs.DescribeDestinationsOutput, error) {
+// DisassociateKmsKey mocks base method
+func (m *MockCloudWatchLogsAPI) DisassociateKmsKey(arg0 *cloudwatchlogs.DisassociateKmsKeyInput) (*cloudwatchlogs.DisassociateKmsKeyOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeDestinations", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeDestinationsOutput)
+	ret := m.ctrl.Call(m, "DisassociateKmsKey", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.DisassociateKmsKeyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeDestinations indicates an expected call of DescribeDestinations
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDestinations(arg0 interface{}) *gomock.Call {
+// DisassociateKmsKey indicates an expected call of DisassociateKmsKey
+func (mr *MockCloudWatchLogsAPIMockRecorder) DisassociateKmsKey(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDestinations", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDestinations), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateKmsKey", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DisassociateKmsKey), arg0)
 }
 
-// DescribeDestinationsPages mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeDestinationsPages(arg0 *cloudwatchlogs.DescribeDestinationsInput, arg1 func(*cloudwatchlogs.DescribeDestinationsOutput, bool) bool) error {
+// DisassociateKmsKeyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) DisassociateKmsKeyRequest(arg0 *cloudwatchlogs.DisassociateKmsKeyInput) (*request.Request, *cloudwatchlogs.DisassociateKmsKeyOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeDestinationsPages", arg0, arg1)
+	ret := m.ctrl.Call(m, "DisassociateKmsKeyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.DisassociateKmsKeyOutput)
+	return ret0, ret1
+}
+
+// DisassociateKmsKeyRequest indicates an expected call of DisassociateKmsKeyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) DisassociateKmsKeyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateKmsKeyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DisassociateKmsKeyRequest), arg0)
+}
+
+// DisassociateKmsKeyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) DisassociateKmsKeyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DisassociateKmsKeyInput, arg2 ...request.Option) (*cloudwatchlogs.DisassociateKmsKeyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisassociateKmsKeyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.DisassociateKmsKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisassociateKmsKeyWithContext indicates an expected call of DisassociateKmsKeyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) DisassociateKmsKeyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateKmsKeyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DisassociateKmsKeyWithContext), varargs...)
+}
+
+// FilterLogEvents mocks base method
+func (m *MockCloudWatchLogsAPI) FilterLogEvents(arg0 *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterLogEvents", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.FilterLogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterLogEvents indicates an expected call of FilterLogEvents
+func (mr *MockCloudWatchLogsAPIMockRecorder) FilterLogEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEvents", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).FilterLogEvents), arg0)
+}
+
+// FilterLogEventsPages mocks base method
+func (m *MockCloudWatchLogsAPI) FilterLogEventsPages(arg0 *cloudwatchlogs.FilterLogEventsInput, arg1 func(*cloudwatchlogs.FilterLogEventsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterLogEventsPages", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DescribeDestinationsPages indicates an expected call of DescribeDestinationsPages
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDestinationsPages(arg0, arg1 interface{}) *gomock.Call {
+// FilterLogEventsPages indicates an expected call of FilterLogEventsPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) FilterLogEventsPages(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDestinationsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDestinationsPages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEventsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).FilterLogEventsPages), arg0, arg1)
 }
 
-// DescribeDestinationsPagesWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeDestinationsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeDestinationsInput, arg2 func(*cloudwatchlogs.DescribeDestinationsOutput, bool) bool, arg3 ...request.Option) error {
+// FilterLogEventsPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) FilterLogEventsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.FilterLogEventsInput, arg2 func(*cloudwatchlogs.FilterLogEventsOutput, bool) bool, arg3 ...request.Option) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1, arg2}
 	for _, a := range arg3 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeDestinationsPagesWithContext", varargs...)
+	ret := m.ctrl.Call(m, "FilterLogEventsPagesWithContext", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DescribeDestinationsPagesWithContext indicates an expected call of DescribeDestinationsPagesWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDestinationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+// FilterLogEventsPagesWithContext indicates an expected call of FilterLogEventsPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) FilterLogEventsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDestinationsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDestinationsPagesWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEventsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).FilterLogEventsPagesWithContext), varargs...)
 }
 
-// DescribeDestinationsRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeDestinationsRequest(arg0 *cloudwatchlogs.DescribeDestinationsInput) (*request.Request, *cloudwatchlogs.DescribeDestinationsOutput) {
+// FilterLogEventsRequest mocks base method
+func (m *MockCloudWatchLogsAPI) FilterLogEventsRequest(arg0 *cloudwatchlogs.FilterLogEventsInput) (*request.Request, *cloudwatchlogs.FilterLogEventsOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeDestinationsRequest", arg0)
+	ret := m.ctrl.Call(m, "FilterLogEventsRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DescribeDestinationsOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.FilterLogEventsOutput)
 	return ret0, ret1
 }
 
-// DescribeDestinationsRequest indicates an expected call of DescribeDestinationsRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDestinationsRequest(arg0 interface{}) *gomock.Call {
+// FilterLogEventsRequest indicates an expected call of FilterLogEventsRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) FilterLogEventsRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDestinationsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDestinationsRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEventsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).FilterLogEventsRequest), arg0)
 }
 
-// DescribeDestinationsWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeDestinationsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeDestinationsInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeDestinationsOutput, error) {
+// FilterLogEventsWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) FilterLogEventsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.FilterLogEventsInput, arg2 ...request.Option) (*cloudwatchlogs.FilterLogEventsOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeDestinationsWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeDestinationsOutput)
+	ret := m.ctrl.Call(m, "FilterLogEventsWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.FilterLogEventsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeDestinationsWithContext indicates an expected call of DescribeDestinationsWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeDestinationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// FilterLogEventsWithContext indicates an expected call of FilterLogEventsWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) FilterLogEventsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDestinationsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeDestinationsWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEventsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).FilterLogEventsWithContext), varargs...)
 }
 
-// DescribeExportTasks mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeExportTasks(arg0 *cloudwatchlogs.DescribeExportTasksInput) (*cloudwatchlogs.DescribeExportTasksOutput, error) {
+// GetDataProtectionPolicy mocks base method
+func (m *MockCloudWatchLogsAPI) GetDataProtectionPolicy(arg0 *cloudwatchlogs.GetDataProtectionPolicyInput) (*cloudwatchlogs.GetDataProtectionPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataProtectionPolicy", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetDataProtectionPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataProtectionPolicy indicates an expected call of GetDataProtectionPolicy
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDataProtectionPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataProtectionPolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDataProtectionPolicy), arg0)
+}
+
+// GetDataProtectionPolicyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) GetDataProtectionPolicyRequest(arg0 *cloudwatchlogs.GetDataProtectionPolicyInput) (*request.Request, *cloudwatchlogs.GetDataProtectionPolicyOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataProtectionPolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.GetDataProtectionPolicyOutput)
+	return ret0, ret1
+}
+
+// GetDataProtectionPolicyRequest indicates an expected call of GetDataProtectionPolicyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDataProtectionPolicyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataProtectionPolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDataProtectionPolicyRequest), arg0)
+}
+
+// GetDataProtectionPolicyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) GetDataProtectionPolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetDataProtectionPolicyInput, arg2 ...request.Option) (*cloudwatchlogs.GetDataProtectionPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDataProtectionPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetDataProtectionPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataProtectionPolicyWithContext indicates an expected call of GetDataProtectionPolicyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDataProtectionPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataProtectionPolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDataProtectionPolicyWithContext), varargs...)
+}
+
+// GetDelivery mocks base method
+func (m *MockCloudWatchLogsAPI) GetDelivery(arg0 *cloudwatchlogs.GetDeliveryInput) (*cloudwatchlogs.GetDeliveryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetDeliveryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelivery indicates an expected call of GetDelivery
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDelivery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDelivery), arg0)
+}
+
+// GetDeliveryDestination mocks base method
+func (m *MockCloudWatchLogsAPI) GetDeliveryDestination(arg0 *cloudwatchlogs.GetDeliveryDestinationInput) (*cloudwatchlogs.GetDeliveryDestinationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryDestination", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetDeliveryDestinationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveryDestination indicates an expected call of GetDeliveryDestination
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDeliveryDestination(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryDestination", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDeliveryDestination), arg0)
+}
+
+// GetDeliveryDestinationPolicy mocks base method
+func (m *MockCloudWatchLogsAPI) GetDeliveryDestinationPolicy(arg0 *cloudwatchlogs.GetDeliveryDestinationPolicyInput) (*cloudwatchlogs.GetDeliveryDestinationPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryDestinationPolicy", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetDeliveryDestinationPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveryDestinationPolicy indicates an expected call of GetDeliveryDestinationPolicy
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDeliveryDestinationPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryDestinationPolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDeliveryDestinationPolicy), arg0)
+}
+
+// GetDeliveryDestinationPolicyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) GetDeliveryDestinationPolicyRequest(arg0 *cloudwatchlogs.GetDeliveryDestinationPolicyInput) (*request.Request, *cloudwatchlogs.GetDeliveryDestinationPolicyOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryDestinationPolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.GetDeliveryDestinationPolicyOutput)
+	return ret0, ret1
+}
+
+// GetDeliveryDestinationPolicyRequest indicates an expected call of GetDeliveryDestinationPolicyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDeliveryDestinationPolicyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryDestinationPolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDeliveryDestinationPolicyRequest), arg0)
+}
+
+// GetDeliveryDestinationPolicyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) GetDeliveryDestinationPolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetDeliveryDestinationPolicyInput, arg2 ...request.Option) (*cloudwatchlogs.GetDeliveryDestinationPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeliveryDestinationPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetDeliveryDestinationPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveryDestinationPolicyWithContext indicates an expected call of GetDeliveryDestinationPolicyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDeliveryDestinationPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryDestinationPolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDeliveryDestinationPolicyWithContext), varargs...)
+}
+
+// GetDeliveryDestinationRequest mocks base method
+func (m *MockCloudWatchLogsAPI) GetDeliveryDestinationRequest(arg0 *cloudwatchlogs.GetDeliveryDestinationInput) (*request.Request, *cloudwatchlogs.GetDeliveryDestinationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryDestinationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.GetDeliveryDestinationOutput)
+	return ret0, ret1
+}
+
+// GetDeliveryDestinationRequest indicates an expected call of GetDeliveryDestinationRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDeliveryDestinationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryDestinationRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDeliveryDestinationRequest), arg0)
+}
+
+// GetDeliveryDestinationWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) GetDeliveryDestinationWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetDeliveryDestinationInput, arg2 ...request.Option) (*cloudwatchlogs.GetDeliveryDestinationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeliveryDestinationWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetDeliveryDestinationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveryDestinationWithContext indicates an expected call of GetDeliveryDestinationWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDeliveryDestinationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryDestinationWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDeliveryDestinationWithContext), varargs...)
+}
+
+// GetDeliveryRequest mocks base method
+func (m *MockCloudWatchLogsAPI) GetDeliveryRequest(arg0 *cloudwatchlogs.GetDeliveryInput) (*request.Request, *cloudwatchlogs.GetDeliveryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.GetDeliveryOutput)
+	return ret0, ret1
+}
+
+// GetDeliveryRequest indicates an expected call of GetDeliveryRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDeliveryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDeliveryRequest), arg0)
+}
+
+// GetDeliverySource mocks base method
+func (m *MockCloudWatchLogsAPI) GetDeliverySource(arg0 *cloudwatchlogs.GetDeliverySourceInput) (*cloudwatchlogs.GetDeliverySourceOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeExportTasks", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeExportTasksOutput)
+	ret := m.ctrl.Call(m, "GetDeliverySource", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetDeliverySourceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeExportTasks indicates an expected call of DescribeExportTasks
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeExportTasks(arg0 interface{}) *gomock.Call {
+// GetDeliverySource indicates an expected call of GetDeliverySource
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDeliverySource(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeExportTasks", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeExportTasks), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliverySource", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDeliverySource), arg0)
 }
 
-// DescribeExportTasksRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeExportTasksRequest(arg0 *cloudwatchlogs.DescribeExportTasksInput) (*request.Request, *cloudwatchlogs.DescribeExportTasksOutput) {
+// GetDeliverySourceRequest mocks base method
+func (m *MockCloudWatchLogsAPI) GetDeliverySourceRequest(arg0 *cloudwatchlogs.GetDeliverySourceInput) (*request.Request, *cloudwatchlogs.GetDeliverySourceOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeExportTasksRequest", arg0)
+	ret := m.ctrl.Call(m, "GetDeliverySourceRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DescribeExportTasksOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.GetDeliverySourceOutput)
 	return ret0, ret1
 }
 
-// DescribeExportTasksRequest indicates an expected call of DescribeExportTasksRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeExportTasksRequest(arg0 interface{}) *gomock.Call {
+// GetDeliverySourceRequest indicates an expected call of GetDeliverySourceRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDeliverySourceRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeExportTasksRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeExportTasksRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliverySourceRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDeliverySourceRequest), arg0)
 }
 
-// DescribeExportTasksWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeExportTasksWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeExportTasksInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeExportTasksOutput, error) {
+// GetDeliverySourceWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) GetDeliverySourceWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetDeliverySourceInput, arg2 ...request.Option) (*cloudwatchlogs.GetDeliverySourceOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeExportTasksWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeExportTasksOutput)
+	ret := m.ctrl.Call(m, "GetDeliverySourceWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetDeliverySourceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeExportTasksWithContext indicates an expected call of DescribeExportTasksWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeExportTasksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetDeliverySourceWithContext indicates an expected call of GetDeliverySourceWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDeliverySourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeExportTasksWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeExportTasksWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliverySourceWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDeliverySourceWithContext), varargs...)
 }
 
-// DescribeLogGroups mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeLogGroups(arg0 *cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+// GetDeliveryWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) GetDeliveryWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetDeliveryInput, arg2 ...request.Option) (*cloudwatchlogs.GetDeliveryOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLogGroups", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeLogGroupsOutput)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeliveryWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetDeliveryOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeLogGroups indicates an expected call of DescribeLogGroups
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogGroups(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogGroups", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogGroups), arg0)
-}
-
-// DescribeLogGroupsPages mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeLogGroupsPages(arg0 *cloudwatchlogs.DescribeLogGroupsInput, arg1 func(*cloudwatchlogs.DescribeLogGroupsOutput, bool) bool) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLogGroupsPages", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DescribeLogGroupsPages indicates an expected call of DescribeLogGroupsPages
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogGroupsPages(arg0, arg1 interface{}) *gomock.Call {
+// GetDeliveryWithContext indicates an expected call of GetDeliveryWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetDeliveryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogGroupsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogGroupsPages), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetDeliveryWithContext), varargs...)
 }
 
-// DescribeLogGroupsPagesWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeLogGroupsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeLogGroupsInput, arg2 func(*cloudwatchlogs.DescribeLogGroupsOutput, bool) bool, arg3 ...request.Option) error {
+// GetLogAnomalyDetector mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogAnomalyDetector(arg0 *cloudwatchlogs.GetLogAnomalyDetectorInput) (*cloudwatchlogs.GetLogAnomalyDetectorOutput, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1, arg2}
-	for _, a := range arg3 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "DescribeLogGroupsPagesWithContext", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetLogAnomalyDetector", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetLogAnomalyDetectorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DescribeLogGroupsPagesWithContext indicates an expected call of DescribeLogGroupsPagesWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogGroupsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+// GetLogAnomalyDetector indicates an expected call of GetLogAnomalyDetector
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogAnomalyDetector(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogGroupsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogGroupsPagesWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogAnomalyDetector", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogAnomalyDetector), arg0)
 }
 
-// DescribeLogGroupsRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeLogGroupsRequest(arg0 *cloudwatchlogs.DescribeLogGroupsInput) (*request.Request, *cloudwatchlogs.DescribeLogGroupsOutput) {
+// GetLogAnomalyDetectorRequest mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogAnomalyDetectorRequest(arg0 *cloudwatchlogs.GetLogAnomalyDetectorInput) (*request.Request, *cloudwatchlogs.GetLogAnomalyDetectorOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLogGroupsRequest", arg0)
+	ret := m.ctrl.Call(m, "GetLogAnomalyDetectorRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DescribeLogGroupsOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.GetLogAnomalyDetectorOutput)
 	return ret0, ret1
 }
 
-// DescribeLogGroupsRequest indicates an expected call of DescribeLogGroupsRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogGroupsRequest(arg0 interface{}) *gomock.Call {
+// GetLogAnomalyDetectorRequest indicates an expected call of GetLogAnomalyDetectorRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogAnomalyDetectorRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogGroupsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogGroupsRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogAnomalyDetectorRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogAnomalyDetectorRequest), arg0)
 }
 
-// DescribeLogGroupsWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeLogGroupsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeLogGroupsInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+// GetLogAnomalyDetectorWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogAnomalyDetectorWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetLogAnomalyDetectorInput, arg2 ...request.Option) (*cloudwatchlogs.GetLogAnomalyDetectorOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeLogGroupsWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeLogGroupsOutput)
+	ret := m.ctrl.Call(m, "GetLogAnomalyDetectorWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetLogAnomalyDetectorOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeLogGroupsWithContext indicates an expected call of DescribeLogGroupsWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetLogAnomalyDetectorWithContext indicates an expected call of GetLogAnomalyDetectorWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogAnomalyDetectorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogGroupsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogGroupsWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogAnomalyDetectorWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogAnomalyDetectorWithContext), varargs...)
 }
 
-// DescribeLogStreams mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeLogStreams(arg0 *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error) {
+// GetLogEvents mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogEvents(arg0 *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLogStreams", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeLogStreamsOutput)
+	ret := m.ctrl.Call(m, "GetLogEvents", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetLogEventsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeLogStreams indicates an expected call of DescribeLogStreams
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogStreams(arg0 interface{}) *gomock.Call {
+// GetLogEvents indicates an expected call of GetLogEvents
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogEvents(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogStreams", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogStreams), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEvents", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogEvents), arg0)
 }
 
-// DescribeLogStreamsPages mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeLogStreamsPages(arg0 *cloudwatchlogs.DescribeLogStreamsInput, arg1 func(*cloudwatchlogs.DescribeLogStreamsOutput, bool) bool) error {
+// GetLogEventsPages mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogEventsPages(arg0 *cloudwatchlogs.GetLogEventsInput, arg1 func(*cloudwatchlogs.GetLogEventsOutput, bool) bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLogStreamsPages", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetLogEventsPages", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DescribeLogStreamsPages indicates an expected call of DescribeLogStreamsPages
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogStreamsPages(arg0, arg1 interface{}) *gomock.Call {
+// GetLogEventsPages indicates an expected call of GetLogEventsPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogEventsPages(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogStreamsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogStreamsPages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEventsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogEventsPages), arg0, arg1)
 }
 
-// DescribeLogStreamsPagesWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeLogStreamsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeLogStreamsInput, arg2 func(*cloudwatchlogs.DescribeLogStreamsOutput, bool) bool, arg3 ...request.Option) error {
+// GetLogEventsPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogEventsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetLogEventsInput, arg2 func(*cloudwatchlogs.GetLogEventsOutput, bool) bool, arg3 ...request.Option) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1, arg2}
 	for _, a := range arg3 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeLogStreamsPagesWithContext", varargs...)
+	ret := m.ctrl.Call(m, "GetLogEventsPagesWithContext", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DescribeLogStreamsPagesWithContext indicates an expected call of DescribeLogStreamsPagesWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogStreamsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+// GetLogEventsPagesWithContext indicates an expected call of GetLogEventsPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogEventsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogStreamsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogStreamsPagesWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEventsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogEventsPagesWithContext), varargs...)
 }
 
-// DescribeLogStreamsRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeLogStreamsRequest(arg0 *cloudwatchlogs.DescribeLogStreamsInput) (*request.Request, *cloudwatchlogs.DescribeLogStreamsOutput) {
+// GetLogEventsRequest mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogEventsRequest(arg0 *cloudwatchlogs.GetLogEventsInput) (*request.Request, *cloudwatchlogs.GetLogEventsOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLogStreamsRequest", arg0)
+	ret := m.ctrl.Call(m, "GetLogEventsRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DescribeLogStreamsOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.GetLogEventsOutput)
 	return ret0, ret1
 }
 
-// DescribeLogStreamsRequest indicates an expected call of DescribeLogStreamsRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogStreamsRequest(arg0 interface{}) *gomock.Call {
+// GetLogEventsRequest indicates an expected call of GetLogEventsRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogEventsRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogStreamsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogStreamsRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEventsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogEventsRequest), arg0)
 }
 
-// DescribeLogStreamsWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeLogStreamsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeLogStreamsInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeLogStreamsOutput, error) {
+// GetLogEventsWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogEventsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetLogEventsInput, arg2 ...request.Option) (*cloudwatchlogs.GetLogEventsOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeLogStreamsWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeLogStreamsOutput)
+	ret := m.ctrl.Call(m, "GetLogEventsWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetLogEventsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeLogStreamsWithContext indicates an expected call of DescribeLogStreamsWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeLogStreamsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetLogEventsWithContext indicates an expected call of GetLogEventsWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogEventsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogStreamsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeLogStreamsWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEventsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogEventsWithContext), varargs...)
 }
 
-// DescribeMetricFilters mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeMetricFilters(arg0 *cloudwatchlogs.DescribeMetricFiltersInput) (*cloudwatchlogs.DescribeMetricFiltersOutput, error) {
+// GetLogGroupFields mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogGroupFields(arg0 *cloudwatchlogs.GetLogGroupFieldsInput) (*cloudwatchlogs.GetLogGroupFieldsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeMetricFilters", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeMetricFiltersOutput)
+	ret := m.ctrl.Call(m, "GetLogGroupFields", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetLogGroupFieldsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeMetricFilters indicates an expected call of DescribeMetricFilters
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeMetricFilters(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMetricFilters", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeMetricFilters), arg0)
-}
-
-// DescribeMetricFiltersPages mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeMetricFiltersPages(arg0 *cloudwatchlogs.DescribeMetricFiltersInput, arg1 func(*cloudwatchlogs.DescribeMetricFiltersOutput, bool) bool) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeMetricFiltersPages", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DescribeMetricFiltersPages indicates an expected call of DescribeMetricFiltersPages
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeMetricFiltersPages(arg0, arg1 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMetricFiltersPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeMetricFiltersPages), arg0, arg1)
-}
-
-// DescribeMetricFiltersPagesWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeMetricFiltersPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeMetricFiltersInput, arg2 func(*cloudwatchlogs.DescribeMetricFiltersOutput, bool) bool, arg3 ...request.Option) error {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1, arg2}
-	for _, a := range arg3 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "DescribeMetricFiltersPagesWithContext", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DescribeMetricFiltersPagesWithContext indicates an expected call of DescribeMetricFiltersPagesWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeMetricFiltersPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+// GetLogGroupFields indicates an expected call of GetLogGroupFields
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogGroupFields(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMetricFiltersPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeMetricFiltersPagesWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogGroupFields", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogGroupFields), arg0)
 }
 
-// DescribeMetricFiltersRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeMetricFiltersRequest(arg0 *cloudwatchlogs.DescribeMetricFiltersInput) (*request.Request, *cloudwatchlogs.DescribeMetricFiltersOutput) {
+// GetLogGroupFieldsRequest mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogGroupFieldsRequest(arg0 *cloudwatchlogs.GetLogGroupFieldsInput) (*request.Request, *cloudwatchlogs.GetLogGroupFieldsOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeMetricFiltersRequest", arg0)
+	ret := m.ctrl.Call(m, "GetLogGroupFieldsRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DescribeMetricFiltersOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.GetLogGroupFieldsOutput)
 	return ret0, ret1
 }
 
-// DescribeMetricFiltersRequest indicates an expected call of DescribeMetricFiltersRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeMetricFiltersRequest(arg0 interface{}) *gomock.Call {
+// GetLogGroupFieldsRequest indicates an expected call of GetLogGroupFieldsRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogGroupFieldsRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMetricFiltersRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeMetricFiltersRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogGroupFieldsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogGroupFieldsRequest), arg0)
 }
 
-// DescribeMetricFiltersWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeMetricFiltersWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeMetricFiltersInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeMetricFiltersOutput, error) {
+// GetLogGroupFieldsWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogGroupFieldsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetLogGroupFieldsInput, arg2 ...request.Option) (*cloudwatchlogs.GetLogGroupFieldsOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeMetricFiltersWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeMetricFiltersOutput)
+	ret := m.ctrl.Call(m, "GetLogGroupFieldsWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetLogGroupFieldsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeMetricFiltersWithContext indicates an expected call of DescribeMetricFiltersWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeMetricFiltersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetLogGroupFieldsWithContext indicates an expected call of GetLogGroupFieldsWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogGroupFieldsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMetricFiltersWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeMetricFiltersWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogGroupFieldsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogGroupFieldsWithContext), varargs...)
 }
 
-// DescribeQueries mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeQueries(arg0 *cloudwatchlogs.DescribeQueriesInput) (*cloudwatchlogs.DescribeQueriesOutput, error) {
+// GetLogRecord mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogRecord(arg0 *cloudwatchlogs.GetLogRecordInput) (*cloudwatchlogs.GetLogRecordOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeQueries", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeQueriesOutput)
+	ret := m.ctrl.Call(m, "GetLogRecord", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetLogRecordOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeQueries indicates an expected call of DescribeQueries
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeQueries(arg0 interface{}) *gomock.Call {
+// GetLogRecord indicates an expected call of GetLogRecord
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogRecord(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueries", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeQueries), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogRecord", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogRecord), arg0)
 }
 
-// DescribeQueriesRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeQueriesRequest(arg0 *cloudwatchlogs.DescribeQueriesInput) (*request.Request, *cloudwatchlogs.DescribeQueriesOutput) {
+// GetLogRecordRequest mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogRecordRequest(arg0 *cloudwatchlogs.GetLogRecordInput) (*request.Request, *cloudwatchlogs.GetLogRecordOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeQueriesRequest", arg0)
+	ret := m.ctrl.Call(m, "GetLogRecordRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DescribeQueriesOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.GetLogRecordOutput)
 	return ret0, ret1
 }
 
-// DescribeQueriesRequest indicates an expected call of DescribeQueriesRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeQueriesRequest(arg0 interface{}) *gomock.Call {
+// GetLogRecordRequest indicates an expected call of GetLogRecordRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogRecordRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueriesRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeQueriesRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogRecordRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogRecordRequest), arg0)
 }
 
-// DescribeQueriesWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeQueriesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeQueriesInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeQueriesOutput, error) {
+// GetLogRecordWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) GetLogRecordWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetLogRecordInput, arg2 ...request.Option) (*cloudwatchlogs.GetLogRecordOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeQueriesWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeQueriesOutput)
+	ret := m.ctrl.Call(m, "GetLogRecordWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetLogRecordOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeQueriesWithContext indicates an expected call of DescribeQueriesWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeQueriesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetLogRecordWithContext indicates an expected call of GetLogRecordWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogRecordWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueriesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeQueriesWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogRecordWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogRecordWithContext), varargs...)
 }
 
-// DescribeResourcePolicies mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeResourcePolicies(arg0 *cloudwatchlogs.DescribeResourcePoliciesInput) (*cloudwatchlogs.DescribeResourcePoliciesOutput, error) {
+// GetQueryResults mocks base method
+func (m *MockCloudWatchLogsAPI) GetQueryResults(arg0 *cloudwatchlogs.GetQueryResultsInput) (*cloudwatchlogs.GetQueryResultsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeResourcePolicies", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeResourcePoliciesOutput)
+	ret := m.ctrl.Call(m, "GetQueryResults", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetQueryResultsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeResourcePolicies indicates an expected call of DescribeResourcePolicies
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeResourcePolicies(arg0 interface{}) *gomock.Call {
+// GetQueryResults indicates an expected call of GetQueryResults
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetQueryResults(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeResourcePolicies", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeResourcePolicies), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryResults", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetQueryResults), arg0)
 }
 
-// DescribeResourcePoliciesRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeResourcePoliciesRequest(arg0 *cloudwatchlogs.DescribeResourcePoliciesInput) (*request.Request, *cloudwatchlogs.DescribeResourcePoliciesOutput) {
+// GetQueryResultsRequest mocks base method
+func (m *MockCloudWatchLogsAPI) GetQueryResultsRequest(arg0 *cloudwatchlogs.GetQueryResultsInput) (*request.Request, *cloudwatchlogs.GetQueryResultsOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeResourcePoliciesRequest", arg0)
+	ret := m.ctrl.Call(m, "GetQueryResultsRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DescribeResourcePoliciesOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.GetQueryResultsOutput)
 	return ret0, ret1
 }
 
-// DescribeResourcePoliciesRequest indicates an expected call of DescribeResourcePoliciesRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeResourcePoliciesRequest(arg0 interface{}) *gomock.Call {
+// GetQueryResultsRequest indicates an expected call of GetQueryResultsRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetQueryResultsRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeResourcePoliciesRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeResourcePoliciesRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryResultsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetQueryResultsRequest), arg0)
 }
 
-// DescribeResourcePoliciesWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeResourcePoliciesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeResourcePoliciesInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeResourcePoliciesOutput, error) {
+// GetQueryResultsWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) GetQueryResultsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetQueryResultsInput, arg2 ...request.Option) (*cloudwatchlogs.GetQueryResultsOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeResourcePoliciesWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeResourcePoliciesOutput)
+	ret := m.ctrl.Call(m, "GetQueryResultsWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetQueryResultsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeResourcePoliciesWithContext indicates an expected call of DescribeResourcePoliciesWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeResourcePoliciesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetQueryResultsWithContext indicates an expected call of GetQueryResultsWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) GetQueryResultsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeResourcePoliciesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeResourcePoliciesWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryResultsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetQueryResultsWithContext), varargs...)
 }
 
-// DescribeSubscriptionFilters mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeSubscriptionFilters(arg0 *cloudwatchlogs.DescribeSubscriptionFiltersInput) (*cloudwatchlogs.DescribeSubscriptionFiltersOutput, error) {
+// ListAnomalies mocks base method
+func (m *MockCloudWatchLogsAPI) ListAnomalies(arg0 *cloudwatchlogs.ListAnomaliesInput) (*cloudwatchlogs.ListAnomaliesOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeSubscriptionFilters", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeSubscriptionFiltersOutput)
+	ret := m.ctrl.Call(m, "ListAnomalies", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.ListAnomaliesOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeSubscriptionFilters indicates an expected call of DescribeSubscriptionFilters
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeSubscriptionFilters(arg0 interface{}) *gomock.Call {
+// ListAnomalies indicates an expected call of ListAnomalies
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListAnomalies(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubscriptionFilters", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeSubscriptionFilters), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnomalies", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListAnomalies), arg0)
 }
 
-// DescribeSubscriptionFiltersPages mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeSubscriptionFiltersPages(arg0 *cloudwatchlogs.DescribeSubscriptionFiltersInput, arg1 func(*cloudwatchlogs.DescribeSubscriptionFiltersOutput, bool) bool) error {
+// ListAnomaliesPages mocks base method
+func (m *MockCloudWatchLogsAPI) ListAnomaliesPages(arg0 *cloudwatchlogs.ListAnomaliesInput, arg1 func(*cloudwatchlogs.ListAnomaliesOutput, bool) bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeSubscriptionFiltersPages", arg0, arg1)
+	ret := m.ctrl.Call(m, "ListAnomaliesPages", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DescribeSubscriptionFiltersPages indicates an expected call of DescribeSubscriptionFiltersPages
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeSubscriptionFiltersPages(arg0, arg1 interface{}) *gomock.Call {
+// ListAnomaliesPages indicates an expected call of ListAnomaliesPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListAnomaliesPages(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubscriptionFiltersPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeSubscriptionFiltersPages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnomaliesPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListAnomaliesPages), arg0, arg1)
 }
 
-// DescribeSubscriptionFiltersPagesWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeSubscriptionFiltersPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeSubscriptionFiltersInput, arg2 func(*cloudwatchlogs.DescribeSubscriptionFiltersOutput, bool) bool, arg3 ...request.Option) error {
+// ListAnomaliesPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) ListAnomaliesPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.ListAnomaliesInput, arg2 func(*cloudwatchlogs.ListAnomaliesOutput, bool) bool, arg3 ...request.Option) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1, arg2}
 	for _, a := range arg3 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeSubscriptionFiltersPagesWithContext", varargs...)
+	ret := m.ctrl.Call(m, "ListAnomaliesPagesWithContext", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DescribeSubscriptionFiltersPagesWithContext indicates an expected call of DescribeSubscriptionFiltersPagesWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeSubscriptionFiltersPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+// ListAnomaliesPagesWithContext indicates an expected call of ListAnomaliesPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListAnomaliesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubscriptionFiltersPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeSubscriptionFiltersPagesWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnomaliesPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListAnomaliesPagesWithContext), varargs...)
 }
 
-// DescribeSubscriptionFiltersRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeSubscriptionFiltersRequest(arg0 *cloudwatchlogs.DescribeSubscriptionFiltersInput) (*request.Request, *cloudwatchlogs.DescribeSubscriptionFiltersOutput) {
+// ListAnomaliesRequest mocks base method
+func (m *MockCloudWatchLogsAPI) ListAnomaliesRequest(arg0 *cloudwatchlogs.ListAnomaliesInput) (*request.Request, *cloudwatchlogs.ListAnomaliesOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeSubscriptionFiltersRequest", arg0)
+	ret := m.ctrl.Call(m, "ListAnomaliesRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DescribeSubscriptionFiltersOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.ListAnomaliesOutput)
 	return ret0, ret1
 }
 
-// DescribeSubscriptionFiltersRequest indicates an expected call of DescribeSubscriptionFiltersRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeSubscriptionFiltersRequest(arg0 interface{}) *gomock.Call {
+// ListAnomaliesRequest indicates an expected call of ListAnomaliesRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListAnomaliesRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubscriptionFiltersRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeSubscriptionFiltersRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnomaliesRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListAnomaliesRequest), arg0)
 }
 
-// DescribeSubscriptionFiltersWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DescribeSubscriptionFiltersWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DescribeSubscriptionFiltersInput, arg2 ...request.Option) (*cloudwatchlogs.DescribeSubscriptionFiltersOutput, error) {
+// ListAnomaliesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) ListAnomaliesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.ListAnomaliesInput, arg2 ...request.Option) (*cloudwatchlogs.ListAnomaliesOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DescribeSubscriptionFiltersWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DescribeSubscriptionFiltersOutput)
+	ret := m.ctrl.Call(m, "ListAnomaliesWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.ListAnomaliesOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeSubscriptionFiltersWithContext indicates an expected call of DescribeSubscriptionFiltersWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DescribeSubscriptionFiltersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListAnomaliesWithContext indicates an expected call of ListAnomaliesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListAnomaliesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubscriptionFiltersWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DescribeSubscriptionFiltersWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnomaliesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListAnomaliesWithContext), varargs...)
 }
 
-// DisassociateKmsKey mocks base method
-func (m *MockCloudWatchLogsAPI) DisassociateKmsKey(arg0 *cloudwatchlogs.DisassociateKmsKeyInput) (*cloudwatchlogs.DisassociateKmsKeyOutput, error) {
+// ListLogAnomalyDetectors mocks base method
+func (m *MockCloudWatchLogsAPI) ListLogAnomalyDetectors(arg0 *cloudwatchlogs.ListLogAnomalyDetectorsInput) (*cloudwatchlogs.ListLogAnomalyDetectorsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisassociateKmsKey", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.DisassociateKmsKeyOutput)
+	ret := m.ctrl.Call(m, "ListLogAnomalyDetectors", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.ListLogAnomalyDetectorsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DisassociateKmsKey indicates an expected call of DisassociateKmsKey
-func (mr *MockCloudWatchLogsAPIMockRecorder) DisassociateKmsKey(arg0 interface{}) *gomock.Call {
+// ListLogAnomalyDetectors indicates an expected call of ListLogAnomalyDetectors
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListLogAnomalyDetectors(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateKmsKey", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DisassociateKmsKey), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLogAnomalyDetectors", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListLogAnomalyDetectors), arg0)
 }
 
-// DisassociateKmsKeyRequest mocks base method
-func (m *MockCloudWatchLogsAPI) DisassociateKmsKeyRequest(arg0 *cloudwatchlogs.DisassociateKmsKeyInput) (*request.Request, *cloudwatchlogs.DisassociateKmsKeyOutput) {
+// ListLogAnomalyDetectorsPages mocks base method
+func (m *MockCloudWatchLogsAPI) ListLogAnomalyDetectorsPages(arg0 *cloudwatchlogs.ListLogAnomalyDetectorsInput, arg1 func(*cloudwatchlogs.ListLogAnomalyDetectorsOutput, bool) bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisassociateKmsKeyRequest", arg0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.DisassociateKmsKeyOutput)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ListLogAnomalyDetectorsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// DisassociateKmsKeyRequest indicates an expected call of DisassociateKmsKeyRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) DisassociateKmsKeyRequest(arg0 interface{}) *gomock.Call {
+// ListLogAnomalyDetectorsPages indicates an expected call of ListLogAnomalyDetectorsPages
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListLogAnomalyDetectorsPages(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateKmsKeyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DisassociateKmsKeyRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLogAnomalyDetectorsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListLogAnomalyDetectorsPages), arg0, arg1)
 }
 
-// DisassociateKmsKeyWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) DisassociateKmsKeyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.DisassociateKmsKeyInput, arg2 ...request.Option) (*cloudwatchlogs.DisassociateKmsKeyOutput, error) {
+// ListLogAnomalyDetectorsPagesWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) ListLogAnomalyDetectorsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.ListLogAnomalyDetectorsInput, arg2 func(*cloudwatchlogs.ListLogAnomalyDetectorsOutput, bool) bool, arg3 ...request.Option) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DisassociateKmsKeyWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.DisassociateKmsKeyOutput)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ListLogAnomalyDetectorsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// DisassociateKmsKeyWithContext indicates an expected call of DisassociateKmsKeyWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) DisassociateKmsKeyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListLogAnomalyDetectorsPagesWithContext indicates an expected call of ListLogAnomalyDetectorsPagesWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListLogAnomalyDetectorsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateKmsKeyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).DisassociateKmsKeyWithContext), varargs...)
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLogAnomalyDetectorsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListLogAnomalyDetectorsPagesWithContext), varargs...)
 }
 
-// FilterLogEvents mocks base method
-func (m *MockCloudWatchLogsAPI) FilterLogEvents(arg0 *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+// ListLogAnomalyDetectorsRequest mocks base method
+func (m *MockCloudWatchLogsAPI) ListLogAnomalyDetectorsRequest(arg0 *cloudwatchlogs.ListLogAnomalyDetectorsInput) (*request.Request, *cloudwatchlogs.ListLogAnomalyDetectorsOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FilterLogEvents", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.FilterLogEventsOutput)
-	ret1, _ := ret[1].(error)
+	ret := m.ctrl.Call(m, "ListLogAnomalyDetectorsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.ListLogAnomalyDetectorsOutput)
 	return ret0, ret1
 }
 
-// FilterLogEvents indicates an expected call of FilterLogEvents
-func (mr *MockCloudWatchLogsAPIMockRecorder) FilterLogEvents(arg0 interface{}) *gomock.Call {
+// ListLogAnomalyDetectorsRequest indicates an expected call of ListLogAnomalyDetectorsRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListLogAnomalyDetectorsRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEvents", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).FilterLogEvents), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLogAnomalyDetectorsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListLogAnomalyDetectorsRequest), arg0)
 }
 
-// FilterLogEventsPages mocks base method
-func (m *MockCloudWatchLogsAPI) FilterLogEventsPages(arg0 *cloudwatchlogs.FilterLogEventsInput, arg1 func(*cloudwatchlogs.FilterLogEventsOutput, bool) bool) error {
+// ListLogAnomalyDetectorsWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) ListLogAnomalyDetectorsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.ListLogAnomalyDetectorsInput, arg2 ...request.Option) (*cloudwatchlogs.ListLogAnomalyDetectorsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FilterLogEventsPages", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLogAnomalyDetectorsWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.ListLogAnomalyDetectorsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// FilterLogEventsPages indicates an expected call of FilterLogEventsPages
-func (mr *MockCloudWatchLogsAPIMockRecorder) FilterLogEventsPages(arg0, arg1 interface{}) *gomock.Call {
+// ListLogAnomalyDetectorsWithContext indicates an expected call of ListLogAnomalyDetectorsWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListLogAnomalyDetectorsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEventsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).FilterLogEventsPages), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLogAnomalyDetectorsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListLogAnomalyDetectorsWithContext), varargs...)
 }
 
-// FilterLogEventsPagesWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) FilterLogEventsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.FilterLogEventsInput, arg2 func(*cloudwatchlogs.FilterLogEventsOutput, bool) bool, arg3 ...request.Option) error {
+// ListTagsForResource mocks base method
+func (m *MockCloudWatchLogsAPI) ListTagsForResource(arg0 *cloudwatchlogs.ListTagsForResourceInput) (*cloudwatchlogs.ListTagsForResourceOutput, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1, arg2}
-	for _, a := range arg3 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "FilterLogEventsPagesWithContext", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListTagsForResource", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.ListTagsForResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// FilterLogEventsPagesWithContext indicates an expected call of FilterLogEventsPagesWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) FilterLogEventsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+// ListTagsForResource indicates an expected call of ListTagsForResource
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListTagsForResource(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEventsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).FilterLogEventsPagesWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResource", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListTagsForResource), arg0)
 }
 
-// FilterLogEventsRequest mocks base method
-func (m *MockCloudWatchLogsAPI) FilterLogEventsRequest(arg0 *cloudwatchlogs.FilterLogEventsInput) (*request.Request, *cloudwatchlogs.FilterLogEventsOutput) {
+// ListTagsForResourceRequest mocks base method
+func (m *MockCloudWatchLogsAPI) ListTagsForResourceRequest(arg0 *cloudwatchlogs.ListTagsForResourceInput) (*request.Request, *cloudwatchlogs.ListTagsForResourceOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FilterLogEventsRequest", arg0)
+	ret := m.ctrl.Call(m, "ListTagsForResourceRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.FilterLogEventsOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.ListTagsForResourceOutput)
 	return ret0, ret1
 }
 
-// FilterLogEventsRequest indicates an expected call of FilterLogEventsRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) FilterLogEventsRequest(arg0 interface{}) *gomock.Call {
+// ListTagsForResourceRequest indicates an expected call of ListTagsForResourceRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListTagsForResourceRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEventsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).FilterLogEventsRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourceRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListTagsForResourceRequest), arg0)
 }
 
-// FilterLogEventsWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) FilterLogEventsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.FilterLogEventsInput, arg2 ...request.Option) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+// ListTagsForResourceWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) ListTagsForResourceWithContext(arg0 context.Context, arg1 *cloudwatchlogs.ListTagsForResourceInput, arg2 ...request.Option) (*cloudwatchlogs.ListTagsForResourceOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "FilterLogEventsWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.FilterLogEventsOutput)
+	ret := m.ctrl.Call(m, "ListTagsForResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.ListTagsForResourceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FilterLogEventsWithContext indicates an expected call of FilterLogEventsWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) FilterLogEventsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListTagsForResourceWithContext indicates an expected call of ListTagsForResourceWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListTagsForResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEventsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).FilterLogEventsWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourceWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListTagsForResourceWithContext), varargs...)
 }
 
-// GetLogEvents mocks base method
-func (m *MockCloudWatchLogsAPI) GetLogEvents(arg0 *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error) {
+// ListTagsLogGroup mocks base method
+func (m *MockCloudWatchLogsAPI) ListTagsLogGroup(arg0 *cloudwatchlogs.ListTagsLogGroupInput) (*cloudwatchlogs.ListTagsLogGroupOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLogEvents", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.GetLogEventsOutput)
+	ret := m.ctrl.Call(m, "ListTagsLogGroup", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.ListTagsLogGroupOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLogEvents indicates an expected call of GetLogEvents
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogEvents(arg0 interface{}) *gomock.Call {
+// ListTagsLogGroup indicates an expected call of ListTagsLogGroup
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListTagsLogGroup(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEvents", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogEvents), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsLogGroup", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListTagsLogGroup), arg0)
 }
 
-// GetLogEventsPages mocks base method
-func (m *MockCloudWatchLogsAPI) GetLogEventsPages(arg0 *cloudwatchlogs.GetLogEventsInput, arg1 func(*cloudwatchlogs.GetLogEventsOutput, bool) bool) error {
+// ListTagsLogGroupRequest mocks base method
+func (m *MockCloudWatchLogsAPI) ListTagsLogGroupRequest(arg0 *cloudwatchlogs.ListTagsLogGroupInput) (*request.Request, *cloudwatchlogs.ListTagsLogGroupOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLogEventsPages", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListTagsLogGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.ListTagsLogGroupOutput)
+	return ret0, ret1
 }
 
-// GetLogEventsPages indicates an expected call of GetLogEventsPages
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogEventsPages(arg0, arg1 interface{}) *gomock.Call {
+// ListTagsLogGroupRequest indicates an expected call of ListTagsLogGroupRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListTagsLogGroupRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEventsPages", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogEventsPages), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsLogGroupRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListTagsLogGroupRequest), arg0)
 }
 
-// GetLogEventsPagesWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) GetLogEventsPagesWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetLogEventsInput, arg2 func(*cloudwatchlogs.GetLogEventsOutput, bool) bool, arg3 ...request.Option) error {
+// ListTagsLogGroupWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) ListTagsLogGroupWithContext(arg0 context.Context, arg1 *cloudwatchlogs.ListTagsLogGroupInput, arg2 ...request.Option) (*cloudwatchlogs.ListTagsLogGroupOutput, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1, arg2}
-	for _, a := range arg3 {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetLogEventsPagesWithContext", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListTagsLogGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.ListTagsLogGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetLogEventsPagesWithContext indicates an expected call of GetLogEventsPagesWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogEventsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+// ListTagsLogGroupWithContext indicates an expected call of ListTagsLogGroupWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) ListTagsLogGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEventsPagesWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogEventsPagesWithContext), varargs...)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsLogGroupWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListTagsLogGroupWithContext), varargs...)
 }
 
-// GetLogEventsRequest mocks base method
-func (m *MockCloudWatchLogsAPI) GetLogEventsRequest(arg0 *cloudwatchlogs.GetLogEventsInput) (*request.Request, *cloudwatchlogs.GetLogEventsOutput) {
+// PutAccountPolicy mocks base method
+func (m *MockCloudWatchLogsAPI) PutAccountPolicy(arg0 *cloudwatchlogs.PutAccountPolicyInput) (*cloudwatchlogs.PutAccountPolicyOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLogEventsRequest", arg0)
+	ret := m.ctrl.Call(m, "PutAccountPolicy", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutAccountPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutAccountPolicy indicates an expected call of PutAccountPolicy
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutAccountPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAccountPolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutAccountPolicy), arg0)
+}
+
+// PutAccountPolicyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) PutAccountPolicyRequest(arg0 *cloudwatchlogs.PutAccountPolicyInput) (*request.Request, *cloudwatchlogs.PutAccountPolicyOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutAccountPolicyRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.GetLogEventsOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.PutAccountPolicyOutput)
 	return ret0, ret1
 }
 
-// GetLogEventsRequest indicates an expected call of GetLogEventsRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogEventsRequest(arg0 interface{}) *gomock.Call {
+// PutAccountPolicyRequest indicates an expected call of PutAccountPolicyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutAccountPolicyRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEventsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogEventsRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAccountPolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutAccountPolicyRequest), arg0)
 }
 
-// GetLogEventsWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) GetLogEventsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetLogEventsInput, arg2 ...request.Option) (*cloudwatchlogs.GetLogEventsOutput, error) {
+// PutAccountPolicyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) PutAccountPolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.PutAccountPolicyInput, arg2 ...request.Option) (*cloudwatchlogs.PutAccountPolicyOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetLogEventsWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.GetLogEventsOutput)
+	ret := m.ctrl.Call(m, "PutAccountPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutAccountPolicyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLogEventsWithContext indicates an expected call of GetLogEventsWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogEventsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// PutAccountPolicyWithContext indicates an expected call of PutAccountPolicyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutAccountPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEventsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogEventsWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAccountPolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutAccountPolicyWithContext), varargs...)
 }
 
-// GetLogGroupFields mocks base method
-func (m *MockCloudWatchLogsAPI) GetLogGroupFields(arg0 *cloudwatchlogs.GetLogGroupFieldsInput) (*cloudwatchlogs.GetLogGroupFieldsOutput, error) {
+// PutDataProtectionPolicy mocks base method
+func (m *MockCloudWatchLogsAPI) PutDataProtectionPolicy(arg0 *cloudwatchlogs.PutDataProtectionPolicyInput) (*cloudwatchlogs.PutDataProtectionPolicyOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLogGroupFields", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.GetLogGroupFieldsOutput)
+	ret := m.ctrl.Call(m, "PutDataProtectionPolicy", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutDataProtectionPolicyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLogGroupFields indicates an expected call of GetLogGroupFields
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogGroupFields(arg0 interface{}) *gomock.Call {
+// PutDataProtectionPolicy indicates an expected call of PutDataProtectionPolicy
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDataProtectionPolicy(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogGroupFields", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogGroupFields), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDataProtectionPolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDataProtectionPolicy), arg0)
 }
 
-// GetLogGroupFieldsRequest mocks base method
-func (m *MockCloudWatchLogsAPI) GetLogGroupFieldsRequest(arg0 *cloudwatchlogs.GetLogGroupFieldsInput) (*request.Request, *cloudwatchlogs.GetLogGroupFieldsOutput) {
+// PutDataProtectionPolicyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) PutDataProtectionPolicyRequest(arg0 *cloudwatchlogs.PutDataProtectionPolicyInput) (*request.Request, *cloudwatchlogs.PutDataProtectionPolicyOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLogGroupFieldsRequest", arg0)
+	ret := m.ctrl.Call(m, "PutDataProtectionPolicyRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.GetLogGroupFieldsOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.PutDataProtectionPolicyOutput)
 	return ret0, ret1
 }
 
-// GetLogGroupFieldsRequest indicates an expected call of GetLogGroupFieldsRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogGroupFieldsRequest(arg0 interface{}) *gomock.Call {
+// PutDataProtectionPolicyRequest indicates an expected call of PutDataProtectionPolicyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDataProtectionPolicyRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogGroupFieldsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogGroupFieldsRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDataProtectionPolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDataProtectionPolicyRequest), arg0)
 }
 
-// GetLogGroupFieldsWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) GetLogGroupFieldsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetLogGroupFieldsInput, arg2 ...request.Option) (*cloudwatchlogs.GetLogGroupFieldsOutput, error) {
+// PutDataProtectionPolicyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) PutDataProtectionPolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.PutDataProtectionPolicyInput, arg2 ...request.Option) (*cloudwatchlogs.PutDataProtectionPolicyOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetLogGroupFieldsWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.GetLogGroupFieldsOutput)
+	ret := m.ctrl.Call(m, "PutDataProtectionPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutDataProtectionPolicyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLogGroupFieldsWithContext indicates an expected call of GetLogGroupFieldsWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogGroupFieldsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// PutDataProtectionPolicyWithContext indicates an expected call of PutDataProtectionPolicyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDataProtectionPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogGroupFieldsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogGroupFieldsWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDataProtectionPolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDataProtectionPolicyWithContext), varargs...)
 }
 
-// GetLogRecord mocks base method
-func (m *MockCloudWatchLogsAPI) GetLogRecord(arg0 *cloudwatchlogs.GetLogRecordInput) (*cloudwatchlogs.GetLogRecordOutput, error) {
+// PutDeliveryDestination mocks base method
+func (m *MockCloudWatchLogsAPI) PutDeliveryDestination(arg0 *cloudwatchlogs.PutDeliveryDestinationInput) (*cloudwatchlogs.PutDeliveryDestinationOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLogRecord", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.GetLogRecordOutput)
+	ret := m.ctrl.Call(m, "PutDeliveryDestination", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutDeliveryDestinationOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLogRecord indicates an expected call of GetLogRecord
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogRecord(arg0 interface{}) *gomock.Call {
+// PutDeliveryDestination indicates an expected call of PutDeliveryDestination
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDeliveryDestination(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogRecord", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogRecord), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliveryDestination", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDeliveryDestination), arg0)
 }
 
-// GetLogRecordRequest mocks base method
-func (m *MockCloudWatchLogsAPI) GetLogRecordRequest(arg0 *cloudwatchlogs.GetLogRecordInput) (*request.Request, *cloudwatchlogs.GetLogRecordOutput) {
+// PutDeliveryDestinationPolicy mocks base method
+func (m *MockCloudWatchLogsAPI) PutDeliveryDestinationPolicy(arg0 *cloudwatchlogs.PutDeliveryDestinationPolicyInput) (*cloudwatchlogs.PutDeliveryDestinationPolicyOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLogRecordRequest", arg0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.GetLogRecordOutput)
+	ret := m.ctrl.Call(m, "PutDeliveryDestinationPolicy", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutDeliveryDestinationPolicyOutput)
+	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLogRecordRequest indicates an expected call of GetLogRecordRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogRecordRequest(arg0 interface{}) *gomock.Call {
+// PutDeliveryDestinationPolicy indicates an expected call of PutDeliveryDestinationPolicy
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDeliveryDestinationPolicy(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogRecordRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogRecordRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliveryDestinationPolicy", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDeliveryDestinationPolicy), arg0)
 }
 
-// GetLogRecordWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) GetLogRecordWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetLogRecordInput, arg2 ...request.Option) (*cloudwatchlogs.GetLogRecordOutput, error) {
+// PutDeliveryDestinationPolicyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) PutDeliveryDestinationPolicyRequest(arg0 *cloudwatchlogs.PutDeliveryDestinationPolicyInput) (*request.Request, *cloudwatchlogs.PutDeliveryDestinationPolicyOutput) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetLogRecordWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.GetLogRecordOutput)
-	ret1, _ := ret[1].(error)
+	ret := m.ctrl.Call(m, "PutDeliveryDestinationPolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.PutDeliveryDestinationPolicyOutput)
 	return ret0, ret1
 }
 
-// GetLogRecordWithContext indicates an expected call of GetLogRecordWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetLogRecordWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// PutDeliveryDestinationPolicyRequest indicates an expected call of PutDeliveryDestinationPolicyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDeliveryDestinationPolicyRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogRecordWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetLogRecordWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliveryDestinationPolicyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDeliveryDestinationPolicyRequest), arg0)
 }
 
-// GetQueryResults mocks base method
-func (m *MockCloudWatchLogsAPI) GetQueryResults(arg0 *cloudwatchlogs.GetQueryResultsInput) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+// PutDeliveryDestinationPolicyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) PutDeliveryDestinationPolicyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.PutDeliveryDestinationPolicyInput, arg2 ...request.Option) (*cloudwatchlogs.PutDeliveryDestinationPolicyOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetQueryResults", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.GetQueryResultsOutput)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutDeliveryDestinationPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutDeliveryDestinationPolicyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetQueryResults indicates an expected call of GetQueryResults
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetQueryResults(arg0 interface{}) *gomock.Call {
+// PutDeliveryDestinationPolicyWithContext indicates an expected call of PutDeliveryDestinationPolicyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDeliveryDestinationPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryResults", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetQueryResults), arg0)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliveryDestinationPolicyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDeliveryDestinationPolicyWithContext), varargs...)
 }
 
-// GetQueryResultsRequest mocks base method
-func (m *MockCloudWatchLogsAPI) GetQueryResultsRequest(arg0 *cloudwatchlogs.GetQueryResultsInput) (*request.Request, *cloudwatchlogs.GetQueryResultsOutput) {
+// PutDeliveryDestinationRequest mocks base method
+func (m *MockCloudWatchLogsAPI) PutDeliveryDestinationRequest(arg0 *cloudwatchlogs.PutDeliveryDestinationInput) (*request.Request, *cloudwatchlogs.PutDeliveryDestinationOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetQueryResultsRequest", arg0)
+	ret := m.ctrl.Call(m, "PutDeliveryDestinationRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.GetQueryResultsOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.PutDeliveryDestinationOutput)
 	return ret0, ret1
 }
 
-// GetQueryResultsRequest indicates an expected call of GetQueryResultsRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetQueryResultsRequest(arg0 interface{}) *gomock.Call {
+// PutDeliveryDestinationRequest indicates an expected call of PutDeliveryDestinationRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDeliveryDestinationRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryResultsRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetQueryResultsRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliveryDestinationRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDeliveryDestinationRequest), arg0)
 }
 
-// GetQueryResultsWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) GetQueryResultsWithContext(arg0 context.Context, arg1 *cloudwatchlogs.GetQueryResultsInput, arg2 ...request.Option) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+// PutDeliveryDestinationWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) PutDeliveryDestinationWithContext(arg0 context.Context, arg1 *cloudwatchlogs.PutDeliveryDestinationInput, arg2 ...request.Option) (*cloudwatchlogs.PutDeliveryDestinationOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetQueryResultsWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.GetQueryResultsOutput)
+	ret := m.ctrl.Call(m, "PutDeliveryDestinationWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutDeliveryDestinationOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetQueryResultsWithContext indicates an expected call of GetQueryResultsWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) GetQueryResultsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// PutDeliveryDestinationWithContext indicates an expected call of PutDeliveryDestinationWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDeliveryDestinationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryResultsWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).GetQueryResultsWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliveryDestinationWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDeliveryDestinationWithContext), varargs...)
 }
 
-// ListTagsLogGroup mocks base method
-func (m *MockCloudWatchLogsAPI) ListTagsLogGroup(arg0 *cloudwatchlogs.ListTagsLogGroupInput) (*cloudwatchlogs.ListTagsLogGroupOutput, error) {
+// PutDeliverySource mocks base method
+func (m *MockCloudWatchLogsAPI) PutDeliverySource(arg0 *cloudwatchlogs.PutDeliverySourceInput) (*cloudwatchlogs.PutDeliverySourceOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTagsLogGroup", arg0)
-	ret0, _ := ret[0].(*cloudwatchlogs.ListTagsLogGroupOutput)
+	ret := m.ctrl.Call(m, "PutDeliverySource", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutDeliverySourceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTagsLogGroup indicates an expected call of ListTagsLogGroup
-func (mr *MockCloudWatchLogsAPIMockRecorder) ListTagsLogGroup(arg0 interface{}) *gomock.Call {
+// PutDeliverySource indicates an expected call of PutDeliverySource
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDeliverySource(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsLogGroup", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListTagsLogGroup), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliverySource", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDeliverySource), arg0)
 }
 
-// ListTagsLogGroupRequest mocks base method
-func (m *MockCloudWatchLogsAPI) ListTagsLogGroupRequest(arg0 *cloudwatchlogs.ListTagsLogGroupInput) (*request.Request, *cloudwatchlogs.ListTagsLogGroupOutput) {
+// PutDeliverySourceRequest mocks base method
+func (m *MockCloudWatchLogsAPI) PutDeliverySourceRequest(arg0 *cloudwatchlogs.PutDeliverySourceInput) (*request.Request, *cloudwatchlogs.PutDeliverySourceOutput) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTagsLogGroupRequest", arg0)
+	ret := m.ctrl.Call(m, "PutDeliverySourceRequest", arg0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*cloudwatchlogs.ListTagsLogGroupOutput)
+	ret1, _ := ret[1].(*cloudwatchlogs.PutDeliverySourceOutput)
 	return ret0, ret1
 }
 
-// ListTagsLogGroupRequest indicates an expected call of ListTagsLogGroupRequest
-func (mr *MockCloudWatchLogsAPIMockRecorder) ListTagsLogGroupRequest(arg0 interface{}) *gomock.Call {
+// PutDeliverySourceRequest indicates an expected call of PutDeliverySourceRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDeliverySourceRequest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsLogGroupRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListTagsLogGroupRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliverySourceRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDeliverySourceRequest), arg0)
 }
 
-// ListTagsLogGroupWithContext mocks base method
-func (m *MockCloudWatchLogsAPI) ListTagsLogGroupWithContext(arg0 context.Context, arg1 *cloudwatchlogs.ListTagsLogGroupInput, arg2 ...request.Option) (*cloudwatchlogs.ListTagsLogGroupOutput, error) {
+// PutDeliverySourceWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) PutDeliverySourceWithContext(arg0 context.Context, arg1 *cloudwatchlogs.PutDeliverySourceInput, arg2 ...request.Option) (*cloudwatchlogs.PutDeliverySourceOutput, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListTagsLogGroupWithContext", varargs...)
-	ret0, _ := ret[0].(*cloudwatchlogs.ListTagsLogGroupOutput)
+	ret := m.ctrl.Call(m, "PutDeliverySourceWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutDeliverySourceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTagsLogGroupWithContext indicates an expected call of ListTagsLogGroupWithContext
-func (mr *MockCloudWatchLogsAPIMockRecorder) ListTagsLogGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// PutDeliverySourceWithContext indicates an expected call of PutDeliverySourceWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutDeliverySourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsLogGroupWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).ListTagsLogGroupWithContext), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliverySourceWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutDeliverySourceWithContext), varargs...)
 }
 
 // PutDestination mocks base method
@@ -1830,6 +3445,56 @@ func (mr *MockCloudWatchLogsAPIMockRecorder) PutMetricFilterWithContext(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMetricFilterWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutMetricFilterWithContext), varargs...)
 }
 
+// PutQueryDefinition mocks base method
+func (m *MockCloudWatchLogsAPI) PutQueryDefinition(arg0 *cloudwatchlogs.PutQueryDefinitionInput) (*cloudwatchlogs.PutQueryDefinitionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutQueryDefinition", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutQueryDefinitionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutQueryDefinition indicates an expected call of PutQueryDefinition
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutQueryDefinition(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutQueryDefinition", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutQueryDefinition), arg0)
+}
+
+// PutQueryDefinitionRequest mocks base method
+func (m *MockCloudWatchLogsAPI) PutQueryDefinitionRequest(arg0 *cloudwatchlogs.PutQueryDefinitionInput) (*request.Request, *cloudwatchlogs.PutQueryDefinitionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutQueryDefinitionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.PutQueryDefinitionOutput)
+	return ret0, ret1
+}
+
+// PutQueryDefinitionRequest indicates an expected call of PutQueryDefinitionRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutQueryDefinitionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutQueryDefinitionRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutQueryDefinitionRequest), arg0)
+}
+
+// PutQueryDefinitionWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) PutQueryDefinitionWithContext(arg0 context.Context, arg1 *cloudwatchlogs.PutQueryDefinitionInput, arg2 ...request.Option) (*cloudwatchlogs.PutQueryDefinitionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutQueryDefinitionWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutQueryDefinitionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutQueryDefinitionWithContext indicates an expected call of PutQueryDefinitionWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) PutQueryDefinitionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutQueryDefinitionWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutQueryDefinitionWithContext), varargs...)
+}
+
 // PutResourcePolicy mocks base method
 func (m *MockCloudWatchLogsAPI) PutResourcePolicy(arg0 *cloudwatchlogs.PutResourcePolicyInput) (*cloudwatchlogs.PutResourcePolicyOutput, error) {
 	m.ctrl.T.Helper()
@@ -1980,6 +3645,56 @@ func (mr *MockCloudWatchLogsAPIMockRecorder) PutSubscriptionFilterWithContext(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSubscriptionFilterWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).PutSubscriptionFilterWithContext), varargs...)
 }
 
+// StartLiveTail mocks base method
+func (m *MockCloudWatchLogsAPI) StartLiveTail(arg0 *cloudwatchlogs.StartLiveTailInput) (*cloudwatchlogs.StartLiveTailOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLiveTail", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.StartLiveTailOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartLiveTail indicates an expected call of StartLiveTail
+func (mr *MockCloudWatchLogsAPIMockRecorder) StartLiveTail(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLiveTail", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).StartLiveTail), arg0)
+}
+
+// StartLiveTailRequest mocks base method
+func (m *MockCloudWatchLogsAPI) StartLiveTailRequest(arg0 *cloudwatchlogs.StartLiveTailInput) (*request.Request, *cloudwatchlogs.StartLiveTailOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLiveTailRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.StartLiveTailOutput)
+	return ret0, ret1
+}
+
+// StartLiveTailRequest indicates an expected call of StartLiveTailRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) StartLiveTailRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLiveTailRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).StartLiveTailRequest), arg0)
+}
+
+// StartLiveTailWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) StartLiveTailWithContext(arg0 context.Context, arg1 *cloudwatchlogs.StartLiveTailInput, arg2 ...request.Option) (*cloudwatchlogs.StartLiveTailOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartLiveTailWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.StartLiveTailOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartLiveTailWithContext indicates an expected call of StartLiveTailWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) StartLiveTailWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLiveTailWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).StartLiveTailWithContext), varargs...)
+}
+
 // StartQuery mocks base method
 func (m *MockCloudWatchLogsAPI) StartQuery(arg0 *cloudwatchlogs.StartQueryInput) (*cloudwatchlogs.StartQueryOutput, error) {
 	m.ctrl.T.Helper()
@@ -2130,6 +3845,56 @@ func (mr *MockCloudWatchLogsAPIMockRecorder) TagLogGroupWithContext(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagLogGroupWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).TagLogGroupWithContext), varargs...)
 }
 
+// TagResource mocks base method
+func (m *MockCloudWatchLogsAPI) TagResource(arg0 *cloudwatchlogs.TagResourceInput) (*cloudwatchlogs.TagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResource", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.TagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResource indicates an expected call of TagResource
+func (mr *MockCloudWatchLogsAPIMockRecorder) TagResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResource", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).TagResource), arg0)
+}
+
+// TagResourceRequest mocks base method
+func (m *MockCloudWatchLogsAPI) TagResourceRequest(arg0 *cloudwatchlogs.TagResourceInput) (*request.Request, *cloudwatchlogs.TagResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.TagResourceOutput)
+	return ret0, ret1
+}
+
+// TagResourceRequest indicates an expected call of TagResourceRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) TagResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).TagResourceRequest), arg0)
+}
+
+// TagResourceWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) TagResourceWithContext(arg0 context.Context, arg1 *cloudwatchlogs.TagResourceInput, arg2 ...request.Option) (*cloudwatchlogs.TagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TagResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.TagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResourceWithContext indicates an expected call of TagResourceWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) TagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).TagResourceWithContext), varargs...)
+}
+
 // TestMetricFilter mocks base method
 func (m *MockCloudWatchLogsAPI) TestMetricFilter(arg0 *cloudwatchlogs.TestMetricFilterInput) (*cloudwatchlogs.TestMetricFilterOutput, error) {
 	m.ctrl.T.Helper()
@@ -2229,3 +3994,153 @@ func (mr *MockCloudWatchLogsAPIMockRecorder) UntagLogGroupWithContext(arg0, arg1
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagLogGroupWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).UntagLogGroupWithContext), varargs...)
 }
+
+// UntagResource mocks base method
+func (m *MockCloudWatchLogsAPI) UntagResource(arg0 *cloudwatchlogs.UntagResourceInput) (*cloudwatchlogs.UntagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResource", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.UntagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResource indicates an expected call of UntagResource
+func (mr *MockCloudWatchLogsAPIMockRecorder) UntagResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResource", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).UntagResource), arg0)
+}
+
+// UntagResourceRequest mocks base method
+func (m *MockCloudWatchLogsAPI) UntagResourceRequest(arg0 *cloudwatchlogs.UntagResourceInput) (*request.Request, *cloudwatchlogs.UntagResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.UntagResourceOutput)
+	return ret0, ret1
+}
+
+// UntagResourceRequest indicates an expected call of UntagResourceRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) UntagResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).UntagResourceRequest), arg0)
+}
+
+// UntagResourceWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) UntagResourceWithContext(arg0 context.Context, arg1 *cloudwatchlogs.UntagResourceInput, arg2 ...request.Option) (*cloudwatchlogs.UntagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UntagResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.UntagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResourceWithContext indicates an expected call of UntagResourceWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) UntagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).UntagResourceWithContext), varargs...)
+}
+
+// UpdateAnomaly mocks base method
+func (m *MockCloudWatchLogsAPI) UpdateAnomaly(arg0 *cloudwatchlogs.UpdateAnomalyInput) (*cloudwatchlogs.UpdateAnomalyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAnomaly", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.UpdateAnomalyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAnomaly indicates an expected call of UpdateAnomaly
+func (mr *MockCloudWatchLogsAPIMockRecorder) UpdateAnomaly(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnomaly", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).UpdateAnomaly), arg0)
+}
+
+// UpdateAnomalyRequest mocks base method
+func (m *MockCloudWatchLogsAPI) UpdateAnomalyRequest(arg0 *cloudwatchlogs.UpdateAnomalyInput) (*request.Request, *cloudwatchlogs.UpdateAnomalyOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAnomalyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.UpdateAnomalyOutput)
+	return ret0, ret1
+}
+
+// UpdateAnomalyRequest indicates an expected call of UpdateAnomalyRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) UpdateAnomalyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnomalyRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).UpdateAnomalyRequest), arg0)
+}
+
+// UpdateAnomalyWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) UpdateAnomalyWithContext(arg0 context.Context, arg1 *cloudwatchlogs.UpdateAnomalyInput, arg2 ...request.Option) (*cloudwatchlogs.UpdateAnomalyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAnomalyWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.UpdateAnomalyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAnomalyWithContext indicates an expected call of UpdateAnomalyWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) UpdateAnomalyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnomalyWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).UpdateAnomalyWithContext), varargs...)
+}
+
+// UpdateLogAnomalyDetector mocks base method
+func (m *MockCloudWatchLogsAPI) UpdateLogAnomalyDetector(arg0 *cloudwatchlogs.UpdateLogAnomalyDetectorInput) (*cloudwatchlogs.UpdateLogAnomalyDetectorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLogAnomalyDetector", arg0)
+	ret0, _ := ret[0].(*cloudwatchlogs.UpdateLogAnomalyDetectorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLogAnomalyDetector indicates an expected call of UpdateLogAnomalyDetector
+func (mr *MockCloudWatchLogsAPIMockRecorder) UpdateLogAnomalyDetector(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogAnomalyDetector", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).UpdateLogAnomalyDetector), arg0)
+}
+
+// UpdateLogAnomalyDetectorRequest mocks base method
+func (m *MockCloudWatchLogsAPI) UpdateLogAnomalyDetectorRequest(arg0 *cloudwatchlogs.UpdateLogAnomalyDetectorInput) (*request.Request, *cloudwatchlogs.UpdateLogAnomalyDetectorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLogAnomalyDetectorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*cloudwatchlogs.UpdateLogAnomalyDetectorOutput)
+	return ret0, ret1
+}
+
+// UpdateLogAnomalyDetectorRequest indicates an expected call of UpdateLogAnomalyDetectorRequest
+func (mr *MockCloudWatchLogsAPIMockRecorder) UpdateLogAnomalyDetectorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogAnomalyDetectorRequest", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).UpdateLogAnomalyDetectorRequest), arg0)
+}
+
+// UpdateLogAnomalyDetectorWithContext mocks base method
+func (m *MockCloudWatchLogsAPI) UpdateLogAnomalyDetectorWithContext(arg0 context.Context, arg1 *cloudwatchlogs.UpdateLogAnomalyDetectorInput, arg2 ...request.Option) (*cloudwatchlogs.UpdateLogAnomalyDetectorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateLogAnomalyDetectorWithContext", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.UpdateLogAnomalyDetectorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLogAnomalyDetectorWithContext indicates an expected call of UpdateLogAnomalyDetectorWithContext
+func (mr *MockCloudWatchLogsAPIMockRecorder) UpdateLogAnomalyDetectorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogAnomalyDetectorWithContext", reflect.TypeOf((*MockCloudWatchLogsAPI)(nil).UpdateLogAnomalyDetectorWithContext), varargs...)
+}
@@ -0,0 +1,53 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package costexplorer contains functions for looking up the costs of AWS resources
+package costexplorer
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/aws-sdk-go/service/costexplorer/costexploreriface"
+)
+
+// factory function to create clients
+func newCostExplorerClient(config *config.CommandConfig) costexploreriface.CostExplorerAPI {
+	client := costexplorer.New(config.Session)
+	client.Handlers.Build.PushBackNamed(clients.CustomUserAgentHandler())
+	return client
+}
+
+// GetCostAndUsageFunc is the interface/signature for GetCostAndUsage
+// This helps when writing code in other packages that need to mock this function
+type GetCostAndUsageFunc func(input *costexplorer.GetCostAndUsageInput, config *config.CommandConfig) ([]*costexplorer.ResultByTime, error)
+
+// GetCostAndUsage returns the costs of every time period matching the input, across all pages
+func GetCostAndUsage(input *costexplorer.GetCostAndUsageInput, config *config.CommandConfig) ([]*costexplorer.ResultByTime, error) {
+	return getCostAndUsage(input, newCostExplorerClient(config))
+}
+
+func getCostAndUsage(input *costexplorer.GetCostAndUsageInput, client costexploreriface.CostExplorerAPI) ([]*costexplorer.ResultByTime, error) {
+	var results []*costexplorer.ResultByTime
+	for {
+		output, err := client.GetCostAndUsage(input)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, output.ResultsByTime...)
+		if output.NextPageToken == nil {
+			return results, nil
+		}
+		input.NextPageToken = output.NextPageToken
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package costexplorer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/costexplorer/mock/sdk"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCostAndUsage(t *testing.T) {
+	first := &costexplorer.ResultByTime{TimePeriod: &costexplorer.DateInterval{Start: aws.String("2020-01-01"), End: aws.String("2020-02-01")}}
+	second := &costexplorer.ResultByTime{TimePeriod: &costexplorer.DateInterval{Start: aws.String("2020-02-01"), End: aws.String("2020-02-15")}}
+	mockCostExplorer, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockCostExplorer.EXPECT().GetCostAndUsage(gomock.Any()).Do(func(input *costexplorer.GetCostAndUsageInput) {
+			assert.Nil(t, input.NextPageToken, "Expected first page to be requested without a token")
+		}).Return(&costexplorer.GetCostAndUsageOutput{
+			ResultsByTime: []*costexplorer.ResultByTime{first},
+			NextPageToken: aws.String("1"),
+		}, nil),
+		mockCostExplorer.EXPECT().GetCostAndUsage(gomock.Any()).Do(func(input *costexplorer.GetCostAndUsageInput) {
+			assert.Equal(t, "1", aws.StringValue(input.NextPageToken), "Expected next page token to match")
+		}).Return(&costexplorer.GetCostAndUsageOutput{
+			ResultsByTime: []*costexplorer.ResultByTime{second},
+		}, nil),
+	)
+
+	results, err := getCostAndUsage(&costexplorer.GetCostAndUsageInput{}, mockCostExplorer)
+	assert.NoError(t, err, "Unexpected error getting costs")
+	assert.Equal(t, []*costexplorer.ResultByTime{first, second}, results, "Expected results of every page")
+}
+
+func TestGetCostAndUsageErrorCase(t *testing.T) {
+	mockCostExplorer, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockCostExplorer.EXPECT().GetCostAndUsage(gomock.Any()).Return(nil, errors.New("something went wrong"))
+
+	_, err := getCostAndUsage(&costexplorer.GetCostAndUsageInput{}, mockCostExplorer)
+	assert.Error(t, err, "Expected error getting costs")
+}
+
+func setupTestController(t *testing.T) (*mock_costexploreriface.MockCostExplorerAPI, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	return mock_costexploreriface.NewMockCostExplorerAPI(ctrl), ctrl
+}
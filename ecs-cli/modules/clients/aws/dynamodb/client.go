@@ -0,0 +1,122 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package dynamodb contains functions for the leases held in a DynamoDB table, which serialize
+// the operations of ECS CLI invocations running on different machines, and for the inventory of
+// the stacks the ECS CLI manages
+package dynamodb
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+)
+
+// Attributes of the lock items. LockID is the partition key of the table, and ExpiresAt, in epoch
+// seconds, can be enabled as the time to live attribute of the table to clean up expired leases.
+const (
+	LockIDAttribute    = "LockID"
+	ownerAttribute     = "Owner"
+	expiresAtAttribute = "ExpiresAt"
+)
+
+// make the clock easily mockable in tests
+var now = time.Now
+
+// factory function to create clients
+func newDynamoDBClient(config *config.CommandConfig) dynamodbiface.DynamoDBAPI {
+	client := dynamodb.New(config.Session)
+	client.Handlers.Build.PushBackNamed(clients.CustomUserAgentHandler())
+	return client
+}
+
+// AcquireLockFunc is the interface/signature for AcquireLock
+// This helps when writing code in other packages that need to mock this function
+type AcquireLockFunc func(table, lockID, owner string, lease time.Duration, config *config.CommandConfig) (bool, error)
+
+// ReleaseLockFunc is the interface/signature for ReleaseLock
+// This helps when writing code in other packages that need to mock this function
+type ReleaseLockFunc func(table, lockID, owner string, config *config.CommandConfig) error
+
+// AcquireLock takes the lock with the ID in the table for the lease duration, and returns false if
+// another owner holds an unexpired lease on it. The owner of the lock renews its lease by acquiring
+// the lock again.
+func AcquireLock(table, lockID, owner string, lease time.Duration, config *config.CommandConfig) (bool, error) {
+	return acquireLock(table, lockID, owner, lease, newDynamoDBClient(config))
+}
+
+func acquireLock(table, lockID, owner string, lease time.Duration, client dynamodbiface.DynamoDBAPI) (bool, error) {
+	currentTime := now()
+	_, err := client.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String(table),
+		Item: map[string]*dynamodb.AttributeValue{
+			LockIDAttribute:    {S: aws.String(lockID)},
+			ownerAttribute:     {S: aws.String(owner)},
+			expiresAtAttribute: {N: aws.String(strconv.FormatInt(currentTime.Add(lease).Unix(), 10))},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(#id) OR #expires < :now OR #owner = :owner"),
+		ExpressionAttributeNames: map[string]*string{
+			"#id":      aws.String(LockIDAttribute),
+			"#owner":   aws.String(ownerAttribute),
+			"#expires": aws.String(expiresAtAttribute),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":now":   {N: aws.String(strconv.FormatInt(currentTime.Unix(), 10))},
+			":owner": {S: aws.String(owner)},
+		},
+	})
+	if isConditionalCheckFailed(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReleaseLock deletes the lock with the ID in the table, unless its lease expired and another owner
+// acquired it since.
+func ReleaseLock(table, lockID, owner string, config *config.CommandConfig) error {
+	return releaseLock(table, lockID, owner, newDynamoDBClient(config))
+}
+
+func releaseLock(table, lockID, owner string, client dynamodbiface.DynamoDBAPI) error {
+	_, err := client.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName: aws.String(table),
+		Key: map[string]*dynamodb.AttributeValue{
+			LockIDAttribute: {S: aws.String(lockID)},
+		},
+		ConditionExpression: aws.String("#owner = :owner"),
+		ExpressionAttributeNames: map[string]*string{
+			"#owner": aws.String(ownerAttribute),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":owner": {S: aws.String(owner)},
+		},
+	})
+	if isConditionalCheckFailed(err) {
+		return nil
+	}
+	return err
+}
+
+func isConditionalCheckFailed(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+}
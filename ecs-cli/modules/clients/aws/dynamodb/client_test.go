@@ -0,0 +1,100 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package dynamodb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/dynamodb/mock/sdk"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquireLock(t *testing.T) {
+	now = func() time.Time { return time.Unix(1500000000, 0) }
+	defer func() { now = time.Now }()
+	mockDynamoDB, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDynamoDB.EXPECT().PutItem(gomock.Any()).Do(func(input *dynamodb.PutItemInput) {
+		assert.Equal(t, "locks", aws.StringValue(input.TableName), "Expected table to match")
+		assert.Equal(t, "us-west-2/default/web", aws.StringValue(input.Item[LockIDAttribute].S), "Expected lock ID to match")
+		assert.Equal(t, "runner-1", aws.StringValue(input.Item[ownerAttribute].S), "Expected owner to match")
+		assert.Equal(t, "1500000120", aws.StringValue(input.Item[expiresAtAttribute].N), "Expected lease to expire after two minutes")
+		assert.Equal(t, "1500000000", aws.StringValue(input.ExpressionAttributeValues[":now"].N), "Expected expired leases to be taken over")
+		assert.Contains(t, aws.StringValue(input.ConditionExpression), "#owner = :owner", "Expected owner to be able to renew its lease")
+	}).Return(&dynamodb.PutItemOutput{}, nil)
+
+	acquired, err := acquireLock("locks", "us-west-2/default/web", "runner-1", 2*time.Minute, mockDynamoDB)
+	require.NoError(t, err, "Unexpected error acquiring lock")
+	assert.True(t, acquired, "Expected lock to be acquired")
+}
+
+func TestAcquireLockHeldByAnotherOwner(t *testing.T) {
+	now = func() time.Time { return time.Unix(1500000000, 0) }
+	defer func() { now = time.Now }()
+	mockDynamoDB, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDynamoDB.EXPECT().PutItem(gomock.Any()).Return(nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "The conditional request failed", nil))
+
+	acquired, err := acquireLock("locks", "us-west-2/default/web", "runner-2", 2*time.Minute, mockDynamoDB)
+	assert.NoError(t, err, "Expected a held lock not to be an error")
+	assert.False(t, acquired, "Expected lock not to be acquired")
+}
+
+func TestAcquireLockError(t *testing.T) {
+	now = func() time.Time { return time.Unix(1500000000, 0) }
+	defer func() { now = time.Now }()
+	mockDynamoDB, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDynamoDB.EXPECT().PutItem(gomock.Any()).Return(nil, awserr.New(dynamodb.ErrCodeResourceNotFoundException, "Requested resource not found", nil))
+
+	_, err := acquireLock("locks", "us-west-2/default/web", "runner-1", 2*time.Minute, mockDynamoDB)
+	assert.Error(t, err, "Expected error when the table does not exist")
+}
+
+func TestReleaseLock(t *testing.T) {
+	mockDynamoDB, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDynamoDB.EXPECT().DeleteItem(gomock.Any()).Do(func(input *dynamodb.DeleteItemInput) {
+		assert.Equal(t, "us-west-2/default/web", aws.StringValue(input.Key[LockIDAttribute].S), "Expected lock ID to match")
+		assert.Equal(t, "runner-1", aws.StringValue(input.ExpressionAttributeValues[":owner"].S), "Expected only the owner to release the lock")
+	}).Return(&dynamodb.DeleteItemOutput{}, nil)
+
+	err := releaseLock("locks", "us-west-2/default/web", "runner-1", mockDynamoDB)
+	assert.NoError(t, err, "Unexpected error releasing lock")
+}
+
+func TestReleaseLockTakenOver(t *testing.T) {
+	mockDynamoDB, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDynamoDB.EXPECT().DeleteItem(gomock.Any()).Return(nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "The conditional request failed", nil))
+
+	err := releaseLock("locks", "us-west-2/default/web", "runner-1", mockDynamoDB)
+	assert.NoError(t, err, "Expected a lock taken over by another owner to be left alone")
+}
+
+func setupTestController(t *testing.T) (*mock_dynamodbiface.MockDynamoDBAPI, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	return mock_dynamodbiface.NewMockDynamoDBAPI(ctrl), ctrl
+}
@@ -0,0 +1,157 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package dynamodb
+
+import (
+	"sort"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+)
+
+// Attributes of the inventory items. StackID, the region and the name of the stack, is the
+// partition key of the table, so that the stacks of every region can share a table.
+const (
+	StackIDAttribute    = "StackID"
+	stackNameAttribute  = "StackName"
+	clusterAttribute    = "Cluster"
+	regionAttribute     = "Region"
+	accountAttribute    = "Account"
+	modifiedByAttribute = "ModifiedBy"
+	modifiedAtAttribute = "ModifiedAt"
+	operationAttribute  = "Operation"
+	statusAttribute     = "Status"
+	parametersAttribute = "Parameters"
+)
+
+// Statuses of the stacks in the inventory. The items of deleted stacks are kept, so that the
+// inventory shows who deleted them and when.
+const (
+	StackStatusActive  = "ACTIVE"
+	StackStatusDeleted = "DELETED"
+)
+
+// StackRecord is the item of a stack managed by the ECS CLI in the inventory table
+type StackRecord struct {
+	StackName  string
+	Cluster    string
+	Region     string
+	Account    string
+	ModifiedBy string // ARN of the caller which last modified the stack
+	ModifiedAt time.Time
+	Operation  string // ECS CLI command which last modified the stack, e.g. up or scale
+	Status     string
+	Parameters map[string]string // flags passed to the command
+}
+
+// StackID returns the partition key of the item of the stack
+func (r *StackRecord) StackID() string {
+	return r.Region + "/" + r.StackName
+}
+
+// RecordStackFunc is the interface/signature for RecordStack
+// This helps when writing code in other packages that need to mock this function
+type RecordStackFunc func(table string, record *StackRecord, config *config.CommandConfig) error
+
+// RecordStack writes the item of the stack to the inventory table, replacing the previous one
+func RecordStack(table string, record *StackRecord, config *config.CommandConfig) error {
+	return recordStack(table, record, newDynamoDBClient(config))
+}
+
+func recordStack(table string, record *StackRecord, client dynamodbiface.DynamoDBAPI) error {
+	parameters := make(map[string]*dynamodb.AttributeValue, len(record.Parameters))
+	for name, value := range record.Parameters {
+		parameters[name] = &dynamodb.AttributeValue{S: aws.String(value)}
+	}
+	item := map[string]*dynamodb.AttributeValue{
+		StackIDAttribute:    {S: aws.String(record.StackID())},
+		stackNameAttribute:  {S: aws.String(record.StackName)},
+		clusterAttribute:    {S: aws.String(record.Cluster)},
+		regionAttribute:     {S: aws.String(record.Region)},
+		modifiedAtAttribute: {S: aws.String(record.ModifiedAt.UTC().Format(time.RFC3339))},
+		operationAttribute:  {S: aws.String(record.Operation)},
+		statusAttribute:     {S: aws.String(record.Status)},
+		parametersAttribute: {M: parameters},
+	}
+	// empty strings cannot be stored in key attributes of indexes, so unknown values are left out
+	if record.Account != "" {
+		item[accountAttribute] = &dynamodb.AttributeValue{S: aws.String(record.Account)}
+	}
+	if record.ModifiedBy != "" {
+		item[modifiedByAttribute] = &dynamodb.AttributeValue{S: aws.String(record.ModifiedBy)}
+	}
+	_, err := client.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String(table),
+		Item:      item,
+	})
+	return err
+}
+
+// ListStacksFunc is the interface/signature for ListStacks
+// This helps when writing code in other packages that need to mock this function
+type ListStacksFunc func(table string, config *config.CommandConfig) ([]*StackRecord, error)
+
+// ListStacks returns the stacks recorded in the inventory table, sorted by region and stack name
+func ListStacks(table string, config *config.CommandConfig) ([]*StackRecord, error) {
+	return listStacks(table, newDynamoDBClient(config))
+}
+
+func listStacks(table string, client dynamodbiface.DynamoDBAPI) ([]*StackRecord, error) {
+	var records []*StackRecord
+	err := client.ScanPages(&dynamodb.ScanInput{
+		TableName: aws.String(table),
+	}, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		for _, item := range page.Items {
+			records = append(records, stackRecord(item))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].StackID() < records[j].StackID()
+	})
+	return records, nil
+}
+
+func stackRecord(item map[string]*dynamodb.AttributeValue) *StackRecord {
+	stringValue := func(name string) string {
+		if value, ok := item[name]; ok {
+			return aws.StringValue(value.S)
+		}
+		return ""
+	}
+	record := &StackRecord{
+		StackName:  stringValue(stackNameAttribute),
+		Cluster:    stringValue(clusterAttribute),
+		Region:     stringValue(regionAttribute),
+		Account:    stringValue(accountAttribute),
+		ModifiedBy: stringValue(modifiedByAttribute),
+		Operation:  stringValue(operationAttribute),
+		Status:     stringValue(statusAttribute),
+	}
+	// the time is left zero if the item was edited into another format
+	record.ModifiedAt, _ = time.Parse(time.RFC3339, stringValue(modifiedAtAttribute))
+	if parameters, ok := item[parametersAttribute]; ok && len(parameters.M) > 0 {
+		record.Parameters = make(map[string]string, len(parameters.M))
+		for name, value := range parameters.M {
+			record.Parameters[name] = aws.StringValue(value.S)
+		}
+	}
+	return record
+}
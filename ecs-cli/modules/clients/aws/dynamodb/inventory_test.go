@@ -0,0 +1,125 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package dynamodb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordStack(t *testing.T) {
+	record := &StackRecord{
+		StackName:  "amazon-ecs-cli-setup-default",
+		Cluster:    "default",
+		Region:     "us-west-2",
+		Account:    "123456789012",
+		ModifiedBy: "arn:aws:sts::123456789012:assumed-role/platform/alice",
+		ModifiedAt: time.Date(2020, 5, 1, 12, 30, 0, 0, time.UTC),
+		Operation:  "up",
+		Status:     StackStatusActive,
+		Parameters: map[string]string{"instance-type": "t3.large", "size": "2"},
+	}
+	mockDynamoDB, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDynamoDB.EXPECT().PutItem(gomock.Any()).Do(func(input *dynamodb.PutItemInput) {
+		item := input.Item
+		assert.Equal(t, "inventory", aws.StringValue(input.TableName), "Expected table to match")
+		assert.Equal(t, "us-west-2/amazon-ecs-cli-setup-default", aws.StringValue(item[StackIDAttribute].S), "Expected stack ID to match")
+		assert.Equal(t, "123456789012", aws.StringValue(item[accountAttribute].S), "Expected account to match")
+		assert.Equal(t, "arn:aws:sts::123456789012:assumed-role/platform/alice", aws.StringValue(item[modifiedByAttribute].S), "Expected caller to match")
+		assert.Equal(t, "2020-05-01T12:30:00Z", aws.StringValue(item[modifiedAtAttribute].S), "Expected modification time to match")
+		assert.Equal(t, "up", aws.StringValue(item[operationAttribute].S), "Expected operation to match")
+		assert.Equal(t, StackStatusActive, aws.StringValue(item[statusAttribute].S), "Expected status to match")
+		assert.Equal(t, "t3.large", aws.StringValue(item[parametersAttribute].M["instance-type"].S), "Expected parameters to match")
+		assert.Nil(t, input.ConditionExpression, "Expected previous item to be replaced")
+	}).Return(&dynamodb.PutItemOutput{}, nil)
+
+	err := recordStack("inventory", record, mockDynamoDB)
+	assert.NoError(t, err, "Unexpected error recording stack")
+}
+
+func TestRecordStackWithoutCaller(t *testing.T) {
+	record := &StackRecord{
+		StackName: "amazon-ecs-cli-setup-default",
+		Region:    "us-west-2",
+		Operation: "down",
+		Status:    StackStatusDeleted,
+	}
+	mockDynamoDB, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDynamoDB.EXPECT().PutItem(gomock.Any()).Do(func(input *dynamodb.PutItemInput) {
+		assert.NotContains(t, input.Item, accountAttribute, "Expected unknown account to be left out")
+		assert.NotContains(t, input.Item, modifiedByAttribute, "Expected unknown caller to be left out")
+	}).Return(&dynamodb.PutItemOutput{}, nil)
+
+	err := recordStack("inventory", record, mockDynamoDB)
+	assert.NoError(t, err, "Unexpected error recording stack")
+}
+
+func TestListStacks(t *testing.T) {
+	mockDynamoDB, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDynamoDB.EXPECT().ScanPages(gomock.Any(), gomock.Any()).Do(func(input *dynamodb.ScanInput, fn func(*dynamodb.ScanOutput, bool) bool) {
+		assert.Equal(t, "inventory", aws.StringValue(input.TableName), "Expected table to match")
+		fn(&dynamodb.ScanOutput{
+			Items: []map[string]*dynamodb.AttributeValue{{
+				StackIDAttribute:    {S: aws.String("us-west-2/amazon-ecs-cli-setup-prod")},
+				stackNameAttribute:  {S: aws.String("amazon-ecs-cli-setup-prod")},
+				regionAttribute:     {S: aws.String("us-west-2")},
+				modifiedAtAttribute: {S: aws.String("2020-05-01T12:30:00Z")},
+				statusAttribute:     {S: aws.String(StackStatusActive)},
+				parametersAttribute: {M: map[string]*dynamodb.AttributeValue{"size": {S: aws.String("4")}}},
+			}},
+		}, false)
+		fn(&dynamodb.ScanOutput{
+			Items: []map[string]*dynamodb.AttributeValue{{
+				StackIDAttribute:    {S: aws.String("eu-west-1/amazon-ecs-cli-setup-staging")},
+				stackNameAttribute:  {S: aws.String("amazon-ecs-cli-setup-staging")},
+				regionAttribute:     {S: aws.String("eu-west-1")},
+				modifiedAtAttribute: {S: aws.String("not a time")},
+				statusAttribute:     {S: aws.String(StackStatusDeleted)},
+			}},
+		}, true)
+	}).Return(nil)
+
+	records, err := listStacks("inventory", mockDynamoDB)
+	require.NoError(t, err, "Unexpected error listing stacks")
+	require.Len(t, records, 2, "Expected the stacks of every page")
+	assert.Equal(t, "amazon-ecs-cli-setup-staging", records[0].StackName, "Expected stacks to be sorted by region")
+	assert.True(t, records[0].ModifiedAt.IsZero(), "Expected invalid time to be left zero")
+	assert.Nil(t, records[0].Parameters, "Expected no parameters")
+	assert.Equal(t, "amazon-ecs-cli-setup-prod", records[1].StackName, "Expected stack name to match")
+	assert.Equal(t, time.Date(2020, 5, 1, 12, 30, 0, 0, time.UTC), records[1].ModifiedAt, "Expected modification time to match")
+	assert.Equal(t, map[string]string{"size": "4"}, records[1].Parameters, "Expected parameters to match")
+}
+
+func TestListStacksError(t *testing.T) {
+	mockDynamoDB, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDynamoDB.EXPECT().ScanPages(gomock.Any(), gomock.Any()).Return(errors.New("AccessDeniedException"))
+
+	_, err := listStacks("inventory", mockDynamoDB)
+	assert.Error(t, err, "Expected error scanning the table")
+}
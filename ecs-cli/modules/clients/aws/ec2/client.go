@@ -16,6 +16,8 @@ package ec2
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
@@ -28,7 +30,25 @@ import (
 type EC2Client interface {
 	DescribeInstances(ec2InstanceIds []*string) (map[string]*ec2.Instance, error)
 	DescribeNetworkInterfaces(networkInterfaceIDs []*string) ([]*ec2.NetworkInterface, error)
+	CountNetworkInterfaces() (int64, error)
+	DescribeNetworkInterfacesByFilter(filterName, value string) ([]*ec2.NetworkInterface, error)
+	DetachNetworkInterface(networkInterfaceID, attachmentID string) error
+	DeleteNetworkInterface(networkInterfaceID string) error
 	DescribeInstanceTypeOfferings(location string) ([]string, error)
+	DescribeInstanceTypeOfferingsByAZ(availabilityZones []string) (map[string][]string, error)
+	IsInstanceTypeOffered(instanceType string) (bool, error)
+	DescribeRegions() ([]string, error)
+	GetSubnetAvailabilityZones(subnetIDs []string) ([]string, error)
+	GetPublicSubnets(subnetIDs []string) (map[string]bool, error)
+	DescribeSubnets(subnetIDs []string) ([]*ec2.Subnet, error)
+	DescribeSecurityGroups(groupIDs []string) ([]*ec2.SecurityGroup, error)
+	DescribeImage(imageID string) (*ec2.Image, error)
+	GetComparableInstanceTypes(instanceType string) ([]string, error)
+	GetDefaultVpc() (string, error)
+	GetDefaultSubnets(vpcID string) ([]string, error)
+	GetAutoScalingGroupInstanceIDs(asgName string) ([]string, error)
+	DescribeSpotInstanceRequests(instanceIDs []string) ([]*ec2.SpotInstanceRequest, error)
+	TerminateInstance(instanceID string) error
 }
 
 // ec2Client implements EC2Client
@@ -88,6 +108,93 @@ func (c *ec2Client) DescribeNetworkInterfaces(networkInterfaceIDs []*string) ([]
 	return response.NetworkInterfaces, nil
 }
 
+// DescribeSubnets returns the subnets with the given IDs.
+func (c *ec2Client) DescribeSubnets(subnetIDs []string) ([]*ec2.Subnet, error) {
+	response, err := c.client.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice(subnetIDs),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Subnets, nil
+}
+
+// DescribeSecurityGroups returns the security groups with the given IDs.
+func (c *ec2Client) DescribeSecurityGroups(groupIDs []string) ([]*ec2.SecurityGroup, error) {
+	response, err := c.client.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice(groupIDs),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.SecurityGroups, nil
+}
+
+// DescribeImage returns the AMI with the given ID.
+func (c *ec2Client) DescribeImage(imageID string) (*ec2.Image, error) {
+	response, err := c.client.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{imageID}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Images) == 0 {
+		return nil, fmt.Errorf("AMI %s not found", imageID)
+	}
+	return response.Images[0], nil
+}
+
+// CountNetworkInterfaces returns the number of network interfaces in the region.
+func (c *ec2Client) CountNetworkInterfaces() (int64, error) {
+	var count int64
+	err := c.client.DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{}, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		count += int64(len(page.NetworkInterfaces))
+		return true
+	})
+	return count, err
+}
+
+// DescribeNetworkInterfacesByFilter returns the network interfaces matching the filter, e.g. the
+// network interfaces in a subnet with the subnet-id filter.
+func (c *ec2Client) DescribeNetworkInterfacesByFilter(filterName, value string) ([]*ec2.NetworkInterface, error) {
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String(filterName),
+				Values: []*string{aws.String(value)},
+			},
+		},
+	}
+	var networkInterfaces []*ec2.NetworkInterface
+	err := c.client.DescribeNetworkInterfacesPages(input, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		networkInterfaces = append(networkInterfaces, page.NetworkInterfaces...)
+		return true
+	})
+	return networkInterfaces, err
+}
+
+// DetachNetworkInterface forcibly detaches the network interface and waits until it is available.
+func (c *ec2Client) DetachNetworkInterface(networkInterfaceID, attachmentID string) error {
+	_, err := c.client.DetachNetworkInterface(&ec2.DetachNetworkInterfaceInput{
+		AttachmentId: aws.String(attachmentID),
+		Force:        aws.Bool(true),
+	})
+	if err != nil {
+		return err
+	}
+	return c.client.WaitUntilNetworkInterfaceAvailable(&ec2.DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: aws.StringSlice([]string{networkInterfaceID}),
+	})
+}
+
+// DeleteNetworkInterface deletes the network interface, which must be detached.
+func (c *ec2Client) DeleteNetworkInterface(networkInterfaceID string) error {
+	_, err := c.client.DeleteNetworkInterface(&ec2.DeleteNetworkInterfaceInput{
+		NetworkInterfaceId: aws.String(networkInterfaceID),
+	})
+	return err
+}
+
 func (c *ec2Client) DescribeInstanceTypeOfferings(region string) ([]string, error) {
 	request := &ec2.DescribeInstanceTypeOfferingsInput{
 		LocationType: aws.String("region"),
@@ -113,3 +220,323 @@ func (c *ec2Client) DescribeInstanceTypeOfferings(region string) ([]string, erro
 	}
 	return instanceTypes, nil
 }
+
+// GetDefaultVpc returns the ID of the default VPC in the region
+func (c *ec2Client) GetDefaultVpc() (string, error) {
+	response, err := c.client.DescribeVpcs(&ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("isDefault"),
+				Values: []*string{aws.String("true")},
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(response.Vpcs) == 0 {
+		return "", errors.New("No default VPC found in region")
+	}
+	return aws.StringValue(response.Vpcs[0].VpcId), nil
+}
+
+// GetDefaultSubnets returns the IDs of the default subnets (one per availability zone) in the VPC
+func (c *ec2Client) GetDefaultSubnets(vpcID string) ([]string, error) {
+	response, err := c.client.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("vpc-id"),
+				Values: []*string{aws.String(vpcID)},
+			},
+			&ec2.Filter{
+				Name:   aws.String("default-for-az"),
+				Values: []*string{aws.String("true")},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var subnetIDs []string
+	for _, subnet := range response.Subnets {
+		subnetIDs = append(subnetIDs, aws.StringValue(subnet.SubnetId))
+	}
+	if len(subnetIDs) == 0 {
+		return nil, fmt.Errorf("No default subnets found in VPC %s", vpcID)
+	}
+	return subnetIDs, nil
+}
+
+// DescribeInstanceTypeOfferingsByAZ returns the instance types offered in each of the availability zones
+func (c *ec2Client) DescribeInstanceTypeOfferingsByAZ(availabilityZones []string) (map[string][]string, error) {
+	request := &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("location"),
+				Values: aws.StringSlice(availabilityZones),
+			},
+		},
+	}
+	instanceTypes := make(map[string][]string)
+	err := c.client.DescribeInstanceTypeOfferingsPages(request, func(response *ec2.DescribeInstanceTypeOfferingsOutput, lastPage bool) bool {
+		for _, offering := range response.InstanceTypeOfferings {
+			location := aws.StringValue(offering.Location)
+			instanceTypes[location] = append(instanceTypes[location], aws.StringValue(offering.InstanceType))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return instanceTypes, nil
+}
+
+// IsInstanceTypeOffered returns whether the instance type is offered in the region of the client
+func (c *ec2Client) IsInstanceTypeOffered(instanceType string) (bool, error) {
+	response, err := c.client.DescribeInstanceTypeOfferings(&ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeRegion),
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("instance-type"),
+				Values: []*string{aws.String(instanceType)},
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(response.InstanceTypeOfferings) > 0, nil
+}
+
+// DescribeRegions returns the sorted names of the regions enabled for the account
+func (c *ec2Client) DescribeRegions() ([]string, error) {
+	response, err := c.client.DescribeRegions(&ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, err
+	}
+	var regions []string
+	for _, region := range response.Regions {
+		regions = append(regions, aws.StringValue(region.RegionName))
+	}
+	sort.Strings(regions)
+	return regions, nil
+}
+
+// GetSubnetAvailabilityZones returns the distinct availability zones of the subnets, in the order of the subnets
+func (c *ec2Client) GetSubnetAvailabilityZones(subnetIDs []string) ([]string, error) {
+	response, err := c.client.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice(subnetIDs),
+	})
+	if err != nil {
+		return nil, err
+	}
+	subnetAZs := make(map[string]string)
+	for _, subnet := range response.Subnets {
+		subnetAZs[aws.StringValue(subnet.SubnetId)] = aws.StringValue(subnet.AvailabilityZone)
+	}
+	var availabilityZones []string
+	seen := make(map[string]bool)
+	for _, subnetID := range subnetIDs {
+		az, ok := subnetAZs[subnetID]
+		if ok && !seen[az] {
+			seen[az] = true
+			availabilityZones = append(availabilityZones, az)
+		}
+	}
+	return availabilityZones, nil
+}
+
+// GetPublicSubnets returns which of the subnets are public, i.e. have a route to an internet gateway.
+// Subnets without an explicit route table association use the main route table of their VPC.
+func (c *ec2Client) GetPublicSubnets(subnetIDs []string) (map[string]bool, error) {
+	response, err := c.client.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("association.subnet-id"),
+				Values: aws.StringSlice(subnetIDs),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	publicSubnets := make(map[string]bool)
+	associated := make(map[string]bool)
+	for _, routeTable := range response.RouteTables {
+		public := routesToInternetGateway(routeTable)
+		for _, association := range routeTable.Associations {
+			subnetID := aws.StringValue(association.SubnetId)
+			associated[subnetID] = true
+			if public {
+				publicSubnets[subnetID] = true
+			}
+		}
+	}
+
+	var unassociated []string
+	for _, subnetID := range subnetIDs {
+		if !associated[subnetID] {
+			unassociated = append(unassociated, subnetID)
+		}
+	}
+	if len(unassociated) == 0 {
+		return publicSubnets, nil
+	}
+	subnets, err := c.client.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice(unassociated),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var vpcIDs []string
+	for _, subnet := range subnets.Subnets {
+		vpcIDs = append(vpcIDs, aws.StringValue(subnet.VpcId))
+	}
+	mainRouteTables, err := c.client.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("association.main"),
+				Values: []*string{aws.String("true")},
+			},
+			&ec2.Filter{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice(vpcIDs),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	publicVpcs := make(map[string]bool)
+	for _, routeTable := range mainRouteTables.RouteTables {
+		if routesToInternetGateway(routeTable) {
+			publicVpcs[aws.StringValue(routeTable.VpcId)] = true
+		}
+	}
+	for _, subnet := range subnets.Subnets {
+		if publicVpcs[aws.StringValue(subnet.VpcId)] {
+			publicSubnets[aws.StringValue(subnet.SubnetId)] = true
+		}
+	}
+	return publicSubnets, nil
+}
+
+func routesToInternetGateway(routeTable *ec2.RouteTable) bool {
+	for _, route := range routeTable.Routes {
+		if strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-") {
+			return true
+		}
+	}
+	return false
+}
+
+// GetComparableInstanceTypes returns the current generation instance types with the same architecture,
+// number of vCPUs and amount of memory as the instance type, sorted by name
+func (c *ec2Client) GetComparableInstanceTypes(instanceType string) ([]string, error) {
+	response, err := c.client.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice([]string{instanceType}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.InstanceTypes) == 0 {
+		return nil, fmt.Errorf("Instance type %s not found", instanceType)
+	}
+	info := response.InstanceTypes[0]
+	if info.VCpuInfo == nil || info.MemoryInfo == nil || info.ProcessorInfo == nil || len(info.ProcessorInfo.SupportedArchitectures) == 0 {
+		return nil, fmt.Errorf("Incomplete description of instance type %s", instanceType)
+	}
+
+	request := &ec2.DescribeInstanceTypesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("current-generation"),
+				Values: aws.StringSlice([]string{"true"}),
+			},
+			&ec2.Filter{
+				Name:   aws.String("processor-info.supported-architecture"),
+				Values: info.ProcessorInfo.SupportedArchitectures[:1],
+			},
+			&ec2.Filter{
+				Name:   aws.String("vcpu-info.default-vcpus"),
+				Values: aws.StringSlice([]string{fmt.Sprint(aws.Int64Value(info.VCpuInfo.DefaultVCpus))}),
+			},
+			&ec2.Filter{
+				Name:   aws.String("memory-info.size-in-mib"),
+				Values: aws.StringSlice([]string{fmt.Sprint(aws.Int64Value(info.MemoryInfo.SizeInMiB))}),
+			},
+		},
+	}
+	var instanceTypes []string
+	err = c.client.DescribeInstanceTypesPages(request, func(response *ec2.DescribeInstanceTypesOutput, lastPage bool) bool {
+		for _, comparable := range response.InstanceTypes {
+			if name := aws.StringValue(comparable.InstanceType); name != instanceType {
+				instanceTypes = append(instanceTypes, name)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(instanceTypes)
+	return instanceTypes, nil
+}
+
+// GetAutoScalingGroupInstanceIDs returns the IDs of the instances launched by the Auto Scaling group,
+// including instances terminated recently enough to still be described by EC2.
+func (c *ec2Client) GetAutoScalingGroupInstanceIDs(asgName string) ([]string, error) {
+	var instanceIDs []string
+	err := c.client.DescribeInstancesPages(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("tag:aws:autoscaling:groupName"),
+				Values: aws.StringSlice([]string{asgName}),
+			},
+		},
+	}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				instanceIDs = append(instanceIDs, aws.StringValue(instance.InstanceId))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return instanceIDs, nil
+}
+
+// DescribeSpotInstanceRequests returns the Spot Instance requests that launched the instances.
+func (c *ec2Client) DescribeSpotInstanceRequests(instanceIDs []string) ([]*ec2.SpotInstanceRequest, error) {
+	if len(instanceIDs) == 0 {
+		return nil, nil
+	}
+	var requests []*ec2.SpotInstanceRequest
+	err := c.client.DescribeSpotInstanceRequestsPages(&ec2.DescribeSpotInstanceRequestsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-id"),
+				Values: aws.StringSlice(instanceIDs),
+			},
+		},
+	}, func(page *ec2.DescribeSpotInstanceRequestsOutput, lastPage bool) bool {
+		requests = append(requests, page.SpotInstanceRequests...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// TerminateInstance terminates the instance. An instance of an Auto Scaling group terminated this way
+// is replaced by the group, since its desired capacity is left unchanged.
+func (c *ec2Client) TerminateInstance(instanceID string) error {
+	_, err := c.client.TerminateInstances(&ec2.TerminateInstancesInput{
+		InstanceIds: aws.StringSlice([]string{instanceID}),
+	})
+	return err
+}
@@ -169,6 +169,444 @@ func TestDescribeInstanceTypeOfferingsWithEmptyResult(t *testing.T) {
 	assert.Error(t, err, "Expected error while no region found")
 }
 
+func TestGetDefaultVpc(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	vpcID := "vpc-02dd3038"
+	result := &ec2.DescribeVpcsOutput{
+		Vpcs: []*ec2.Vpc{
+			&ec2.Vpc{
+				VpcId: aws.String(vpcID),
+			},
+		},
+	}
+
+	mockEC2.EXPECT().DescribeVpcs(gomock.Any()).Do(func(input interface{}) {
+		vpcsInput := input.(*ec2.DescribeVpcsInput)
+		assert.NotEmpty(t, vpcsInput.Filters)
+		assert.Equal(t, "isDefault", aws.StringValue(vpcsInput.Filters[0].Name))
+		assert.Equal(t, "true", aws.StringValue(vpcsInput.Filters[0].Values[0]))
+	}).Return(result, nil)
+
+	output, err := client.GetDefaultVpc()
+	assert.NoError(t, err, "Expected no error while getting default VPC")
+	assert.Equal(t, vpcID, output, "Expected VPC ID to match")
+}
+
+func TestGetDefaultVpcWithNoDefaultVpc(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	mockEC2.EXPECT().DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{}, nil)
+
+	_, err := client.GetDefaultVpc()
+	assert.Error(t, err, "Expected error when there is no default VPC")
+}
+
+func TestGetDefaultSubnets(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	vpcID := "vpc-02dd3038"
+	result := &ec2.DescribeSubnetsOutput{
+		Subnets: []*ec2.Subnet{
+			&ec2.Subnet{
+				SubnetId: aws.String("subnet-04726b21"),
+			},
+			&ec2.Subnet{
+				SubnetId: aws.String("subnet-04346b21"),
+			},
+		},
+	}
+
+	mockEC2.EXPECT().DescribeSubnets(gomock.Any()).Do(func(input interface{}) {
+		subnetsInput := input.(*ec2.DescribeSubnetsInput)
+		assert.Len(t, subnetsInput.Filters, 2)
+		assert.Equal(t, "vpc-id", aws.StringValue(subnetsInput.Filters[0].Name))
+		assert.Equal(t, vpcID, aws.StringValue(subnetsInput.Filters[0].Values[0]))
+		assert.Equal(t, "default-for-az", aws.StringValue(subnetsInput.Filters[1].Name))
+	}).Return(result, nil)
+
+	output, err := client.GetDefaultSubnets(vpcID)
+	assert.NoError(t, err, "Expected no error while getting default subnets")
+	assert.Equal(t, []string{"subnet-04726b21", "subnet-04346b21"}, output, "Expected subnet IDs to match")
+}
+
+func TestGetDefaultSubnetsWithError(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	mockEC2.EXPECT().DescribeSubnets(gomock.Any()).Return(nil, errors.New("some error"))
+
+	_, err := client.GetDefaultSubnets("vpc-02dd3038")
+	assert.Error(t, err, "Expected error when DescribeSubnets fails")
+}
+
+func TestDescribeInstanceTypeOfferingsByAZ(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	availabilityZones := []string{"us-west-2a", "us-west-2b"}
+	result := &ec2.DescribeInstanceTypeOfferingsOutput{
+		InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+			&ec2.InstanceTypeOffering{InstanceType: aws.String("t2.micro"), Location: aws.String("us-west-2a")},
+			&ec2.InstanceTypeOffering{InstanceType: aws.String("t2.micro"), Location: aws.String("us-west-2b")},
+			&ec2.InstanceTypeOffering{InstanceType: aws.String("m5.large"), Location: aws.String("us-west-2a")},
+		},
+	}
+
+	mockEC2.EXPECT().DescribeInstanceTypeOfferingsPages(gomock.Any(), gomock.Any()).Do(func(input, fn interface{}) {
+		offeringsInput := input.(*ec2.DescribeInstanceTypeOfferingsInput)
+		assert.Equal(t, "availability-zone", aws.StringValue(offeringsInput.LocationType), "Expected request to have LocationType set")
+		assert.Equal(t, "location", aws.StringValue(offeringsInput.Filters[0].Name))
+		assert.Equal(t, availabilityZones, aws.StringValueSlice(offeringsInput.Filters[0].Values))
+		fn.(func(*ec2.DescribeInstanceTypeOfferingsOutput, bool) bool)(result, true)
+	}).Return(nil)
+
+	offerings, err := client.DescribeInstanceTypeOfferingsByAZ(availabilityZones)
+	assert.NoError(t, err, "Expected no error while describing instance type offerings")
+	assert.Equal(t, map[string][]string{
+		"us-west-2a": {"t2.micro", "m5.large"},
+		"us-west-2b": {"t2.micro"},
+	}, offerings)
+}
+
+func TestIsInstanceTypeOffered(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	mockEC2.EXPECT().DescribeInstanceTypeOfferings(gomock.Any()).Do(func(input interface{}) {
+		offeringsInput := input.(*ec2.DescribeInstanceTypeOfferingsInput)
+		assert.Equal(t, "region", aws.StringValue(offeringsInput.LocationType))
+		assert.Equal(t, "instance-type", aws.StringValue(offeringsInput.Filters[0].Name))
+		assert.Equal(t, "m6g.large", aws.StringValue(offeringsInput.Filters[0].Values[0]))
+	}).Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+		InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+			&ec2.InstanceTypeOffering{InstanceType: aws.String("m6g.large")},
+		},
+	}, nil)
+	mockEC2.EXPECT().DescribeInstanceTypeOfferings(gomock.Any()).Return(&ec2.DescribeInstanceTypeOfferingsOutput{}, nil)
+
+	offered, err := client.IsInstanceTypeOffered("m6g.large")
+	assert.NoError(t, err, "Unexpected error checking the instance type offering")
+	assert.True(t, offered, "Expected instance type to be offered")
+
+	offered, err = client.IsInstanceTypeOffered("m6g.large")
+	assert.NoError(t, err, "Unexpected error checking the instance type offering")
+	assert.False(t, offered, "Expected instance type not to be offered")
+}
+
+func TestDescribeRegions(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	mockEC2.EXPECT().DescribeRegions(gomock.Any()).Return(&ec2.DescribeRegionsOutput{
+		Regions: []*ec2.Region{
+			&ec2.Region{RegionName: aws.String("us-west-2")},
+			&ec2.Region{RegionName: aws.String("eu-west-1")},
+		},
+	}, nil)
+
+	regions, err := client.DescribeRegions()
+	assert.NoError(t, err, "Unexpected error describing regions")
+	assert.Equal(t, []string{"eu-west-1", "us-west-2"}, regions)
+}
+
+func TestGetSubnetAvailabilityZones(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	subnetIDs := []string{"subnet-04726b21", "subnet-04346b21", "subnet-0a0b0c0d"}
+	result := &ec2.DescribeSubnetsOutput{
+		Subnets: []*ec2.Subnet{
+			&ec2.Subnet{SubnetId: aws.String("subnet-04346b21"), AvailabilityZone: aws.String("us-west-2b")},
+			&ec2.Subnet{SubnetId: aws.String("subnet-04726b21"), AvailabilityZone: aws.String("us-west-2a")},
+			&ec2.Subnet{SubnetId: aws.String("subnet-0a0b0c0d"), AvailabilityZone: aws.String("us-west-2a")},
+		},
+	}
+
+	mockEC2.EXPECT().DescribeSubnets(gomock.Any()).Do(func(input interface{}) {
+		assert.Equal(t, subnetIDs, aws.StringValueSlice(input.(*ec2.DescribeSubnetsInput).SubnetIds))
+	}).Return(result, nil)
+
+	output, err := client.GetSubnetAvailabilityZones(subnetIDs)
+	assert.NoError(t, err, "Expected no error while describing subnets")
+	assert.Equal(t, []string{"us-west-2a", "us-west-2b"}, output, "Expected distinct availability zones in the order of the subnets")
+}
+
+func TestGetPublicSubnets(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	subnetIDs := []string{"subnet-public", "subnet-private", "subnet-main"}
+	associated := &ec2.DescribeRouteTablesOutput{
+		RouteTables: []*ec2.RouteTable{
+			&ec2.RouteTable{
+				Associations: []*ec2.RouteTableAssociation{&ec2.RouteTableAssociation{SubnetId: aws.String("subnet-public")}},
+				Routes: []*ec2.Route{
+					&ec2.Route{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local")},
+					&ec2.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-c0ffee")},
+				},
+			},
+			&ec2.RouteTable{
+				Associations: []*ec2.RouteTableAssociation{&ec2.RouteTableAssociation{SubnetId: aws.String("subnet-private")}},
+				Routes: []*ec2.Route{
+					&ec2.Route{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local")},
+					&ec2.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-c0ffee")},
+				},
+			},
+		},
+	}
+	subnets := &ec2.DescribeSubnetsOutput{
+		Subnets: []*ec2.Subnet{&ec2.Subnet{SubnetId: aws.String("subnet-main"), VpcId: aws.String("vpc-feedface")}},
+	}
+	mainRouteTables := &ec2.DescribeRouteTablesOutput{
+		RouteTables: []*ec2.RouteTable{
+			&ec2.RouteTable{
+				VpcId:  aws.String("vpc-feedface"),
+				Routes: []*ec2.Route{&ec2.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-c0ffee")}},
+			},
+		},
+	}
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeRouteTables(gomock.Any()).Do(func(input interface{}) {
+			filters := input.(*ec2.DescribeRouteTablesInput).Filters
+			assert.Equal(t, "association.subnet-id", aws.StringValue(filters[0].Name))
+			assert.Equal(t, subnetIDs, aws.StringValueSlice(filters[0].Values))
+		}).Return(associated, nil),
+		mockEC2.EXPECT().DescribeSubnets(gomock.Any()).Do(func(input interface{}) {
+			assert.Equal(t, []string{"subnet-main"}, aws.StringValueSlice(input.(*ec2.DescribeSubnetsInput).SubnetIds))
+		}).Return(subnets, nil),
+		mockEC2.EXPECT().DescribeRouteTables(gomock.Any()).Do(func(input interface{}) {
+			filters := map[string][]string{}
+			for _, filter := range input.(*ec2.DescribeRouteTablesInput).Filters {
+				filters[aws.StringValue(filter.Name)] = aws.StringValueSlice(filter.Values)
+			}
+			assert.Equal(t, map[string][]string{
+				"association.main": []string{"true"},
+				"vpc-id":           []string{"vpc-feedface"},
+			}, filters)
+		}).Return(mainRouteTables, nil),
+	)
+
+	publicSubnets, err := client.GetPublicSubnets(subnetIDs)
+	assert.NoError(t, err, "Expected no error while describing route tables")
+	assert.Equal(t, map[string]bool{"subnet-public": true, "subnet-main": true}, publicSubnets)
+}
+
+func TestGetPublicSubnetsAllAssociated(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	mockEC2.EXPECT().DescribeRouteTables(gomock.Any()).Return(&ec2.DescribeRouteTablesOutput{
+		RouteTables: []*ec2.RouteTable{
+			&ec2.RouteTable{
+				Associations: []*ec2.RouteTableAssociation{&ec2.RouteTableAssociation{SubnetId: aws.String("subnet-private")}},
+				Routes:       []*ec2.Route{&ec2.Route{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local")}},
+			},
+		},
+	}, nil)
+
+	publicSubnets, err := client.GetPublicSubnets([]string{"subnet-private"})
+	assert.NoError(t, err, "Expected no error while describing route tables")
+	assert.Empty(t, publicSubnets)
+}
+
+func TestDescribeSubnets(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	subnets := []*ec2.Subnet{&ec2.Subnet{SubnetId: aws.String("subnet-04726b21"), VpcId: aws.String("vpc-feedface")}}
+	mockEC2.EXPECT().DescribeSubnets(gomock.Any()).Do(func(input interface{}) {
+		assert.Equal(t, []string{"subnet-04726b21"}, aws.StringValueSlice(input.(*ec2.DescribeSubnetsInput).SubnetIds))
+	}).Return(&ec2.DescribeSubnetsOutput{Subnets: subnets}, nil)
+
+	output, err := client.DescribeSubnets([]string{"subnet-04726b21"})
+	assert.NoError(t, err, "Expected no error while describing subnets")
+	assert.Equal(t, subnets, output)
+}
+
+func TestDescribeSecurityGroups(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	groups := []*ec2.SecurityGroup{&ec2.SecurityGroup{GroupId: aws.String("sg-c0ffee"), VpcId: aws.String("vpc-feedface")}}
+	mockEC2.EXPECT().DescribeSecurityGroups(gomock.Any()).Do(func(input interface{}) {
+		assert.Equal(t, []string{"sg-c0ffee"}, aws.StringValueSlice(input.(*ec2.DescribeSecurityGroupsInput).GroupIds))
+	}).Return(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: groups}, nil)
+
+	output, err := client.DescribeSecurityGroups([]string{"sg-c0ffee"})
+	assert.NoError(t, err, "Expected no error while describing security groups")
+	assert.Equal(t, groups, output)
+}
+
+func TestDescribeImage(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	image := &ec2.Image{ImageId: aws.String("ami-c0ffee"), Name: aws.String("amzn2-ami-ecs-gpu-hvm-2.0.20230301-x86_64-ebs")}
+	mockEC2.EXPECT().DescribeImages(gomock.Any()).Do(func(input interface{}) {
+		assert.Equal(t, []string{"ami-c0ffee"}, aws.StringValueSlice(input.(*ec2.DescribeImagesInput).ImageIds))
+	}).Return(&ec2.DescribeImagesOutput{Images: []*ec2.Image{image}}, nil)
+
+	output, err := client.DescribeImage("ami-c0ffee")
+	assert.NoError(t, err, "Expected no error while describing the image")
+	assert.Equal(t, image, output)
+}
+
+func TestDescribeImageNotFound(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	mockEC2.EXPECT().DescribeImages(gomock.Any()).Return(&ec2.DescribeImagesOutput{}, nil)
+
+	_, err := client.DescribeImage("ami-c0ffee")
+	assert.Error(t, err, "Expected an error for an image which does not exist")
+}
+
+func TestGetComparableInstanceTypes(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	instanceType := "m5.large"
+	described := &ec2.DescribeInstanceTypesOutput{
+		InstanceTypes: []*ec2.InstanceTypeInfo{
+			&ec2.InstanceTypeInfo{
+				InstanceType:  aws.String(instanceType),
+				VCpuInfo:      &ec2.VCpuInfo{DefaultVCpus: aws.Int64(2)},
+				MemoryInfo:    &ec2.MemoryInfo{SizeInMiB: aws.Int64(8192)},
+				ProcessorInfo: &ec2.ProcessorInfo{SupportedArchitectures: aws.StringSlice([]string{"x86_64"})},
+			},
+		},
+	}
+	comparable := &ec2.DescribeInstanceTypesOutput{
+		InstanceTypes: []*ec2.InstanceTypeInfo{
+			&ec2.InstanceTypeInfo{InstanceType: aws.String("t3.large")},
+			&ec2.InstanceTypeInfo{InstanceType: aws.String(instanceType)},
+			&ec2.InstanceTypeInfo{InstanceType: aws.String("m5a.large")},
+		},
+	}
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInstanceTypes(gomock.Any()).Do(func(input interface{}) {
+			assert.Equal(t, []string{instanceType}, aws.StringValueSlice(input.(*ec2.DescribeInstanceTypesInput).InstanceTypes))
+		}).Return(described, nil),
+		mockEC2.EXPECT().DescribeInstanceTypesPages(gomock.Any(), gomock.Any()).Do(func(input, fn interface{}) {
+			filters := map[string]string{}
+			for _, filter := range input.(*ec2.DescribeInstanceTypesInput).Filters {
+				filters[aws.StringValue(filter.Name)] = aws.StringValue(filter.Values[0])
+			}
+			assert.Equal(t, map[string]string{
+				"current-generation":                    "true",
+				"processor-info.supported-architecture": "x86_64",
+				"vcpu-info.default-vcpus":               "2",
+				"memory-info.size-in-mib":               "8192",
+			}, filters)
+			fn.(func(*ec2.DescribeInstanceTypesOutput, bool) bool)(comparable, true)
+		}).Return(nil),
+	)
+
+	output, err := client.GetComparableInstanceTypes(instanceType)
+	assert.NoError(t, err, "Expected no error while getting comparable instance types")
+	assert.Equal(t, []string{"m5a.large", "t3.large"}, output, "Expected sorted comparable instance types")
+}
+
+func TestGetAutoScalingGroupInstanceIDs(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	mockEC2.EXPECT().DescribeInstancesPages(gomock.Any(), gomock.Any()).Do(func(input, fn interface{}) {
+		instancesInput := input.(*ec2.DescribeInstancesInput)
+		assert.Equal(t, "tag:aws:autoscaling:groupName", aws.StringValue(instancesInput.Filters[0].Name))
+		assert.Equal(t, []string{"my-asg"}, aws.StringValueSlice(instancesInput.Filters[0].Values))
+		fn.(func(*ec2.DescribeInstancesOutput, bool) bool)(&ec2.DescribeInstancesOutput{
+			Reservations: []*ec2.Reservation{
+				{Instances: []*ec2.Instance{{InstanceId: aws.String("i-1")}, {InstanceId: aws.String("i-2")}}},
+			},
+		}, true)
+	}).Return(nil)
+
+	instanceIDs, err := client.GetAutoScalingGroupInstanceIDs("my-asg")
+	assert.NoError(t, err, "Unexpected error getting Auto Scaling group instances")
+	assert.Equal(t, []string{"i-1", "i-2"}, instanceIDs)
+}
+
+func TestCountNetworkInterfaces(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	mockEC2.EXPECT().DescribeNetworkInterfacesPages(gomock.Any(), gomock.Any()).Do(func(input, fn interface{}) {
+		pageFunc := fn.(func(*ec2.DescribeNetworkInterfacesOutput, bool) bool)
+		pageFunc(&ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []*ec2.NetworkInterface{{}, {}}}, false)
+		pageFunc(&ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []*ec2.NetworkInterface{{}}}, true)
+	}).Return(nil)
+
+	count, err := client.CountNetworkInterfaces()
+	assert.NoError(t, err, "Unexpected error counting network interfaces")
+	assert.Equal(t, int64(3), count, "Expected network interfaces of every page to be counted")
+}
+
+func TestDescribeNetworkInterfacesByFilter(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	mockEC2.EXPECT().DescribeNetworkInterfacesPages(gomock.Any(), gomock.Any()).Do(func(input, fn interface{}) {
+		filter := input.(*ec2.DescribeNetworkInterfacesInput).Filters[0]
+		assert.Equal(t, "group-id", aws.StringValue(filter.Name))
+		assert.Equal(t, []string{"sg-1"}, aws.StringValueSlice(filter.Values))
+		pageFunc := fn.(func(*ec2.DescribeNetworkInterfacesOutput, bool) bool)
+		pageFunc(&ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []*ec2.NetworkInterface{{NetworkInterfaceId: aws.String("eni-1")}}}, false)
+		pageFunc(&ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []*ec2.NetworkInterface{{NetworkInterfaceId: aws.String("eni-2")}}}, true)
+	}).Return(nil)
+
+	networkInterfaces, err := client.DescribeNetworkInterfacesByFilter("group-id", "sg-1")
+	assert.NoError(t, err, "Unexpected error describing network interfaces")
+	assert.Len(t, networkInterfaces, 2, "Expected network interfaces of every page to be returned")
+}
+
+func TestDetachNetworkInterface(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DetachNetworkInterface(gomock.Any()).Do(func(input interface{}) {
+			detachInput := input.(*ec2.DetachNetworkInterfaceInput)
+			assert.Equal(t, "eni-attach-1", aws.StringValue(detachInput.AttachmentId))
+			assert.True(t, aws.BoolValue(detachInput.Force), "Expected the network interface to be detached forcibly")
+		}).Return(&ec2.DetachNetworkInterfaceOutput{}, nil),
+		mockEC2.EXPECT().WaitUntilNetworkInterfaceAvailable(gomock.Any()).Do(func(input interface{}) {
+			assert.Equal(t, []string{"eni-1"}, aws.StringValueSlice(input.(*ec2.DescribeNetworkInterfacesInput).NetworkInterfaceIds))
+		}).Return(nil),
+	)
+
+	err := client.DetachNetworkInterface("eni-1", "eni-attach-1")
+	assert.NoError(t, err, "Unexpected error detaching network interface")
+}
+
+func TestDescribeSpotInstanceRequests(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	request := &ec2.SpotInstanceRequest{
+		InstanceId: aws.String("i-1"),
+		Status:     &ec2.SpotInstanceStatus{Code: aws.String("instance-terminated-no-capacity")},
+	}
+	mockEC2.EXPECT().DescribeSpotInstanceRequestsPages(gomock.Any(), gomock.Any()).Do(func(input, fn interface{}) {
+		requestsInput := input.(*ec2.DescribeSpotInstanceRequestsInput)
+		assert.Equal(t, "instance-id", aws.StringValue(requestsInput.Filters[0].Name))
+		assert.Equal(t, []string{"i-1", "i-2"}, aws.StringValueSlice(requestsInput.Filters[0].Values))
+		fn.(func(*ec2.DescribeSpotInstanceRequestsOutput, bool) bool)(&ec2.DescribeSpotInstanceRequestsOutput{
+			SpotInstanceRequests: []*ec2.SpotInstanceRequest{request},
+		}, true)
+	}).Return(nil)
+
+	requests, err := client.DescribeSpotInstanceRequests([]string{"i-1", "i-2"})
+	assert.NoError(t, err, "Unexpected error describing Spot Instance requests")
+	assert.Equal(t, []*ec2.SpotInstanceRequest{request}, requests)
+}
+
+func TestDescribeSpotInstanceRequestsWithNoInstances(t *testing.T) {
+	_, client := setupTest(t)
+
+	requests, err := client.DescribeSpotInstanceRequests(nil)
+	assert.NoError(t, err, "Unexpected error describing Spot Instance requests")
+	assert.Empty(t, requests)
+}
+
+func TestTerminateInstance(t *testing.T) {
+	mockEC2, client := setupTest(t)
+
+	mockEC2.EXPECT().TerminateInstances(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ec2.TerminateInstancesInput)
+		assert.Equal(t, []string{"i-1"}, aws.StringValueSlice(req.InstanceIds), "Expected instance IDs to match")
+	}).Return(&ec2.TerminateInstancesOutput{}, nil)
+
+	err := client.TerminateInstance("i-1")
+	assert.NoError(t, err, "Unexpected error terminating instance")
+}
+
 func setupTest(t *testing.T) (*mock_ec2iface.MockEC2API, EC2Client) {
 	ctrl := gomock.NewController(t)
 	// TODO will having defer within scope of this function call the
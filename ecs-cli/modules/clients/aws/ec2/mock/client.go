@@ -47,6 +47,50 @@ func (m *MockEC2Client) EXPECT() *MockEC2ClientMockRecorder {
 	return m.recorder
 }
 
+// CountNetworkInterfaces mocks base method
+func (m *MockEC2Client) CountNetworkInterfaces() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountNetworkInterfaces")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountNetworkInterfaces indicates an expected call of CountNetworkInterfaces
+func (mr *MockEC2ClientMockRecorder) CountNetworkInterfaces() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNetworkInterfaces", reflect.TypeOf((*MockEC2Client)(nil).CountNetworkInterfaces))
+}
+
+// DeleteNetworkInterface mocks base method
+func (m *MockEC2Client) DeleteNetworkInterface(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkInterface", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetworkInterface indicates an expected call of DeleteNetworkInterface
+func (mr *MockEC2ClientMockRecorder) DeleteNetworkInterface(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkInterface", reflect.TypeOf((*MockEC2Client)(nil).DeleteNetworkInterface), arg0)
+}
+
+// DescribeImage mocks base method
+func (m *MockEC2Client) DescribeImage(arg0 string) (*ec2.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeImage", arg0)
+	ret0, _ := ret[0].(*ec2.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeImage indicates an expected call of DescribeImage
+func (mr *MockEC2ClientMockRecorder) DescribeImage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImage", reflect.TypeOf((*MockEC2Client)(nil).DescribeImage), arg0)
+}
+
 // DescribeInstanceTypeOfferings mocks base method
 func (m *MockEC2Client) DescribeInstanceTypeOfferings(arg0 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -62,6 +106,21 @@ func (mr *MockEC2ClientMockRecorder) DescribeInstanceTypeOfferings(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceTypeOfferings", reflect.TypeOf((*MockEC2Client)(nil).DescribeInstanceTypeOfferings), arg0)
 }
 
+// DescribeInstanceTypeOfferingsByAZ mocks base method
+func (m *MockEC2Client) DescribeInstanceTypeOfferingsByAZ(arg0 []string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstanceTypeOfferingsByAZ", arg0)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstanceTypeOfferingsByAZ indicates an expected call of DescribeInstanceTypeOfferingsByAZ
+func (mr *MockEC2ClientMockRecorder) DescribeInstanceTypeOfferingsByAZ(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceTypeOfferingsByAZ", reflect.TypeOf((*MockEC2Client)(nil).DescribeInstanceTypeOfferingsByAZ), arg0)
+}
+
 // DescribeInstances mocks base method
 func (m *MockEC2Client) DescribeInstances(arg0 []*string) (map[string]*ec2.Instance, error) {
 	m.ctrl.T.Helper()
@@ -91,3 +150,211 @@ func (mr *MockEC2ClientMockRecorder) DescribeNetworkInterfaces(arg0 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNetworkInterfaces", reflect.TypeOf((*MockEC2Client)(nil).DescribeNetworkInterfaces), arg0)
 }
+
+// DescribeNetworkInterfacesByFilter mocks base method
+func (m *MockEC2Client) DescribeNetworkInterfacesByFilter(arg0, arg1 string) ([]*ec2.NetworkInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNetworkInterfacesByFilter", arg0, arg1)
+	ret0, _ := ret[0].([]*ec2.NetworkInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNetworkInterfacesByFilter indicates an expected call of DescribeNetworkInterfacesByFilter
+func (mr *MockEC2ClientMockRecorder) DescribeNetworkInterfacesByFilter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNetworkInterfacesByFilter", reflect.TypeOf((*MockEC2Client)(nil).DescribeNetworkInterfacesByFilter), arg0, arg1)
+}
+
+// DescribeRegions mocks base method
+func (m *MockEC2Client) DescribeRegions() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRegions")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRegions indicates an expected call of DescribeRegions
+func (mr *MockEC2ClientMockRecorder) DescribeRegions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegions", reflect.TypeOf((*MockEC2Client)(nil).DescribeRegions))
+}
+
+// DescribeSecurityGroups mocks base method
+func (m *MockEC2Client) DescribeSecurityGroups(arg0 []string) ([]*ec2.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSecurityGroups", arg0)
+	ret0, _ := ret[0].([]*ec2.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSecurityGroups indicates an expected call of DescribeSecurityGroups
+func (mr *MockEC2ClientMockRecorder) DescribeSecurityGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecurityGroups", reflect.TypeOf((*MockEC2Client)(nil).DescribeSecurityGroups), arg0)
+}
+
+// DescribeSpotInstanceRequests mocks base method
+func (m *MockEC2Client) DescribeSpotInstanceRequests(arg0 []string) ([]*ec2.SpotInstanceRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSpotInstanceRequests", arg0)
+	ret0, _ := ret[0].([]*ec2.SpotInstanceRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSpotInstanceRequests indicates an expected call of DescribeSpotInstanceRequests
+func (mr *MockEC2ClientMockRecorder) DescribeSpotInstanceRequests(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSpotInstanceRequests", reflect.TypeOf((*MockEC2Client)(nil).DescribeSpotInstanceRequests), arg0)
+}
+
+// DescribeSubnets mocks base method
+func (m *MockEC2Client) DescribeSubnets(arg0 []string) ([]*ec2.Subnet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSubnets", arg0)
+	ret0, _ := ret[0].([]*ec2.Subnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSubnets indicates an expected call of DescribeSubnets
+func (mr *MockEC2ClientMockRecorder) DescribeSubnets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubnets", reflect.TypeOf((*MockEC2Client)(nil).DescribeSubnets), arg0)
+}
+
+// DetachNetworkInterface mocks base method
+func (m *MockEC2Client) DetachNetworkInterface(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachNetworkInterface", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachNetworkInterface indicates an expected call of DetachNetworkInterface
+func (mr *MockEC2ClientMockRecorder) DetachNetworkInterface(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachNetworkInterface", reflect.TypeOf((*MockEC2Client)(nil).DetachNetworkInterface), arg0, arg1)
+}
+
+// GetAutoScalingGroupInstanceIDs mocks base method
+func (m *MockEC2Client) GetAutoScalingGroupInstanceIDs(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutoScalingGroupInstanceIDs", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutoScalingGroupInstanceIDs indicates an expected call of GetAutoScalingGroupInstanceIDs
+func (mr *MockEC2ClientMockRecorder) GetAutoScalingGroupInstanceIDs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScalingGroupInstanceIDs", reflect.TypeOf((*MockEC2Client)(nil).GetAutoScalingGroupInstanceIDs), arg0)
+}
+
+// GetComparableInstanceTypes mocks base method
+func (m *MockEC2Client) GetComparableInstanceTypes(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComparableInstanceTypes", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComparableInstanceTypes indicates an expected call of GetComparableInstanceTypes
+func (mr *MockEC2ClientMockRecorder) GetComparableInstanceTypes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComparableInstanceTypes", reflect.TypeOf((*MockEC2Client)(nil).GetComparableInstanceTypes), arg0)
+}
+
+// GetDefaultSubnets mocks base method
+func (m *MockEC2Client) GetDefaultSubnets(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultSubnets", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultSubnets indicates an expected call of GetDefaultSubnets
+func (mr *MockEC2ClientMockRecorder) GetDefaultSubnets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultSubnets", reflect.TypeOf((*MockEC2Client)(nil).GetDefaultSubnets), arg0)
+}
+
+// GetDefaultVpc mocks base method
+func (m *MockEC2Client) GetDefaultVpc() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultVpc")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultVpc indicates an expected call of GetDefaultVpc
+func (mr *MockEC2ClientMockRecorder) GetDefaultVpc() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultVpc", reflect.TypeOf((*MockEC2Client)(nil).GetDefaultVpc))
+}
+
+// GetPublicSubnets mocks base method
+func (m *MockEC2Client) GetPublicSubnets(arg0 []string) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicSubnets", arg0)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicSubnets indicates an expected call of GetPublicSubnets
+func (mr *MockEC2ClientMockRecorder) GetPublicSubnets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicSubnets", reflect.TypeOf((*MockEC2Client)(nil).GetPublicSubnets), arg0)
+}
+
+// GetSubnetAvailabilityZones mocks base method
+func (m *MockEC2Client) GetSubnetAvailabilityZones(arg0 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetAvailabilityZones", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetAvailabilityZones indicates an expected call of GetSubnetAvailabilityZones
+func (mr *MockEC2ClientMockRecorder) GetSubnetAvailabilityZones(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetAvailabilityZones", reflect.TypeOf((*MockEC2Client)(nil).GetSubnetAvailabilityZones), arg0)
+}
+
+// IsInstanceTypeOffered mocks base method
+func (m *MockEC2Client) IsInstanceTypeOffered(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsInstanceTypeOffered", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsInstanceTypeOffered indicates an expected call of IsInstanceTypeOffered
+func (mr *MockEC2ClientMockRecorder) IsInstanceTypeOffered(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInstanceTypeOffered", reflect.TypeOf((*MockEC2Client)(nil).IsInstanceTypeOffered), arg0)
+}
+
+// TerminateInstance mocks base method
+func (m *MockEC2Client) TerminateInstance(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateInstance", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TerminateInstance indicates an expected call of TerminateInstance
+func (mr *MockEC2ClientMockRecorder) TerminateInstance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateInstance", reflect.TypeOf((*MockEC2Client)(nil).TerminateInstance), arg0)
+}
@@ -14,11 +14,17 @@
 package ecr
 
 import (
+	"encoding/json"
+	"net/http"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
@@ -31,6 +37,20 @@ const (
 	CacheDir = "~/.ecs"
 )
 
+// Media types of the image manifests accepted when looking up the architectures of an image
+var imageManifestMediaTypes = []string{
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+}
+
+// ErrImageNotFound is returned by GetImageArchitectures if the repository or the image does not exist
+var ErrImageNotFound = errors.New("image not found")
+
+// imageConfigClient downloads image configurations from the URLs returned by GetDownloadUrlForLayer
+var imageConfigClient = &http.Client{Timeout: 30 * time.Second}
+
 // ProcessImageDetails callback function for describe images
 type ProcessImageDetails func(images []*ecr.ImageDetail) error
 
@@ -44,6 +64,10 @@ type Client interface {
 	CreateRepository(repositoryName string) (string, error)
 	RepositoryExists(repositoryName string) bool
 	GetImages(repositoryNames []*string, tagStatus string, registryID string, processFn ProcessImageDetails) error
+	ListRepositoryNames(registryID string) ([]*string, error)
+	DescribeImagesPage(repositoryName, tagStatus, registryID string, nextToken *string, maxResults *int64) ([]*ecr.ImageDetail, *string, error)
+	GetImageArchitectures(registryID, repositoryName, reference string) ([]string, error)
+	GetImageDigest(registryID, repositoryName, tag string) (string, error)
 }
 
 // ecrClient implements Client
@@ -197,6 +221,70 @@ func (c *ecrClient) GetImages(repositoryNames []*string, tagStatus string, regis
 	return err
 }
 
+// ListRepositoryNames returns the names of all the repositories of the registry
+func (c *ecrClient) ListRepositoryNames(registryID string) ([]*string, error) {
+	var repositoryNames []*string
+	err := c.describeRepositories(nil, registryID, func(repositories []*string) error {
+		repositoryNames = append(repositoryNames, repositories...)
+		return nil
+	})
+	return repositoryNames, err
+}
+
+// DescribeImagesPage describes a single page of the images of the repository, and returns the token of the next page
+func (c *ecrClient) DescribeImagesPage(repositoryName, tagStatus, registryID string, nextToken *string, maxResults *int64) ([]*ecr.ImageDetail, *string, error) {
+	filter := &ecr.DescribeImagesFilter{}
+	if tagStatus != "" {
+		filter.SetTagStatus(tagStatus)
+	}
+
+	input := &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repositoryName),
+		Filter:         filter,
+		NextToken:      nextToken,
+		MaxResults:     maxResults,
+	}
+
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	output, err := c.client.DescribeImages(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	return output.ImageDetails, output.NextToken, nil
+}
+
+// GetImageDigest returns the digest of the image with the tag. ErrImageNotFound is returned if the
+// repository or the image does not exist.
+func (c *ecrClient) GetImageDigest(registryID, repositoryName, tag string) (string, error) {
+	input := &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repositoryName),
+		ImageIds:       []*ecr.ImageIdentifier{{ImageTag: aws.String(tag)}},
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	output, err := c.client.DescribeImages(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			switch aerr.Code() {
+			case ecr.ErrCodeRepositoryNotFoundException:
+				return "", errors.Wrapf(ErrImageNotFound, "repository %s does not exist", repositoryName)
+			case ecr.ErrCodeImageNotFoundException:
+				return "", errors.Wrapf(ErrImageNotFound, "%s:%s", repositoryName, tag)
+			}
+		}
+		return "", err
+	}
+	if len(output.ImageDetails) == 0 {
+		return "", errors.Wrapf(ErrImageNotFound, "%s:%s", repositoryName, tag)
+	}
+	return aws.StringValue(output.ImageDetails[0].ImageDigest), nil
+}
+
 func (c *ecrClient) describeRepositories(repositoryNames []*string, registryID string, outputFn ProcessRepositories) error {
 	var outErr error
 
@@ -262,3 +350,118 @@ func (c *ecrClient) describeImages(repositoryName string, tagStatus string, regi
 	}
 	return outErr
 }
+
+// imageManifest holds the fields of image manifests and manifest lists which describe the platforms of an image
+type imageManifest struct {
+	Architecture string `json:"architecture"`
+	Config       struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+	Manifests []struct {
+		Platform struct {
+			Architecture string `json:"architecture"`
+		} `json:"platform"`
+	} `json:"manifests"`
+}
+
+// GetImageArchitectures returns the CPU architectures (e.g. amd64, arm64) the image is built for, using the
+// platforms of a multi-architecture image or the configuration of a single image. The reference is either a tag
+// or a digest. ErrImageNotFound is returned if the repository or the image does not exist.
+func (c *ecrClient) GetImageArchitectures(registryID, repositoryName, reference string) ([]string, error) {
+	imageID := &ecr.ImageIdentifier{}
+	if strings.HasPrefix(reference, "sha256:") {
+		imageID.SetImageDigest(reference)
+	} else {
+		imageID.SetImageTag(reference)
+	}
+	input := &ecr.BatchGetImageInput{
+		RepositoryName:     aws.String(repositoryName),
+		ImageIds:           []*ecr.ImageIdentifier{imageID},
+		AcceptedMediaTypes: aws.StringSlice(imageManifestMediaTypes),
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	output, err := c.client.BatchGetImage(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ecr.ErrCodeRepositoryNotFoundException {
+			return nil, errors.Wrapf(ErrImageNotFound, "repository %s does not exist", repositoryName)
+		}
+		return nil, err
+	}
+	for _, failure := range output.Failures {
+		if aws.StringValue(failure.FailureCode) == ecr.ImageFailureCodeImageNotFound {
+			return nil, errors.Wrapf(ErrImageNotFound, "%s:%s", repositoryName, reference)
+		}
+		return nil, errors.Errorf("unable to get image %s:%s: %s", repositoryName, reference, aws.StringValue(failure.FailureReason))
+	}
+	if len(output.Images) == 0 {
+		return nil, errors.Wrapf(ErrImageNotFound, "%s:%s", repositoryName, reference)
+	}
+
+	manifest := imageManifest{}
+	if err := json.Unmarshal([]byte(aws.StringValue(output.Images[0].ImageManifest)), &manifest); err != nil {
+		return nil, errors.Wrap(err, "unable to parse image manifest")
+	}
+
+	var architectures []string
+	for _, platformManifest := range manifest.Manifests {
+		architecture := platformManifest.Platform.Architecture
+		// attestation manifests added by docker buildx have the platform unknown/unknown
+		if architecture != "" && architecture != "unknown" && !utils.InSlice(architecture, architectures) {
+			architectures = append(architectures, architecture)
+		}
+	}
+	if len(manifest.Manifests) > 0 {
+		sort.Strings(architectures)
+		return architectures, nil
+	}
+	if manifest.Architecture != "" {
+		return []string{manifest.Architecture}, nil
+	}
+	if manifest.Config.Digest == "" {
+		return nil, nil
+	}
+
+	architecture, err := c.getImageConfigArchitecture(registryID, repositoryName, manifest.Config.Digest)
+	if err != nil {
+		return nil, err
+	}
+	if architecture == "" {
+		return nil, nil
+	}
+	return []string{architecture}, nil
+}
+
+// getImageConfigArchitecture downloads the configuration of a single image to read its architecture.
+func (c *ecrClient) getImageConfigArchitecture(registryID, repositoryName, configDigest string) (string, error) {
+	input := &ecr.GetDownloadUrlForLayerInput{
+		RepositoryName: aws.String(repositoryName),
+		LayerDigest:    aws.String(configDigest),
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+	output, err := c.client.GetDownloadUrlForLayer(input)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to get image configuration")
+	}
+
+	resp, err := imageConfigClient.Get(aws.StringValue(output.DownloadUrl))
+	if err != nil {
+		return "", errors.Wrap(err, "unable to download image configuration")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("unable to download image configuration: %s", resp.Status)
+	}
+
+	imageConfig := struct {
+		Architecture string `json:"architecture"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&imageConfig); err != nil {
+		return "", errors.Wrap(err, "unable to parse image configuration")
+	}
+	return imageConfig.Architecture, nil
+}
@@ -15,16 +15,20 @@ package ecr
 
 import (
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	mock_login "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock/credential-helper"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock/sdk"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	login "github.com/awslabs/amazon-ecr-credential-helper/ecr-login/api"
 	"github.com/golang/mock/gomock"
+	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -278,6 +282,118 @@ func TestDescribeImageErrorCase(t *testing.T) {
 	assert.Error(t, err, "Get Images should fail")
 }
 
+func TestGetImageArchitecturesManifestList(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	manifestList := `{
+		"mediaType": "application/vnd.oci.image.index.v1+json",
+		"manifests": [
+			{"digest": "sha256:1", "platform": {"architecture": "arm64", "os": "linux"}},
+			{"digest": "sha256:2", "platform": {"architecture": "amd64", "os": "linux"}},
+			{"digest": "sha256:3", "platform": {"architecture": "unknown", "os": "unknown"}}
+		]
+	}`
+	mockEcr.EXPECT().BatchGetImage(gomock.Any()).Do(func(x interface{}) {
+		input := x.(*ecr.BatchGetImageInput)
+		assert.Equal(t, registryID, aws.StringValue(input.RegistryId), "Expected registry ID to match")
+		assert.Equal(t, repositoryName, aws.StringValue(input.RepositoryName), "Expected repository name to match")
+		assert.Equal(t, "latest", aws.StringValue(input.ImageIds[0].ImageTag), "Expected image tag to match")
+		assert.NotEmpty(t, input.AcceptedMediaTypes, "Expected manifest lists to be accepted")
+	}).Return(&ecr.BatchGetImageOutput{
+		Images: []*ecr.Image{{ImageManifest: aws.String(manifestList)}},
+	}, nil)
+
+	architectures, err := client.GetImageArchitectures(registryID, repositoryName, "latest")
+	assert.NoError(t, err, "Unexpected error getting image architectures")
+	assert.Equal(t, []string{"amd64", "arm64"}, architectures)
+}
+
+func TestGetImageArchitecturesSingleImage(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"architecture": "arm64", "os": "linux"}`))
+	}))
+	defer server.Close()
+
+	digest := "sha256:0d9f3c4ae2e8c2ef4bf6b5bb2bb7fa1a17b67e0a0a4c0f2a1c9d9c1ae2a3b4c5"
+	manifest := `{
+		"mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+		"config": {"digest": "sha256:config"}
+	}`
+	mockEcr.EXPECT().BatchGetImage(gomock.Any()).Do(func(x interface{}) {
+		input := x.(*ecr.BatchGetImageInput)
+		assert.Equal(t, digest, aws.StringValue(input.ImageIds[0].ImageDigest), "Expected image digest to match")
+	}).Return(&ecr.BatchGetImageOutput{
+		Images: []*ecr.Image{{ImageManifest: aws.String(manifest)}},
+	}, nil)
+	mockEcr.EXPECT().GetDownloadUrlForLayer(gomock.Any()).Do(func(x interface{}) {
+		input := x.(*ecr.GetDownloadUrlForLayerInput)
+		assert.Equal(t, "sha256:config", aws.StringValue(input.LayerDigest), "Expected config digest to be downloaded")
+	}).Return(&ecr.GetDownloadUrlForLayerOutput{DownloadUrl: aws.String(server.URL)}, nil)
+
+	architectures, err := client.GetImageArchitectures(registryID, repositoryName, digest)
+	assert.NoError(t, err, "Unexpected error getting image architectures")
+	assert.Equal(t, []string{"arm64"}, architectures)
+}
+
+func TestGetImageArchitecturesImageNotFound(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().BatchGetImage(gomock.Any()).Return(&ecr.BatchGetImageOutput{
+		Failures: []*ecr.ImageFailure{{
+			FailureCode:   aws.String(ecr.ImageFailureCodeImageNotFound),
+			FailureReason: aws.String("Requested image not found"),
+		}},
+	}, nil)
+
+	_, err := client.GetImageArchitectures(registryID, repositoryName, "latest")
+	assert.Error(t, err, "Expected error for missing image")
+	assert.Equal(t, ErrImageNotFound, pkgerrors.Cause(err), "Expected image not found error")
+}
+
+func TestGetImageArchitecturesRepositoryNotFound(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().BatchGetImage(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeRepositoryNotFoundException, "repository does not exist", nil))
+
+	_, err := client.GetImageArchitectures(registryID, repositoryName, "latest")
+	assert.Error(t, err, "Expected error for missing repository")
+	assert.Equal(t, ErrImageNotFound, pkgerrors.Cause(err), "Expected image not found error")
+}
+
+func TestGetImageDigest(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DescribeImages(gomock.Any()).Do(func(x interface{}) {
+		input := x.(*ecr.DescribeImagesInput)
+		assert.Equal(t, repositoryName, aws.StringValue(input.RepositoryName), "Expected repository name to match")
+		assert.Equal(t, registryID, aws.StringValue(input.RegistryId), "Expected registry ID to match")
+		assert.Equal(t, "v1", aws.StringValue(input.ImageIds[0].ImageTag), "Expected image tag to match")
+	}).Return(&ecr.DescribeImagesOutput{
+		ImageDetails: []*ecr.ImageDetail{{ImageDigest: aws.String("sha256:feedface")}},
+	}, nil)
+
+	digest, err := client.GetImageDigest(registryID, repositoryName, "v1")
+	assert.NoError(t, err, "Unexpected error getting the image digest")
+	assert.Equal(t, "sha256:feedface", digest, "Expected digest to match")
+}
+
+func TestGetImageDigestImageNotFound(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DescribeImages(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeImageNotFoundException, "image not found", nil))
+
+	_, err := client.GetImageDigest(registryID, repositoryName, "v1")
+	assert.Equal(t, ErrImageNotFound, pkgerrors.Cause(err), "Expected image not found error")
+}
+
 func setupTestController(t *testing.T) (*mock_ecriface.MockECRAPI, *mock_login.MockClient, Client, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
 	mockEcr := mock_ecriface.NewMockECRAPI(ctrl)
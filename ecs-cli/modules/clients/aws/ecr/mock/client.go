@@ -21,6 +21,7 @@ import (
 	reflect "reflect"
 
 	ecr "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	ecr0 "github.com/aws/aws-sdk-go/service/ecr"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -92,6 +93,36 @@ func (mr *MockClientMockRecorder) GetAuthorizationTokenByID(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationTokenByID", reflect.TypeOf((*MockClient)(nil).GetAuthorizationTokenByID), arg0)
 }
 
+// GetImageArchitectures mocks base method
+func (m *MockClient) GetImageArchitectures(arg0, arg1, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageArchitectures", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageArchitectures indicates an expected call of GetImageArchitectures
+func (mr *MockClientMockRecorder) GetImageArchitectures(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageArchitectures", reflect.TypeOf((*MockClient)(nil).GetImageArchitectures), arg0, arg1, arg2)
+}
+
+// GetImageDigest mocks base method
+func (m *MockClient) GetImageDigest(arg0, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageDigest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageDigest indicates an expected call of GetImageDigest
+func (mr *MockClientMockRecorder) GetImageDigest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageDigest", reflect.TypeOf((*MockClient)(nil).GetImageDigest), arg0, arg1, arg2)
+}
+
 // GetImages mocks base method
 func (m *MockClient) GetImages(arg0 []*string, arg1, arg2 string, arg3 ecr.ProcessImageDetails) error {
 	m.ctrl.T.Helper()
@@ -106,6 +137,37 @@ func (mr *MockClientMockRecorder) GetImages(arg0, arg1, arg2, arg3 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImages", reflect.TypeOf((*MockClient)(nil).GetImages), arg0, arg1, arg2, arg3)
 }
 
+// ListRepositoryNames mocks base method
+func (m *MockClient) ListRepositoryNames(arg0 string) ([]*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoryNames", arg0)
+	ret0, _ := ret[0].([]*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepositoryNames indicates an expected call of ListRepositoryNames
+func (mr *MockClientMockRecorder) ListRepositoryNames(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoryNames", reflect.TypeOf((*MockClient)(nil).ListRepositoryNames), arg0)
+}
+
+// DescribeImagesPage mocks base method
+func (m *MockClient) DescribeImagesPage(arg0, arg1, arg2 string, arg3 *string, arg4 *int64) ([]*ecr0.ImageDetail, *string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeImagesPage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*ecr0.ImageDetail)
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DescribeImagesPage indicates an expected call of DescribeImagesPage
+func (mr *MockClientMockRecorder) DescribeImagesPage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImagesPage", reflect.TypeOf((*MockClient)(nil).DescribeImagesPage), arg0, arg1, arg2, arg3, arg4)
+}
+
 // RepositoryExists mocks base method
 func (m *MockClient) RepositoryExists(arg0 string) bool {
 	m.ctrl.T.Helper()
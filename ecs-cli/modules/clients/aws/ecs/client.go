@@ -17,6 +17,8 @@ import (
 	"crypto/md5"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
@@ -32,33 +34,74 @@ import (
 // ecsChunkSize is the maximum number of elements to pass into a describe api
 const ecsChunkSize = 100
 
+// attributesChunkSize is the maximum number of attributes that can be put or deleted in a single call
+const attributesChunkSize = 10
+
+// deleteTaskDefinitionsChunkSize is the maximum number of task definitions that can be deleted in a single call
+const deleteTaskDefinitionsChunkSize = 10
+
+// updateContainerInstancesStateChunkSize is the maximum number of container instances
+// whose state can be updated in a single UpdateContainerInstancesState call
+const updateContainerInstancesStateChunkSize = 10
+
+// describeServicesChunkSize is the maximum number of services that can be described in a single call
+const describeServicesChunkSize = 10
+
 type ProcessTasksAction func(tasks []*ecs.Task) error
 
+// TaskDefinitionRegistration describes a task definition revision and its registration
+type TaskDefinitionRegistration struct {
+	TaskDefinitionArn    string
+	Revision             int64
+	ContainerDefinitions []*ecs.ContainerDefinition
+	// RegisteredAt and RegisteredBy are the time and the IAM principal of the registration
+	RegisteredAt time.Time
+	RegisteredBy string
+	Tags         []*ecs.Tag
+}
+
 // ECSClient is an interface that specifies only the methods used from the sdk interface. Intended to make mocking and testing easier.
 type ECSClient interface {
 	// Cluster related
 	CreateCluster(clusterName string, tags []*ecs.Tag) (string, error)
+	CreateClusterWithServiceConnectNamespace(clusterName string, tags []*ecs.Tag, namespace string) (string, error)
 	DeleteCluster(clusterName string) (string, error)
 	IsActiveCluster(clusterName string) (bool, error)
+	GetClusterTaskCounts(clusterName string) (int64, int64, error)
+	DescribeCapacityProviders(names []string) ([]*ecs.CapacityProvider, error)
 
 	// Service related
 	CreateService(createServiceInput *ecs.CreateServiceInput) error
 	UpdateService(updateServiceInput *ecs.UpdateServiceInput) error
 	DescribeService(serviceName string) (*ecs.DescribeServicesOutput, error)
 	DeleteService(serviceName string) error
+	ListServices() ([]*string, error)
+	DescribeServices(serviceArns []*string) ([]*ecs.Service, error)
 
 	// Task Definition related
 	RegisterTaskDefinitionIfNeeded(request *ecs.RegisterTaskDefinitionInput, tdCache cache.Cache) (*ecs.TaskDefinition, error)
 	DescribeTaskDefinition(taskDefinitionName string) (*ecs.TaskDefinition, error)
+	DescribeTaskDefinitionRegistration(taskDefinitionName string) (*TaskDefinitionRegistration, error)
+	DeregisterTaskDefinition(taskDefinitionArn string) error
+	ListTaskDefinitionRevisions(family, status string) ([]string, error)
+	DeleteTaskDefinitions(taskDefinitionArns []string) error
 
 	// Tasks related
 	GetTasksPages(listTasksInput *ecs.ListTasksInput, fn ProcessTasksAction) error
+	ListTasksPage(listTasksInput *ecs.ListTasksInput) ([]*ecs.Task, *string, error)
 	RunTask(runTaskInput *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
 	StopTask(taskID string) error
 	DescribeTasks(taskIds []*string) ([]*ecs.Task, error)
 
 	// Container Instance related
 	GetEC2InstanceIDs(containerInstanceArns []*string) (map[string]string, error)
+	ListContainerInstances() ([]*string, error)
+	DrainContainerInstances(containerInstanceArns []*string) error
+	GetRunningTasksCount(containerInstanceArns []*string) (int64, error)
+	DescribeContainerInstances(containerInstanceArns []*string) ([]*ecs.ContainerInstance, error)
+	UpdateContainerAgent(containerInstanceArn string) error
+	PutAttributes(attributes []*ecs.Attribute) error
+	DeleteAttributes(attributes []*ecs.Attribute) error
 	//Describe Container Instances - Attribute Checker related
 	GetAttributesFromDescribeContainerInstances(containerInstanceArns []*string) (map[string][]*string, error)
 	// Settings related
@@ -87,12 +130,27 @@ func newClient(config *config.CommandConfig, client ecsiface.ECSAPI) ECSClient {
 }
 
 func (c *ecsClient) CreateCluster(clusterName string, tags []*ecs.Tag) (string, error) {
+	return c.createCluster(clusterName, tags, "")
+}
+
+// CreateClusterWithServiceConnectNamespace creates a cluster whose services use the Service
+// Connect namespace by default. ECS creates the namespace if it does not exist.
+func (c *ecsClient) CreateClusterWithServiceConnectNamespace(clusterName string, tags []*ecs.Tag, namespace string) (string, error) {
+	return c.createCluster(clusterName, tags, namespace)
+}
+
+func (c *ecsClient) createCluster(clusterName string, tags []*ecs.Tag, namespace string) (string, error) {
 	input := &ecs.CreateClusterInput{
 		ClusterName: &clusterName,
 	}
 	if len(tags) > 0 {
 		input.Tags = tags
 	}
+	if namespace != "" {
+		input.ServiceConnectDefaults = &ecs.ClusterServiceConnectDefaultsRequest{
+			Namespace: aws.String(namespace),
+		}
+	}
 	resp, err := c.client.CreateCluster(input)
 
 	if err != nil {
@@ -181,6 +239,41 @@ func (c *ecsClient) DescribeService(serviceName string) (*ecs.DescribeServicesOu
 	return output, err
 }
 
+// ListServices returns the ARNs of all services in the cluster.
+func (c *ecsClient) ListServices() ([]*string, error) {
+	var serviceArns []*string
+	err := c.client.ListServicesPages(&ecs.ListServicesInput{
+		Cluster: aws.String(c.config.Cluster),
+	}, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+		serviceArns = append(serviceArns, page.ServiceArns...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return serviceArns, nil
+}
+
+// DescribeServices returns the services of the cluster with the given names or ARNs.
+func (c *ecsClient) DescribeServices(serviceArns []*string) ([]*ecs.Service, error) {
+	var services []*ecs.Service
+	for i := 0; i < len(serviceArns); i += describeServicesChunkSize {
+		end := i + describeServicesChunkSize
+		if end > len(serviceArns) {
+			end = len(serviceArns)
+		}
+		output, err := c.client.DescribeServices(&ecs.DescribeServicesInput{
+			Cluster:  aws.String(c.config.Cluster),
+			Services: serviceArns[i:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, output.Services...)
+	}
+	return services, nil
+}
+
 func (c *ecsClient) registerTaskDefinition(request *ecs.RegisterTaskDefinitionInput) (*ecs.TaskDefinition, error) {
 	resp, err := c.client.RegisterTaskDefinition(request)
 	if err != nil {
@@ -293,14 +386,110 @@ func (c *ecsClient) DescribeTaskDefinition(taskDefinitionName string) (*ecs.Task
 
 }
 
+// DescribeTaskDefinitionRegistration describes a task definition with its tags and registration
+func (c *ecsClient) DescribeTaskDefinitionRegistration(taskDefinitionName string) (*TaskDefinitionRegistration, error) {
+	resp, err := c.client.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinitionName),
+		Include:        aws.StringSlice([]string{ecs.TaskDefinitionFieldTags}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &TaskDefinitionRegistration{
+		TaskDefinitionArn:    aws.StringValue(resp.TaskDefinition.TaskDefinitionArn),
+		Revision:             aws.Int64Value(resp.TaskDefinition.Revision),
+		ContainerDefinitions: resp.TaskDefinition.ContainerDefinitions,
+		RegisteredAt:         aws.TimeValue(resp.TaskDefinition.RegisteredAt),
+		RegisteredBy:         aws.StringValue(resp.TaskDefinition.RegisteredBy),
+		Tags:                 resp.Tags,
+	}, nil
+}
+
+// DeregisterTaskDefinition marks the given task definition revision as INACTIVE
+func (c *ecsClient) DeregisterTaskDefinition(taskDefinitionArn string) error {
+	_, err := c.client.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinitionArn),
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"taskDefinition": taskDefinitionArn,
+			"error":          err,
+		}).Error("Error deregistering task definition")
+		return err
+	}
+	return nil
+}
+
+// ListTaskDefinitionRevisions returns the ARNs of the revisions of the task definition family
+// with the given status, newest first
+func (c *ecsClient) ListTaskDefinitionRevisions(family, status string) ([]string, error) {
+	input := &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: aws.String(family),
+		Status:       aws.String(status),
+		Sort:         aws.String(ecs.SortOrderDesc),
+	}
+	var taskDefinitionArns []string
+	err := c.client.ListTaskDefinitionsPages(input, func(page *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
+		for _, arn := range page.TaskDefinitionArns {
+			// FamilyPrefix also matches families that start with the given family
+			if taskDefinitionFamily(aws.StringValue(arn)) == family {
+				taskDefinitionArns = append(taskDefinitionArns, aws.StringValue(arn))
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"family": family,
+			"error":  err,
+		}).Error("Error listing task definitions")
+		return nil, err
+	}
+	return taskDefinitionArns, nil
+}
+
+// taskDefinitionFamily returns the family of a task definition ARN, e.g. 'web' for
+// arn:aws:ecs:us-west-2:123456789012:task-definition/web:3
+func taskDefinitionFamily(taskDefinitionArn string) string {
+	id := utils.GetIdFromArn(taskDefinitionArn)
+	if i := strings.LastIndex(id, ":"); i >= 0 {
+		return id[:i]
+	}
+	return id
+}
+
+// DeleteTaskDefinitions deletes INACTIVE task definition revisions
+func (c *ecsClient) DeleteTaskDefinitions(taskDefinitionArns []string) error {
+	for start := 0; start < len(taskDefinitionArns); start += deleteTaskDefinitionsChunkSize {
+		end := start + deleteTaskDefinitionsChunkSize
+		if end > len(taskDefinitionArns) {
+			end = len(taskDefinitionArns)
+		}
+		output, err := c.client.DeleteTaskDefinitions(&ecs.DeleteTaskDefinitionsInput{
+			TaskDefinitions: aws.StringSlice(taskDefinitionArns[start:end]),
+		})
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).Error("Error deleting task definitions")
+			return err
+		}
+		if len(output.Failures) > 0 {
+			return fmt.Errorf("Failed to delete task definition '%s': %s", aws.StringValue(output.Failures[0].Arn), aws.StringValue(output.Failures[0].Reason))
+		}
+	}
+	return nil
+}
+
 // GetTasksPages lists and describe tasks per page and executes the custom function supplied
 // any time any call returns error, the processing stops and appropriate error is returned
 func (c *ecsClient) GetTasksPages(listTasksInput *ecs.ListTasksInput, tasksFunc ProcessTasksAction) error {
 	listTasksInput.Cluster = aws.String(c.config.Cluster)
 	var outErr error
 	err := c.client.ListTasksPages(listTasksInput, func(page *ecs.ListTasksOutput, end bool) bool {
+		// a page may be empty even though more pages follow
 		if len(page.TaskArns) == 0 {
-			return false
+			return true
 		}
 		// describe this page of tasks
 		resp, err := c.DescribeTasks(page.TaskArns)
@@ -333,6 +522,7 @@ func (c *ecsClient) DescribeTasks(taskArns []*string) ([]*ecs.Task, error) {
 	descTasksRequest := &ecs.DescribeTasksInput{
 		Tasks:   taskArns,
 		Cluster: aws.String(c.config.Cluster),
+		Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
 	}
 	descTasksResp, err := c.client.DescribeTasks(descTasksRequest)
 	if descTasksResp == nil || err != nil {
@@ -345,6 +535,27 @@ func (c *ecsClient) DescribeTasks(taskArns []*string) ([]*ecs.Task, error) {
 	return descTasksResp.Tasks, nil
 }
 
+// ListTasksPage lists and describes a single page of tasks, and returns the token of the next page
+func (c *ecsClient) ListTasksPage(listTasksInput *ecs.ListTasksInput) ([]*ecs.Task, *string, error) {
+	listTasksInput.Cluster = aws.String(c.config.Cluster)
+	page, err := c.client.ListTasks(listTasksInput)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"request": listTasksInput,
+			"error":   err,
+		}).Error("Error listing tasks")
+		return nil, nil, err
+	}
+	if len(page.TaskArns) == 0 {
+		return nil, page.NextToken, nil
+	}
+	tasks, err := c.DescribeTasks(page.TaskArns)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, page.NextToken, nil
+}
+
 // RunTask issues a run task request for the input task definition
 func (c *ecsClient) RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error) {
 	resp, err := c.client.RunTask(input)
@@ -402,6 +613,130 @@ func (c *ecsClient) GetEC2InstanceIDs(containerInstanceArns []*string) (map[stri
 	return containerToEC2InstanceMap, nil
 }
 
+// ListContainerInstances returns the ARNs of all container instances registered to the cluster.
+func (c *ecsClient) ListContainerInstances() ([]*string, error) {
+	var containerInstanceArns []*string
+	err := c.client.ListContainerInstancesPages(&ecs.ListContainerInstancesInput{
+		Cluster: aws.String(c.config.Cluster),
+	}, func(page *ecs.ListContainerInstancesOutput, lastPage bool) bool {
+		containerInstanceArns = append(containerInstanceArns, page.ContainerInstanceArns...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return containerInstanceArns, nil
+}
+
+// DrainContainerInstances sets the status of the container instances to DRAINING, so that
+// service tasks running on them are stopped and no new tasks are placed on them.
+func (c *ecsClient) DrainContainerInstances(containerInstanceArns []*string) error {
+	for i := 0; i < len(containerInstanceArns); i += updateContainerInstancesStateChunkSize {
+		end := i + updateContainerInstancesStateChunkSize
+		if end > len(containerInstanceArns) {
+			end = len(containerInstanceArns)
+		}
+		output, err := c.client.UpdateContainerInstancesState(&ecs.UpdateContainerInstancesStateInput{
+			Cluster:            aws.String(c.config.Cluster),
+			ContainerInstances: containerInstanceArns[i:end],
+			Status:             aws.String(ecs.ContainerInstanceStatusDraining),
+		})
+		if err != nil {
+			return err
+		}
+		if len(output.Failures) > 0 {
+			failure := output.Failures[0]
+			return fmt.Errorf("Failed to drain container instance %s: %s", aws.StringValue(failure.Arn), aws.StringValue(failure.Reason))
+		}
+	}
+	return nil
+}
+
+// DescribeContainerInstances returns the container instances of the cluster with the given ARNs.
+func (c *ecsClient) DescribeContainerInstances(containerInstanceArns []*string) ([]*ecs.ContainerInstance, error) {
+	var containerInstances []*ecs.ContainerInstance
+	for i := 0; i < len(containerInstanceArns); i += ecsChunkSize {
+		end := i + ecsChunkSize
+		if end > len(containerInstanceArns) {
+			end = len(containerInstanceArns)
+		}
+		output, err := c.client.DescribeContainerInstances(&ecs.DescribeContainerInstancesInput{
+			Cluster:            aws.String(c.config.Cluster),
+			ContainerInstances: containerInstanceArns[i:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		containerInstances = append(containerInstances, output.ContainerInstances...)
+	}
+	return containerInstances, nil
+}
+
+// UpdateContainerAgent starts the update of the ECS agent on the container instance.
+func (c *ecsClient) UpdateContainerAgent(containerInstanceArn string) error {
+	_, err := c.client.UpdateContainerAgent(&ecs.UpdateContainerAgentInput{
+		Cluster:           aws.String(c.config.Cluster),
+		ContainerInstance: aws.String(containerInstanceArn),
+	})
+	return err
+}
+
+// PutAttributes creates or updates the attributes of the targets (e.g. container instances) they specify.
+func (c *ecsClient) PutAttributes(attributes []*ecs.Attribute) error {
+	for i := 0; i < len(attributes); i += attributesChunkSize {
+		end := i + attributesChunkSize
+		if end > len(attributes) {
+			end = len(attributes)
+		}
+		if _, err := c.client.PutAttributes(&ecs.PutAttributesInput{
+			Cluster:    aws.String(c.config.Cluster),
+			Attributes: attributes[i:end],
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteAttributes deletes the attributes from the targets (e.g. container instances) they specify.
+func (c *ecsClient) DeleteAttributes(attributes []*ecs.Attribute) error {
+	for i := 0; i < len(attributes); i += attributesChunkSize {
+		end := i + attributesChunkSize
+		if end > len(attributes) {
+			end = len(attributes)
+		}
+		if _, err := c.client.DeleteAttributes(&ecs.DeleteAttributesInput{
+			Cluster:    aws.String(c.config.Cluster),
+			Attributes: attributes[i:end],
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRunningTasksCount returns the total number of tasks running on the container instances.
+func (c *ecsClient) GetRunningTasksCount(containerInstanceArns []*string) (int64, error) {
+	var count int64
+	for i := 0; i < len(containerInstanceArns); i += ecsChunkSize {
+		end := i + ecsChunkSize
+		if end > len(containerInstanceArns) {
+			end = len(containerInstanceArns)
+		}
+		output, err := c.client.DescribeContainerInstances(&ecs.DescribeContainerInstancesInput{
+			Cluster:            aws.String(c.config.Cluster),
+			ContainerInstances: containerInstanceArns[i:end],
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, containerInstance := range output.ContainerInstances {
+			count += aws.Int64Value(containerInstance.RunningTasksCount)
+		}
+	}
+	return count, nil
+}
+
 // DescribeContainer Instances returns a Map with key container instance ARN and values list of attributes
 func (c *ecsClient) GetAttributesFromDescribeContainerInstances(containerInstanceArns []*string) (map[string][]*string, error) {
 	descrContainerInstancesoutputMap := map[string][]*string{}
@@ -459,6 +794,33 @@ func (c *ecsClient) IsActiveCluster(clusterName string) (bool, error) {
 	return false, nil
 }
 
+// GetClusterTaskCounts returns the numbers of running and pending tasks in the cluster.
+func (c *ecsClient) GetClusterTaskCounts(clusterName string) (int64, int64, error) {
+	output, err := c.client.DescribeClusters(&ecs.DescribeClustersInput{
+		Clusters: []*string{aws.String(clusterName)},
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(output.Clusters) == 0 {
+		return 0, 0, fmt.Errorf("Cluster '%s' not found", clusterName)
+	}
+	cluster := output.Clusters[0]
+	return aws.Int64Value(cluster.RunningTasksCount), aws.Int64Value(cluster.PendingTasksCount), nil
+}
+
+// DescribeCapacityProviders returns the capacity providers found among the given names, e.g. the
+// FARGATE and FARGATE_SPOT capacity providers in the regions where they are available.
+func (c *ecsClient) DescribeCapacityProviders(names []string) ([]*ecs.CapacityProvider, error) {
+	output, err := c.client.DescribeCapacityProviders(&ecs.DescribeCapacityProvidersInput{
+		CapacityProviders: aws.StringSlice(names),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.CapacityProviders, nil
+}
+
 // Checks if the given setting is enabled
 func (c *ecsClient) ListAccountSettings(input *ecs.ListAccountSettingsInput) (*ecs.ListAccountSettingsOutput, error) {
 	return c.client.ListAccountSettings(input)
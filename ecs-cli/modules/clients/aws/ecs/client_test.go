@@ -20,6 +20,7 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock/sdk"
@@ -389,6 +390,122 @@ func TestRegisterTaskDefinitionIfNeededCachedTDIsInactive(t *testing.T) {
 	}, "Expected revison of response to be incremented because the cached task definition is INACTIVE")
 }
 
+func TestDeregisterTaskDefinition(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	taskDefinitionArn := "arn:aws:ecs:us-west-2:123456789012:task-definition/hello_world:8"
+
+	mockEcs.EXPECT().DeregisterTaskDefinition(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecs.DeregisterTaskDefinitionInput)
+		assert.Equal(t, taskDefinitionArn, aws.StringValue(req.TaskDefinition), "Expected task definition to match")
+	}).Return(&ecs.DeregisterTaskDefinitionOutput{}, nil)
+
+	err := client.DeregisterTaskDefinition(taskDefinitionArn)
+	assert.NoError(t, err, "Unexpected error when deregistering task definition")
+}
+
+func TestListTaskDefinitionRevisions(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	taskDefinitionPrefix := "arn:aws:ecs:us-west-2:123456789012:task-definition/"
+
+	mockEcs.EXPECT().ListTaskDefinitionsPages(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+		req := x.(*ecs.ListTaskDefinitionsInput)
+		assert.Equal(t, "web", aws.StringValue(req.FamilyPrefix), "Expected family prefix to match")
+		assert.Equal(t, ecs.TaskDefinitionStatusActive, aws.StringValue(req.Status), "Expected status to match")
+		assert.Equal(t, ecs.SortOrderDesc, aws.StringValue(req.Sort), "Expected revisions to be sorted newest first")
+
+		funct := y.(func(page *ecs.ListTaskDefinitionsOutput, lastPage bool) bool)
+		assert.True(t, funct(&ecs.ListTaskDefinitionsOutput{
+			TaskDefinitionArns: aws.StringSlice([]string{taskDefinitionPrefix + "web:3", taskDefinitionPrefix + "web-worker:7"}),
+		}, false), "Expected pagination to continue")
+		assert.False(t, funct(&ecs.ListTaskDefinitionsOutput{
+			TaskDefinitionArns: aws.StringSlice([]string{taskDefinitionPrefix + "web:2"}),
+		}, true), "Expected pagination to stop on the last page")
+	}).Return(nil)
+
+	taskDefinitionArns, err := client.ListTaskDefinitionRevisions("web", ecs.TaskDefinitionStatusActive)
+	assert.NoError(t, err, "Unexpected error when listing task definitions")
+	assert.Equal(t, []string{taskDefinitionPrefix + "web:3", taskDefinitionPrefix + "web:2"}, taskDefinitionArns, "Expected only revisions of the family")
+}
+
+func TestDeleteTaskDefinitions(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	var taskDefinitionArns []string
+	for i := 1; i <= 12; i++ {
+		taskDefinitionArns = append(taskDefinitionArns, fmt.Sprintf("web:%d", i))
+	}
+
+	var calls [][]string
+	mockEcs.EXPECT().DeleteTaskDefinitions(gomock.Any()).Do(func(input *ecs.DeleteTaskDefinitionsInput) {
+		calls = append(calls, aws.StringValueSlice(input.TaskDefinitions))
+	}).Return(&ecs.DeleteTaskDefinitionsOutput{}, nil).Times(2)
+
+	err := client.DeleteTaskDefinitions(taskDefinitionArns)
+	assert.NoError(t, err, "Unexpected error when deleting task definitions")
+	assert.Equal(t, [][]string{taskDefinitionArns[:10], taskDefinitionArns[10:]}, calls, "Expected task definitions to be deleted in chunks of 10")
+}
+
+func TestDeleteTaskDefinitionsWithFailure(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().DeleteTaskDefinitions(gomock.Any()).Return(&ecs.DeleteTaskDefinitionsOutput{
+		Failures: []*ecs.Failure{{Arn: aws.String("web:2"), Reason: aws.String("TASK_DEFINITION_NOT_INACTIVE")}},
+	}, nil)
+
+	err := client.DeleteTaskDefinitions([]string{"web:1", "web:2"})
+	assert.EqualError(t, err, "Failed to delete task definition 'web:2': TASK_DEFINITION_NOT_INACTIVE")
+}
+
+func TestCreateClusterWithServiceConnectNamespace(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().CreateCluster(gomock.Any()).Do(func(input *ecs.CreateClusterInput) {
+		assert.Equal(t, clusterName, aws.StringValue(input.ClusterName), "Expected cluster name to match")
+		assert.Equal(t, "internal", aws.StringValue(input.ServiceConnectDefaults.Namespace), "Expected Service Connect namespace to match")
+	}).Return(&ecs.CreateClusterOutput{Cluster: &ecs.Cluster{ClusterName: aws.String(clusterName)}}, nil)
+
+	cluster, err := client.CreateClusterWithServiceConnectNamespace(clusterName, nil, "internal")
+	assert.NoError(t, err, "Unexpected error when calling CreateClusterWithServiceConnectNamespace")
+	assert.Equal(t, clusterName, cluster)
+}
+
+func TestDescribeTaskDefinitionRegistration(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	registeredAt := time.Unix(1700000000, 0)
+	mockEcs.EXPECT().DescribeTaskDefinition(gomock.Any()).Do(func(input *ecs.DescribeTaskDefinitionInput) {
+		assert.Equal(t, "web:3", aws.StringValue(input.TaskDefinition), "Expected task definition to match")
+		assert.Equal(t, []string{ecs.TaskDefinitionFieldTags}, aws.StringValueSlice(input.Include), "Expected tags to be included")
+	}).Return(&ecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: &ecs.TaskDefinition{
+			TaskDefinitionArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/web:3"),
+			Family:               aws.String("web"),
+			Revision:             aws.Int64(3),
+			ContainerDefinitions: []*ecs.ContainerDefinition{{Name: aws.String("web"), Image: aws.String("nginx:1.25")}},
+			RegisteredAt:         aws.Time(registeredAt),
+			RegisteredBy:         aws.String("arn:aws:sts::123456789012:assumed-role/deploy/alice"),
+		},
+		Tags: []*ecs.Tag{{Key: aws.String("git-commit"), Value: aws.String("4cb8bca")}},
+	}, nil)
+
+	registration, err := client.DescribeTaskDefinitionRegistration("web:3")
+	assert.NoError(t, err, "Unexpected error when calling DescribeTaskDefinitionRegistration")
+	assert.Equal(t, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:3", registration.TaskDefinitionArn)
+	assert.Equal(t, int64(3), registration.Revision)
+	assert.Equal(t, "nginx:1.25", aws.StringValue(registration.ContainerDefinitions[0].Image))
+	assert.Equal(t, registeredAt, registration.RegisteredAt)
+	assert.Equal(t, "arn:aws:sts::123456789012:assumed-role/deploy/alice", registration.RegisteredBy)
+	assert.Equal(t, "4cb8bca", aws.StringValue(registration.Tags[0].Value))
+}
+
 func TestGetTasksPages(t *testing.T) {
 	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
 	defer ctrl.Finish()
@@ -419,6 +536,7 @@ func TestGetTasksPages(t *testing.T) {
 		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
 		assert.Equal(t, len(taskIds), len(req.Tasks), "Expected tasks length to match")
 		assert.Equal(t, aws.StringValue(taskIds[0]), aws.StringValue(req.Tasks[0]), "Expected taskId to match")
+		assert.Equal(t, []string{ecs.TaskFieldTags}, aws.StringValueSlice(req.Include), "Expected task tags to be included")
 	}).Return(&ecs.DescribeTasksOutput{Tasks: []*ecs.Task{taskDetail}}, nil)
 
 	// make actual call
@@ -430,6 +548,42 @@ func TestGetTasksPages(t *testing.T) {
 
 }
 
+func TestGetTasksPagesContinuesAfterEmptyPage(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().ListTasksPages(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+		funct := y.(func(page *ecs.ListTasksOutput, end bool) bool)
+		assert.True(t, funct(&ecs.ListTasksOutput{NextToken: aws.String("next")}, false), "Expected the listing to continue after an empty page")
+	}).Return(nil)
+
+	err := client.GetTasksPages(&ecs.ListTasksInput{}, func(tasks []*ecs.Task) error {
+		return nil
+	})
+	assert.NoError(t, err, "Unexpected error listing tasks")
+}
+
+func TestListTasksPage(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	taskArns := aws.StringSlice([]string{"task1", "task2"})
+	gomock.InOrder(
+		mockEcs.EXPECT().ListTasks(&ecs.ListTasksInput{
+			Cluster:    aws.String(clusterName),
+			MaxResults: aws.Int64(2),
+		}).Return(&ecs.ListTasksOutput{TaskArns: taskArns, NextToken: aws.String("next")}, nil),
+		mockEcs.EXPECT().DescribeTasks(gomock.Any()).Return(&ecs.DescribeTasksOutput{
+			Tasks: []*ecs.Task{{TaskArn: taskArns[0]}, {TaskArn: taskArns[1]}},
+		}, nil),
+	)
+
+	tasks, nextToken, err := client.ListTasksPage(&ecs.ListTasksInput{MaxResults: aws.Int64(2)})
+	assert.NoError(t, err, "Unexpected error listing tasks")
+	assert.Len(t, tasks, 2, "Expected the tasks of the page")
+	assert.Equal(t, "next", aws.StringValue(nextToken), "Expected the token of the next page")
+}
+
 func TestRunTask(t *testing.T) {
 	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
 	defer ctrl.Finish()
@@ -692,6 +846,40 @@ func TestIsActiveCluster(t *testing.T) {
 	assert.True(t, active, "Expected IsActiveCluster to return true when API returned active cluster")
 }
 
+func TestGetClusterTaskCounts(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().DescribeClusters(gomock.Any()).Do(func(input interface{}) {
+		assert.Equal(t, []string{clusterName}, aws.StringValueSlice(input.(*ecs.DescribeClustersInput).Clusters))
+	}).Return(&ecs.DescribeClustersOutput{
+		Clusters: []*ecs.Cluster{{RunningTasksCount: aws.Int64(3), PendingTasksCount: aws.Int64(1)}},
+	}, nil)
+
+	running, pending, err := client.GetClusterTaskCounts(clusterName)
+	assert.NoError(t, err, "Unexpected error getting the task counts of the cluster")
+	assert.Equal(t, int64(3), running, "Expected running task count to match")
+	assert.Equal(t, int64(1), pending, "Expected pending task count to match")
+}
+
+func TestDescribeCapacityProviders(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	names := []string{"FARGATE", "FARGATE_SPOT"}
+	mockEcs.EXPECT().DescribeCapacityProviders(gomock.Any()).Do(func(input interface{}) {
+		assert.Equal(t, names, aws.StringValueSlice(input.(*ecs.DescribeCapacityProvidersInput).CapacityProviders))
+	}).Return(&ecs.DescribeCapacityProvidersOutput{
+		CapacityProviders: []*ecs.CapacityProvider{{Name: aws.String("FARGATE")}},
+		Failures:          []*ecs.Failure{{Arn: aws.String("FARGATE_SPOT"), Reason: aws.String("MISSING")}},
+	}, nil)
+
+	providers, err := client.DescribeCapacityProviders(names)
+	assert.NoError(t, err, "Unexpected error describing capacity providers")
+	assert.Len(t, providers, 1, "Expected only the found capacity providers")
+	assert.Equal(t, "FARGATE", aws.StringValue(providers[0].Name))
+}
+
 func TestGetEC2InstanceIDs(t *testing.T) {
 	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
 	defer ctrl.Finish()
@@ -763,6 +951,196 @@ func TestGetEC2InstanceIDsErrorCase(t *testing.T) {
 	assert.Error(t, err, "Expected error when calling GetEC2InstanceIDs")
 }
 
+func TestListContainerInstances(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().ListContainerInstancesPages(gomock.Any(), gomock.Any()).Do(func(input, fn interface{}) {
+		assert.Equal(t, clusterName, aws.StringValue(input.(*ecs.ListContainerInstancesInput).Cluster), "Expected clusterName to match")
+		pageFunc := fn.(func(*ecs.ListContainerInstancesOutput, bool) bool)
+		pageFunc(&ecs.ListContainerInstancesOutput{ContainerInstanceArns: aws.StringSlice([]string{"arn1"})}, false)
+		pageFunc(&ecs.ListContainerInstancesOutput{ContainerInstanceArns: aws.StringSlice([]string{"arn2"})}, true)
+	}).Return(nil)
+
+	containerInstanceArns, err := client.ListContainerInstances()
+	assert.NoError(t, err, "Unexpected error when calling ListContainerInstances")
+	assert.Equal(t, []string{"arn1", "arn2"}, aws.StringValueSlice(containerInstanceArns))
+}
+
+func TestListServices(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().ListServicesPages(gomock.Any(), gomock.Any()).Do(func(input, fn interface{}) {
+		assert.Equal(t, clusterName, aws.StringValue(input.(*ecs.ListServicesInput).Cluster), "Expected clusterName to match")
+		pageFunc := fn.(func(*ecs.ListServicesOutput, bool) bool)
+		pageFunc(&ecs.ListServicesOutput{ServiceArns: aws.StringSlice([]string{"arn1"})}, false)
+		pageFunc(&ecs.ListServicesOutput{ServiceArns: aws.StringSlice([]string{"arn2"})}, true)
+	}).Return(nil)
+
+	serviceArns, err := client.ListServices()
+	assert.NoError(t, err, "Unexpected error when calling ListServices")
+	assert.Equal(t, []string{"arn1", "arn2"}, aws.StringValueSlice(serviceArns))
+}
+
+func TestDescribeServices(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	var serviceArns []*string
+	for i := 0; i < 12; i++ {
+		serviceArns = append(serviceArns, aws.String(fmt.Sprintf("arn%d", i)))
+	}
+
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeServices(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.DescribeServicesInput)
+			assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
+			assert.Len(t, req.Services, 10, "Expected first call to describe 10 services")
+		}).Return(&ecs.DescribeServicesOutput{Services: []*ecs.Service{{ServiceArn: aws.String("arn0")}}}, nil),
+		mockEcs.EXPECT().DescribeServices(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.DescribeServicesInput)
+			assert.Equal(t, []string{"arn10", "arn11"}, aws.StringValueSlice(req.Services), "Expected remaining services to be described")
+		}).Return(&ecs.DescribeServicesOutput{Services: []*ecs.Service{{ServiceArn: aws.String("arn10")}}}, nil),
+	)
+
+	services, err := client.DescribeServices(serviceArns)
+	assert.NoError(t, err, "Unexpected error when calling DescribeServices")
+	assert.Len(t, services, 2, "Expected services of both calls")
+}
+
+func TestDrainContainerInstances(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	var containerInstanceArns []*string
+	for i := 0; i < 12; i++ {
+		containerInstanceArns = append(containerInstanceArns, aws.String(fmt.Sprintf("arn%d", i)))
+	}
+
+	gomock.InOrder(
+		mockEcs.EXPECT().UpdateContainerInstancesState(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.UpdateContainerInstancesStateInput)
+			assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
+			assert.Equal(t, ecs.ContainerInstanceStatusDraining, aws.StringValue(req.Status), "Expected status to be DRAINING")
+			assert.Len(t, req.ContainerInstances, 10, "Expected first call to update 10 container instances")
+		}).Return(&ecs.UpdateContainerInstancesStateOutput{}, nil),
+		mockEcs.EXPECT().UpdateContainerInstancesState(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.UpdateContainerInstancesStateInput)
+			assert.Equal(t, []string{"arn10", "arn11"}, aws.StringValueSlice(req.ContainerInstances), "Expected remaining container instances to be updated")
+		}).Return(&ecs.UpdateContainerInstancesStateOutput{}, nil),
+	)
+
+	err := client.DrainContainerInstances(containerInstanceArns)
+	assert.NoError(t, err, "Unexpected error when calling DrainContainerInstances")
+}
+
+func TestDrainContainerInstancesWithFailure(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().UpdateContainerInstancesState(gomock.Any()).Return(&ecs.UpdateContainerInstancesStateOutput{
+		Failures: []*ecs.Failure{{Arn: aws.String("arn1"), Reason: aws.String("MISSING")}},
+	}, nil)
+
+	err := client.DrainContainerInstances(aws.StringSlice([]string{"arn1"}))
+	assert.Error(t, err, "Expected error when a container instance fails to drain")
+}
+
+func TestGetRunningTasksCount(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().DescribeContainerInstances(gomock.Any()).Return(&ecs.DescribeContainerInstancesOutput{
+		ContainerInstances: []*ecs.ContainerInstance{
+			{ContainerInstanceArn: aws.String("arn1"), RunningTasksCount: aws.Int64(2)},
+			{ContainerInstanceArn: aws.String("arn2"), RunningTasksCount: aws.Int64(1)},
+		},
+	}, nil)
+
+	count, err := client.GetRunningTasksCount(aws.StringSlice([]string{"arn1", "arn2"}))
+	assert.NoError(t, err, "Unexpected error when calling GetRunningTasksCount")
+	assert.Equal(t, int64(3), count)
+}
+
+func TestDescribeContainerInstances(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	containerInstances := []*ecs.ContainerInstance{
+		{ContainerInstanceArn: aws.String("arn1"), AgentConnected: aws.Bool(true)},
+	}
+	mockEcs.EXPECT().DescribeContainerInstances(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecs.DescribeContainerInstancesInput)
+		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
+		assert.Equal(t, []string{"arn1"}, aws.StringValueSlice(req.ContainerInstances))
+	}).Return(&ecs.DescribeContainerInstancesOutput{ContainerInstances: containerInstances}, nil)
+
+	output, err := client.DescribeContainerInstances(aws.StringSlice([]string{"arn1"}))
+	assert.NoError(t, err, "Unexpected error when calling DescribeContainerInstances")
+	assert.Equal(t, containerInstances, output)
+}
+
+func TestUpdateContainerAgent(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().UpdateContainerAgent(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecs.UpdateContainerAgentInput)
+		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
+		assert.Equal(t, "arn1", aws.StringValue(req.ContainerInstance), "Expected container instance to match")
+	}).Return(&ecs.UpdateContainerAgentOutput{}, nil)
+
+	err := client.UpdateContainerAgent("arn1")
+	assert.NoError(t, err, "Unexpected error when calling UpdateContainerAgent")
+}
+
+func TestPutAttributes(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	var attributes []*ecs.Attribute
+	for i := 0; i < 12; i++ {
+		attributes = append(attributes, &ecs.Attribute{
+			Name:       aws.String(fmt.Sprintf("attribute%d", i)),
+			TargetId:   aws.String("containerInstanceId"),
+			TargetType: aws.String(ecs.TargetTypeContainerInstance),
+		})
+	}
+
+	gomock.InOrder(
+		mockEcs.EXPECT().PutAttributes(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.PutAttributesInput)
+			assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
+			assert.Equal(t, attributes[:10], req.Attributes, "Expected first call to put 10 attributes")
+		}).Return(&ecs.PutAttributesOutput{}, nil),
+		mockEcs.EXPECT().PutAttributes(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.PutAttributesInput)
+			assert.Equal(t, attributes[10:], req.Attributes, "Expected remaining attributes to be put")
+		}).Return(&ecs.PutAttributesOutput{}, nil),
+	)
+
+	err := client.PutAttributes(attributes)
+	assert.NoError(t, err, "Unexpected error when calling PutAttributes")
+}
+
+func TestDeleteAttributes(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	attributes := []*ecs.Attribute{
+		{Name: aws.String("stack"), TargetId: aws.String("containerInstanceId"), TargetType: aws.String(ecs.TargetTypeContainerInstance)},
+	}
+	mockEcs.EXPECT().DeleteAttributes(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecs.DeleteAttributesInput)
+		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
+		assert.Equal(t, attributes, req.Attributes, "Expected attributes to match")
+	}).Return(&ecs.DeleteAttributesOutput{}, nil)
+
+	err := client.DeleteAttributes(attributes)
+	assert.NoError(t, err, "Unexpected error when calling DeleteAttributes")
+}
+
 func TestGetAttributesFromDescribeContainerInstances(t *testing.T) {
 	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
 	defer ctrl.Finish()
@@ -64,6 +64,21 @@ func (mr *MockECSClientMockRecorder) CreateCluster(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCluster", reflect.TypeOf((*MockECSClient)(nil).CreateCluster), arg0, arg1)
 }
 
+// CreateClusterWithServiceConnectNamespace mocks base method
+func (m *MockECSClient) CreateClusterWithServiceConnectNamespace(arg0 string, arg1 []*ecs0.Tag, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterWithServiceConnectNamespace", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterWithServiceConnectNamespace indicates an expected call of CreateClusterWithServiceConnectNamespace
+func (mr *MockECSClientMockRecorder) CreateClusterWithServiceConnectNamespace(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterWithServiceConnectNamespace", reflect.TypeOf((*MockECSClient)(nil).CreateClusterWithServiceConnectNamespace), arg0, arg1, arg2)
+}
+
 // CreateService mocks base method
 func (m *MockECSClient) CreateService(arg0 *ecs0.CreateServiceInput) error {
 	m.ctrl.T.Helper()
@@ -78,6 +93,20 @@ func (mr *MockECSClientMockRecorder) CreateService(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateService", reflect.TypeOf((*MockECSClient)(nil).CreateService), arg0)
 }
 
+// DeleteAttributes mocks base method
+func (m *MockECSClient) DeleteAttributes(arg0 []*ecs0.Attribute) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttributes", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttributes indicates an expected call of DeleteAttributes
+func (mr *MockECSClientMockRecorder) DeleteAttributes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttributes", reflect.TypeOf((*MockECSClient)(nil).DeleteAttributes), arg0)
+}
+
 // DeleteCluster mocks base method
 func (m *MockECSClient) DeleteCluster(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -107,6 +136,64 @@ func (mr *MockECSClientMockRecorder) DeleteService(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MockECSClient)(nil).DeleteService), arg0)
 }
 
+// DeleteTaskDefinitions mocks base method
+func (m *MockECSClient) DeleteTaskDefinitions(arg0 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskDefinitions", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaskDefinitions indicates an expected call of DeleteTaskDefinitions
+func (mr *MockECSClientMockRecorder) DeleteTaskDefinitions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskDefinitions", reflect.TypeOf((*MockECSClient)(nil).DeleteTaskDefinitions), arg0)
+}
+
+// DeregisterTaskDefinition mocks base method
+func (m *MockECSClient) DeregisterTaskDefinition(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTaskDefinition", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterTaskDefinition indicates an expected call of DeregisterTaskDefinition
+func (mr *MockECSClientMockRecorder) DeregisterTaskDefinition(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTaskDefinition", reflect.TypeOf((*MockECSClient)(nil).DeregisterTaskDefinition), arg0)
+}
+
+// DescribeCapacityProviders mocks base method
+func (m *MockECSClient) DescribeCapacityProviders(arg0 []string) ([]*ecs0.CapacityProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCapacityProviders", arg0)
+	ret0, _ := ret[0].([]*ecs0.CapacityProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCapacityProviders indicates an expected call of DescribeCapacityProviders
+func (mr *MockECSClientMockRecorder) DescribeCapacityProviders(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCapacityProviders", reflect.TypeOf((*MockECSClient)(nil).DescribeCapacityProviders), arg0)
+}
+
+// DescribeContainerInstances mocks base method
+func (m *MockECSClient) DescribeContainerInstances(arg0 []*string) ([]*ecs0.ContainerInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeContainerInstances", arg0)
+	ret0, _ := ret[0].([]*ecs0.ContainerInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeContainerInstances indicates an expected call of DescribeContainerInstances
+func (mr *MockECSClientMockRecorder) DescribeContainerInstances(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeContainerInstances", reflect.TypeOf((*MockECSClient)(nil).DescribeContainerInstances), arg0)
+}
+
 // DescribeService mocks base method
 func (m *MockECSClient) DescribeService(arg0 string) (*ecs0.DescribeServicesOutput, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +209,21 @@ func (mr *MockECSClientMockRecorder) DescribeService(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockECSClient)(nil).DescribeService), arg0)
 }
 
+// DescribeServices mocks base method
+func (m *MockECSClient) DescribeServices(arg0 []*string) ([]*ecs0.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeServices", arg0)
+	ret0, _ := ret[0].([]*ecs0.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeServices indicates an expected call of DescribeServices
+func (mr *MockECSClientMockRecorder) DescribeServices(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeServices", reflect.TypeOf((*MockECSClient)(nil).DescribeServices), arg0)
+}
+
 // DescribeTaskDefinition mocks base method
 func (m *MockECSClient) DescribeTaskDefinition(arg0 string) (*ecs0.TaskDefinition, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +239,21 @@ func (mr *MockECSClientMockRecorder) DescribeTaskDefinition(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskDefinition", reflect.TypeOf((*MockECSClient)(nil).DescribeTaskDefinition), arg0)
 }
 
+// DescribeTaskDefinitionRegistration mocks base method
+func (m *MockECSClient) DescribeTaskDefinitionRegistration(arg0 string) (*ecs.TaskDefinitionRegistration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskDefinitionRegistration", arg0)
+	ret0, _ := ret[0].(*ecs.TaskDefinitionRegistration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskDefinitionRegistration indicates an expected call of DescribeTaskDefinitionRegistration
+func (mr *MockECSClientMockRecorder) DescribeTaskDefinitionRegistration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskDefinitionRegistration", reflect.TypeOf((*MockECSClient)(nil).DescribeTaskDefinitionRegistration), arg0)
+}
+
 // DescribeTasks mocks base method
 func (m *MockECSClient) DescribeTasks(arg0 []*string) ([]*ecs0.Task, error) {
 	m.ctrl.T.Helper()
@@ -152,6 +269,20 @@ func (mr *MockECSClientMockRecorder) DescribeTasks(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTasks", reflect.TypeOf((*MockECSClient)(nil).DescribeTasks), arg0)
 }
 
+// DrainContainerInstances mocks base method
+func (m *MockECSClient) DrainContainerInstances(arg0 []*string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrainContainerInstances", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DrainContainerInstances indicates an expected call of DrainContainerInstances
+func (mr *MockECSClientMockRecorder) DrainContainerInstances(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainContainerInstances", reflect.TypeOf((*MockECSClient)(nil).DrainContainerInstances), arg0)
+}
+
 // GetAttributesFromDescribeContainerInstances mocks base method
 func (m *MockECSClient) GetAttributesFromDescribeContainerInstances(arg0 []*string) (map[string][]*string, error) {
 	m.ctrl.T.Helper()
@@ -167,6 +298,22 @@ func (mr *MockECSClientMockRecorder) GetAttributesFromDescribeContainerInstances
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributesFromDescribeContainerInstances", reflect.TypeOf((*MockECSClient)(nil).GetAttributesFromDescribeContainerInstances), arg0)
 }
 
+// GetClusterTaskCounts mocks base method
+func (m *MockECSClient) GetClusterTaskCounts(arg0 string) (int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterTaskCounts", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterTaskCounts indicates an expected call of GetClusterTaskCounts
+func (mr *MockECSClientMockRecorder) GetClusterTaskCounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterTaskCounts", reflect.TypeOf((*MockECSClient)(nil).GetClusterTaskCounts), arg0)
+}
+
 // GetEC2InstanceIDs mocks base method
 func (m *MockECSClient) GetEC2InstanceIDs(arg0 []*string) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -182,6 +329,21 @@ func (mr *MockECSClientMockRecorder) GetEC2InstanceIDs(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEC2InstanceIDs", reflect.TypeOf((*MockECSClient)(nil).GetEC2InstanceIDs), arg0)
 }
 
+// GetRunningTasksCount mocks base method
+func (m *MockECSClient) GetRunningTasksCount(arg0 []*string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunningTasksCount", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunningTasksCount indicates an expected call of GetRunningTasksCount
+func (mr *MockECSClientMockRecorder) GetRunningTasksCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningTasksCount", reflect.TypeOf((*MockECSClient)(nil).GetRunningTasksCount), arg0)
+}
+
 // GetTasksPages mocks base method
 func (m *MockECSClient) GetTasksPages(arg0 *ecs0.ListTasksInput, arg1 ecs.ProcessTasksAction) error {
 	m.ctrl.T.Helper()
@@ -226,6 +388,81 @@ func (mr *MockECSClientMockRecorder) ListAccountSettings(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountSettings", reflect.TypeOf((*MockECSClient)(nil).ListAccountSettings), arg0)
 }
 
+// ListContainerInstances mocks base method
+func (m *MockECSClient) ListContainerInstances() ([]*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContainerInstances")
+	ret0, _ := ret[0].([]*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContainerInstances indicates an expected call of ListContainerInstances
+func (mr *MockECSClientMockRecorder) ListContainerInstances() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContainerInstances", reflect.TypeOf((*MockECSClient)(nil).ListContainerInstances))
+}
+
+// ListServices mocks base method
+func (m *MockECSClient) ListServices() ([]*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices")
+	ret0, _ := ret[0].([]*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices
+func (mr *MockECSClientMockRecorder) ListServices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockECSClient)(nil).ListServices))
+}
+
+// ListTaskDefinitionRevisions mocks base method
+func (m *MockECSClient) ListTaskDefinitionRevisions(arg0, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskDefinitionRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskDefinitionRevisions indicates an expected call of ListTaskDefinitionRevisions
+func (mr *MockECSClientMockRecorder) ListTaskDefinitionRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskDefinitionRevisions", reflect.TypeOf((*MockECSClient)(nil).ListTaskDefinitionRevisions), arg0, arg1)
+}
+
+// ListTasksPage mocks base method
+func (m *MockECSClient) ListTasksPage(arg0 *ecs0.ListTasksInput) ([]*ecs0.Task, *string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTasksPage", arg0)
+	ret0, _ := ret[0].([]*ecs0.Task)
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTasksPage indicates an expected call of ListTasksPage
+func (mr *MockECSClientMockRecorder) ListTasksPage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasksPage", reflect.TypeOf((*MockECSClient)(nil).ListTasksPage), arg0)
+}
+
+// PutAttributes mocks base method
+func (m *MockECSClient) PutAttributes(arg0 []*ecs0.Attribute) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutAttributes", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutAttributes indicates an expected call of PutAttributes
+func (mr *MockECSClientMockRecorder) PutAttributes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAttributes", reflect.TypeOf((*MockECSClient)(nil).PutAttributes), arg0)
+}
+
 // RegisterTaskDefinitionIfNeeded mocks base method
 func (m *MockECSClient) RegisterTaskDefinitionIfNeeded(arg0 *ecs0.RegisterTaskDefinitionInput, arg1 cache.Cache) (*ecs0.TaskDefinition, error) {
 	m.ctrl.T.Helper()
@@ -270,6 +507,20 @@ func (mr *MockECSClientMockRecorder) StopTask(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTask", reflect.TypeOf((*MockECSClient)(nil).StopTask), arg0)
 }
 
+// UpdateContainerAgent mocks base method
+func (m *MockECSClient) UpdateContainerAgent(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContainerAgent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateContainerAgent indicates an expected call of UpdateContainerAgent
+func (mr *MockECSClientMockRecorder) UpdateContainerAgent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContainerAgent", reflect.TypeOf((*MockECSClient)(nil).UpdateContainerAgent), arg0)
+}
+
 // UpdateService mocks base method
 func (m *MockECSClient) UpdateService(arg0 *ecs0.UpdateServiceInput) error {
 	m.ctrl.T.Helper()
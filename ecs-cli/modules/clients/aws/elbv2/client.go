@@ -0,0 +1,168 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package elbv2 contains functions for looking up the Application and
+// Network Load Balancers in front of ECS Services
+package elbv2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+)
+
+// factory function to create clients
+func newELBV2Client(config *config.CommandConfig) elbv2iface.ELBV2API {
+	client := elbv2.New(config.Session)
+	client.Handlers.Build.PushBackNamed(clients.CustomUserAgentHandler())
+	return client
+}
+
+// GetLoadBalancerForTargetGroupFunc is the interface/signature for GetLoadBalancerForTargetGroup
+// This helps when writing code in other packages that need to mock this function
+type GetLoadBalancerForTargetGroupFunc func(targetGroupArn string, config *config.CommandConfig) (*elbv2.LoadBalancer, error)
+
+// GetLoadBalancerForTargetGroup returns the load balancer that forwards traffic to the given target group
+func GetLoadBalancerForTargetGroup(targetGroupArn string, config *config.CommandConfig) (*elbv2.LoadBalancer, error) {
+	return getLoadBalancerForTargetGroup(targetGroupArn, newELBV2Client(config))
+}
+
+func getLoadBalancerForTargetGroup(targetGroupArn string, client elbv2iface.ELBV2API) (*elbv2.LoadBalancer, error) {
+	targetGroups, err := client.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+		TargetGroupArns: aws.StringSlice([]string{targetGroupArn}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(targetGroups.TargetGroups) == 0 {
+		return nil, fmt.Errorf("Target group %s not found", targetGroupArn)
+	}
+	loadBalancerArns := targetGroups.TargetGroups[0].LoadBalancerArns
+	if len(loadBalancerArns) == 0 {
+		return nil, fmt.Errorf("Target group %s is not associated with a load balancer", targetGroupArn)
+	}
+
+	loadBalancers, err := client.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: loadBalancerArns[:1],
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(loadBalancers.LoadBalancers) == 0 {
+		return nil, fmt.Errorf("Load balancer %s not found", aws.StringValue(loadBalancerArns[0]))
+	}
+	return loadBalancers.LoadBalancers[0], nil
+}
+
+// GetTargetHealthFunc is the interface/signature for GetTargetHealth
+type GetTargetHealthFunc func(targetGroupArn string, config *config.CommandConfig) (map[string]string, error)
+
+// GetTargetHealth returns the health state of the targets of the target group, keyed by TargetKey
+func GetTargetHealth(targetGroupArn string, config *config.CommandConfig) (map[string]string, error) {
+	return getTargetHealth(targetGroupArn, newELBV2Client(config))
+}
+
+func getTargetHealth(targetGroupArn string, client elbv2iface.ELBV2API) (map[string]string, error) {
+	output, err := client.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupArn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	targetHealth := make(map[string]string)
+	for _, description := range output.TargetHealthDescriptions {
+		if description.Target == nil || description.TargetHealth == nil {
+			continue
+		}
+		key := TargetKey(aws.StringValue(description.Target.Id), aws.Int64Value(description.Target.Port))
+		targetHealth[key] = aws.StringValue(description.TargetHealth.State)
+	}
+	return targetHealth, nil
+}
+
+// TargetKey identifies a target by its ID (an EC2 instance ID or an IP address) and port
+func TargetKey(id string, port int64) string {
+	return fmt.Sprintf("%s:%d", id, port)
+}
+
+// GetServiceURLFunc is the interface/signature for GetServiceURL
+type GetServiceURLFunc func(targetGroupArn string, config *config.CommandConfig) (string, error)
+
+// GetServiceURL returns the URL of the load balancer that forwards traffic to the given target group,
+// using its first listener
+func GetServiceURL(targetGroupArn string, config *config.CommandConfig) (string, error) {
+	return getServiceURL(targetGroupArn, newELBV2Client(config))
+}
+
+func getServiceURL(targetGroupArn string, client elbv2iface.ELBV2API) (string, error) {
+	loadBalancer, err := getLoadBalancerForTargetGroup(targetGroupArn, client)
+	if err != nil {
+		return "", err
+	}
+	output, err := client.DescribeListeners(&elbv2.DescribeListenersInput{
+		LoadBalancerArn: loadBalancer.LoadBalancerArn,
+	})
+	if err != nil {
+		return "", err
+	}
+	dnsName := aws.StringValue(loadBalancer.DNSName)
+	if len(output.Listeners) == 0 {
+		return dnsName, nil
+	}
+	return listenerURL(dnsName, output.Listeners[0]), nil
+}
+
+// listenerURL formats the address of the listener, omitting default HTTP and HTTPS ports
+func listenerURL(dnsName string, listener *elbv2.Listener) string {
+	protocol := aws.StringValue(listener.Protocol)
+	port := aws.Int64Value(listener.Port)
+	switch {
+	case protocol == "HTTP" && port == 80, protocol == "HTTPS" && port == 443:
+		return fmt.Sprintf("%s://%s", strings.ToLower(protocol), dnsName)
+	case protocol == "HTTP", protocol == "HTTPS":
+		return fmt.Sprintf("%s://%s:%d", strings.ToLower(protocol), dnsName, port)
+	default:
+		return fmt.Sprintf("%s:%d", dnsName, port)
+	}
+}
+
+// deregistrationDelayAttribute is the target group attribute holding the number of seconds
+// to wait before deregistering a draining target
+const deregistrationDelayAttribute = "deregistration_delay.timeout_seconds"
+
+// SetDeregistrationDelayFunc is the interface/signature for SetDeregistrationDelay
+type SetDeregistrationDelayFunc func(targetGroupArn string, seconds int64, config *config.CommandConfig) error
+
+// SetDeregistrationDelay sets the deregistration delay of the target group
+func SetDeregistrationDelay(targetGroupArn string, seconds int64, config *config.CommandConfig) error {
+	return setDeregistrationDelay(targetGroupArn, seconds, newELBV2Client(config))
+}
+
+func setDeregistrationDelay(targetGroupArn string, seconds int64, client elbv2iface.ELBV2API) error {
+	_, err := client.ModifyTargetGroupAttributes(&elbv2.ModifyTargetGroupAttributesInput{
+		TargetGroupArn: aws.String(targetGroupArn),
+		Attributes: []*elbv2.TargetGroupAttribute{
+			{
+				Key:   aws.String(deregistrationDelayAttribute),
+				Value: aws.String(strconv.FormatInt(seconds, 10)),
+			},
+		},
+	})
+	return err
+}
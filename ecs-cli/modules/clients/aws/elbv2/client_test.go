@@ -0,0 +1,171 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/elbv2/mock/sdk"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	targetGroupArn  = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/web/6d0ecf831eec9f09"
+	loadBalancerArn = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/web/50dc6c495c0c9188"
+)
+
+func TestGetLoadBalancerForTargetGroup(t *testing.T) {
+	loadBalancer := &elbv2.LoadBalancer{
+		LoadBalancerArn:       aws.String(loadBalancerArn),
+		DNSName:               aws.String("web-1234567890.us-west-2.elb.amazonaws.com"),
+		CanonicalHostedZoneId: aws.String("Z1H1FL5HABSF5"),
+	}
+	mockELBV2, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockELBV2.EXPECT().DescribeTargetGroups(gomock.Any()).Do(func(input *elbv2.DescribeTargetGroupsInput) {
+		assert.Equal(t, []string{targetGroupArn}, aws.StringValueSlice(input.TargetGroupArns), "Expected target group ARN to match")
+	}).Return(&elbv2.DescribeTargetGroupsOutput{
+		TargetGroups: []*elbv2.TargetGroup{
+			{
+				TargetGroupArn:   aws.String(targetGroupArn),
+				LoadBalancerArns: aws.StringSlice([]string{loadBalancerArn}),
+			},
+		},
+	}, nil)
+	mockELBV2.EXPECT().DescribeLoadBalancers(gomock.Any()).Do(func(input *elbv2.DescribeLoadBalancersInput) {
+		assert.Equal(t, []string{loadBalancerArn}, aws.StringValueSlice(input.LoadBalancerArns), "Expected load balancer ARN to match")
+	}).Return(&elbv2.DescribeLoadBalancersOutput{
+		LoadBalancers: []*elbv2.LoadBalancer{loadBalancer},
+	}, nil)
+
+	observed, err := getLoadBalancerForTargetGroup(targetGroupArn, mockELBV2)
+	assert.NoError(t, err, "Unexpected error looking up load balancer")
+	assert.Equal(t, loadBalancer, observed, "Expected load balancer to match")
+}
+
+func TestGetLoadBalancerForTargetGroupWithoutLoadBalancer(t *testing.T) {
+	mockELBV2, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockELBV2.EXPECT().DescribeTargetGroups(gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+		TargetGroups: []*elbv2.TargetGroup{
+			{TargetGroupArn: aws.String(targetGroupArn)},
+		},
+	}, nil)
+
+	_, err := getLoadBalancerForTargetGroup(targetGroupArn, mockELBV2)
+	assert.Error(t, err, "Expected error looking up load balancer")
+}
+
+func TestGetLoadBalancerForTargetGroupNotFound(t *testing.T) {
+	mockELBV2, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockELBV2.EXPECT().DescribeTargetGroups(gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{}, nil)
+
+	_, err := getLoadBalancerForTargetGroup(targetGroupArn, mockELBV2)
+	assert.Error(t, err, "Expected error looking up load balancer")
+}
+
+func TestGetTargetHealth(t *testing.T) {
+	mockELBV2, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockELBV2.EXPECT().DescribeTargetHealth(gomock.Any()).Do(func(input *elbv2.DescribeTargetHealthInput) {
+		assert.Equal(t, targetGroupArn, aws.StringValue(input.TargetGroupArn), "Expected target group ARN to match")
+	}).Return(&elbv2.DescribeTargetHealthOutput{
+		TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+			{
+				Target:       &elbv2.TargetDescription{Id: aws.String("10.0.0.1"), Port: aws.Int64(80)},
+				TargetHealth: &elbv2.TargetHealth{State: aws.String("healthy")},
+			},
+			{
+				Target:       &elbv2.TargetDescription{Id: aws.String("i-1234"), Port: aws.Int64(32768)},
+				TargetHealth: &elbv2.TargetHealth{State: aws.String("unhealthy"), Reason: aws.String("Target.FailedHealthChecks")},
+			},
+		},
+	}, nil)
+
+	targetHealth, err := getTargetHealth(targetGroupArn, mockELBV2)
+	assert.NoError(t, err, "Unexpected error getting target health")
+	assert.Equal(t, map[string]string{
+		"10.0.0.1:80":  "healthy",
+		"i-1234:32768": "unhealthy",
+	}, targetHealth, "Expected target health to match")
+}
+
+func TestGetServiceURL(t *testing.T) {
+	mockELBV2, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockELBV2.EXPECT().DescribeTargetGroups(gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+		TargetGroups: []*elbv2.TargetGroup{
+			{
+				TargetGroupArn:   aws.String(targetGroupArn),
+				LoadBalancerArns: aws.StringSlice([]string{loadBalancerArn}),
+			},
+		},
+	}, nil)
+	mockELBV2.EXPECT().DescribeLoadBalancers(gomock.Any()).Return(&elbv2.DescribeLoadBalancersOutput{
+		LoadBalancers: []*elbv2.LoadBalancer{
+			{
+				LoadBalancerArn: aws.String(loadBalancerArn),
+				DNSName:         aws.String("web-1234567890.us-west-2.elb.amazonaws.com"),
+			},
+		},
+	}, nil)
+	mockELBV2.EXPECT().DescribeListeners(gomock.Any()).Do(func(input *elbv2.DescribeListenersInput) {
+		assert.Equal(t, loadBalancerArn, aws.StringValue(input.LoadBalancerArn), "Expected load balancer ARN to match")
+	}).Return(&elbv2.DescribeListenersOutput{
+		Listeners: []*elbv2.Listener{
+			{Port: aws.Int64(443), Protocol: aws.String("HTTPS")},
+		},
+	}, nil)
+
+	url, err := getServiceURL(targetGroupArn, mockELBV2)
+	assert.NoError(t, err, "Unexpected error getting service URL")
+	assert.Equal(t, "https://web-1234567890.us-west-2.elb.amazonaws.com", url, "Expected service URL to match")
+}
+
+func TestListenerURL(t *testing.T) {
+	dnsName := "web.elb.amazonaws.com"
+	assert.Equal(t, "http://web.elb.amazonaws.com", listenerURL(dnsName, &elbv2.Listener{Port: aws.Int64(80), Protocol: aws.String("HTTP")}))
+	assert.Equal(t, "http://web.elb.amazonaws.com:8080", listenerURL(dnsName, &elbv2.Listener{Port: aws.Int64(8080), Protocol: aws.String("HTTP")}))
+	assert.Equal(t, "web.elb.amazonaws.com:6379", listenerURL(dnsName, &elbv2.Listener{Port: aws.Int64(6379), Protocol: aws.String("TCP")}))
+}
+
+func TestSetDeregistrationDelay(t *testing.T) {
+	mockELBV2, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockELBV2.EXPECT().ModifyTargetGroupAttributes(gomock.Any()).Do(func(input *elbv2.ModifyTargetGroupAttributesInput) {
+		assert.Equal(t, targetGroupArn, aws.StringValue(input.TargetGroupArn), "Expected target group ARN to match")
+		require.Len(t, input.Attributes, 1, "Expected one attribute to be modified")
+		assert.Equal(t, "deregistration_delay.timeout_seconds", aws.StringValue(input.Attributes[0].Key), "Expected attribute key to match")
+		assert.Equal(t, "30", aws.StringValue(input.Attributes[0].Value), "Expected attribute value to match")
+	}).Return(&elbv2.ModifyTargetGroupAttributesOutput{}, nil)
+
+	err := setDeregistrationDelay(targetGroupArn, 30, mockELBV2)
+	assert.NoError(t, err, "Unexpected error setting deregistration delay")
+}
+
+func setupTestController(t *testing.T) (*mock_elbv2iface.MockELBV2API, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	return mock_elbv2iface.NewMockELBV2API(ctrl), ctrl
+}
@@ -28,6 +28,9 @@ type Client interface {
 	CreateRole(iam.CreateRoleInput) (*iam.CreateRoleOutput, error)
 	CreatePolicy(iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error)
 	CreateOrFindRole(string, string, string, []*iam.Tag) (string, error)
+	CreateServiceLinkedRole(awsServiceName string) (*iam.CreateServiceLinkedRoleOutput, error)
+	GetRole(roleName string) (*iam.GetRoleOutput, error)
+	PutRolePolicy(roleName, policyName, policyDocument string) (*iam.PutRolePolicyOutput, error)
 }
 
 type iamClient struct {
@@ -80,6 +83,49 @@ func (c *iamClient) CreatePolicy(input iam.CreatePolicyInput) (*iam.CreatePolicy
 	return output, nil
 }
 
+// CreateServiceLinkedRole creates the service-linked role of an AWS service, e.g. ecs.amazonaws.com
+func (c *iamClient) CreateServiceLinkedRole(awsServiceName string) (*iam.CreateServiceLinkedRoleOutput, error) {
+	request := iam.CreateServiceLinkedRoleInput{
+		AWSServiceName: aws.String(awsServiceName),
+	}
+
+	output, err := c.client.CreateServiceLinkedRole(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (c *iamClient) GetRole(roleName string) (*iam.GetRoleOutput, error) {
+	request := iam.GetRoleInput{
+		RoleName: aws.String(roleName),
+	}
+
+	output, err := c.client.GetRole(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// PutRolePolicy creates or replaces an inline policy of the role
+func (c *iamClient) PutRolePolicy(roleName, policyName, policyDocument string) (*iam.PutRolePolicyOutput, error) {
+	request := iam.PutRolePolicyInput{
+		RoleName:       aws.String(roleName),
+		PolicyName:     aws.String(policyName),
+		PolicyDocument: aws.String(policyDocument),
+	}
+
+	output, err := c.client.PutRolePolicy(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 // CreateOrFindRole returns a new role ARN or an empty string if role already exists
 func (c *iamClient) CreateOrFindRole(roleName, roleDescription, assumeRolePolicyDoc string, tags []*iam.Tag) (string, error) {
 	createRoleRequest := iam.CreateRoleInput{
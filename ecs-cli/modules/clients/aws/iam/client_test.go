@@ -117,6 +117,62 @@ func TestCreatePolicy_ErrorCase(t *testing.T) {
 	assert.Error(t, err, "Expected error when Creating Policy")
 }
 
+func TestCreateServiceLinkedRole(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+
+	expectedInput := iam.CreateServiceLinkedRoleInput{
+		AWSServiceName: aws.String("ecs.amazonaws.com"),
+	}
+	mockIAM.EXPECT().CreateServiceLinkedRole(&expectedInput).Return(&iam.CreateServiceLinkedRoleOutput{}, nil)
+
+	_, err := client.CreateServiceLinkedRole("ecs.amazonaws.com")
+	assert.NoError(t, err, "Unexpected error when Creating Service-Linked Role")
+}
+
+func TestGetRole(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+
+	expectedInput := iam.GetRoleInput{
+		RoleName: aws.String(testRoleName),
+	}
+	mockIAM.EXPECT().GetRole(&expectedInput).Return(&iam.GetRoleOutput{Role: &iam.Role{RoleName: aws.String(testRoleName)}}, nil)
+
+	output, err := client.GetRole(testRoleName)
+	assert.NoError(t, err, "Unexpected error when Getting Role")
+	assert.Equal(t, testRoleName, aws.StringValue(output.Role.RoleName))
+}
+
+func TestGetRole_ErrorCase(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+	mockIAM.EXPECT().GetRole(gomock.Any()).Return(nil, errors.New("something went wrong"))
+
+	_, err := client.GetRole(testRoleName)
+	assert.Error(t, err, "Expected error when Getting Role")
+}
+
+func TestPutRolePolicy(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+
+	policyDocument := `{"Version":"2012-10-17","Statement":[]}`
+	expectedInput := iam.PutRolePolicyInput{
+		RoleName:       aws.String(testRoleName),
+		PolicyName:     aws.String("secrets"),
+		PolicyDocument: aws.String(policyDocument),
+	}
+	mockIAM.EXPECT().PutRolePolicy(&expectedInput).Return(&iam.PutRolePolicyOutput{}, nil)
+
+	_, err := client.PutRolePolicy(testRoleName, "secrets", policyDocument)
+	assert.NoError(t, err, "Unexpected error when Putting Role Policy")
+}
+
+func TestPutRolePolicy_ErrorCase(t *testing.T) {
+	mockIAM, client := setupTestController(t)
+	mockIAM.EXPECT().PutRolePolicy(gomock.Any()).Return(nil, errors.New("something went wrong"))
+
+	_, err := client.PutRolePolicy(testRoleName, "secrets", "{}")
+	assert.Error(t, err, "Expected error when Putting Role Policy")
+}
+
 func setupTestController(t *testing.T) (*mock_iamiface.MockIAMAPI, Client) {
 	ctrl := gomock.NewController(t)
 	mockIAM := mock_iamiface.NewMockIAMAPI(ctrl)
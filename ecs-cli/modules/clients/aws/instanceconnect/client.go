@@ -0,0 +1,61 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package instanceconnect contains functions for connecting to EC2 instances with one-time SSH keys
+package instanceconnect
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2instanceconnect"
+	"github.com/aws/aws-sdk-go/service/ec2instanceconnect/ec2instanceconnectiface"
+)
+
+// factory function to create clients
+func newInstanceConnectClient(config *config.CommandConfig) ec2instanceconnectiface.EC2InstanceConnectAPI {
+	client := ec2instanceconnect.New(config.Session)
+	client.Handlers.Build.PushBackNamed(clients.CustomUserAgentHandler())
+	return client
+}
+
+// SendSSHPublicKeyFunc is the interface/signature for SendSSHPublicKey
+// This helps when writing code in other packages that need to mock this function
+type SendSSHPublicKeyFunc func(instanceID, availabilityZone, osUser, publicKey string, config *config.CommandConfig) error
+
+// SendSSHPublicKey pushes the public key to the instance for the OS user. EC2 Instance Connect
+// removes the key from the instance after 60 seconds, so the SSH connection must be made by then.
+func SendSSHPublicKey(instanceID, availabilityZone, osUser, publicKey string, config *config.CommandConfig) error {
+	return sendSSHPublicKey(instanceID, availabilityZone, osUser, publicKey, newInstanceConnectClient(config))
+}
+
+func sendSSHPublicKey(instanceID, availabilityZone, osUser, publicKey string, client ec2instanceconnectiface.EC2InstanceConnectAPI) error {
+	input := &ec2instanceconnect.SendSSHPublicKeyInput{
+		InstanceId:     aws.String(instanceID),
+		InstanceOSUser: aws.String(osUser),
+		SSHPublicKey:   aws.String(publicKey),
+	}
+	if availabilityZone != "" {
+		input.AvailabilityZone = aws.String(availabilityZone)
+	}
+	output, err := client.SendSSHPublicKey(input)
+	if err != nil {
+		return err
+	}
+	if !aws.BoolValue(output.Success) {
+		return fmt.Errorf("EC2 Instance Connect did not push the SSH public key to instance %s", instanceID)
+	}
+	return nil
+}
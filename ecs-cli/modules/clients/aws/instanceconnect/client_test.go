@@ -0,0 +1,90 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package instanceconnect
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/instanceconnect/mock/sdk"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2instanceconnect"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendSSHPublicKey(t *testing.T) {
+	mockInstanceConnect, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockInstanceConnect.EXPECT().SendSSHPublicKey(gomock.Any()).Do(func(input *ec2instanceconnect.SendSSHPublicKeyInput) {
+		assert.Equal(t, &ec2instanceconnect.SendSSHPublicKeyInput{
+			InstanceId:     aws.String("i-0123456789abcdef0"),
+			InstanceOSUser: aws.String("ec2-user"),
+			SSHPublicKey:   aws.String("ssh-rsa AAAA"),
+		}, input, "Expected the availability zone to be omitted")
+	}).Return(&ec2instanceconnect.SendSSHPublicKeyOutput{Success: aws.Bool(true)}, nil)
+
+	err := sendSSHPublicKey("i-0123456789abcdef0", "", "ec2-user", "ssh-rsa AAAA", mockInstanceConnect)
+	assert.NoError(t, err, "Unexpected error sending SSH public key")
+}
+
+func TestSendSSHPublicKeyWithAvailabilityZone(t *testing.T) {
+	mockInstanceConnect, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockInstanceConnect.EXPECT().SendSSHPublicKey(gomock.Any()).Do(func(input *ec2instanceconnect.SendSSHPublicKeyInput) {
+		assert.Equal(t, "us-west-2a", aws.StringValue(input.AvailabilityZone), "Expected availability zone to match")
+	}).Return(&ec2instanceconnect.SendSSHPublicKeyOutput{Success: aws.Bool(true)}, nil)
+
+	err := sendSSHPublicKey("i-0123456789abcdef0", "us-west-2a", "ec2-user", "ssh-rsa AAAA", mockInstanceConnect)
+	assert.NoError(t, err, "Unexpected error sending SSH public key")
+}
+
+func TestSendSSHPublicKeyUnsuccessful(t *testing.T) {
+	mockInstanceConnect, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockInstanceConnect.EXPECT().SendSSHPublicKey(gomock.Any()).Return(&ec2instanceconnect.SendSSHPublicKeyOutput{Success: aws.Bool(false)}, nil)
+
+	err := sendSSHPublicKey("i-0123456789abcdef0", "us-west-2a", "ec2-user", "ssh-rsa AAAA", mockInstanceConnect)
+	assert.Error(t, err, "Expected error when the key was not pushed")
+}
+
+func TestGenerateSSHKey(t *testing.T) {
+	privateKeyPEM, publicKey, err := GenerateSSHKey()
+	require.NoError(t, err, "Unexpected error generating SSH key")
+
+	block, _ := pem.Decode(privateKeyPEM)
+	require.NotNil(t, block, "Expected PEM encoded private key")
+	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	require.NoError(t, err, "Unexpected error parsing private key")
+
+	fields := strings.Fields(publicKey)
+	require.Len(t, fields, 2, "Expected key type and key")
+	assert.Equal(t, "ssh-rsa", fields[0])
+	wire, err := base64.StdEncoding.DecodeString(fields[1])
+	require.NoError(t, err, "Unexpected error decoding public key")
+	assert.Equal(t, authorizedKey(&privateKey.PublicKey), publicKey, "Expected public key to match private key")
+	assert.Equal(t, "\x00\x00\x00\x07ssh-rsa", string(wire[:11]), "Expected wire format to start with key type")
+}
+
+func setupTestController(t *testing.T) (*mock_ec2instanceconnectiface.MockEC2InstanceConnectAPI, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	return mock_ec2instanceconnectiface.NewMockEC2InstanceConnectAPI(ctrl), ctrl
+}
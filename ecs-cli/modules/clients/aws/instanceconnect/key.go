@@ -0,0 +1,70 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package instanceconnect
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+)
+
+const (
+	sshKeyBits = 2048
+	sshKeyType = "ssh-rsa"
+)
+
+// GenerateSSHKey returns a new RSA key pair for a single connection, with the private key PEM encoded
+// for the -i flag of ssh, and the public key in the authorized_keys format sent to the instance.
+func GenerateSSHKey() ([]byte, string, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, sshKeyBits)
+	if err != nil {
+		return nil, "", err
+	}
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+	return privateKeyPEM, authorizedKey(&privateKey.PublicKey), nil
+}
+
+// authorizedKey encodes the public key in the SSH wire format of RFC 4253: the key type, the
+// public exponent and the modulus, each prefixed with its length
+func authorizedKey(publicKey *rsa.PublicKey) string {
+	var wire []byte
+	for _, field := range [][]byte{
+		[]byte(sshKeyType),
+		mpint(big.NewInt(int64(publicKey.E))),
+		mpint(publicKey.N),
+	} {
+		length := make([]byte, 4)
+		binary.BigEndian.PutUint32(length, uint32(len(field)))
+		wire = append(wire, length...)
+		wire = append(wire, field...)
+	}
+	return sshKeyType + " " + base64.StdEncoding.EncodeToString(wire)
+}
+
+// mpint returns the bytes of a positive integer in the mpint format of RFC 4251, which has a
+// leading zero byte if the most significant bit is set so that it is not read as negative
+func mpint(n *big.Int) []byte {
+	b := n.Bytes()
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		return append([]byte{0}, b...)
+	}
+	return b
+}
@@ -0,0 +1,57 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package lambda contains functions for invoking AWS Lambda functions
+package lambda
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+)
+
+// factory function to create clients
+func newLambdaClient(config *config.CommandConfig) lambdaiface.LambdaAPI {
+	client := lambda.New(config.Session)
+	client.Handlers.Build.PushBackNamed(clients.CustomUserAgentHandler())
+	return client
+}
+
+// InvokeFunc is the interface/signature for Invoke
+// This helps when writing code in other packages that need to mock this function
+type InvokeFunc func(functionName string, payload []byte, config *config.CommandConfig) ([]byte, error)
+
+// Invoke invokes the function synchronously and returns its response payload.
+// An error is returned if the function itself failed.
+func Invoke(functionName string, payload []byte, config *config.CommandConfig) ([]byte, error) {
+	return invoke(functionName, payload, newLambdaClient(config))
+}
+
+func invoke(functionName string, payload []byte, client lambdaiface.LambdaAPI) ([]byte, error) {
+	output, err := client.Invoke(&lambda.InvokeInput{
+		FunctionName:   aws.String(functionName),
+		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
+		Payload:        payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if output.FunctionError != nil {
+		return output.Payload, fmt.Errorf("Function %s failed (%s): %s", functionName, aws.StringValue(output.FunctionError), string(output.Payload))
+	}
+	return output.Payload, nil
+}
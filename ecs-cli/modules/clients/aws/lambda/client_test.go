@@ -0,0 +1,58 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/lambda/mock/sdk"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvoke(t *testing.T) {
+	mockLambda, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockLambda.EXPECT().Invoke(gomock.Any()).Do(func(input *lambda.InvokeInput) {
+		assert.Equal(t, "warm-cache", aws.StringValue(input.FunctionName), "Expected function name to match")
+		assert.Equal(t, lambda.InvocationTypeRequestResponse, aws.StringValue(input.InvocationType), "Expected synchronous invocation")
+		assert.Equal(t, `{"stage":"post_deploy"}`, string(input.Payload), "Expected payload to match")
+	}).Return(&lambda.InvokeOutput{Payload: []byte(`"ok"`)}, nil)
+
+	payload, err := invoke("warm-cache", []byte(`{"stage":"post_deploy"}`), mockLambda)
+	assert.NoError(t, err, "Unexpected error invoking function")
+	assert.Equal(t, `"ok"`, string(payload), "Expected response payload")
+}
+
+func TestInvokeFunctionError(t *testing.T) {
+	mockLambda, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockLambda.EXPECT().Invoke(gomock.Any()).Return(&lambda.InvokeOutput{
+		FunctionError: aws.String("Unhandled"),
+		Payload:       []byte(`{"errorMessage":"boom"}`),
+	}, nil)
+
+	_, err := invoke("warm-cache", nil, mockLambda)
+	assert.Error(t, err, "Expected error when the function fails")
+	assert.Contains(t, err.Error(), "boom", "Expected error to contain the function error")
+}
+
+func setupTestController(t *testing.T) (*mock_lambdaiface.MockLambdaAPI, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	return mock_lambdaiface.NewMockLambdaAPI(ctrl), ctrl
+}
@@ -0,0 +1,114 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package route53
+
+import (
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	log "github.com/sirupsen/logrus"
+)
+
+// UpsertAliasRecordFunc is the interface/signature for UpsertAliasRecord
+// This helps when writing code in other packages that need to mock this function
+type UpsertAliasRecordFunc func(hostedZoneID, name, targetDNSName, targetHostedZoneID string, config *config.CommandConfig) error
+
+// DeleteAliasRecordFunc is the interface/signature for DeleteAliasRecord
+// This helps when writing code in other packages that need to mock this function
+type DeleteAliasRecordFunc func(hostedZoneID, name string, config *config.CommandConfig) error
+
+// UpsertAliasRecord creates or updates an A record with the given name in the hosted zone
+// as an alias for the target DNS name, e.g. the DNS name of a load balancer
+func UpsertAliasRecord(hostedZoneID, name, targetDNSName, targetHostedZoneID string, config *config.CommandConfig) error {
+	return upsertAliasRecord(hostedZoneID, name, targetDNSName, targetHostedZoneID, newRoute53Client(config))
+}
+
+func upsertAliasRecord(hostedZoneID, name, targetDNSName, targetHostedZoneID string, r53Client route53Client) error {
+	recordSet := &route53.ResourceRecordSet{
+		Name: aws.String(name),
+		Type: aws.String(route53.RRTypeA),
+		AliasTarget: &route53.AliasTarget{
+			DNSName:              aws.String(targetDNSName),
+			HostedZoneId:         aws.String(targetHostedZoneID),
+			EvaluateTargetHealth: aws.Bool(true),
+		},
+	}
+	if err := changeRecordSet(hostedZoneID, route53.ChangeActionUpsert, recordSet, r53Client); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"record": name,
+		"target": targetDNSName,
+	}).Info("Updated Route 53 alias record")
+	return nil
+}
+
+// DeleteAliasRecord deletes the A record with the given name from the hosted zone.
+// It is a no-op if the record does not exist.
+func DeleteAliasRecord(hostedZoneID, name string, config *config.CommandConfig) error {
+	return deleteAliasRecord(hostedZoneID, name, newRoute53Client(config))
+}
+
+func deleteAliasRecord(hostedZoneID, name string, r53Client route53Client) error {
+	// Route 53 only deletes a record set that matches the current one exactly
+	output, err := r53Client.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(hostedZoneID),
+		StartRecordName: aws.String(name),
+		StartRecordType: aws.String(route53.RRTypeA),
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		return err
+	}
+	if len(output.ResourceRecordSets) == 0 || !isAliasRecord(output.ResourceRecordSets[0], name) {
+		log.WithFields(log.Fields{
+			"record": name,
+		}).Info("Route 53 alias record does not exist")
+		return nil
+	}
+
+	if err = changeRecordSet(hostedZoneID, route53.ChangeActionDelete, output.ResourceRecordSets[0], r53Client); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"record": name,
+	}).Info("Deleted Route 53 alias record")
+	return nil
+}
+
+func changeRecordSet(hostedZoneID, action string, recordSet *route53.ResourceRecordSet, r53Client route53Client) error {
+	_, err := r53Client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(action),
+					ResourceRecordSet: recordSet,
+				},
+			},
+		},
+	})
+	return err
+}
+
+// isAliasRecord returns true if the record set is an A alias record with the given name.
+// Route 53 returns fully qualified names, which end in a dot.
+func isAliasRecord(recordSet *route53.ResourceRecordSet, name string) bool {
+	recordName := strings.TrimSuffix(aws.StringValue(recordSet.Name), ".")
+	return strings.EqualFold(recordName, strings.TrimSuffix(name, ".")) &&
+		aws.StringValue(recordSet.Type) == route53.RRTypeA &&
+		recordSet.AliasTarget != nil
+}
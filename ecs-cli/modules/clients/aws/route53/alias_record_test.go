@@ -0,0 +1,78 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package route53
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertAliasRecord(t *testing.T) {
+	mockR53 := &mockRoute53Client{}
+
+	err := upsertAliasRecord("Z123", "app.example.com", "web-1234567890.us-west-2.elb.amazonaws.com", "Z1H1FL5HABSF5", mockR53)
+	assert.NoError(t, err, "Unexpected error upserting alias record")
+
+	require.Len(t, mockR53.changes, 1, "Expected one record change")
+	change := mockR53.changes[0]
+	assert.Equal(t, route53.ChangeActionUpsert, aws.StringValue(change.Action), "Expected change action to be UPSERT")
+	assert.Equal(t, "app.example.com", aws.StringValue(change.ResourceRecordSet.Name), "Expected record name to match")
+	assert.Equal(t, route53.RRTypeA, aws.StringValue(change.ResourceRecordSet.Type), "Expected record type to be A")
+	assert.Equal(t, "web-1234567890.us-west-2.elb.amazonaws.com", aws.StringValue(change.ResourceRecordSet.AliasTarget.DNSName), "Expected alias target DNS name to match")
+	assert.Equal(t, "Z1H1FL5HABSF5", aws.StringValue(change.ResourceRecordSet.AliasTarget.HostedZoneId), "Expected alias target hosted zone to match")
+}
+
+func TestDeleteAliasRecord(t *testing.T) {
+	existingRecord := &route53.ResourceRecordSet{
+		Name: aws.String("app.example.com."),
+		Type: aws.String(route53.RRTypeA),
+		AliasTarget: &route53.AliasTarget{
+			DNSName:              aws.String("web-1234567890.us-west-2.elb.amazonaws.com."),
+			HostedZoneId:         aws.String("Z1H1FL5HABSF5"),
+			EvaluateTargetHealth: aws.Bool(true),
+		},
+	}
+	mockR53 := &mockRoute53Client{
+		recordSets: []*route53.ResourceRecordSet{existingRecord},
+	}
+
+	err := deleteAliasRecord("Z123", "app.example.com", mockR53)
+	assert.NoError(t, err, "Unexpected error deleting alias record")
+
+	require.Len(t, mockR53.changes, 1, "Expected one record change")
+	assert.Equal(t, route53.ChangeActionDelete, aws.StringValue(mockR53.changes[0].Action), "Expected change action to be DELETE")
+	assert.Equal(t, existingRecord, mockR53.changes[0].ResourceRecordSet, "Expected the existing record set to be deleted")
+}
+
+func TestDeleteAliasRecordDoesNotExist(t *testing.T) {
+	mockR53 := &mockRoute53Client{
+		recordSets: []*route53.ResourceRecordSet{
+			&route53.ResourceRecordSet{
+				Name: aws.String("other.example.com."),
+				Type: aws.String(route53.RRTypeA),
+				AliasTarget: &route53.AliasTarget{
+					DNSName: aws.String("web-1234567890.us-west-2.elb.amazonaws.com."),
+				},
+			},
+		},
+	}
+
+	err := deleteAliasRecord("Z123", "app.example.com", mockR53)
+	assert.NoError(t, err, "Unexpected error deleting alias record")
+	assert.Empty(t, mockR53.changes, "Expected no record changes")
+}
@@ -199,6 +199,8 @@ func (mock *mockSDClient) GetService(input *servicediscovery.GetServiceInput) (*
 // Implements route53Client interface
 type mockRoute53Client struct {
 	hostedZoneData map[string]route53.GetHostedZoneOutput
+	recordSets     []*route53.ResourceRecordSet
+	changes        []*route53.Change
 }
 
 func (mock *mockRoute53Client) GetHostedZone(input *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error) {
@@ -206,6 +208,17 @@ func (mock *mockRoute53Client) GetHostedZone(input *route53.GetHostedZoneInput)
 	return &zone, nil
 }
 
+func (mock *mockRoute53Client) ChangeResourceRecordSets(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	mock.changes = append(mock.changes, input.ChangeBatch.Changes...)
+	return &route53.ChangeResourceRecordSetsOutput{}, nil
+}
+
+func (mock *mockRoute53Client) ListResourceRecordSets(input *route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error) {
+	return &route53.ListResourceRecordSetsOutput{
+		ResourceRecordSets: mock.recordSets,
+	}, nil
+}
+
 func setupHostedZoneMocks(t *testing.T) route53Client {
 	// Mock Data
 	var hostedZoneData = map[string]route53.GetHostedZoneOutput{
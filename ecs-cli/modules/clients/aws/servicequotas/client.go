@@ -0,0 +1,84 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package servicequotas contains functions for looking up the quotas of AWS services
+package servicequotas
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
+)
+
+const (
+	// ECSServiceCode is the Service Quotas code of Amazon ECS
+	ECSServiceCode = "ecs"
+	// VPCServiceCode is the Service Quotas code of Amazon VPC, which owns the network interface quota
+	VPCServiceCode = "vpc"
+
+	maxResults = 100
+)
+
+// factory function to create clients
+func newServiceQuotasClient(config *config.CommandConfig) servicequotasiface.ServiceQuotasAPI {
+	client := servicequotas.New(config.Session)
+	client.Handlers.Build.PushBackNamed(clients.CustomUserAgentHandler())
+	return client
+}
+
+// GetServiceQuotasFunc is the interface/signature for GetServiceQuotas
+// This helps when writing code in other packages that need to mock this function
+type GetServiceQuotasFunc func(serviceCode string, config *config.CommandConfig) (map[string]float64, error)
+
+// GetServiceQuotas returns the quotas of the AWS service in the configured region, keyed by quota
+// name. Quotas that were increased for the account take precedence over the AWS default values.
+func GetServiceQuotas(serviceCode string, config *config.CommandConfig) (map[string]float64, error) {
+	return getServiceQuotas(serviceCode, newServiceQuotasClient(config))
+}
+
+func getServiceQuotas(serviceCode string, client servicequotasiface.ServiceQuotasAPI) (map[string]float64, error) {
+	quotas := make(map[string]float64)
+	addQuotas := func(page []*servicequotas.ServiceQuota) {
+		for _, quota := range page {
+			if quota.Value != nil {
+				quotas[aws.StringValue(quota.QuotaName)] = aws.Float64Value(quota.Value)
+			}
+		}
+	}
+
+	err := client.ListAWSDefaultServiceQuotasPages(&servicequotas.ListAWSDefaultServiceQuotasInput{
+		ServiceCode: aws.String(serviceCode),
+		MaxResults:  aws.Int64(maxResults),
+	}, func(page *servicequotas.ListAWSDefaultServiceQuotasOutput, lastPage bool) bool {
+		addQuotas(page.Quotas)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Applied quotas are only listed once they differ from the defaults
+	err = client.ListServiceQuotasPages(&servicequotas.ListServiceQuotasInput{
+		ServiceCode: aws.String(serviceCode),
+		MaxResults:  aws.Int64(maxResults),
+	}, func(page *servicequotas.ListServiceQuotasOutput, lastPage bool) bool {
+		addQuotas(page.Quotas)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return quotas, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package servicequotas
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/servicequotas/mock/sdk"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func quota(name string, value float64) *servicequotas.ServiceQuota {
+	return &servicequotas.ServiceQuota{QuotaName: aws.String(name), Value: aws.Float64(value)}
+}
+
+func TestGetServiceQuotas(t *testing.T) {
+	mockServiceQuotas, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockServiceQuotas.EXPECT().ListAWSDefaultServiceQuotasPages(gomock.Any(), gomock.Any()).Do(func(input *servicequotas.ListAWSDefaultServiceQuotasInput, fn func(*servicequotas.ListAWSDefaultServiceQuotasOutput, bool) bool) {
+		assert.Equal(t, ECSServiceCode, aws.StringValue(input.ServiceCode), "Expected service code to match")
+		fn(&servicequotas.ListAWSDefaultServiceQuotasOutput{
+			Quotas: []*servicequotas.ServiceQuota{quota("Services per cluster", 5000), quota("Tasks per service", 5000)},
+		}, false)
+		fn(&servicequotas.ListAWSDefaultServiceQuotasOutput{
+			Quotas: []*servicequotas.ServiceQuota{quota("Container instances per cluster", 5000)},
+		}, true)
+	}).Return(nil)
+	mockServiceQuotas.EXPECT().ListServiceQuotasPages(gomock.Any(), gomock.Any()).Do(func(input *servicequotas.ListServiceQuotasInput, fn func(*servicequotas.ListServiceQuotasOutput, bool) bool) {
+		assert.Equal(t, ECSServiceCode, aws.StringValue(input.ServiceCode), "Expected service code to match")
+		fn(&servicequotas.ListServiceQuotasOutput{
+			Quotas: []*servicequotas.ServiceQuota{quota("Tasks per service", 8000)},
+		}, true)
+	}).Return(nil)
+
+	quotas, err := getServiceQuotas(ECSServiceCode, mockServiceQuotas)
+	assert.NoError(t, err, "Unexpected error getting quotas")
+	assert.Equal(t, map[string]float64{
+		"Services per cluster":            5000,
+		"Tasks per service":               8000,
+		"Container instances per cluster": 5000,
+	}, quotas, "Expected applied quotas to take precedence over defaults")
+}
+
+func TestGetServiceQuotasErrorCase(t *testing.T) {
+	mockServiceQuotas, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockServiceQuotas.EXPECT().ListAWSDefaultServiceQuotasPages(gomock.Any(), gomock.Any()).Return(errors.New("something went wrong"))
+
+	_, err := getServiceQuotas(ECSServiceCode, mockServiceQuotas)
+	assert.Error(t, err, "Expected error getting quotas")
+}
+
+func setupTestController(t *testing.T) (*mock_servicequotasiface.MockServiceQuotasAPI, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	return mock_servicequotasiface.NewMockServiceQuotasAPI(ctrl), ctrl
+}
@@ -18,7 +18,6 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 )
@@ -26,6 +25,7 @@ import (
 // Client sts interface
 type Client interface {
 	GetAWSAccountID() (string, error)
+	GetCallerArn() (string, error)
 }
 
 // stsClient implements Client
@@ -36,7 +36,7 @@ type stsClient struct {
 
 // NewClient Creates a new sts client
 func NewClient(config *config.CommandConfig) Client {
-	client := sts.New(session.New(config.Session.Config))
+	client := sts.New(config.Session.Copy())
 	client.Handlers.Build.PushBackNamed(clients.CustomUserAgentHandler())
 	return newClient(config, client)
 }
@@ -58,3 +58,12 @@ func (c *stsClient) GetAWSAccountID() (string, error) {
 	}
 	return aws.StringValue(resp.Account), nil
 }
+
+// GetCallerArn returns the ARN of the IAM user or role of the caller
+func (c *stsClient) GetCallerArn() (string, error) {
+	resp, err := c.client.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(resp.Arn), nil
+}
@@ -52,6 +52,22 @@ func TestGetAWSAccountIDErrorCase(t *testing.T) {
 
 }
 
+func TestGetCallerArn(t *testing.T) {
+	mockSts, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	expectedArn := "arn:aws:sts::123456789:assumed-role/platform/alice"
+
+	mockSts.EXPECT().GetCallerIdentity(gomock.Any()).Return(&sts.GetCallerIdentityOutput{
+		Account: aws.String("123456789"),
+		Arn:     aws.String(expectedArn),
+	}, nil)
+
+	callerArn, err := client.GetCallerArn()
+	assert.NoError(t, err, "GetCallerArn")
+	assert.Equal(t, expectedArn, callerArn, "Expected caller ARN to match")
+}
+
 func setupTestController(t *testing.T) (*mock_stsiface.MockSTSAPI, Client, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
 	mockSts := mock_stsiface.NewMockSTSAPI(ctrl)
@@ -60,3 +60,18 @@ func (mr *MockClientMockRecorder) GetAWSAccountID() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAWSAccountID", reflect.TypeOf((*MockClient)(nil).GetAWSAccountID))
 }
+
+// GetCallerArn mocks base method
+func (m *MockClient) GetCallerArn() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCallerArn")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCallerArn indicates an expected call of GetCallerArn
+func (mr *MockClientMockRecorder) GetCallerArn() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCallerArn", reflect.TypeOf((*MockClient)(nil).GetCallerArn))
+}
@@ -0,0 +1,56 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package addonsCommand defines the addons commands.
+package addonsCommand
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/addons"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
+	"github.com/urfave/cli"
+)
+
+// AddonsCommand provides the commands to manage the cluster-wide agents.
+func AddonsCommand() cli.Command {
+	return cli.Command{
+		Name:  "addons",
+		Usage: usage.Addons,
+		Subcommands: []cli.Command{
+			installCommand(),
+		},
+	}
+}
+
+func installCommand() cli.Command {
+	return cli.Command{
+		Name:      "install",
+		Usage:     usage.AddonsInstall,
+		ArgsUsage: "datadog|fluent-bit|newrelic",
+		Action: readonly.Guard("addons install", addons.Install,
+			"iam:CreateRole", "iam:AttachRolePolicy", "iam:PutRolePolicy",
+			"ecs:RegisterTaskDefinition", "ecs:CreateService", "ecs:UpdateService"),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), installFlags()),
+		OnUsageError: flags.UsageErrorFactory("install"),
+	}
+}
+
+func installFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.SecretArnFlag,
+			Usage: "Specifies the ARN of the Secrets Manager secret holding the API or license key of the agent. Required for the datadog and newrelic addons.",
+		},
+	}
+}
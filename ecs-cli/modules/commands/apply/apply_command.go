@@ -0,0 +1,118 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package applyCommand defines the apply, reconcile and destroy commands.
+package applyCommand
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/apply"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
+	"github.com/urfave/cli"
+)
+
+// defaultReconcileInterval is the number of seconds between two reconciliations with --watch
+const defaultReconcileInterval = 300
+
+// ApplyCommand applies a manifest to a cluster. The commands of the plan are run with the
+// application returned by newApp.
+func ApplyCommand(newApp func() *cli.App) cli.Command {
+	return cli.Command{
+		Name:         "apply",
+		Usage:        usage.Apply,
+		Action:       readonly.Guard("apply", apply.Apply(newApp), "cloudformation:CreateStack", "cloudformation:UpdateStack", "ecs:RegisterTaskDefinition", "ecs:CreateService", "ecs:UpdateService", "ecs:DeleteService", "servicediscovery:DeleteService"),
+		Flags:        flags.AppendFlags(applyFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("apply"),
+	}
+}
+
+// ReconcileCommand applies a manifest to a cluster periodically, correcting its drift. The projects
+// of the manifest are loaded with the application returned by newApp.
+func ReconcileCommand(newApp func() *cli.App) cli.Command {
+	return cli.Command{
+		Name:         "reconcile",
+		Usage:        usage.Reconcile,
+		Action:       readonly.Guard("reconcile", apply.Reconcile(newApp), "cloudformation:CreateStack", "cloudformation:UpdateStack", "ecs:RegisterTaskDefinition", "ecs:CreateService", "ecs:UpdateService", "ecs:DeleteService", "servicediscovery:DeleteService", "logs:CreateLogGroup"),
+		Flags:        flags.AppendFlags(applyFlags(), reconcileFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("reconcile"),
+	}
+}
+
+// DestroyCommand deletes the resources of a manifest. The deletions are run with the application
+// returned by newApp.
+func DestroyCommand(newApp func() *cli.App) cli.Command {
+	return cli.Command{
+		Name:         "destroy",
+		Usage:        usage.Destroy,
+		Action:       readonly.Guard("destroy", apply.Destroy(newApp), "ecs:DeleteService", "ecs:DeleteCluster", "cloudformation:DeleteStack"),
+		Flags:        flags.AppendFlags(destroyFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("destroy"),
+	}
+}
+
+func applyFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.ManifestFileFlag + ", f",
+			Usage: "Specifies the manifest declaring the cluster and the compose projects deployed on it.",
+		},
+		cli.BoolFlag{
+			Name:  flags.DryRunFlag,
+			Usage: "[Optional] Prints the changes which would be made, without making them.",
+		},
+		cli.BoolFlag{
+			Name:  flags.PruneFlag,
+			Usage: "[Optional] Deletes the services of the cluster created by the compose commands which are not declared in the manifest, after confirmation.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ForceFlag,
+			Usage: "[Optional] Deletes the services which are not declared in the manifest with --" + flags.PruneFlag + " without confirmation.",
+		},
+	}
+}
+
+func reconcileFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.WatchFlag,
+			Usage: "[Optional] Reconciles the cluster every --" + flags.ReconcileIntervalFlag + " seconds until interrupted, instead of once.",
+		},
+		cli.IntFlag{
+			Name:  flags.ReconcileIntervalFlag,
+			Value: defaultReconcileInterval,
+			Usage: "[Optional] Specifies the number of seconds between two reconciliations with --" + flags.WatchFlag + ". Defaults to 5 minutes.",
+		},
+	}
+}
+
+func destroyFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.ManifestFileFlag + ", f",
+			Usage: "Specifies the manifest declaring the cluster and the compose projects deployed on it.",
+		},
+		cli.StringSliceFlag{
+			Name:  flags.TargetFlag,
+			Usage: "[Optional] Deletes only the targeted resource, either cluster or service/<project>, instead of all the resources of the manifest. Targeting the cluster also deletes the services declared on it. Can be used multiple times.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ForceFlag,
+			Usage: "[Optional] Acknowledges that this command permanently deletes resources.",
+		},
+		cli.BoolFlag{
+			Name:  flags.DryRunFlag,
+			Usage: "[Optional] Prints the resources which would be deleted, without deleting them.",
+		},
+	}
+}
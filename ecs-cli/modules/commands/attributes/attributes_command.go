@@ -0,0 +1,68 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package attributesCommand defines the commands that manage container instance attributes
+package attributesCommand
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/attributes"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
+	"github.com/urfave/cli"
+)
+
+// AttributesCommand provides the commands to put and delete custom attributes on container instances.
+func AttributesCommand() cli.Command {
+	return cli.Command{
+		Name:  "attributes",
+		Usage: usage.Attributes,
+		Subcommands: []cli.Command{
+			putCommand(),
+			deleteCommand(),
+		},
+	}
+}
+
+func putCommand() cli.Command {
+	return cli.Command{
+		Name:         "put",
+		Usage:        usage.AttributesPut,
+		Action:       readonly.Guard("attributes put", attributes.PutAttributes, "ecs:PutAttributes"),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), attributesFlags("A comma-separated list of attributes to put, in the format 'name1=value1,name2'. The value is optional.")),
+		OnUsageError: flags.UsageErrorFactory("put"),
+	}
+}
+
+func deleteCommand() cli.Command {
+	return cli.Command{
+		Name:         "delete",
+		Usage:        usage.AttributesDelete,
+		Action:       readonly.Guard("attributes delete", attributes.DeleteAttributes, "ecs:DeleteAttributes"),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), attributesFlags("A comma-separated list of the names of the attributes to delete.")),
+		OnUsageError: flags.UsageErrorFactory("delete"),
+	}
+}
+
+func attributesFlags(attributesUsage string) []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.ContainerInstancesFlag,
+			Usage: "A comma-separated list of container instance IDs or full ARN entries.",
+		},
+		cli.StringFlag{
+			Name:  flags.AttributesFlag,
+			Usage: attributesUsage,
+		},
+	}
+}
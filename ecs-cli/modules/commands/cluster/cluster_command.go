@@ -16,9 +16,20 @@ package clusterCommand
 
 import (
 	ecscli "github.com/aws/amazon-ecs-cli/ecs-cli/modules"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/agents"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/capacity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/clone"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/cluster"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/costs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/env"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/events"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/instances"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/interruptions"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/quotas"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/ssh"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
 	"github.com/urfave/cli"
 )
 
@@ -27,8 +38,8 @@ func UpCommand() cli.Command {
 		Name:         "up",
 		Usage:        usage.ClusterUp,
 		Before:       ecscli.BeforeApp,
-		Action:       cluster.ClusterUp,
-		Flags:        flags.AppendFlags(clusterUpFlags(), flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.DebugFlag()),
+		Action:       readonly.Guard("up", cluster.ClusterUp, "cloudformation:CreateStack", "ecs:CreateCluster", "iam:CreateServiceLinkedRole", "dynamodb:PutItem", "sns:Publish", "cloudwatch:PutMetricData"),
+		Flags:        flags.AppendFlags(clusterUpFlags(), flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCFNWaitMaxAttemptsFlag(), flags.OptionalProgressFlag(), flags.DebugFlag()),
 		OnUsageError: flags.UsageErrorFactory("up"),
 	}
 }
@@ -37,8 +48,9 @@ func DownCommand() cli.Command {
 	return cli.Command{
 		Name:         "down",
 		Usage:        usage.ClusterDown,
-		Action:       cluster.ClusterDown,
-		Flags:        flags.AppendFlags(clusterDownFlags(), flags.OptionalConfigFlags()),
+		Before:       ecscli.BeforeApp,
+		Action:       readonly.Guard("down", cluster.ClusterDown, "cloudformation:DeleteStack", "ecs:DeleteCluster", "dynamodb:PutItem", "sns:Publish", "cloudwatch:PutMetricData"),
+		Flags:        flags.AppendFlags(clusterDownFlags(), flags.OptionalConfigFlags(), flags.OptionalCFNWaitMaxAttemptsFlag(), flags.OptionalProgressFlag()),
 		OnUsageError: flags.UsageErrorFactory("down"),
 	}
 }
@@ -47,32 +59,186 @@ func ScaleCommand() cli.Command {
 	return cli.Command{
 		Name:         "scale",
 		Usage:        usage.ClusterScale,
-		Action:       cluster.ClusterScale,
-		Flags:        flags.AppendFlags(clusterScaleFlags(), flags.OptionalConfigFlags()),
+		Before:       ecscli.BeforeApp,
+		Action:       readonly.Guard("scale", cluster.ClusterScale, "cloudformation:UpdateStack", "dynamodb:PutItem"),
+		Flags:        flags.AppendFlags(clusterScaleFlags(), flags.OptionalConfigFlags(), flags.OptionalCFNWaitMaxAttemptsFlag(), flags.OptionalProgressFlag()),
 		OnUsageError: flags.UsageErrorFactory("scale"),
 	}
 }
 
+func StopCommand() cli.Command {
+	return cli.Command{
+		Name:         "stop",
+		Usage:        usage.ClusterStop,
+		Before:       ecscli.BeforeApp,
+		Action:       readonly.Guard("stop", capacity.Stop, "ecs:UpdateContainerInstancesState", "cloudformation:UpdateStack", "dynamodb:PutItem"),
+		Flags:        flags.AppendFlags(clusterStopFlags(), flags.OptionalConfigFlags(), flags.OptionalCFNWaitMaxAttemptsFlag(), flags.OptionalProgressFlag()),
+		OnUsageError: flags.UsageErrorFactory("stop"),
+	}
+}
+
+func StartCommand() cli.Command {
+	return cli.Command{
+		Name:         "start",
+		Usage:        usage.ClusterStart,
+		Before:       ecscli.BeforeApp,
+		Action:       readonly.Guard("start", capacity.Start, "cloudformation:UpdateStack", "dynamodb:PutItem"),
+		Flags:        flags.AppendFlags(clusterStartFlags(), flags.OptionalConfigFlags(), flags.OptionalCFNWaitMaxAttemptsFlag(), flags.OptionalProgressFlag()),
+		OnUsageError: flags.UsageErrorFactory("start"),
+	}
+}
+
+func SSHCommand() cli.Command {
+	return cli.Command{
+		Name:         "ssh",
+		Usage:        usage.ClusterSSH,
+		ArgsUsage:    "CONTAINER_INSTANCE [COMMAND...]",
+		Action:       ssh.SSH,
+		Flags:        flags.AppendFlags(clusterSSHFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("ssh"),
+	}
+}
+
 func PsCommand() cli.Command {
 	return cli.Command{
 		Name:         "ps",
 		Usage:        usage.ClusterPs,
 		Action:       cluster.ClusterPS,
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalDesiredStatusFlag()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalDesiredStatusFlag(), flags.OptionalPaginationFlags()),
 		OnUsageError: flags.UsageErrorFactory("ps"),
 	}
 }
 
+func StacksCommand() cli.Command {
+	return cli.Command{
+		Name:         "stacks",
+		Usage:        usage.ClusterStacks,
+		Action:       cluster.ClusterStacks,
+		Flags:        flags.OptionalConfigFlags(),
+		OnUsageError: flags.UsageErrorFactory("stacks"),
+	}
+}
+
+func InventoryCommand() cli.Command {
+	return cli.Command{
+		Name:         "inventory",
+		Usage:        usage.ClusterInventory,
+		Action:       cluster.ClusterInventory,
+		Flags:        flags.AppendFlags(clusterInventoryFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("inventory"),
+	}
+}
+
+func InterruptionsCommand() cli.Command {
+	return cli.Command{
+		Name:         "interruptions",
+		Usage:        usage.ClusterInterruptions,
+		Action:       interruptions.Interruptions,
+		Flags:        flags.OptionalConfigFlags(),
+		OnUsageError: flags.UsageErrorFactory("interruptions"),
+	}
+}
+
+func AgentsCommand() cli.Command {
+	return cli.Command{
+		Name:         "agents",
+		Usage:        usage.ClusterAgents,
+		Action:       agents.Agents,
+		Flags:        flags.AppendFlags(clusterAgentsFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("agents"),
+	}
+}
+
+func QuotasCommand() cli.Command {
+	return cli.Command{
+		Name:         "quotas",
+		Usage:        usage.ClusterQuotas,
+		Action:       quotas.Quotas,
+		Flags:        flags.AppendFlags(clusterQuotasFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("quotas"),
+	}
+}
+
+func CostsCommand() cli.Command {
+	return cli.Command{
+		Name:         "costs",
+		Usage:        usage.ClusterCosts,
+		Action:       costs.Costs,
+		Flags:        flags.AppendFlags(clusterCostsFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("costs"),
+	}
+}
+
+func HealthCommand() cli.Command {
+	return cli.Command{
+		Name:         "health",
+		Usage:        usage.ClusterHealth,
+		Action:       events.Health,
+		Flags:        flags.AppendFlags(clusterHealthFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("health"),
+	}
+}
+
+func EnvCommand() cli.Command {
+	return cli.Command{
+		Name:         "env",
+		Usage:        usage.ClusterEnv,
+		Action:       env.Env,
+		Flags:        flags.AppendFlags(clusterEnvFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("env"),
+	}
+}
+
+func CloneCommand() cli.Command {
+	return cli.Command{
+		Name:         "clone",
+		Usage:        usage.ClusterClone,
+		Before:       ecscli.BeforeApp,
+		Action:       readonly.Guard("clone", clone.Clone, "cloudformation:CreateStack", "ecs:CreateCluster", "dynamodb:PutItem"),
+		Flags:        flags.AppendFlags(clusterCloneFlags(), flags.OptionalConfigFlags(), flags.OptionalCFNWaitMaxAttemptsFlag()),
+		OnUsageError: flags.UsageErrorFactory("clone"),
+	}
+}
+
+func ReplaceInstanceCommand() cli.Command {
+	return cli.Command{
+		Name:         "replace-instance",
+		Usage:        usage.ClusterReplaceInstance,
+		ArgsUsage:    "CONTAINER_INSTANCE [CONTAINER_INSTANCE...]",
+		Action:       readonly.Guard("replace-instance", instances.ReplaceInstance, "ecs:UpdateContainerInstancesState", "ec2:TerminateInstances"),
+		Flags:        flags.AppendFlags(clusterReplaceInstanceFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("replace-instance"),
+	}
+}
+
+func ResizeInstanceTypeCommand() cli.Command {
+	return cli.Command{
+		Name:         "resize-instance-type",
+		Usage:        usage.ClusterResizeInstanceType,
+		Action:       readonly.Guard("resize-instance-type", instances.ResizeInstanceType, "cloudformation:ExecuteChangeSet", "ecs:UpdateContainerInstancesState", "ec2:TerminateInstances", "dynamodb:PutItem"),
+		Flags:        flags.AppendFlags(clusterResizeInstanceTypeFlags(), flags.OptionalConfigFlags(), flags.OptionalCFNWaitMaxAttemptsFlag()),
+		OnUsageError: flags.UsageErrorFactory("resize-instance-type"),
+	}
+}
+
 func clusterUpFlags() []cli.Flag {
 	return []cli.Flag{
 		cli.BoolFlag{
 			Name:  flags.CapabilityIAMFlag,
 			Usage: "Acknowledges that this command may create IAM resources. Required if --instance-role is not specified. NOTE: Not applicable for launch type FARGATE or when creating an empty cluster.",
 		},
+		cli.StringFlag{
+			Name:  flags.CapabilitiesFlag,
+			Usage: "[Optional] Specifies additional comma-separated capabilities to acknowledge when creating the CloudFormation stack. Valid values are CAPABILITY_IAM, CAPABILITY_NAMED_IAM and CAPABILITY_AUTO_EXPAND.",
+		},
 		cli.BoolFlag{
 			Name:  flags.EmptyFlag + ",e",
 			Usage: "[Optional] Specifies that an ECS cluster will be created with no resources.",
 		},
+		cli.BoolFlag{
+			Name:  flags.CreateServiceLinkedRoleFlag,
+			Usage: "[Optional] Creates the ECS service-linked role AWSServiceRoleForECS without prompting, if it does not exist in your account yet.",
+		},
 		cli.StringFlag{
 			Name:  flags.InstanceRoleFlag,
 			Usage: "[Optional] Specifies a custom IAM Role for instances in your cluster. A new instance profile will be created and attached to this role. Required if --capability-iam is not specified. NOTE: Not applicable for launch type FARGATE.",
@@ -81,6 +247,10 @@ func clusterUpFlags() []cli.Flag {
 			Name:  flags.KeypairNameFlag,
 			Usage: "[Optional] Specifies the name of an existing Amazon EC2 key pair to enable SSH access to the EC2 instances in your cluster. Recommended for EC2 launch type. NOTE: Not applicable for launch type FARGATE.",
 		},
+		cli.BoolFlag{
+			Name:  flags.UseInstanceConnectFlag,
+			Usage: "[Optional] Enables SSH access to the EC2 instances in your cluster with one-time keys pushed by EC2 Instance Connect, with 'ecs-cli ssh --" + flags.UseInstanceConnectFlag + "', instead of a key pair. Installs EC2 Instance Connect on instances whose AMI does not include it. NOTE: Not applicable for launch type FARGATE.",
+		},
 		cli.StringFlag{
 			Name:  flags.InstanceTypeFlag,
 			Usage: "[Optional] Specifies the EC2 instance type for your container instances. If you specify the A1 instance family, the ECS optimized arm64 AMI will be used, otherwise the x86 AMI will be used. Defaults to t2.micro. NOTE: Not applicable for launch type FARGATE.",
@@ -89,6 +259,30 @@ func clusterUpFlags() []cli.Flag {
 			Name:  flags.SpotPriceFlag,
 			Usage: "[Optional] If filled and greater than 0, EC2 Spot instances will be requested.",
 		},
+		cli.StringFlag{
+			Name:  flags.CapacityReservationIdFlag,
+			Usage: "[Optional] Specifies the ID of an existing EC2 capacity reservation to launch your container instances into. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.BoolFlag{
+			Name:  flags.CreateCapacityReservationFlag,
+			Usage: "[Optional] Creates an EC2 capacity reservation for the number of instances specified by --size and launches your container instances into it. The container instances are launched in the first subnet only. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.StringFlag{
+			Name:  flags.PlacementGroupStrategyFlag,
+			Usage: "[Optional] Creates a placement group with the specified strategy (cluster or spread) and launches your container instances into it. With the cluster strategy the container instances are launched in the first subnet only. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.StringFlag{
+			Name:  flags.TenancyFlag,
+			Usage: "[Optional] Specifies the tenancy of your container instances: dedicated or host. Defaults to shared hardware. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.StringFlag{
+			Name:  flags.HostResourceGroupArnFlag,
+			Usage: "[Optional] Specifies the ARN of a host resource group in which to launch your container instances. Requires --tenancy host. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.StringFlag{
+			Name:  flags.LicenseConfigurationArnFlag,
+			Usage: "[Optional] Specifies the ARN of a License Manager license configuration to associate with your container instances, e.g. for Windows BYOL. NOTE: Not applicable for launch type FARGATE.",
+		},
 		cli.StringFlag{
 			Name:  flags.ImageIdFlag,
 			Usage: "[Optional] Specify the AMI ID for your container instances. Defaults to amazon-ecs-optimized AMI. NOTE: Not applicable for launch type FARGATE.",
@@ -125,6 +319,14 @@ func clusterUpFlags() []cli.Flag {
 			Name:  flags.VpcIdFlag,
 			Usage: "[Optional] Specifies the ID of an existing VPC in which to launch your container instances. If you specify a VPC ID, you must specify a list of existing subnets in that VPC with the --subnets option. If you do not specify a VPC ID, a new VPC is created with two subnets.",
 		},
+		cli.BoolFlag{
+			Name:  flags.UseDefaultVpcFlag,
+			Usage: "[Optional] Launches your container instances in the default VPC of the region, using its default subnets, instead of creating a new VPC. Cannot be used with the --vpc, --subnets or --azs options.",
+		},
+		cli.StringFlag{
+			Name:  flags.ShareVpcFromStackFlag,
+			Usage: "[Optional] Specifies the name of the CloudFormation stack of another cluster created by the ECS CLI whose VPC, subnets and security group should be used by this cluster, instead of creating a new VPC. Cannot be used with the --vpc, --subnets, --azs, --security-group or --use-default-vpc options.",
+		},
 		cli.StringSliceFlag{
 			Name:  flags.UserDataFlag,
 			Usage: "[Optional] Specifies additional User Data for your EC2 instances. Files can be shell scripts or cloud-init directives and are packaged into a MIME Multipart Archive along with ECS CLI provided User Data which directs instances to join your cluster.",
@@ -142,6 +344,70 @@ func clusterUpFlags() []cli.Flag {
 			Name:  flags.IMDSv2Flag,
 			Usage: "[Optional] Disable IMDSv1 on an EC2 instance launch.",
 		},
+		cli.StringFlag{
+			Name:  flags.MetadataHopLimitFlag,
+			Usage: "[Optional] Specifies the maximum number of network hops (1-64) for instance metadata PUT responses. Containers using bridge networking need a hop limit of at least 2 to use IMDSv2. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.BoolFlag{
+			Name:  flags.InstanceMetadataTagsFlag,
+			Usage: "[Optional] Makes the tags of your container instances available in the instance metadata. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.BoolFlag{
+			Name:  flags.EnableDetailedMonitoringFlag,
+			Usage: "[Optional] Enables detailed (1-minute) CloudWatch monitoring of your container instances. Additional charges apply. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.BoolFlag{
+			Name:  flags.CaptureTaskEventsFlag,
+			Usage: "[Optional] Creates an EventBridge rule capturing the events of the tasks of your cluster which stopped, with their stop reasons, into a CloudWatch Logs log group kept for 90 days. Use 'ecs-cli events history' to query it.",
+		},
+		cli.BoolFlag{
+			Name:  flags.CapacityProviderFlag,
+			Usage: "[Optional] Creates an ECS capacity provider for the Auto Scaling group of your container instances and makes it the default capacity provider of your cluster, so that services can use capacity provider strategies. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ManagedScalingFlag,
+			Usage: "[Optional] Lets ECS scale the Auto Scaling group of the capacity provider to fit the tasks of your cluster, up to --size instances. Used with --capacity-provider.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ManagedTerminationFlag,
+			Usage: "[Optional] Protects the container instances running tasks from being terminated when ECS scales the Auto Scaling group in. Requires --managed-scaling.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ManagedDrainingFlag,
+			Usage: "[Optional] Lets ECS drain the container instances of the capacity provider before the Auto Scaling group terminates them, so that their tasks are replaced elsewhere first. Use --managed-draining=false to disable it. Defaults to the ECS default. Used with --capacity-provider.",
+		},
+		cli.IntFlag{
+			Name:  flags.InstanceWarmupPeriodFlag,
+			Usage: "[Optional] Specifies the number of seconds a new container instance takes before it contributes to the metrics of managed scaling, from 0 to 10000. Defaults to the ECS default. Requires --managed-scaling.",
+		},
+		cli.StringFlag{
+			Name:  flags.ServiceConnectNamespaceFlag,
+			Usage: "[Optional] Specifies the name or ARN of the Cloud Map namespace the services of your cluster use for Service Connect by default. A namespace name which does not exist is created as an HTTP namespace.",
+		},
+		cli.StringFlag{
+			Name:  flags.ScheduledScalingFlag,
+			Usage: "[Optional] Specifies a semicolon-separated list of recurring changes of the number of instances in your cluster, in the format 'cron(0 8 * * MON-FRI)=5;cron(0 20 * * *)=0'. Schedules are in UTC and each number of instances cannot exceed --size. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.StringFlag{
+			Name:  flags.InstanceAttributesFlag,
+			Usage: "[Optional] Specifies a comma-separated list of custom attributes to register your container instances with, in the format 'name1=value1,name2=value2'. They can be used in placement constraints such as 'memberOf(attribute:name1 == value1)'. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.BoolFlag{
+			Name:  flags.GPUAttributesFlag,
+			Usage: "[Optional] Configures your GPU container instances to share their GPUs with the containers which do not reserve one, with the NVIDIA runtime as default Docker runtime, and registers them with the gpu.count and gpu.type attributes of their instance type, e.g. 'memberOf(attribute:gpu.type == t4)'. Requires a GPU instance type and the GPU variant of the ECS optimized AMI. NOTE: Not applicable for launch type FARGATE.",
+		},
+		cli.BoolFlag{
+			Name:  flags.DryRunFlag,
+			Usage: "[Optional] Validates and prints the CloudFormation template for your cluster resources, without creating the cluster or the stack.",
+		},
+		cli.StringFlag{
+			Name:  flags.TemplateFormatFlag,
+			Usage: "[Optional] Specifies the format of the template printed with --dry-run: json or yaml. Defaults to json.",
+		},
+		cli.StringFlag{
+			Name:  flags.ExtraTemplateFileFlag,
+			Usage: "[Optional] Specifies a CloudFormation template (JSON or YAML) whose resources are added to your cluster stack, and created and deleted with it. Its parameters can reference the outputs of the cluster stack by key: VpcId, SubnetIds, SecurityGroupId, AsgName and InstanceRoleArn.",
+		},
 	}
 }
 
@@ -151,6 +417,15 @@ func clusterDownFlags() []cli.Flag {
 			Name:  flags.ForceFlag + ", f",
 			Usage: "[Optional] Acknowledges that this command permanently deletes resources.",
 		},
+		cli.BoolFlag{
+			Name:  flags.DeleteNetworkInterfacesFlag,
+			Usage: "[Optional] Deletes the network interfaces left in your cluster resources, such as those of stopped awsvpc tasks, when they block the deletion of the CloudFormation stack, and retries the deletion.",
+		},
+		cli.Float64Flag{
+			Name:  flags.DrainTimeoutFlag,
+			Value: 10,
+			Usage: "[Optional] Specifies the timeout value in minutes (decimals supported) to wait for the tasks of the cluster to stop and release their network interfaces before deleting the CloudFormation stack. Setting the timeout to 0 deletes the stack without waiting.",
+		},
 	}
 }
 
@@ -164,5 +439,187 @@ func clusterScaleFlags() []cli.Flag {
 			Name:  flags.AsgMaxSizeFlag,
 			Usage: "Specifies the number of instances to maintain in your cluster.",
 		},
+		cli.StringFlag{
+			Name:  flags.LaunchTemplateVersionFlag,
+			Usage: "[Optional] Specifies the version number of the launch template of your container instances to launch new instances from, or 'latest' to launch them from its latest version again. Instances already running are not replaced.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ManagedDrainingFlag,
+			Usage: "[Optional] Enables the managed draining of the capacity provider of your cluster, or disables it with --managed-draining=false.",
+		},
+		cli.IntFlag{
+			Name:  flags.InstanceWarmupPeriodFlag,
+			Usage: "[Optional] Specifies the number of seconds a new container instance of the capacity provider of your cluster takes before it contributes to the metrics of managed scaling, from 0 to 10000.",
+		},
+	}
+}
+
+func clusterStopFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.CapabilityIAMFlag,
+			Usage: "Acknowledges that this command may create IAM resources.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ForceFlag + ", f",
+			Usage: "[Optional] Stops the cluster without waiting for the tasks on your container instances to be drained. Tasks that are not part of a service are not stopped by draining.",
+		},
+	}
+}
+
+func clusterStartFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.CapabilityIAMFlag,
+			Usage: "Acknowledges that this command may create IAM resources.",
+		},
+	}
+}
+
+func clusterInventoryFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.InventoryTableFlag,
+			Usage: "[Optional] Specifies the DynamoDB table of the inventory. Defaults to the inventory table of the cluster configuration.",
+		},
+		cli.BoolFlag{
+			Name:  flags.IncludeDeletedFlag,
+			Usage: "[Optional] Also lists the stacks deleted with 'ecs-cli down'.",
+		},
+	}
+}
+
+func clusterAgentsFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.UpdateAgentFlag,
+			Usage: "[Optional] Starts the update of the outdated ECS agents of connected container instances. Agent updates are not supported on all AMIs, e.g. on Amazon Linux 2 the ecs-init package must be updated instead.",
+		},
+	}
+}
+
+func clusterQuotasFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.IntFlag{
+			Name:  flags.QuotaWarningThresholdFlag,
+			Value: 80,
+			Usage: "[Optional] Specifies the percentage of a quota above which a warning is logged.",
+		},
+	}
+}
+
+func clusterCostsFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.CostPeriodFlag,
+			Value: "30d",
+			Usage: "[Optional] Specifies the number of days to report the costs of, e.g. 7d.",
+		},
+	}
+}
+
+func clusterHealthFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.IntFlag{
+			Name:  flags.SinceFlag,
+			Value: 1440,
+			Usage: "[Optional] Specifies the number of minutes before now in which to scan the stopped tasks. Defaults to one day.",
+		},
+		cli.IntFlag{
+			Name:  flags.RestartThresholdFlag,
+			Value: 3,
+			Usage: "[Optional] Specifies the number of tasks of a service whose essential container exited from which the service is reported as crash looping.",
+		},
+	}
+}
+
+func clusterEnvFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.EnvFormatFlag,
+			Value: env.FormatDotenv,
+			Usage: "[Optional] Specifies the format of the variables. Options: " + env.FormatDotenv + ", with upper case environment variable names, or " + env.FormatGitHubActions + ", with lower case step output names.",
+		},
+	}
+}
+
+func clusterSSHFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.SSHUserFlag,
+			Value: "ec2-user",
+			Usage: "[Optional] Specifies the OS user to connect as.",
+		},
+		cli.StringFlag{
+			Name:  flags.IdentityFileFlag + ", i",
+			Usage: "[Optional] Specifies the private key file of the key pair of the instance. Defaults to the keys of your SSH configuration and agent.",
+		},
+		cli.BoolFlag{
+			Name:  flags.UseInstanceConnectFlag,
+			Usage: "[Optional] Connects with a one-time key pushed to the instance by EC2 Instance Connect instead of a key pair. The instance must have EC2 Instance Connect installed, e.g. by 'ecs-cli up --" + flags.UseInstanceConnectFlag + "'.",
+		},
+	}
+}
+
+func clusterCloneFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.CapabilityIAMFlag,
+			Usage: "Acknowledges that this command may create IAM resources.",
+		},
+		cli.StringFlag{
+			Name:  flags.CloneFromFlag,
+			Usage: "[Optional] Specifies the name of the cluster to clone. Defaults to the configured cluster.",
+		},
+		cli.StringFlag{
+			Name:  flags.CloneToFlag,
+			Usage: "Specifies the name of the new cluster.",
+		},
+		cli.StringFlag{
+			Name:  flags.ImageIdFlag,
+			Usage: "[Optional] Specifies the AMI ID of the container instances of the new cluster. Defaults to the AMI of the cloned cluster, or to the recommended ECS-optimized AMI if --" + flags.InstanceTypeFlag + " is specified.",
+		},
+		cli.StringFlag{
+			Name:  flags.InstanceTypeFlag,
+			Usage: "[Optional] Specifies the EC2 instance type of the container instances of the new cluster. Defaults to the instance type of the cloned cluster.",
+		},
+		cli.StringFlag{
+			Name:  flags.AsgMaxSizeFlag,
+			Usage: "[Optional] Specifies the number of instances to launch in the new cluster. Defaults to the size of the cloned cluster.",
+		},
+	}
+}
+
+func clusterResizeInstanceTypeFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.CapabilityIAMFlag,
+			Usage: "Acknowledges that this command may create IAM resources.",
+		},
+		cli.StringFlag{
+			Name:  flags.InstanceTypeFlag,
+			Usage: "Specifies the new EC2 instance type of the container instances of your cluster.",
+		},
+		cli.StringFlag{
+			Name:  flags.ImageIdFlag,
+			Usage: "[Optional] Specifies the AMI ID of the new container instances. Defaults to the recommended ECS-optimized AMI for the new instance type, whose architecture may differ from the current one.",
+		},
+		cli.BoolFlag{
+			Name:  flags.DryRunFlag,
+			Usage: "[Optional] Prints the changes to your cluster resources and the number of container instances to replace, without applying them.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ForceFlag + ", f",
+			Usage: "[Optional] Applies the changes without prompting for confirmation.",
+		},
+	}
+}
+
+func clusterReplaceInstanceFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.ForceFlag + ", f",
+			Usage: "[Optional] Terminates each container instance without waiting for its tasks to be drained. Tasks that are not part of a service are not stopped by draining.",
+		},
 	}
 }
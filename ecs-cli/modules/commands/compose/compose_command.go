@@ -18,9 +18,11 @@ import (
 	ecscli "github.com/aws/amazon-ecs-cli/ecs-cli/modules"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose"
 	composeFactory "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/importer"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/compose/service"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
 	"github.com/urfave/cli"
 )
 
@@ -68,6 +70,7 @@ func ComposeCommand(factory composeFactory.ProjectFactory) cli.Command {
 			startCommand(factory),
 			stopCommand(factory),
 			upCommand(factory),
+			importCommand(),
 			// ----- Unsupported/Unimplemented COMMANDS -----
 			// build, pull, logs, port, restart, rm, kill
 
@@ -104,6 +107,12 @@ func composeFlags() []cli.Flag {
 			Name:  flags.RegistryCredsFileNameFlag,
 			Usage: "[Optional] Specifies the ecs-registry-creds file to use. Defaults to latest 'ecs-registry-creds' output file, if one exists.",
 		},
+		cli.StringSliceFlag{
+			Name:   flags.ComposeProfileFlag,
+			Usage:  "[Optional] Specifies a compose profile to activate. Services assigned to profiles with the 'profiles' key are only included when one of their profiles is active. Can be used multiple times.",
+			Value:  &cli.StringSlice{},
+			EnvVar: flags.ComposeProfilesEnvVar,
+		},
 	}
 }
 
@@ -111,8 +120,8 @@ func createCommand(factory composeFactory.ProjectFactory) cli.Command {
 	return cli.Command{
 		Name:         "create",
 		Usage:        usage.ComposeCreate,
-		Action:       compose.WithProject(factory, compose.ProjectCreate, false),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), resourceTagsFlag(false)),
+		Action:       readonly.Guard("compose create", compose.WithProject(factory, compose.ProjectCreate, false), "ecs:RegisterTaskDefinition", "logs:CreateLogGroup"),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), resourceTagsFlag(false), flags.OptionalSkipImageValidationFlag(), flags.OptionalGitTagsFlags()),
 		OnUsageError: flags.UsageErrorFactory("create"),
 	}
 }
@@ -123,7 +132,7 @@ func psCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Aliases:      []string{"list"},
 		Usage:        usage.ComposePs,
 		Action:       compose.WithProject(factory, compose.ProjectPs, false),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalDesiredStatusFlag()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalDesiredStatusFlag(), flags.OptionalPaginationFlags()),
 		OnUsageError: flags.UsageErrorFactory("ps"),
 	}
 }
@@ -132,8 +141,8 @@ func upCommand(factory composeFactory.ProjectFactory) cli.Command {
 	return cli.Command{
 		Name:         "up",
 		Usage:        usage.ComposeUp,
-		Action:       compose.WithProject(factory, compose.ProjectUp, false),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), flags.OptionalForceUpdateFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag()),
+		Action:       readonly.Guard("compose up", compose.WithProject(factory, compose.ProjectUp, false), "ecs:RegisterTaskDefinition", "ecs:RunTask", "ecs:StopTask", "logs:CreateLogGroup"),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalAssignPublicIPFlag(), flags.OptionalCreateLogsFlag(), flags.OptionalForceUpdateFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag(), flags.OptionalDryRunFlag(), flags.OptionalSkipImageValidationFlag(), flags.OptionalGitTagsFlags(), flags.OptionalServicesFlag(), flags.OptionalBuildFlag()),
 		OnUsageError: flags.UsageErrorFactory("up"),
 	}
 }
@@ -142,8 +151,8 @@ func startCommand(factory composeFactory.ProjectFactory) cli.Command {
 	return cli.Command{
 		Name:         "start",
 		Usage:        usage.ComposeStart,
-		Action:       compose.WithProject(factory, compose.ProjectStart, false),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag()),
+		Action:       readonly.Guard("compose start", compose.WithProject(factory, compose.ProjectStart, false), "ecs:RegisterTaskDefinition", "ecs:RunTask", "logs:CreateLogGroup"),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag(), flags.OptionalSkipImageValidationFlag(), flags.OptionalGitTagsFlags()),
 		OnUsageError: flags.UsageErrorFactory("start"),
 	}
 }
@@ -153,8 +162,8 @@ func runCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "run",
 		Usage:        usage.ComposeRun,
 		ArgsUsage:    "[CONTAINER_NAME] [\"COMMAND ...\"] [CONTAINER_NAME] [\"COMMAND ...\"] ...",
-		Action:       compose.WithProject(factory, compose.ProjectRun, false),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), resourceTagsFlag(true), disableECSManagedTagsFlag()),
+		Action:       readonly.Guard("compose run", compose.WithProject(factory, compose.ProjectRun, false), "ecs:RegisterTaskDefinition", "ecs:RunTask"),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), resourceTagsFlag(true), disableECSManagedTagsFlag(), flags.OptionalSkipImageValidationFlag(), flags.OptionalGitTagsFlags()),
 		OnUsageError: flags.UsageErrorFactory("run"),
 	}
 }
@@ -164,7 +173,7 @@ func stopCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "stop",
 		Aliases:      []string{"down"},
 		Usage:        usage.ComposeStop,
-		Action:       compose.WithProject(factory, compose.ProjectStop, false),
+		Action:       readonly.Guard("compose stop", compose.WithProject(factory, compose.ProjectStop, false), "ecs:StopTask"),
 		Flags:        flags.OptionalConfigFlags(),
 		OnUsageError: flags.UsageErrorFactory("stop"),
 	}
@@ -174,12 +183,35 @@ func scaleCommand(factory composeFactory.ProjectFactory) cli.Command {
 	return cli.Command{
 		Name:         "scale",
 		Usage:        usage.ComposeScale,
-		Action:       compose.WithProject(factory, compose.ProjectScale, false),
+		Action:       readonly.Guard("compose scale", compose.WithProject(factory, compose.ProjectScale, false), "ecs:RegisterTaskDefinition", "ecs:RunTask", "ecs:StopTask"),
 		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag()),
 		OnUsageError: flags.UsageErrorFactory("scale"),
 	}
 }
 
+func importCommand() cli.Command {
+	return cli.Command{
+		Name:         "import",
+		Usage:        usage.ComposeImport,
+		Action:       importer.Import,
+		Flags:        flags.AppendFlags(importFlags(), flags.OptionalConfigFlags()),
+		OnUsageError: flags.UsageErrorFactory("import"),
+	}
+}
+
+func importFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.ImportServiceFlag,
+			Usage: "Specifies the name of the ECS service to import.",
+		},
+		cli.BoolFlag{
+			Name:  flags.ForceFlag,
+			Usage: "[Optional] Overwrites the Compose file and the ECS params file if they already exist.",
+		},
+	}
+}
+
 func resourceTagsFlag(runTasks bool) []cli.Flag {
 	usage := "[Optional] Specify resource tags for your Task Definition. Specify tags in the format 'key1=value1,key2=value2,key3=value3'."
 	if runTasks {
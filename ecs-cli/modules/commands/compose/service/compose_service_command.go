@@ -17,11 +17,13 @@ package serviceCommand
 import (
 	"fmt"
 
+	ecscli "github.com/aws/amazon-ecs-cli/ecs-cli/modules"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/service"
 	composeFactory "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
 	"github.com/urfave/cli"
 )
 
@@ -37,8 +39,13 @@ import (
 //   ecs-cli compose service up          : compose service create ; compose service start. If the compose yml was changed, it updates the service with new task definition
 // List containers in or view details of the project:
 //   ecs-cli compose service ps          : calls ECS.ListTasks of this service
+//   ecs-cli compose service history     : calls ECS.DescribeTaskDefinition for the last revisions of the service
+//   ecs-cli compose service diff        : compares the running task definition with the compose file or a revision
+//   ecs-cli compose service verify      : sends HTTP requests to the load balancer or the tasks of the service
+//   ecs-cli compose service rebalance   : reports the spread of the tasks of the service across zones and instances
 // Modify containers
 //   ecs-cli compose service scale       : calls ECS.UpdateService with new count
+//   ecs-cli compose service restart     : calls ECS.UpdateService with forceNewDeployment=true
 // Stop and delete the project
 //   ecs-cli compose service stop        : calls ECS.UpdateService with count=0
 //   ecs-cli compose service down        : calls ECS.DeleteService
@@ -56,6 +63,11 @@ func ServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 			upServiceCommand(factory),
 			psServiceCommand(factory),
 			scaleServiceCommand(factory),
+			restartServiceCommand(factory),
+			historyServiceCommand(factory),
+			diffServiceCommand(factory),
+			verifyServiceCommand(factory),
+			rebalanceServiceCommand(factory),
 			stopServiceCommand(factory),
 			rmServiceCommand(factory),
 		},
@@ -67,8 +79,8 @@ func createServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 	return cli.Command{
 		Name:         "create",
 		Usage:        usage.ServiceCreate,
-		Action:       compose.WithProject(factory, compose.ProjectCreate, true),
-		Flags:        flags.AppendFlags(deploymentConfigFlags(true), loadBalancerFlags(), flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), serviceDiscoveryFlags(), flags.OptionalSchedulingStrategyFlag(), taggingFlags()),
+		Action:       readonly.Guard("compose service create", compose.WithProject(factory, compose.ProjectCreate, true), "ecs:RegisterTaskDefinition", "ecs:CreateService", "logs:CreateLogGroup", "servicediscovery:CreateService"),
+		Flags:        flags.AppendFlags(deploymentConfigFlags(true), loadBalancerFlags(), flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), serviceDiscoveryFlags(), flags.OptionalSchedulingStrategyFlag(), taggingFlags(), flags.OptionalSkipImageValidationFlag(), flags.OptionalGitTagsFlags()),
 		OnUsageError: flags.UsageErrorFactory("create"),
 	}
 }
@@ -77,8 +89,8 @@ func startServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 	return cli.Command{
 		Name:         "start",
 		Usage:        usage.ServiceStart,
-		Action:       compose.WithProject(factory, compose.ProjectStart, true),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), flags.OptionalCreateLogsFlag(), ForceNewDeploymentFlag()),
+		Action:       readonly.Guard("compose service start", compose.WithProject(factory, compose.ProjectStart, true), "ecs:UpdateService"),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), flags.OptionalCreateLogsFlag(), ForceNewDeploymentFlag(), flags.OptionalSkipImageValidationFlag(), flags.OptionalGitTagsFlags()),
 		OnUsageError: flags.UsageErrorFactory("start"),
 	}
 }
@@ -87,8 +99,9 @@ func upServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 	return cli.Command{
 		Name:         "up",
 		Usage:        usage.ServiceUp,
-		Action:       compose.WithProject(factory, compose.ProjectUp, true),
-		Flags:        flags.AppendFlags(deploymentConfigFlags(true), loadBalancerFlags(), flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), ForceNewDeploymentFlag(), serviceDiscoveryFlags(), updateServiceDiscoveryFlags(), flags.OptionalSchedulingStrategyFlag(), taggingFlags()),
+		Before:       ecscli.BeforeApp,
+		Action:       readonly.Guard("compose service up", compose.ServiceUp(factory), "ecs:RegisterTaskDefinition", "ecs:CreateService", "ecs:UpdateService", "logs:CreateLogGroup", "servicediscovery:CreateService", "ecs:RunTask", "lambda:InvokeFunction", "dynamodb:PutItem", "application-autoscaling:RegisterScalableTarget", "sns:Publish", "cloudwatch:PutMetricData"),
+		Flags:        flags.AppendFlags(deploymentConfigFlags(true), loadBalancerFlags(), flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), flags.OptionalLaunchTypeFlag(), flags.OptionalAssignPublicIPFlag(), flags.OptionalCreateLogsFlag(), ForceNewDeploymentFlag(), preserveDesiredCountFlag(), suspendAutoScalingFlag(), scaleFlag(), debugOnFailureFlag(), deployLockFlags(), clustersFlags(), serviceDiscoveryFlags(), updateServiceDiscoveryFlags(), flags.OptionalSchedulingStrategyFlag(), taggingFlags(), dnsRecordFlags(), flags.OptionalDryRunFlag(), flags.OptionalProgressFlag(), flags.OptionalSkipImageValidationFlag(), flags.OptionalGitTagsFlags(), flags.OptionalServicesFlag(), flags.OptionalBuildFlag()),
 		OnUsageError: flags.UsageErrorFactory("up"),
 	}
 }
@@ -99,7 +112,7 @@ func psServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Aliases:      []string{"list"},
 		Usage:        usage.ServicePs,
 		Action:       compose.WithProject(factory, compose.ProjectPs, true),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalDesiredStatusFlag()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalDesiredStatusFlag(), flags.OptionalPaginationFlags()),
 		OnUsageError: flags.UsageErrorFactory("ps"),
 	}
 }
@@ -108,17 +121,67 @@ func scaleServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 	return cli.Command{
 		Name:         "scale",
 		Usage:        usage.ServiceScale,
-		Action:       compose.WithProject(factory, compose.ProjectScale, true),
-		Flags:        flags.AppendFlags(deploymentConfigFlags(false), flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag()),
+		Action:       readonly.Guard("compose service scale", compose.WithProject(factory, compose.ProjectScale, true), "ecs:UpdateService"),
+		Flags:        flags.AppendFlags(deploymentConfigFlags(false), flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), scaleBatchFlags()),
 		OnUsageError: flags.UsageErrorFactory("scale"),
 	}
 }
 
+func restartServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
+	return cli.Command{
+		Name:         "restart",
+		Usage:        usage.ServiceRestart,
+		Action:       readonly.Guard("compose service restart", compose.WithProject(factory, compose.ProjectRestart, true), "ecs:UpdateService"),
+		Flags:        flags.AppendFlags(deploymentConfigFlags(false), flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag()),
+		OnUsageError: flags.UsageErrorFactory("restart"),
+	}
+}
+
+func historyServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
+	return cli.Command{
+		Name:         "history",
+		Usage:        usage.ServiceHistory,
+		Action:       compose.WithProject(factory, compose.ProjectHistory, true),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), historyLimitFlag()),
+		OnUsageError: flags.UsageErrorFactory("history"),
+	}
+}
+
+func diffServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
+	return cli.Command{
+		Name:         "diff",
+		Usage:        usage.ServiceDiff,
+		Action:       compose.WithProject(factory, compose.ProjectDiff, true),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), diffRevisionFlag()),
+		OnUsageError: flags.UsageErrorFactory("diff"),
+	}
+}
+
+func verifyServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
+	return cli.Command{
+		Name:         "verify",
+		Usage:        usage.ServiceVerify,
+		Action:       compose.WithProject(factory, compose.ProjectVerify, true),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), verifyFlags()),
+		OnUsageError: flags.UsageErrorFactory("verify"),
+	}
+}
+
+func rebalanceServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
+	return cli.Command{
+		Name:         "rebalance",
+		Usage:        usage.ServiceRebalance,
+		Action:       compose.WithProject(factory, compose.ProjectRebalance, true),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), rebalanceFixFlag()),
+		OnUsageError: flags.UsageErrorFactory("rebalance"),
+	}
+}
+
 func stopServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 	return cli.Command{
 		Name:         "stop",
 		Usage:        usage.ServiceStop,
-		Action:       compose.WithProject(factory, compose.ProjectStop, true),
+		Action:       readonly.Guard("compose service stop", compose.WithProject(factory, compose.ProjectStop, true), "ecs:UpdateService"),
 		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag()),
 		OnUsageError: flags.UsageErrorFactory("stop"),
 	}
@@ -129,8 +192,8 @@ func rmServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "rm",
 		Aliases:      []string{"delete", "down"},
 		Usage:        usage.ServiceRm,
-		Action:       compose.WithProject(factory, compose.ProjectDown, true),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), deleteServiceDiscoveryFlags()),
+		Action:       readonly.Guard("compose service rm", compose.WithNamedProject(factory, compose.ProjectDown, true), "ecs:UpdateService", "ecs:DeleteService", "logs:DeleteLogGroup", "servicediscovery:DeleteService"),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), deleteServiceDiscoveryFlags(), deleteLogsFlags(), dnsRecordFlags()),
 		OnUsageError: flags.UsageErrorFactory("rm"),
 	}
 }
@@ -202,6 +265,93 @@ func deleteServiceDiscoveryFlags() []cli.Flag {
 	}
 }
 
+func deleteLogsFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.DeleteLogsFlag,
+			Usage: "[Optional] Deletes the CloudWatch log groups that were created for the project with --" + flags.CreateLogsFlag,
+		},
+	}
+}
+
+func scaleBatchFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.IntFlag{
+			Name:  flags.BatchSizeFlag,
+			Usage: fmt.Sprintf("[Optional] Specifies the maximum number of tasks to add or remove at a time. The desired count of the service is changed in batches of this size. Requires --%s.", flags.WaitFlag),
+		},
+		cli.BoolFlag{
+			Name:  flags.WaitFlag,
+			Usage: fmt.Sprintf("[Optional] Waits for the service to reach a steady state after each batch before starting the next one. Cannot be used with a --%s of 0.", flags.ComposeServiceTimeOutFlag),
+		},
+		cli.IntFlag{
+			Name:  flags.DrainDelayFlag,
+			Usage: fmt.Sprintf("[Optional] Specifies the number of seconds to wait between scale-down batches, e.g. to let connections to stopped tasks drain. Requires --%s.", flags.BatchSizeFlag),
+		},
+	}
+}
+
+func historyLimitFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.IntFlag{
+			Name:  flags.HistoryLimitFlag,
+			Value: 10,
+			Usage: "[Optional] Specifies the number of task definition revisions to list.",
+		},
+	}
+}
+
+func diffRevisionFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.DiffRevisionFlag,
+			Usage: "[Optional] Specifies the task definition to compare the running one with, as a revision number of the project's family, a family:revision or an ARN. Defaults to the task definition converted from your compose file.",
+		},
+	}
+}
+
+func verifyFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.URLPathFlag,
+			Value: "/",
+			Usage: "[Optional] Specifies the path requested from the service, e.g. /healthz.",
+		},
+		cli.IntFlag{
+			Name:  flags.ExpectStatusFlag,
+			Value: 200,
+			Usage: "[Optional] Specifies the HTTP status code the service must respond with.",
+		},
+		cli.StringFlag{
+			Name:  flags.ComposeServiceTimeOutFlag,
+			Value: fmt.Sprintf("%dm", service.DefaultUpdateServiceTimeout),
+			Usage: "[Optional] Specifies how long to wait for the service to respond with the expected status, as a duration like 3m or 90s, or a number of minutes. The checks are retried every 10 seconds until then.",
+		},
+	}
+}
+
+func rebalanceFixFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.FixFlag,
+			Usage: "[Optional] Stops the tasks over-concentrated in an availability zone or on a container instance one at a time, waiting for the service to replace each of them before stopping the next one.",
+		},
+	}
+}
+
+func dnsRecordFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.DNSNameFlag,
+			Usage: fmt.Sprintf("[Optional] Specifies a DNS name, e.g. app.example.com, for an alias record pointing at the Application or Network Load Balancer of your service. The record is created or updated after the service is deployed and deleted when the service is removed. Requires --%s.", flags.HostedZoneIDFlag),
+		},
+		cli.StringFlag{
+			Name:  flags.HostedZoneIDFlag,
+			Usage: fmt.Sprintf("[Optional] Specifies the ID of the Route 53 hosted zone in which to manage the --%s alias record.", flags.DNSNameFlag),
+		},
+	}
+}
+
 func deploymentConfigFlags(specifyDefaults bool) []cli.Flag {
 	maxPercentUsageString := "[Optional] Specifies the upper limit (as a percentage of the service's desiredCount) of the number of running tasks that can be running in a service during a deployment."
 	minHealthyPercentUsageString := "[Optional] Specifies the lower limit (as a percentage of the service's desiredCount) of the number of running tasks that must remain running and healthy in a service during a deployment."
@@ -228,7 +378,8 @@ func loadBalancerFlags() []cli.Flag {
 	loadBalancerNameUsageString := fmt.Sprintf("[Deprecated] Specifies the name of a previously configured Classic Elastic Load Balancing load balancer to associate with your service. NOTE: For Application Load Balancers or Network Load Balancers, use the --%s flag.", flags.TargetGroupArnFlag)
 	targetGroupsUsageString := fmt.Sprintf("[Optional] Specifies multiple target groups to register with a service. Can't be used with --%s flag or --%s at the same time. To specify multiple target groups, add multiple seperate --%s flags Example: ecs-cli compose service create --target-groups targetGroupArn=arn,containerName=nginx,containerPort=80 --target-groups targetGroupArn=arn,containerName=database,containerPort=3306", flags.LoadBalancerNameFlag, flags.TargetGroupArnFlag, flags.TargetGroupsFlag)
 	roleUsageString := fmt.Sprintf("[Optional] Specifies the name or full Amazon Resource Name (ARN) of the IAM role that allows Amazon ECS to make calls to your load balancer or target group on your behalf. This parameter requires either --%s or --%s to be specified.", flags.LoadBalancerNameFlag, flags.TargetGroupArnFlag)
-	healthCheckGracePeriodString := "[Optional] Specifies the period of time, in seconds, that the Amazon ECS service scheduler should ignore unhealthy Elastic Load Balancing target health checks after a task has first started."
+	healthCheckGracePeriodString := "[Optional] Specifies the period of time, in seconds, that the Amazon ECS service scheduler should ignore unhealthy Elastic Load Balancing target health checks after a task has first started. Can also be set as health_check_grace_period in the ECS Params file."
+	deregistrationDelayString := "[Optional] Specifies the amount of time, in seconds, that Elastic Load Balancing waits before deregistering a task from the service's target groups. Applies to Application and Network Load Balancers. Can also be set as deregistration_delay in the ECS Params file."
 
 	return []cli.Flag{
 		cli.StringFlag{
@@ -255,6 +406,10 @@ func loadBalancerFlags() []cli.Flag {
 			Name:  flags.HealthCheckGracePeriodFlag,
 			Usage: healthCheckGracePeriodString,
 		},
+		cli.StringFlag{
+			Name:  flags.DeregistrationDelayFlag,
+			Usage: deregistrationDelayString,
+		},
 		cli.StringSliceFlag{
 			Name:  flags.TargetGroupsFlag,
 			Usage: targetGroupsUsageString,
@@ -285,6 +440,77 @@ func ForceNewDeploymentFlag() []cli.Flag {
 	}
 }
 
+func debugOnFailureFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.DebugOnFailureFlag,
+			Usage: "[Optional] If the deploy fails, runs one task of the new task definition with the entry points of its containers replaced by sleep and ECS Exec enabled, and opens a shell in it with the AWS CLI, or prints the command to open one. The task role needs the permissions required by ECS Exec.",
+		},
+	}
+}
+
+func deployLockFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.LockFlag,
+			Usage: fmt.Sprintf("[Optional] Holds a lock on the service in a DynamoDB table while it is deployed, so that concurrent runs of this command for the same service, e.g. on different CI runners, deploy one after the other. Requires a --%s, which can also be configured for the cluster with 'ecs-cli configure'.", flags.DeployLockTableFlag),
+		},
+		cli.StringFlag{
+			Name:  flags.DeployLockTableFlag,
+			Usage: fmt.Sprintf("[Optional] Specifies the DynamoDB table, with a string partition key named LockID, holding the locks taken with --%s. Overrides the table configured for the cluster.", flags.LockFlag),
+		},
+		cli.IntFlag{
+			Name:  flags.LockTimeoutFlag,
+			Value: 600,
+			Usage: fmt.Sprintf("[Optional] Specifies the maximum number of seconds to wait for another deploy of the service to release its lock. Used with --%s.", flags.LockFlag),
+		},
+	}
+}
+
+func clustersFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.ClustersFlag,
+			Usage: fmt.Sprintf("[Optional] Deploys the project to each of the comma separated cluster configurations in turn, e.g. staging-eu,staging-us, and prints the result of each deploy. Cannot be combined with --%s, --%s or --%s.", flags.ClusterConfigFlag, flags.ClusterFlag, flags.RegionFlag),
+		},
+		cli.StringFlag{
+			Name:  flags.ClusterOverridesFlag,
+			Usage: fmt.Sprintf("[Optional] Specifies a YAML file mapping the cluster configurations of --%s to the image_tag and the environment variables to set on the containers of the compose file when deploying to them.", flags.ClustersFlag),
+		},
+		cli.BoolFlag{
+			Name:  flags.BestEffortFlag,
+			Usage: fmt.Sprintf("[Optional] Deploys to every cluster of --%s even if a deploy fails. By default, the first failure stops the deploys and rolls back the services already deployed to their previous task definition.", flags.ClustersFlag),
+		},
+	}
+}
+
+func preserveDesiredCountFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolTFlag{
+			Name:  flags.PreserveDesiredCountFlag,
+			Usage: "[Optional] Leaves the desired count of an existing service unchanged, so that deploys don't undo manual scaling or Application Auto Scaling. A stopped service is still started with its configured desired count, or 1, unless Application Auto Scaling manages it. Defaults to true; use --preserve-desired-count=false to set the desired count configured with desired_count in the ecs-params file, or else the desired count the service had when the deploy started.",
+		},
+	}
+}
+
+func suspendAutoScalingFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.SuspendAutoScalingFlag,
+			Usage: "[Optional] Suspends the scale-in of the Application Auto Scaling target of an existing service while it is deployed, and resumes it once the deploy and its post-deploy hooks are done, whether they succeed or not. Scale-out keeps running. Scale-in which is already suspended is left suspended.",
+		},
+	}
+}
+
+func scaleFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.ScaleFlag,
+			Usage: "[Optional] Sets the desired count of the service, either for all compose services (e.g. 3) or per compose service (e.g. web=4,worker=2). Overrides desired_count in the ecs-params file and --preserve-desired-count. The compose services deployed together must have the same desired count, since they run in the tasks of a single ECS service.",
+		},
+	}
+}
+
 func taggingFlags() []cli.Flag {
 	return []cli.Flag{
 		cli.BoolFlag{
@@ -47,11 +47,53 @@ func ConfigureCommand() cli.Command {
 			configureProfileCommand(),
 			defaultClusterCommand(),
 			migrateCommand(),
+			exportCommand(),
+			importCommand(),
 		},
 		OnUsageError: flags.UsageErrorFactory("configure"),
 	}
 }
 
+// ContextCommand switches between the stored cluster configurations
+func ContextCommand() cli.Command {
+	return cli.Command{
+		Name:   "ctx",
+		Usage:  usage.Ctx,
+		Action: errorLogger(configure.Context),
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  flags.ShowContextFlag,
+				Usage: "[Optional] Prints the name of the active cluster configuration, or nothing if there is none, e.g. to show it in your shell prompt.",
+			},
+		},
+		Subcommands: []cli.Command{
+			listContextsCommand(),
+			useContextCommand(),
+		},
+		OnUsageError: flags.UsageErrorFactory("ctx"),
+	}
+}
+
+func listContextsCommand() cli.Command {
+	return cli.Command{
+		Name:         "list",
+		Aliases:      []string{"ls"},
+		Usage:        usage.CtxList,
+		Action:       errorLogger(configure.ListContexts),
+		OnUsageError: flags.UsageErrorFactory("list"),
+	}
+}
+
+func useContextCommand() cli.Command {
+	return cli.Command{
+		Name:         "use",
+		Usage:        usage.CtxUse,
+		ArgsUsage:    "CONFIG_NAME",
+		Action:       errorLogger(configure.UseContext),
+		OnUsageError: flags.UsageErrorFactory("use"),
+	}
+}
+
 func defaultClusterCommand() cli.Command {
 	return cli.Command{
 		Name:         "default",
@@ -113,6 +155,35 @@ func migrateCommand() cli.Command {
 	}
 }
 
+func exportCommand() cli.Command {
+	return cli.Command{
+		Name:   "export",
+		Usage:  usage.ConfigureExport,
+		Action: errorLogger(configure.Export),
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  flags.IncludeProfilesFlag,
+				Usage: "[Optional] Includes the ECS profiles, which contain AWS credentials, in the exported configuration.",
+			},
+			cli.BoolFlag{
+				Name:  flags.EncryptProfilesFlag,
+				Usage: "[Optional] Includes the ECS profiles encrypted with the passphrase in the " + flags.ConfigPassphraseEnvVar + " environment variable.",
+			},
+		},
+		OnUsageError: flags.UsageErrorFactory("export"),
+	}
+}
+
+func importCommand() cli.Command {
+	return cli.Command{
+		Name:         "import",
+		Usage:        usage.ConfigureImport,
+		ArgsUsage:    "FILE",
+		Action:       errorLogger(configure.Import),
+		OnUsageError: flags.UsageErrorFactory("import"),
+	}
+}
+
 func configureDefaultProfileFlags() []cli.Flag {
 	return []cli.Flag{
 		cli.StringFlag{
@@ -198,5 +269,47 @@ func configureFlags() []cli.Flag {
 				"[Optional] Specifies the type of tasks that you would like to run. Options: EC2 or FARGATE. Defaults to empty string if none provided.",
 			),
 		},
+		cli.StringFlag{
+			Name: flags.AuditLogFileFlag,
+			Usage: fmt.Sprintf(
+				"[Optional] Specifies a local file to which a record of every mutating AWS API call made by the ECS CLI is appended.",
+			),
+		},
+		cli.StringFlag{
+			Name: flags.AuditLogGroupFlag,
+			Usage: fmt.Sprintf(
+				"[Optional] Specifies an existing CloudWatch Logs log group to which a record of every mutating AWS API call made by the ECS CLI is written. A new log stream is created for each invocation.",
+			),
+		},
+		cli.StringFlag{
+			Name: flags.NotificationWebhookURLFlag,
+			Usage: fmt.Sprintf(
+				"[Optional] Specifies a webhook URL, such as a Slack incoming webhook, to which a JSON notification is posted when a service deploy starts, succeeds or fails, and when the cluster is created or deleted.",
+			),
+		},
+		cli.StringFlag{
+			Name: flags.NotificationTopicArnFlag,
+			Usage: fmt.Sprintf(
+				"[Optional] Specifies an existing SNS topic to which a notification is published when a service deploy starts, succeeds or fails, and when the cluster is created or deleted.",
+			),
+		},
+		cli.StringFlag{
+			Name: flags.DeployMetricsNamespaceFlag,
+			Usage: fmt.Sprintf(
+				"[Optional] Specifies a CloudWatch namespace, e.g. ECSCLI/Deployments, in which the number, failures, duration and rollbacks of the service deploys and cluster changes are published as metrics, per cluster and per service.",
+			),
+		},
+		cli.StringFlag{
+			Name: flags.DeployLockTableFlag,
+			Usage: fmt.Sprintf(
+				"[Optional] Specifies an existing DynamoDB table, with a string partition key named LockID, holding the locks taken by 'compose service up --%s' so that only one deploy of a service runs at a time.", flags.LockFlag,
+			),
+		},
+		cli.StringFlag{
+			Name: flags.InventoryTableFlag,
+			Usage: fmt.Sprintf(
+				"[Optional] Specifies an existing DynamoDB table, with a string partition key named StackID, in which the cluster stacks created, updated or deleted by the ECS CLI are recorded, with who last modified them and the flags used. List them with 'ecs-cli inventory'.",
+			),
+		},
 	}
 }
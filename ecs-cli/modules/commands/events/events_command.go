@@ -0,0 +1,64 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package eventsCommand defines the events commands.
+package eventsCommand
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/events"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/urfave/cli"
+)
+
+// defaultSince is the number of minutes of history listed by default, one day
+const defaultSince = 1440
+
+// EventsCommand provides the commands to query the events captured for a cluster.
+func EventsCommand() cli.Command {
+	return cli.Command{
+		Name:  "events",
+		Usage: usage.Events,
+		Subcommands: []cli.Command{
+			historyCommand(),
+		},
+	}
+}
+
+func historyCommand() cli.Command {
+	return cli.Command{
+		Name:         "history",
+		Usage:        usage.EventsHistory,
+		Action:       events.History,
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), historyFlags()),
+		OnUsageError: flags.UsageErrorFactory("history"),
+	}
+}
+
+func historyFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.IntFlag{
+			Name:  flags.SinceFlag,
+			Value: defaultSince,
+			Usage: "[Optional] Lists the tasks which stopped in the given number of minutes before now. Defaults to one day.",
+		},
+		cli.StringFlag{
+			Name:  flags.EventsServiceFlag,
+			Usage: "[Optional] Lists only the tasks started by the ECS service with the given name.",
+		},
+		cli.StringFlag{
+			Name:  flags.TaskIDFlag,
+			Usage: "[Optional] Lists only the task with the given ID.",
+		},
+	}
+}
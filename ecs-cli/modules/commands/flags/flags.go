@@ -38,6 +38,7 @@ const (
 	ClusterFlag             = "cluster"
 	ClusterEnvVar           = "ECS_CLUSTER"
 	VerboseFlag             = "verbose"
+	ProgressFlag            = "progress"
 	ClusterConfigFlag       = "cluster-config"
 	ECSProfileFlag          = "ecs-profile"
 	ProfileNameFlag         = "profile-name"
@@ -48,6 +49,18 @@ const (
 	AWSAccessKeyEnvVar      = "AWS_ACCESS_KEY_ID"
 	AWSSecretKeyEnvVar      = "AWS_SECRET_ACCESS_KEY"
 
+	// Read-only mode
+	ReadOnlyFlag   = "read-only"
+	ReadOnlyEnvVar = "ECS_CLI_READ_ONLY"
+
+	// Configure Export/Import
+	IncludeProfilesFlag    = "include-profiles"
+	EncryptProfilesFlag    = "encrypt"
+	ConfigPassphraseEnvVar = "ECS_CLI_CONFIG_PASSPHRASE"
+
+	// Ctx
+	ShowContextFlag = "show-context"
+
 	// logs
 	TaskIDFlag         = "task-id"
 	TaskDefinitionFlag = "task-def"
@@ -58,6 +71,7 @@ const (
 	EndTimeFlag        = "end-time"
 	TimeStampsFlag     = "timestamps"
 	CreateLogsFlag     = "create-log-groups"
+	DeleteLogsFlag     = "delete-log-groups"
 
 	// Service Discovery
 	PrivateDNSNamespaceNameFlag                 = "private-dns-namespace"
@@ -83,6 +97,30 @@ const (
 	LaunchTypeFlag         = "launch-type"
 	DefaultLaunchTypeFlag  = "default-launch-type"
 	SchedulingStrategyFlag = "scheduling-strategy"
+	AssignPublicIPFlag     = "assign-public-ip"
+
+	// Audit log
+	AuditLogFileFlag  = "audit-log-file"
+	AuditLogGroupFlag = "audit-log-group"
+
+	// Notifications
+	NotificationWebhookURLFlag = "notification-webhook-url"
+	NotificationTopicArnFlag   = "notification-topic-arn"
+	DeployMetricsNamespaceFlag = "deploy-metrics-namespace"
+
+	// Deploy lock
+	DeployLockTableFlag = "deploy-lock-table"
+	LockFlag            = "lock"
+	LockTimeoutFlag     = "lock-timeout"
+
+	// Stack inventory
+	InventoryTableFlag = "inventory-table"
+	IncludeDeletedFlag = "include-deleted"
+
+	// Deploys to several clusters
+	ClustersFlag         = "clusters"
+	ClusterOverridesFlag = "cluster-overrides"
+	BestEffortFlag       = "best-effort"
 
 	//attribute-checker
 	ContainerInstancesFlag = "container-instances"
@@ -90,28 +128,105 @@ const (
 	// Cluster
 	AsgMaxSizeFlag                  = "size"
 	IMDSv2Flag                      = "imdsv2"
+	MetadataHopLimitFlag            = "metadata-hop-limit"
+	InstanceMetadataTagsFlag        = "enable-instance-metadata-tags"
+	LaunchTemplateVersionFlag       = "launch-template-version"
 	VpcAzFlag                       = "azs"
 	SecurityGroupFlag               = "security-group"
 	SourceCidrFlag                  = "cidr"
 	EcsPortFlag                     = "port"
 	SubnetIdsFlag                   = "subnets"
 	VpcIdFlag                       = "vpc"
+	UseDefaultVpcFlag               = "use-default-vpc"
+	ShareVpcFromStackFlag           = "share-vpc-from-stack"
 	InstanceTypeFlag                = "instance-type"
 	SpotPriceFlag                   = "spot-price"
+	CapacityReservationIdFlag       = "capacity-reservation-id"
+	CreateCapacityReservationFlag   = "create-capacity-reservation"
+	PlacementGroupStrategyFlag      = "placement-group-strategy"
+	TenancyFlag                     = "tenancy"
+	HostResourceGroupArnFlag        = "host-resource-group-arn"
+	LicenseConfigurationArnFlag     = "license-configuration-arn"
+	EnableDetailedMonitoringFlag    = "enable-detailed-monitoring"
+	CaptureTaskEventsFlag           = "capture-task-events"
+	CapacityProviderFlag            = "capacity-provider"
+	ManagedScalingFlag              = "managed-scaling"
+	ManagedTerminationFlag          = "managed-termination-protection"
+	ManagedDrainingFlag             = "managed-draining"
+	InstanceWarmupPeriodFlag        = "instance-warmup-period"
+	ServiceConnectNamespaceFlag     = "service-connect-namespace"
+	RestartThresholdFlag            = "restart-threshold"
+	TemplateFormatFlag              = "template-format"
+	ScheduledScalingFlag            = "scheduled-scaling"
+	UpdateAgentFlag                 = "update"
+	QuotaWarningThresholdFlag       = "warning-threshold"
+	CostPeriodFlag                  = "last"
+	CloneFromFlag                   = "from"
+	CloneToFlag                     = "to"
+	InstanceAttributesFlag          = "instance-attributes"
+	GPUAttributesFlag               = "gpu-attributes"
+	AttributesFlag                  = "attributes"
 	InstanceRoleFlag                = "instance-role"
 	ImageIdFlag                     = "image-id"
 	KeypairNameFlag                 = "keypair"
+	UseInstanceConnectFlag          = "use-instance-connect"
+	SSHUserFlag                     = "user"
+	IdentityFileFlag                = "identity-file"
 	CapabilityIAMFlag               = "capability-iam"
+	CapabilitiesFlag                = "capabilities"
 	NoAutoAssignPublicIPAddressFlag = "no-associate-public-ip-address"
 	ForceFlag                       = "force"
 	EmptyFlag                       = "empty"
+	CreateServiceLinkedRoleFlag     = "create-service-linked-role"
 	UserDataFlag                    = "extra-user-data"
+	ExtraTemplateFileFlag           = "extra-template-file"
+	DeleteNetworkInterfacesFlag     = "delete-network-interfaces"
+	DrainTimeoutFlag                = "drain-timeout"
+	CFNWaitMaxAttemptsFlag          = "cfn-wait-max-attempts"
+	CFNWaitMaxAttemptsEnvVar        = "ECS_CLI_CFN_WAIT_MAX_ATTEMPTS"
+
+	// Version
+	CheckUpdateFlag = "check-update"
 
 	// Image
 	RegistryIdFlag = "registry-id"
 	TaggedFlag     = "tagged"
 	UntaggedFlag   = "untagged"
 	UseFIPSFlag    = "use-fips" // TODO: repurpose to use more generally with other services/workflows
+	SBOMFlag       = "sbom"
+	SignKeyFlag    = "sign-key"
+
+	// Task Definition
+	FamilyFlag       = "family"
+	KeepFlag         = "keep"
+	DeleteFlag       = "delete"
+	InactiveOnlyFlag = "inactive-only"
+
+	// Secrets
+	SecretArnFlag   = "secret-arn"
+	ConcurrencyFlag = "concurrency"
+
+	// Regions
+	RegionsFlag = "regions"
+
+	// Schema
+	ValidateFlag = "validate"
+
+	// Events
+	EventsServiceFlag = "service"
+
+	// Apply
+	ManifestFileFlag      = "file"
+	PruneFlag             = "prune"
+	WatchFlag             = "watch"
+	ReconcileIntervalFlag = "interval"
+	TargetFlag            = "target"
+
+	// Env
+	EnvFormatFlag = "format"
+
+	// Stats
+	NoStreamFlag = "no-stream"
 
 	// Compose
 	ProjectNameFlag           = "project-name"
@@ -120,6 +235,19 @@ const (
 	ECSParamsFileNameFlag     = "ecs-params"
 	ForceUpdateFlag           = "force-update"
 	RegistryCredsFileNameFlag = "registry-creds"
+	DryRunFlag                = "dry-run"
+	SkipImageValidationFlag   = "skip-image-validation"
+	GitTagsFlag               = "git-tags"
+	GitCommitFlag             = "git-commit"
+	GitBranchFlag             = "git-branch"
+	GitRepositoryFlag         = "git-repository"
+	ComposeProfileFlag        = "profile"
+	ComposeProfilesEnvVar     = "COMPOSE_PROFILES"
+	ServicesFlag              = "services"
+	BuildFlag                 = "build"
+	BuildPlatformFlag         = "platform"
+	BuildCacheFlag            = "build-cache"
+	ImportServiceFlag         = "service"
 
 	// Compose Service
 	CreateServiceCommandName                = "create"
@@ -132,10 +260,25 @@ const (
 	ContainerPortFlag                       = "container-port"
 	LoadBalancerNameFlag                    = "load-balancer-name"
 	HealthCheckGracePeriodFlag              = "health-check-grace-period"
+	PreserveDesiredCountFlag                = "preserve-desired-count"
+	SuspendAutoScalingFlag                  = "suspend-autoscaling"
+	ScaleFlag                               = "scale"
+	DebugOnFailureFlag                      = "debug-on-failure"
+	DeregistrationDelayFlag                 = "deregistration-delay"
 	RoleFlag                                = "role"
 	ComposeServiceTimeOutFlag               = "timeout"
 	ForceDeploymentFlag                     = "force-deployment"
 	TargetGroupsFlag                        = "target-groups"
+	DNSNameFlag                             = "dns-name"
+	HostedZoneIDFlag                        = "hosted-zone-id"
+	BatchSizeFlag                           = "batch-size"
+	WaitFlag                                = "wait"
+	DrainDelayFlag                          = "drain-delay"
+	HistoryLimitFlag                        = "limit"
+	DiffRevisionFlag                        = "revision"
+	URLPathFlag                             = "url-path"
+	ExpectStatusFlag                        = "expect-status"
+	FixFlag                                 = "fix"
 
 	// Registry Creds
 	UpdateExistingSecretsFlag = "update-existing-secrets"
@@ -146,6 +289,10 @@ const (
 
 	DesiredTaskStatus = "desired-status"
 
+	// Pagination
+	MaxItemsFlag      = "max-items"
+	StartingTokenFlag = "starting-token"
+
 	ResourceTagsFlag          = "tags"
 	DisableECSManagedTagsFlag = "disable-ecs-managed-tags"
 
@@ -254,6 +401,18 @@ func OptionalSchedulingStrategyFlag() []cli.Flag {
 	}
 }
 
+// OptionalAssignPublicIPFlag allows users to specify whether their Fargate tasks get a public IP
+func OptionalAssignPublicIPFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name: AssignPublicIPFlag,
+			Usage: fmt.Sprintf(
+				"[Optional] Specifies whether the tasks get a public IP, which they need to pull images from the internet in a public subnet. Options: ENABLED or DISABLED. Overrides assign_public_ip in the ECS Params file. Only valid with the awsvpc network mode.",
+			),
+		},
+	}
+}
+
 // OptionalCreateLogsFlag allows users to specify the launch type for their task/service/cluster
 func OptionalCreateLogsFlag() []cli.Flag {
 	return []cli.Flag{
@@ -276,6 +435,59 @@ func OptionalForceUpdateFlag() []cli.Flag {
 	}
 }
 
+// OptionalCFNWaitMaxAttemptsFlag allows users to wait longer for CloudFormation stacks with many resources
+func OptionalCFNWaitMaxAttemptsFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:   CFNWaitMaxAttemptsFlag,
+			EnvVar: CFNWaitMaxAttemptsEnvVar,
+			Usage:  "[Optional] Specifies the maximum number of times the CloudFormation stack status is checked while waiting for it to be created, updated or deleted. The stack is polled every 5 seconds at first, backing off to every 30 seconds.",
+		},
+	}
+}
+
+// OptionalDryRunFlag allows users to print the requests compose up would send to ECS without making them.
+func OptionalDryRunFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  DryRunFlag,
+			Usage: "[Optional] Prints the ECS API requests that would be made, with secrets redacted, without executing them.",
+		},
+	}
+}
+
+// OptionalSkipImageValidationFlag allows users to deploy images that can't be validated before tasks are started.
+func OptionalSkipImageValidationFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  SkipImageValidationFlag,
+			Usage: "[Optional] Skips checking that the ECR images exist and are built for the CPU architecture of the cluster before tasks are started.",
+		},
+	}
+}
+
+// OptionalGitTagsFlags allows users to tag the resources of a deployment with its git commit, branch and repository.
+func OptionalGitTagsFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  GitTagsFlag,
+			Usage: "[Optional] Tags the task definition, tasks and service with the git commit, branch and repository of the working tree of the compose file. The tags are shown by the ps commands.",
+		},
+		cli.StringFlag{
+			Name:  GitCommitFlag,
+			Usage: "[Optional] Specifies the git commit to tag the resources with, instead of detecting it from the working tree.",
+		},
+		cli.StringFlag{
+			Name:  GitBranchFlag,
+			Usage: "[Optional] Specifies the git branch to tag the resources with, instead of detecting it from the working tree.",
+		},
+		cli.StringFlag{
+			Name:  GitRepositoryFlag,
+			Usage: "[Optional] Specifies the git repository to tag the resources with, instead of detecting it from the working tree.",
+		},
+	}
+}
+
 func DebugFlag() []cli.Flag {
 	return []cli.Flag{
 		cli.BoolFlag{
@@ -285,6 +497,17 @@ func DebugFlag() []cli.Flag {
 	}
 }
 
+// OptionalProgressFlag allows users to request machine-readable progress events for long running operations
+func OptionalProgressFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  ProgressFlag,
+			Value: "text",
+			Usage: "[Optional] Specifies the format of progress output. Options: text or json. With json, newline-delimited progress events are written to stdout, and log and all other output is written to stderr.",
+		},
+	}
+}
+
 // OptionalDesiredStatusFlag allows users to filter tasks returned by the ps commands
 func OptionalDesiredStatusFlag() []cli.Flag {
 	return []cli.Flag{
@@ -299,6 +522,50 @@ func OptionalDesiredStatusFlag() []cli.Flag {
 	}
 }
 
+// OptionalPaginationFlags allows users to cap the number of items listed by a command and
+// to resume the listing where a previous invocation stopped
+func OptionalPaginationFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.IntFlag{
+			Name:  MaxItemsFlag,
+			Usage: "[Optional] Specifies the maximum number of items to list. If more items are available, a token to pass to --" + StartingTokenFlag + " is logged.",
+		},
+		cli.StringFlag{
+			Name:  StartingTokenFlag,
+			Usage: "[Optional] Resumes the listing where a previous invocation of the command stopped, using the token it logged.",
+		},
+	}
+}
+
+// OptionalServicesFlag allows users to deploy a subset of the services of a compose project
+func OptionalServicesFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  ServicesFlag,
+			Usage: "[Optional] Specifies a comma-separated list of the compose services to deploy, e.g. web,worker. The services they link to or mount volumes from are deployed as well. Defaults to the services enabled by the active profiles.",
+		},
+	}
+}
+
+// OptionalBuildFlag allows users to build and push the images of the compose services before deploying them,
+// for several platforms and with a build cache in ECR
+func OptionalBuildFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  BuildFlag,
+			Usage: "[Optional] Builds the images of the services with a build section using the local docker daemon, pushes them to Amazon ECR, creating the repositories as needed, and deploys the pushed images.",
+		},
+		cli.StringFlag{
+			Name:  BuildPlatformFlag,
+			Usage: "[Optional] Specifies a comma-separated list of the platforms to build the images for with --build, e.g. linux/amd64,linux/arm64. The images are built with docker buildx and pushed as multi-platform images.",
+		},
+		cli.BoolFlag{
+			Name:  BuildCacheFlag,
+			Usage: "[Optional] Builds the images with docker buildx using a build cache stored in the ECR repository of each image, so that builds on other machines reuse its layers.",
+		},
+	}
+}
+
 // UsageErrorFactory Returns a usage error function for the specified command
 func UsageErrorFactory(command string) func(*cli.Context, error, bool) error {
 	return func(c *cli.Context, err error, isSubcommand bool) error {
@@ -323,11 +590,18 @@ func CFNResourceFlags() []string {
 		EcsPortFlag,
 		SubnetIdsFlag,
 		VpcIdFlag,
+		ShareVpcFromStackFlag,
 		InstanceTypeFlag,
 		InstanceRoleFlag,
 		ImageIdFlag,
 		KeypairNameFlag,
 		SpotPriceFlag,
+		CapacityReservationIdFlag,
+		PlacementGroupStrategyFlag,
+		TenancyFlag,
+		HostResourceGroupArnFlag,
+		LicenseConfigurationArnFlag,
+		MetadataHopLimitFlag,
 	}
 }
 
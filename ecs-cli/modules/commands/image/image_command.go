@@ -19,6 +19,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/image"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
 	"github.com/urfave/cli"
 )
 
@@ -29,7 +30,7 @@ func PushCommand() cli.Command {
 		Usage:        usage.Push,
 		ArgsUsage:    image.PushImageFormat,
 		Before:       app.BeforeApp,
-		Action:       image.ImagePush,
+		Action:       readonly.Guard("push", image.ImagePush, "ecr:CreateRepository", "ecr:InitiateLayerUpload", "ecr:UploadLayerPart", "ecr:CompleteLayerUpload", "ecr:PutImage"),
 		Flags:        flags.AppendFlags(imagePushFlags(), flags.OptionalRegionAndProfileFlags(), flags.DebugFlag(), fipsEndpointFlag()),
 		OnUsageError: flags.UsageErrorFactory("push"),
 	}
@@ -56,7 +57,7 @@ func ImagesCommand() cli.Command {
 		ArgsUsage:    image.ListImageFormat,
 		Before:       app.BeforeApp,
 		Action:       image.ImageList,
-		Flags:        flags.AppendFlags(imageListFlags(), flags.OptionalRegionAndProfileFlags(), flags.DebugFlag()),
+		Flags:        flags.AppendFlags(imageListFlags(), flags.OptionalRegionAndProfileFlags(), flags.OptionalPaginationFlags(), flags.DebugFlag()),
 		OnUsageError: flags.UsageErrorFactory("images"),
 	}
 }
@@ -71,6 +72,14 @@ func imagePushFlags() []cli.Flag {
 			Name:  flags.ResourceTagsFlag,
 			Usage: "[Optional] Specify AWS Resource tags which will be to your ECR repository. Specify in the format 'key1=value1,key2=value2,key3=value3.",
 		},
+		cli.BoolFlag{
+			Name:  flags.SBOMFlag,
+			Usage: "[Optional] Generates an SPDX software bill of materials of the pushed image with syft, and stores it in ECR with cosign, as an attestation signed with --sign-key if given.",
+		},
+		cli.StringFlag{
+			Name:  flags.SignKeyFlag,
+			Usage: "[Optional] Signs the pushed image with cosign, storing the signature in ECR. Specifies the ID, alias (alias/name) or ARN of an AWS KMS key, a cosign key file, or any key reference cosign accepts, e.g. awskms:///alias/name.",
+		},
 	}
 }
 
@@ -11,7 +11,7 @@
 // express or implied. See the License for the specific language governing
 // permissions and limitations under the License.
 
-// Package licenseCommand defines the command for displaying license information
+// Package licenseCommand defines the commands for displaying license and version information
 package licenseCommand
 
 import (
@@ -24,9 +24,35 @@ import (
 // LicenseCommand prints the license
 func LicenseCommand() cli.Command {
 	return cli.Command{
-		Name:         "license",
-		Usage:        usage.License,
-		Action:       license.PrintLicense,
+		Name:   "license",
+		Usage:  usage.License,
+		Action: license.PrintLicense,
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  flags.JSON,
+				Usage: "[Optional] Prints the third-party components and their licenses as JSON.",
+			},
+		},
 		OnUsageError: flags.UsageErrorFactory("license"),
 	}
 }
+
+// VersionCommand prints the build metadata
+func VersionCommand() cli.Command {
+	return cli.Command{
+		Name:   "version",
+		Usage:  usage.Version,
+		Action: license.PrintVersion,
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  flags.JSON,
+				Usage: "[Optional] Prints the build metadata, the AWS API versions and the third-party components as JSON.",
+			},
+			cli.BoolFlag{
+				Name:  flags.CheckUpdateFlag,
+				Usage: "[Optional] Compares the version with the latest release on GitHub.",
+			},
+		},
+		OnUsageError: flags.UsageErrorFactory("version"),
+	}
+}
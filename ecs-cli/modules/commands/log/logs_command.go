@@ -54,7 +54,7 @@ func logFlags() []cli.Flag {
 		},
 		cli.StringFlag{
 			Name:  flags.ContainerNameFlag,
-			Usage: "[Optional] Prints the logs for the given container. Required if containers in the Task use different log groups",
+			Usage: "[Optional] Prints the logs for the given container. A partial name selects the container it uniquely matches. Without this flag, the logs of all containers are shown, prefixed with the container name; if containers in the Task use different log groups, you are prompted for a container.",
 		},
 		cli.IntFlag{
 			Name:  flags.SinceFlag,
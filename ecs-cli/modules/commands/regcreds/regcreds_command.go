@@ -19,6 +19,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/regcreds"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
 	"github.com/urfave/cli"
 )
 
@@ -39,7 +40,7 @@ func upCommand() cli.Command {
 	return cli.Command{
 		Name:         "up",
 		Usage:        usage.RegistryCredsUp,
-		Action:       regcreds.Up,
+		Action:       readonly.Guard("registry-creds up", regcreds.Up, "secretsmanager:CreateSecret", "secretsmanager:PutSecretValue", "iam:CreateRole", "iam:CreatePolicy", "iam:AttachRolePolicy"),
 		Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), regcredsUpFlags()),
 		OnUsageError: flags.UsageErrorFactory("up"),
 	}
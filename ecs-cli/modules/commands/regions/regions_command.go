@@ -0,0 +1,48 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package regionsCommand defines the regions command.
+package regionsCommand
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/regions"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudformation"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/urfave/cli"
+)
+
+// RegionsCommand reports the availability of the ECS launch options in each region.
+func RegionsCommand() cli.Command {
+	return cli.Command{
+		Name:         "regions",
+		Usage:        usage.Regions,
+		Action:       regions.Regions,
+		Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), regionsFlags()),
+		OnUsageError: flags.UsageErrorFactory("regions"),
+	}
+}
+
+func regionsFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.RegionsFlag,
+			Usage: "[Optional] Specifies a comma-separated list of regions to check, e.g. us-east-1,eu-west-1. Defaults to all the regions enabled for your account.",
+		},
+		cli.StringFlag{
+			Name:  flags.InstanceTypeFlag,
+			Value: cloudformation.DefaultECSInstanceType,
+			Usage: "[Optional] Specifies the EC2 instance type to check. The ECS-optimized AMI flavor (x86_64, arm64 or gpu) is chosen from the instance type, as in the up command.",
+		},
+	}
+}
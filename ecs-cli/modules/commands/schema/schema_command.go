@@ -0,0 +1,39 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package schemaCommand defines the schema command.
+package schemaCommand
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/schema"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/urfave/cli"
+)
+
+// SchemaCommand prints the JSON Schema of a file read by the ECS CLI
+func SchemaCommand() cli.Command {
+	return cli.Command{
+		Name:      "schema",
+		Usage:     usage.Schema,
+		ArgsUsage: "ecs-params|cluster-config|credentials",
+		Action:    schema.Schema,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  flags.ValidateFlag,
+				Usage: "[Optional] Specifies a file to validate against the schema instead of printing the schema.",
+			},
+		},
+		OnUsageError: flags.UsageErrorFactory("schema"),
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package secretsCommand defines the commands that act on the consumers of secrets
+package secretsCommand
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/secrets"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
+	"github.com/urfave/cli"
+)
+
+const (
+	defaultConcurrency = 2
+	defaultTimeout     = 10
+)
+
+// SecretsCommand provides the commands to act on the services consuming a secret.
+func SecretsCommand() cli.Command {
+	return cli.Command{
+		Name:  "secrets",
+		Usage: usage.Secrets,
+		Subcommands: []cli.Command{
+			redeployCommand(),
+		},
+	}
+}
+
+func redeployCommand() cli.Command {
+	return cli.Command{
+		Name:         "redeploy",
+		Usage:        usage.SecretsRedeploy,
+		Action:       readonly.Guard("secrets redeploy", secrets.RedeployServices, "ecs:UpdateService"),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), redeployFlags()),
+		OnUsageError: flags.UsageErrorFactory("redeploy"),
+	}
+}
+
+func redeployFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.SecretArnFlag,
+			Usage: "Specifies the ARN of the rotated Secrets Manager secret.",
+		},
+		cli.IntFlag{
+			Name:  flags.ConcurrencyFlag,
+			Value: defaultConcurrency,
+			Usage: "[Optional] Specifies the maximum number of services to redeploy at the same time.",
+		},
+		cli.Float64Flag{
+			Name:  flags.ComposeServiceTimeOutFlag,
+			Value: defaultTimeout,
+			Usage: "[Optional] Specifies the timeout value in minutes (decimals supported) to wait for the deployment of each service to complete. A value of 0 forces the new deployments without waiting for them.",
+		},
+		cli.BoolFlag{
+			Name:  flags.DryRunFlag,
+			Usage: "[Optional] Lists the services that reference the secret without redeploying them.",
+		},
+	}
+}
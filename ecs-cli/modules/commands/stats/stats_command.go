@@ -0,0 +1,42 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package statsCommand defines the command that displays task resource utilization
+package statsCommand
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/stats"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/urfave/cli"
+)
+
+// StatsCommand displays the CPU and memory utilization of the tasks in a cluster.
+func StatsCommand() cli.Command {
+	return cli.Command{
+		Name:         "stats",
+		Usage:        usage.Stats,
+		Action:       stats.Stats,
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), statsFlags()),
+		OnUsageError: flags.UsageErrorFactory("stats"),
+	}
+}
+
+func statsFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.NoStreamFlag,
+			Usage: "[Optional] Prints the current utilization once instead of refreshing it until interrupted.",
+		},
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package taskdefCommand defines the commands that manage task definitions
+package taskdefCommand
+
+import (
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/taskdef"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/usage"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/readonly"
+	"github.com/urfave/cli"
+)
+
+const defaultKeep = 20
+
+// TaskDefCommand provides the commands to manage task definition revisions.
+func TaskDefCommand() cli.Command {
+	return cli.Command{
+		Name:  "taskdef",
+		Usage: usage.TaskDef,
+		Subcommands: []cli.Command{
+			pruneCommand(),
+		},
+	}
+}
+
+func pruneCommand() cli.Command {
+	return cli.Command{
+		Name:         "prune",
+		Usage:        usage.TaskDefPrune,
+		Action:       readonly.Guard("taskdef prune", taskdef.PruneTaskDefinitions, "ecs:DeregisterTaskDefinition", "ecs:DeleteTaskDefinitions"),
+		Flags:        flags.AppendFlags(flags.OptionalRegionAndProfileFlags(), pruneFlags()),
+		OnUsageError: flags.UsageErrorFactory("prune"),
+	}
+}
+
+func pruneFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.FamilyFlag,
+			Usage: "Specifies the task definition family to prune.",
+		},
+		cli.IntFlag{
+			Name:  flags.KeepFlag,
+			Value: defaultKeep,
+			Usage: "[Optional] Specifies the number of newest ACTIVE revisions to keep registered.",
+		},
+		cli.BoolFlag{
+			Name:  flags.DeleteFlag,
+			Usage: "[Optional] Deletes the INACTIVE revisions of the family, including the revisions deregistered by this command. Deleted revisions can no longer be described or used to run tasks.",
+		},
+		cli.BoolFlag{
+			Name:  flags.InactiveOnlyFlag,
+			Usage: "[Optional] Leaves every ACTIVE revision registered and only deletes the revisions that are already INACTIVE. Requires --" + flags.DeleteFlag + ".",
+		},
+	}
+}
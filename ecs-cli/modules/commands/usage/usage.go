@@ -31,10 +31,24 @@ const (
 
 // Cluster
 const (
-	ClusterUp    = "Creates the ECS cluster (if it does not already exist) and the AWS resources required to set up the cluster."
-	ClusterDown  = "Deletes the CloudFormation stack that was created by ecs-cli up and the associated resources."
-	ClusterScale = "Modifies the number of container instances in your cluster. This command changes the desired and maximum instance count in the Auto Scaling group created by the ecs-cli up command. You can use this command to scale up (increase the number of instances) or scale down (decrease the number of instances) your cluster."
-	ClusterPs    = "Lists all of the running containers in your ECS cluster."
+	ClusterUp                 = "Creates the ECS cluster (if it does not already exist) and the AWS resources required to set up the cluster."
+	ClusterDown               = "Deletes the CloudFormation stack that was created by ecs-cli up and the associated resources."
+	ClusterScale              = "Modifies the number of container instances in your cluster. This command changes the desired and maximum instance count in the Auto Scaling group created by the ecs-cli up command. You can use this command to scale up (increase the number of instances) or scale down (decrease the number of instances) your cluster."
+	ClusterStop               = "Stops your cluster to save cost while it is not in use. This command drains your container instances and scales the desired instance count of the Auto Scaling group created by the ecs-cli up command to 0, keeping its maximum instance count."
+	ClusterStart              = "Starts a cluster stopped with the ecs-cli stop command, scaling the desired instance count of its Auto Scaling group back to its maximum instance count."
+	ClusterSSH                = "Connects to a container instance in your ECS cluster with ssh, at the public IP address of its EC2 instance or its private IP address if it has none. The remaining arguments are run as a command on the instance. With --use-instance-connect, a one-time SSH key is pushed to the instance with EC2 Instance Connect, so no key pair is needed."
+	ClusterPs                 = "Lists all of the running containers in your ECS cluster."
+	ClusterInterruptions      = "Lists the recent Spot interruptions of the container instances launched by the ecs-cli up command for your cluster. EC2 keeps the Spot Instance requests of terminated instances for a few hours only. Rebalance recommendations are not recorded by EC2 and are not listed."
+	ClusterAgents             = "Lists the container instances in your ECS cluster with the versions of their ECS agent and Docker, and flags agents older than the agent of the recommended ECS-optimized AMI."
+	ClusterQuotas             = "Compares the usage of your ECS cluster against the ECS and VPC service quotas of the region: services per cluster, tasks per service, container instances per cluster and network interfaces per region. Quotas are looked up with the Service Quotas API, and a warning is logged for each quota above the warning threshold."
+	ClusterCosts              = "Reports the costs of your ECS cluster from Cost Explorer, broken down into EC2, EBS, NAT Gateway and Fargate costs. Costs are matched by the aws:cloudformation:stack-name tag of the resources created by the ecs-cli up command and the aws:ecs:clusterName tag of the tasks, which must be activated as cost allocation tags."
+	ClusterReplaceInstance    = "Replaces container instances launched by the ecs-cli up command, one at a time. Each container instance is drained and its EC2 instance is terminated without changing the desired instance count of the Auto Scaling group, which launches a replacement. The command waits for the replacement to register to your cluster before replacing the next container instance."
+	ClusterResizeInstanceType = "Changes the EC2 instance type of the container instances launched by the ecs-cli up command. The CloudFormation stack of your cluster is updated with the new instance type and its recommended ECS-optimized AMI through a change set, which is printed for confirmation. The existing container instances are then replaced one at a time: each one is drained, and the command waits for its replacement to register to your cluster before replacing the next one."
+	ClusterStacks             = "Lists the CloudFormation stacks that the ECS CLI manages for your cluster, with the stacks each one depends on and the stacks that use its exports."
+	ClusterInventory          = "Lists the cluster stacks recorded in the inventory table by the ECS CLI commands which create, update or delete them, across every cluster and region, with who last modified each stack, when, and the flags used."
+	ClusterHealth             = "Scans the tasks of your ECS cluster which stopped recently for out of memory errors, crash loops of services and image pull failures, and prints them grouped by task definition with suggested fixes. The events captured for clusters created with --capture-task-events are scanned, otherwise only the stopped tasks that ECS keeps for about an hour."
+	ClusterEnv                = "Prints the name and region of your ECS cluster, the VPC, subnets and security group of the CloudFormation stack created by the ecs-cli up command, and the DNS names of the load balancers of its services, as KEY=value lines. The dotenv format can be sourced by shell scripts or appended to $GITHUB_ENV, and the github-actions format can be appended to $GITHUB_OUTPUT."
+	ClusterClone              = "Creates a cluster with the same configuration as an existing cluster, from the parameters, tags and capabilities of the CloudFormation stack created by the ecs-cli up command. The new cluster shares the VPC of the existing cluster, so that services can be moved to it before the existing cluster is deleted. Scheduled scaling actions and the resources of an extra template file are not cloned."
 )
 
 // Compose
@@ -47,29 +61,44 @@ const (
 	ComposeRun    = "Starts all containers overriding commands with the supplied one-off commands for the containers."
 	ComposeStop   = "Stops all the running tasks created by the compose project."
 	ComposeScale  = "Scales the number of running tasks to the specified count."
+	ComposeImport = "Creates a Compose file and an ECS params file from an existing ECS service and its task definition, so that it can be managed with the compose commands."
 )
 
 // Compose Service
 const (
-	Service       = "Manage Amazon ECS services with docker-compose-style commands on an ECS cluster."
-	ServiceCreate = "Creates an ECS service from your compose file. The service is created with a desired count of 0, so no containers are started by this command. Note that we do not recommend using plain text environment variables for sensitive information, such as credential data."
-	ServiceStart  = "Starts one copy of each of the containers on an existing ECS service by setting the desired count to 1 (only if the current desired count is 0)."
-	ServiceUp     = "Creates a new ECS service or updates an existing one according to your compose file. For new services or existing services with a current desired count of 0, the desired count for the service is set to 1. For existing services with non-zero desired counts, a new task definition is created to reflect any changes to the compose file and the service is updated to use that task definition. In this case, the desired count does not change."
-	ServicePs     = "Lists all the containers in your cluster that belong to the service created with the compose project."
-	ServiceScale  = "Scales the desired count of the service to the specified count."
-	ServiceStop   = "Stops the running tasks that belong to the service created with the compose project. This command updates the desired count of the service to 0."
-	ServiceRm     = "Updates the desired count of the service to 0 and then deletes the service."
+	Service          = "Manage Amazon ECS services with docker-compose-style commands on an ECS cluster."
+	ServiceCreate    = "Creates an ECS service from your compose file. The service is created with a desired count of 0, so no containers are started by this command. Note that we do not recommend using plain text environment variables for sensitive information, such as credential data."
+	ServiceStart     = "Starts one copy of each of the containers on an existing ECS service by setting the desired count to 1 (only if the current desired count is 0)."
+	ServiceUp        = "Creates a new ECS service or updates an existing one according to your compose file. For new services or existing services with a current desired count of 0, the desired count for the service is set to 1. For existing services with non-zero desired counts, a new task definition is created to reflect any changes to the compose file and the service is updated to use that task definition. In this case, the desired count does not change."
+	ServicePs        = "Lists all the containers in your cluster that belong to the service created with the compose project."
+	ServiceScale     = "Scales the desired count of the service to the specified count."
+	ServiceRestart   = "Forces a new deployment of the service with its current task definition, and waits for the new tasks to replace the old ones. Use it to pick up a new image pushed with the same tag, or the new value of a rotated secret, without changing the compose file."
+	ServiceHistory   = "Lists the last revisions of the task definition of the service, newest first, with when and by whom they were registered, the git commit and branch they were deployed from, their images, and their status in the deployments of the service."
+	ServiceDiff      = "Shows the changes between the task definition the service is running and the one converted from your compose file, or another revision with --revision: the task size, and the images, sizes, environment variables and secret references of the containers."
+	ServiceVerify    = "Checks that the service serves its new revision once deployed: sends HTTP requests to its load balancer, or to each of its running tasks if it has none, until they respond with the expected status, and fails if they do not before the timeout."
+	ServiceRebalance = "Reports how the running tasks of the service are spread across availability zones and container instances, and with --fix stops the over-concentrated tasks one at a time so that the scheduler replaces them elsewhere."
+	ServiceStop      = "Stops the running tasks that belong to the service created with the compose project. This command updates the desired count of the service to 0."
+	ServiceRm        = "Updates the desired count of the service to 0 and then deletes the service, along with the task definitions and other resources recorded for the project."
 )
 
 // Configure
 const (
 	Configure               = "Stores a single cluster configuration."
 	ConfigureDefault        = "Sets the default cluster config."
+	ConfigureExport         = "Writes the cluster configurations, and optionally the profiles, to standard output as YAML that can be imported on another machine with ecs-cli configure import."
+	ConfigureImport         = "Stores the cluster configurations and profiles of a file written by ecs-cli configure export, replacing the configurations with the same names."
 	ConfigureMigrate        = "Migrates a legacy ECS CLI configuration file to the current YAML format."
 	ConfigureProfile        = "Stores a single profile."
 	ConfigureProfileDefault = "Sets the default profile."
 )
 
+// Ctx
+const (
+	Ctx     = "Switches between the stored cluster configurations. With --show-context, prints the name of the active one."
+	CtxList = "Lists the stored cluster configurations, marking the active one, the default cluster configuration."
+	CtxUse  = "Makes a stored cluster configuration the active one, the default cluster configuration."
+)
+
 // Image
 const (
 	Push   = "Pushes an image to an Amazon ECR repository."
@@ -80,6 +109,7 @@ const (
 // License
 const (
 	License = "Prints the LICENSE files for the ECS CLI and its dependencies."
+	Version = "Prints the version, build metadata and AWS API versions of the ECS CLI, and optionally checks for a newer release."
 )
 
 // Local
@@ -96,8 +126,61 @@ const (
 	Logs = "Retrieves container logs from CloudWatch logs. Assumes your Task Definition uses the awslogs driver and has a log stream prefix specified."
 )
 
+// Attributes
+const (
+	Attributes       = "Manages the custom attributes of your container instances, for use in placement constraints such as 'memberOf(attribute:stack == prod)'."
+	AttributesPut    = "Creates or updates custom attributes on the given container instances."
+	AttributesDelete = "Deletes custom attributes from the given container instances."
+)
+
+// TaskDef
+const (
+	TaskDef      = "Manages the revisions of your ECS task definitions."
+	TaskDefPrune = "Deregisters all but the newest ACTIVE revisions of a task definition family, and optionally deletes its INACTIVE revisions."
+)
+
+// Secrets
+const (
+	Secrets         = "Manages the ECS services that consume your Secrets Manager secrets."
+	SecretsRedeploy = "Forces a new deployment of every service in the cluster whose task definition references a secret, so that its tasks read the rotated value of the secret."
+)
+
+// Regions
+const (
+	Regions = "Reports, per region, whether Fargate, Fargate Spot, an EC2 instance type and its ECS-optimized AMI are available, to check the regions of a multi-region rollout."
+)
+
+// Schema
+const (
+	Schema = "Prints the JSON Schema of the ecs-params.yml file, the cluster configurations file or the ECS profiles file, and optionally validates a file against it."
+)
+
+// Stats
+const (
+	Stats = "Displays the CPU and memory utilization of the running tasks in your cluster, refreshed every minute. Requires Container Insights to be enabled on the cluster."
+)
+
 // Regcreds
 const (
 	RegistryCreds   = "Facilitates the creation and use of private registry credentials within ECS."
 	RegistryCredsUp = "Uses a YAML input file to generate AWS Secrets Manager secrets and an IAM Task Execution Role for use in an ECS Task Definition."
 )
+
+// Addons
+const (
+	Addons        = "Manages the cluster-wide agents run as daemon services on your container instances."
+	AddonsInstall = "Registers the task definition recommended by the vendor of a monitoring or log routing agent and runs it on every container instance of the cluster with a daemon service, injecting its API key from a Secrets Manager secret."
+)
+
+// Apply
+const (
+	Apply     = "Creates, scales and updates your cluster and the services of your compose projects to match a manifest, and deletes the services it marks as absent."
+	Destroy   = "Deletes the services and the cluster declared in a manifest, or only the targeted ones, deleting the services of a cluster before it."
+	Reconcile = "Applies a manifest like apply, once or periodically with --watch, and reports how your cluster drifted from it, correcting changed service counts and deleted log groups."
+)
+
+// Events
+const (
+	Events        = "Queries the ECS events captured for your cluster."
+	EventsHistory = "Lists the tasks of your cluster which stopped, with their stop code, the exit codes of their containers and the reasons they stopped. Requires a cluster created with --capture-task-events."
+)
@@ -14,10 +14,18 @@
 package config
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/audit"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/interrupt"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/notify"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
 
@@ -41,12 +49,24 @@ type CommandConfig struct {
 	ComposeProjectNamePrefix string // Deprecated; remains for backwards compatibility
 	CFNStackName             string
 	LaunchType               string
+	CFNWaitMaxAttempts       int              // Overrides the default maximum number of polls while waiting for a CloudFormation stack operation
+	Notifier                 *notify.Notifier // nil unless notifications are configured
+	DeployLockTable          string           // DynamoDB table holding the leases of service deploys run with --lock
+	InventoryTable           string           // DynamoDB table recording the cluster stacks created by the ECS CLI
 }
 
 func (c *CommandConfig) Region() string {
 	return aws.StringValue(c.Session.Config.Region)
 }
 
+// Ungated returns a copy of the config whose requests are not stopped when the command is interrupted,
+// for the clients that report the status of the interrupted operation or roll it back.
+func (c *CommandConfig) Ungated() *CommandConfig {
+	ungated := *c
+	ungated.Session = interrupt.Ungated(c.Session)
+	return &ungated
+}
+
 // Searches as far up the context as necessary. This function works no matter
 // how many layers of nested subcommands there are. It is more powerful than
 // merely calling context.String and context.GlobalString
@@ -62,12 +82,9 @@ func RecursiveFlagSearch(context *cli.Context, flag string) string {
 
 // NewCommandConfig creates a new CommandConfig object from the local ECS config file and flags
 func NewCommandConfig(context *cli.Context, rdwr ReadWriter) (*CommandConfig, error) {
-	clusterConfig := RecursiveFlagSearch(context, flags.ClusterConfigFlag)
-	profileConfig := RecursiveFlagSearch(context, flags.ECSProfileFlag)
-	ecsConfig, err := rdwr.Get(clusterConfig, profileConfig)
-
+	ecsConfig, err := loadLocalConfig(context, rdwr)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error loading config")
+		return nil, err
 	}
 
 	// Configuration passed in via flags take precedence over stored config
@@ -81,6 +98,12 @@ func NewCommandConfig(context *cli.Context, rdwr ReadWriter) (*CommandConfig, er
 	if err != nil {
 		return nil, err
 	}
+	enableAuditLog(ecsConfig, svcSession)
+
+	cfnWaitMaxAttempts, err := cfnWaitMaxAttemptsFromFlags(context)
+	if err != nil {
+		return nil, err
+	}
 
 	// Determine Cloudformation StackName
 	if ecsConfig.Version == iniConfigVersion {
@@ -97,18 +120,80 @@ func NewCommandConfig(context *cli.Context, rdwr ReadWriter) (*CommandConfig, er
 		ComposeProjectNamePrefix: ecsConfig.ComposeProjectNamePrefix, // deprecated; remains for backwards compatibility
 		CFNStackName:             ecsConfig.CFNStackName,
 		LaunchType:               ecsConfig.DefaultLaunchType,
+		CFNWaitMaxAttempts:       cfnWaitMaxAttempts,
+		Notifier:                 notify.New(svcSession, ecsConfig.NotificationWebhookURL, ecsConfig.NotificationTopicArn, ecsConfig.DeployMetricsNamespace),
+		DeployLockTable:          ecsConfig.DeployLockTable,
+		InventoryTable:           ecsConfig.InventoryTable,
 	}, nil
 }
 
-// NewCommandConfig creates a new CommandConfig object from the local ECS
-// config file and flags and custom region
-func NewCommandConfigWithRegion(context *cli.Context, rdwr ReadWriter, region string) (*CommandConfig, error) {
+// loadLocalConfig reads the stored ECS CLI configuration, which the flags then override. When it
+// can't be read, e.g. on a machine where nothing was configured, commands still run if both the
+// cluster and the region are passed as flags without --cluster-config or --ecs-profile.
+func loadLocalConfig(context *cli.Context, rdwr ReadWriter) (*LocalConfig, error) {
 	clusterConfig := RecursiveFlagSearch(context, flags.ClusterConfigFlag)
 	profileConfig := RecursiveFlagSearch(context, flags.ECSProfileFlag)
+
 	ecsConfig, err := rdwr.Get(clusterConfig, profileConfig)
+	if err == nil {
+		return ecsConfig, nil
+	}
+	if clusterConfig != "" || profileConfig != "" {
+		return nil, errors.Wrap(err, "Error loading config")
+	}
+	if missing := missingConfigOverrides(context); len(missing) > 0 {
+		return nil, errors.Wrapf(err, "Error loading config (to run without a stored configuration, specify %s)", strings.Join(missing, " and "))
+	}
+	logrus.Debugf("Running without a stored configuration, since --%s and --%s were specified: %v", flags.ClusterFlag, flags.RegionFlag, err)
+	return &LocalConfig{Version: yamlConfigVersion}, nil
+}
 
+// missingConfigOverrides returns the flags that must also be specified to run without a stored configuration
+func missingConfigOverrides(context *cli.Context) []string {
+	var missing []string
+	if RecursiveFlagSearch(context, flags.ClusterFlag) == "" {
+		missing = append(missing, "--"+flags.ClusterFlag)
+	}
+	if RecursiveFlagSearch(context, flags.RegionFlag) == "" {
+		missing = append(missing, "--"+flags.RegionFlag)
+	}
+	return missing
+}
+
+// cfnWaitMaxAttemptsFromFlags returns the maximum number of attempts to wait for a CloudFormation stack
+// operation, or 0 if the default should be used.
+func cfnWaitMaxAttemptsFromFlags(context *cli.Context) (int, error) {
+	value := RecursiveFlagSearch(context, flags.CFNWaitMaxAttemptsFlag)
+	if value == "" {
+		return 0, nil
+	}
+	maxAttempts, err := strconv.Atoi(value)
+	if err != nil || maxAttempts < 1 {
+		return 0, fmt.Errorf("Invalid value for --%s: '%s'. Must be a positive integer", flags.CFNWaitMaxAttemptsFlag, value)
+	}
+	return maxAttempts, nil
+}
+
+// enableAuditLog records the mutating API calls made with the session if an audit log is configured
+func enableAuditLog(ecsConfig *LocalConfig, svcSession *session.Session) {
+	var sinks []audit.Sink
+	if ecsConfig.AuditLogFile != "" {
+		sinks = append(sinks, audit.NewFileSink(ecsConfig.AuditLogFile))
+	}
+	if ecsConfig.AuditLogGroup != "" {
+		sinks = append(sinks, audit.NewCloudWatchLogsSink(svcSession, ecsConfig.AuditLogGroup))
+	}
+	if len(sinks) > 0 {
+		audit.Enable(svcSession, sinks...)
+	}
+}
+
+// NewCommandConfig creates a new CommandConfig object from the local ECS
+// config file and flags and custom region
+func NewCommandConfigWithRegion(context *cli.Context, rdwr ReadWriter, region string) (*CommandConfig, error) {
+	ecsConfig, err := loadLocalConfig(context, rdwr)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error loading config")
+		return nil, err
 	}
 
 	// Configuration passed in via flags take precedence over stored config
@@ -124,6 +209,12 @@ func NewCommandConfigWithRegion(context *cli.Context, rdwr ReadWriter, region st
 	if err != nil {
 		return nil, err
 	}
+	enableAuditLog(ecsConfig, svcSession)
+
+	cfnWaitMaxAttempts, err := cfnWaitMaxAttemptsFromFlags(context)
+	if err != nil {
+		return nil, err
+	}
 
 	// Determine Cloudformation StackName
 	if ecsConfig.Version == iniConfigVersion {
@@ -140,5 +231,9 @@ func NewCommandConfigWithRegion(context *cli.Context, rdwr ReadWriter, region st
 		ComposeProjectNamePrefix: ecsConfig.ComposeProjectNamePrefix, // deprecated; remains for backwards compatibility
 		CFNStackName:             ecsConfig.CFNStackName,
 		LaunchType:               ecsConfig.DefaultLaunchType,
+		CFNWaitMaxAttempts:       cfnWaitMaxAttempts,
+		Notifier:                 notify.New(svcSession, ecsConfig.NotificationWebhookURL, ecsConfig.NotificationTopicArn, ecsConfig.DeployMetricsNamespace),
+		DeployLockTable:          ecsConfig.DeployLockTable,
+		InventoryTable:           ecsConfig.InventoryTable,
 	}, nil
 }
@@ -14,6 +14,7 @@
 package config
 
 import (
+	"errors"
 	"flag"
 	"io/ioutil"
 	"os"
@@ -21,6 +22,7 @@ import (
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
 )
 
@@ -42,9 +44,15 @@ type mockReadWriter struct {
 	isKeyPresentValue bool
 	fargate           bool
 	version           int
+	auditLogFile      string
+	auditLogGroup     string
+	err               error
 }
 
 func (rdwr *mockReadWriter) Get(clusterConfig string, profileConfig string) (*LocalConfig, error) {
+	if rdwr.err != nil {
+		return nil, rdwr.err
+	}
 	config := NewLocalConfig(clusterName)
 	if rdwr.isKeyPresentValue && rdwr.version == iniConfigVersion {
 		config.ComposeServiceNamePrefix = composeServiceNamePrefix
@@ -59,6 +67,8 @@ func (rdwr *mockReadWriter) Get(clusterConfig string, profileConfig string) (*Lo
 			config.DefaultLaunchType = LaunchTypeFargate
 		}
 	}
+	config.AuditLogFile = rdwr.auditLogFile
+	config.AuditLogGroup = rdwr.auditLogGroup
 	config.Version = rdwr.version
 	return config, nil
 }
@@ -81,6 +91,7 @@ func (rdwr *mockReadWriter) SetDefaultCluster(configName string) error {
 
 func TestNewCommandConfigFromEnvVarsWithRegionNotSpecified(t *testing.T) {
 	context, rdwr := setupTest(t)
+	defer mockInstanceMetadataRegion("", errors.New("EC2MetadataRequestError: failed to get EC2 instance identity document"))()
 
 	_, err := NewCommandConfig(context, rdwr)
 	assert.Error(t, err, "Expected error when region is not specified")
@@ -196,6 +207,30 @@ func TestNewCommandConfigINIVersionLaunchTypeFlagEC2(t *testing.T) {
 	assert.Equal(t, LaunchTypeEC2, config.LaunchType)
 }
 
+func TestNewCommandConfigCFNWaitMaxAttempts(t *testing.T) {
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "SECRET")
+	defer func() {
+		os.Unsetenv("AWS_ACCESS_KEY")
+		os.Unsetenv("AWS_SECRET_KEY")
+	}()
+
+	rdwr := &mockReadWriter{version: yamlConfigVersion}
+
+	config, err := NewCommandConfig(defaultConfig(), rdwr)
+	assert.NoError(t, err, "Unexpected error when getting new CLI config")
+	assert.Equal(t, 0, config.CFNWaitMaxAttempts, "Expected default max attempts")
+
+	config, err = NewCommandConfig(configWithCFNWaitMaxAttempts("500"), rdwr)
+	assert.NoError(t, err, "Unexpected error when getting new CLI config")
+	assert.Equal(t, 500, config.CFNWaitMaxAttempts)
+
+	_, err = NewCommandConfig(configWithCFNWaitMaxAttempts("0"), rdwr)
+	assert.Error(t, err, "Expected error for non-positive max attempts")
+	_, err = NewCommandConfig(configWithCFNWaitMaxAttempts("many"), rdwr)
+	assert.Error(t, err, "Expected error for non-numeric max attempts")
+}
+
 func TestNewCommandConfigINIVersionLaunchTypeFlagFargate(t *testing.T) {
 	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
 	os.Setenv("AWS_SECRET_KEY", "SECRET")
@@ -364,6 +399,71 @@ aws_secret_access_key = aws-secret
 	assert.Equal(t, awsSecretAWSProfile, creds.SecretAccessKey, "Expected AWS Secret Access Key to be read from the AWS Profile")
 }
 
+func TestNewCommandConfigClusterAndRegionFlagsWithoutStoredConfig(t *testing.T) {
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "SECRET")
+	defer os.Clearenv()
+
+	context := configWithCluster("flag-cluster")
+
+	// the stored config can't be read, e.g. nothing was configured
+	rdwr := &mockReadWriter{err: errors.New("Cluster Configuration could not be found")}
+	config, err := NewCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error when cluster and region are specified as flags")
+	assert.Equal(t, "flag-cluster", config.Cluster, "Expected cluster to match flag")
+	assert.Equal(t, "us-east-1", config.Region(), "Expected region to match flag")
+	assert.Equal(t, flags.CFNStackNamePrefixDefaultValue+"flag-cluster", config.CFNStackName, "Expected default CFNStackName")
+}
+
+func TestNewCommandConfigClusterAndRegionFlagsKeepStoredConfig(t *testing.T) {
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "SECRET")
+	defer os.Clearenv()
+
+	context := configWithCluster("flag-cluster")
+	rdwr := &mockReadWriter{
+		isKeyPresentValue: true,
+		version:           yamlConfigVersion,
+		fargate:           true,
+		auditLogFile:      "/var/log/ecs-cli/audit.log",
+		auditLogGroup:     "ecs-cli-audit",
+	}
+
+	config, err := NewCommandConfig(context, rdwr)
+	require.NoError(t, err, "Unexpected error when cluster and region are specified as flags")
+	assert.Equal(t, "flag-cluster", config.Cluster, "Expected cluster to match flag")
+	assert.Equal(t, "us-east-1", config.Region(), "Expected region to match flag")
+	assert.Equal(t, LaunchTypeFargate, config.LaunchType, "Expected stored launch type to be kept")
+	assert.Equal(t, composeServiceNamePrefix, config.ComposeServiceNamePrefix, "Expected stored ComposeServiceNamePrefix to be kept")
+	assert.Equal(t, cfnStackName, config.CFNStackName, "Expected stored CFNStackName to be kept")
+
+	ecsConfig, err := loadLocalConfig(context, rdwr)
+	require.NoError(t, err, "Unexpected error loading config")
+	assert.Equal(t, "/var/log/ecs-cli/audit.log", ecsConfig.AuditLogFile, "Expected stored audit log file to be kept")
+	assert.Equal(t, "ecs-cli-audit", ecsConfig.AuditLogGroup, "Expected stored audit log group to be kept")
+}
+
+func TestNewCommandConfigStoredConfigErrorListsMissingFlags(t *testing.T) {
+	os.Setenv("AWS_ACCESS_KEY", "AKIDEXAMPLE")
+	os.Setenv("AWS_SECRET_KEY", "SECRET")
+	defer os.Clearenv()
+
+	rdwr := &mockReadWriter{err: errors.New("Cluster Configuration could not be found")}
+	_, err := NewCommandConfig(defaultConfig(), rdwr)
+	assert.Error(t, err, "Expected error when the stored config can't be read")
+	assert.Contains(t, err.Error(), "--cluster", "Expected error to list the missing flag")
+	assert.NotContains(t, err.Error(), "--region", "Expected error not to list the specified flag")
+}
+
+func configWithCluster(cluster string) *cli.Context {
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalContext := cli.NewContext(nil, globalSet, nil)
+	flagSet := flag.NewFlagSet("ecs-cli-ps", 0)
+	flagSet.String(flags.RegionFlag, "us-east-1", "")
+	flagSet.String(flags.ClusterFlag, cluster, "")
+	return cli.NewContext(nil, flagSet, globalContext)
+}
+
 func defaultConfig() *cli.Context {
 	globalSet := flag.NewFlagSet("ecs-cli", 0)
 	globalContext := cli.NewContext(nil, globalSet, nil)
@@ -381,6 +481,15 @@ func configWithLaunchType(launchType string) *cli.Context {
 	return cli.NewContext(nil, flagSet, globalContext)
 }
 
+func configWithCFNWaitMaxAttempts(maxAttempts string) *cli.Context {
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalContext := cli.NewContext(nil, globalSet, nil)
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String("region", "us-east-1", "")
+	flagSet.String(flags.CFNWaitMaxAttemptsFlag, maxAttempts, "")
+	return cli.NewContext(nil, flagSet, globalContext)
+}
+
 func setupTest(t *testing.T) (*cli.Context, *mockReadWriter) {
 	globalSet := flag.NewFlagSet("ecs-cli", 0)
 	globalContext := cli.NewContext(nil, globalSet, nil)
@@ -14,6 +14,7 @@
 package config
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"net/http"
@@ -220,6 +221,7 @@ func TestRegionWhenNoneSpecified(t *testing.T) {
 	ecsConfig.AWSSecretKey = awsSecretKey
 
 	// NOTE: no region set
+	defer mockInstanceMetadataRegion("", errors.New("EC2MetadataRequestError: failed to get EC2 instance identity document"))()
 
 	// invoke test and verify
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
@@ -228,6 +230,35 @@ func TestRegionWhenNoneSpecified(t *testing.T) {
 	assert.Error(t, err, "Expected error when region is not specified or resolved")
 }
 
+func TestRegionWhenUsingInstanceMetadata(t *testing.T) {
+	// defaults
+	os.Clearenv()
+	ecsConfig := NewLocalConfig(clusterName)
+	defer mockInstanceMetadataRegion("eu-central-1", nil)()
+
+	// invoke test and verify
+	testRegionInSession(t, ecsConfig, "eu-central-1")
+}
+
+func TestRegionInstanceMetadataNotUsedWithEnvVariable(t *testing.T) {
+	// defaults
+	os.Clearenv()
+	ecsConfig := NewLocalConfig(clusterName)
+	os.Setenv("AWS_REGION", "us-west-1")
+	defer os.Clearenv()
+	defer mockInstanceMetadataRegion("eu-central-1", nil)()
+
+	// invoke test and verify
+	testRegionInSession(t, ecsConfig, "us-west-1")
+}
+
+// mockInstanceMetadataRegion replaces the region of the EC2 instance metadata, until the returned function is called
+func mockInstanceMetadataRegion(region string, err error) func() {
+	original := getRegionFromInstanceMetadata
+	getRegionFromInstanceMetadata = func() (string, error) { return region, err }
+	return func() { getRegionFromInstanceMetadata = original }
+}
+
 func testRegionInSession(t *testing.T, inputConfig *LocalConfig, expectedRegion string) {
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 	context := cli.NewContext(nil, flagSet, nil)
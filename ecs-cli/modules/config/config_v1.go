@@ -18,10 +18,14 @@ import (
 	"os"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/interrupt"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
 
@@ -54,6 +58,13 @@ type LocalConfig struct {
 	CFNStackName             string
 	CFNStackNamePrefix       string // Deprecated; remains for backwards compatibility
 	DefaultLaunchType        string
+	AuditLogFile             string
+	AuditLogGroup            string
+	NotificationWebhookURL   string
+	NotificationTopicArn     string
+	DeployMetricsNamespace   string
+	DeployLockTable          string
+	InventoryTable           string
 }
 
 // Profile is a simple struct for storing a single AWS profile config
@@ -70,6 +81,13 @@ type Cluster struct {
 	ComposeServiceNamePrefix string `yaml:"compose-service-name-prefix,omitempty"`
 	CFNStackName             string `yaml:"cfn-stack-name,omitempty"`
 	DefaultLaunchType        string `yaml:"default_launch_type"`
+	AuditLogFile             string `yaml:"audit-log-file,omitempty"`
+	AuditLogGroup            string `yaml:"audit-log-group,omitempty"`
+	NotificationWebhookURL   string `yaml:"notification-webhook-url,omitempty"`
+	NotificationTopicArn     string `yaml:"notification-topic-arn,omitempty"`
+	DeployMetricsNamespace   string `yaml:"deploy-metrics-namespace,omitempty"`
+	DeployLockTable          string `yaml:"deploy-lock-table,omitempty"`
+	InventoryTable           string `yaml:"inventory-table,omitempty"`
 }
 
 // ClusterConfig is the top level struct representing the cluster config file
@@ -104,6 +122,7 @@ func NewLocalConfig(cluster string) *LocalConfig {
 //    a) --aws-profile flag
 //    b) AWS_PROFILE environment variable
 //    c) AWS_DEFAULT_PROFILE environment variable (defaults to 'default')
+//  5) EC2 Instance Metadata - attempts to use the region of the instance the CLI runs on
 //
 // Credentials: Order of resolution
 //  1) ECS CLI Profile Flags
@@ -141,6 +160,17 @@ func (cfg *LocalConfig) ToAWSSession(context *cli.Context) (*session.Session, er
 // The argument svcConfig is needed to allow important unit tests to work
 // (for example: assume role)
 func (cfg *LocalConfig) toAWSSessionWithConfig(context *cli.Context, svcConfig *aws.Config) (*session.Session, error) {
+	sess, err := cfg.newAWSSession(context, svcConfig)
+	if err != nil {
+		return nil, err
+	}
+	addCredentialsRefreshHandler(sess)
+	// requests issued after the command is interrupted wait for the user to decide what to do
+	sess.Handlers.Send.PushFrontNamed(interrupt.GateHandler)
+	return sess, nil
+}
+
+func (cfg *LocalConfig) newAWSSession(context *cli.Context, svcConfig *aws.Config) (*session.Session, error) {
 	region, err := cfg.getRegion()
 
 	if err != nil || region == "" {
@@ -174,6 +204,16 @@ func (cfg *LocalConfig) applyFlags(context *cli.Context) error {
 		return err
 	}
 
+	// The deploy lock table flag overrides the one stored in the local config
+	if lockTableFromFlag := RecursiveFlagSearch(context, flags.DeployLockTableFlag); lockTableFromFlag != "" {
+		cfg.DeployLockTable = lockTableFromFlag
+	}
+
+	// The inventory table flag overrides the one stored in the local config
+	if inventoryTableFromFlag := RecursiveFlagSearch(context, flags.InventoryTableFlag); inventoryTableFromFlag != "" {
+		cfg.InventoryTable = inventoryTableFromFlag
+	}
+
 	// Determine cluster
 	// Order of cluster resolution:
 	//  1) Inline flag
@@ -244,6 +284,8 @@ func sessionFromProfile(profile string, region string, svcConfig *aws.Config) (*
 		Config:            *svcConfig,
 		Profile:           profile,
 		SharedConfigState: session.SharedConfigEnable,
+		// prompts for the MFA token of profiles with mfa_serial when their role is assumed
+		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
 	})
 }
 
@@ -266,6 +308,8 @@ func sessionFromKeys(region string, awsAccess string, awsSecret string, sessionT
 //    a) --aws-profile flag
 //    b) AWS_PROFILE environment variable
 //    c) AWS_DEFAULT_PROFILE environment variable (defaults to 'default')
+//  5) EC2 Instance Metadata - attempts to use the region of the instance the CLI runs on, e.g. on
+//     EC2, CodeBuild or CloudShell, where credentials also come from the instance role
 func (cfg *LocalConfig) getRegion() (string, error) {
 	region := cfg.Region
 
@@ -284,9 +328,27 @@ func (cfg *LocalConfig) getRegion() (string, error) {
 		region, err = cfg.getRegionFromAWSProfile()
 	}
 
+	if region == "" && err == nil {
+		if region, err = getRegionFromInstanceMetadata(); err != nil {
+			logrus.Debugf("Unable to get the region from the EC2 instance metadata: %v", err)
+			return "", nil
+		}
+	}
+
 	return region, err
 }
 
+// getRegionFromInstanceMetadata returns the region of the EC2 instance the CLI runs on. The metadata
+// client fails within a few seconds elsewhere, or at once if AWS_EC2_METADATA_DISABLED is true.
+// Can be replaced in tests.
+var getRegionFromInstanceMetadata = func() (string, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return "", err
+	}
+	return ec2metadata.New(sess).Region()
+}
+
 func (cfg *LocalConfig) getRegionFromAWSProfile() (string, error) {
 	awsProfile := ""
 	if cfg.AWSProfile != "" {
@@ -0,0 +1,96 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	credentialsRefreshHandlerName = "ecscli.CredentialsRefreshHandler"
+
+	// provider names of the credentials that are read once and cannot be refreshed
+	envConfigProviderName    = "EnvConfigCredentials"
+	sharedConfigProviderName = "SharedConfigCredentials"
+)
+
+// addCredentialsRefreshHandler makes the requests of the session that fail because its credentials
+// expired, e.g. the polling of a long CloudFormation wait with assumed role credentials, retry once
+// with refreshed credentials. The SDK does not retry these errors, so the credentials would never be
+// refreshed. If the credentials cannot be refreshed, the request fails with an error that says so.
+func addCredentialsRefreshHandler(sess *session.Session) {
+	sess.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: credentialsRefreshHandlerName,
+		Fn:   refreshExpiredCredentials,
+	})
+}
+
+func refreshExpiredCredentials(r *request.Request) {
+	if !r.IsErrorExpired() || r.Config.Credentials == nil || r.Config.Credentials == credentials.AnonymousCredentials {
+		return
+	}
+	providerName := credentialsProviderName(r.Config.Credentials)
+	if r.RetryCount == 0 && isRefreshableProvider(providerName) {
+		// the core AfterRetry handler expires the credentials of retried requests, so that they are
+		// signed again with refreshed credentials
+		r.Retryable = aws.Bool(true)
+		return
+	}
+	r.Retryable = aws.Bool(false)
+	r.Error = expiredCredentialsError(providerName, r.Error)
+}
+
+// credentialsProviderName returns the name of the provider the credentials were last retrieved from
+func credentialsProviderName(creds *credentials.Credentials) string {
+	value, err := creds.Get()
+	if err != nil {
+		return ""
+	}
+	return value.ProviderName
+}
+
+// isRefreshableProvider returns false for the providers of static credentials, which return the same
+// credentials until the ECS CLI is run again
+func isRefreshableProvider(providerName string) bool {
+	switch providerName {
+	case credentials.StaticProviderName, credentials.EnvProviderName, envConfigProviderName, credentials.SharedCredsProviderName:
+		return false
+	}
+	return !strings.HasPrefix(providerName, sharedConfigProviderName)
+}
+
+func expiredCredentialsError(providerName string, err error) error {
+	code := request.ErrCodeRequestError
+	if aerr, ok := err.(awserr.Error); ok {
+		code = aerr.Code()
+	}
+	source := "the session"
+	if providerName != "" {
+		source = providerName
+	}
+	message := fmt.Sprintf("The AWS credentials from %s expired and could not be refreshed. ", source)
+	if isRefreshableProvider(providerName) {
+		message += "Check that the source credentials of the role are still valid."
+	} else {
+		message += "Use an AWS profile with role_arn and source_profile, whose credentials the ECS CLI refreshes, or credentials that last longer than the command."
+	}
+	return awserr.New(code, message, err)
+}
@@ -0,0 +1,124 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingProvider returns new credentials each time it is refreshed
+type countingProvider struct {
+	retrieved int
+	expired   bool
+}
+
+func (p *countingProvider) Retrieve() (credentials.Value, error) {
+	p.retrieved++
+	p.expired = false
+	return credentials.Value{
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "SECRET",
+		SessionToken:    "TOKEN",
+		ProviderName:    "AssumeRoleProvider",
+	}, nil
+}
+
+func (p *countingProvider) IsExpired() bool {
+	return p.expired
+}
+
+// expiredTokenServer fails the first expiredRequests requests with an ExpiredTokenException
+func expiredTokenServer(expiredRequests int) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= expiredRequests {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"ExpiredTokenException","message":"The security token included in the request is expired"}`))
+			return
+		}
+		w.Write([]byte(`{"clusterArns":[]}`))
+	}))
+	return server, &requests
+}
+
+func newCredentialsTestSession(t *testing.T, endpoint string, creds *credentials.Credentials) *session.Session {
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(region),
+		Endpoint:    aws.String(endpoint),
+		DisableSSL:  aws.Bool(true),
+		Credentials: creds,
+		MaxRetries:  aws.Int(3),
+	})
+	require.NoError(t, err, "Unexpected error creating the session")
+	addCredentialsRefreshHandler(sess)
+	return sess
+}
+
+func TestCredentialsRefreshedOnExpiredToken(t *testing.T) {
+	server, requests := expiredTokenServer(1)
+	defer server.Close()
+
+	provider := &countingProvider{}
+	sess := newCredentialsTestSession(t, server.URL, credentials.NewCredentials(provider))
+
+	_, err := ecs.New(sess).ListClusters(&ecs.ListClustersInput{})
+	assert.NoError(t, err, "Expected the request to succeed with refreshed credentials")
+	assert.Equal(t, 2, *requests, "Expected the request to be retried once")
+	assert.Equal(t, 2, provider.retrieved, "Expected the credentials to be refreshed")
+}
+
+func TestCredentialsRefreshedOnlyOnce(t *testing.T) {
+	server, requests := expiredTokenServer(3)
+	defer server.Close()
+
+	sess := newCredentialsTestSession(t, server.URL, credentials.NewCredentials(&countingProvider{}))
+
+	_, err := ecs.New(sess).ListClusters(&ecs.ListClustersInput{})
+	require.Error(t, err, "Expected error when the refreshed credentials are expired")
+	assert.Equal(t, 2, *requests, "Expected the request to be retried once")
+	assert.Equal(t, "ExpiredTokenException", err.(awserr.Error).Code())
+	assert.Contains(t, err.Error(), "AWS credentials from AssumeRoleProvider expired and could not be refreshed")
+}
+
+func TestStaticCredentialsNotRefreshed(t *testing.T) {
+	server, requests := expiredTokenServer(1)
+	defer server.Close()
+
+	sess := newCredentialsTestSession(t, server.URL, credentials.NewStaticCredentials("AKID", "SECRET", "TOKEN"))
+
+	_, err := ecs.New(sess).ListClusters(&ecs.ListClustersInput{})
+	require.Error(t, err, "Expected error when static credentials expire")
+	assert.Equal(t, 1, *requests, "Expected the request not to be retried")
+	assert.Contains(t, err.Error(), "AWS credentials from StaticProvider expired and could not be refreshed")
+	assert.Contains(t, err.Error(), "role_arn and source_profile")
+}
+
+func TestIsRefreshableProvider(t *testing.T) {
+	assert.True(t, isRefreshableProvider("AssumeRoleProvider"))
+	assert.True(t, isRefreshableProvider("EC2RoleProvider"))
+	assert.False(t, isRefreshableProvider(credentials.StaticProviderName))
+	assert.False(t, isRefreshableProvider(envConfigProviderName))
+	assert.False(t, isRefreshableProvider("SharedConfigCredentials: /home/user/.aws/credentials"))
+}
@@ -0,0 +1,216 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	saltSize = 16
+	keySize  = 32
+	// pbkdf2Iterations is the number of PBKDF2 iterations used to derive the profiles encryption key
+	pbkdf2Iterations = 100000
+)
+
+// Environment is the portable form of the ECS CLI configuration, used to export the configuration
+// of a machine and import it on another one. Profiles are only exported on request, and can be
+// encrypted with a passphrase.
+type Environment struct {
+	Version           string             `yaml:"version"`
+	DefaultCluster    string             `yaml:"default_cluster,omitempty"`
+	Clusters          map[string]Cluster `yaml:"clusters,omitempty"`
+	DefaultProfile    string             `yaml:"default_profile,omitempty"`
+	Profiles          map[string]Profile `yaml:"ecs_profiles,omitempty"`
+	EncryptedProfiles string             `yaml:"encrypted_ecs_profiles,omitempty"`
+}
+
+// Export returns the cluster configurations, and the profiles if includeProfiles is set
+func (rdwr *YAMLReadWriter) Export(includeProfiles bool) (*Environment, error) {
+	env := &Environment{Version: configVersion}
+
+	clusterPath := ConfigFilePath(rdwr.destination)
+	if _, err := os.Stat(clusterPath); err == nil {
+		clusterConfig, err := ReadClusterFile(clusterPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "Run 'ecs-cli configure migrate' to convert an old configuration file before exporting it")
+		}
+		env.DefaultCluster = clusterConfig.Default
+		env.Clusters = clusterConfig.Clusters
+	}
+
+	if includeProfiles {
+		profilePath := credentialsFilePath(rdwr.destination)
+		if _, err := os.Stat(profilePath); err == nil {
+			profileConfig, err := ReadCredFile(profilePath)
+			if err != nil {
+				return nil, err
+			}
+			env.DefaultProfile = profileConfig.Default
+			env.Profiles = profileConfig.Profiles
+		}
+	}
+
+	if len(env.Clusters) == 0 && len(env.Profiles) == 0 {
+		return nil, fmt.Errorf("No ECS CLI configuration found in %s", rdwr.destination.Path)
+	}
+	return env, nil
+}
+
+// Import saves the cluster configurations and profiles of the environment, replacing existing
+// configurations with the same names. The profiles must have been decrypted.
+func (rdwr *YAMLReadWriter) Import(env *Environment) error {
+	if env.EncryptedProfiles != "" {
+		return errors.New("The profiles must be decrypted before they are imported")
+	}
+	for name, cluster := range env.Clusters {
+		cluster := cluster
+		if err := ValidateLaunchType(cluster.DefaultLaunchType); err != nil {
+			return errors.Wrapf(err, "Invalid cluster configuration %s", name)
+		}
+		if err := rdwr.SaveCluster(name, &cluster); err != nil {
+			return err
+		}
+	}
+	if env.DefaultCluster != "" {
+		if err := rdwr.SetDefaultCluster(env.DefaultCluster); err != nil {
+			return err
+		}
+	}
+	for name, profile := range env.Profiles {
+		profile := profile
+		if err := rdwr.SaveProfile(name, &profile); err != nil {
+			return err
+		}
+	}
+	if env.DefaultProfile != "" {
+		if err := rdwr.SetDefaultProfile(env.DefaultProfile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EncryptProfiles replaces the profiles of the environment with their encryption with the passphrase
+func (env *Environment) EncryptProfiles(passphrase string) error {
+	if passphrase == "" {
+		return errors.New("A passphrase is required to encrypt the profiles")
+	}
+	plaintext, err := yaml.Marshal(env.Profiles)
+	if err != nil {
+		return err
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return err
+	}
+	gcm, err := newProfilesCipher(passphrase, salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	// the salt and nonce are stored in front of the ciphertext, they are needed to decrypt it
+	sealed := append(append(salt, nonce...), gcm.Seal(nil, nonce, plaintext, nil)...)
+	env.EncryptedProfiles = base64.StdEncoding.EncodeToString(sealed)
+	env.Profiles = nil
+	return nil
+}
+
+// DecryptProfiles replaces the encrypted profiles of the environment with their decryption with the passphrase
+func (env *Environment) DecryptProfiles(passphrase string) error {
+	if env.EncryptedProfiles == "" {
+		return nil
+	}
+	if passphrase == "" {
+		return errors.New("A passphrase is required to decrypt the profiles")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(env.EncryptedProfiles)
+	if err != nil {
+		return errors.Wrap(err, "Unable to decode the encrypted profiles")
+	}
+	if len(sealed) < saltSize {
+		return errors.New("The encrypted profiles are truncated")
+	}
+	gcm, err := newProfilesCipher(passphrase, sealed[:saltSize])
+	if err != nil {
+		return err
+	}
+	sealed = sealed[saltSize:]
+	if len(sealed) < gcm.NonceSize() {
+		return errors.New("The encrypted profiles are truncated")
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return errors.New("Unable to decrypt the profiles; check the passphrase")
+	}
+
+	profiles := make(map[string]Profile)
+	if err := yaml.Unmarshal(plaintext, &profiles); err != nil {
+		return errors.Wrap(err, "Unable to parse the decrypted profiles")
+	}
+	env.Profiles = profiles
+	env.EncryptedProfiles = ""
+	return nil
+}
+
+func newProfilesCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(pbkdf2SHA256([]byte(passphrase), salt, pbkdf2Iterations, keySize))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// pbkdf2SHA256 derives a key from the passphrase as specified by RFC 8018, since
+// golang.org/x/crypto/pbkdf2 is not vendored
+func pbkdf2SHA256(passphrase, salt []byte, iterations, keyLen int) []byte {
+	prf := hmac.New(sha256.New, passphrase)
+	var key []byte
+	for block := uint32(1); len(key) < keyLen; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		counter := make([]byte, 4)
+		binary.BigEndian.PutUint32(counter, block)
+		prf.Write(counter)
+		u := prf.Sum(nil)
+		t := append([]byte(nil), u...)
+		for i := 1; i < iterations; i++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		key = append(key, t...)
+	}
+	return key[:keyLen]
+}
@@ -0,0 +1,115 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package config
+
+import (
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPBKDF2SHA256(t *testing.T) {
+	// test vectors from RFC 7914, section 11
+	assert.Equal(t, "120fb6cffcf8b32c43e7225256c4f837a86548c92ccc35480805987cb70be17b", hex.EncodeToString(pbkdf2SHA256([]byte("password"), []byte("salt"), 1, 32)))
+	assert.Equal(t, "ae4d0c95af6b46d32d0adff928f06dd02a303f8ef3c251dfd6e2d85a95474c43", hex.EncodeToString(pbkdf2SHA256([]byte("password"), []byte("salt"), 2, 32)))
+}
+
+func TestEncryptDecryptProfiles(t *testing.T) {
+	profiles := map[string]Profile{
+		"default": {AWSAccessKey: "AKID", AWSSecretKey: "SECRET"},
+	}
+	env := &Environment{Profiles: profiles}
+
+	err := env.EncryptProfiles("correct horse")
+	require.NoError(t, err, "Unexpected error encrypting profiles")
+	assert.Nil(t, env.Profiles, "Expected plain text profiles to be removed")
+	assert.NotEmpty(t, env.EncryptedProfiles, "Expected encrypted profiles")
+	assert.NotContains(t, env.EncryptedProfiles, "SECRET")
+
+	encrypted := env.EncryptedProfiles
+	err = env.DecryptProfiles("battery staple")
+	assert.Error(t, err, "Expected error decrypting with the wrong passphrase")
+	assert.Equal(t, encrypted, env.EncryptedProfiles, "Expected encrypted profiles to be kept")
+
+	err = env.DecryptProfiles("correct horse")
+	require.NoError(t, err, "Unexpected error decrypting profiles")
+	assert.Equal(t, profiles, env.Profiles, "Expected decrypted profiles to match")
+	assert.Empty(t, env.EncryptedProfiles, "Expected encrypted profiles to be removed")
+}
+
+func TestEncryptProfilesWithoutPassphrase(t *testing.T) {
+	env := &Environment{Profiles: map[string]Profile{"default": {AWSAccessKey: "AKID"}}}
+	assert.Error(t, env.EncryptProfiles(""), "Expected error without passphrase")
+}
+
+func TestExportImport(t *testing.T) {
+	source, err := newMockDestination()
+	require.NoError(t, err, "Error creating mock config destination")
+	defer os.RemoveAll(source.Path)
+
+	sourceParser := &YAMLReadWriter{destination: source}
+	require.NoError(t, sourceParser.SaveCluster("dev", &Cluster{Cluster: "dev-cluster", Region: testRegion}))
+	require.NoError(t, sourceParser.SaveCluster("prod", &Cluster{Cluster: "prod-cluster", Region: testRegion, DefaultLaunchType: LaunchTypeFargate}))
+	require.NoError(t, sourceParser.SetDefaultCluster("prod"))
+	require.NoError(t, sourceParser.SaveProfile("ci", &Profile{AWSAccessKey: "AKID", AWSSecretKey: "SECRET"}))
+
+	env, err := sourceParser.Export(false)
+	require.NoError(t, err, "Unexpected error exporting configuration")
+	assert.Equal(t, "prod", env.DefaultCluster)
+	assert.Len(t, env.Clusters, 2)
+	assert.Empty(t, env.Profiles, "Expected profiles not to be exported by default")
+
+	env, err = sourceParser.Export(true)
+	require.NoError(t, err, "Unexpected error exporting configuration")
+	assert.Equal(t, "ci", env.DefaultProfile)
+
+	target, err := newMockDestination()
+	require.NoError(t, err, "Error creating mock config destination")
+	defer os.RemoveAll(target.Path)
+
+	targetParser := &YAMLReadWriter{destination: target}
+	require.NoError(t, targetParser.SaveCluster("dev", &Cluster{Cluster: "old-cluster", Region: "us-east-1"}))
+	require.NoError(t, targetParser.Import(env), "Unexpected error importing configuration")
+
+	localConfig, err := targetParser.Get("", "")
+	require.NoError(t, err, "Unexpected error reading imported configuration")
+	assert.Equal(t, "prod-cluster", localConfig.Cluster, "Expected default cluster to be imported")
+	assert.Equal(t, LaunchTypeFargate, localConfig.DefaultLaunchType, "Expected default launch type to be imported")
+	assert.Equal(t, "AKID", localConfig.AWSAccessKey, "Expected default profile to be imported")
+
+	localConfig, err = targetParser.Get("dev", "")
+	require.NoError(t, err, "Unexpected error reading imported configuration")
+	assert.Equal(t, "dev-cluster", localConfig.Cluster, "Expected existing cluster configuration to be replaced")
+}
+
+func TestExportWithoutConfiguration(t *testing.T) {
+	dest, err := newMockDestination()
+	require.NoError(t, err, "Error creating mock config destination")
+	defer os.RemoveAll(dest.Path)
+
+	_, err = (&YAMLReadWriter{destination: dest}).Export(true)
+	assert.Error(t, err, "Expected error when nothing is configured")
+}
+
+func TestImportEncryptedProfiles(t *testing.T) {
+	dest, err := newMockDestination()
+	require.NoError(t, err, "Error creating mock config destination")
+	defer os.RemoveAll(dest.Path)
+
+	err = (&YAMLReadWriter{destination: dest}).Import(&Environment{EncryptedProfiles: "c2VjcmV0"})
+	assert.Error(t, err, "Expected error importing profiles that are still encrypted")
+}
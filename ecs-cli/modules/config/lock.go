@@ -0,0 +1,130 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const lockFileSuffix = ".lock"
+
+var (
+	// lockRetryDelay is the time to wait between attempts to acquire a config file lock
+	lockRetryDelay = 100 * time.Millisecond
+	// lockTimeout is the total time to wait for a config file lock before giving up
+	lockTimeout = 30 * time.Second
+	// staleLockAge is the age after which a lock file is assumed to be left behind by a
+	// process that exited without releasing it
+	staleLockAge = 2 * time.Minute
+)
+
+// fileLock is an advisory lock on a config file. The lock is held by creating a
+// lock file next to the config file; only one process can create it at a time.
+type fileLock struct {
+	path string
+}
+
+// lockFile acquires the advisory lock for the file at path, retrying until
+// lockTimeout elapses if another process holds it.
+func lockFile(path string) (*fileLock, error) {
+	lockPath := path + lockFileSuffix
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, configFileMode)
+		if err == nil {
+			fmt.Fprintf(f, "%d", os.Getpid())
+			f.Close()
+			return &fileLock{path: lockPath}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if info, statErr := os.Stat(lockPath); statErr == nil && time.Since(info.ModTime()) > staleLockAge {
+			breakStaleLock(lockPath, info)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("Timed out waiting for lock on %s. If no other ecs-cli process is running, remove %s", path, lockPath)
+		}
+		logrus.Debugf("Waiting for lock on %s", path)
+		time.Sleep(lockRetryDelay)
+	}
+}
+
+// breakStaleLock removes the stale lock file described by stale. The lock file is first
+// renamed to a name unique to this process, so that when several processes find the same
+// stale lock only one of them takes it. If the file taken is not the stale one, because the
+// lock was broken and acquired again in the meantime, it is put back instead of removed.
+func breakStaleLock(lockPath string, stale os.FileInfo) {
+	brokenPath := fmt.Sprintf("%s.stale-%d-%d", lockPath, os.Getpid(), time.Now().UnixNano())
+	if err := os.Rename(lockPath, brokenPath); err != nil {
+		// Another process broke the lock first
+		return
+	}
+	defer os.Remove(brokenPath)
+
+	// The modification time is compared too, since a new lock file may reuse the inode of the stale one
+	if info, err := os.Stat(brokenPath); err == nil && os.SameFile(info, stale) && info.ModTime().Equal(stale.ModTime()) {
+		logrus.Warnf("Removed stale lock file %s", lockPath)
+		return
+	}
+	// Linking fails if yet another lock file was created, rather than replacing it
+	if err := os.Link(brokenPath, lockPath); err != nil {
+		logrus.Warnf("Unable to restore lock file %s: %v", lockPath, err)
+	}
+}
+
+// unlock releases the lock.
+func (l *fileLock) unlock() {
+	if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+		logrus.Warnf("Unable to remove lock file %s: %v", l.path, err)
+	}
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory as path and
+// renames it over path, so that readers never observe a partially written file.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+"."+strconv.Itoa(os.Getpid())+"-")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+
+	if _, err = tmp.Write(data); err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmpPath, perm)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockFileTimesOutWhenHeld(t *testing.T) {
+	oldTimeout, oldDelay := lockTimeout, lockRetryDelay
+	lockTimeout, lockRetryDelay = 50*time.Millisecond, 10*time.Millisecond
+	defer func() {
+		lockTimeout, lockRetryDelay = oldTimeout, oldDelay
+	}()
+
+	dir, err := ioutil.TempDir("", "ecs-cli-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, clusterConfigFileName)
+
+	lock, err := lockFile(path)
+	assert.NoError(t, err, "Unexpected error acquiring lock")
+
+	_, err = lockFile(path)
+	assert.Error(t, err, "Expected error acquiring lock which is already held")
+
+	lock.unlock()
+	lock, err = lockFile(path)
+	assert.NoError(t, err, "Expected lock to be acquired after it was released")
+	lock.unlock()
+}
+
+func TestLockFileRemovesStaleLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ecs-cli-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, clusterConfigFileName)
+
+	lockPath := path + lockFileSuffix
+	assert.NoError(t, ioutil.WriteFile(lockPath, []byte("1"), configFileMode))
+	staleTime := time.Now().Add(-2 * staleLockAge)
+	assert.NoError(t, os.Chtimes(lockPath, staleTime, staleTime))
+
+	lock, err := lockFile(path)
+	assert.NoError(t, err, "Expected stale lock to be replaced")
+	lock.unlock()
+}
+
+func TestBreakStaleLockKeepsReacquiredLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ecs-cli-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	lockPath := filepath.Join(dir, clusterConfigFileName) + lockFileSuffix
+
+	assert.NoError(t, ioutil.WriteFile(lockPath, []byte("1"), configFileMode))
+	staleTime := time.Now().Add(-2 * staleLockAge)
+	assert.NoError(t, os.Chtimes(lockPath, staleTime, staleTime))
+	stale, err := os.Stat(lockPath)
+	assert.NoError(t, err)
+
+	// Another process breaks the stale lock and acquires it before this one gets to it
+	assert.NoError(t, os.Remove(lockPath))
+	assert.NoError(t, ioutil.WriteFile(lockPath, []byte("2"), configFileMode))
+
+	breakStaleLock(lockPath, stale)
+	data, err := ioutil.ReadFile(lockPath)
+	assert.NoError(t, err, "Expected lock acquired by the other process to be kept")
+	assert.Equal(t, "2", string(data))
+
+	breakStaleLock(lockPath, stale)
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1, "Expected no renamed lock file to be left behind")
+
+	current, err := os.Stat(lockPath)
+	assert.NoError(t, err)
+	breakStaleLock(lockPath, current)
+	_, err = os.Stat(lockPath)
+	assert.True(t, os.IsNotExist(err), "Expected stale lock file to be removed")
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ecs-cli-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, clusterConfigFileName)
+
+	assert.NoError(t, writeFileAtomic(path, []byte("first"), configFileMode))
+	assert.NoError(t, writeFileAtomic(path, []byte("second"), configFileMode))
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "second", string(data))
+
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1, "Expected temporary files to be cleaned up")
+}
@@ -19,6 +19,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/schema"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
@@ -87,6 +88,9 @@ func ReadClusterFile(path string) (*ClusterConfig, error) {
 	if err = yaml.Unmarshal(dat, &config); err != nil {
 		return nil, errors.Wrap(err, "Failed to parse yaml file: "+path)
 	}
+	if err = schema.Validate(schema.ClusterConfig, dat); err != nil {
+		return nil, errors.Wrap(err, "Invalid config file: "+path)
+	}
 
 	return &config, nil
 }
@@ -101,6 +105,9 @@ func ReadCredFile(path string) (*ProfileConfig, error) {
 	if err = yaml.Unmarshal(dat, &config); err != nil {
 		return nil, errors.Wrap(err, "Failed to parse yaml file: "+path)
 	}
+	if err = schema.Validate(schema.Credentials, dat); err != nil {
+		return nil, errors.Wrap(err, "Invalid credentials file: "+path)
+	}
 
 	return &config, nil
 }
@@ -129,6 +136,13 @@ func readClusterConfig(path string, clusterConfigKey string, localConfig *LocalC
 	localConfig.ComposeServiceNamePrefix = cluster.ComposeServiceNamePrefix
 	localConfig.CFNStackName = cluster.CFNStackName
 	localConfig.DefaultLaunchType = cluster.DefaultLaunchType
+	localConfig.AuditLogFile = cluster.AuditLogFile
+	localConfig.AuditLogGroup = cluster.AuditLogGroup
+	localConfig.NotificationWebhookURL = cluster.NotificationWebhookURL
+	localConfig.NotificationTopicArn = cluster.NotificationTopicArn
+	localConfig.DeployMetricsNamespace = cluster.DeployMetricsNamespace
+	localConfig.DeployLockTable = cluster.DeployLockTable
+	localConfig.InventoryTable = cluster.InventoryTable
 	// Fields must be explicitly set as empty because the iniReadWriter will set them to default
 	localConfig.ComposeProjectNamePrefix = ""
 	localConfig.CFNStackNamePrefix = ""
@@ -207,20 +221,9 @@ func (rdwr *YAMLReadWriter) saveConfig(path string, config interface{}) error {
 		return errors.Wrap(err, "Error saving config file")
 	}
 
-	destMode := rdwr.destination.Mode
-	if err = os.MkdirAll(rdwr.destination.Path, *destMode); err != nil {
-		return err
-	}
-
-	// If config file exists, set permissions first, because we may be writing creds.
-	if _, err = os.Stat(path); err == nil {
-		if err = os.Chmod(path, configFileMode); err != nil {
-			logrus.Errorf("Unable to chmod %s to mode %s", path, configFileMode)
-			return err
-		}
-	}
-
-	if err = ioutil.WriteFile(path, data, configFileMode.Perm()); err != nil {
+	// Write to a temporary file and rename it so that concurrent readers never see a partial file.
+	// The temporary file is created with configFileMode, because we may be writing creds.
+	if err = writeFileAtomic(path, data, configFileMode.Perm()); err != nil {
 		logrus.Errorf("Unable to write configuration to %s", path)
 		return err
 	}
@@ -228,10 +231,27 @@ func (rdwr *YAMLReadWriter) saveConfig(path string, config interface{}) error {
 	return nil
 }
 
+// lock acquires the advisory lock on the config file at path. It must be held for the
+// whole read-modify-write cycle so that concurrent ecs-cli processes do not overwrite
+// each other's changes.
+func (rdwr *YAMLReadWriter) lock(path string) (*fileLock, error) {
+	destMode := rdwr.destination.Mode
+	if err := os.MkdirAll(rdwr.destination.Path, *destMode); err != nil {
+		return nil, err
+	}
+	return lockFile(path)
+}
+
 // SaveProfile saves a single credential configuration
 func (rdwr *YAMLReadWriter) SaveProfile(configName string, profile *Profile) error {
 	path := credentialsFilePath(rdwr.destination)
 
+	lock, err := rdwr.lock(path)
+	if err != nil {
+		return err
+	}
+	defer lock.unlock()
+
 	config := &ProfileConfig{Profiles: make(map[string]Profile), Version: configVersion}
 	if _, err := os.Stat(path); err == nil {
 		// an existing config file is there
@@ -254,6 +274,12 @@ func (rdwr *YAMLReadWriter) SaveProfile(configName string, profile *Profile) err
 func (rdwr *YAMLReadWriter) SaveCluster(configName string, cluster *Cluster) error {
 	path := ConfigFilePath(rdwr.destination)
 
+	lock, err := rdwr.lock(path)
+	if err != nil {
+		return err
+	}
+	defer lock.unlock()
+
 	// if no err on read- then existing yaml config
 	config, err := ReadClusterFile(path)
 	if err != nil {
@@ -273,6 +299,12 @@ func (rdwr *YAMLReadWriter) SaveCluster(configName string, cluster *Cluster) err
 // SetDefaultProfile updates which set of credentials is defined as default
 func (rdwr *YAMLReadWriter) SetDefaultProfile(configName string) error {
 	path := credentialsFilePath(rdwr.destination)
+
+	lock, err := rdwr.lock(path)
+	if err != nil {
+		return err
+	}
+	defer lock.unlock()
 	config, err := ReadCredFile(path)
 	if err != nil {
 		return err
@@ -291,6 +323,12 @@ func (rdwr *YAMLReadWriter) SetDefaultProfile(configName string) error {
 // SetDefaultCluster updates which cluster configuration is default
 func (rdwr *YAMLReadWriter) SetDefaultCluster(configName string) error {
 	path := ConfigFilePath(rdwr.destination)
+
+	lock, err := rdwr.lock(path)
+	if err != nil {
+		return err
+	}
+	defer lock.unlock()
 	config, err := ReadClusterFile(path)
 	if err != nil {
 		return err
@@ -14,8 +14,10 @@
 package config
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
@@ -202,6 +204,32 @@ ecs_profiles:
 	assert.Equal(t, yamlConfigVersion, config.Version, "Expected yaml config version to be set.")
 }
 
+func TestReadClusterFileUnknownField(t *testing.T) {
+	configContents := `default: Default
+clusters:
+  Default:
+    cluster: cli-demo
+    region: us-west-1
+    cfn_stack_name: cli-demo-stack
+`
+
+	dest, err := newMockDestination()
+	assert.NoError(t, err, "Error creating mock config destination")
+
+	err = os.MkdirAll(dest.Path, *dest.Mode)
+	assert.NoError(t, err, "Could not create config directory")
+
+	defer os.RemoveAll(dest.Path)
+
+	err = ioutil.WriteFile(dest.Path+"/"+clusterConfigFileName, []byte(configContents), *dest.Mode)
+	assert.NoError(t, err)
+
+	_, err = ReadClusterFile(dest.Path + "/" + clusterConfigFileName)
+	if assert.Error(t, err, "Expected an error for an unknown field") {
+		assert.Contains(t, err.Error(), "clusters.Default.cfn_stack_name: Additional property cfn_stack_name is not allowed")
+	}
+}
+
 func TestReadClusterConfigFileNoLaunchType(t *testing.T) {
 	configContents := `default: prod_config
 clusters:
@@ -345,3 +373,30 @@ cfn-stack-name-prefix = amazon-ecs-cli-setup-
 	assert.Equal(t, yamlConfigVersion, readConfig.Version, "Expected yaml config version to be set.")
 	assert.Empty(t, readConfig.DefaultLaunchType, "Expected launch type to be empty.")
 }
+
+func TestSaveClusterConcurrently(t *testing.T) {
+	dest, err := newMockDestination()
+	assert.NoError(t, err, "Error creating mock config destination")
+	defer os.RemoveAll(dest.Path)
+
+	parser := setupParser(t, dest, false)
+
+	var wg sync.WaitGroup
+	numConfigs := 10
+	for i := 0; i < numConfigs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cluster := &Cluster{Cluster: fmt.Sprintf("cluster-%d", i), Region: testRegion}
+			assert.NoError(t, parser.SaveCluster(fmt.Sprintf("config-%d", i), cluster), "Error saving cluster config")
+		}(i)
+	}
+	wg.Wait()
+
+	config, err := ReadClusterFile(ConfigFilePath(dest))
+	assert.NoError(t, err, "Error reading config")
+	assert.Len(t, config.Clusters, numConfigs, "Expected every concurrently saved cluster config to be present")
+
+	_, err = os.Stat(ConfigFilePath(dest) + lockFileSuffix)
+	assert.True(t, os.IsNotExist(err), "Expected lock file to be removed")
+}
@@ -0,0 +1,143 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package audit records every mutating AWS API call made by the CLI to an
+// append-only audit log.
+package audit
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/redact"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/sirupsen/logrus"
+)
+
+// Results recorded for an audited call
+const (
+	ResultSuccess = "SUCCESS"
+	ResultFailure = "FAILURE"
+)
+
+// readOperationPrefixes are the API operation name prefixes which only read AWS resources. Every
+// other operation is audited, so that a new write API, e.g. Attach, Terminate or Publish, is never
+// missed.
+var readOperationPrefixes = []string{
+	"BatchCheck",
+	"BatchGet",
+	"Describe",
+	"Filter",
+	"Get",
+	"List",
+	"Lookup",
+	"Search",
+	"Validate",
+}
+
+// Record is a single entry in the audit log.
+type Record struct {
+	Timestamp  time.Time   `json:"timestamp"`
+	Operation  string      `json:"operation"`
+	Region     string      `json:"region,omitempty"`
+	Parameters interface{} `json:"parameters"`
+	Caller     string      `json:"caller,omitempty"`
+	Account    string      `json:"account,omitempty"`
+	Result     string      `json:"result"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// Sink is a destination for audit records.
+type Sink interface {
+	Write(record *Record) error
+}
+
+// callerIdentity resolves the identity of the caller once per process.
+type callerIdentity struct {
+	once    sync.Once
+	client  stsiface.STSAPI
+	arn     string
+	account string
+}
+
+func (c *callerIdentity) get() (string, string) {
+	c.once.Do(func() {
+		output, err := c.client.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+		if err != nil {
+			logrus.Warnf("Unable to determine caller identity for audit log: %v", err)
+			return
+		}
+		c.arn = aws.StringValue(output.Arn)
+		c.account = aws.StringValue(output.Account)
+	})
+	return c.arn, c.account
+}
+
+// Enable adds a handler to the session which writes a record to the sinks for
+// every mutating API call made by clients created from the session.
+func Enable(sess *session.Session, sinks ...Sink) {
+	// The STS client is created from a fresh session so that it does not inherit the audit handler
+	identity := &callerIdentity{client: sts.New(sess.Copy())}
+	sess.Handlers.Complete.PushBackNamed(handler(sinks, identity))
+}
+
+func handler(sinks []Sink, identity *callerIdentity) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "ECSCLIAuditHandler",
+		Fn: func(r *request.Request) {
+			if r.Operation == nil || !IsMutating(r.Operation.Name) {
+				return
+			}
+			record := &Record{
+				Timestamp: time.Now().UTC(),
+				Operation: r.ClientInfo.ServiceName + ":" + r.Operation.Name,
+				Region:    aws.StringValue(r.Config.Region),
+				Result:    ResultSuccess,
+			}
+			// the parameters are recorded with secrets redacted, e.g. the environment variables
+			// of a task definition or the value of a Secrets Manager secret
+			parameters, err := redact.Request(r.Params)
+			if err != nil {
+				logrus.Errorf("Unable to redact the parameters of %s for the audit log: %v", record.Operation, err)
+				parameters = redact.Placeholder
+			}
+			record.Parameters = parameters
+			record.Caller, record.Account = identity.get()
+			if r.Error != nil {
+				record.Result = ResultFailure
+				record.Error = r.Error.Error()
+			}
+			for _, sink := range sinks {
+				if err := sink.Write(record); err != nil {
+					logrus.Errorf("Unable to write audit record for %s: %v", record.Operation, err)
+				}
+			}
+		},
+	}
+}
+
+// IsMutating returns true if the API operation may change AWS resources, i.e. it is not known to
+// only read them.
+func IsMutating(operation string) bool {
+	for _, prefix := range readOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,200 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	mock_stsiface "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts/mock/sdk"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testCallerArn = "arn:aws:iam::123456789012:user/deployer"
+	testAccountID = "123456789012"
+)
+
+type recordingSink struct {
+	records []*Record
+}
+
+func (s *recordingSink) Write(record *Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+// newTestSession returns a session whose requests never leave the process
+func newTestSession(sendErr error) *session.Session {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+	sess.Handlers.Send.Clear()
+	sess.Handlers.Send.PushBack(func(r *request.Request) {
+		if sendErr != nil {
+			r.Error = sendErr
+			r.Retryable = aws.Bool(false)
+			return
+		}
+		r.HTTPResponse = &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader("{}")),
+		}
+	})
+	return sess
+}
+
+func setupHandler(t *testing.T, sess *session.Session) (*recordingSink, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockSTS := mock_stsiface.NewMockSTSAPI(ctrl)
+	mockSTS.EXPECT().GetCallerIdentity(gomock.Any()).Return(&sts.GetCallerIdentityOutput{
+		Arn:     aws.String(testCallerArn),
+		Account: aws.String(testAccountID),
+	}, nil).MaxTimes(1)
+
+	sink := &recordingSink{}
+	sess.Handlers.Complete.PushBackNamed(handler([]Sink{sink}, &callerIdentity{client: mockSTS}))
+	return sink, ctrl
+}
+
+func TestHandlerRecordsMutatingCalls(t *testing.T) {
+	sess := newTestSession(nil)
+	sink, ctrl := setupHandler(t, sess)
+	defer ctrl.Finish()
+
+	client := ecs.New(sess)
+	_, err := client.ListClusters(&ecs.ListClustersInput{})
+	assert.NoError(t, err, "Unexpected error listing clusters")
+	_, err = client.CreateCluster(&ecs.CreateClusterInput{ClusterName: aws.String("test-cluster")})
+	assert.NoError(t, err, "Unexpected error creating cluster")
+	_, err = client.DeleteCluster(&ecs.DeleteClusterInput{Cluster: aws.String("test-cluster")})
+	assert.NoError(t, err, "Unexpected error deleting cluster")
+
+	assert.Len(t, sink.records, 2, "Expected only mutating calls to be recorded")
+	record := sink.records[0]
+	assert.Equal(t, "ecs:CreateCluster", record.Operation)
+	assert.Equal(t, "us-west-2", record.Region)
+	assert.Equal(t, testCallerArn, record.Caller)
+	assert.Equal(t, testAccountID, record.Account)
+	assert.Equal(t, ResultSuccess, record.Result)
+	assert.Equal(t, "test-cluster", record.Parameters.(map[string]interface{})["ClusterName"])
+	assert.Equal(t, "ecs:DeleteCluster", sink.records[1].Operation)
+}
+
+func TestHandlerRedactsSecrets(t *testing.T) {
+	sess := newTestSession(nil)
+	sink, ctrl := setupHandler(t, sess)
+	defer ctrl.Finish()
+
+	_, err := secretsmanager.New(sess).CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String("registry"),
+		SecretString: aws.String(`{"username":"deployer","password":"hunter2"}`),
+	})
+	assert.NoError(t, err, "Unexpected error creating secret")
+	_, err = ecs.New(sess).RegisterTaskDefinition(&ecs.RegisterTaskDefinitionInput{
+		Family: aws.String("hello"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{{
+			Name: aws.String("web"),
+			Environment: []*ecs.KeyValuePair{
+				{Name: aws.String("DB_PASSWORD"), Value: aws.String("hunter2")},
+				{Name: aws.String("PORT"), Value: aws.String("8080")},
+			},
+		}},
+	})
+	assert.NoError(t, err, "Unexpected error registering task definition")
+
+	assert.Len(t, sink.records, 2)
+	secret := sink.records[0].Parameters.(map[string]interface{})
+	assert.Equal(t, "registry", secret["Name"])
+	assert.NotContains(t, secret, "SecretString", "Expected the value of the secret to be dropped")
+
+	data, err := json.Marshal(sink.records[1].Parameters)
+	assert.NoError(t, err, "Unexpected error marshalling parameters")
+	assert.NotContains(t, string(data), "hunter2", "Expected the sensitive environment variable to be redacted")
+	assert.Contains(t, string(data), "8080", "Expected the other environment variables to be recorded")
+}
+
+func TestHandlerRecordsFailedCalls(t *testing.T) {
+	sess := newTestSession(errors.New("something went wrong"))
+	sink, ctrl := setupHandler(t, sess)
+	defer ctrl.Finish()
+
+	_, err := ecs.New(sess).CreateCluster(&ecs.CreateClusterInput{ClusterName: aws.String("test-cluster")})
+	assert.Error(t, err, "Expected error creating cluster")
+
+	assert.Len(t, sink.records, 1)
+	assert.Equal(t, ResultFailure, sink.records[0].Result)
+	assert.Contains(t, sink.records[0].Error, "something went wrong")
+}
+
+func TestIsMutating(t *testing.T) {
+	mutating := []string{
+		"AssociateRouteTable",
+		"AttachRolePolicy",
+		"AuthorizeSecurityGroupIngress",
+		"ChangeResourceRecordSets",
+		"CreateService",
+		"DeleteCluster",
+		"DeregisterContainerInstance",
+		"ModifyLaunchTemplate",
+		"Publish",
+		"PutLogEvents",
+		"RegisterTaskDefinition",
+		"RegisterScalableTarget",
+		"ResumeProcesses",
+		"RunTask",
+		"SetDesiredCapacity",
+		"StartDeployment",
+		"StopTask",
+		"SubmitTaskStateChange",
+		"SuspendProcesses",
+		"TagResource",
+		"TerminateInstanceInAutoScalingGroup",
+		"TerminateInstances",
+		"UntagResource",
+		"UpdateService",
+	}
+	for _, operation := range mutating {
+		assert.True(t, IsMutating(operation), "Expected %s to be audited", operation)
+	}
+
+	reads := []string{
+		"BatchCheckLayerAvailability",
+		"BatchGetImage",
+		"DescribeServices",
+		"FilterLogEvents",
+		"GetCallerIdentity",
+		"ListTasks",
+		"LookupEvents",
+		"SearchResources",
+		"ValidateTemplate",
+	}
+	for _, operation := range reads {
+		assert.False(t, IsMutating(operation), "Expected %s not to be audited", operation)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+)
+
+const auditFileMode = os.FileMode(0600) // Owner=read/write, Other=None
+
+// fileSink appends records as lines of JSON to a local file.
+type fileSink struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileSink creates a Sink which appends records to the file at path.
+func NewFileSink(path string) Sink {
+	return &fileSink{path: path}
+}
+
+func (s *fileSink) Write(record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The file is opened in append mode for every record, so that concurrent
+	// ecs-cli processes do not overwrite each other's records.
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, auditFileMode)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// cloudWatchLogsSink writes records to a log stream in CloudWatch Logs. A new
+// stream is created for every invocation of the CLI.
+type cloudWatchLogsSink struct {
+	mu            sync.Mutex
+	client        cloudwatchlogsiface.CloudWatchLogsAPI
+	logGroup      string
+	logStream     string
+	streamCreated bool
+	sequenceToken *string
+}
+
+// NewCloudWatchLogsSink creates a Sink which writes records to the existing log group.
+func NewCloudWatchLogsSink(sess *session.Session, logGroup string) Sink {
+	// The client is created from a fresh session so that it does not inherit the audit handler
+	client := cloudwatchlogs.New(sess.Copy())
+	return newCloudWatchLogsSink(client, logGroup, defaultLogStreamName())
+}
+
+func newCloudWatchLogsSink(client cloudwatchlogsiface.CloudWatchLogsAPI, logGroup, logStream string) *cloudWatchLogsSink {
+	return &cloudWatchLogsSink{
+		client:    client,
+		logGroup:  logGroup,
+		logStream: logStream,
+	}
+}
+
+func defaultLogStreamName() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("ecs-cli/%s/%d/%s", hostname, os.Getpid(), time.Now().UTC().Format("20060102T150405Z"))
+}
+
+func (s *cloudWatchLogsSink) Write(record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.streamCreated {
+		if _, err := s.client.CreateLogStream(&cloudwatchlogs.CreateLogStreamInput{
+			LogGroupName:  aws.String(s.logGroup),
+			LogStreamName: aws.String(s.logStream),
+		}); err != nil {
+			return err
+		}
+		s.streamCreated = true
+	}
+
+	output, err := s.client.PutLogEvents(&cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String(s.logGroup),
+		LogStreamName: aws.String(s.logStream),
+		SequenceToken: s.sequenceToken,
+		LogEvents: []*cloudwatchlogs.InputLogEvent{
+			{
+				Message:   aws.String(string(data)),
+				Timestamp: aws.Int64(record.Timestamp.UnixNano() / int64(time.Millisecond)),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	s.sequenceToken = output.NextSequenceToken
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	mock_cloudwatchlogsiface "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs/mock/sdk"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSinkAppendsRecords(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ecs-cli-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	sink := NewFileSink(path)
+	assert.NoError(t, sink.Write(&Record{Operation: "ecs:CreateService", Result: ResultSuccess}))
+	assert.NoError(t, NewFileSink(path).Write(&Record{Operation: "ecs:UpdateService", Result: ResultFailure}))
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 2, "Expected one line per record")
+
+	record := &Record{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), record))
+	assert.Equal(t, "ecs:UpdateService", record.Operation)
+	assert.Equal(t, ResultFailure, record.Result)
+
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, auditFileMode, info.Mode(), "Unexpected audit log file mode")
+}
+
+func TestCloudWatchLogsSink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCWL := mock_cloudwatchlogsiface.NewMockCloudWatchLogsAPI(ctrl)
+
+	gomock.InOrder(
+		mockCWL.EXPECT().CreateLogStream(gomock.Any()).Do(func(x interface{}) {
+			input := x.(*cloudwatchlogs.CreateLogStreamInput)
+			assert.Equal(t, "audit", aws.StringValue(input.LogGroupName))
+			assert.Equal(t, "stream", aws.StringValue(input.LogStreamName))
+		}).Return(&cloudwatchlogs.CreateLogStreamOutput{}, nil),
+		mockCWL.EXPECT().PutLogEvents(gomock.Any()).Do(func(x interface{}) {
+			input := x.(*cloudwatchlogs.PutLogEventsInput)
+			assert.Nil(t, input.SequenceToken, "Expected no sequence token for a new stream")
+			assert.Len(t, input.LogEvents, 1)
+			assert.Contains(t, aws.StringValue(input.LogEvents[0].Message), "ecs:CreateService")
+		}).Return(&cloudwatchlogs.PutLogEventsOutput{NextSequenceToken: aws.String("token")}, nil),
+		mockCWL.EXPECT().PutLogEvents(gomock.Any()).Do(func(x interface{}) {
+			input := x.(*cloudwatchlogs.PutLogEventsInput)
+			assert.Equal(t, "token", aws.StringValue(input.SequenceToken), "Expected sequence token from previous call")
+		}).Return(&cloudwatchlogs.PutLogEventsOutput{NextSequenceToken: aws.String("token2")}, nil),
+	)
+
+	sink := newCloudWatchLogsSink(mockCWL, "audit", "stream")
+	assert.NoError(t, sink.Write(&Record{Timestamp: time.Now(), Operation: "ecs:CreateService"}))
+	assert.NoError(t, sink.Write(&Record{Timestamp: time.Now(), Operation: "ecs:UpdateService"}))
+}
@@ -15,8 +15,10 @@ package utils
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/regcredio"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -34,6 +36,9 @@ type TaskDefParams struct {
 	ipcMode          string
 	containerDefs    ContainerDefs
 	executionRoleArn string
+	tracing          string
+	metrics          *Metrics
+	accelerators     []InferenceAccelerator
 }
 
 // ConvertTaskDefParams contains the inputs required to convert compose & ECS inputs into an ECS task definition
@@ -76,8 +81,7 @@ func ConvertToTaskDefinition(params ConvertTaskDefParams) (*ecs.TaskDefinition,
 		}
 
 		// Validate essential containers
-		count := len(params.ContainerConfigs)
-		if !hasEssential(taskDefParams.containerDefs, count) {
+		if !hasEssential(taskDefParams.containerDefs, params.ContainerConfigs) {
 			return nil, errors.New("Task definition does not have any essential containers")
 		}
 
@@ -93,6 +97,20 @@ func ConvertToTaskDefinition(params ConvertTaskDefParams) (*ecs.TaskDefinition,
 		containerDefinitions = append(containerDefinitions, containerDef)
 	}
 
+	containerDefinitions = addTracingSidecar(containerDefinitions, taskDefParams.tracing, taskDefParams.networkMode)
+	containerDefinitions, err = addMetricsSidecar(containerDefinitions, taskDefParams.metrics, params.TaskDefName, taskDefParams.networkMode)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateLogConfigurations(containerDefinitions, params.RequiredCompatibilites); err != nil {
+		return nil, err
+	}
+
+	if err = validateContainerResources(containerDefinitions, taskDefParams.cpu, taskDefParams.memory); err != nil {
+		return nil, err
+	}
+
 	ecsVolumes, err := convertToECSVolumes(params.Volumes, params.ECSParams)
 	if err != nil {
 		return nil, err
@@ -150,6 +168,9 @@ func ConvertToTaskDefinition(params ConvertTaskDefParams) (*ecs.TaskDefinition,
 	if taskDefParams.ipcMode != "" {
 		taskDefinition.SetIpcMode(taskDefParams.ipcMode)
 	}
+	if len(taskDefParams.accelerators) > 0 {
+		taskDefinition.SetInferenceAccelerators(convertToECSInferenceAccelerators(taskDefParams.accelerators))
+	}
 	return taskDefinition, nil
 }
 
@@ -233,7 +254,7 @@ func convertToECSVolumes(hostPaths *adapter.Volumes, ecsParams *ECSParams) ([]*e
 	}
 
 	// volumes without host path (allowed to have Docker Volume Configuration)
-	volumesWithoutHost, err := mergeVolumesWithoutHost(hostPaths.VolumeEmptyHost, ecsParams)
+	volumesWithoutHost, err := mergeVolumesWithoutHost(hostPaths, ecsParams)
 	if err != nil {
 		return nil, err
 	}
@@ -273,17 +294,84 @@ func convertToECSContainerDependency(dependencies []ContainerDependency) []*ecs.
 	return ecsContainerDependencies
 }
 
-func mergeVolumesWithoutHost(composeVolumes []string, ecsParams *ECSParams) ([]*ecs.Volume, error) {
+// log drivers supported by ECS
+var logDrivers = []string{
+	ecs.LogDriverJsonFile,
+	ecs.LogDriverSyslog,
+	ecs.LogDriverJournald,
+	ecs.LogDriverGelf,
+	ecs.LogDriverFluentd,
+	ecs.LogDriverAwslogs,
+	ecs.LogDriverSplunk,
+	ecs.LogDriverAwsfirelens,
+}
+
+// log drivers supported by tasks with the Fargate launch type
+var fargateLogDrivers = []string{
+	ecs.LogDriverAwslogs,
+	ecs.LogDriverSplunk,
+	ecs.LogDriverAwsfirelens,
+}
+
+// validateLogConfigurations checks that the log drivers of the containers are supported by ECS and
+// by the launch type, and that a FireLens log router is present if a container uses awsfirelens
+func validateLogConfigurations(containerDefs []*ecs.ContainerDefinition, launchType string) error {
+	hasLogRouter := false
+	for _, containerDef := range containerDefs {
+		if containerDef.FirelensConfiguration != nil {
+			hasLogRouter = true
+		}
+	}
+
+	for _, containerDef := range containerDefs {
+		if containerDef.LogConfiguration == nil {
+			continue
+		}
+		containerName := aws.StringValue(containerDef.Name)
+		logDriver := aws.StringValue(containerDef.LogConfiguration.LogDriver)
+
+		if !utils.InSlice(logDriver, logDrivers) {
+			return fmt.Errorf("Log driver %s of container %s is not supported by ECS. Supported log drivers are: %s", logDriver, containerName, strings.Join(logDrivers, ", "))
+		}
+		if launchType == ecs.LaunchTypeFargate && !utils.InSlice(logDriver, fargateLogDrivers) {
+			return fmt.Errorf("Log driver %s of container %s is not supported by the %s launch type. Supported log drivers are: %s", logDriver, containerName, launchType, strings.Join(fargateLogDrivers, ", "))
+		}
+		if logDriver == ecs.LogDriverAwsfirelens && !hasLogRouter {
+			return fmt.Errorf("Container %s uses the %s log driver, but no container in the task has a firelens_configuration to route its logs", containerName, logDriver)
+		}
+	}
+	return nil
+}
+
+// mergeVolumesWithoutHost merges the named volumes of the compose file with the volumes in ecs-params.
+// Docker volumes in ecs-params override the driver configuration of the compose file.
+func mergeVolumesWithoutHost(composeVolumes *adapter.Volumes, ecsParams *ECSParams) ([]*ecs.Volume, error) {
 	volumesWithoutHost := make(map[string]Volume)
 	output := []*ecs.Volume{}
 
-	for _, volName := range composeVolumes {
+	for _, volName := range composeVolumes.VolumeEmptyHost {
 		volumesWithoutHost[volName] = Volume{}
+		if volumeConfig, ok := composeVolumes.VolumeConfigs[volName]; ok {
+			volumesWithoutHost[volName] = Volume{DockerVolumeConfig: DockerVolume{
+				Name:          volName,
+				Scope:         volumeConfig.Scope,
+				Autoprovision: volumeConfig.Autoprovision,
+				Driver:        volumeConfig.Driver,
+				DriverOptions: aws.StringValueMap(volumeConfig.DriverOpts),
+				Labels:        aws.StringValueMap(volumeConfig.Labels),
+			}}
+		}
 	}
 
 	if ecsParams != nil {
 		for _, dockerVol := range ecsParams.TaskDefinition.DockerVolumes {
 			if dockerVol.Name != "" {
+				if _, ok := composeVolumes.VolumeConfigs[dockerVol.Name]; ok {
+					log.WithFields(log.Fields{
+						"option name": "docker_volumes",
+						"volume name": dockerVol.Name,
+					}).Info("Using ecs-params value as override")
+				}
 				volumesWithoutHost[dockerVol.Name] = Volume{DockerVolumeConfig: dockerVol}
 			} else {
 				return nil, fmt.Errorf("Name is required when specifying a docker volume")
@@ -315,10 +403,10 @@ func mergeVolumesWithoutHost(composeVolumes []string, ecsParams *ECSParams) ([]*
 			if dVolCfg.Scope != nil {
 				ecsVolume.DockerVolumeConfiguration.Scope = dVolCfg.Scope
 			}
-			if dVolCfg.DriverOptions != nil {
+			if len(dVolCfg.DriverOptions) > 0 {
 				ecsVolume.DockerVolumeConfiguration.DriverOpts = aws.StringMap(dVolCfg.DriverOptions)
 			}
-			if dVolCfg.Labels != nil {
+			if len(dVolCfg.Labels) > 0 {
 				ecsVolume.DockerVolumeConfiguration.Labels = aws.StringMap(dVolCfg.Labels)
 			}
 		}
@@ -360,7 +448,7 @@ func mergeVolumesWithoutHost(composeVolumes []string, ecsParams *ECSParams) ([]*
 	return output, nil
 }
 
-func hasEssential(ecsParamsContainerDefs ContainerDefs, count int) bool {
+func hasEssential(ecsParamsContainerDefs ContainerDefs, containerConfigs []adapter.ContainerConfig) bool {
 	// If the customer does not set the "essential" field on any container
 	// definition, ECS will mark all containers in a TaskDefinition as
 	// essential. Previously, since the customer could not pass in the
@@ -372,16 +460,15 @@ func hasEssential(ecsParamsContainerDefs ContainerDefs, count int) bool {
 	// least one essential container, i.e. that the customer does not
 	// explicitly set all containers to be non-essential.
 
-	nonEssentialCount := 0
-
-	for _, containerDef := range ecsParamsContainerDefs {
-		if !containerDef.Essential {
-			nonEssentialCount++
+	// Only the containers of the compose services count, since the ecs-params file may
+	// configure services that are not deployed, e.g. when selecting them with --services.
+	for _, containerConfig := range containerConfigs {
+		containerDef, ok := ecsParamsContainerDefs[containerConfig.Name]
+		if !ok || containerDef.Essential {
+			return true
 		}
 	}
-
-	// 'count' is the total number of containers specified in the service config
-	return nonEssentialCount != count
+	return false
 }
 
 // Converts fields from ecsParams into the appropriate types for fields on an
@@ -400,6 +487,19 @@ func convertTaskDefParams(ecsParams *ECSParams) (params TaskDefParams, e error)
 	params.executionRoleArn = taskDef.ExecutionRole
 	params.ipcMode = taskDef.IPCMode
 	params.pidMode = taskDef.PIDMode
+	params.tracing = taskDef.Tracing
+	params.metrics = taskDef.Metrics
+	params.accelerators = taskDef.InferenceAccelerators
+
+	if err := validateTracing(params.tracing); err != nil {
+		return params, err
+	}
+	if err := validateMetrics(params.metrics); err != nil {
+		return params, err
+	}
+	if err := validateInferenceAccelerators(params.accelerators, params.containerDefs); err != nil {
+		return params, err
+	}
 
 	return params, nil
 }
@@ -361,6 +361,78 @@ func TestConvertToTaskDefinitionLaunchTypeFargate(t *testing.T) {
 	assert.Equal(t, "FARGATE", aws.StringValue(taskDefinition.RequiresCompatibilities[0]))
 }
 
+func TestConvertToTaskDefinitionLogDriverValidation(t *testing.T) {
+	testCases := map[string]struct {
+		logDriver   string
+		launchType  string
+		expectError bool
+	}{
+		"awslogs on Fargate": {
+			logDriver:  "awslogs",
+			launchType: "FARGATE",
+		},
+		"splunk on Fargate": {
+			logDriver:  "splunk",
+			launchType: "FARGATE",
+		},
+		"syslog on Fargate": {
+			logDriver:   "syslog",
+			launchType:  "FARGATE",
+			expectError: true,
+		},
+		"syslog on EC2": {
+			logDriver:  "syslog",
+			launchType: "EC2",
+		},
+		"unsupported driver on EC2": {
+			logDriver:   "local",
+			launchType:  "EC2",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			containerConfig := adapter.ContainerConfig{
+				Name: "web",
+				LogConfiguration: &ecs.LogConfiguration{
+					LogDriver: aws.String(tc.logDriver),
+				},
+			}
+			_, err := convertToTaskDefinitionForTest(t, []adapter.ContainerConfig{containerConfig}, "", tc.launchType, nil, nil)
+			if tc.expectError {
+				assert.Error(t, err, "Expected error converting Task Definition with log driver %s", tc.logDriver)
+			} else {
+				assert.NoError(t, err, "Unexpected error converting Task Definition with log driver %s", tc.logDriver)
+			}
+		})
+	}
+}
+
+func TestConvertToTaskDefinitionFirelensLogDriverRequiresLogRouter(t *testing.T) {
+	web := adapter.ContainerConfig{
+		Name: "web",
+		LogConfiguration: &ecs.LogConfiguration{
+			LogDriver: aws.String("awsfirelens"),
+		},
+	}
+	_, err := convertToTaskDefinitionForTest(t, []adapter.ContainerConfig{web}, "", "FARGATE", nil, nil)
+	assert.Error(t, err, "Expected error converting Task Definition with awsfirelens and no log router")
+
+	content := `version: 1
+task_definition:
+  services:
+    log_router:
+      firelens_configuration:
+        type: fluentbit`
+	ecsParams, err := createTempECSParamsForTest(t, content)
+	assert.NoError(t, err, "Could not read ECS Params file")
+
+	logRouter := adapter.ContainerConfig{Name: "log_router"}
+	_, err = convertToTaskDefinitionForTest(t, []adapter.ContainerConfig{web, logRouter}, "", "FARGATE", ecsParams, nil)
+	assert.NoError(t, err, "Unexpected error converting Task Definition with a log router")
+}
+
 // Tests for ConvertToTaskDefinition with ECS Params
 func TestConvertToTaskDefinitionWithECSParams_ComposeMemoryLessThanMemoryRes(t *testing.T) {
 	// set up containerConfig w/o value for Memory
@@ -542,6 +614,39 @@ task_definition:
 	}
 }
 
+func TestConvertToTaskDefinitionWithECSParams_EssentialWithUndeployedService(t *testing.T) {
+	// the ecs-params file may configure services that are not deployed, e.g. when selecting services with --services
+	containerConfigs := testContainerConfigs([]string{"mysql", "wordpress"})
+	ecsParamsString := `version: 1
+task_definition:
+  services:
+    mysql:
+      essential: false
+    worker:
+      essential: false`
+
+	tmpfile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs fields tempfile")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(ecsParamsString))
+	assert.NoError(t, err, "Could not write data to ecs fields tempfile")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+
+	ecsParams, err := ReadECSParams(tmpfile.Name())
+	assert.NoError(t, err, "Could not read ECS Params file")
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+
+	if assert.NoError(t, err) {
+		wordpress := findContainerByName("wordpress", taskDefinition.ContainerDefinitions)
+		assert.True(t, aws.BoolValue(wordpress.Essential), "Expected container with name: '%v' to be true", *wordpress.Name)
+	}
+}
+
 func TestConvertToTaskDefinitionWithECSParams_EssentialExplicitlyMarkedTrue(t *testing.T) {
 	containerConfigs := testContainerConfigs([]string{"mysql", "wordpress"})
 	ecsParamsString := `version: 1
@@ -1140,6 +1245,67 @@ task_definition:
 	}
 }
 
+func TestConvertToTaskDefinitionWithECSParams_ContainerResourcesFitTaskSize(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{
+		{Name: "web", CPU: 256, Memory: 1024, MemoryReservation: 256},
+		{Name: "worker", CPU: 256, Memory: 768},
+	}
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			TaskSize: TaskSize{
+				Cpu:    "0.5 vCPU",
+				Memory: "1GB",
+			},
+		},
+	}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+
+	if assert.NoError(t, err) {
+		web := findContainerByName("web", taskDefinition.ContainerDefinitions)
+		assert.Equal(t, int64(1024), aws.Int64Value(web.Memory), "Expected Memory to match")
+		assert.Equal(t, int64(256), aws.Int64Value(web.MemoryReservation), "Expected MemoryReservation to match")
+	}
+}
+
+func TestConvertToTaskDefinitionWithECSParams_ContainerResourcesExceedTaskSize(t *testing.T) {
+	testCases := map[string]struct {
+		containerConfigs []adapter.ContainerConfig
+		taskSize         TaskSize
+	}{
+		"total cpu": {
+			containerConfigs: []adapter.ContainerConfig{
+				{Name: "web", CPU: 256, Memory: 256},
+				{Name: "worker", CPU: 512, Memory: 256},
+			},
+			taskSize: TaskSize{Cpu: "512", Memory: "1024"},
+		},
+		"hard memory limit": {
+			containerConfigs: []adapter.ContainerConfig{
+				{Name: "web", Memory: 2048, MemoryReservation: 256},
+			},
+			taskSize: TaskSize{Cpu: "512", Memory: "1GB"},
+		},
+		"total memory": {
+			containerConfigs: []adapter.ContainerConfig{
+				{Name: "web", MemoryReservation: 768},
+				{Name: "worker", Memory: 512},
+			},
+			taskSize: TaskSize{Cpu: "512", Memory: "1GB"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ecsParams := &ECSParams{
+				TaskDefinition: EcsTaskDef{TaskSize: tc.taskSize},
+			}
+			_, err := convertToTaskDefinitionForTest(t, tc.containerConfigs, "", "", ecsParams, nil)
+			assert.Error(t, err, "Expected error when container resources exceed the task size")
+		})
+	}
+}
+
 func TestConvertToTaskDefinition_MemLimitOnlyProvided(t *testing.T) {
 	webMem := int64(1048576)
 	containerConfig := adapter.ContainerConfig{
@@ -1348,6 +1514,98 @@ func TestConvertToTaskDefinitionWithVolumesWithHostOnly(t *testing.T) {
 	assert.ElementsMatch(t, expectedVolumes, actualVolumes, "Expected volumes to match")
 }
 
+func TestConvertToTaskDefinitionWithComposeVolumeDriver(t *testing.T) {
+	driverOpts := map[string]string{
+		"type":   "nfs",
+		"o":      "addr=10.0.0.1,rw",
+		"device": ":/exports",
+	}
+	volumeConfigs := &adapter.Volumes{
+		VolumeEmptyHost: []string{namedVolume, namedVolume2},
+		VolumeConfigs: map[string]*ecs.DockerVolumeConfiguration{
+			namedVolume: {
+				Driver:     aws.String("local"),
+				DriverOpts: aws.StringMap(driverOpts),
+			},
+			namedVolume2: {
+				Driver: aws.String("local"),
+			},
+		},
+	}
+	containerConfigs := []adapter.ContainerConfig{{Name: "web"}}
+
+	// ecs-params overrides the driver configuration of the compose file
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			DockerVolumes: []DockerVolume{
+				{
+					Name:          namedVolume2,
+					Scope:         aws.String("shared"),
+					Autoprovision: aws.Bool(true),
+					Driver:        aws.String("rexray/ebs"),
+				},
+			},
+		},
+	}
+
+	expectedVolumes := []*ecs.Volume{
+		{
+			Name: aws.String(namedVolume),
+			DockerVolumeConfiguration: &ecs.DockerVolumeConfiguration{
+				Driver:     aws.String("local"),
+				DriverOpts: aws.StringMap(driverOpts),
+			},
+		},
+		{
+			Name: aws.String(namedVolume2),
+			DockerVolumeConfiguration: &ecs.DockerVolumeConfiguration{
+				Scope:         aws.String("shared"),
+				Autoprovision: aws.Bool(true),
+				Driver:        aws.String("rexray/ebs"),
+			},
+		},
+	}
+
+	testParams := ConvertTaskDefParams{
+		TaskDefName:      projectName,
+		Volumes:          volumeConfigs,
+		ContainerConfigs: containerConfigs,
+		ECSParams:        ecsParams,
+	}
+
+	taskDefinition, err := ConvertToTaskDefinition(testParams)
+	assert.NoError(t, err, "Unexpected error converting Task Definition")
+	assert.ElementsMatch(t, expectedVolumes, taskDefinition.Volumes, "Expected volumes to match")
+}
+
+func TestConvertToTaskDefinitionWithECSParams_VolumesFrom(t *testing.T) {
+	content := `version: 1
+task_definition:
+  services:
+    web:
+      volumes_from:
+        - data:ro
+        - container:logs`
+	ecsParams, err := createTempECSParamsForTest(t, content)
+	assert.NoError(t, err, "Could not read ECS Params file")
+
+	containerConfigs := []adapter.ContainerConfig{
+		{Name: "web", Image: "httpd"},
+		{Name: "data", Image: "busybox"},
+		{Name: "logs", Image: "busybox"},
+	}
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+
+	expectedVolumesFrom := []*ecs.VolumeFrom{
+		{SourceContainer: aws.String("data"), ReadOnly: aws.Bool(true)},
+		{SourceContainer: aws.String("logs"), ReadOnly: aws.Bool(false)},
+	}
+	if assert.NoError(t, err) {
+		web := findContainerByName("web", taskDefinition.ContainerDefinitions)
+		assert.Equal(t, expectedVolumesFrom, web.VolumesFrom, "Expected VolumesFrom to match")
+	}
+}
+
 func TestConvertToTaskDefinitionWithECSParamsVolumeWithoutNameError(t *testing.T) {
 	volumeConfigs := &adapter.Volumes{
 		VolumeEmptyHost: []string{namedVolume, namedVolume2},
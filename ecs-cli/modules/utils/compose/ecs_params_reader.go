@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/schema"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	libYaml "github.com/docker/libcompose/yaml"
@@ -53,6 +54,26 @@ type EcsTaskDef struct {
 	DockerVolumes        []DockerVolume `yaml:"docker_volumes"`
 	EFSVolumes           []EFSVolume    `yaml:"efs_volumes"`
 	PlacementConstraints []Constraint   `yaml:"placement_constraints"`
+	Tracing              string         `yaml:"tracing"` // Optional. xray or otel
+	Metrics              *Metrics       `yaml:"metrics"`
+	// InferenceAccelerators are the Elastic Inference accelerators of the task, which the containers
+	// reserve by device name
+	InferenceAccelerators []InferenceAccelerator `yaml:"inference_accelerators"`
+}
+
+// Metrics holds the configuration of the sidecar which scrapes the Prometheus metrics of the containers
+type Metrics struct {
+	Collector string `yaml:"collector"` // Optional. adot (default) or cloudwatch
+	Path      string `yaml:"path"`      // Optional. default: /metrics
+	Port      int64  `yaml:"port"`      // Optional. default: the ports mapped by the containers
+	Interval  string `yaml:"interval"`  // Optional. default: 1m
+}
+
+// InferenceAccelerator holds the fields of an ECS Inference Accelerator
+// https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_InferenceAccelerator.html
+type InferenceAccelerator struct {
+	DeviceName string `yaml:"device_name"` // Required
+	DeviceType string `yaml:"device_type"` // Required, e.g. eia2.medium
 }
 
 // ContainerDefs is a map of ContainerDefs within a task definition
@@ -73,6 +94,10 @@ type ContainerDef struct {
 	Secrets               []Secret               `yaml:"secrets"`
 	GPU                   string                 `yaml:"gpu"`
 	ContainerDependencies []ContainerDependency  `yaml:"depends_on"`
+	VolumesFrom           []string               `yaml:"volumes_from"`
+	DesiredCount          *int64                 `yaml:"desired_count"`
+	InferenceAccelerators []string               `yaml:"inference_accelerators"` // device names of the inference accelerators of the task
+	NeuronDevices         int64                  `yaml:"neuron_devices"`         // number of /dev/neuronN devices mapped into the container
 }
 
 type Volume struct {
@@ -153,6 +178,32 @@ type RunParams struct {
 	NetworkConfiguration NetworkConfiguration `yaml:"network_configuration"`
 	TaskPlacement        TaskPlacement        `yaml:"task_placement"`
 	ServiceDiscovery     ServiceDiscovery     `yaml:"service_discovery"`
+	ServiceConnect       *ServiceConnect      `yaml:"service_connect"`
+	// Service load balancing settings, overridden by the corresponding compose service flags
+	HealthCheckGracePeriod *int64      `yaml:"health_check_grace_period"`
+	DeregistrationDelay    *int64      `yaml:"deregistration_delay"`
+	DeployHooks            DeployHooks `yaml:"deploy_hooks"`
+}
+
+// DeployHooks are run before and after compose service up deploys the service
+type DeployHooks struct {
+	PreDeploy  []DeployHook `yaml:"pre_deploy"`
+	PostDeploy []DeployHook `yaml:"post_deploy"`
+}
+
+// DeployHook runs a local command, invokes a Lambda function or runs a one-off task.
+// Exactly one of Command, Lambda and Task must be specified.
+type DeployHook struct {
+	Name    string    `yaml:"name"`
+	Command []string  `yaml:"command"`
+	Lambda  string    `yaml:"lambda"`
+	Task    *TaskHook `yaml:"task"`
+}
+
+// TaskHook runs the task definition being deployed once, with the command of one of its containers overridden
+type TaskHook struct {
+	Container string   `yaml:"container"`
+	Command   []string `yaml:"command"`
 }
 
 // NetworkConfiguration specifies the network config for the task definition.
@@ -218,6 +269,27 @@ type HealthCheckCustomConfig struct {
 	FailureThreshold *int64 `yaml:"failure_threshold"`
 }
 
+// ServiceConnect holds the ECS Service Connect configuration of the service. The namespace
+// defaults to the Service Connect namespace of the cluster.
+type ServiceConnect struct {
+	Namespace string                  `yaml:"namespace"`
+	Services  []ServiceConnectService `yaml:"services"`
+}
+
+// ServiceConnectService exposes a container port to the other services of the namespace
+type ServiceConnectService struct {
+	ContainerName string                      `yaml:"container_name"`
+	ContainerPort int64                       `yaml:"container_port"`
+	DiscoveryName string                      `yaml:"discovery_name"`
+	ClientAliases []ServiceConnectClientAlias `yaml:"client_aliases"`
+}
+
+// ServiceConnectClientAlias is a DNS name and port the clients of the service connect to
+type ServiceConnectClientAlias struct {
+	Port    int64  `yaml:"port"`
+	DNSName string `yaml:"dns_name"`
+}
+
 const (
 	Enabled  AssignPublicIp = "ENABLED"
 	Disabled AssignPublicIp = "DISABLED"
@@ -272,6 +344,9 @@ func ReadECSParams(filename string) (*ECSParams, error) {
 		return nil, errors.Wrapf(err, "Error reading file '%v'", filename)
 	}
 	ecsParamsData = []byte(os.ExpandEnv(string(ecsParamsData)))
+	if err = schema.Validate(schema.ECSParams, ecsParamsData); err != nil {
+		return nil, errors.Wrapf(err, "Invalid ECS params file: %v", filename)
+	}
 	ecsParams := &ECSParams{}
 
 	if err = yaml.Unmarshal([]byte(ecsParamsData), &ecsParams); err != nil {
@@ -71,6 +71,31 @@ func TestReadECSParams_NoFile(t *testing.T) {
 	}
 }
 
+func TestReadECSParams_UnknownField(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  services:
+    web:
+      mem_limits: 512m`
+
+	tmpfile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+
+	ecsParamsFileName := tmpfile.Name()
+	defer os.Remove(ecsParamsFileName)
+
+	_, err = tmpfile.Write([]byte(ecsParamsString))
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+
+	_, err = ReadECSParams(ecsParamsFileName)
+	if assert.Error(t, err, "Expected an error for an unknown field") {
+		assert.Contains(t, err.Error(), "task_definition.services.web.mem_limits: Additional property mem_limits is not allowed")
+	}
+}
+
 func TestReadECSParams_WithServices(t *testing.T) {
 	ecsParamsString := `version: 1
 task_definition:
@@ -865,6 +890,81 @@ run_params:
 	}
 }
 
+func TestReadECSParams_WithDeployHooks(t *testing.T) {
+	ecsParamsString := `version: 1
+run_params:
+  deploy_hooks:
+    pre_deploy:
+      - name: migrate
+        task:
+          container: web
+          command: ["rake", "db:migrate"]
+    post_deploy:
+      - command: ["./smoke-test.sh", "https://example.com"]
+      - lambda: warm-cache`
+
+	content := []byte(ecsParamsString)
+
+	tmpfile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+
+	ecsParamsFileName := tmpfile.Name()
+	defer os.Remove(ecsParamsFileName)
+
+	_, err = tmpfile.Write(content)
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+
+	ecsParams, err := ReadECSParams(ecsParamsFileName)
+
+	if assert.NoError(t, err) {
+		hooks := ecsParams.RunParams.DeployHooks
+		expectedPreDeploy := []DeployHook{
+			{Name: "migrate", Task: &TaskHook{Container: "web", Command: []string{"rake", "db:migrate"}}},
+		}
+		expectedPostDeploy := []DeployHook{
+			{Command: []string{"./smoke-test.sh", "https://example.com"}},
+			{Lambda: "warm-cache"},
+		}
+		assert.Equal(t, expectedPreDeploy, hooks.PreDeploy, "Expected pre-deploy hooks to match")
+		assert.Equal(t, expectedPostDeploy, hooks.PostDeploy, "Expected post-deploy hooks to match")
+	}
+}
+
+func TestReadECSParams_WithDesiredCount(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  services:
+    web:
+      desired_count: 4
+    worker:
+      essential: false`
+
+	content := []byte(ecsParamsString)
+
+	tmpfile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+
+	ecsParamsFileName := tmpfile.Name()
+	defer os.Remove(ecsParamsFileName)
+
+	_, err = tmpfile.Write(content)
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+
+	ecsParams, err := ReadECSParams(ecsParamsFileName)
+
+	if assert.NoError(t, err) {
+		containerDefs := ecsParams.TaskDefinition.ContainerDefinitions
+		assert.Equal(t, int64(4), aws.Int64Value(containerDefs["web"].DesiredCount), "Expected desired count to match")
+		assert.Nil(t, containerDefs["worker"].DesiredCount, "Expected desired count to be unset")
+	}
+}
+
 func TestConvertToECSHealthCheck(t *testing.T) {
 	testHealthCheck := &HealthCheck{
 		Test:        []string{"CMD-SHELL", "curl -f http://localhost"},
@@ -0,0 +1,95 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// neuronDevicePathFormat is the path of the Neuron devices of Inferentia and Trainium instances,
+// which are numbered from 0
+const neuronDevicePathFormat = "/dev/neuron%d"
+
+// validateInferenceAccelerators checks that the inference accelerators of the task are named and
+// typed, and that the containers only reserve accelerators of the task
+func validateInferenceAccelerators(accelerators []InferenceAccelerator, containerDefs ContainerDefs) error {
+	deviceNames := make(map[string]bool)
+	for _, accelerator := range accelerators {
+		if accelerator.DeviceName == "" || accelerator.DeviceType == "" {
+			return fmt.Errorf("Inference accelerators require a device_name and a device_type")
+		}
+		if deviceNames[accelerator.DeviceName] {
+			return fmt.Errorf("Inference accelerator %s is defined more than once", accelerator.DeviceName)
+		}
+		deviceNames[accelerator.DeviceName] = true
+	}
+	for name, containerDef := range containerDefs {
+		for _, deviceName := range containerDef.InferenceAccelerators {
+			if !deviceNames[deviceName] {
+				return fmt.Errorf("Service %s uses inference accelerator %s, which is not one of the inference_accelerators of the task definition", name, deviceName)
+			}
+		}
+		if containerDef.NeuronDevices < 0 {
+			return fmt.Errorf("Service %s: neuron_devices cannot be negative", name)
+		}
+	}
+	return nil
+}
+
+func convertToECSInferenceAccelerators(accelerators []InferenceAccelerator) []*ecs.InferenceAccelerator {
+	var output []*ecs.InferenceAccelerator
+	for _, accelerator := range accelerators {
+		output = append(output, &ecs.InferenceAccelerator{
+			DeviceName: aws.String(accelerator.DeviceName),
+			DeviceType: aws.String(accelerator.DeviceType),
+		})
+	}
+	return output
+}
+
+// inferenceAcceleratorRequirements returns the resource requirements reserving the inference
+// accelerators of the task with the given device names
+func inferenceAcceleratorRequirements(deviceNames []string) []*ecs.ResourceRequirement {
+	var requirements []*ecs.ResourceRequirement
+	for _, deviceName := range deviceNames {
+		requirements = append(requirements, &ecs.ResourceRequirement{
+			Type:  aws.String(ecs.ResourceTypeInferenceAccelerator),
+			Value: aws.String(deviceName),
+		})
+	}
+	return requirements
+}
+
+// addNeuronDevices maps the first count Neuron devices of the instance into the container, at the same
+// path, unless the devices of the compose file already map them
+func addNeuronDevices(linuxParameters *ecs.LinuxParameters, count int64) {
+	mapped := make(map[string]bool)
+	for _, device := range linuxParameters.Devices {
+		mapped[aws.StringValue(device.HostPath)] = true
+	}
+	for i := int64(0); i < count; i++ {
+		path := fmt.Sprintf(neuronDevicePathFormat, i)
+		if mapped[path] {
+			continue
+		}
+		linuxParameters.Devices = append(linuxParameters.Devices, &ecs.Device{
+			HostPath:      aws.String(path),
+			ContainerPath: aws.String(path),
+			Permissions:   aws.StringSlice([]string{ecs.DeviceCgroupPermissionRead, ecs.DeviceCgroupPermissionWrite}),
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertToTaskDefinitionWithInferenceAccelerators(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{{Name: "model", Image: "model-server"}, {Name: "web", Image: "httpd"}}
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			InferenceAccelerators: []InferenceAccelerator{{DeviceName: "eia", DeviceType: "eia2.medium"}},
+			ContainerDefinitions: ContainerDefs{
+				"model": {Essential: true, InferenceAccelerators: []string{"eia"}},
+			},
+		},
+	}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+	require.NoError(t, err, "Unexpected error converting the task definition")
+
+	expectedAccelerators := []*ecs.InferenceAccelerator{{DeviceName: aws.String("eia"), DeviceType: aws.String("eia2.medium")}}
+	assert.Equal(t, expectedAccelerators, taskDefinition.InferenceAccelerators)
+	model := findContainerByName("model", taskDefinition.ContainerDefinitions)
+	expectedRequirements := []*ecs.ResourceRequirement{{Type: aws.String(ecs.ResourceTypeInferenceAccelerator), Value: aws.String("eia")}}
+	assert.Equal(t, expectedRequirements, model.ResourceRequirements)
+	web := findContainerByName("web", taskDefinition.ContainerDefinitions)
+	assert.Empty(t, web.ResourceRequirements, "Expected the other containers not to reserve the accelerator")
+}
+
+func TestConvertToTaskDefinitionWithUnknownInferenceAccelerator(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{{Name: "model", Image: "model-server"}}
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			ContainerDefinitions: ContainerDefs{
+				"model": {Essential: true, InferenceAccelerators: []string{"eia"}},
+			},
+		},
+	}
+
+	_, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+	assert.Error(t, err, "Expected error for an inference accelerator which the task does not define")
+}
+
+func TestValidateInferenceAccelerators(t *testing.T) {
+	assert.Error(t, validateInferenceAccelerators([]InferenceAccelerator{{DeviceName: "eia"}}, nil), "Expected error for an accelerator without type")
+	assert.Error(t, validateInferenceAccelerators([]InferenceAccelerator{
+		{DeviceName: "eia", DeviceType: "eia2.medium"},
+		{DeviceName: "eia", DeviceType: "eia2.large"},
+	}, nil), "Expected error for accelerators with the same name")
+}
+
+func TestConvertToTaskDefinitionWithNeuronDevices(t *testing.T) {
+	neuron0, err := adapter.ConvertToDevices([]string{"/dev/neuron0:/dev/neuron0:r"})
+	require.NoError(t, err, "Unexpected error converting the devices")
+	containerConfigs := []adapter.ContainerConfig{{Name: "model", Image: "model-server", Devices: neuron0}}
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			ContainerDefinitions: ContainerDefs{
+				"model": {Essential: true, NeuronDevices: 2},
+			},
+		},
+	}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+	require.NoError(t, err, "Unexpected error converting the task definition")
+
+	model := findContainerByName("model", taskDefinition.ContainerDefinitions)
+	require.Len(t, model.LinuxParameters.Devices, 2)
+	assert.Equal(t, []string{"read"}, aws.StringValueSlice(model.LinuxParameters.Devices[0].Permissions), "Expected the compose device to be kept")
+	assert.Equal(t, "/dev/neuron1", aws.StringValue(model.LinuxParameters.Devices[1].HostPath))
+	assert.Equal(t, "/dev/neuron1", aws.StringValue(model.LinuxParameters.Devices[1].ContainerPath))
+	assert.Equal(t, []string{"read", "write"}, aws.StringValueSlice(model.LinuxParameters.Devices[1].Permissions))
+}
@@ -0,0 +1,292 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"gopkg.in/yaml.v2"
+)
+
+// Values of the collector field of the metrics section in ecs-params
+const (
+	MetricsCollectorADOT       = "adot"
+	MetricsCollectorCloudWatch = "cloudwatch"
+)
+
+const (
+	defaultMetricsPath     = "/metrics"
+	defaultMetricsInterval = "1m"
+	// metricsNamespace is the CloudWatch namespace of the scraped metrics, the one used by Container Insights
+	metricsNamespace = "ECS/ContainerInsights/Prometheus"
+)
+
+// Environment variables the collectors read their configuration from
+const (
+	adotConfigEnvVar       = "AOT_CONFIG_CONTENT"
+	cloudWatchConfigEnvVar = "CW_CONFIG_CONTENT"
+	prometheusConfigEnvVar = "PROMETHEUS_CONFIG_CONTENT"
+)
+
+var metricsSidecarImages = map[string]string{
+	MetricsCollectorADOT:       "public.ecr.aws/aws-observability/aws-otel-collector:latest",
+	MetricsCollectorCloudWatch: "public.ecr.aws/cloudwatch-agent/cloudwatch-agent:latest",
+}
+
+var metricsSidecarNames = map[string]string{
+	MetricsCollectorADOT:       "metrics-collector",
+	MetricsCollectorCloudWatch: "cloudwatch-agent",
+}
+
+// metricsPolicies are the names of the AWS managed policies the task role needs to publish the metrics
+var metricsPolicies = []string{"CloudWatchAgentServerPolicy"}
+
+// prometheusConfig is the subset of the Prometheus configuration file used to scrape the containers
+type prometheusConfig struct {
+	Global        prometheusGlobal         `yaml:"global"`
+	ScrapeConfigs []prometheusScrapeConfig `yaml:"scrape_configs"`
+}
+
+type prometheusGlobal struct {
+	ScrapeInterval string `yaml:"scrape_interval"`
+}
+
+type prometheusScrapeConfig struct {
+	JobName       string                   `yaml:"job_name"`
+	MetricsPath   string                   `yaml:"metrics_path"`
+	StaticConfigs []prometheusStaticConfig `yaml:"static_configs"`
+}
+
+type prometheusStaticConfig struct {
+	Targets []string          `yaml:"targets"`
+	Labels  map[string]string `yaml:"labels"`
+}
+
+// metricsCollector returns the collector of the metrics section, which defaults to ADOT
+func metricsCollector(metrics *Metrics) string {
+	if metrics.Collector == "" {
+		return MetricsCollectorADOT
+	}
+	return metrics.Collector
+}
+
+func validateMetrics(metrics *Metrics) error {
+	if metrics == nil {
+		return nil
+	}
+	if _, ok := metricsSidecarImages[metricsCollector(metrics)]; !ok {
+		return fmt.Errorf("Metrics collector %s is not supported. Supported values are: %s, %s", metrics.Collector, MetricsCollectorADOT, MetricsCollectorCloudWatch)
+	}
+	return nil
+}
+
+// addMetricsSidecar adds the ADOT collector or the CloudWatch agent to the containers of the task,
+// configured to scrape the Prometheus metrics of the other containers on the ports they expose, or on
+// the port of the metrics section, and to publish them to CloudWatch.
+func addMetricsSidecar(containerDefs []*ecs.ContainerDefinition, metrics *Metrics, family, networkMode string) ([]*ecs.ContainerDefinition, error) {
+	if metrics == nil {
+		return containerDefs, nil
+	}
+	collector := metricsCollector(metrics)
+	sidecarName := metricsSidecarNames[collector]
+
+	// in the bridge network mode, the default one, the sidecar reaches the containers through links,
+	// whereas the containers of an awsvpc or host task share its network interface
+	useLinks := networkMode == "" || networkMode == ecs.NetworkModeBridge
+	var links []string
+	var staticConfigs []prometheusStaticConfig
+	localTargets := make(map[string]bool)
+	for _, containerDef := range containerDefs {
+		name := aws.StringValue(containerDef.Name)
+		if name == sidecarName || isTracingSidecar(name) {
+			continue
+		}
+		host := "localhost"
+		if useLinks {
+			host = name
+		}
+		var targets []string
+		for _, port := range metricsPorts(containerDef, metrics) {
+			target := fmt.Sprintf("%s:%d", host, port)
+			// containers sharing the network interface of the task may expose the same port
+			if !useLinks {
+				if localTargets[target] {
+					continue
+				}
+				localTargets[target] = true
+			}
+			targets = append(targets, target)
+		}
+		if len(targets) == 0 {
+			continue
+		}
+		if useLinks {
+			links = append(links, name)
+		}
+		staticConfigs = append(staticConfigs, prometheusStaticConfig{
+			Targets: targets,
+			Labels:  map[string]string{"container": name},
+		})
+	}
+	if len(staticConfigs) == 0 {
+		return nil, fmt.Errorf("No container exposes a port to scrape metrics from. Map the ports of the containers in the compose file, or set the port of the metrics section")
+	}
+
+	path := metrics.Path
+	if path == "" {
+		path = defaultMetricsPath
+	}
+	interval := metrics.Interval
+	if interval == "" {
+		interval = defaultMetricsInterval
+	}
+	scrapeConfig := prometheusConfig{
+		Global: prometheusGlobal{ScrapeInterval: interval},
+		ScrapeConfigs: []prometheusScrapeConfig{{
+			JobName:       family,
+			MetricsPath:   path,
+			StaticConfigs: staticConfigs,
+		}},
+	}
+	logGroupName := "/ecs/prometheus/" + family
+
+	var environment map[string]string
+	var err error
+	if collector == MetricsCollectorCloudWatch {
+		environment, err = cloudWatchAgentEnvironment(scrapeConfig, logGroupName)
+	} else {
+		environment, err = adotCollectorEnvironment(scrapeConfig, logGroupName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the sidecar is not essential, so that a metrics failure does not stop the task
+	sidecarDef := &ecs.ContainerDefinition{
+		Name:              aws.String(sidecarName),
+		Image:             aws.String(metricsSidecarImages[collector]),
+		Essential:         aws.Bool(false),
+		Cpu:               aws.Int64(64),
+		MemoryReservation: aws.Int64(256),
+	}
+	if len(links) > 0 {
+		sidecarDef.Links = aws.StringSlice(links)
+	}
+	var names []string
+	for name := range environment {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		setDefaultEnvironment(sidecarDef, name, environment[name])
+	}
+	return append(containerDefs, sidecarDef), nil
+}
+
+// metricsPorts returns the ports of the container to scrape
+func metricsPorts(containerDef *ecs.ContainerDefinition, metrics *Metrics) []int64 {
+	if metrics.Port != 0 {
+		return []int64{metrics.Port}
+	}
+	var ports []int64
+	for _, portMapping := range containerDef.PortMappings {
+		protocol := aws.StringValue(portMapping.Protocol)
+		if protocol == "" || protocol == ecs.TransportProtocolTcp {
+			ports = append(ports, aws.Int64Value(portMapping.ContainerPort))
+		}
+	}
+	return ports
+}
+
+func isTracingSidecar(name string) bool {
+	for _, sidecar := range tracingSidecars {
+		if sidecar.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// adotCollectorEnvironment returns the configuration of the ADOT collector, which receives the
+// metrics with its Prometheus receiver and publishes them with the CloudWatch EMF exporter
+func adotCollectorEnvironment(scrapeConfig prometheusConfig, logGroupName string) (map[string]string, error) {
+	config := map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"prometheus": map[string]interface{}{
+				"config": scrapeConfig,
+			},
+		},
+		"exporters": map[string]interface{}{
+			"awsemf": map[string]interface{}{
+				"namespace":      metricsNamespace,
+				"log_group_name": logGroupName,
+			},
+		},
+		"service": map[string]interface{}{
+			"pipelines": map[string]interface{}{
+				"metrics": map[string]interface{}{
+					"receivers": []string{"prometheus"},
+					"exporters": []string{"awsemf"},
+				},
+			},
+		},
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{adotConfigEnvVar: string(data)}, nil
+}
+
+// cloudWatchAgentEnvironment returns the configuration of the CloudWatch agent, which reads the
+// Prometheus configuration from an environment variable and publishes every scraped metric with
+// the container as dimension
+func cloudWatchAgentEnvironment(scrapeConfig prometheusConfig, logGroupName string) (map[string]string, error) {
+	prometheusData, err := yaml.Marshal(scrapeConfig)
+	if err != nil {
+		return nil, err
+	}
+	agentConfig := map[string]interface{}{
+		"logs": map[string]interface{}{
+			"metrics_collected": map[string]interface{}{
+				"prometheus": map[string]interface{}{
+					"log_group_name":         logGroupName,
+					"prometheus_config_path": "env:" + prometheusConfigEnvVar,
+					"emf_processor": map[string]interface{}{
+						"metric_namespace": metricsNamespace,
+						"metric_declaration": []map[string]interface{}{{
+							"source_labels":    []string{"job"},
+							"label_matcher":    "^" + regexp.QuoteMeta(scrapeConfig.ScrapeConfigs[0].JobName) + "$",
+							"dimensions":       [][]string{{"container"}},
+							"metric_selectors": []string{"^.+$"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	agentData, err := json.Marshal(agentConfig)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		cloudWatchConfigEnvVar: string(agentData),
+		prometheusConfigEnvVar: string(prometheusData),
+	}, nil
+}
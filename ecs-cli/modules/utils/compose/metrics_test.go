@@ -0,0 +1,148 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func ecsParamsWithMetrics(metrics *Metrics, networkMode string) *ECSParams {
+	return &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			NetworkMode: networkMode,
+			Metrics:     metrics,
+		},
+	}
+}
+
+func containerConfigWithPorts(name string, ports ...int64) adapter.ContainerConfig {
+	containerConfig := adapter.ContainerConfig{Name: name, Image: name}
+	for _, port := range ports {
+		containerConfig.PortMappings = append(containerConfig.PortMappings, &ecs.PortMapping{
+			ContainerPort: aws.Int64(port),
+			Protocol:      aws.String(ecs.TransportProtocolTcp),
+		})
+	}
+	return containerConfig
+}
+
+func TestConvertToTaskDefinitionWithADOTMetricsInBridgeMode(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{
+		containerConfigWithPorts("web", 80, 9100),
+		containerConfigWithPorts("worker"),
+	}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParamsWithMetrics(&Metrics{}, ""), nil)
+	require.NoError(t, err, "Unexpected error converting the task definition")
+
+	require.Len(t, taskDefinition.ContainerDefinitions, 3)
+	collector := findContainerByName("metrics-collector", taskDefinition.ContainerDefinitions)
+	assert.Equal(t, "public.ecr.aws/aws-observability/aws-otel-collector:latest", aws.StringValue(collector.Image))
+	assert.False(t, aws.BoolValue(collector.Essential), "Expected the collector not to be essential")
+	assert.Equal(t, []string{"web"}, aws.StringValueSlice(collector.Links))
+
+	config := make(map[string]interface{})
+	require.NoError(t, yaml.Unmarshal([]byte(environmentValue(collector, adotConfigEnvVar)), &config))
+	receiver := config["receivers"].(map[interface{}]interface{})["prometheus"].(map[interface{}]interface{})["config"]
+	scrapeConfig := prometheusConfig{}
+	data, err := yaml.Marshal(receiver)
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(data, &scrapeConfig))
+
+	assert.Equal(t, "1m", scrapeConfig.Global.ScrapeInterval)
+	if assert.Len(t, scrapeConfig.ScrapeConfigs, 1) {
+		job := scrapeConfig.ScrapeConfigs[0]
+		assert.Equal(t, "/metrics", job.MetricsPath)
+		assert.Equal(t, []prometheusStaticConfig{{
+			Targets: []string{"web:80", "web:9100"},
+			Labels:  map[string]string{"container": "web"},
+		}}, job.StaticConfigs)
+	}
+	exporter := config["exporters"].(map[interface{}]interface{})["awsemf"].(map[interface{}]interface{})
+	assert.Equal(t, metricsNamespace, exporter["namespace"])
+}
+
+func TestConvertToTaskDefinitionWithCloudWatchMetricsInAwsvpcMode(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{
+		containerConfigWithPorts("web", 80),
+		containerConfigWithPorts("sidecar", 80),
+	}
+	metrics := &Metrics{
+		Collector: MetricsCollectorCloudWatch,
+		Path:      "/prometheus",
+		Port:      9100,
+		Interval:  "30s",
+	}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParamsWithMetrics(metrics, ecs.NetworkModeAwsvpc), nil)
+	require.NoError(t, err, "Unexpected error converting the task definition")
+
+	agent := findContainerByName("cloudwatch-agent", taskDefinition.ContainerDefinitions)
+	assert.Equal(t, "public.ecr.aws/cloudwatch-agent/cloudwatch-agent:latest", aws.StringValue(agent.Image))
+	assert.Empty(t, agent.Links)
+
+	scrapeConfig := prometheusConfig{}
+	require.NoError(t, yaml.Unmarshal([]byte(environmentValue(agent, prometheusConfigEnvVar)), &scrapeConfig))
+	assert.Equal(t, "30s", scrapeConfig.Global.ScrapeInterval)
+	if assert.Len(t, scrapeConfig.ScrapeConfigs, 1) {
+		job := scrapeConfig.ScrapeConfigs[0]
+		assert.Equal(t, "/prometheus", job.MetricsPath)
+		// the containers share the network interface of the task, so the port is only scraped once
+		assert.Equal(t, []prometheusStaticConfig{{
+			Targets: []string{"localhost:9100"},
+			Labels:  map[string]string{"container": "web"},
+		}}, job.StaticConfigs)
+	}
+
+	agentConfig := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal([]byte(environmentValue(agent, cloudWatchConfigEnvVar)), &agentConfig))
+	prometheus := agentConfig["logs"].(map[string]interface{})["metrics_collected"].(map[string]interface{})["prometheus"].(map[string]interface{})
+	assert.Equal(t, "env:"+prometheusConfigEnvVar, prometheus["prometheus_config_path"])
+}
+
+func TestConvertToTaskDefinitionWithMetricsSkipsTracingSidecar(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{containerConfigWithPorts("web", 8080)}
+	ecsParams := ecsParamsWithMetrics(&Metrics{}, ecs.NetworkModeAwsvpc)
+	ecsParams.TaskDefinition.Tracing = TracingOTEL
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+	require.NoError(t, err, "Unexpected error converting the task definition")
+
+	require.Len(t, taskDefinition.ContainerDefinitions, 3)
+	collector := findContainerByName("metrics-collector", taskDefinition.ContainerDefinitions)
+	assert.Contains(t, environmentValue(collector, adotConfigEnvVar), "localhost:8080")
+	assert.NotContains(t, environmentValue(collector, adotConfigEnvVar), "4317")
+}
+
+func TestConvertToTaskDefinitionWithMetricsWithoutPorts(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{containerConfigWithPorts("web")}
+
+	_, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParamsWithMetrics(&Metrics{}, ""), nil)
+	assert.EqualError(t, err, "No container exposes a port to scrape metrics from. Map the ports of the containers in the compose file, or set the port of the metrics section")
+}
+
+func TestConvertToTaskDefinitionWithUnsupportedMetricsCollector(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{containerConfigWithPorts("web", 80)}
+
+	_, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParamsWithMetrics(&Metrics{Collector: "datadog"}, ""), nil)
+	assert.EqualError(t, err, "Metrics collector datadog is not supported. Supported values are: adot, cloudwatch")
+}
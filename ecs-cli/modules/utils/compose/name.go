@@ -23,6 +23,9 @@ const (
 	// changing this will cause user's caches to break.
 	ProjectTDCache = "tdcache"
 
+	// the ~/.cache/ecscompose directory in which to store the resources created for each project
+	ProjectStateCache = "projectstate"
+
 	// prefix for grouping tasks
 	TaskGroupPrefix = "task"
 )
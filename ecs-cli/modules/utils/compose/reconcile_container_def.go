@@ -19,6 +19,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
 )
 
 const (
@@ -130,6 +131,21 @@ func reconcileContainerDef(inputCfg *adapter.ContainerConfig, ecsConDef *Contain
 			outputContDef.SetSecrets(convertToECSSecrets(ecsConDef.Secrets))
 		}
 
+		// volumes_from is not supported by compose v3, so it can be set in ecs-params instead
+		if len(ecsConDef.VolumesFrom) > 0 {
+			volumesFrom, err := adapter.ConvertToVolumesFrom(ecsConDef.VolumesFrom)
+			if err != nil {
+				return nil, err
+			}
+			if len(inputCfg.VolumesFrom) > 0 {
+				log.WithFields(log.Fields{
+					"option name":  "volumes_from",
+					"service name": inputCfg.Name,
+				}).Info("Using ecs-params value as override")
+			}
+			outputContDef.SetVolumesFrom(volumesFrom)
+		}
+
 		if len(ecsConDef.ContainerDependencies) > 0 {
 			outputContDef.SetDependsOn(convertToECSContainerDependency(ecsConDef.ContainerDependencies))
 		}
@@ -153,6 +169,12 @@ func reconcileContainerDef(inputCfg *adapter.ContainerConfig, ecsConDef *Contain
 			}
 			resourceRequirements = append(resourceRequirements, &resourceRequirement)
 		}
+
+		resourceRequirements = append(resourceRequirements, inferenceAcceleratorRequirements(ecsConDef.InferenceAccelerators)...)
+
+		if ecsConDef.NeuronDevices > 0 {
+			addNeuronDevices(outputContDef.LinuxParameters, ecsConDef.NeuronDevices)
+		}
 	}
 
 	// At least one memory value is required to register a task definition.
@@ -0,0 +1,101 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
+)
+
+// validateContainerResources checks that the resources of the containers fit within the task size,
+// which is required by ECS. A container reserves its soft memory limit if one is set, or else its
+// hard memory limit, and no hard memory limit can exceed the task memory. Task sizes that can't be
+// parsed are left for ECS to validate.
+func validateContainerResources(containerDefs []*ecs.ContainerDefinition, taskCPU, taskMemory string) error {
+	if taskCPU != "" {
+		if cpu, ok := parseTaskCPU(taskCPU); ok {
+			var totalCPU int64
+			for _, containerDef := range containerDefs {
+				totalCPU += aws.Int64Value(containerDef.Cpu)
+			}
+			if totalCPU > cpu {
+				return fmt.Errorf("The containers reserve %d CPU units in total, which is more than the task cpu_limit of %s (%d CPU units)", totalCPU, taskCPU, cpu)
+			}
+		}
+	}
+
+	if taskMemory != "" {
+		if memory, ok := parseTaskMemory(taskMemory); ok {
+			var totalMemory int64
+			for _, containerDef := range containerDefs {
+				hardLimit := aws.Int64Value(containerDef.Memory)
+				if hardLimit > memory {
+					return fmt.Errorf("The mem_limit of container %s (%d MiB) is more than the task mem_limit of %s (%d MiB)", aws.StringValue(containerDef.Name), hardLimit, taskMemory, memory)
+				}
+				if containerDef.MemoryReservation != nil {
+					totalMemory += aws.Int64Value(containerDef.MemoryReservation)
+				} else {
+					totalMemory += hardLimit
+				}
+			}
+			if totalMemory > memory {
+				return fmt.Errorf("The containers reserve %d MiB of memory in total, which is more than the task mem_limit of %s (%d MiB)", totalMemory, taskMemory, memory)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseTaskCPU converts a task cpu_limit, in CPU units (e.g. 512) or vCPUs (e.g. 0.5 vCPU), to CPU units
+func parseTaskCPU(cpu string) (int64, bool) {
+	value := strings.ToLower(strings.TrimSpace(cpu))
+	multiplier := float64(1)
+	if strings.HasSuffix(value, "vcpu") {
+		value = strings.TrimSpace(strings.TrimSuffix(value, "vcpu"))
+		multiplier = adapter.CPUUnitsPerVCPU
+	}
+	return parseTaskSizeValue(cpu, value, multiplier)
+}
+
+// parseTaskMemory converts a task mem_limit, in MiB (e.g. 512 or 512MB) or GiB (e.g. 0.5GB), to MiB
+func parseTaskMemory(memory string) (int64, bool) {
+	value := strings.ToLower(strings.TrimSpace(memory))
+	multiplier := float64(1)
+	switch {
+	case strings.HasSuffix(value, "gb"):
+		value = strings.TrimSpace(strings.TrimSuffix(value, "gb"))
+		multiplier = 1024
+	case strings.HasSuffix(value, "mb"):
+		value = strings.TrimSpace(strings.TrimSuffix(value, "mb"))
+	}
+	return parseTaskSizeValue(memory, value, multiplier)
+}
+
+func parseTaskSizeValue(original, value string, multiplier float64) (int64, bool) {
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"task size": original,
+		}).Debug("Unable to parse task size; skipping validation of container resources")
+		return 0, false
+	}
+	return int64(parsed * multiplier), true
+}
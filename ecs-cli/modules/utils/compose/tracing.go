@@ -0,0 +1,165 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
+)
+
+// Values of the tracing field of the task definition in ecs-params
+const (
+	TracingXRay = "xray"
+	TracingOTEL = "otel"
+)
+
+// Environment variables read by the X-Ray and OpenTelemetry SDKs to find the daemon or the collector
+const (
+	xrayDaemonAddressEnvVar = "AWS_XRAY_DAEMON_ADDRESS"
+	otlpEndpointEnvVar      = "OTEL_EXPORTER_OTLP_ENDPOINT"
+)
+
+const (
+	xrayDaemonPort   = 2000
+	otlpReceiverPort = 4317
+)
+
+// tracingSidecar describes the container injected into the task definition for a tracing option
+type tracingSidecar struct {
+	name         string
+	image        string
+	command      []string
+	portMappings []*ecs.PortMapping
+	// policies are the names of the AWS managed policies the task role needs to send the traces
+	policies []string
+}
+
+var tracingSidecars = map[string]tracingSidecar{
+	TracingXRay: {
+		name:  "xray-daemon",
+		image: "public.ecr.aws/xray/aws-xray-daemon:latest",
+		portMappings: []*ecs.PortMapping{
+			{ContainerPort: aws.Int64(xrayDaemonPort), Protocol: aws.String(ecs.TransportProtocolUdp)},
+		},
+		policies: []string{"AWSXRayDaemonWriteAccess"},
+	},
+	TracingOTEL: {
+		name:    "aws-otel-collector",
+		image:   "public.ecr.aws/aws-observability/aws-otel-collector:latest",
+		command: []string{"--config=/etc/ecs/ecs-default-config.yaml"},
+		portMappings: []*ecs.PortMapping{
+			{ContainerPort: aws.Int64(xrayDaemonPort), Protocol: aws.String(ecs.TransportProtocolUdp)},
+			{ContainerPort: aws.Int64(otlpReceiverPort), Protocol: aws.String(ecs.TransportProtocolTcp)},
+		},
+		// the default configuration of the collector also sends metrics to CloudWatch
+		policies: []string{"AWSXRayDaemonWriteAccess", "CloudWatchAgentServerPolicy"},
+	},
+}
+
+// SidecarPolicies returns the names of the AWS managed policies the task role needs for the tracing
+// and metrics sidecars of the task definition, or nil if there are none
+func SidecarPolicies(taskDef EcsTaskDef) []string {
+	var policies []string
+	seen := make(map[string]bool)
+	add := func(names []string) {
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				policies = append(policies, name)
+			}
+		}
+	}
+	add(tracingSidecars[taskDef.Tracing].policies)
+	if taskDef.Metrics != nil {
+		add(metricsPolicies)
+	}
+	return policies
+}
+
+func validateTracing(tracing string) error {
+	if _, ok := tracingSidecars[tracing]; tracing != "" && !ok {
+		return fmt.Errorf("Tracing %s is not supported. Supported values are: %s, %s", tracing, TracingXRay, TracingOTEL)
+	}
+	return nil
+}
+
+// addTracingSidecar adds the X-Ray daemon or the OpenTelemetry collector to the containers of the
+// task, and points the tracing SDKs of the other containers to it. A container of the compose file
+// with the name of the sidecar is used instead of the default one.
+func addTracingSidecar(containerDefs []*ecs.ContainerDefinition, tracing, networkMode string) []*ecs.ContainerDefinition {
+	sidecar, ok := tracingSidecars[tracing]
+	if !ok {
+		return containerDefs
+	}
+
+	// containers in the bridge network mode, the default one, reach the sidecar through a link,
+	// whereas the containers of an awsvpc or host task share its network interface
+	host := "127.0.0.1"
+	useLinks := networkMode == "" || networkMode == ecs.NetworkModeBridge
+	if useLinks {
+		host = sidecar.name
+	}
+
+	hasSidecar := false
+	for _, containerDef := range containerDefs {
+		if aws.StringValue(containerDef.Name) == sidecar.name {
+			hasSidecar = true
+			log.WithFields(log.Fields{"container": sidecar.name}).Info("Using the tracing container of the compose file")
+			continue
+		}
+		setDefaultEnvironment(containerDef, xrayDaemonAddressEnvVar, fmt.Sprintf("%s:%d", host, xrayDaemonPort))
+		if tracing == TracingOTEL {
+			setDefaultEnvironment(containerDef, otlpEndpointEnvVar, fmt.Sprintf("http://%s:%d", host, otlpReceiverPort))
+		}
+		if useLinks {
+			containerDef.Links = append(containerDef.Links, aws.String(sidecar.name))
+		}
+	}
+	if hasSidecar {
+		return containerDefs
+	}
+
+	// the sidecar is not essential, so that a tracing failure does not stop the task
+	sidecarDef := &ecs.ContainerDefinition{
+		Name:              aws.String(sidecar.name),
+		Image:             aws.String(sidecar.image),
+		Essential:         aws.Bool(false),
+		Cpu:               aws.Int64(32),
+		MemoryReservation: aws.Int64(256),
+	}
+	for _, portMapping := range sidecar.portMappings {
+		mapping := *portMapping
+		sidecarDef.PortMappings = append(sidecarDef.PortMappings, &mapping)
+	}
+	if len(sidecar.command) > 0 {
+		sidecarDef.Command = aws.StringSlice(sidecar.command)
+	}
+	return append(containerDefs, sidecarDef)
+}
+
+// setDefaultEnvironment sets the environment variable of the container, unless it is already set
+func setDefaultEnvironment(containerDef *ecs.ContainerDefinition, name, value string) {
+	for _, env := range containerDef.Environment {
+		if aws.StringValue(env.Name) == name {
+			return
+		}
+	}
+	containerDef.Environment = append(containerDef.Environment, &ecs.KeyValuePair{
+		Name:  aws.String(name),
+		Value: aws.String(value),
+	})
+}
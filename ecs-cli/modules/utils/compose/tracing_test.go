@@ -0,0 +1,119 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ecsParamsWithTracing(tracing, networkMode string) *ECSParams {
+	return &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			NetworkMode: networkMode,
+			Tracing:     tracing,
+		},
+	}
+}
+
+func environmentValue(containerDef *ecs.ContainerDefinition, name string) string {
+	for _, env := range containerDef.Environment {
+		if aws.StringValue(env.Name) == name {
+			return aws.StringValue(env.Value)
+		}
+	}
+	return ""
+}
+
+func TestConvertToTaskDefinitionWithXRayTracingInBridgeMode(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{{Name: "web", Image: "httpd"}}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParamsWithTracing(TracingXRay, ""), nil)
+	require.NoError(t, err, "Unexpected error converting the task definition")
+
+	require.Len(t, taskDefinition.ContainerDefinitions, 2)
+	web := findContainerByName("web", taskDefinition.ContainerDefinitions)
+	assert.Equal(t, "xray-daemon:2000", environmentValue(web, xrayDaemonAddressEnvVar))
+	assert.Equal(t, []string{"xray-daemon"}, aws.StringValueSlice(web.Links))
+
+	daemon := findContainerByName("xray-daemon", taskDefinition.ContainerDefinitions)
+	assert.Equal(t, "public.ecr.aws/xray/aws-xray-daemon:latest", aws.StringValue(daemon.Image))
+	assert.False(t, aws.BoolValue(daemon.Essential), "Expected the daemon not to be essential")
+	assert.Equal(t, int64(32), aws.Int64Value(daemon.Cpu))
+	assert.Equal(t, int64(256), aws.Int64Value(daemon.MemoryReservation))
+	if assert.Len(t, daemon.PortMappings, 1) {
+		assert.Equal(t, int64(2000), aws.Int64Value(daemon.PortMappings[0].ContainerPort))
+		assert.Equal(t, ecs.TransportProtocolUdp, aws.StringValue(daemon.PortMappings[0].Protocol))
+	}
+}
+
+func TestConvertToTaskDefinitionWithOTELTracingInAwsvpcMode(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{{
+		Name:  "web",
+		Image: "httpd",
+		Environment: []*ecs.KeyValuePair{{
+			Name:  aws.String(xrayDaemonAddressEnvVar),
+			Value: aws.String("tracing.internal:2000"),
+		}},
+	}}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParamsWithTracing(TracingOTEL, ecs.NetworkModeAwsvpc), nil)
+	require.NoError(t, err, "Unexpected error converting the task definition")
+
+	web := findContainerByName("web", taskDefinition.ContainerDefinitions)
+	assert.Equal(t, "tracing.internal:2000", environmentValue(web, xrayDaemonAddressEnvVar), "Expected the compose value to be kept")
+	assert.Equal(t, "http://127.0.0.1:4317", environmentValue(web, otlpEndpointEnvVar))
+	assert.Empty(t, web.Links)
+
+	collector := findContainerByName("aws-otel-collector", taskDefinition.ContainerDefinitions)
+	assert.Equal(t, []string{"--config=/etc/ecs/ecs-default-config.yaml"}, aws.StringValueSlice(collector.Command))
+	assert.Len(t, collector.PortMappings, 2)
+}
+
+func TestConvertToTaskDefinitionWithTracingContainerInCompose(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{
+		{Name: "web", Image: "httpd"},
+		{Name: "xray-daemon", Image: "amazon/aws-xray-daemon:3.2.0"},
+	}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParamsWithTracing(TracingXRay, ecs.NetworkModeHost), nil)
+	require.NoError(t, err, "Unexpected error converting the task definition")
+
+	require.Len(t, taskDefinition.ContainerDefinitions, 2)
+	daemon := findContainerByName("xray-daemon", taskDefinition.ContainerDefinitions)
+	assert.Equal(t, "amazon/aws-xray-daemon:3.2.0", aws.StringValue(daemon.Image))
+	assert.Empty(t, environmentValue(daemon, xrayDaemonAddressEnvVar))
+
+	web := findContainerByName("web", taskDefinition.ContainerDefinitions)
+	assert.Equal(t, "127.0.0.1:2000", environmentValue(web, xrayDaemonAddressEnvVar))
+}
+
+func TestConvertToTaskDefinitionWithUnsupportedTracing(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{{Name: "web", Image: "httpd"}}
+
+	_, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParamsWithTracing("zipkin", ""), nil)
+	assert.EqualError(t, err, "Tracing zipkin is not supported. Supported values are: xray, otel")
+}
+
+func TestSidecarPolicies(t *testing.T) {
+	assert.Equal(t, []string{"AWSXRayDaemonWriteAccess"}, SidecarPolicies(EcsTaskDef{Tracing: TracingXRay}))
+	assert.Equal(t, []string{"AWSXRayDaemonWriteAccess", "CloudWatchAgentServerPolicy"}, SidecarPolicies(EcsTaskDef{Tracing: TracingOTEL, Metrics: &Metrics{}}))
+	assert.Equal(t, []string{"CloudWatchAgentServerPolicy"}, SidecarPolicies(EcsTaskDef{Metrics: &Metrics{}}))
+	assert.Empty(t, SidecarPolicies(EcsTaskDef{}))
+}
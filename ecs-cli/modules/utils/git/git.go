@@ -0,0 +1,105 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package git detects the source control metadata of the working tree a
+// deployment is made from, so that deployed resources can be traced back to it.
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+// Keys of the resource tags with the git metadata of a deployment
+const (
+	CommitTagKey     = "git-commit"
+	BranchTagKey     = "git-branch"
+	RepositoryTagKey = "git-repository"
+)
+
+// maxTagValueLength is the maximum length of the value of an AWS resource tag
+const maxTagValueLength = 256
+
+// invalidTagValueCharacters matches the characters which are not allowed in AWS resource tag values
+var invalidTagValueCharacters = regexp.MustCompile(`[^\p{L}\p{N}\s+\-=._:/@]`)
+
+// Metadata is the source control information of a deployment
+type Metadata struct {
+	Commit     string
+	Branch     string
+	Repository string
+	// Dirty is true if the working tree has uncommitted changes
+	Dirty bool
+}
+
+// runGit runs a git command in a directory and returns its trimmed output; can be replaced in tests
+var runGit = func(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("git %s: %s", strings.Join(args, " "), message)
+		}
+		return "", fmt.Errorf("git %s: %v", strings.Join(args, " "), err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// Detect returns the metadata of the git working tree containing dir. The branch is empty if HEAD
+// is detached, and the repository is empty if there is no origin remote.
+func Detect(dir string) (*Metadata, error) {
+	commit, err := runGit(dir, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	metadata := &Metadata{Commit: commit}
+
+	if branch, err := runGit(dir, "rev-parse", "--abbrev-ref", "HEAD"); err == nil && branch != "HEAD" {
+		metadata.Branch = branch
+	}
+	if repository, err := runGit(dir, "config", "--get", "remote.origin.url"); err == nil {
+		metadata.Repository = RedactRepositoryURL(repository)
+	}
+	if status, err := runGit(dir, "status", "--porcelain"); err == nil && status != "" {
+		metadata.Dirty = true
+	}
+	return metadata, nil
+}
+
+// RedactRepositoryURL removes the credentials from an HTTP(S) repository URL (e.g. a token embedded in
+// the remote by a CI system), so that it can be stored in resource tags.
+func RedactRepositoryURL(repository string) string {
+	parsed, err := url.Parse(repository)
+	if err != nil || parsed.User == nil || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return repository
+	}
+	parsed.User = nil
+	return parsed.String()
+}
+
+// TagValue converts a value to a valid AWS resource tag value, replacing the characters which are
+// not allowed and truncating it to the maximum length of 256 characters.
+func TagValue(value string) string {
+	value = invalidTagValueCharacters.ReplaceAllString(value, "_")
+	if runes := []rune(value); len(runes) > maxTagValueLength {
+		value = string(runes[:maxTagValueLength])
+	}
+	return value
+}
@@ -0,0 +1,226 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package interrupt handles SIGINT and SIGTERM during long running operations, so that the user
+// decides what happens to the operation in AWS instead of the CLI exiting in an unknown state.
+package interrupt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const gateHandlerName = "ecscli.InterruptGateHandler"
+
+// Choices at the interrupt prompt
+const (
+	ChoiceLeave    = "leave"
+	ChoiceRollback = "rollback"
+	ChoiceDetach   = "detach"
+)
+
+// Operation is a long running operation in AWS, e.g. the creation of the stack of a cluster.
+// Its functions are called after an interrupt, when the requests of the command are stopped, so they
+// must use clients of an Ungated session.
+type Operation struct {
+	// Description of the operation in progress, e.g. "Creation of the CloudFormation stack 'x'"
+	Description string
+	// Status returns the current state of the operation in AWS
+	Status func() (string, error)
+	// Rollback starts undoing the operation; nil if it cannot be undone
+	Rollback func() error
+	// RollbackDescription describes what Rollback does, e.g. "delete the stack"
+	RollbackDescription string
+
+	cleanupMu sync.Mutex
+	cleanups  []func()
+}
+
+// AddCleanup adds a function restoring a state the command changed for the operation, e.g. the
+// suspended scale-in of a service, which must be restored even if the command is interrupted.
+// The cleanups run once the interrupt is resolved, whatever the choice at the prompt.
+func (o *Operation) AddCleanup(cleanup func()) {
+	o.cleanupMu.Lock()
+	defer o.cleanupMu.Unlock()
+	o.cleanups = append(o.cleanups, cleanup)
+}
+
+// Cleanup runs the cleanups of the operation, the last added first.
+func (o *Operation) Cleanup() {
+	o.cleanupMu.Lock()
+	cleanups := o.cleanups
+	o.cleanupMu.Unlock()
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
+}
+
+var (
+	mu          sync.Mutex
+	interrupted bool
+	// resolved is never closed: the requests and the command wait on it until the process exits
+	resolved = make(chan struct{})
+
+	// can be replaced in tests
+	stdin      io.Reader = os.Stdin
+	stderr     io.Writer = os.Stderr
+	exit                 = os.Exit
+	isTerminal           = func() bool { return terminal.IsTerminal(int(os.Stdin.Fd())) }
+)
+
+// Handle traps SIGINT and SIGTERM while the operation runs, until the returned function is called.
+// On SIGINT in a terminal, the user is asked whether to leave the operation running in AWS, roll it
+// back, or detach; otherwise the operation is left running and its status is printed. The CLI then
+// exits, without issuing any other request than those of the operation's functions.
+func Handle(operation *Operation) func() {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case sig := <-signals:
+			exit(resolve(operation, sig, signals))
+		case <-done:
+		}
+	}()
+	return func() {
+		if isInterrupted() {
+			// the process exits once the interrupt is resolved
+			<-resolved
+		}
+		signal.Stop(signals)
+		close(done)
+	}
+}
+
+// GateHandler stops the requests issued after an interrupt: they wait until the process exits.
+// It is added to the Send handlers of the sessions of the CLI.
+var GateHandler = request.NamedHandler{
+	Name: gateHandlerName,
+	Fn: func(r *request.Request) {
+		if isInterrupted() {
+			<-resolved
+		}
+	},
+}
+
+// Ungated returns a copy of the session whose requests are not stopped by an interrupt
+func Ungated(sess *session.Session) *session.Session {
+	ungated := sess.Copy()
+	ungated.Handlers.Send.RemoveByName(gateHandlerName)
+	return ungated
+}
+
+func isInterrupted() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return interrupted
+}
+
+// resolve handles the signal and returns the exit code of the CLI
+func resolve(operation *Operation, sig os.Signal, signals <-chan os.Signal) int {
+	mu.Lock()
+	interrupted = true
+	mu.Unlock()
+
+	exitCode := 130
+	if sig == syscall.SIGTERM {
+		exitCode = 143
+	}
+
+	fmt.Fprintf(stderr, "\nInterrupted. %s is still in progress in AWS.\n", operation.Description)
+	defer operation.Cleanup()
+	choice := ChoiceLeave
+	if sig == syscall.SIGINT && isTerminal() {
+		choice = prompt(operation, signals)
+	}
+
+	switch choice {
+	case ChoiceRollback:
+		fmt.Fprintf(stderr, "Rolling back: %s...\n", operation.RollbackDescription)
+		if err := operation.Rollback(); err != nil {
+			fmt.Fprintf(stderr, "Rollback failed: %v\n", err)
+			printStatus(operation)
+			return exitCode
+		}
+		fmt.Fprintln(stderr, "Rollback started.")
+		printStatus(operation)
+	case ChoiceLeave:
+		fmt.Fprintln(stderr, "Left the operation running in AWS.")
+		printStatus(operation)
+	default:
+		fmt.Fprintln(stderr, "Detached. The operation may still be running in AWS.")
+	}
+	return exitCode
+}
+
+// prompt asks what to do with the operation until the answer is valid. Another interrupt detaches.
+func prompt(operation *Operation, signals <-chan os.Signal) string {
+	choices := map[string]string{"": ChoiceLeave, "l": ChoiceLeave, ChoiceLeave: ChoiceLeave, "d": ChoiceDetach, ChoiceDetach: ChoiceDetach}
+	question := "[l]eave it running (default), or [d]etach without checking its status?"
+	if operation.Rollback != nil {
+		choices["r"] = ChoiceRollback
+		choices[ChoiceRollback] = ChoiceRollback
+		question = fmt.Sprintf("[l]eave it running (default), [r]oll back (%s), or [d]etach without checking its status?", operation.RollbackDescription)
+	}
+
+	answers := make(chan string)
+	reader := bufio.NewReader(stdin)
+	go func() {
+		for {
+			input, err := reader.ReadString('\n')
+			if err != nil && input == "" {
+				close(answers)
+				return
+			}
+			answers <- strings.ToLower(strings.TrimSpace(input))
+		}
+	}()
+
+	for {
+		fmt.Fprintln(stderr, question)
+		select {
+		case answer, ok := <-answers:
+			if !ok {
+				return ChoiceLeave
+			}
+			if choice, valid := choices[answer]; valid {
+				return choice
+			}
+		case <-signals:
+			return ChoiceDetach
+		}
+	}
+}
+
+func printStatus(operation *Operation) {
+	if operation.Status == nil {
+		return
+	}
+	status, err := operation.Status()
+	if err != nil {
+		fmt.Fprintf(stderr, "Could not get the status of the operation: %v\n", err)
+		return
+	}
+	fmt.Fprintln(stderr, status)
+}
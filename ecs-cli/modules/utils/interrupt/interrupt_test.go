@@ -0,0 +1,170 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package interrupt
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testOperation records whether its status was described and whether it was rolled back
+type testOperation struct {
+	statusCalled bool
+	rolledBack   bool
+}
+
+func (o *testOperation) operation(rollbackErr error) *Operation {
+	return &Operation{
+		Description: "Creation of the CloudFormation stack 'test'",
+		Status: func() (string, error) {
+			o.statusCalled = true
+			return "The CloudFormation stack 'test' is CREATE_IN_PROGRESS.", nil
+		},
+		Rollback: func() error {
+			o.rolledBack = true
+			return rollbackErr
+		},
+		RollbackDescription: "delete the stack",
+	}
+}
+
+// setupResolve replaces the terminal with the input, until the returned function is called
+func setupResolve(input string, terminal bool) (*bytes.Buffer, func()) {
+	var output bytes.Buffer
+	originalStdin, originalStderr, originalIsTerminal := stdin, stderr, isTerminal
+	stdin = strings.NewReader(input)
+	stderr = &output
+	isTerminal = func() bool { return terminal }
+	return &output, func() {
+		stdin, stderr, isTerminal = originalStdin, originalStderr, originalIsTerminal
+		mu.Lock()
+		interrupted = false
+		mu.Unlock()
+	}
+}
+
+func TestResolveRollback(t *testing.T) {
+	output, restore := setupResolve("x\nr\n", true)
+	defer restore()
+
+	o := &testOperation{}
+	exitCode := resolve(o.operation(nil), os.Interrupt, make(chan os.Signal))
+	assert.Equal(t, 130, exitCode)
+	assert.True(t, isInterrupted(), "Expected the requests to be stopped")
+	assert.True(t, o.rolledBack, "Expected the operation to be rolled back")
+	assert.True(t, o.statusCalled, "Expected the status to be printed after the rollback")
+	assert.Equal(t, 2, strings.Count(output.String(), "[r]oll back (delete the stack)"), "Expected the invalid answer to be asked again")
+	assert.Contains(t, output.String(), "Rollback started.")
+}
+
+func TestResolveRollbackFailed(t *testing.T) {
+	output, restore := setupResolve("rollback\n", true)
+	defer restore()
+
+	o := &testOperation{}
+	resolve(o.operation(errors.New("stack is in UPDATE_COMPLETE_CLEANUP_IN_PROGRESS")), os.Interrupt, make(chan os.Signal))
+	assert.Contains(t, output.String(), "Rollback failed: stack is in UPDATE_COMPLETE_CLEANUP_IN_PROGRESS")
+	assert.True(t, o.statusCalled, "Expected the status to be printed after the failed rollback")
+}
+
+func TestResolveLeaveByDefault(t *testing.T) {
+	output, restore := setupResolve("\n", true)
+	defer restore()
+
+	o := &testOperation{}
+	resolve(o.operation(nil), os.Interrupt, make(chan os.Signal))
+	assert.False(t, o.rolledBack, "Expected the operation to be left running")
+	assert.Contains(t, output.String(), "Left the operation running in AWS.\nThe CloudFormation stack 'test' is CREATE_IN_PROGRESS.\n")
+}
+
+func TestResolveDetach(t *testing.T) {
+	output, restore := setupResolve("d\n", true)
+	defer restore()
+
+	o := &testOperation{}
+	resolve(o.operation(nil), os.Interrupt, make(chan os.Signal))
+	assert.False(t, o.statusCalled, "Expected no request after detaching")
+	assert.Contains(t, output.String(), "Detached.")
+}
+
+func TestResolveRunsCleanups(t *testing.T) {
+	for _, input := range []string{"l\n", "r\n", "d\n"} {
+		_, restore := setupResolve(input, true)
+
+		var cleanups []string
+		operation := (&testOperation{}).operation(nil)
+		operation.AddCleanup(func() { cleanups = append(cleanups, "first") })
+		operation.AddCleanup(func() { cleanups = append(cleanups, "second") })
+		resolve(operation, os.Interrupt, make(chan os.Signal))
+		assert.Equal(t, []string{"second", "first"}, cleanups, "Expected the cleanups to run in reverse order for %q", input)
+		restore()
+	}
+}
+
+func TestResolveSecondInterruptDetaches(t *testing.T) {
+	_, restore := setupResolve("", true)
+	defer restore()
+	// the prompt waits for an answer on a reader which never returns one
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+	defer writer.Close()
+	stdin = reader
+
+	signals := make(chan os.Signal, 1)
+	signals <- os.Interrupt
+	o := &testOperation{}
+	resolve(o.operation(nil), os.Interrupt, signals)
+	assert.False(t, o.statusCalled, "Expected no request after a second interrupt")
+}
+
+func TestResolveWithoutTerminal(t *testing.T) {
+	output, restore := setupResolve("r\n", false)
+	defer restore()
+
+	o := &testOperation{}
+	exitCode := resolve(o.operation(nil), syscall.SIGTERM, make(chan os.Signal))
+	assert.Equal(t, 143, exitCode)
+	assert.False(t, o.rolledBack, "Expected no prompt without a terminal")
+	assert.True(t, o.statusCalled, "Expected the status to be printed")
+	assert.NotContains(t, output.String(), "[l]eave")
+}
+
+func TestPromptWithoutRollback(t *testing.T) {
+	output, restore := setupResolve("r\nl\n", true)
+	defer restore()
+
+	operation := (&testOperation{}).operation(nil)
+	operation.Rollback = nil
+	assert.Equal(t, ChoiceLeave, prompt(operation, make(chan os.Signal)))
+	assert.NotContains(t, output.String(), "[r]oll back")
+}
+
+func TestUngated(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String("us-west-2")})
+	require.NoError(t, err)
+	sess.Handlers.Send.PushFrontNamed(GateHandler)
+
+	ungated := Ungated(sess)
+	assert.Equal(t, sess.Handlers.Send.Len()-1, ungated.Handlers.Send.Len(), "Expected the gate to be removed")
+	assert.Equal(t, sess.Config.Credentials, ungated.Config.Credentials, "Expected the credentials to be shared")
+}
@@ -0,0 +1,117 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package notify
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+)
+
+// Names of the metrics published for the events
+const (
+	MetricOperations = "Operations"
+	MetricFailures   = "Failures"
+	MetricDuration   = "Duration"
+	MetricRollbacks  = "Rollbacks"
+)
+
+// Names of the dimensions of the metrics
+const (
+	dimensionOperation = "Operation"
+	dimensionCluster   = "Cluster"
+	dimensionService   = "Service"
+)
+
+// metricsSink publishes the outcome of events as CloudWatch metrics.
+type metricsSink struct {
+	client    cloudwatchiface.CloudWatchAPI
+	namespace string
+}
+
+// NewMetricsSink creates a Sink which publishes CloudWatch metrics in the namespace, in the region
+// of the session, for the completed and rolled back operations.
+func NewMetricsSink(sess *session.Session, namespace string) Sink {
+	return &metricsSink{
+		client:    cloudwatch.New(sess),
+		namespace: namespace,
+	}
+}
+
+func (s *metricsSink) Send(event *Event) error {
+	metricData := eventMetrics(event)
+	if len(metricData) == 0 {
+		return nil
+	}
+	_, err := s.client.PutMetricData(&cloudwatch.PutMetricDataInput{
+		Namespace:  aws.String(s.namespace),
+		MetricData: metricData,
+	})
+	return err
+}
+
+// eventMetrics returns the metrics of the event: the count, failures and duration of a completed
+// operation, or the count of rollbacks. Each metric is published per cluster, and also per service
+// for deploys, so that both can be graphed. Started operations have no metrics.
+func eventMetrics(event *Event) []*cloudwatch.MetricDatum {
+	type metric struct {
+		name  string
+		value float64
+		unit  string
+	}
+	var metrics []metric
+	switch event.Status {
+	case StatusSucceeded, StatusFailed:
+		failures := 0.0
+		if event.Status == StatusFailed {
+			failures = 1
+		}
+		metrics = append(metrics, metric{MetricOperations, 1, "Count"}, metric{MetricFailures, failures, "Count"})
+		if event.Duration > 0 {
+			metrics = append(metrics, metric{MetricDuration, event.Duration, "Seconds"})
+		}
+	case StatusRolledBack:
+		metrics = append(metrics, metric{MetricRollbacks, 1, "Count"})
+	default:
+		return nil
+	}
+
+	dimensionSets := [][]*cloudwatch.Dimension{{
+		{Name: aws.String(dimensionOperation), Value: aws.String(event.Type)},
+		{Name: aws.String(dimensionCluster), Value: aws.String(event.Cluster)},
+	}}
+	if event.Service != "" {
+		dimensionSets = append(dimensionSets, append(dimensionSets[0], &cloudwatch.Dimension{
+			Name: aws.String(dimensionService), Value: aws.String(event.Service),
+		}))
+	}
+
+	var data []*cloudwatch.MetricDatum
+	for _, dimensions := range dimensionSets {
+		for _, m := range metrics {
+			datum := &cloudwatch.MetricDatum{
+				MetricName: aws.String(m.name),
+				Dimensions: dimensions,
+				Value:      aws.Float64(m.value),
+				Unit:       aws.String(m.unit),
+			}
+			if !event.Timestamp.IsZero() {
+				datum.Timestamp = aws.Time(event.Timestamp)
+			}
+			data = append(data, datum)
+		}
+	}
+	return data
+}
@@ -0,0 +1,115 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package notify
+
+import (
+	"testing"
+	"time"
+
+	mock_cloudwatchiface "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/notify/mock/cloudwatch"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// metricValues returns the values of the metrics by name, for the dimensions of the given length
+func metricValues(data []*cloudwatch.MetricDatum, dimensions int) map[string]float64 {
+	values := make(map[string]float64)
+	for _, datum := range data {
+		if len(datum.Dimensions) == dimensions {
+			values[aws.StringValue(datum.MetricName)] = aws.Float64Value(datum.Value)
+		}
+	}
+	return values
+}
+
+func TestEventMetrics(t *testing.T) {
+	testCases := map[string]struct {
+		event              *Event
+		expectedByCluster  map[string]float64
+		expectedByService  map[string]float64
+		expectedDataPoints int
+	}{
+		"deploy started": {
+			event: &Event{Type: EventDeploy, Status: StatusStarted, Cluster: "prod", Service: "web"},
+		},
+		"deploy succeeded": {
+			event:              &Event{Type: EventDeploy, Status: StatusSucceeded, Cluster: "prod", Service: "web", Duration: 312.5},
+			expectedByCluster:  map[string]float64{MetricOperations: 1, MetricFailures: 0, MetricDuration: 312.5},
+			expectedByService:  map[string]float64{MetricOperations: 1, MetricFailures: 0, MetricDuration: 312.5},
+			expectedDataPoints: 6,
+		},
+		"deploy failed": {
+			event:              &Event{Type: EventDeploy, Status: StatusFailed, Cluster: "prod", Service: "web", Duration: 60},
+			expectedByCluster:  map[string]float64{MetricOperations: 1, MetricFailures: 1, MetricDuration: 60},
+			expectedByService:  map[string]float64{MetricOperations: 1, MetricFailures: 1, MetricDuration: 60},
+			expectedDataPoints: 6,
+		},
+		"deploy rolled back": {
+			event:              &Event{Type: EventDeploy, Status: StatusRolledBack, Cluster: "prod", Service: "web"},
+			expectedByCluster:  map[string]float64{MetricRollbacks: 1},
+			expectedByService:  map[string]float64{MetricRollbacks: 1},
+			expectedDataPoints: 2,
+		},
+		"cluster up without duration": {
+			event:              &Event{Type: EventClusterUp, Status: StatusSucceeded, Cluster: "prod"},
+			expectedByCluster:  map[string]float64{MetricOperations: 1, MetricFailures: 0},
+			expectedByService:  map[string]float64{},
+			expectedDataPoints: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data := eventMetrics(tc.event)
+			assert.Len(t, data, tc.expectedDataPoints)
+			if tc.expectedDataPoints == 0 {
+				return
+			}
+			assert.Equal(t, tc.expectedByCluster, metricValues(data, 2), "Unexpected metrics per cluster")
+			assert.Equal(t, tc.expectedByService, metricValues(data, 3), "Unexpected metrics per service")
+		})
+	}
+}
+
+func TestMetricsSink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCloudWatch := mock_cloudwatchiface.NewMockCloudWatchAPI(ctrl)
+	sink := &metricsSink{client: mockCloudWatch, namespace: "ECSCLI/Deployments"}
+
+	var inputs []*cloudwatch.PutMetricDataInput
+	mockCloudWatch.EXPECT().PutMetricData(gomock.Any()).Do(func(input *cloudwatch.PutMetricDataInput) {
+		inputs = append(inputs, input)
+	}).Return(&cloudwatch.PutMetricDataOutput{}, nil)
+
+	timestamp := time.Date(2026, 10, 14, 9, 12, 45, 0, time.UTC)
+	assert.NoError(t, sink.Send(&Event{Type: EventDeploy, Status: StatusStarted, Cluster: "prod", Service: "web"}))
+	assert.Empty(t, inputs, "Expected no metrics for a started deploy")
+
+	assert.NoError(t, sink.Send(&Event{Type: EventDeploy, Status: StatusSucceeded, Cluster: "prod", Service: "web", Timestamp: timestamp}))
+	require.Len(t, inputs, 1)
+	input := inputs[0]
+	assert.Equal(t, "ECSCLI/Deployments", aws.StringValue(input.Namespace))
+	datum := input.MetricData[len(input.MetricData)-1]
+	assert.Equal(t, timestamp, aws.TimeValue(datum.Timestamp))
+	assert.Equal(t, "Count", aws.StringValue(datum.Unit))
+	var dimensions []string
+	for _, d := range datum.Dimensions {
+		dimensions = append(dimensions, aws.StringValue(d.Name)+"="+aws.StringValue(d.Value))
+	}
+	assert.Equal(t, []string{"Operation=deploy", "Cluster=prod", "Service=web"}, dimensions)
+}
@@ -0,0 +1,175 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package notify sends notifications of deployments and cluster changes to
+// webhooks (e.g. Slack incoming webhooks) and SNS topics, and publishes their
+// outcome as CloudWatch metrics.
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/sirupsen/logrus"
+)
+
+// Types of events
+const (
+	EventDeploy      = "deploy"
+	EventClusterUp   = "cluster-up"
+	EventClusterDown = "cluster-down"
+)
+
+// Statuses of events
+const (
+	StatusStarted   = "STARTED"
+	StatusSucceeded = "SUCCEEDED"
+	StatusFailed    = "FAILED"
+	// StatusRolledBack is the status of a deploy undone after it failed or was interrupted
+	StatusRolledBack = "ROLLED_BACK"
+)
+
+// Event is a single notification.
+type Event struct {
+	// Text is a human readable summary of the event; Slack incoming webhooks display it as the message
+	Text           string    `json:"text"`
+	Timestamp      time.Time `json:"timestamp"`
+	Type           string    `json:"type"`
+	Status         string    `json:"status"`
+	Cluster        string    `json:"cluster,omitempty"`
+	Region         string    `json:"region,omitempty"`
+	Service        string    `json:"service,omitempty"`
+	TaskDefinition string    `json:"taskDefinition,omitempty"`
+	Caller         string    `json:"caller,omitempty"`
+	Account        string    `json:"account,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	// Duration is the number of seconds the operation took, set once it completed
+	Duration float64 `json:"durationSeconds,omitempty"`
+}
+
+// Sink is a destination for notifications.
+type Sink interface {
+	Send(event *Event) error
+}
+
+// Notifier sends events to its sinks. A nil Notifier sends nothing, so that callers do not
+// need to check whether notifications are configured.
+type Notifier struct {
+	sinks    []Sink
+	identity *callerIdentity
+}
+
+// callerIdentity resolves the identity of the caller once per process.
+type callerIdentity struct {
+	once    sync.Once
+	client  stsiface.STSAPI
+	arn     string
+	account string
+}
+
+func (c *callerIdentity) get() (string, string) {
+	c.once.Do(func() {
+		output, err := c.client.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+		if err != nil {
+			logrus.Warnf("Unable to determine caller identity for notifications: %v", err)
+			return
+		}
+		c.arn = aws.StringValue(output.Arn)
+		c.account = aws.StringValue(output.Account)
+	})
+	return c.arn, c.account
+}
+
+// New creates a Notifier which posts events to the webhook URL, publishes them to the SNS topic
+// and publishes their metrics in the CloudWatch namespace, whichever are configured. It returns
+// nil if none is.
+func New(sess *session.Session, webhookURL, topicArn, metricsNamespace string) *Notifier {
+	var sinks []Sink
+	if webhookURL != "" {
+		sinks = append(sinks, NewWebhookSink(webhookURL))
+	}
+	if topicArn != "" {
+		sinks = append(sinks, NewSNSSink(sess, topicArn))
+	}
+	if metricsNamespace != "" {
+		sinks = append(sinks, NewMetricsSink(sess, metricsNamespace))
+	}
+	if len(sinks) == 0 {
+		return nil
+	}
+	return newNotifier(sinks, sts.New(sess.Copy()))
+}
+
+func newNotifier(sinks []Sink, stsClient stsiface.STSAPI) *Notifier {
+	return &Notifier{
+		sinks:    sinks,
+		identity: &callerIdentity{client: stsClient},
+	}
+}
+
+// Notify sends the event to the sinks. Failures are logged rather than returned, so that a
+// notification never fails the command.
+func (n *Notifier) Notify(event *Event) {
+	if n == nil {
+		return
+	}
+	event.Timestamp = time.Now().UTC()
+	event.Caller, event.Account = n.identity.get()
+	event.Text = summary(event)
+	for _, sink := range n.sinks {
+		if err := sink.Send(event); err != nil {
+			logrus.Warnf("Unable to send %s notification: %v", event.Type, err)
+		}
+	}
+}
+
+// summary returns a one line description of the event, e.g.
+// "Deploy of service web to cluster prod succeeded (task definition web:42) by arn:aws:iam::123456789012:user/alice"
+func summary(event *Event) string {
+	var subject string
+	switch event.Type {
+	case EventDeploy:
+		subject = "Deploy"
+		if event.Service != "" {
+			subject += " of service " + event.Service
+		}
+		subject += " to cluster " + event.Cluster
+	case EventClusterUp:
+		subject = "Creation of cluster " + event.Cluster
+	case EventClusterDown:
+		subject = "Deletion of cluster " + event.Cluster
+	default:
+		subject = event.Type + " of cluster " + event.Cluster
+	}
+	if event.Region != "" {
+		subject += " in " + event.Region
+	}
+
+	text := fmt.Sprintf("%s %s", subject, strings.ToLower(strings.Replace(event.Status, "_", " ", -1)))
+	if event.TaskDefinition != "" {
+		text += fmt.Sprintf(" (task definition %s)", event.TaskDefinition)
+	}
+	if event.Caller != "" {
+		text += " by " + event.Caller
+	}
+	if event.Error != "" {
+		text += ": " + event.Error
+	}
+	return text
+}
@@ -0,0 +1,137 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package notify
+
+import (
+	"errors"
+	"testing"
+
+	mock_stsiface "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts/mock/sdk"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testCallerArn = "arn:aws:iam::123456789012:user/deployer"
+	testAccountID = "123456789012"
+)
+
+type recordingSink struct {
+	events []Event
+	err    error
+}
+
+func (s *recordingSink) Send(event *Event) error {
+	s.events = append(s.events, *event)
+	return s.err
+}
+
+func TestNewWithoutDestinations(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String("us-west-2")}))
+	assert.Nil(t, New(sess, "", "", ""), "Expected no notifier when no webhook, topic or metrics namespace is configured")
+}
+
+func TestNotifyNilNotifier(t *testing.T) {
+	var notifier *Notifier
+	assert.NotPanics(t, func() {
+		notifier.Notify(&Event{Type: EventDeploy, Status: StatusStarted})
+	})
+}
+
+func TestNotifyAddsCallerIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSTS := mock_stsiface.NewMockSTSAPI(ctrl)
+
+	// the identity is only resolved once per process
+	mockSTS.EXPECT().GetCallerIdentity(gomock.Any()).Return(&sts.GetCallerIdentityOutput{
+		Arn:     aws.String(testCallerArn),
+		Account: aws.String(testAccountID),
+	}, nil).Times(1)
+
+	sink := &recordingSink{}
+	notifier := newNotifier([]Sink{sink}, mockSTS)
+	notifier.Notify(&Event{
+		Type:    EventDeploy,
+		Status:  StatusStarted,
+		Cluster: "prod",
+		Region:  "us-west-2",
+		Service: "web",
+	})
+	notifier.Notify(&Event{
+		Type:           EventDeploy,
+		Status:         StatusSucceeded,
+		Cluster:        "prod",
+		Region:         "us-west-2",
+		Service:        "web",
+		TaskDefinition: "web:42",
+	})
+
+	assert.Len(t, sink.events, 2)
+	event := sink.events[1]
+	assert.Equal(t, testCallerArn, event.Caller)
+	assert.Equal(t, testAccountID, event.Account)
+	assert.False(t, event.Timestamp.IsZero(), "Expected the timestamp to be set")
+	assert.Equal(t, "Deploy of service web to cluster prod in us-west-2 succeeded (task definition web:42) by "+testCallerArn, event.Text)
+}
+
+func TestNotifyContinuesAfterSinkError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSTS := mock_stsiface.NewMockSTSAPI(ctrl)
+	mockSTS.EXPECT().GetCallerIdentity(gomock.Any()).Return(nil, errors.New("AccessDenied"))
+
+	failing := &recordingSink{err: errors.New("connection refused")}
+	sink := &recordingSink{}
+	notifier := newNotifier([]Sink{failing, sink}, mockSTS)
+	notifier.Notify(&Event{Type: EventClusterDown, Status: StatusSucceeded, Cluster: "prod"})
+
+	assert.Len(t, failing.events, 1)
+	assert.Len(t, sink.events, 1, "Expected the event to be sent to the remaining sinks")
+	assert.Empty(t, sink.events[0].Caller, "Expected no caller when the identity cannot be determined")
+	assert.Equal(t, "Deletion of cluster prod succeeded", sink.events[0].Text)
+}
+
+func TestSummary(t *testing.T) {
+	testCases := map[string]struct {
+		event    *Event
+		expected string
+	}{
+		"deploy started": {
+			event:    &Event{Type: EventDeploy, Status: StatusStarted, Cluster: "prod", Service: "web"},
+			expected: "Deploy of service web to cluster prod started",
+		},
+		"deploy failed": {
+			event:    &Event{Type: EventDeploy, Status: StatusFailed, Cluster: "prod", Service: "web", TaskDefinition: "web:3", Error: "timeout"},
+			expected: "Deploy of service web to cluster prod failed (task definition web:3): timeout",
+		},
+		"deploy rolled back": {
+			event:    &Event{Type: EventDeploy, Status: StatusRolledBack, Cluster: "prod", Service: "web", TaskDefinition: "web:2"},
+			expected: "Deploy of service web to cluster prod rolled back (task definition web:2)",
+		},
+		"cluster up": {
+			event:    &Event{Type: EventClusterUp, Status: StatusSucceeded, Cluster: "prod", Region: "eu-west-1", Caller: testCallerArn},
+			expected: "Creation of cluster prod in eu-west-1 succeeded by " + testCallerArn,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, summary(tc.event))
+		})
+	}
+}
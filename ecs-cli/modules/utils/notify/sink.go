@@ -0,0 +1,101 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+)
+
+const (
+	webhookTimeout = 10 * time.Second
+
+	// SNS subjects are limited to 100 characters
+	maxSubjectLength = 100
+)
+
+// webhookSink posts events as JSON to a URL.
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink creates a Sink which posts events as JSON to the URL. The text field of the
+// event makes the payload compatible with Slack incoming webhooks.
+func NewWebhookSink(url string) Sink {
+	return &webhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+func (s *webhookSink) Send(event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// snsSink publishes events as JSON to an SNS topic.
+type snsSink struct {
+	client   snsiface.SNSAPI
+	topicArn string
+}
+
+// NewSNSSink creates a Sink which publishes events to the SNS topic, in the region of the topic.
+func NewSNSSink(sess *session.Session, topicArn string) Sink {
+	region := aws.StringValue(sess.Config.Region)
+	if parsed, err := arn.Parse(topicArn); err == nil {
+		region = parsed.Region
+	}
+	return &snsSink{
+		client:   sns.New(sess, &aws.Config{Region: aws.String(region)}),
+		topicArn: topicArn,
+	}
+}
+
+func (s *snsSink) Send(event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	subject := event.Text
+	if len(subject) > maxSubjectLength {
+		subject = subject[:maxSubjectLength-3] + "..."
+	}
+	_, err = s.client.Publish(&sns.PublishInput{
+		TopicArn: aws.String(s.topicArn),
+		Subject:  aws.String(subject),
+		Message:  aws.String(string(data)),
+	})
+	return err
+}
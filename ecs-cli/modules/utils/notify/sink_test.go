@@ -0,0 +1,81 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mock_snsiface "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/notify/mock/sns"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSink(t *testing.T) {
+	var received Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL)
+	err := sink.Send(&Event{Text: "Deploy of service web to cluster prod started", Type: EventDeploy, Cluster: "prod"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Deploy of service web to cluster prod started", received.Text, "Expected the text field used by Slack")
+	assert.Equal(t, "prod", received.Cluster)
+}
+
+func TestWebhookSinkErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	err := NewWebhookSink(server.URL).Send(&Event{Type: EventDeploy})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+}
+
+func TestSNSSink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSNS := mock_snsiface.NewMockSNSAPI(ctrl)
+	sink := &snsSink{client: mockSNS, topicArn: "arn:aws:sns:us-east-1:123456789012:deploys"}
+
+	text := strings.Repeat("a", maxSubjectLength+20)
+	mockSNS.EXPECT().Publish(gomock.Any()).Do(func(input *sns.PublishInput) {
+		assert.Equal(t, "arn:aws:sns:us-east-1:123456789012:deploys", aws.StringValue(input.TopicArn))
+		assert.Len(t, aws.StringValue(input.Subject), maxSubjectLength, "Expected the subject to be truncated")
+
+		event := &Event{}
+		assert.NoError(t, json.Unmarshal([]byte(aws.StringValue(input.Message)), event))
+		assert.Equal(t, EventClusterUp, event.Type)
+		assert.Equal(t, text, event.Text)
+	}).Return(&sns.PublishOutput{MessageId: aws.String("id")}, nil)
+
+	assert.NoError(t, sink.Send(&Event{Text: text, Type: EventClusterUp, Cluster: "prod"}))
+}
+
+func TestNewSNSSinkUsesTopicRegion(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String("us-west-2")}))
+	sink := NewSNSSink(sess, "arn:aws:sns:eu-west-1:123456789012:deploys").(*snsSink)
+	assert.Equal(t, "eu-west-1", aws.StringValue(sink.client.(*sns.SNS).Config.Region), "Expected the client to use the region of the topic")
+}
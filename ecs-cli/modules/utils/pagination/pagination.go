@@ -0,0 +1,139 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package pagination caps the number of items a command lists, and resumes a listing
+// where a previous invocation of the command stopped.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// Token is the position at which a capped listing stopped. A command may list several
+// collections in turn, e.g. the RUNNING then the STOPPED tasks of a cluster, so the token
+// records the collection to resume along with the NextToken returned by AWS.
+type Token struct {
+	Listing   string `json:"listing"`
+	NextToken string `json:"nextToken,omitempty"`
+}
+
+// String encodes the token as the value of the --starting-token flag
+func (t *Token) String() string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseToken decodes the value of the --starting-token flag
+func ParseToken(value string) (*Token, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("--%s is not a token printed by a previous listing", flags.StartingTokenFlag)
+	}
+	token := &Token{}
+	if err := json.Unmarshal(data, token); err != nil || token.Listing == "" {
+		return nil, fmt.Errorf("--%s is not a token printed by a previous listing", flags.StartingTokenFlag)
+	}
+	return token, nil
+}
+
+// Paginator caps a listing at --max-items items and resumes it from --starting-token.
+// Pages are requested with a page size which ends the last page exactly at the cap, so
+// that the NextToken of that page resumes the listing without skipping any item.
+type Paginator struct {
+	maxItems int64
+	start    *Token
+	listed   int64
+}
+
+// NewPaginator returns the paginator for the pagination flags of the command. The context
+// may be nil, in which case the listing is neither capped nor resumed.
+func NewPaginator(context *cli.Context) (*Paginator, error) {
+	paginator := &Paginator{}
+	if context == nil {
+		return paginator, nil
+	}
+	paginator.maxItems = int64(context.Int(flags.MaxItemsFlag))
+	if paginator.maxItems < 0 {
+		return nil, fmt.Errorf("--%s must be zero or greater", flags.MaxItemsFlag)
+	}
+	if value := context.String(flags.StartingTokenFlag); value != "" {
+		start, err := ParseToken(value)
+		if err != nil {
+			return nil, err
+		}
+		paginator.start = start
+	}
+	return paginator, nil
+}
+
+// Enabled returns true if the listing is capped or resumed
+func (p *Paginator) Enabled() bool {
+	return p.maxItems > 0 || p.start != nil
+}
+
+// Resume returns the listings left to list, starting from the listing of the starting
+// token, and the NextToken to resume the first of them with.
+func (p *Paginator) Resume(listings []string) ([]string, *string, error) {
+	if p.start == nil {
+		return listings, nil, nil
+	}
+	for i, listing := range listings {
+		if listing == p.start.Listing {
+			var nextToken *string
+			if p.start.NextToken != "" {
+				nextToken = aws.String(p.start.NextToken)
+			}
+			return listings[i:], nextToken, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("--%s resumes the listing of '%s', which this command does not list", flags.StartingTokenFlag, p.start.Listing)
+}
+
+// PageSize returns the page size of the next request to an API that returns at most apiMax
+// items per page, or nil if the listing is not capped.
+func (p *Paginator) PageSize(apiMax int64) *int64 {
+	if p.maxItems == 0 {
+		return nil
+	}
+	if remaining := p.maxItems - p.listed; remaining < apiMax {
+		return aws.Int64(remaining)
+	}
+	return aws.Int64(apiMax)
+}
+
+// Add counts the items of a page, and returns true once the listing reached --max-items
+func (p *Paginator) Add(items int) bool {
+	p.listed += int64(items)
+	return p.maxItems > 0 && p.listed >= p.maxItems
+}
+
+// Stop logs the token which resumes the listing after a page of the listing reached
+// --max-items, unless the page was the last one of the last listing.
+func (p *Paginator) Stop(items, listing string, nextToken *string, remainingListings []string) {
+	var token *Token
+	if aws.StringValue(nextToken) != "" {
+		token = &Token{Listing: listing, NextToken: aws.StringValue(nextToken)}
+	} else if len(remainingListings) > 0 {
+		token = &Token{Listing: remainingListings[0]}
+	}
+	if token != nil {
+		logrus.Infof("Listed %d %s; to list more, run the command again with --%s %s", p.listed, items, flags.StartingTokenFlag, token)
+	}
+}
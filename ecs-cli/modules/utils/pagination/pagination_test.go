@@ -0,0 +1,90 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package pagination
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func newContext(maxItems int, startingToken string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli-ps", 0)
+	flagSet.Int(flags.MaxItemsFlag, maxItems, "")
+	flagSet.String(flags.StartingTokenFlag, startingToken, "")
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func TestTokenRoundTrip(t *testing.T) {
+	token := &Token{Listing: "STOPPED", NextToken: "eyJhbGciOi/+="}
+	parsed, err := ParseToken(token.String())
+	require.NoError(t, err, "Unexpected error parsing token")
+	assert.Equal(t, token, parsed)
+}
+
+func TestParseTokenInvalid(t *testing.T) {
+	_, err := ParseToken("not a token")
+	assert.Error(t, err, "Expected error parsing a value which is not base64")
+
+	_, err = ParseToken((&Token{}).String())
+	assert.Error(t, err, "Expected error parsing a token without listing")
+}
+
+func TestNewPaginator(t *testing.T) {
+	paginator, err := NewPaginator(nil)
+	require.NoError(t, err, "Unexpected error without context")
+	assert.False(t, paginator.Enabled(), "Expected pagination to be disabled without context")
+
+	paginator, err = NewPaginator(newContext(0, ""))
+	require.NoError(t, err, "Unexpected error without flags")
+	assert.False(t, paginator.Enabled(), "Expected pagination to be disabled without flags")
+	assert.Nil(t, paginator.PageSize(100), "Expected the default page size without --max-items")
+
+	_, err = NewPaginator(newContext(-1, ""))
+	assert.Error(t, err, "Expected error with negative --max-items")
+
+	_, err = NewPaginator(newContext(0, "garbage!"))
+	assert.Error(t, err, "Expected error with an invalid --starting-token")
+}
+
+func TestPaginatorCapsPages(t *testing.T) {
+	paginator, err := NewPaginator(newContext(250, ""))
+	require.NoError(t, err, "Unexpected error creating paginator")
+	assert.True(t, paginator.Enabled())
+
+	assert.Equal(t, int64(100), aws.Int64Value(paginator.PageSize(100)))
+	assert.False(t, paginator.Add(100))
+	assert.False(t, paginator.Add(100))
+	assert.Equal(t, int64(50), aws.Int64Value(paginator.PageSize(100)), "Expected the last page to end at --max-items")
+	assert.True(t, paginator.Add(50))
+}
+
+func TestPaginatorResume(t *testing.T) {
+	token := &Token{Listing: "STOPPED", NextToken: "next"}
+	paginator, err := NewPaginator(newContext(0, token.String()))
+	require.NoError(t, err, "Unexpected error creating paginator")
+
+	listings, nextToken, err := paginator.Resume([]string{"RUNNING", "STOPPED"})
+	require.NoError(t, err, "Unexpected error resuming listing")
+	assert.Equal(t, []string{"STOPPED"}, listings)
+	assert.Equal(t, "next", aws.StringValue(nextToken))
+
+	_, _, err = paginator.Resume([]string{"RUNNING"})
+	assert.Error(t, err, "Expected error resuming a listing the command does not list")
+}
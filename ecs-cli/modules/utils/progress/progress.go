@@ -0,0 +1,150 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package progress emits machine-readable progress events for long running
+// operations so that wrapper tools do not have to scrape log lines.
+package progress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Supported values for the --progress flag
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Phases reported by the CLI
+const (
+	PhaseCluster       = "cluster"
+	PhaseStack         = "stack"
+	PhaseStackResource = "stack-resource"
+	PhaseService       = "service"
+)
+
+// Statuses reported for phases which are not backed by an AWS resource status
+const (
+	StatusStarted    = "STARTED"
+	StatusInProgress = "IN_PROGRESS"
+	StatusComplete   = "COMPLETE"
+	StatusFailed     = "FAILED"
+)
+
+// Event is a single progress update, serialized as one line of JSON.
+type Event struct {
+	Timestamp time.Time `json:"timestamp"`
+	Phase     string    `json:"phase"`
+	Resource  string    `json:"resource"`
+	Status    string    `json:"status"`
+	Message   string    `json:"message,omitempty"`
+	Current   *int64    `json:"current,omitempty"`
+	Total     *int64    `json:"total,omitempty"`
+}
+
+// Reporter receives progress events.
+type Reporter interface {
+	Report(event Event)
+}
+
+// noopReporter drops all events; used for the default human readable output.
+type noopReporter struct{}
+
+func (noopReporter) Report(Event) {}
+
+// jsonReporter writes newline-delimited JSON events to a writer.
+type jsonReporter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewJSONReporter creates a Reporter which writes each event as a line of JSON to w.
+func NewJSONReporter(w io.Writer) Reporter {
+	return &jsonReporter{enc: json.NewEncoder(w)}
+}
+
+func (r *jsonReporter) Report(event Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.enc.Encode(event); err != nil {
+		logrus.Debugf("Unable to write progress event: %v", err)
+	}
+}
+
+var (
+	reporter Reporter  = noopReporter{}
+	output   io.Writer = os.Stdout
+
+	// now can be mocked in tests
+	now = time.Now
+)
+
+// SetFormat configures the reporter for the value of the --progress flag. With
+// the JSON format, events are written to stdout, and log and human readable output
+// are moved to stderr so that stdout can be consumed line by line.
+func SetFormat(format string) error {
+	switch format {
+	case "", FormatText:
+		reporter = noopReporter{}
+		output = os.Stdout
+	case FormatJSON:
+		logrus.SetOutput(os.Stderr)
+		reporter = NewJSONReporter(os.Stdout)
+		output = os.Stderr
+	default:
+		return fmt.Errorf("Invalid progress format '%s'. Valid values are '%s' and '%s'", format, FormatText, FormatJSON)
+	}
+	return nil
+}
+
+// Output returns where human readable output, such as tables, prompts and the output
+// of child processes, is written: stdout, or stderr if stdout carries JSON events.
+func Output() io.Writer {
+	return output
+}
+
+// SetReporter replaces the reporter that receives events.
+func SetReporter(r Reporter) {
+	reporter = r
+}
+
+// Emit reports a progress event for the resource.
+func Emit(phase, resource, status, message string) {
+	reporter.Report(Event{
+		Timestamp: now().UTC(),
+		Phase:     phase,
+		Resource:  resource,
+		Status:    status,
+		Message:   message,
+	})
+}
+
+// EmitCount reports a progress event which carries a current and total count,
+// e.g. the running and desired task count of a service.
+func EmitCount(phase, resource, status string, current, total int64) {
+	reporter.Report(Event{
+		Timestamp: now().UTC(),
+		Phase:     phase,
+		Resource:  resource,
+		Status:    status,
+		Current:   &current,
+		Total:     &total,
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package progress
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONReporter(t *testing.T) {
+	defer func() {
+		SetReporter(noopReporter{})
+		now = time.Now
+	}()
+	now = func() time.Time {
+		return time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	}
+
+	buf := &bytes.Buffer{}
+	SetReporter(NewJSONReporter(buf))
+
+	Emit(PhaseStack, "my-stack", "CREATE_IN_PROGRESS", "")
+	EmitCount(PhaseService, "my-service", StatusInProgress, 1, 3)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2, "Expected one line per event")
+	assert.Equal(t, `{"timestamp":"2019-06-01T12:00:00Z","phase":"stack","resource":"my-stack","status":"CREATE_IN_PROGRESS"}`, lines[0])
+	assert.Equal(t, `{"timestamp":"2019-06-01T12:00:00Z","phase":"service","resource":"my-service","status":"IN_PROGRESS","current":1,"total":3}`, lines[1])
+}
+
+func TestSetFormat(t *testing.T) {
+	defer func() {
+		SetReporter(noopReporter{})
+		output = os.Stdout
+	}()
+
+	assert.NoError(t, SetFormat(""))
+	assert.IsType(t, noopReporter{}, reporter)
+	assert.Equal(t, os.Stdout, Output())
+	assert.NoError(t, SetFormat(FormatText))
+	assert.IsType(t, noopReporter{}, reporter)
+	assert.Equal(t, os.Stdout, Output())
+	assert.NoError(t, SetFormat(FormatJSON))
+	assert.IsType(t, &jsonReporter{}, reporter)
+	assert.Equal(t, os.Stderr, Output(), "Expected human readable output to move to stderr")
+	assert.Error(t, SetFormat("xml"))
+}
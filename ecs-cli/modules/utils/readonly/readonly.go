@@ -0,0 +1,50 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package readonly keeps the commands that modify AWS resources from running when the
+// ECS CLI is used with read-only credentials.
+package readonly
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// Enabled returns true if the ECS CLI runs in read-only mode.
+func Enabled(context *cli.Context) bool {
+	return context.GlobalBool(flags.ReadOnlyFlag)
+}
+
+// Check returns an error listing the write APIs the command would call if the ECS CLI runs
+// in read-only mode. Dry runs only describe resources, so they are always allowed.
+func Check(context *cli.Context, command string, writeAPIs ...string) error {
+	if !Enabled(context) || context.Bool(flags.DryRunFlag) {
+		return nil
+	}
+	return fmt.Errorf("'%s' is not allowed in read-only mode, since it would call the write APIs %s", command, strings.Join(writeAPIs, ", "))
+}
+
+// Guard wraps the action of a command which calls the given write APIs, so that the command
+// refuses to run in read-only mode before sending any request.
+func Guard(command string, action func(*cli.Context), writeAPIs ...string) func(*cli.Context) {
+	return func(context *cli.Context) {
+		if err := Check(context, command, writeAPIs...); err != nil {
+			logrus.Fatal(err)
+		}
+		action(context)
+	}
+}
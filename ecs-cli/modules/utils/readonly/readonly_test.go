@@ -0,0 +1,51 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package readonly
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func newContext(readOnly, dryRun bool) *cli.Context {
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalSet.Bool(flags.ReadOnlyFlag, readOnly, "")
+	globalContext := cli.NewContext(nil, globalSet, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-command", 0)
+	flagSet.Bool(flags.DryRunFlag, dryRun, "")
+	return cli.NewContext(nil, flagSet, globalContext)
+}
+
+func TestCheck(t *testing.T) {
+	err := Check(newContext(true, false), "compose service up", "ecs:CreateService", "ecs:UpdateService")
+	assert.EqualError(t, err, "'compose service up' is not allowed in read-only mode, since it would call the write APIs ecs:CreateService, ecs:UpdateService")
+}
+
+func TestCheckAllowed(t *testing.T) {
+	assert.NoError(t, Check(newContext(false, false), "compose service up", "ecs:UpdateService"), "Expected commands to run outside of read-only mode")
+	assert.NoError(t, Check(newContext(true, true), "compose service up", "ecs:UpdateService"), "Expected dry runs to be allowed in read-only mode")
+}
+
+func TestGuard(t *testing.T) {
+	called := false
+	action := Guard("secrets redeploy", func(*cli.Context) { called = true }, "ecs:UpdateService")
+
+	action(newContext(false, false))
+	assert.True(t, called, "Expected the action to run outside of read-only mode")
+}
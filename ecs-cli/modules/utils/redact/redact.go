@@ -0,0 +1,96 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package redact hides the secrets in the AWS API requests the ECS CLI prints or records.
+package redact
+
+import (
+	"encoding/json"
+	"regexp"
+)
+
+// Placeholder replaces the redacted values
+const Placeholder = "REDACTED"
+
+// sensitiveNamePattern matches names of environment variables, log driver options
+// and labels whose values are likely to contain secrets
+var sensitiveNamePattern = regexp.MustCompile(`(?i)(secret|password|passwd|token|credential|private|api[-_]?key|access[-_]?key)`)
+
+// freeFormMapFields are the request fields whose keys are chosen by the user
+var freeFormMapFields = map[string]bool{
+	"DockerLabels": true,
+	"Options":      true,
+}
+
+// secretFields are the request fields which hold a secret whatever their context, e.g. the value of
+// a Secrets Manager secret, and are always dropped
+var secretFields = map[string]bool{
+	"SecretBinary": true,
+	"SecretString": true,
+}
+
+// Value returns the value of the environment variable or option with the given name, or a
+// placeholder if its name suggests that it contains a secret.
+func Value(name, value string) string {
+	if sensitiveNamePattern.MatchString(name) {
+		return Placeholder
+	}
+	return value
+}
+
+// Request returns the AWS API request as a generic JSON payload, with secrets redacted.
+func Request(request interface{}) (interface{}, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	var payload interface{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	return Payload(payload), nil
+}
+
+// Payload drops the secret fields of the JSON payload, and replaces the values of sensitive
+// Name/Value pairs (e.g. environment variables) and of sensitive entries in free-form maps
+// (e.g. log driver options).
+func Payload(payload interface{}) interface{} {
+	switch value := payload.(type) {
+	case map[string]interface{}:
+		if name, ok := value["Name"].(string); ok && sensitiveNamePattern.MatchString(name) {
+			if _, ok := value["Value"]; ok {
+				value["Value"] = Placeholder
+			}
+		}
+		for key, item := range value {
+			if secretFields[key] {
+				delete(value, key)
+				continue
+			}
+			if options, ok := item.(map[string]interface{}); ok && freeFormMapFields[key] {
+				for optionKey := range options {
+					if sensitiveNamePattern.MatchString(optionKey) {
+						options[optionKey] = Placeholder
+					}
+				}
+				continue
+			}
+			value[key] = Payload(item)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = Payload(item)
+		}
+	}
+	return payload
+}
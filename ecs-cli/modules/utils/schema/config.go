@@ -0,0 +1,75 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package schema
+
+// clusterConfigSchema describes the ClusterConfig struct of the config package, which must be kept in sync
+const clusterConfigSchema = `{
+  "$schema": "http://json-schema.org/draft-04/schema#",
+  "title": "ECS CLI cluster configurations",
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "version": {"$ref": "#/definitions/string"},
+    "default": {"$ref": "#/definitions/string"},
+    "clusters": {"type": "object", "patternProperties": {".*": {"$ref": "#/definitions/cluster"}}}
+  },
+  "definitions": {
+    "string": {"type": ["string", "number", "boolean"]},
+    "cluster": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "cluster": {"$ref": "#/definitions/string"},
+        "region": {"$ref": "#/definitions/string"},
+        "compose-service-name-prefix": {"$ref": "#/definitions/string"},
+        "cfn-stack-name": {"$ref": "#/definitions/string"},
+        "default_launch_type": {"$ref": "#/definitions/string"},
+        "audit-log-file": {"$ref": "#/definitions/string"},
+        "audit-log-group": {"$ref": "#/definitions/string"},
+        "notification-webhook-url": {"$ref": "#/definitions/string"},
+        "notification-topic-arn": {"$ref": "#/definitions/string"},
+        "deploy-metrics-namespace": {"$ref": "#/definitions/string"},
+        "deploy-lock-table": {"$ref": "#/definitions/string"},
+        "inventory-table": {"$ref": "#/definitions/string"}
+      }
+    }
+  }
+}
+`
+
+// credentialsSchema describes the ProfileConfig struct of the config package, which must be kept in sync
+const credentialsSchema = `{
+  "$schema": "http://json-schema.org/draft-04/schema#",
+  "title": "ECS CLI profiles",
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "version": {"$ref": "#/definitions/string"},
+    "default": {"$ref": "#/definitions/string"},
+    "ecs_profiles": {"type": "object", "patternProperties": {".*": {"$ref": "#/definitions/profile"}}}
+  },
+  "definitions": {
+    "string": {"type": ["string", "number", "boolean"]},
+    "profile": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "aws_access_key_id": {"$ref": "#/definitions/string"},
+        "aws_secret_access_key": {"$ref": "#/definitions/string"},
+        "aws_session_token": {"$ref": "#/definitions/string"}
+      }
+    }
+  }
+}
+`
@@ -0,0 +1,331 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package schema
+
+// ecsParamsSchema describes the ECSParams struct of the compose utils package, which must be kept in sync.
+// String fields also accept numbers and booleans, which YAML unmarshals into strings.
+const ecsParamsSchema = `{
+  "$schema": "http://json-schema.org/draft-04/schema#",
+  "title": "ECS CLI ecs-params.yml",
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "version": {"$ref": "#/definitions/string"},
+    "task_definition": {"$ref": "#/definitions/task_definition"},
+    "run_params": {"$ref": "#/definitions/run_params"}
+  },
+  "definitions": {
+    "string": {"type": ["string", "number", "boolean"]},
+    "string_list": {"type": "array", "items": {"$ref": "#/definitions/string"}},
+    "string_map": {"type": "object", "patternProperties": {".*": {"$ref": "#/definitions/string"}}},
+    "string_or_list": {"oneOf": [{"$ref": "#/definitions/string"}, {"$ref": "#/definitions/string_list"}]},
+    "memory": {"type": ["string", "integer"]},
+    "task_definition": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "ecs_network_mode": {"$ref": "#/definitions/string"},
+        "task_role_arn": {"$ref": "#/definitions/string"},
+        "pid_mode": {"$ref": "#/definitions/string"},
+        "ipc_mode": {"$ref": "#/definitions/string"},
+        "services": {"type": "object", "patternProperties": {".*": {"$ref": "#/definitions/container"}}},
+        "task_execution_role": {"$ref": "#/definitions/string"},
+        "task_size": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "cpu_limit": {"$ref": "#/definitions/string"},
+            "mem_limit": {"$ref": "#/definitions/string"}
+          }
+        },
+        "docker_volumes": {"type": "array", "items": {"$ref": "#/definitions/docker_volume"}},
+        "efs_volumes": {"type": "array", "items": {"$ref": "#/definitions/efs_volume"}},
+        "placement_constraints": {"type": "array", "items": {"$ref": "#/definitions/constraint"}},
+        "tracing": {"enum": ["xray", "otel"]},
+        "metrics": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "collector": {"enum": ["adot", "cloudwatch"]},
+            "path": {"$ref": "#/definitions/string"},
+            "port": {"type": "integer", "minimum": 1, "maximum": 65535},
+            "interval": {"$ref": "#/definitions/string"}
+          }
+        },
+        "inference_accelerators": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "additionalProperties": false,
+            "properties": {
+              "device_name": {"$ref": "#/definitions/string"},
+              "device_type": {"$ref": "#/definitions/string"}
+            }
+          }
+        }
+      }
+    },
+    "container": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "essential": {"type": "boolean"},
+        "init_process_enabled": {"type": "boolean"},
+        "repository_credentials": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "credentials_parameter": {"$ref": "#/definitions/string"}
+          }
+        },
+        "cpu_shares": {"type": "integer"},
+        "mem_limit": {"$ref": "#/definitions/memory"},
+        "mem_reservation": {"$ref": "#/definitions/memory"},
+        "healthcheck": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "test": {"$ref": "#/definitions/string_or_list"},
+            "command": {"$ref": "#/definitions/string_or_list"},
+            "timeout": {"$ref": "#/definitions/string"},
+            "interval": {"$ref": "#/definitions/string"},
+            "retries": {"type": "integer"},
+            "start_period": {"$ref": "#/definitions/string"}
+          }
+        },
+        "logging": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "secret_options": {"type": "array", "items": {"$ref": "#/definitions/secret"}}
+          }
+        },
+        "firelens_configuration": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "type": {"$ref": "#/definitions/string"},
+            "options": {"$ref": "#/definitions/string_map"}
+          }
+        },
+        "secrets": {"type": "array", "items": {"$ref": "#/definitions/secret"}},
+        "gpu": {"$ref": "#/definitions/string"},
+        "depends_on": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "additionalProperties": false,
+            "properties": {
+              "container_name": {"$ref": "#/definitions/string"},
+              "condition": {"$ref": "#/definitions/string"}
+            }
+          }
+        },
+        "volumes_from": {"$ref": "#/definitions/string_list"},
+        "desired_count": {"type": "integer", "minimum": 0},
+        "inference_accelerators": {"$ref": "#/definitions/string_list"},
+        "neuron_devices": {"type": "integer", "minimum": 0}
+      }
+    },
+    "secret": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "value_from": {"$ref": "#/definitions/string"},
+        "name": {"$ref": "#/definitions/string"}
+      }
+    },
+    "docker_volume": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "name": {"$ref": "#/definitions/string"},
+        "scope": {"$ref": "#/definitions/string"},
+        "autoprovision": {"type": "boolean"},
+        "driver": {"$ref": "#/definitions/string"},
+        "driver_opts": {"$ref": "#/definitions/string_map"},
+        "labels": {"$ref": "#/definitions/string_map"}
+      }
+    },
+    "efs_volume": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "name": {"$ref": "#/definitions/string"},
+        "filesystem_id": {"$ref": "#/definitions/string"},
+        "root_directory": {"$ref": "#/definitions/string"},
+        "transit_encryption": {"$ref": "#/definitions/string"},
+        "transit_encryption_port": {"type": "integer"},
+        "access_point": {"$ref": "#/definitions/string"},
+        "iam": {"$ref": "#/definitions/string"}
+      }
+    },
+    "constraint": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "expression": {"$ref": "#/definitions/string"},
+        "type": {"$ref": "#/definitions/string"}
+      }
+    },
+    "run_params": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "network_configuration": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "awsvpc_configuration": {
+              "type": "object",
+              "additionalProperties": false,
+              "properties": {
+                "subnets": {"$ref": "#/definitions/string_list"},
+                "security_groups": {"$ref": "#/definitions/string_list"},
+                "assign_public_ip": {"$ref": "#/definitions/string"}
+              }
+            }
+          }
+        },
+        "task_placement": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "strategy": {
+              "type": "array",
+              "items": {
+                "type": "object",
+                "additionalProperties": false,
+                "properties": {
+                  "field": {"$ref": "#/definitions/string"},
+                  "type": {"$ref": "#/definitions/string"}
+                }
+              }
+            },
+            "constraints": {"type": "array", "items": {"$ref": "#/definitions/constraint"}}
+          }
+        },
+        "service_discovery": {"$ref": "#/definitions/service_discovery"},
+        "service_connect": {"$ref": "#/definitions/service_connect"},
+        "health_check_grace_period": {"type": "integer", "minimum": 0},
+        "deregistration_delay": {"type": "integer", "minimum": 0},
+        "deploy_hooks": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "pre_deploy": {"type": "array", "items": {"$ref": "#/definitions/deploy_hook"}},
+            "post_deploy": {"type": "array", "items": {"$ref": "#/definitions/deploy_hook"}}
+          }
+        }
+      }
+    },
+    "service_discovery": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "container_name": {"$ref": "#/definitions/string"},
+        "container_port": {"type": "integer"},
+        "private_dns_namespace": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "id": {"$ref": "#/definitions/string"},
+            "name": {"$ref": "#/definitions/string"},
+            "vpc": {"$ref": "#/definitions/string"},
+            "description": {"$ref": "#/definitions/string"}
+          }
+        },
+        "public_dns_namespace": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "id": {"$ref": "#/definitions/string"},
+            "name": {"$ref": "#/definitions/string"}
+          }
+        },
+        "service_discovery_service": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "name": {"$ref": "#/definitions/string"},
+            "description": {"$ref": "#/definitions/string"},
+            "dns_config": {
+              "type": "object",
+              "additionalProperties": false,
+              "properties": {
+                "type": {"$ref": "#/definitions/string"},
+                "ttl": {"type": "integer", "minimum": 0}
+              }
+            },
+            "healthcheck_custom_config": {
+              "type": "object",
+              "additionalProperties": false,
+              "properties": {
+                "failure_threshold": {"type": "integer", "minimum": 1}
+              }
+            }
+          }
+        }
+      }
+    },
+    "service_connect": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "namespace": {"$ref": "#/definitions/string"},
+        "services": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "additionalProperties": false,
+            "properties": {
+              "container_name": {"$ref": "#/definitions/string"},
+              "container_port": {"type": "integer", "minimum": 1, "maximum": 65535},
+              "discovery_name": {"$ref": "#/definitions/string"},
+              "client_aliases": {
+                "type": "array",
+                "items": {
+                  "type": "object",
+                  "additionalProperties": false,
+                  "properties": {
+                    "port": {"type": "integer", "minimum": 1, "maximum": 65535},
+                    "dns_name": {"$ref": "#/definitions/string"}
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "deploy_hook": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "name": {"$ref": "#/definitions/string"},
+        "command": {"$ref": "#/definitions/string_list"},
+        "lambda": {"$ref": "#/definitions/string"},
+        "task": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "container": {"$ref": "#/definitions/string"},
+            "command": {"$ref": "#/definitions/string_list"}
+          }
+        }
+      }
+    }
+  }
+}
+`
@@ -0,0 +1,137 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package schema contains the JSON Schemas of the files read by the ECS CLI, and validates
+// the files against them
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// ECSParams is the name of the schema of the ecs-params.yml file
+	ECSParams = "ecs-params"
+	// ClusterConfig is the name of the schema of the cluster configurations file, ~/.ecs/config
+	ClusterConfig = "cluster-config"
+	// Credentials is the name of the schema of the ECS profiles file, ~/.ecs/credentials
+	Credentials = "credentials"
+)
+
+const (
+	// rootContext prefixes the path of every field in the validation results
+	rootContext            = "(root)"
+	invalidPropertyPattern = "invalid_property_pattern"
+)
+
+var schemas = map[string]string{
+	ECSParams:     ecsParamsSchema,
+	ClusterConfig: clusterConfigSchema,
+	Credentials:   credentialsSchema,
+}
+
+// Names returns the names of the schemas, sorted
+func Names() []string {
+	var names []string
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the JSON Schema with the name
+func Get(name string) (string, error) {
+	schema, ok := schemas[name]
+	if !ok {
+		return "", fmt.Errorf("Unknown schema '%s'. Valid schemas are: %s", name, strings.Join(Names(), ", "))
+	}
+	return schema, nil
+}
+
+// Validate checks the YAML document against the schema with the name, and returns an error listing
+// every violation, with the path of the field it concerns. Null values are ignored, as they are when
+// the document is unmarshalled, and so are documents which are not valid YAML, which are reported by
+// the unmarshalling.
+func Validate(name string, document []byte) error {
+	schema, err := Get(name)
+	if err != nil {
+		return err
+	}
+	var data interface{}
+	if err := yaml.Unmarshal(document, &data); err != nil || data == nil {
+		return nil
+	}
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(schema), gojsonschema.NewGoLoader(toJSONValue(data)))
+	if err != nil {
+		return errors.Wrapf(err, "Error validating against the %s schema", name)
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	var violations []string
+	for _, resultErr := range result.Errors() {
+		// the maps of services, clusters and profiles match any key, and the violations inside a
+		// value which does not match its pattern are reported separately
+		if resultErr.Type() == invalidPropertyPattern {
+			continue
+		}
+		violations = append(violations, fmt.Sprintf("%s: %s", violationField(resultErr), resultErr.Description()))
+	}
+	sort.Strings(violations)
+	return fmt.Errorf("%d schema violation(s) (see 'ecs-cli schema %s'):\n  %s", len(violations), name, strings.Join(violations, "\n  "))
+}
+
+// violationField returns the path of the field a violation concerns. The path of an unknown field is
+// that of the object which contains it, so the name of the field is appended to it.
+func violationField(resultErr gojsonschema.ResultError) string {
+	field := strings.TrimPrefix(strings.TrimPrefix(resultErr.Context().String(), rootContext), ".")
+	if property, ok := resultErr.Details()["property"].(string); ok {
+		field = strings.TrimPrefix(field+"."+property, ".")
+	}
+	if field == "" {
+		return "(root)"
+	}
+	return field
+}
+
+// toJSONValue converts the maps unmarshalled from YAML, whose keys are not necessarily strings,
+// to maps which can be marshalled to JSON, and drops null values
+func toJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{})
+		for key, item := range v {
+			if item != nil {
+				m[fmt.Sprint(key)] = toJSONValue(item)
+			}
+		}
+		return m
+	case []interface{}:
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			if item != nil {
+				items = append(items, toJSONValue(item))
+			}
+		}
+		return items
+	}
+	return value
+}
@@ -0,0 +1,176 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemasAreValidJSON(t *testing.T) {
+	for _, name := range Names() {
+		document, err := Get(name)
+		require.NoError(t, err, "Unexpected error getting schema %s", name)
+		var v map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(document), &v), "Schema %s is not valid JSON", name)
+	}
+}
+
+func TestGetUnknownSchema(t *testing.T) {
+	_, err := Get("docker-compose")
+	assert.EqualError(t, err, "Unknown schema 'docker-compose'. Valid schemas are: cluster-config, credentials, ecs-params")
+}
+
+func TestValidateECSParams(t *testing.T) {
+	ecsParams := `version: 1
+task_definition:
+  ecs_network_mode: awsvpc
+  tracing: xray
+  metrics:
+    collector: cloudwatch
+    port: 9100
+  task_size:
+    cpu_limit: 256
+    mem_limit: 0.5GB
+  inference_accelerators:
+    - device_name: eia
+      device_type: eia2.medium
+  services:
+    web:
+      essential: true
+      cpu_shares: 100
+      mem_limit: 512m
+      mem_reservation: 256
+      healthcheck:
+        test: ["CMD", "curl", "-f", "http://localhost"]
+        retries: 3
+      secrets:
+        - value_from: arn:aws:ssm:us-east-1:123456789012:parameter/password
+          name: PASSWORD
+      depends_on:
+        - container_name: log_router
+          condition: START
+      inference_accelerators: [eia]
+      neuron_devices: 1
+    log_router:
+      firelens_configuration:
+        type: fluentbit
+        options:
+          enable-ecs-log-metadata: true
+  docker_volumes:
+    - name: data
+      autoprovision: true
+      labels:
+        owner: web
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets:
+        - subnet-feedface
+      assign_public_ip: ENABLED
+  service_discovery:
+    container_port: 80
+    service_discovery_service:
+      dns_config:
+        ttl: 60
+  service_connect:
+    namespace: internal
+    services:
+      - container_name: web
+        container_port: 80
+        discovery_name: web
+        client_aliases:
+          - port: 80
+            dns_name: web.internal
+  health_check_grace_period: 30
+  deploy_hooks:
+    pre_deploy:
+      - name: migrate
+        task:
+          container: web
+          command: ["./migrate"]`
+
+	assert.NoError(t, Validate(ECSParams, []byte(ecsParams)))
+}
+
+func TestValidateECSParamsViolations(t *testing.T) {
+	ecsParams := `version: 1
+task_definition:
+  task_rol_arn: arn:aws:iam::123456789012:role/web
+  services:
+    web:
+      cpu_shares: lots
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets: subnet-feedface`
+
+	err := Validate(ECSParams, []byte(ecsParams))
+	assert.EqualError(t, err, `3 schema violation(s) (see 'ecs-cli schema ecs-params'):
+  run_params.network_configuration.awsvpc_configuration.subnets: Invalid type. Expected: array, given: string
+  task_definition.services.web.cpu_shares: Invalid type. Expected: integer, given: string
+  task_definition.task_rol_arn: Additional property task_rol_arn is not allowed`)
+}
+
+func TestValidateIgnoresNullValues(t *testing.T) {
+	ecsParams := `version: 1
+task_definition:
+  task_execution_role:
+  services:
+    web:
+      mem_limit:
+run_params:`
+
+	assert.NoError(t, Validate(ECSParams, []byte(ecsParams)))
+}
+
+func TestValidateIgnoresEmptyAndInvalidYAML(t *testing.T) {
+	assert.NoError(t, Validate(ECSParams, []byte("")))
+	assert.NoError(t, Validate(ECSParams, []byte("[default]\n\tcluster = cli-demo")))
+}
+
+func TestValidateClusterConfig(t *testing.T) {
+	clusterConfig := `version: v1
+default: default
+clusters:
+  default:
+    cluster: cli-demo
+    region: us-east-1
+    default_launch_type: FARGATE
+    audit-log-file: /var/log/ecs-cli.log`
+
+	assert.NoError(t, Validate(ClusterConfig, []byte(clusterConfig)))
+
+	err := Validate(ClusterConfig, []byte(clusterConfig+"\n    launch_type: EC2"))
+	assert.EqualError(t, err, `1 schema violation(s) (see 'ecs-cli schema cluster-config'):
+  clusters.default.launch_type: Additional property launch_type is not allowed`)
+}
+
+func TestValidateCredentials(t *testing.T) {
+	credentials := `version: v1
+default: default
+ecs_profiles:
+  default:
+    aws_access_key_id: AKID
+    aws_secret_access_key: SECRET`
+
+	assert.NoError(t, Validate(Credentials, []byte(credentials)))
+
+	err := Validate(Credentials, []byte("ecs_profiles: default"))
+	assert.EqualError(t, err, `1 schema violation(s) (see 'ecs-cli schema credentials'):
+  ecs_profiles: Invalid type. Expected: object, given: string`)
+}
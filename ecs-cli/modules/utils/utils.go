@@ -67,6 +67,32 @@ func EntityAlreadyExists(err error) bool {
 	return false
 }
 
+// NoSuchEntity returns true if an error indicates that the IAM resource does not exist
+func NoSuchEntity(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == "NoSuchEntity"
+	}
+	return false
+}
+
+// accessDeniedCodes are the error codes with which AWS services reject a request the caller
+// is not authorized to make
+var accessDeniedCodes = []string{
+	"AccessDenied",
+	"AccessDeniedException",
+	"AuthorizationError",
+	"UnauthorizedOperation",
+}
+
+// IsAccessDenied returns true if an error indicates that the credentials are not allowed to
+// call the AWS API
+func IsAccessDenied(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return InSlice(awsErr.Code(), accessDeniedCodes)
+	}
+	return false
+}
+
 // ParseTags parses AWS Resource tags from the flag value
 // users specify tags in this format: key1=value1,key2=value2,key3=value3
 func ParseTags(flagValue string, tags []*ecs.Tag) ([]*ecs.Tag, error) {
@@ -84,6 +110,27 @@ func ParseTags(flagValue string, tags []*ecs.Tag) ([]*ecs.Tag, error) {
 	return tags, nil
 }
 
+// ParseAttributes parses ECS attributes from the flag value
+// users specify attributes in this format: name1=value1,name2=value2,name3
+// The value of an attribute is optional.
+func ParseAttributes(flagValue string) ([]*ecs.Attribute, error) {
+	var attributes []*ecs.Attribute
+	for _, nameValue := range strings.Split(flagValue, ",") {
+		pair := strings.SplitN(nameValue, "=", 2)
+		if pair[0] == "" {
+			return nil, fmt.Errorf("Attribute input not formatted correctly: %s", nameValue)
+		}
+		attribute := &ecs.Attribute{
+			Name: aws.String(pair[0]),
+		}
+		if len(pair) == 2 {
+			attribute.Value = aws.String(pair[1])
+		}
+		attributes = append(attributes, attribute)
+	}
+	return attributes, nil
+}
+
 // GetTagsMap parses AWS Resource tags from the flag value
 // users specify tags in this format: key1=value1,key2=value2,key3=value3
 // Returns tags in the format used by the standalone resource tagging API
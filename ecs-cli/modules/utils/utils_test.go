@@ -14,11 +14,13 @@
 package utils
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,6 +35,13 @@ func TestInSlice(t *testing.T) {
 	}
 }
 
+func TestIsAccessDenied(t *testing.T) {
+	assert.True(t, IsAccessDenied(awserr.New("AccessDeniedException", "not authorized", nil)))
+	assert.True(t, IsAccessDenied(awserr.New("UnauthorizedOperation", "not authorized", nil)))
+	assert.False(t, IsAccessDenied(awserr.New("ThrottlingException", "rate exceeded", nil)))
+	assert.False(t, IsAccessDenied(errors.New("AccessDenied")))
+}
+
 func TestGetHomeDir(t *testing.T) {
 	tempDirName := tempDir(t)
 	defer os.Remove(tempDirName)
@@ -73,6 +82,25 @@ func TestParseTags(t *testing.T) {
 
 }
 
+func TestParseAttributes(t *testing.T) {
+	expectedAttributes := []*ecs.Attribute{
+		&ecs.Attribute{
+			Name:  aws.String("stack"),
+			Value: aws.String("prod"),
+		},
+		&ecs.Attribute{
+			Name: aws.String("gpu"),
+		},
+	}
+
+	attributes, err := ParseAttributes("stack=prod,gpu")
+	assert.NoError(t, err, "Unexpected error calling ParseAttributes")
+	assert.Equal(t, expectedAttributes, attributes, "Expected attributes to match")
+
+	_, err = ParseAttributes("stack=prod,=value")
+	assert.Error(t, err, "Expected error calling ParseAttributes without an attribute name")
+}
+
 func TestParseTagsSpaceAndSymbols(t *testing.T) {
 	actualTags := make([]*ecs.Tag, 0)
 	expectedTags := []*ecs.Tag{
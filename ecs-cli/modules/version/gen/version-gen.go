@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 	"strings"
 	"text/template"
+	"time"
 )
 
 const versiongoTemplate = `// This is an autogenerated file and should not be edited.
@@ -54,12 +55,16 @@ const GitDirty = {{.Dirty}}
 
 // GitShortHash is the short hash of this ecs-cli build
 const GitShortHash = "{{.Hash}}"
+
+// BuildDate is the UTC time at which this ecs-cli was built
+const BuildDate = "{{.BuildDate}}"
 `
 
 type versionInfo struct {
-	Version string
-	Dirty   bool
-	Hash    string
+	Version   string
+	Dirty     bool
+	Hash      string
+	BuildDate string
 }
 
 func gitDirty() bool {
@@ -92,9 +97,10 @@ func main() {
 
 	// default values
 	info := versionInfo{
-		Version: strings.TrimSpace(string(versionStr)),
-		Dirty:   true,
-		Hash:    "UNKNOWN",
+		Version:   strings.TrimSpace(string(versionStr)),
+		Dirty:     true,
+		Hash:      "UNKNOWN",
+		BuildDate: "UNKNOWN",
 	}
 
 	if strings.TrimSpace(os.Getenv("ECS_RELEASE")) == "cleanbuild" {
@@ -108,6 +114,7 @@ func main() {
 		// env var should not be set when building, and go generate should be
 		// run before any build, such that the commithash will be set correctly.
 		info.Hash = gitHash()
+		info.BuildDate = time.Now().UTC().Format(time.RFC3339)
 	}
 
 	outFile, err := os.Create("version.go")
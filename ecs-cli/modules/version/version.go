@@ -29,3 +29,6 @@ const GitDirty = true
 
 // GitShortHash is the short hash of this ecs-cli build
 const GitShortHash = "UNKNOWN"
+
+// BuildDate is the UTC time at which this ecs-cli was built
+const BuildDate = "UNKNOWN"